@@ -0,0 +1,22 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	InvitationURL string        // 招待メールのリンクの遷移先（承諾・辞退を選ぶ画面）
+	InvitationTTL time.Duration // 招待の回答期限
+	Locale        mailer.Locale // メール文面の言語
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		InvitationURL: utils.GetEnvDefault("ORG_INVITATION_URL", "http://localhost:3000/invitations"),
+		InvitationTTL: utils.GetEnvDuration("ORG_INVITATION_TTL", 7*24*time.Hour),
+		Locale:        mailer.LocaleFromEnv(),
+	}
+}
@@ -0,0 +1,264 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userRepository "github.com/goda6565/nexus-user-auth/domain/user/repository"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidEmail            = errs.NewServiceError("invalid email")
+	ErrAlreadyMember           = errs.NewServiceError("user is already a member of the organization")
+	ErrInvitationNotFound      = errs.NewServiceError("invitation not found")
+	ErrInvalidInvitationToken  = errs.NewServiceError("invalid invitation token")
+	ErrInvitationEmailMismatch = errs.NewServiceError("invitation was sent to a different email address")
+	ErrEmailNotVerified        = errs.NewServiceError("email not verified")
+)
+
+type InvitationService interface {
+	// Invite: メールアドレスを組織に招待し、承諾・辞退のリンクを送信（オーナー・管理者のみ。オーナーとしての招待はオーナーのみ）
+	Invite(userObjID string, orgObjID string, email string, role string) (*entity.Invitation, error)
+	// ListInvitations: 組織の回答待ちの招待の一覧（オーナー・管理者のみ）
+	ListInvitations(userObjID string, orgObjID string) ([]*entity.Invitation, error)
+	// RevokeInvitation: 回答待ちの招待を取り消す（オーナー・管理者のみ）
+	RevokeInvitation(userObjID string, orgObjID string, invitationID string) error
+	// AcceptInvitation: 招待のトークンで組織に参加（招待されたメールアドレスのアカウントのみ）
+	AcceptInvitation(userObjID string, token string) (*repository.UserOrganization, error)
+	// DeclineInvitation: 招待のトークンで招待を辞退（ログインは不要）
+	DeclineInvitation(token string) error
+}
+
+// invitationService は InvitationService の実装
+type invitationService struct {
+	organizationRepository repository.OrganizationRepository
+	invitationRepository   repository.InvitationRepository
+	userRepository         userRepository.UserRepository
+	mailer                 mailer.Mailer
+	tokens                 *utils.TokenSigner
+	config                 *Config
+}
+
+// NewInvitationService は InvitationService のインスタンスを作成
+func NewInvitationService(organizationRepository repository.OrganizationRepository, invitationRepository repository.InvitationRepository, userRepository userRepository.UserRepository, mailer mailer.Mailer, tokens *utils.TokenSigner, config *Config) InvitationService {
+	return &invitationService{
+		organizationRepository: organizationRepository,
+		invitationRepository:   invitationRepository,
+		userRepository:         userRepository,
+		mailer:                 mailer,
+		tokens:                 tokens,
+		config:                 config,
+	}
+}
+
+// Invite は招待を作成してメールを送信する
+// 同じアドレスへの回答待ちの招待がある場合は取り消して送り直す
+func (s *invitationService) Invite(userObjID string, orgObjID string, email string, role string) (*entity.Invitation, error) {
+	current, err := s.loadManager(userObjID, orgObjID)
+	if err != nil {
+		return nil, err
+	}
+	memberRole, err := value.NewMemberRole(role)
+	if err != nil {
+		return nil, ErrInvalidMemberRole
+	}
+	if memberRole.IsOwner() && !current.Membership.Role().IsOwner() {
+		return nil, ErrForbidden
+	}
+	emailValue, err := userValue.NewUserEmail(email)
+	if err != nil {
+		return nil, ErrInvalidEmail
+	}
+	if invitee, err := s.userRepository.GetUserByEmail(emailValue.Value()); err == nil {
+		membership, err := s.organizationRepository.FindMembership(orgObjID, invitee.ObjID().Value())
+		if err != nil {
+			return nil, errs.NewServiceError("failed to get membership")
+		}
+		if membership != nil {
+			return nil, ErrAlreadyMember
+		}
+	}
+	inviter, err := s.userRepository.GetUserByObjID(userObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get user")
+	}
+
+	now := time.Now()
+	pending, err := s.invitationRepository.ListPendingInvitations(orgObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to list invitations")
+	}
+	for _, invitation := range pending {
+		if !invitation.Email().Equals(emailValue) {
+			continue
+		}
+		if err := invitation.Revoke(now); err != nil {
+			return nil, errs.NewServiceError("failed to revoke invitation")
+		}
+		if _, err := s.invitationRepository.UpdateInvitation(invitation); err != nil {
+			return nil, errs.NewServiceError("failed to update invitation in repository")
+		}
+	}
+
+	invitation, err := entity.NewInvitation(current.Organization.ObjID(), emailValue, memberRole, inviter.ObjID(), now.Add(s.config.InvitationTTL))
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create invitation")
+	}
+	if _, err := s.invitationRepository.CreateInvitation(invitation); err != nil {
+		return nil, errs.NewServiceError("failed to create invitation in repository")
+	}
+
+	token, err := s.tokens.GenerateActionTokenWithRef(utils.PurposeOrgInvitation, orgObjID, emailValue.Value(), invitation.ID(), s.config.InvitationTTL)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to generate invitation token")
+	}
+	link, err := utils.BuildTokenLink(s.config.InvitationURL, token)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to build invitation link")
+	}
+	msg, err := mailer.Render(mailer.TemplateOrgInvitation, s.config.Locale, emailValue.Value(), map[string]any{
+		"Inviter":      inviter.Username().Value(),
+		"Organization": current.Organization.Name().Value(),
+		"Role":         memberRole.Value(),
+		"Link":         link,
+		"ExpiresIn":    s.config.InvitationTTL,
+	})
+	if err != nil {
+		return nil, errs.NewServiceError("failed to render invitation")
+	}
+	if err := s.mailer.Send(msg); err != nil {
+		return nil, errs.NewServiceError("failed to send invitation")
+	}
+	return invitation, nil
+}
+
+func (s *invitationService) ListInvitations(userObjID string, orgObjID string) ([]*entity.Invitation, error) {
+	if _, err := s.loadManager(userObjID, orgObjID); err != nil {
+		return nil, err
+	}
+	invitations, err := s.invitationRepository.ListPendingInvitations(orgObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to list invitations")
+	}
+	return invitations, nil
+}
+
+func (s *invitationService) RevokeInvitation(userObjID string, orgObjID string, invitationID string) error {
+	if _, err := s.loadManager(userObjID, orgObjID); err != nil {
+		return err
+	}
+	invitation, err := s.invitationRepository.FindInvitation(invitationID)
+	if err != nil {
+		return errs.NewServiceError("failed to get invitation")
+	}
+	if invitation == nil || invitation.OrgObjID().Value() != orgObjID || invitation.Status() != entity.InvitationPending {
+		return ErrInvitationNotFound
+	}
+	if err := invitation.Revoke(time.Now()); err != nil {
+		return ErrInvitationNotFound
+	}
+	if _, err := s.invitationRepository.UpdateInvitation(invitation); err != nil {
+		return errs.NewServiceError("failed to update invitation in repository")
+	}
+	return nil
+}
+
+// AcceptInvitation は招待を承諾し、招待されたロールのメンバーとして組織に追加する
+// 招待はメールアドレス宛てのため、同じアドレスを確認済みのアカウントでのみ承諾できる
+func (s *invitationService) AcceptInvitation(userObjID string, token string) (*repository.UserOrganization, error) {
+	invitation, err := s.loadInvitation(token)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepository.GetUserByObjID(userObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get user")
+	}
+	if !user.Email().Equals(invitation.Email()) {
+		return nil, ErrInvitationEmailMismatch
+	}
+	if !user.IsEmailVerified() {
+		return nil, ErrEmailNotVerified
+	}
+	organization, err := s.organizationRepository.FindOrganization(invitation.OrgObjID().Value())
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get organization")
+	}
+	if organization == nil {
+		return nil, ErrInvalidInvitationToken
+	}
+	existing, err := s.organizationRepository.FindMembership(invitation.OrgObjID().Value(), userObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get membership")
+	}
+	if existing != nil {
+		return nil, ErrAlreadyMember
+	}
+
+	if err := invitation.Accept(time.Now()); err != nil {
+		return nil, ErrInvalidInvitationToken
+	}
+	membership, err := entity.NewMembership(invitation.OrgObjID(), user.ObjID(), invitation.Role())
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create membership")
+	}
+	accepted, err := s.invitationRepository.AcceptInvitation(invitation, membership)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to accept invitation in repository")
+	}
+	if !accepted {
+		// 確認後に別のリクエストで回答済みになった
+		return nil, ErrInvalidInvitationToken
+	}
+	return &repository.UserOrganization{Organization: organization, Membership: membership}, nil
+}
+
+// DeclineInvitation は招待を辞退する（リンクを受け取ったアドレスの持ち主であれば、アカウントがなくても辞退できる）
+func (s *invitationService) DeclineInvitation(token string) error {
+	invitation, err := s.loadInvitation(token)
+	if err != nil {
+		return err
+	}
+	if err := invitation.Decline(time.Now()); err != nil {
+		return ErrInvalidInvitationToken
+	}
+	if _, err := s.invitationRepository.UpdateInvitation(invitation); err != nil {
+		return errs.NewServiceError("failed to update invitation in repository")
+	}
+	return nil
+}
+
+// loadManager はユーザーがオーナー・管理者として所属する組織を取得する
+func (s *invitationService) loadManager(userObjID string, orgObjID string) (*repository.UserOrganization, error) {
+	current, err := findUserOrganization(s.organizationRepository, userObjID, orgObjID)
+	if err != nil {
+		return nil, err
+	}
+	if !current.Membership.Role().CanManage() {
+		return nil, ErrForbidden
+	}
+	return current, nil
+}
+
+// loadInvitation はトークンを検証し、トークンに紐づく回答待ちの招待を取得する
+func (s *invitationService) loadInvitation(token string) (*entity.Invitation, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeOrgInvitation, token)
+	if err != nil {
+		return nil, ErrInvalidInvitationToken
+	}
+	invitation, err := s.invitationRepository.FindInvitation(claims.Ref)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get invitation")
+	}
+	// 取り消し・回答済みの招待や、送り直す前の古いリンクは受け付けない
+	if invitation == nil || invitation.OrgObjID().Value() != claims.ObjID || invitation.Status() != entity.InvitationPending {
+		return nil, ErrInvalidInvitationToken
+	}
+	return invitation, nil
+}
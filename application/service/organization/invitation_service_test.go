@@ -0,0 +1,277 @@
+package organization_test
+
+import (
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/organization"
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// inMemoryInvitationRepository はメモリ上で招待を保持するテスト用リポジトリ
+type inMemoryInvitationRepository struct {
+	items       []*entity.Invitation
+	responded   map[string]bool
+	memberships *inMemoryOrganizationRepository
+}
+
+func (r *inMemoryInvitationRepository) CreateInvitation(invitation *entity.Invitation) (*entity.Invitation, error) {
+	r.items = append(r.items, invitation)
+	return invitation, nil
+}
+
+func (r *inMemoryInvitationRepository) FindInvitation(id string) (*entity.Invitation, error) {
+	for _, invitation := range r.items {
+		if invitation.ID() == id {
+			return invitation, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryInvitationRepository) ListPendingInvitations(orgObjID string) ([]*entity.Invitation, error) {
+	result := []*entity.Invitation{}
+	for _, invitation := range r.items {
+		if invitation.OrgObjID().Value() == orgObjID && invitation.Status() == entity.InvitationPending {
+			result = append(result, invitation)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryInvitationRepository) UpdateInvitation(invitation *entity.Invitation) (*entity.Invitation, error) {
+	r.responded[invitation.ID()] = true
+	return invitation, nil
+}
+
+func (r *inMemoryInvitationRepository) AcceptInvitation(invitation *entity.Invitation, membership *entity.Membership) (bool, error) {
+	if r.responded[invitation.ID()] {
+		return false, nil
+	}
+	r.responded[invitation.ID()] = true
+	_, err := r.memberships.CreateMembership(membership)
+	return true, err
+}
+
+// tokenSentTo は指定アドレス宛ての最後のメール本文のリンクから token クエリを取り出す
+func tokenSentTo(outbox *mailer.MemoryOutbox, to string) string {
+	msg := outbox.LastTo(to)
+	if msg == nil {
+		return ""
+	}
+	u, err := url.Parse(regexp.MustCompile(`https?://\S+`).FindString(msg.Body))
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("token")
+}
+
+// --- テストスイート ---
+
+type InvitationServiceTestSuite struct {
+	suite.Suite
+	userRepo       *inMemoryUserRepository
+	orgRepo        *inMemoryOrganizationRepository
+	invitationRepo *inMemoryInvitationRepository
+	outbox         *mailer.MemoryOutbox
+	service        organization.InvitationService
+	owner          *userEntity.User
+	member         *userEntity.User
+	orgObjID       string
+}
+
+func TestInvitationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(InvitationServiceTestSuite))
+}
+
+func (suite *InvitationServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *InvitationServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+// SetupTest はオーナーとメンバーが所属する組織を用意する
+func (suite *InvitationServiceTestSuite) SetupTest() {
+	suite.userRepo = &inMemoryUserRepository{users: make(map[string]*userEntity.User)}
+	suite.orgRepo = &inMemoryOrganizationRepository{organizations: make(map[string]*entity.Organization)}
+	suite.invitationRepo = &inMemoryInvitationRepository{responded: make(map[string]bool), memberships: suite.orgRepo}
+	suite.outbox = mailer.NewMemoryOutbox()
+	suite.service = organization.NewInvitationService(suite.orgRepo, suite.invitationRepo, suite.userRepo, suite.outbox, utils.DefaultTokenSigner(), &organization.Config{
+		InvitationURL: "https://app.example.com/invitations",
+		InvitationTTL: 24 * time.Hour,
+		Locale:        mailer.LocaleJa,
+	})
+
+	suite.owner = newUser(&suite.Suite, suite.userRepo, "owner@example.com", "owner")
+	suite.member = newUser(&suite.Suite, suite.userRepo, "member@example.com", "member")
+	created, err := organization.NewOrganizationService(suite.orgRepo, suite.userRepo, utils.DefaultTokenSigner()).CreateOrganization(suite.owner.ObjID().Value(), "Acme", "acme")
+	suite.Require().NoError(err)
+	suite.orgObjID = created.Organization.ObjID().Value()
+
+	memberRole, err := value.NewMemberRole(value.MemberRoleMember)
+	suite.Require().NoError(err)
+	membership, err := entity.NewMembership(created.Organization.ObjID(), suite.member.ObjID(), memberRole)
+	suite.Require().NoError(err)
+	_, err = suite.orgRepo.CreateMembership(membership)
+	suite.Require().NoError(err)
+}
+
+// invite はオーナーとして invitee@example.com を招待し、メールで届いたトークンを返す
+func (suite *InvitationServiceTestSuite) invite(role string) string {
+	_, err := suite.service.Invite(suite.owner.ObjID().Value(), suite.orgObjID, "invitee@example.com", role)
+	suite.Require().NoError(err)
+	token := tokenSentTo(suite.outbox, "invitee@example.com")
+	suite.Require().NotEmpty(token, "招待メールにリンクが含まれること")
+	return token
+}
+
+func (suite *InvitationServiceTestSuite) TestInvite_SendsEmail() {
+	invitation, err := suite.service.Invite(suite.owner.ObjID().Value(), suite.orgObjID, "invitee@example.com", value.MemberRoleAdmin)
+	suite.Require().NoError(err)
+	suite.Equal(entity.InvitationPending, invitation.Status())
+
+	msg := suite.outbox.LastTo("invitee@example.com")
+	suite.Require().NotNil(msg)
+	suite.Contains(msg.Subject, "Acme")
+	suite.Contains(msg.Body, "owner")
+	suite.Contains(msg.Body, "https://app.example.com/invitations?token=")
+
+	invitations, err := suite.service.ListInvitations(suite.owner.ObjID().Value(), suite.orgObjID)
+	suite.NoError(err)
+	suite.Len(invitations, 1)
+}
+
+// 招待はオーナー・管理者のみ行え、オーナーとしての招待はオーナーのみ行えること
+func (suite *InvitationServiceTestSuite) TestInvite_Permissions() {
+	_, err := suite.service.Invite(suite.member.ObjID().Value(), suite.orgObjID, "invitee@example.com", value.MemberRoleMember)
+	suite.ErrorIs(err, organization.ErrForbidden)
+
+	_, err = suite.service.ListInvitations(suite.member.ObjID().Value(), suite.orgObjID)
+	suite.ErrorIs(err, organization.ErrForbidden)
+
+	orgObjID, _ := value.NewOrgObjID(suite.orgObjID)
+	adminRole, _ := value.NewMemberRole(value.MemberRoleAdmin)
+	admin := newUser(&suite.Suite, suite.userRepo, "admin@example.com", "admin")
+	membership, _ := entity.NewMembership(orgObjID, admin.ObjID(), adminRole)
+	_, err = suite.orgRepo.CreateMembership(membership)
+	suite.Require().NoError(err)
+
+	_, err = suite.service.Invite(admin.ObjID().Value(), suite.orgObjID, "invitee@example.com", value.MemberRoleOwner)
+	suite.ErrorIs(err, organization.ErrForbidden)
+	_, err = suite.service.Invite(admin.ObjID().Value(), suite.orgObjID, "invitee@example.com", value.MemberRoleMember)
+	suite.NoError(err)
+}
+
+func (suite *InvitationServiceTestSuite) TestInvite_Invalid() {
+	_, err := suite.service.Invite(suite.owner.ObjID().Value(), suite.orgObjID, "member@example.com", value.MemberRoleMember)
+	suite.ErrorIs(err, organization.ErrAlreadyMember)
+
+	_, err = suite.service.Invite(suite.owner.ObjID().Value(), suite.orgObjID, "not-an-email", value.MemberRoleMember)
+	suite.ErrorIs(err, organization.ErrInvalidEmail)
+
+	_, err = suite.service.Invite(suite.owner.ObjID().Value(), suite.orgObjID, "invitee@example.com", "superuser")
+	suite.ErrorIs(err, organization.ErrInvalidMemberRole)
+	suite.Empty(suite.outbox.Messages())
+}
+
+// 同じアドレスを招待し直すと、古い招待のリンクは使えなくなること
+func (suite *InvitationServiceTestSuite) TestInvite_ResendRevokesPrevious() {
+	oldToken := suite.invite(value.MemberRoleMember)
+	newToken := suite.invite(value.MemberRoleAdmin)
+	suite.NotEqual(oldToken, newToken)
+
+	invitations, err := suite.service.ListInvitations(suite.owner.ObjID().Value(), suite.orgObjID)
+	suite.NoError(err)
+	suite.Require().Len(invitations, 1)
+	suite.Equal(value.MemberRoleAdmin, invitations[0].Role().Value())
+
+	suite.ErrorIs(suite.service.DeclineInvitation(oldToken), organization.ErrInvalidInvitationToken)
+	suite.NoError(suite.service.DeclineInvitation(newToken))
+}
+
+// 招待されたアドレスのアカウントで承諾すると、招待されたロールで組織に参加すること
+func (suite *InvitationServiceTestSuite) TestAcceptInvitation() {
+	token := suite.invite(value.MemberRoleAdmin)
+	invitee := newUser(&suite.Suite, suite.userRepo, "invitee@example.com", "invitee")
+
+	_, err := suite.service.AcceptInvitation(suite.member.ObjID().Value(), token)
+	suite.ErrorIs(err, organization.ErrInvitationEmailMismatch, "別のアドレスのアカウントでは承諾できない")
+
+	joined, err := suite.service.AcceptInvitation(invitee.ObjID().Value(), token)
+	suite.Require().NoError(err)
+	suite.Equal(suite.orgObjID, joined.Organization.ObjID().Value())
+	suite.Equal(value.MemberRoleAdmin, joined.Membership.Role().Value())
+
+	membership, err := suite.orgRepo.FindMembership(suite.orgObjID, invitee.ObjID().Value())
+	suite.NoError(err)
+	suite.NotNil(membership)
+
+	// 同じ招待は二度使えないこと
+	_, err = suite.service.AcceptInvitation(invitee.ObjID().Value(), token)
+	suite.ErrorIs(err, organization.ErrInvalidInvitationToken)
+}
+
+// 確認後に別のリクエストで回答済みになった招待では、メンバーを追加せずに失敗すること
+func (suite *InvitationServiceTestSuite) TestAcceptInvitation_RespondedConcurrently() {
+	token := suite.invite(value.MemberRoleMember)
+	invitee := newUser(&suite.Suite, suite.userRepo, "invitee@example.com", "invitee")
+	invitations, err := suite.service.ListInvitations(suite.owner.ObjID().Value(), suite.orgObjID)
+	suite.Require().NoError(err)
+	suite.invitationRepo.responded[invitations[0].ID()] = true
+
+	_, err = suite.service.AcceptInvitation(invitee.ObjID().Value(), token)
+	suite.ErrorIs(err, organization.ErrInvalidInvitationToken)
+
+	membership, err := suite.orgRepo.FindMembership(suite.orgObjID, invitee.ObjID().Value())
+	suite.NoError(err)
+	suite.Nil(membership, "メンバーが追加されないこと")
+}
+
+// メールアドレスを確認していないアカウントでは承諾できないこと
+func (suite *InvitationServiceTestSuite) TestAcceptInvitation_EmailNotVerified() {
+	token := suite.invite(value.MemberRoleMember)
+	email, _ := userValue.NewUserEmail("invitee@example.com")
+	username, _ := userValue.NewUserUsername("invitee")
+	invitee, err := userEntity.NewUser(email, userValue.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	_, _ = suite.userRepo.CreateUser(invitee)
+
+	_, err = suite.service.AcceptInvitation(invitee.ObjID().Value(), token)
+	suite.ErrorIs(err, organization.ErrEmailNotVerified)
+}
+
+// 取り消した招待・辞退した招待・不正なトークンでは承諾できないこと
+func (suite *InvitationServiceTestSuite) TestAcceptInvitation_Invalid() {
+	invitee := newUser(&suite.Suite, suite.userRepo, "invitee@example.com", "invitee")
+
+	token := suite.invite(value.MemberRoleMember)
+	invitations, err := suite.service.ListInvitations(suite.owner.ObjID().Value(), suite.orgObjID)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.service.RevokeInvitation(suite.owner.ObjID().Value(), suite.orgObjID, invitations[0].ID()))
+	_, err = suite.service.AcceptInvitation(invitee.ObjID().Value(), token)
+	suite.ErrorIs(err, organization.ErrInvalidInvitationToken)
+	suite.ErrorIs(suite.service.RevokeInvitation(suite.owner.ObjID().Value(), suite.orgObjID, invitations[0].ID()), organization.ErrInvitationNotFound)
+
+	token = suite.invite(value.MemberRoleMember)
+	suite.NoError(suite.service.DeclineInvitation(token))
+	_, err = suite.service.AcceptInvitation(invitee.ObjID().Value(), token)
+	suite.ErrorIs(err, organization.ErrInvalidInvitationToken)
+
+	_, err = suite.service.AcceptInvitation(invitee.ObjID().Value(), "invalid-token")
+	suite.ErrorIs(err, organization.ErrInvalidInvitationToken)
+	suite.ErrorIs(suite.service.DeclineInvitation("invalid-token"), organization.ErrInvalidInvitationToken)
+}
@@ -0,0 +1,312 @@
+package organization
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	userRepository "github.com/goda6565/nexus-user-auth/domain/user/repository"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrOrganizationNotFound = errs.NewServiceError("organization not found")
+	ErrInvalidOrganization  = errs.NewServiceError("invalid organization")
+	ErrSlugAlreadyInUse     = errs.NewServiceError("organization slug already in use")
+	ErrMemberNotFound       = errs.NewServiceError("member not found")
+	ErrInvalidMemberRole    = errs.NewServiceError("invalid member role")
+	ErrForbidden            = errs.NewServiceError("operation not permitted for your role in the organization")
+	ErrLastOwner            = errs.NewServiceError("organization must have at least one owner")
+)
+
+// Member は、組織のメンバーシップとメンバーのユーザー
+type Member struct {
+	Membership *entity.Membership
+	User       *userEntity.User
+}
+
+type OrganizationService interface {
+	// CreateOrganization: 組織を作成し、作成したユーザーをオーナーにする
+	CreateOrganization(userObjID string, name string, slug string) (*repository.UserOrganization, error)
+	// ListOrganizations: ユーザーが所属する組織の一覧
+	ListOrganizations(userObjID string) ([]*repository.UserOrganization, error)
+	// GetOrganization: ユーザーが所属する組織を取得（所属していない組織は見つからないものとして扱う）
+	GetOrganization(userObjID string, orgObjID string) (*repository.UserOrganization, error)
+	// UpdateOrganization: 組織名を変更（オーナー・管理者のみ）
+	UpdateOrganization(userObjID string, orgObjID string, name string) (*repository.UserOrganization, error)
+	// DeleteOrganization: 組織を削除（オーナーのみ）
+	DeleteOrganization(userObjID string, orgObjID string) error
+	// ListMembers: 組織のメンバーの一覧
+	ListMembers(userObjID string, orgObjID string) ([]*Member, error)
+	// ChangeMemberRole: メンバーのロールを変更（オーナー・管理者のみ。オーナーの任命・解任はオーナーのみ）
+	ChangeMemberRole(userObjID string, orgObjID string, memberObjID string, role string) (*Member, error)
+	// RemoveMember: メンバーを組織から外す（オーナー・管理者のみ。自分自身は誰でも脱退できる）
+	RemoveMember(userObjID string, orgObjID string, memberObjID string) error
+	// SwitchOrganization: 組織に切り替えたトークン（org_id クレームを含む）を発行。orgObjID が空の場合は個人のトークンを発行
+	SwitchOrganization(userObjID string, orgObjID string, auth utils.AuthContext) (accessToken string, refreshToken string, err error)
+}
+
+// organizationService は OrganizationService の実装
+type organizationService struct {
+	organizationRepository repository.OrganizationRepository
+	userRepository         userRepository.UserRepository
+	tokens                 *utils.TokenSigner
+}
+
+// NewOrganizationService は OrganizationService のインスタンスを作成
+func NewOrganizationService(organizationRepository repository.OrganizationRepository, userRepository userRepository.UserRepository, tokens *utils.TokenSigner) OrganizationService {
+	return &organizationService{
+		organizationRepository: organizationRepository,
+		userRepository:         userRepository,
+		tokens:                 tokens,
+	}
+}
+
+// CreateOrganization は組織を作成する（スラッグは組織全体で一意）
+func (s *organizationService) CreateOrganization(userObjID string, name string, slug string) (*repository.UserOrganization, error) {
+	ownerObjID, err := userValue.NewUserObjID(userObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("invalid user id")
+	}
+	orgName, err := value.NewOrgName(name)
+	if err != nil {
+		return nil, ErrInvalidOrganization
+	}
+	orgSlug, err := value.NewOrgSlug(slug)
+	if err != nil {
+		return nil, ErrInvalidOrganization
+	}
+	existing, err := s.organizationRepository.FindOrganizationBySlug(orgSlug.Value())
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get organization")
+	}
+	if existing != nil {
+		return nil, ErrSlugAlreadyInUse
+	}
+
+	organization, err := entity.NewOrganization(orgName, orgSlug)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create organization")
+	}
+	ownerRole, err := value.NewMemberRole(value.MemberRoleOwner)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create member role")
+	}
+	owner, err := entity.NewMembership(organization.ObjID(), ownerObjID, ownerRole)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create membership")
+	}
+	if _, err := s.organizationRepository.CreateOrganization(organization, owner); err != nil {
+		return nil, errs.NewServiceError("failed to create organization in repository")
+	}
+	return &repository.UserOrganization{Organization: organization, Membership: owner}, nil
+}
+
+func (s *organizationService) ListOrganizations(userObjID string) ([]*repository.UserOrganization, error) {
+	organizations, err := s.organizationRepository.ListUserOrganizations(userObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to list organizations")
+	}
+	return organizations, nil
+}
+
+func (s *organizationService) GetOrganization(userObjID string, orgObjID string) (*repository.UserOrganization, error) {
+	return s.load(userObjID, orgObjID)
+}
+
+func (s *organizationService) UpdateOrganization(userObjID string, orgObjID string, name string) (*repository.UserOrganization, error) {
+	current, err := s.load(userObjID, orgObjID)
+	if err != nil {
+		return nil, err
+	}
+	if !current.Membership.Role().CanManage() {
+		return nil, ErrForbidden
+	}
+	orgName, err := value.NewOrgName(name)
+	if err != nil {
+		return nil, ErrInvalidOrganization
+	}
+	if err := current.Organization.Rename(orgName); err != nil {
+		return nil, ErrInvalidOrganization
+	}
+	if _, err := s.organizationRepository.UpdateOrganization(current.Organization); err != nil {
+		return nil, errs.NewServiceError("failed to update organization in repository")
+	}
+	return current, nil
+}
+
+func (s *organizationService) DeleteOrganization(userObjID string, orgObjID string) error {
+	current, err := s.load(userObjID, orgObjID)
+	if err != nil {
+		return err
+	}
+	if !current.Membership.Role().IsOwner() {
+		return ErrForbidden
+	}
+	if err := s.organizationRepository.DeleteOrganization(orgObjID); err != nil {
+		return errs.NewServiceError("failed to delete organization in repository")
+	}
+	return nil
+}
+
+func (s *organizationService) ListMembers(userObjID string, orgObjID string) ([]*Member, error) {
+	if _, err := s.load(userObjID, orgObjID); err != nil {
+		return nil, err
+	}
+	memberships, err := s.organizationRepository.ListMemberships(orgObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to list memberships")
+	}
+	members := make([]*Member, 0, len(memberships))
+	for _, membership := range memberships {
+		user, err := s.userRepository.GetUserByObjID(membership.UserObjID().Value())
+		if err != nil {
+			// 削除されたユーザーのメンバーシップは一覧に含めない
+			continue
+		}
+		members = append(members, &Member{Membership: membership, User: user})
+	}
+	return members, nil
+}
+
+// ChangeMemberRole はメンバーのロールを変更する
+// オーナーの任命・解任はオーナーのみが行え、最後のオーナーは解任できない
+func (s *organizationService) ChangeMemberRole(userObjID string, orgObjID string, memberObjID string, role string) (*Member, error) {
+	current, err := s.load(userObjID, orgObjID)
+	if err != nil {
+		return nil, err
+	}
+	if !current.Membership.Role().CanManage() {
+		return nil, ErrForbidden
+	}
+	newRole, err := value.NewMemberRole(role)
+	if err != nil {
+		return nil, ErrInvalidMemberRole
+	}
+	target, err := s.findMember(orgObjID, memberObjID)
+	if err != nil {
+		return nil, err
+	}
+	if (newRole.IsOwner() || target.Role().IsOwner()) && !current.Membership.Role().IsOwner() {
+		return nil, ErrForbidden
+	}
+	if target.Role().IsOwner() && !newRole.IsOwner() {
+		if err := s.checkNotLastOwner(orgObjID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := target.ChangeRole(newRole); err != nil {
+		return nil, ErrInvalidMemberRole
+	}
+	if _, err := s.organizationRepository.UpdateMembership(target); err != nil {
+		return nil, errs.NewServiceError("failed to update membership in repository")
+	}
+	user, err := s.userRepository.GetUserByObjID(memberObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get user")
+	}
+	return &Member{Membership: target, User: user}, nil
+}
+
+// RemoveMember はメンバーを組織から外す
+// オーナーを外せるのはオーナーのみで、最後のオーナーは外せない（脱退もできない）
+func (s *organizationService) RemoveMember(userObjID string, orgObjID string, memberObjID string) error {
+	current, err := s.load(userObjID, orgObjID)
+	if err != nil {
+		return err
+	}
+	target := current.Membership
+	if memberObjID != userObjID {
+		if !current.Membership.Role().CanManage() {
+			return ErrForbidden
+		}
+		if target, err = s.findMember(orgObjID, memberObjID); err != nil {
+			return err
+		}
+		if target.Role().IsOwner() && !current.Membership.Role().IsOwner() {
+			return ErrForbidden
+		}
+	}
+	if target.Role().IsOwner() {
+		if err := s.checkNotLastOwner(orgObjID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.organizationRepository.DeleteMembership(orgObjID, memberObjID); err != nil {
+		return errs.NewServiceError("failed to delete membership in repository")
+	}
+	return nil
+}
+
+// SwitchOrganization は所属を確認したうえで、認証の情報を引き継いで組織に切り替えたトークンを発行する
+func (s *organizationService) SwitchOrganization(userObjID string, orgObjID string, auth utils.AuthContext) (string, string, error) {
+	if orgObjID != "" {
+		if _, err := s.load(userObjID, orgObjID); err != nil {
+			return "", "", err
+		}
+	}
+	accessToken, refreshToken, err := s.tokens.GenerateOrgTokens(userObjID, auth, orgObjID)
+	if err != nil {
+		return "", "", errs.NewServiceError("failed to generate tokens")
+	}
+	return accessToken, refreshToken, nil
+}
+
+// load はユーザーが所属する組織と、ユーザーのメンバーシップを取得する
+func (s *organizationService) load(userObjID string, orgObjID string) (*repository.UserOrganization, error) {
+	return findUserOrganization(s.organizationRepository, userObjID, orgObjID)
+}
+
+// findMember は組織のメンバーのメンバーシップを取得する
+func (s *organizationService) findMember(orgObjID string, memberObjID string) (*entity.Membership, error) {
+	membership, err := s.organizationRepository.FindMembership(orgObjID, memberObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get membership")
+	}
+	if membership == nil {
+		return nil, ErrMemberNotFound
+	}
+	return membership, nil
+}
+
+// checkNotLastOwner は組織にオーナーが他にもいることを確認する
+func (s *organizationService) checkNotLastOwner(orgObjID string) error {
+	memberships, err := s.organizationRepository.ListMemberships(orgObjID)
+	if err != nil {
+		return errs.NewServiceError("failed to list memberships")
+	}
+	owners := 0
+	for _, membership := range memberships {
+		if membership.Role().IsOwner() {
+			owners++
+		}
+	}
+	if owners <= 1 {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// findUserOrganization はユーザーが所属する組織と、ユーザーのメンバーシップを取得する
+func findUserOrganization(organizationRepository repository.OrganizationRepository, userObjID string, orgObjID string) (*repository.UserOrganization, error) {
+	organization, err := organizationRepository.FindOrganization(orgObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get organization")
+	}
+	if organization == nil {
+		return nil, ErrOrganizationNotFound
+	}
+	membership, err := organizationRepository.FindMembership(orgObjID, userObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get membership")
+	}
+	if membership == nil {
+		// 所属していない組織の存在は明かさない
+		return nil, ErrOrganizationNotFound
+	}
+	return &repository.UserOrganization{Organization: organization, Membership: membership}, nil
+}
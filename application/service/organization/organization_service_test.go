@@ -0,0 +1,362 @@
+package organization_test
+
+import (
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/organization"
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	userRepository "github.com/goda6565/nexus-user-auth/domain/user/repository"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- テスト用リポジトリ ---
+
+// inMemoryUserRepository はメモリ上でユーザーを保持するテスト用リポジトリ
+type inMemoryUserRepository struct {
+	users map[string]*userEntity.User
+}
+
+func (r *inMemoryUserRepository) CreateUser(user *userEntity.User) (*userEntity.User, error) {
+	r.users[user.ObjID().Value()] = user
+	return user, nil
+}
+
+func (r *inMemoryUserRepository) GetUserByEmail(email string) (*userEntity.User, error) {
+	for _, user := range r.users {
+		if user.Email().Value() == email {
+			return user, nil
+		}
+	}
+	return nil, errs.NewInfraError("not found")
+}
+
+func (r *inMemoryUserRepository) GetUserByObjID(objID string) (*userEntity.User, error) {
+	user, ok := r.users[objID]
+	if !ok {
+		return nil, errs.NewInfraError("not found")
+	}
+	return user, nil
+}
+
+func (r *inMemoryUserRepository) ListUsers(filter userRepository.UserListFilter, limit int) ([]*userEntity.User, error) {
+	return nil, nil
+}
+
+func (r *inMemoryUserRepository) UpdateUser(user *userEntity.User) (*userEntity.User, error) {
+	r.users[user.ObjID().Value()] = user
+	return user, nil
+}
+
+func (r *inMemoryUserRepository) DeleteUser(objID string) error {
+	delete(r.users, objID)
+	return nil
+}
+
+// inMemoryOrganizationRepository はメモリ上で組織とメンバーシップを保持するテスト用リポジトリ
+type inMemoryOrganizationRepository struct {
+	organizations map[string]*entity.Organization
+	memberships   []*entity.Membership
+}
+
+func (r *inMemoryOrganizationRepository) CreateOrganization(organization *entity.Organization, owner *entity.Membership) (*entity.Organization, error) {
+	r.organizations[organization.ObjID().Value()] = organization
+	r.memberships = append(r.memberships, owner)
+	return organization, nil
+}
+
+func (r *inMemoryOrganizationRepository) FindOrganization(orgObjID string) (*entity.Organization, error) {
+	return r.organizations[orgObjID], nil
+}
+
+func (r *inMemoryOrganizationRepository) FindOrganizationBySlug(slug string) (*entity.Organization, error) {
+	for _, organization := range r.organizations {
+		if organization.Slug().Value() == slug {
+			return organization, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryOrganizationRepository) UpdateOrganization(organization *entity.Organization) (*entity.Organization, error) {
+	r.organizations[organization.ObjID().Value()] = organization
+	return organization, nil
+}
+
+func (r *inMemoryOrganizationRepository) DeleteOrganization(orgObjID string) error {
+	delete(r.organizations, orgObjID)
+	memberships := r.memberships[:0]
+	for _, membership := range r.memberships {
+		if membership.OrgObjID().Value() != orgObjID {
+			memberships = append(memberships, membership)
+		}
+	}
+	r.memberships = memberships
+	return nil
+}
+
+func (r *inMemoryOrganizationRepository) ListUserOrganizations(userObjID string) ([]*repository.UserOrganization, error) {
+	result := []*repository.UserOrganization{}
+	for _, membership := range r.memberships {
+		if membership.UserObjID().Value() == userObjID {
+			result = append(result, &repository.UserOrganization{Organization: r.organizations[membership.OrgObjID().Value()], Membership: membership})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Organization.Name().Value() < result[j].Organization.Name().Value()
+	})
+	return result, nil
+}
+
+func (r *inMemoryOrganizationRepository) FindMembership(orgObjID string, userObjID string) (*entity.Membership, error) {
+	for _, membership := range r.memberships {
+		if membership.OrgObjID().Value() == orgObjID && membership.UserObjID().Value() == userObjID {
+			return membership, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryOrganizationRepository) ListMemberships(orgObjID string) ([]*entity.Membership, error) {
+	result := []*entity.Membership{}
+	for _, membership := range r.memberships {
+		if membership.OrgObjID().Value() == orgObjID {
+			result = append(result, membership)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryOrganizationRepository) CreateMembership(membership *entity.Membership) (*entity.Membership, error) {
+	r.memberships = append(r.memberships, membership)
+	return membership, nil
+}
+
+func (r *inMemoryOrganizationRepository) UpdateMembership(membership *entity.Membership) (*entity.Membership, error) {
+	return membership, nil
+}
+
+func (r *inMemoryOrganizationRepository) DeleteMembership(orgObjID string, userObjID string) error {
+	memberships := r.memberships[:0]
+	for _, membership := range r.memberships {
+		if membership.OrgObjID().Value() != orgObjID || membership.UserObjID().Value() != userObjID {
+			memberships = append(memberships, membership)
+		}
+	}
+	r.memberships = memberships
+	return nil
+}
+
+// newUser はメールアドレスを確認済みのテスト用ユーザーを作成して保存する
+func newUser(s *suite.Suite, users *inMemoryUserRepository, address string, username string) *userEntity.User {
+	email, err := userValue.NewUserEmail(address)
+	s.Require().NoError(err)
+	name, err := userValue.NewUserUsername(username)
+	s.Require().NoError(err)
+	user, err := userEntity.NewUser(email, userValue.FromHashed("hashed"), name)
+	s.Require().NoError(err)
+	verifiedAt, err := timeobj.NewTimeObj(time.Now().Add(-time.Minute))
+	s.Require().NoError(err)
+	s.Require().NoError(user.VerifyEmail(verifiedAt))
+	_, err = users.CreateUser(user)
+	s.Require().NoError(err)
+	return user
+}
+
+// --- テストスイート ---
+
+type OrganizationServiceTestSuite struct {
+	suite.Suite
+	userRepo *inMemoryUserRepository
+	orgRepo  *inMemoryOrganizationRepository
+	service  organization.OrganizationService
+	owner    *userEntity.User
+	admin    *userEntity.User
+	member   *userEntity.User
+	orgObjID string
+}
+
+func TestOrganizationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationServiceTestSuite))
+}
+
+func (suite *OrganizationServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *OrganizationServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+// SetupTest はオーナー・管理者・メンバーが所属する組織を用意する
+func (suite *OrganizationServiceTestSuite) SetupTest() {
+	suite.userRepo = &inMemoryUserRepository{users: make(map[string]*userEntity.User)}
+	suite.orgRepo = &inMemoryOrganizationRepository{organizations: make(map[string]*entity.Organization)}
+	suite.service = organization.NewOrganizationService(suite.orgRepo, suite.userRepo, utils.DefaultTokenSigner())
+
+	suite.owner = newUser(&suite.Suite, suite.userRepo, "owner@example.com", "owner")
+	suite.admin = newUser(&suite.Suite, suite.userRepo, "admin@example.com", "admin")
+	suite.member = newUser(&suite.Suite, suite.userRepo, "member@example.com", "member")
+
+	created, err := suite.service.CreateOrganization(suite.owner.ObjID().Value(), "Acme", "acme")
+	suite.Require().NoError(err)
+	suite.orgObjID = created.Organization.ObjID().Value()
+	suite.addMember(suite.admin, value.MemberRoleAdmin)
+	suite.addMember(suite.member, value.MemberRoleMember)
+}
+
+// addMember はユーザーを指定のロールで組織に追加する
+func (suite *OrganizationServiceTestSuite) addMember(user *userEntity.User, role string) {
+	orgObjID, err := value.NewOrgObjID(suite.orgObjID)
+	suite.Require().NoError(err)
+	memberRole, err := value.NewMemberRole(role)
+	suite.Require().NoError(err)
+	membership, err := entity.NewMembership(orgObjID, user.ObjID(), memberRole)
+	suite.Require().NoError(err)
+	_, err = suite.orgRepo.CreateMembership(membership)
+	suite.Require().NoError(err)
+}
+
+// 作成したユーザーがオーナーになり、同じスラッグの組織は作成できないこと
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization() {
+	created, err := suite.service.CreateOrganization(suite.member.ObjID().Value(), "  Beta  ", "beta")
+	suite.Require().NoError(err)
+	suite.Equal("Beta", created.Organization.Name().Value())
+	suite.True(created.Membership.Role().IsOwner())
+
+	_, err = suite.service.CreateOrganization(suite.member.ObjID().Value(), "Other", "beta")
+	suite.ErrorIs(err, organization.ErrSlugAlreadyInUse)
+
+	_, err = suite.service.CreateOrganization(suite.member.ObjID().Value(), "Invalid", "Invalid Slug")
+	suite.ErrorIs(err, organization.ErrInvalidOrganization)
+	_, err = suite.service.CreateOrganization(suite.member.ObjID().Value(), " ", "blank-name")
+	suite.ErrorIs(err, organization.ErrInvalidOrganization)
+
+	organizations, err := suite.service.ListOrganizations(suite.member.ObjID().Value())
+	suite.NoError(err)
+	suite.Len(organizations, 2)
+}
+
+// 所属していない組織は存在しないものとして扱うこと
+func (suite *OrganizationServiceTestSuite) TestGetOrganization_NotMember() {
+	outsider := newUser(&suite.Suite, suite.userRepo, "outsider@example.com", "outsider")
+
+	_, err := suite.service.GetOrganization(outsider.ObjID().Value(), suite.orgObjID)
+	suite.ErrorIs(err, organization.ErrOrganizationNotFound)
+
+	found, err := suite.service.GetOrganization(suite.member.ObjID().Value(), suite.orgObjID)
+	suite.NoError(err)
+	suite.Equal(value.MemberRoleMember, found.Membership.Role().Value())
+}
+
+// 組織名の変更はオーナー・管理者のみ、削除はオーナーのみ行えること
+func (suite *OrganizationServiceTestSuite) TestUpdateAndDeleteOrganization() {
+	_, err := suite.service.UpdateOrganization(suite.member.ObjID().Value(), suite.orgObjID, "Renamed")
+	suite.ErrorIs(err, organization.ErrForbidden)
+
+	updated, err := suite.service.UpdateOrganization(suite.admin.ObjID().Value(), suite.orgObjID, "Renamed")
+	suite.NoError(err)
+	suite.Equal("Renamed", updated.Organization.Name().Value())
+
+	suite.ErrorIs(suite.service.DeleteOrganization(suite.admin.ObjID().Value(), suite.orgObjID), organization.ErrForbidden)
+	suite.NoError(suite.service.DeleteOrganization(suite.owner.ObjID().Value(), suite.orgObjID))
+
+	_, err = suite.service.GetOrganization(suite.owner.ObjID().Value(), suite.orgObjID)
+	suite.ErrorIs(err, organization.ErrOrganizationNotFound)
+}
+
+func (suite *OrganizationServiceTestSuite) TestListMembers() {
+	members, err := suite.service.ListMembers(suite.member.ObjID().Value(), suite.orgObjID)
+	suite.NoError(err)
+	suite.Require().Len(members, 3)
+	suite.Equal("owner@example.com", members[0].User.Email().Value())
+	suite.True(members[0].Membership.Role().IsOwner())
+}
+
+// オーナーの任命・解任はオーナーのみが行え、最後のオーナーは解任できないこと
+func (suite *OrganizationServiceTestSuite) TestChangeMemberRole() {
+	_, err := suite.service.ChangeMemberRole(suite.member.ObjID().Value(), suite.orgObjID, suite.admin.ObjID().Value(), value.MemberRoleMember)
+	suite.ErrorIs(err, organization.ErrForbidden, "メンバーはロールを変更できない")
+
+	_, err = suite.service.ChangeMemberRole(suite.admin.ObjID().Value(), suite.orgObjID, suite.member.ObjID().Value(), value.MemberRoleOwner)
+	suite.ErrorIs(err, organization.ErrForbidden, "管理者はオーナーを任命できない")
+
+	_, err = suite.service.ChangeMemberRole(suite.admin.ObjID().Value(), suite.orgObjID, suite.owner.ObjID().Value(), value.MemberRoleMember)
+	suite.ErrorIs(err, organization.ErrForbidden, "管理者はオーナーを解任できない")
+
+	_, err = suite.service.ChangeMemberRole(suite.owner.ObjID().Value(), suite.orgObjID, suite.owner.ObjID().Value(), value.MemberRoleAdmin)
+	suite.ErrorIs(err, organization.ErrLastOwner)
+
+	_, err = suite.service.ChangeMemberRole(suite.owner.ObjID().Value(), suite.orgObjID, suite.member.ObjID().Value(), "superuser")
+	suite.ErrorIs(err, organization.ErrInvalidMemberRole)
+
+	_, err = suite.service.ChangeMemberRole(suite.owner.ObjID().Value(), suite.orgObjID, "00000000-0000-0000-0000-000000000000", value.MemberRoleAdmin)
+	suite.ErrorIs(err, organization.ErrMemberNotFound)
+
+	changed, err := suite.service.ChangeMemberRole(suite.admin.ObjID().Value(), suite.orgObjID, suite.member.ObjID().Value(), value.MemberRoleAdmin)
+	suite.NoError(err)
+	suite.Equal(value.MemberRoleAdmin, changed.Membership.Role().Value())
+	suite.Equal("member", changed.User.Username().Value())
+
+	// オーナーが2人いれば、一方は解任できること
+	_, err = suite.service.ChangeMemberRole(suite.owner.ObjID().Value(), suite.orgObjID, suite.admin.ObjID().Value(), value.MemberRoleOwner)
+	suite.NoError(err)
+	_, err = suite.service.ChangeMemberRole(suite.admin.ObjID().Value(), suite.orgObjID, suite.owner.ObjID().Value(), value.MemberRoleMember)
+	suite.NoError(err)
+}
+
+// メンバーの削除はオーナー・管理者のみ行え、自分自身は脱退できること（最後のオーナーを除く）
+func (suite *OrganizationServiceTestSuite) TestRemoveMember() {
+	err := suite.service.RemoveMember(suite.member.ObjID().Value(), suite.orgObjID, suite.admin.ObjID().Value())
+	suite.ErrorIs(err, organization.ErrForbidden)
+
+	err = suite.service.RemoveMember(suite.admin.ObjID().Value(), suite.orgObjID, suite.owner.ObjID().Value())
+	suite.ErrorIs(err, organization.ErrForbidden)
+
+	err = suite.service.RemoveMember(suite.owner.ObjID().Value(), suite.orgObjID, suite.owner.ObjID().Value())
+	suite.ErrorIs(err, organization.ErrLastOwner)
+
+	suite.NoError(suite.service.RemoveMember(suite.admin.ObjID().Value(), suite.orgObjID, suite.member.ObjID().Value()))
+	suite.NoError(suite.service.RemoveMember(suite.admin.ObjID().Value(), suite.orgObjID, suite.admin.ObjID().Value()), "自分自身は脱退できる")
+
+	members, err := suite.service.ListMembers(suite.owner.ObjID().Value(), suite.orgObjID)
+	suite.NoError(err)
+	suite.Len(members, 1)
+}
+
+// 所属する組織に切り替えたトークンには org_id が含まれ、認証の情報が引き継がれること
+func (suite *OrganizationServiceTestSuite) TestSwitchOrganization() {
+	auth := utils.NewAuthContext(utils.AMRPassword, utils.AMROTP)
+	accessToken, refreshToken, err := suite.service.SwitchOrganization(suite.member.ObjID().Value(), suite.orgObjID, auth)
+	suite.Require().NoError(err)
+	suite.NotEmpty(refreshToken)
+
+	claims, err := utils.ValidateToken(accessToken)
+	suite.Require().NoError(err)
+	suite.Equal(suite.orgObjID, claims.OrgID)
+	suite.Equal(suite.member.ObjID().Value(), claims.ObjID)
+	suite.Equal(utils.ACRMultiFactor, claims.ACR)
+
+	// 組織を指定しない場合は個人のトークンに戻る
+	accessToken, _, err = suite.service.SwitchOrganization(suite.member.ObjID().Value(), "", auth)
+	suite.Require().NoError(err)
+	claims, err = utils.ValidateToken(accessToken)
+	suite.Require().NoError(err)
+	suite.Empty(claims.OrgID)
+
+	outsider := newUser(&suite.Suite, suite.userRepo, "outsider@example.com", "outsider")
+	_, _, err = suite.service.SwitchOrganization(outsider.ObjID().Value(), suite.orgObjID, auth)
+	suite.ErrorIs(err, organization.ErrOrganizationNotFound)
+}
@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	DefaultLimit int // ユーザーの一覧の 1 ページあたりの件数（指定されなかった場合）
+	MaxLimit     int // ユーザーの一覧の 1 ページあたりの件数の上限
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		DefaultLimit: utils.GetEnvInt("ADMIN_USER_LIST_LIMIT", 20),
+		MaxLimit:     utils.GetEnvInt("ADMIN_USER_LIST_MAX_LIMIT", 100),
+	}
+}
@@ -0,0 +1,530 @@
+package admin
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/audit"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/application/service/user/role"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+var (
+	ErrUserNotFound         = errs.NewServiceError("user not found")
+	ErrInvalidUserQuery     = errs.NewServiceError("invalid user query")
+	ErrInvalidCursor        = errs.NewServiceError("invalid cursor")
+	ErrInvalidUser          = errs.NewServiceError("invalid email or username")
+	ErrInvalidRole          = errs.NewServiceError("invalid role")
+	ErrEmailAlreadyInUse    = errs.NewServiceError("email already in use")
+	ErrInvalidSuspendReason = errs.NewServiceError(fmt.Sprintf("reason is required and must be at most %d characters", entity.UserStatusReasonMaxLength))
+	ErrInvalidStatusChange  = errs.NewServiceError("user status cannot be changed from the current status")
+	ErrCannotManageSelf     = errs.NewServiceError("admins cannot change the roles or status of their own account or delete it")
+	ErrCannotGrantRole      = errs.NewServiceError("cannot grant or revoke roles with permissions the actor does not hold")
+	ErrCannotManageUser     = errs.NewServiceError("cannot change the status of, log out or delete users with permissions the actor does not hold")
+	ErrAuditLogFailed       = audit.ErrAuditLogFailed
+)
+
+// temporaryPasswordLength は作成したユーザーの一時的なパスワードの文字数
+const temporaryPasswordLength = 16
+
+// temporaryPasswordAlphabet は一時的なパスワードに使う文字（読み間違えやすい文字を除く）
+const temporaryPasswordAlphabet = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// UserQuery はユーザーの一覧の検索条件（空・nil の項目では絞り込まない）
+type UserQuery struct {
+	Email       string
+	Role        string
+	Status      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Cursor      string // 前のページの NextCursor
+	Limit       int    // 0 の場合は既定の件数
+}
+
+// UserPage はユーザーの一覧の 1 ページ
+type UserPage struct {
+	Users      []*entity.User
+	NextCursor string // 次のページのカーソル（最後のページの場合は空）
+}
+
+// CreatedUser は管理者が作成したユーザーと一時的なパスワード
+type CreatedUser struct {
+	User              *entity.User
+	TemporaryPassword string
+}
+
+// PermissionResolver はユーザーが持つ権限を解決する（role.UserRoleService が満たす）
+type PermissionResolver interface {
+	GetPermissions(userObjID string) (*role.UserPermissions, error)
+}
+
+type UserAdminService interface {
+	// ListUsers: 条件に一致するユーザーを作成日時の新しい順に取得する
+	ListUsers(actorObjID string, query UserQuery) (*UserPage, error)
+	// GetUser: ユーザーを取得する
+	GetUser(actorObjID string, objID string) (*entity.User, error)
+	// CreateUser: 一時的なパスワードを設定した有効なユーザーを作成する（roles が空の場合は一般ユーザー）
+	// 登録を許可しないドメインのメールアドレスは registration.ErrEmailDomainNotAllowed を返す
+	// 管理者自身が持たない権限を含むロールは付与できない
+	CreateUser(actorObjID string, email string, username string, roles []string) (*CreatedUser, error)
+	// UpdateRoles: ユーザーのロールを指定したロールに置き換える
+	// 管理者自身が持たない権限を含むロールは付与・剥奪できない
+	UpdateRoles(actorObjID string, objID string, roles []string) (*entity.User, error)
+	// SuspendUser: ユーザーのアカウントを停止する（理由は必須）
+	// 停止・停止の解除・強制ログアウト・削除は、管理者自身が持たない権限を持つユーザーには行えない
+	SuspendUser(actorObjID string, objID string, reason string) (*entity.User, error)
+	// UnsuspendUser: 停止したアカウントを有効に戻す
+	UnsuspendUser(actorObjID string, objID string) (*entity.User, error)
+	// LogoutUser: ユーザーに発行済みのトークンをすべて無効にする
+	LogoutUser(actorObjID string, objID string) error
+	// DeleteUser: ユーザーを削除する
+	DeleteUser(actorObjID string, objID string) error
+}
+
+// userAdminService は UserAdminService の実装
+// 操作はすべて監査ログに記録する。変更を伴う操作は監査ログと同じトランザクションで保存し、
+// 記録に失敗した場合は操作を取り消してエラーを返す（参照のみの操作も、記録できない場合は結果を返さない）
+type userAdminService struct {
+	userRepository     repository.UserRepository
+	roleRepository     repository.RoleRepository
+	auditLogRepository repository.AuditLogRepository
+	transactor         repository.Transactor
+	permissions        PermissionResolver
+	domainPolicy       registration.DomainPolicy
+	config             *Config
+}
+
+// NewUserAdminService は UserAdminService のインスタンスを作成
+func NewUserAdminService(userRepository repository.UserRepository, roleRepository repository.RoleRepository, auditLogRepository repository.AuditLogRepository, transactor repository.Transactor, permissions PermissionResolver, domainPolicy registration.DomainPolicy, config *Config) UserAdminService {
+	return &userAdminService{
+		userRepository:     userRepository,
+		roleRepository:     roleRepository,
+		auditLogRepository: auditLogRepository,
+		transactor:         transactor,
+		permissions:        permissions,
+		domainPolicy:       domainPolicy,
+		config:             config,
+	}
+}
+
+// ListUsers は検索条件に一致するユーザーの指定されたページを返す
+func (s *userAdminService) ListUsers(actorObjID string, query UserQuery) (*UserPage, error) {
+	limit := query.Limit
+	if limit == 0 {
+		limit = s.config.DefaultLimit
+	}
+	if limit < 1 || limit > s.config.MaxLimit {
+		return nil, ErrInvalidUserQuery
+	}
+	if query.Role != "" {
+		if _, err := value.NewUserRole(query.Role); err != nil {
+			return nil, ErrInvalidUserQuery
+		}
+	}
+	if query.Status != "" {
+		if _, err := value.NewUserStatus(query.Status); err != nil {
+			return nil, ErrInvalidUserQuery
+		}
+	}
+	if query.CreatedFrom != nil && query.CreatedTo != nil && !query.CreatedFrom.Before(*query.CreatedTo) {
+		return nil, ErrInvalidUserQuery
+	}
+	filter := repository.UserListFilter{
+		Email:       strings.TrimSpace(query.Email),
+		Role:        query.Role,
+		Status:      query.Status,
+		CreatedFrom: query.CreatedFrom,
+		CreatedTo:   query.CreatedTo,
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		filter.After = cursor
+	}
+
+	// 1 件多く取得し、次のページがあるかを判定する
+	users, err := s.userRepository.ListUsers(filter, limit+1)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to list users")
+	}
+	page := &UserPage{Users: users}
+	if len(users) > limit {
+		page.Users = users[:limit]
+		page.NextCursor = encodeCursor(page.Users[limit-1])
+	}
+
+	detail := map[string]string{
+		"email":  filter.Email,
+		"role":   filter.Role,
+		"status": filter.Status,
+		"cursor": query.Cursor,
+	}
+	if query.CreatedFrom != nil {
+		detail["createdFrom"] = query.CreatedFrom.Format(time.RFC3339)
+	}
+	if query.CreatedTo != nil {
+		detail["createdTo"] = query.CreatedTo.Format(time.RFC3339)
+	}
+	if err := audit.Record(s.userRepository, s.auditLogRepository, actorObjID, entity.AuditActionUserList, "", detail); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// GetUser はユーザーを取得する
+func (s *userAdminService) GetUser(actorObjID string, objID string) (*entity.User, error) {
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if err := audit.Record(s.userRepository, s.auditLogRepository, actorObjID, entity.AuditActionUserView, objID, nil); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateUser は一時的なパスワードを生成してユーザーを作成する
+// 一時的なパスワードはレスポンスでのみ返すため、管理者からユーザーに伝え、ユーザー自身で変更してもらう
+func (s *userAdminService) CreateUser(actorObjID string, email string, username string, roles []string) (*CreatedUser, error) {
+	emailValue, err := value.NewUserEmail(email)
+	if err != nil {
+		return nil, ErrInvalidUser
+	}
+	usernameValue, err := value.NewUserUsername(username)
+	if err != nil {
+		return nil, ErrInvalidUser
+	}
+	// 管理者が作成する場合も、セルフ登録と同じドメインの制限を適用する
+	if err := s.domainPolicy.Check(emailValue); err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		roles = []string{value.RegularUser}
+	}
+	roleValues, err := s.resolveRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGrantable(actorObjID, roles); err != nil {
+		return nil, err
+	}
+	if _, err := s.userRepository.GetUserByEmail(emailValue.Value()); err == nil {
+		return nil, ErrEmailAlreadyInUse
+	}
+
+	temporaryPassword, passwordValue, err := generateTemporaryPassword()
+	if err != nil {
+		return nil, errs.NewServiceError("failed to generate temporary password")
+	}
+	user, err := entity.NewUser(emailValue, passwordValue, usernameValue)
+	if err != nil {
+		return nil, err
+	}
+	if err := user.ChangeRoles(roleValues); err != nil {
+		return nil, ErrInvalidRole
+	}
+	// 管理者が作成したユーザーは、メールアドレスの確認を待たずに有効にする
+	activatedAt, err := now()
+	if err != nil {
+		return nil, err
+	}
+	if err := user.Activate(activatedAt); err != nil {
+		return nil, err
+	}
+	var createdUser *entity.User
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if createdUser, err = repos.Users.CreateUser(user); err != nil {
+			return errs.NewServiceError("failed to create user")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionUserCreate, createdUser.ObjID().Value(), map[string]string{
+			"email": createdUser.Email().Value(),
+			"roles": strings.Join(createdUser.RoleNames(), ","),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreatedUser{User: createdUser, TemporaryPassword: temporaryPassword}, nil
+}
+
+// UpdateRoles はユーザーのロールを置き換える（管理者自身のロールは変更できない）
+func (s *userAdminService) UpdateRoles(actorObjID string, objID string, roles []string) (*entity.User, error) {
+	if actorObjID == objID {
+		return nil, ErrCannotManageSelf
+	}
+	roleValues, err := s.resolveRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	// 追加するロールと取り除くロールのどちらも、管理者自身が持つ権限の範囲でのみ変更できる
+	changed := make([]string, 0, len(roles))
+	for _, role := range roleValues {
+		if !slices.Contains(user.RoleNames(), role.Value()) {
+			changed = append(changed, role.Value())
+		}
+	}
+	for _, name := range user.RoleNames() {
+		if !slices.Contains(roles, name) {
+			changed = append(changed, name)
+		}
+	}
+	if err := s.checkGrantable(actorObjID, changed); err != nil {
+		return nil, err
+	}
+	previousRoles := strings.Join(user.RoleNames(), ",")
+	if err := user.ChangeRoles(roleValues); err != nil {
+		return nil, ErrInvalidRole
+	}
+	var updatedUser *entity.User
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if updatedUser, err = repos.Users.UpdateUser(user); err != nil {
+			return errs.NewServiceError("failed to update user in repository")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionUserRoles, objID, map[string]string{
+			"previousRoles": previousRoles,
+			"roles":         strings.Join(updatedUser.RoleNames(), ","),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedUser, nil
+}
+
+// SuspendUser はユーザーのアカウントを停止する（管理者自身のアカウントは停止できない）
+// 停止したユーザーのリクエストは、発行済みのトークンを含めて拒否される
+func (s *userAdminService) SuspendUser(actorObjID string, objID string, reason string) (*entity.User, error) {
+	if actorObjID == objID {
+		return nil, ErrCannotManageSelf
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > entity.UserStatusReasonMaxLength {
+		return nil, ErrInvalidSuspendReason
+	}
+	return s.changeStatus(actorObjID, objID, entity.AuditActionUserSuspend, map[string]string{"reason": reason}, func(user *entity.User, at *timeobj.TimeObj) error {
+		return user.Suspend(reason, at)
+	})
+}
+
+// UnsuspendUser は停止したアカウントを有効に戻す
+func (s *userAdminService) UnsuspendUser(actorObjID string, objID string) (*entity.User, error) {
+	if actorObjID == objID {
+		return nil, ErrCannotManageSelf
+	}
+	return s.changeStatus(actorObjID, objID, entity.AuditActionUserUnsuspend, nil, func(user *entity.User, at *timeobj.TimeObj) error {
+		return user.Unsuspend(at)
+	})
+}
+
+// changeStatus はユーザーのアカウントの状態を change で変更して保存する
+func (s *userAdminService) changeStatus(actorObjID string, objID string, action string, detail map[string]string, change func(user *entity.User, at *timeobj.TimeObj) error) (*entity.User, error) {
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if err := s.checkManageable(actorObjID, objID); err != nil {
+		return nil, err
+	}
+	changedAt, err := now()
+	if err != nil {
+		return nil, err
+	}
+	if err := change(user, changedAt); err != nil {
+		return nil, ErrInvalidStatusChange
+	}
+	var updatedUser *entity.User
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if updatedUser, err = repos.Users.UpdateUser(user); err != nil {
+			return errs.NewServiceError("failed to update user in repository")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, action, objID, detail)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedUser, nil
+}
+
+// LogoutUser はユーザーに発行済みのトークン（リフレッシュトークンを含む）をすべて無効にする
+// セッションにはテナントの区別がないため、先にテナント内のユーザーであることを確かめる
+func (s *userAdminService) LogoutUser(actorObjID string, objID string) error {
+	if _, err := s.userRepository.GetUserByObjID(objID); err != nil {
+		return ErrUserNotFound
+	}
+	if err := s.checkManageable(actorObjID, objID); err != nil {
+		return err
+	}
+	return s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		found, err := repos.Sessions.RevokeSessions(objID, time.Now())
+		if err != nil {
+			return errs.NewServiceError("failed to revoke sessions")
+		}
+		if !found {
+			return ErrUserNotFound
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionUserLogout, objID, nil)
+	})
+}
+
+// DeleteUser はユーザーを削除する（管理者自身のアカウントは削除できない）
+func (s *userAdminService) DeleteUser(actorObjID string, objID string) error {
+	if actorObjID == objID {
+		return ErrCannotManageSelf
+	}
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+	if err := s.checkManageable(actorObjID, objID); err != nil {
+		return err
+	}
+	return s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if err := repos.Users.DeleteUser(objID); err != nil {
+			return errs.NewServiceError("failed to delete user")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionUserDelete, objID, map[string]string{"email": user.Email().Value()})
+	})
+}
+
+// resolveRoles はロールの名前を検証し、すべて存在する場合にロールを返す
+func (s *userAdminService) resolveRoles(names []string) ([]*value.UserRole, error) {
+	if len(names) == 0 {
+		return nil, ErrInvalidRole
+	}
+	roles := make([]*value.UserRole, 0, len(names))
+	for _, name := range names {
+		role, err := value.NewUserRole(name)
+		if err != nil {
+			return nil, ErrInvalidRole
+		}
+		roles = append(roles, role)
+	}
+	found, err := s.roleRepository.FindRolesByNames(names)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get roles from repository")
+	}
+	for _, role := range roles {
+		if !slices.ContainsFunc(found, func(r *entity.Role) bool { return r.Name().Value() == role.Value() }) {
+			return nil, ErrInvalidRole
+		}
+	}
+	return roles, nil
+}
+
+// checkGrantable は、ロールが与える権限を操作するアカウントがすべて持っているかを確認する
+// 持っていない権限を含むロールを付与できると、users:write だけを持つアカウントが admin を付与できてしまう
+func (s *userAdminService) checkGrantable(actorObjID string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	roles, err := s.roleRepository.FindRolesByNames(names)
+	if err != nil {
+		return errs.NewServiceError("failed to get roles from repository")
+	}
+	required := []string{}
+	for _, r := range roles {
+		required = append(required, r.PermissionNames()...)
+	}
+	if len(required) == 0 {
+		return nil
+	}
+	held, err := s.permissions.GetPermissions(actorObjID)
+	if err != nil {
+		return errs.NewServiceError("failed to get permissions of the actor")
+	}
+	if !held.GrantsAll(required) {
+		return ErrCannotGrantRole
+	}
+	return nil
+}
+
+// checkManageable は、対象のユーザーが持つ権限を操作するアカウントがすべて持っているかを確認する
+// 持っていない権限を持つユーザーを操作できると、users:write だけを持つアカウントが admin を停止・削除できてしまう
+func (s *userAdminService) checkManageable(actorObjID string, objID string) error {
+	target, err := s.permissions.GetPermissions(objID)
+	if err != nil {
+		return errs.NewServiceError("failed to get permissions of the user")
+	}
+	if len(target.Permissions) == 0 {
+		return nil
+	}
+	held, err := s.permissions.GetPermissions(actorObjID)
+	if err != nil {
+		return errs.NewServiceError("failed to get permissions of the actor")
+	}
+	if !held.GrantsAll(target.Permissions) {
+		return ErrCannotManageUser
+	}
+	return nil
+}
+
+func now() (*timeobj.TimeObj, error) {
+	at, err := timeobj.NewTimeObj(time.Now())
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create changed at")
+	}
+	return at, nil
+}
+
+// generateTemporaryPassword は英字と数字を含む一時的なパスワードを生成し、ハッシュ化した値とともに返す
+func generateTemporaryPassword() (string, *value.UserPassword, error) {
+	max := big.NewInt(int64(len(temporaryPasswordAlphabet)))
+	for {
+		buf := make([]byte, temporaryPasswordLength)
+		for i := range buf {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", nil, err
+			}
+			buf[i] = temporaryPasswordAlphabet[n.Int64()]
+		}
+		plain := string(buf)
+		// 英字と数字の両方を含まない場合は作り直す
+		if passwordValue, err := value.NewUserPassword(plain); err == nil {
+			return plain, passwordValue, nil
+		}
+	}
+}
+
+// encodeCursor はユーザーの一覧での位置を URL に含められる文字列にする
+func encodeCursor(user *entity.User) string {
+	raw := user.CreatedAt().Value().UTC().Format(time.RFC3339Nano) + "|" + user.ObjID().Value()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor は encodeCursor で作成したカーソルを読み取る
+func decodeCursor(cursor string) (*repository.UserCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	createdAt, objID, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, errs.NewServiceError("malformed cursor")
+	}
+	at, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := value.NewUserObjID(objID); err != nil {
+		return nil, err
+	}
+	return &repository.UserCursor{CreatedAt: at, ObjID: objID}, nil
+}
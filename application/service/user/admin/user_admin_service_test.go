@@ -0,0 +1,645 @@
+package admin_test
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/admin"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/application/service/user/role"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+type mockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *mockSessionRepository) RevokeSessions(userObjID string, revokedAt time.Time) (bool, error) {
+	args := m.Called(userObjID, revokedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockSessionRepository) GetSessionsRevokedAt(userObjID string) (*time.Time, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+// stubRoleRepository は登録されたロールを返すテスト用リポジトリ
+type stubRoleRepository struct {
+	roles map[string]*entity.Role
+	err   error
+}
+
+func newStubRoleRepository(names ...string) *stubRoleRepository {
+	roles := make(map[string]*entity.Role, len(names))
+	for _, name := range names {
+		roleName, _ := value.NewUserRole(name)
+		roles[name], _ = entity.BuildRole(roleName, "", nil)
+	}
+	return &stubRoleRepository{roles: roles}
+}
+
+func (r *stubRoleRepository) ListRoles() ([]*entity.Role, error) {
+	return slices.Collect(maps.Values(r.roles)), r.err
+}
+
+func (r *stubRoleRepository) FindRole(name string) (*entity.Role, error) {
+	return r.roles[name], r.err
+}
+
+func (r *stubRoleRepository) FindRolesByNames(names []string) ([]*entity.Role, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	found := []*entity.Role{}
+	for _, name := range names {
+		if role, ok := r.roles[name]; ok {
+			found = append(found, role)
+		}
+	}
+	return found, nil
+}
+
+func (r *stubRoleRepository) CreateRole(role *entity.Role) (*entity.Role, error) {
+	r.roles[role.Name().Value()] = role
+	return role, r.err
+}
+
+func (r *stubRoleRepository) EnsureRoles(roles []*entity.Role) error {
+	for _, role := range roles {
+		if _, ok := r.roles[role.Name().Value()]; !ok {
+			r.roles[role.Name().Value()] = role
+		}
+	}
+	return r.err
+}
+
+func (r *stubRoleRepository) UpdateRole(role *entity.Role) (*entity.Role, error) {
+	r.roles[role.Name().Value()] = role
+	return role, r.err
+}
+
+func (r *stubRoleRepository) DeleteRole(name string) error {
+	delete(r.roles, name)
+	return r.err
+}
+
+// withPermissions はロールに権限を与える
+func (r *stubRoleRepository) withPermissions(name string, permissions ...string) *stubRoleRepository {
+	roleName, _ := value.NewUserRole(name)
+	values := make([]*value.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		p, _ := value.NewPermission(permission)
+		values = append(values, p)
+	}
+	r.roles[name], _ = entity.BuildRole(roleName, "", values)
+	return r
+}
+
+// stubPermissionResolver はユーザーごとに登録された権限を返すテスト用の PermissionResolver
+type stubPermissionResolver struct {
+	permissions map[string][]string
+}
+
+func (r *stubPermissionResolver) GetPermissions(userObjID string) (*role.UserPermissions, error) {
+	permissions, ok := r.permissions[userObjID]
+	if !ok {
+		return nil, role.ErrUserNotFound
+	}
+	return &role.UserPermissions{Roles: []string{}, Groups: []string{}, Permissions: permissions}, nil
+}
+
+// fakeTransactor は、テスト用のリポジトリをそのまま渡す Transactor（fn がエラーを返した場合は取り消したことを記録する）
+type fakeTransactor struct {
+	repos      *repository.TxRepositories
+	rolledBack bool
+}
+
+func (t *fakeTransactor) Transaction(fn func(repos *repository.TxRepositories) error) error {
+	err := fn(t.repos)
+	if err != nil {
+		t.rolledBack = true
+	}
+	return err
+}
+
+// recordingAuditLogRepository は記録された監査ログを保持するテスト用リポジトリ
+type recordingAuditLogRepository struct {
+	logs []*entity.AuditLog
+	err  error
+}
+
+func (r *recordingAuditLogRepository) SaveAuditLog(log *entity.AuditLog) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+// stubDomainPolicy は固定の判定結果を返すドメインポリシー
+type stubDomainPolicy struct {
+	err error
+}
+
+func (p *stubDomainPolicy) Check(email *value.UserEmail) error {
+	return p.err
+}
+
+func (p *stubDomainPolicy) Reload() error {
+	return nil
+}
+
+// --- テストスイート ---
+
+const actorObjID = "11111111-1111-1111-1111-111111111111"
+
+type UserAdminServiceTestSuite struct {
+	suite.Suite
+	userRepo     *mockUserRepository
+	roleRepo     *stubRoleRepository
+	permissions  *stubPermissionResolver
+	sessionRepo  *mockSessionRepository
+	auditRepo    *recordingAuditLogRepository
+	transactor   *fakeTransactor
+	domainPolicy *stubDomainPolicy
+	service      admin.UserAdminService
+	testUser     *entity.User
+}
+
+func TestUserAdminServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserAdminServiceTestSuite))
+}
+
+func (suite *UserAdminServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.roleRepo = newStubRoleRepository(value.RegularUser).
+		withPermissions(value.Admin, value.PermissionAll).
+		withPermissions("support", value.PermissionUsersRead)
+	// 操作するアカウントは既定ですべての権限を持つ
+	suite.permissions = &stubPermissionResolver{permissions: map[string][]string{actorObjID: {value.PermissionAll}}}
+	suite.sessionRepo = new(mockSessionRepository)
+	suite.auditRepo = &recordingAuditLogRepository{}
+	suite.domainPolicy = &stubDomainPolicy{}
+	suite.transactor = &fakeTransactor{repos: &repository.TxRepositories{Users: suite.userRepo, Sessions: suite.sessionRepo, AuditLogs: suite.auditRepo}}
+	suite.service = admin.NewUserAdminService(suite.userRepo, suite.roleRepo, suite.auditRepo, suite.transactor, suite.permissions, suite.domainPolicy, &admin.Config{
+		DefaultLimit: 2,
+		MaxLimit:     10,
+	})
+	suite.testUser = suite.newUser("target@example.com")
+	suite.permissions.permissions[suite.testUser.ObjID().Value()] = []string{}
+	// 監査ログに記録する、操作したアカウントの種類の取得
+	suite.userRepo.On("GetUserByObjID", actorObjID).Return(suite.newActor(value.KindUser), nil).Maybe()
+}
+
+// newActor は操作するアカウント（ID は actorObjID）を生成する
+func (suite *UserAdminServiceTestSuite) newActor(kind string) *entity.User {
+	objID, _ := value.NewUserObjID(actorObjID)
+	email, _ := value.NewUserEmail("actor@example.com")
+	username, _ := value.NewUserUsername("actor")
+	userKind, _ := value.NewUserKind(kind)
+	actor, err := entity.BuildUser(objID, email, value.NoPassword(), username, nil, nil, nil, nil, nil, "", nil, nil, userKind)
+	suite.Require().NoError(err)
+	return actor
+}
+
+// newUser は有効な状態のユーザーを生成する
+func (suite *UserAdminServiceTestSuite) newUser(address string) *entity.User {
+	email, _ := value.NewUserEmail(address)
+	username, _ := value.NewUserUsername("target")
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	activatedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(user.Activate(activatedAt))
+	return user
+}
+
+// lastAudit は最後に記録された監査ログを返す
+func (suite *UserAdminServiceTestSuite) lastAudit() *entity.AuditLog {
+	suite.Require().NotEmpty(suite.auditRepo.logs, "監査ログが記録されていること")
+	return suite.auditRepo.logs[len(suite.auditRepo.logs)-1]
+}
+
+// ----- ListUsers のテスト -----
+
+func (suite *UserAdminServiceTestSuite) TestListUsers_Pagination() {
+	first, second, third := suite.newUser("a@example.com"), suite.newUser("b@example.com"), suite.newUser("c@example.com")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := repository.UserListFilter{Email: "example", Role: value.RegularUser, Status: value.StatusActive, CreatedFrom: &from}
+	// 次のページがあるかを判定するため、1 件多く取得すること
+	suite.userRepo.On("ListUsers", filter, 3).Return([]*entity.User{first, second, third}, nil).Once()
+
+	page, err := suite.service.ListUsers(actorObjID, admin.UserQuery{Email: " example ", Role: value.RegularUser, Status: value.StatusActive, CreatedFrom: &from})
+	suite.Require().NoError(err)
+	suite.Equal([]*entity.User{first, second}, page.Users, "既定の件数だけ返すこと")
+	suite.NotEmpty(page.NextCursor)
+
+	log := suite.lastAudit()
+	suite.Equal(entity.AuditActionUserList, log.Action())
+	suite.Equal(actorObjID, log.ActorObjID().Value())
+	suite.Equal("example", log.Detail()["email"])
+	suite.Equal(from.Format(time.RFC3339), log.Detail()["createdFrom"])
+
+	// カーソルを指定すると、前のページの最後のユーザーより後から取得すること
+	after := filter
+	after.After = &repository.UserCursor{CreatedAt: second.CreatedAt().Value().UTC(), ObjID: second.ObjID().Value()}
+	suite.userRepo.On("ListUsers", mock.MatchedBy(func(f repository.UserListFilter) bool {
+		return f.After != nil && f.After.ObjID == after.After.ObjID && f.After.CreatedAt.Equal(after.After.CreatedAt) && f.Email == "example"
+	}), 3).Return([]*entity.User{third}, nil).Once()
+
+	page, err = suite.service.ListUsers(actorObjID, admin.UserQuery{Email: "example", Role: value.RegularUser, Status: value.StatusActive, CreatedFrom: &from, Cursor: page.NextCursor})
+	suite.Require().NoError(err)
+	suite.Equal([]*entity.User{third}, page.Users)
+	suite.Empty(page.NextCursor, "最後のページではカーソルを返さないこと")
+	suite.userRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserAdminServiceTestSuite) TestListUsers_Limit() {
+	suite.userRepo.On("ListUsers", repository.UserListFilter{}, 11).Return([]*entity.User{}, nil)
+
+	page, err := suite.service.ListUsers(actorObjID, admin.UserQuery{Limit: 10})
+	suite.NoError(err)
+	suite.Empty(page.Users)
+
+	_, err = suite.service.ListUsers(actorObjID, admin.UserQuery{Limit: 11})
+	suite.ErrorIs(err, admin.ErrInvalidUserQuery, "上限を超える件数は指定できないこと")
+	_, err = suite.service.ListUsers(actorObjID, admin.UserQuery{Limit: -1})
+	suite.ErrorIs(err, admin.ErrInvalidUserQuery)
+}
+
+func (suite *UserAdminServiceTestSuite) TestListUsers_InvalidQuery() {
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	cases := []admin.UserQuery{
+		{Role: "Not A Role"},
+		{Status: "unknown"},
+		{CreatedFrom: &from, CreatedTo: &to},
+	}
+	for _, query := range cases {
+		_, err := suite.service.ListUsers(actorObjID, query)
+		suite.ErrorIs(err, admin.ErrInvalidUserQuery)
+	}
+	for _, cursor := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "MjAyNC0wMS0wMVQwMDowMDowMFp8bm90LWEtdXVpZA"} {
+		_, err := suite.service.ListUsers(actorObjID, admin.UserQuery{Cursor: cursor})
+		suite.ErrorIs(err, admin.ErrInvalidCursor)
+	}
+	suite.userRepo.AssertNotCalled(suite.T(), "ListUsers", mock.Anything, mock.Anything)
+	suite.Empty(suite.auditRepo.logs, "失敗した操作は記録しないこと")
+}
+
+// ----- GetUser のテスト -----
+
+func (suite *UserAdminServiceTestSuite) TestGetUser() {
+	objID := suite.testUser.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errs.NewInfraError("record not found"))
+
+	user, err := suite.service.GetUser(actorObjID, objID)
+	suite.NoError(err)
+	suite.Equal(suite.testUser, user)
+	suite.Equal(entity.AuditActionUserView, suite.lastAudit().Action())
+	suite.Equal(objID, suite.lastAudit().TargetObjID())
+
+	_, err = suite.service.GetUser(actorObjID, "unknown")
+	suite.ErrorIs(err, admin.ErrUserNotFound)
+}
+
+// ----- CreateUser のテスト -----
+
+func (suite *UserAdminServiceTestSuite) TestCreateUser() {
+	var created *entity.User
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("record not found"))
+	suite.userRepo.On("CreateUser", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(*entity.User)
+	}).Return(suite.testUser, nil)
+
+	result, err := suite.service.CreateUser(actorObjID, "new@example.com", "newuser", []string{"support", value.Admin})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(created)
+	suite.Equal([]string{value.Admin, "support"}, created.RoleNames(), "指定したロールで作成すること")
+	suite.True(created.IsActive(), "有効な状態で作成すること")
+	suite.Len(result.TemporaryPassword, 16)
+	suite.True(created.Password().Verify(result.TemporaryPassword), "一時的なパスワードを設定すること")
+	suite.Equal(suite.testUser, result.User)
+
+	log := suite.lastAudit()
+	suite.Equal(entity.AuditActionUserCreate, log.Action())
+	suite.Equal(suite.testUser.ObjID().Value(), log.TargetObjID())
+	suite.NotContains(log.Detail(), "password", "パスワードは記録しないこと")
+	suite.Contains(log.Detail(), "roles", "作成したユーザーのロールを記録すること")
+}
+
+func (suite *UserAdminServiceTestSuite) TestCreateUser_DefaultRole() {
+	var created *entity.User
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("record not found"))
+	suite.userRepo.On("CreateUser", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(*entity.User)
+	}).Return(suite.testUser, nil)
+
+	_, err := suite.service.CreateUser(actorObjID, "new@example.com", "newuser", nil)
+	suite.Require().NoError(err)
+	suite.Equal([]string{value.RegularUser}, created.RoleNames())
+}
+
+func (suite *UserAdminServiceTestSuite) TestCreateUser_Invalid() {
+	suite.userRepo.On("GetUserByEmail", "target@example.com").Return(suite.testUser, nil)
+
+	_, err := suite.service.CreateUser(actorObjID, "target@example.com", "newuser", nil)
+	suite.ErrorIs(err, admin.ErrEmailAlreadyInUse)
+	_, err = suite.service.CreateUser(actorObjID, "invalid", "newuser", nil)
+	suite.ErrorIs(err, admin.ErrInvalidUser)
+	_, err = suite.service.CreateUser(actorObjID, "new@example.com", "", nil)
+	suite.ErrorIs(err, admin.ErrInvalidUser)
+	_, err = suite.service.CreateUser(actorObjID, "new@example.com", "newuser", []string{"owner"})
+	suite.ErrorIs(err, admin.ErrInvalidRole, "存在しないロールは指定できないこと")
+	_, err = suite.service.CreateUser(actorObjID, "new@example.com", "newuser", []string{"Not A Role"})
+	suite.ErrorIs(err, admin.ErrInvalidRole)
+	suite.userRepo.AssertNotCalled(suite.T(), "CreateUser", mock.Anything)
+	suite.Empty(suite.auditRepo.logs)
+}
+
+// 登録を許可しないドメインのメールアドレスではユーザーを作成できないこと
+func (suite *UserAdminServiceTestSuite) TestCreateUser_DomainNotAllowed() {
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("record not found"))
+	suite.domainPolicy.err = registration.ErrEmailDomainNotAllowed
+
+	_, err := suite.service.CreateUser(actorObjID, "new@example.com", "newuser", nil)
+	suite.ErrorIs(err, registration.ErrEmailDomainNotAllowed)
+	suite.userRepo.AssertNotCalled(suite.T(), "CreateUser", mock.Anything)
+	suite.Empty(suite.auditRepo.logs)
+}
+
+func (suite *UserAdminServiceTestSuite) TestCreateUser_CannotGrantPermissionsNotHeld() {
+	// users:write だけを持つアカウントは、すべての権限を与える admin を付与できない
+	suite.permissions.permissions[actorObjID] = []string{value.PermissionUsersRead, value.PermissionUsersWrite}
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("record not found"))
+	suite.userRepo.On("CreateUser", mock.Anything).Return(suite.testUser, nil)
+
+	_, err := suite.service.CreateUser(actorObjID, "new@example.com", "newuser", []string{value.Admin})
+	suite.ErrorIs(err, admin.ErrCannotGrantRole)
+	suite.userRepo.AssertNotCalled(suite.T(), "CreateUser", mock.Anything)
+	suite.Empty(suite.auditRepo.logs)
+
+	// 自身が持つ権限の範囲のロールは付与できる
+	_, err = suite.service.CreateUser(actorObjID, "new@example.com", "newuser", []string{"support"})
+	suite.NoError(err)
+}
+
+// ----- UpdateRoles のテスト -----
+
+func (suite *UserAdminServiceTestSuite) TestUpdateRoles() {
+	objID := suite.testUser.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.userRepo.On("UpdateUser", suite.testUser).Return(suite.testUser, nil)
+
+	user, err := suite.service.UpdateRoles(actorObjID, objID, []string{value.RegularUser, "support"})
+	suite.NoError(err)
+	suite.Equal([]string{"support", value.RegularUser}, user.RoleNames())
+
+	log := suite.lastAudit()
+	suite.Equal(entity.AuditActionUserRoles, log.Action())
+	suite.Equal(map[string]string{"previousRoles": value.RegularUser, "roles": "support," + value.RegularUser}, log.Detail())
+}
+
+func (suite *UserAdminServiceTestSuite) TestUpdateRoles_Invalid() {
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errs.NewInfraError("record not found"))
+
+	_, err := suite.service.UpdateRoles(actorObjID, actorObjID, []string{value.RegularUser})
+	suite.ErrorIs(err, admin.ErrCannotManageSelf, "自身のロールは変更できないこと")
+	_, err = suite.service.UpdateRoles(actorObjID, suite.testUser.ObjID().Value(), []string{value.Admin, "owner"})
+	suite.ErrorIs(err, admin.ErrInvalidRole, "存在しないロールを含む場合は変更しないこと")
+	_, err = suite.service.UpdateRoles(actorObjID, suite.testUser.ObjID().Value(), nil)
+	suite.ErrorIs(err, admin.ErrInvalidRole, "ロールは 1 つ以上必要")
+	_, err = suite.service.UpdateRoles(actorObjID, "unknown", []string{value.Admin})
+	suite.ErrorIs(err, admin.ErrUserNotFound)
+	suite.userRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+	suite.Empty(suite.auditRepo.logs)
+}
+
+func (suite *UserAdminServiceTestSuite) TestUpdateRoles_CannotGrantPermissionsNotHeld() {
+	suite.permissions.permissions[actorObjID] = []string{value.PermissionUsersRead, value.PermissionUsersWrite}
+	objID := suite.testUser.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.userRepo.On("UpdateUser", suite.testUser).Return(suite.testUser, nil)
+
+	_, err := suite.service.UpdateRoles(actorObjID, objID, []string{value.RegularUser, value.Admin})
+	suite.ErrorIs(err, admin.ErrCannotGrantRole, "持たない権限を含むロールは付与できないこと")
+	suite.userRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+	suite.Equal([]string{value.RegularUser}, suite.testUser.RoleNames())
+
+	// 持たない権限を含むロールは取り除くこともできない
+	adminRole, _ := value.NewUserRole(value.Admin)
+	suite.Require().NoError(suite.testUser.ChangeRoles([]*value.UserRole{adminRole}))
+	_, err = suite.service.UpdateRoles(actorObjID, objID, []string{value.RegularUser})
+	suite.ErrorIs(err, admin.ErrCannotGrantRole, "持たない権限を含むロールは剥奪できないこと")
+	suite.userRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+
+	// 変更しないロールは、持たない権限を含んでいても確認しない
+	_, err = suite.service.UpdateRoles(actorObjID, objID, []string{value.Admin, "support"})
+	suite.NoError(err)
+}
+
+// ----- SuspendUser / UnsuspendUser のテスト -----
+
+func (suite *UserAdminServiceTestSuite) TestSuspendAndUnsuspendUser() {
+	objID := suite.testUser.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.userRepo.On("UpdateUser", suite.testUser).Return(suite.testUser, nil)
+
+	user, err := suite.service.SuspendUser(actorObjID, objID, " 規約違反 ")
+	suite.Require().NoError(err)
+	suite.Equal(value.StatusSuspended, user.Status().Value())
+	suite.Equal("規約違反", user.StatusReason())
+	suite.Equal(entity.AuditActionUserSuspend, suite.lastAudit().Action())
+	suite.Equal(map[string]string{"reason": "規約違反"}, suite.lastAudit().Detail())
+
+	// 停止中のアカウントは再び停止できないこと
+	_, err = suite.service.SuspendUser(actorObjID, objID, "規約違反")
+	suite.ErrorIs(err, admin.ErrInvalidStatusChange)
+
+	user, err = suite.service.UnsuspendUser(actorObjID, objID)
+	suite.Require().NoError(err)
+	suite.Equal(value.StatusActive, user.Status().Value())
+	suite.Equal(entity.AuditActionUserUnsuspend, suite.lastAudit().Action())
+
+	// 停止していないアカウントは解除できないこと
+	_, err = suite.service.UnsuspendUser(actorObjID, objID)
+	suite.ErrorIs(err, admin.ErrInvalidStatusChange)
+	suite.Len(suite.auditRepo.logs, 2, "失敗した操作は記録しないこと")
+}
+
+func (suite *UserAdminServiceTestSuite) TestSuspendUser_Invalid() {
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errs.NewInfraError("record not found"))
+
+	_, err := suite.service.SuspendUser(actorObjID, actorObjID, "規約違反")
+	suite.ErrorIs(err, admin.ErrCannotManageSelf)
+	_, err = suite.service.SuspendUser(actorObjID, suite.testUser.ObjID().Value(), " ")
+	suite.ErrorIs(err, admin.ErrInvalidSuspendReason, "理由は必須")
+	_, err = suite.service.SuspendUser(actorObjID, suite.testUser.ObjID().Value(), strings.Repeat("a", entity.UserStatusReasonMaxLength+1))
+	suite.ErrorIs(err, admin.ErrInvalidSuspendReason)
+	_, err = suite.service.SuspendUser(actorObjID, "unknown", "規約違反")
+	suite.ErrorIs(err, admin.ErrUserNotFound)
+	_, err = suite.service.UnsuspendUser(actorObjID, actorObjID)
+	suite.ErrorIs(err, admin.ErrCannotManageSelf)
+	suite.userRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+}
+
+// 管理者自身が持たない権限を持つユーザーは、停止・停止の解除・強制ログアウト・削除できないこと
+func (suite *UserAdminServiceTestSuite) TestCannotManageUserWithPermissionsNotHeld() {
+	suite.permissions.permissions[actorObjID] = []string{value.PermissionUsersRead, value.PermissionUsersWrite}
+	objID := suite.testUser.ObjID().Value()
+	suite.permissions.permissions[objID] = []string{value.PermissionAll}
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+
+	_, err := suite.service.SuspendUser(actorObjID, objID, "規約違反")
+	suite.ErrorIs(err, admin.ErrCannotManageUser)
+	_, err = suite.service.UnsuspendUser(actorObjID, objID)
+	suite.ErrorIs(err, admin.ErrCannotManageUser)
+	suite.ErrorIs(suite.service.LogoutUser(actorObjID, objID), admin.ErrCannotManageUser)
+	suite.ErrorIs(suite.service.DeleteUser(actorObjID, objID), admin.ErrCannotManageUser)
+	suite.userRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+	suite.userRepo.AssertNotCalled(suite.T(), "DeleteUser", mock.Anything)
+	suite.sessionRepo.AssertNotCalled(suite.T(), "RevokeSessions", mock.Anything, mock.Anything)
+	suite.Empty(suite.auditRepo.logs)
+
+	// 管理者自身が持つ権限の範囲のユーザーは操作できること
+	suite.permissions.permissions[objID] = []string{value.PermissionUsersRead}
+	suite.sessionRepo.On("RevokeSessions", objID, mock.Anything).Return(true, nil)
+	suite.NoError(suite.service.LogoutUser(actorObjID, objID))
+}
+
+// ----- LogoutUser のテスト -----
+
+func (suite *UserAdminServiceTestSuite) TestLogoutUser() {
+	objID := suite.testUser.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errs.NewInfraError("record not found"))
+	suite.sessionRepo.On("RevokeSessions", objID, mock.Anything).Return(true, nil)
+
+	suite.NoError(suite.service.LogoutUser(actorObjID, objID))
+	suite.Equal(entity.AuditActionUserLogout, suite.lastAudit().Action())
+	suite.Equal(objID, suite.lastAudit().TargetObjID())
+
+	// テナント内に存在しないユーザー（他のテナントのユーザーを含む）のセッションは無効にしない
+	suite.ErrorIs(suite.service.LogoutUser(actorObjID, "unknown"), admin.ErrUserNotFound)
+	suite.sessionRepo.AssertNotCalled(suite.T(), "RevokeSessions", "unknown", mock.Anything)
+	suite.Len(suite.auditRepo.logs, 1)
+	suite.Equal(value.KindUser, suite.lastAudit().ActorKind().Value())
+}
+
+// サービスアカウントによる操作は、監査ログで人による操作と区別できること
+func (suite *UserAdminServiceTestSuite) TestAudit_ServiceAccountActor() {
+	objID := suite.testUser.ObjID().Value()
+	suite.userRepo.ExpectedCalls = nil
+	suite.userRepo.On("GetUserByObjID", actorObjID).Return(suite.newActor(value.KindServiceAccount), nil)
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.sessionRepo.On("RevokeSessions", objID, mock.Anything).Return(true, nil)
+
+	suite.NoError(suite.service.LogoutUser(actorObjID, objID))
+	suite.Equal(value.KindServiceAccount, suite.lastAudit().ActorKind().Value())
+}
+
+// ----- DeleteUser のテスト -----
+
+func (suite *UserAdminServiceTestSuite) TestDeleteUser() {
+	objID := suite.testUser.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errs.NewInfraError("record not found"))
+	suite.userRepo.On("DeleteUser", objID).Return(nil)
+
+	suite.NoError(suite.service.DeleteUser(actorObjID, objID))
+	suite.Equal(entity.AuditActionUserDelete, suite.lastAudit().Action())
+	suite.Equal("target@example.com", suite.lastAudit().Detail()["email"], "削除したユーザーを後から特定できること")
+
+	suite.ErrorIs(suite.service.DeleteUser(actorObjID, actorObjID), admin.ErrCannotManageSelf)
+	suite.ErrorIs(suite.service.DeleteUser(actorObjID, "unknown"), admin.ErrUserNotFound)
+	suite.userRepo.AssertNumberOfCalls(suite.T(), "DeleteUser", 1)
+}
+
+// 監査ログを記録できない場合は、操作を取り消してエラーを返すこと
+func (suite *UserAdminServiceTestSuite) TestAuditFailureFailsAction() {
+	objID := suite.testUser.ObjID().Value()
+	suite.auditRepo.err = errors.New("db down")
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.sessionRepo.On("RevokeSessions", objID, mock.Anything).Return(true, nil)
+
+	suite.ErrorIs(suite.service.LogoutUser(actorObjID, objID), admin.ErrAuditLogFailed)
+	suite.True(suite.transactor.rolledBack, "操作と監査ログの記録をまとめて取り消すこと")
+}
+
+// 参照のみの操作も、監査ログを記録できない場合は結果を返さないこと
+func (suite *UserAdminServiceTestSuite) TestAuditFailureFailsView() {
+	objID := suite.testUser.ObjID().Value()
+	suite.auditRepo.err = errors.New("db down")
+	suite.userRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+
+	user, err := suite.service.GetUser(actorObjID, objID)
+	suite.ErrorIs(err, admin.ErrAuditLogFailed)
+	suite.Nil(user)
+}
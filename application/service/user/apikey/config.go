@@ -0,0 +1,19 @@
+package apikey
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	MaxPerUser int           // ユーザーが持てる有効な API キーの上限
+	MaxTTL     time.Duration // API キーの有効期限の上限（期限を指定しない場合の期限。0 の場合は無期限を許可する）
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		MaxPerUser: utils.GetEnvInt("API_KEY_MAX_PER_USER", 20),
+		MaxTTL:     utils.GetEnvDuration("API_KEY_MAX_TTL", 365*24*time.Hour),
+	}
+}
@@ -0,0 +1,161 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+var (
+	ErrInvalidAPIKey  = errs.NewServiceError("invalid api key name, scopes or expiry")
+	ErrAPIKeyNotFound = errs.NewServiceError("api key not found")
+	ErrTooManyAPIKeys = errs.NewServiceError("too many api keys")
+	ErrAPIKeyRejected = errs.NewServiceError("api key is invalid or expired")
+	errGenerateAPIKey = errs.NewServiceError("failed to generate api key")
+)
+
+// touchInterval は、最終使用日時を更新する間隔（リクエストのたびに書き込まないようにする）
+const touchInterval = time.Minute
+
+type UserAPIKeyService interface {
+	// CreateAPIKey: API キーを作成し、キーそのもの（作成時にのみ返す）とともに返す
+	CreateAPIKey(objID string, name string, scopes []string, expiresAt *time.Time) (secret string, key *entity.APIKey, err error)
+	// ListAPIKeys: ユーザーの API キーを取得（有効期限切れを含む）
+	ListAPIKeys(objID string) ([]*entity.APIKey, error)
+	// RevokeAPIKey: ユーザーの API キーを取り消す
+	RevokeAPIKey(objID string, keyID string) error
+	// Authenticate: キーそのものから有効な API キーを取得し、最終使用日時を記録する
+	Authenticate(secret string) (*entity.APIKey, error)
+	// WithRepository: 同じ設定で apiKeyRepository を使う UserAPIKeyService を返す（トランザクションの中で API キーを操作する場合に使う）
+	WithRepository(apiKeyRepository repository.APIKeyRepository) UserAPIKeyService
+}
+
+// userAPIKeyService は UserAPIKeyService の実装
+type userAPIKeyService struct {
+	apiKeyRepository repository.APIKeyRepository
+	config           *Config
+}
+
+// NewUserAPIKeyService は UserAPIKeyService のインスタンスを作成
+func NewUserAPIKeyService(apiKeyRepository repository.APIKeyRepository, config *Config) UserAPIKeyService {
+	return &userAPIKeyService{
+		apiKeyRepository: apiKeyRepository,
+		config:           config,
+	}
+}
+
+// WithRepository は、リポジトリだけを差し替えた UserAPIKeyService を返す
+func (s *userAPIKeyService) WithRepository(apiKeyRepository repository.APIKeyRepository) UserAPIKeyService {
+	return NewUserAPIKeyService(apiKeyRepository, s.config)
+}
+
+// CreateAPIKey は API キーを作成する
+// 有効期限を指定しない場合は MaxTTL 後を期限とし、MaxTTL より先の期限は指定できない。
+func (s *userAPIKeyService) CreateAPIKey(objID string, name string, scopes []string, expiresAt *time.Time) (string, *entity.APIKey, error) {
+	userObjID, err := value.NewUserObjID(objID)
+	if err != nil {
+		return "", nil, ErrInvalidAPIKey
+	}
+	scopeValues := make([]*value.APIKeyScope, 0, len(scopes))
+	for _, name := range scopes {
+		scope, err := value.NewAPIKeyScope(name)
+		if err != nil {
+			return "", nil, ErrInvalidAPIKey
+		}
+		scopeValues = append(scopeValues, scope)
+	}
+	now := time.Now()
+	if s.config.MaxTTL > 0 {
+		limit := now.Add(s.config.MaxTTL)
+		if expiresAt == nil {
+			expiresAt = &limit
+		} else if expiresAt.After(limit) {
+			return "", nil, ErrInvalidAPIKey
+		}
+	}
+
+	keys, err := s.apiKeyRepository.ListAPIKeys(objID)
+	if err != nil {
+		return "", nil, errs.NewServiceError("failed to get api keys")
+	}
+	active := 0
+	for _, key := range keys {
+		if !key.IsExpired(now) {
+			active++
+		}
+	}
+	if active >= s.config.MaxPerUser {
+		return "", nil, ErrTooManyAPIKeys
+	}
+
+	secret, err := generateAPIKeySecret()
+	if err != nil {
+		return "", nil, errGenerateAPIKey
+	}
+	key, err := entity.NewAPIKey(userObjID, name, secret, scopeValues, expiresAt)
+	if err != nil {
+		return "", nil, ErrInvalidAPIKey
+	}
+	if _, err := s.apiKeyRepository.CreateAPIKey(key); err != nil {
+		return "", nil, errs.NewServiceError("failed to save api key in repository")
+	}
+	return secret, key, nil
+}
+
+// ListAPIKeys はユーザーの API キーを返す（有効期限切れのキーも、取り消せるよう含める）
+func (s *userAPIKeyService) ListAPIKeys(objID string) ([]*entity.APIKey, error) {
+	keys, err := s.apiKeyRepository.ListAPIKeys(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get api keys")
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey は API キーを取り消す。以降そのキーでは API を利用できない
+func (s *userAPIKeyService) RevokeAPIKey(objID string, keyID string) error {
+	deleted, err := s.apiKeyRepository.DeleteAPIKey(objID, keyID)
+	if err != nil {
+		return errs.NewServiceError("failed to delete api key")
+	}
+	if !deleted {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// Authenticate はキーそのもののハッシュで API キーを照合する
+// 最終使用日時の更新に失敗しても認証は成功とし、ログに記録する。
+func (s *userAPIKeyService) Authenticate(secret string) (*entity.APIKey, error) {
+	if !entity.IsAPIKeySecret(secret) {
+		return nil, ErrAPIKeyRejected
+	}
+	key, err := s.apiKeyRepository.FindAPIKeyByHash(entity.HashAPIKeySecret(secret))
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get api key")
+	}
+	now := time.Now()
+	if key == nil || key.IsExpired(now) {
+		return nil, ErrAPIKeyRejected
+	}
+	if lastUsedAt := key.LastUsedAt(); lastUsedAt == nil || now.Sub(*lastUsedAt) >= touchInterval {
+		if err := s.apiKeyRepository.TouchAPIKey(key.ID(), now); err != nil {
+			logger.Warn("failed to update api key usage", "key", key.ID(), "error", err.Error())
+		}
+	}
+	return key, nil
+}
+
+// generateAPIKeySecret は APIKeyPrefix で始まるランダムなキー（256 ビット）を生成する
+func generateAPIKeySecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return entity.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,261 @@
+package apikey_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/apikey"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+// --- テスト用リポジトリ ---
+
+// memoryAPIKeyRepository は API キーをメモリ上に保持するテスト用リポジトリ
+type memoryAPIKeyRepository struct {
+	keys       []*entity.APIKey
+	touchCalls int   // TouchAPIKey が呼ばれた回数
+	err        error // 指定した場合はすべての操作でエラーを返す
+}
+
+func (r *memoryAPIKeyRepository) CreateAPIKey(key *entity.APIKey) (*entity.APIKey, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.keys = append(r.keys, key)
+	return key, nil
+}
+
+func (r *memoryAPIKeyRepository) FindAPIKeyByHash(secretHash string) (*entity.APIKey, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, key := range r.keys {
+		if key.SecretHash() == secretHash {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryAPIKeyRepository) ListAPIKeys(userObjID string) ([]*entity.APIKey, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var keys []*entity.APIKey
+	for _, key := range r.keys {
+		if key.UserObjID().Value() == userObjID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (r *memoryAPIKeyRepository) TouchAPIKey(id string, usedAt time.Time) error {
+	r.touchCalls++
+	for i, key := range r.keys {
+		if key.ID() == id {
+			r.keys[i], _ = entity.BuildAPIKey(key.ID(), key.UserObjID(), key.Name(), key.Prefix(), key.SecretHash(), key.Scopes(), key.ExpiresAt(), key.CreatedAt(), &usedAt)
+		}
+	}
+	return r.err
+}
+
+func (r *memoryAPIKeyRepository) DeleteAPIKey(userObjID string, id string) (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+	before := len(r.keys)
+	r.keys = slices.DeleteFunc(r.keys, func(key *entity.APIKey) bool {
+		return key.ID() == id && key.UserObjID().Value() == userObjID
+	})
+	return len(r.keys) < before, nil
+}
+
+// --- テストスイート ---
+
+const (
+	ownerObjID = "11111111-1111-1111-1111-111111111111"
+	otherObjID = "22222222-2222-2222-2222-222222222222"
+)
+
+type UserAPIKeyServiceTestSuite struct {
+	suite.Suite
+	apiKeyRepo *memoryAPIKeyRepository
+	config     *apikey.Config
+	service    apikey.UserAPIKeyService
+}
+
+func TestUserAPIKeyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserAPIKeyServiceTestSuite))
+}
+
+func (suite *UserAPIKeyServiceTestSuite) SetupTest() {
+	suite.apiKeyRepo = &memoryAPIKeyRepository{}
+	suite.config = &apikey.Config{MaxPerUser: 3, MaxTTL: 30 * 24 * time.Hour}
+	suite.service = apikey.NewUserAPIKeyService(suite.apiKeyRepo, suite.config)
+}
+
+// ----- CreateAPIKey のテスト -----
+
+func (suite *UserAPIKeyServiceTestSuite) TestCreateAPIKey() {
+	secret, key, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeProfileRead}, nil)
+	suite.Require().NoError(err)
+	suite.True(strings.HasPrefix(secret, entity.APIKeyPrefix))
+	suite.Greater(len(secret), 40, "十分な長さのランダムなキーであること")
+	suite.True(strings.HasPrefix(secret, key.Prefix()))
+	suite.Equal(entity.HashAPIKeySecret(secret), key.SecretHash(), "キーそのものではなくハッシュを保存すること")
+	suite.Equal(ownerObjID, key.UserObjID().Value())
+	suite.Require().NotNil(key.ExpiresAt(), "有効期限を指定しない場合は上限の期限を設定すること")
+	suite.WithinDuration(time.Now().Add(suite.config.MaxTTL), *key.ExpiresAt(), time.Minute)
+	suite.Len(suite.apiKeyRepo.keys, 1)
+
+	other, _, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeProfileRead}, nil)
+	suite.NoError(err)
+	suite.NotEqual(secret, other, "キーは作成のたびに異なること")
+}
+
+func (suite *UserAPIKeyServiceTestSuite) TestCreateAPIKey_ExpiresAt() {
+	expiresAt := time.Now().Add(time.Hour)
+	_, key, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeAll}, &expiresAt)
+	suite.NoError(err)
+	suite.Equal(expiresAt, *key.ExpiresAt())
+
+	tooLate := time.Now().Add(suite.config.MaxTTL + time.Hour)
+	_, _, err = suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeAll}, &tooLate)
+	suite.ErrorIs(err, apikey.ErrInvalidAPIKey, "上限より先の期限は指定できないこと")
+
+	// 上限がない場合は無期限のキーを作成できる
+	suite.config.MaxTTL = 0
+	_, key, err = suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeAll}, nil)
+	suite.NoError(err)
+	suite.Nil(key.ExpiresAt())
+}
+
+func (suite *UserAPIKeyServiceTestSuite) TestCreateAPIKey_Invalid() {
+	past := time.Now().Add(-time.Minute)
+	cases := []struct {
+		name      string
+		keyName   string
+		scopes    []string
+		expiresAt *time.Time
+	}{
+		{"名前が空", " ", []string{value.APIKeyScopeAll}, nil},
+		{"スコープなし", "CI", nil, nil},
+		{"未知のスコープ", "CI", []string{"unknown:scope"}, nil},
+		{"過去の有効期限", "CI", []string{value.APIKeyScopeAll}, &past},
+	}
+	for _, tc := range cases {
+		_, _, err := suite.service.CreateAPIKey(ownerObjID, tc.keyName, tc.scopes, tc.expiresAt)
+		suite.ErrorIs(err, apikey.ErrInvalidAPIKey, tc.name)
+	}
+	suite.Empty(suite.apiKeyRepo.keys)
+}
+
+func (suite *UserAPIKeyServiceTestSuite) TestCreateAPIKey_TooMany() {
+	for range suite.config.MaxPerUser {
+		_, _, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeAll}, nil)
+		suite.Require().NoError(err)
+	}
+	_, _, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeAll}, nil)
+	suite.ErrorIs(err, apikey.ErrTooManyAPIKeys)
+
+	// 他のユーザーのキーは数えない
+	_, _, err = suite.service.CreateAPIKey(otherObjID, "CI", []string{value.APIKeyScopeAll}, nil)
+	suite.NoError(err)
+}
+
+// ----- ListAPIKeys / RevokeAPIKey のテスト -----
+
+func (suite *UserAPIKeyServiceTestSuite) TestListAPIKeys() {
+	_, _, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeAll}, nil)
+	suite.Require().NoError(err)
+	_, _, err = suite.service.CreateAPIKey(otherObjID, "Other", []string{value.APIKeyScopeAll}, nil)
+	suite.Require().NoError(err)
+
+	keys, err := suite.service.ListAPIKeys(ownerObjID)
+	suite.NoError(err)
+	suite.Require().Len(keys, 1)
+	suite.Equal("CI", keys[0].Name())
+
+	suite.apiKeyRepo.err = errors.New("db error")
+	_, err = suite.service.ListAPIKeys(ownerObjID)
+	suite.Error(err)
+}
+
+func (suite *UserAPIKeyServiceTestSuite) TestRevokeAPIKey() {
+	secret, key, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeAll}, nil)
+	suite.Require().NoError(err)
+
+	suite.ErrorIs(suite.service.RevokeAPIKey(otherObjID, key.ID()), apikey.ErrAPIKeyNotFound, "他のユーザーのキーは取り消せないこと")
+	suite.NoError(suite.service.RevokeAPIKey(ownerObjID, key.ID()))
+	suite.ErrorIs(suite.service.RevokeAPIKey(ownerObjID, key.ID()), apikey.ErrAPIKeyNotFound)
+
+	_, err = suite.service.Authenticate(secret)
+	suite.ErrorIs(err, apikey.ErrAPIKeyRejected, "取り消したキーでは認証できないこと")
+}
+
+// ----- Authenticate のテスト -----
+
+func (suite *UserAPIKeyServiceTestSuite) TestAuthenticate() {
+	secret, key, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeProfileRead}, nil)
+	suite.Require().NoError(err)
+
+	authenticated, err := suite.service.Authenticate(secret)
+	suite.Require().NoError(err)
+	suite.Equal(key.ID(), authenticated.ID())
+	suite.Equal(1, suite.apiKeyRepo.touchCalls, "最終使用日時を記録すること")
+	keys, _ := suite.apiKeyRepo.ListAPIKeys(ownerObjID)
+	suite.Require().NotNil(keys[0].LastUsedAt())
+
+	// 直前に記録した場合は更新しない
+	_, err = suite.service.Authenticate(secret)
+	suite.NoError(err)
+	suite.Equal(1, suite.apiKeyRepo.touchCalls)
+}
+
+func (suite *UserAPIKeyServiceTestSuite) TestAuthenticate_Rejected() {
+	_, err := suite.service.Authenticate(entity.APIKeyPrefix + "unknown-secret")
+	suite.ErrorIs(err, apikey.ErrAPIKeyRejected, "存在しないキーは拒否すること")
+	_, err = suite.service.Authenticate("not-an-api-key")
+	suite.ErrorIs(err, apikey.ErrAPIKeyRejected)
+
+	// 有効期限切れのキーは拒否する
+	secret := entity.APIKeyPrefix + "expired-secret-0123456789"
+	userObjID, _ := value.NewUserObjID(ownerObjID)
+	scope, _ := value.NewAPIKeyScope(value.APIKeyScopeAll)
+	expiresAt := time.Now().Add(-time.Minute)
+	expired, err := entity.BuildAPIKey("expired", userObjID, "CI", secret[:12], entity.HashAPIKeySecret(secret), []*value.APIKeyScope{scope}, &expiresAt, time.Now().Add(-time.Hour), nil)
+	suite.Require().NoError(err)
+	suite.apiKeyRepo.keys = append(suite.apiKeyRepo.keys, expired)
+	_, err = suite.service.Authenticate(secret)
+	suite.ErrorIs(err, apikey.ErrAPIKeyRejected)
+	suite.Zero(suite.apiKeyRepo.touchCalls)
+}
+
+func (suite *UserAPIKeyServiceTestSuite) TestAuthenticate_TouchFailure() {
+	secret, _, err := suite.service.CreateAPIKey(ownerObjID, "CI", []string{value.APIKeyScopeAll}, nil)
+	suite.Require().NoError(err)
+	key, _ := suite.apiKeyRepo.FindAPIKeyByHash(entity.HashAPIKeySecret(secret))
+
+	// 最終使用日時の更新のみ失敗させる
+	failing := &touchFailingRepository{memoryAPIKeyRepository: suite.apiKeyRepo}
+	service := apikey.NewUserAPIKeyService(failing, suite.config)
+	authenticated, err := service.Authenticate(secret)
+	suite.NoError(err, "最終使用日時の更新に失敗しても認証は成功すること")
+	suite.Equal(key.ID(), authenticated.ID())
+}
+
+// touchFailingRepository は最終使用日時の更新のみ失敗するリポジトリ
+type touchFailingRepository struct {
+	*memoryAPIKeyRepository
+}
+
+func (r *touchFailingRepository) TouchAPIKey(string, time.Time) error {
+	return errors.New("db error")
+}
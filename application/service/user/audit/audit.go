@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+// ErrAuditLogFailed は、監査ログを記録できなかったことを表す（呼び出し側は操作を取り消す）
+var ErrAuditLogFailed = errs.NewServiceError("failed to record audit log")
+
+// Record は管理者による操作を auditLogs に記録する
+// 変更を伴う操作では、操作と同じトランザクションのリポジトリ（repository.TxRepositories の AuditLogs）を渡し、
+// 記録できない場合に返す ErrAuditLogFailed でトランザクションごと取り消す
+// 操作したアカウントの種類（人かサービスアカウントか）は users から取得する
+func Record(users repository.UserRepository, auditLogs repository.AuditLogRepository, actorObjID string, action string, targetObjID string, detail map[string]string) error {
+	actor, err := value.NewUserObjID(actorObjID)
+	if err != nil {
+		logger.Error("failed to record audit log", "actor", actorObjID, "action", action, "target", targetObjID, "error", err.Error())
+		return ErrAuditLogFailed
+	}
+	log, err := entity.NewAuditLog(actor, actorKind(users, actorObjID), action, targetObjID, detail)
+	if err != nil {
+		logger.Error("failed to record audit log", "actor", actorObjID, "action", action, "target", targetObjID, "error", err.Error())
+		return ErrAuditLogFailed
+	}
+	if err := auditLogs.SaveAuditLog(log); err != nil {
+		logger.Error("failed to record audit log", "actor", actorObjID, "action", action, "target", targetObjID, "error", err.Error())
+		return ErrAuditLogFailed
+	}
+	return nil
+}
+
+// actorKind は操作したアカウントの種類を返す
+// 取得できない場合も監査ログの記録は行う（種類は user として記録する）
+func actorKind(users repository.UserRepository, actorObjID string) *value.UserKind {
+	actor, err := users.GetUserByObjID(actorObjID)
+	if err != nil {
+		logger.Error("failed to resolve audit log actor", "actor", actorObjID, "error", err.Error())
+		return nil
+	}
+	return actor.Kind()
+}
@@ -0,0 +1,90 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/audit"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+const actorObjID = "11111111-1111-1111-1111-111111111111"
+
+// stubUserRepository は登録されたユーザーのみを返すテスト用リポジトリ
+type stubUserRepository struct {
+	users map[string]*entity.User
+}
+
+func (r *stubUserRepository) CreateUser(user *entity.User) (*entity.User, error) { return user, nil }
+func (r *stubUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	return nil, errors.New("not found")
+}
+func (r *stubUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	if user, ok := r.users[objID]; ok {
+		return user, nil
+	}
+	return nil, errors.New("not found")
+}
+func (r *stubUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	return nil, nil
+}
+func (r *stubUserRepository) UpdateUser(user *entity.User) (*entity.User, error) { return user, nil }
+func (r *stubUserRepository) DeleteUser(objID string) error                      { return nil }
+
+// recordingAuditLogRepository は記録された監査ログを保持するテスト用リポジトリ（err を設定すると記録に失敗する）
+type recordingAuditLogRepository struct {
+	logs []*entity.AuditLog
+	err  error
+}
+
+func (r *recordingAuditLogRepository) SaveAuditLog(log *entity.AuditLog) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+func newServiceAccount(t *testing.T) *entity.User {
+	objID, _ := value.NewUserObjID(actorObjID)
+	email, _ := value.NewUserEmail("ci@service-account.invalid")
+	username, _ := value.NewUserUsername("ci-bot")
+	kind, _ := value.NewUserKind(value.KindServiceAccount)
+	user, err := entity.BuildUser(objID, email, value.NoPassword(), username, nil, nil, nil, nil, nil, "", nil, nil, kind)
+	assert.NoError(t, err)
+	return user
+}
+
+// 操作したアカウントの種類とともに記録すること
+func TestRecord(t *testing.T) {
+	users := &stubUserRepository{users: map[string]*entity.User{actorObjID: newServiceAccount(t)}}
+	auditLogs := &recordingAuditLogRepository{}
+
+	err := audit.Record(users, auditLogs, actorObjID, entity.AuditActionRoleCreate, "target", map[string]string{"role": "support"})
+	assert.NoError(t, err)
+	if assert.Len(t, auditLogs.logs, 1) {
+		log := auditLogs.logs[0]
+		assert.Equal(t, entity.AuditActionRoleCreate, log.Action())
+		assert.Equal(t, "target", log.TargetObjID())
+		assert.Equal(t, value.KindServiceAccount, log.ActorKind().Value())
+	}
+
+	// 操作したアカウントを取得できない場合は user として記録すること
+	err = audit.Record(&stubUserRepository{}, auditLogs, actorObjID, entity.AuditActionRoleCreate, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, value.KindUser, auditLogs.logs[1].ActorKind().Value())
+}
+
+// 記録できない場合は ErrAuditLogFailed を返すこと
+func TestRecord_Failure(t *testing.T) {
+	users := &stubUserRepository{}
+
+	err := audit.Record(users, &recordingAuditLogRepository{err: errors.New("db down")}, actorObjID, entity.AuditActionRoleCreate, "", nil)
+	assert.ErrorIs(t, err, audit.ErrAuditLogFailed)
+	err = audit.Record(users, &recordingAuditLogRepository{}, "invalid", entity.AuditActionRoleCreate, "", nil)
+	assert.ErrorIs(t, err, audit.ErrAuditLogFailed)
+}
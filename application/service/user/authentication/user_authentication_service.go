@@ -1,63 +1,225 @@
 package authentication
 
 import (
+	"errors"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/trusteddevice"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/domain/user/repository"
 	"github.com/goda6565/nexus-user-auth/errs"
 	"github.com/goda6565/nexus-user-auth/pkg/utils"
 )
 
+var (
+	ErrEmailNotVerified = errs.NewServiceError("email not verified")
+)
+
+type Config struct {
+	RequireVerifiedEmail bool          // メールアドレス未確認のユーザーのログインを拒否する
+	MFAChallengeTTL      time.Duration // 二要素認証のチャレンジトークンの有効期限
+}
+
+// LoginResult はログインの結果
+// 二要素認証が有効なユーザーの場合はトークンの代わりに MFAToken を返し、
+// 二要素目の検証後に改めてトークンを発行する。
+type LoginResult struct {
+	AccessToken  string
+	RefreshToken string
+	MFARequired  bool
+	MFAToken     string
+	MFAMethods   []string // 二要素目に使える方法（MFAMethodTOTP, MFAMethodPasskey）
+}
+
+// 二要素目に使える方法
+const (
+	MFAMethodTOTP    = "totp"
+	MFAMethodPasskey = "passkey"
+)
+
 type UserAuthenticationService interface {
-	// UserLogin: ユーザーログイン
-	UserLogin(email string, password string) (accessToken string, refreshToken string, err error)
-	// UserTokenRefresh: トークンリフレッシュ
+	// UserLogin: ユーザーログイン（deviceToken は信頼済み端末のトークン。ない場合は空文字）
+	// 失敗が続いている場合は *lockout.LockoutError を返す
+	UserLogin(email string, password string, deviceToken string, client loginhistory.ClientInfo) (*LoginResult, error)
+	// CompleteLogin: 一要素目の認証を終えたユーザーのログインを完了する（パスワードレスログインなど）
+	// method には一要素目の認証方法（utils.AMRPassword など）を指定する
+	CompleteLogin(user *entity.User, method string, deviceToken string, client loginhistory.ClientInfo) (*LoginResult, error)
+	// UserTokenRefresh: トークンリフレッシュ（有効でないアカウントの場合は entity.ErrAccountPending などを返す）
 	UserTokenRefresh(refreshToken string) (accessToken string, err error)
 }
 
 // userAuthenticationService は UserAuthenticationService の実装
 type userAuthenticationService struct {
-	userRepository repository.UserRepository
+	userRepository    repository.UserRepository
+	mfaRepository     repository.MFARepository
+	passkeyRepository repository.PasskeyRepository
+	sessionRepository repository.SessionRepository
+	trustedDevices    trusteddevice.UserTrustedDeviceService
+	lockout           lockout.UserLockoutService
+	loginHistory      loginhistory.UserLoginHistoryService
+	tokens            *utils.TokenSigner
+	config            *Config
 }
 
 // NewUserAuthenticationService は UserAuthenticationService のインスタンスを作成
-func NewUserAuthenticationService(userRepository repository.UserRepository) UserAuthenticationService {
+func NewUserAuthenticationService(userRepository repository.UserRepository, mfaRepository repository.MFARepository, passkeyRepository repository.PasskeyRepository, sessionRepository repository.SessionRepository, trustedDevices trusteddevice.UserTrustedDeviceService, lockout lockout.UserLockoutService, loginHistory loginhistory.UserLoginHistoryService, tokens *utils.TokenSigner, config *Config) UserAuthenticationService {
 	return &userAuthenticationService{
-		userRepository: userRepository,
+		userRepository:    userRepository,
+		mfaRepository:     mfaRepository,
+		passkeyRepository: passkeyRepository,
+		sessionRepository: sessionRepository,
+		trustedDevices:    trustedDevices,
+		lockout:           lockout,
+		loginHistory:      loginHistory,
+		tokens:            tokens,
+		config:            config,
 	}
 }
 
 // UserLogin はユーザー認証を行い、アクセストークンとリフレッシュトークンを発行
-func (s *userAuthenticationService) UserLogin(email string, password string) (string, string, error) {
+// 二要素認証が有効な場合はトークンを発行せず、チャレンジトークンを返す
+// アカウント・接続元ごとの失敗が続いている場合は、パスワードを検証せずに拒否する
+// アカウントの失敗回数は、ログインが完了した（トークンを発行した）場合にのみ戻す
+// 登録済みのユーザーの試行は、成功・失敗ともにログイン履歴に記録する
+func (s *userAuthenticationService) UserLogin(email string, password string, deviceToken string, client loginhistory.ClientInfo) (*LoginResult, error) {
+	if err := s.lockout.Check(email, client.IP); err != nil {
+		if user, findErr := s.userRepository.GetUserByEmail(email); findErr == nil {
+			reason := entity.LoginReasonThrottled
+			if errors.Is(err, lockout.ErrAccountLocked) {
+				reason = entity.LoginReasonAccountLocked
+			}
+			s.loginHistory.RecordFailure(user.ObjID().Value(), []string{utils.AMRPassword}, reason, client)
+		}
+		return nil, err
+	}
+
 	// ユーザー取得
 	user, err := s.userRepository.GetUserByEmail(email)
 	if err != nil {
-		return "", "", errs.NewServiceError("invalid email or password")
+		return nil, s.loginFailed(email, client)
 	}
 
 	// パスワードの検証
 	err = utils.CheckPassword(user.Password().Value(), password)
 	if err != nil {
-		return "", "", errs.NewServiceError("invalid email or password")
+		s.loginHistory.RecordFailure(user.ObjID().Value(), []string{utils.AMRPassword}, entity.LoginReasonInvalidCredentials, client)
+		return nil, s.loginFailed(email, client)
+	}
+
+	result, err := s.CompleteLogin(user, utils.AMRPassword, deviceToken, client)
+	if err != nil {
+		return nil, err
+	}
+	// 失敗回数を戻すのはトークンを発行した時点とする（二要素目が必要な場合は、二要素目の検証後に戻す）
+	if !result.MFARequired {
+		s.lockout.RecordSuccess(email)
+	}
+	return result, nil
+}
+
+// loginFailed はログインの失敗を記録し、返すエラーを決める（この失敗でロックした場合はロックのエラー）
+// 登録の有無を推測されないよう、未登録のメールアドレスとパスワードの誤りは同じエラーにする
+func (s *userAuthenticationService) loginFailed(email string, client loginhistory.ClientInfo) error {
+	if err := s.lockout.RecordFailure(email, client.IP); err != nil {
+		return err
+	}
+	return errs.NewServiceError("invalid email or password")
+}
+
+// CompleteLogin は一要素目の認証を終えたユーザーにトークンを発行
+// パスワード以外の方法でログインする場合も、有効でないアカウント・未確認メールアドレスの拒否、二要素認証の判定を同じ経路で行う
+// 信頼済み端末からのログインでは二要素目を省略する（発行するトークンは一要素目のみの認証として扱う）
+func (s *userAuthenticationService) CompleteLogin(user *entity.User, method string, deviceToken string, client loginhistory.ClientInfo) (*LoginResult, error) {
+	// サービスアカウントはログインできない（API キーまたはクライアント資格情報で認証する）
+	if user.IsServiceAccount() {
+		s.loginHistory.RecordFailure(user.ObjID().Value(), []string{method}, entity.LoginReasonServiceAccount, client)
+		return nil, entity.ErrServiceAccount
+	}
+
+	// 有効でないアカウント（有効化待ち・停止・無効化）を状態ごとのエラーで拒否
+	if err := user.CheckActive(); err != nil {
+		s.loginHistory.RecordFailure(user.ObjID().Value(), []string{method}, entity.LoginReasonAccountInactive, client)
+		return nil, err
+	}
+
+	// メールアドレス未確認のユーザーを拒否（パスワード検証後に判定し、存在有無を漏らさない）
+	if s.config.RequireVerifiedEmail && !user.IsEmailVerified() {
+		s.loginHistory.RecordFailure(user.ObjID().Value(), []string{method}, entity.LoginReasonEmailNotVerified, client)
+		return nil, ErrEmailNotVerified
+	}
+
+	// 二要素認証が有効な場合はチャレンジトークンのみ発行する（判定できない場合はログインさせない）
+	methods, err := s.mfaMethods(user.ObjID().Value())
+	if err != nil {
+		return nil, err
+	}
+	if len(methods) > 0 && !s.trustedDevices.IsTrusted(user.ObjID().Value(), deviceToken) {
+		// 一要素目の方法をチャレンジトークンに含め、二要素目の検証後に発行するトークンの amr に引き継ぐ
+		mfaToken, err := s.tokens.GenerateActionTokenWithAMR(utils.PurposeMFAChallenge, user.ObjID().Value(), []string{method}, s.config.MFAChallengeTTL)
+		if err != nil {
+			return nil, errs.NewServiceError("failed to generate mfa token")
+		}
+		return &LoginResult{MFARequired: true, MFAToken: mfaToken, MFAMethods: methods}, nil
 	}
 
 	// トークン生成
-	accessToken, refreshToken, err := utils.GenerateTokens(user.ObjID().Value())
+	auth := utils.NewAuthContext(method)
+	accessToken, refreshToken, err := s.tokens.GenerateTokens(user.ObjID().Value(), auth)
 	if err != nil {
-		return "", "", errs.NewServiceError("failed to generate tokens")
+		return nil, errs.NewServiceError("failed to generate tokens")
 	}
+	s.loginHistory.RecordSuccess(user.ObjID().Value(), auth.AMR, client)
 
-	return accessToken, refreshToken, nil
+	return &LoginResult{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// mfaMethods はユーザーが二要素目に使える方法を返す（確認済みの TOTP、登録済みのパスキー）
+func (s *userAuthenticationService) mfaMethods(objID string) ([]string, error) {
+	var methods []string
+	factor, err := s.mfaRepository.FindTOTPFactor(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get totp factor")
+	}
+	if factor != nil && factor.IsConfirmed() {
+		methods = append(methods, MFAMethodTOTP)
+	}
+	passkeys, err := s.passkeyRepository.ListCredentials(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get passkeys")
+	}
+	if len(passkeys) > 0 {
+		methods = append(methods, MFAMethodPasskey)
+	}
+	return methods, nil
 }
 
 // UserTokenRefresh はリフレッシュトークンを用いて新しいアクセストークンを発行
+// ユーザーがトークンを無効にした日時より前に発行されたリフレッシュトークン、有効でないアカウントのリフレッシュトークンは拒否する
 func (s *userAuthenticationService) UserTokenRefresh(refreshToken string) (string, error) {
 	// リフレッシュトークンを検証
-	_, err := utils.ValidateRefreshToken(refreshToken)
+	claims, err := s.tokens.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return "", errs.NewServiceError("invalid refresh token")
 	}
+	revokedAt, err := s.sessionRepository.GetSessionsRevokedAt(claims.ObjID)
+	if err != nil {
+		return "", errs.NewServiceError("failed to get sessions revoked at")
+	}
+	if revokedAt != nil && claims.IssuedAt.Before(*revokedAt) {
+		return "", errs.NewServiceError("invalid refresh token")
+	}
+	user, err := s.userRepository.GetUserByObjID(claims.ObjID)
+	if err != nil {
+		return "", errs.NewServiceError("invalid refresh token")
+	}
+	if err := user.CheckActive(); err != nil {
+		return "", err
+	}
 
 	// 新しいアクセストークンを発行
-	newAccessToken, err := utils.RefreshAccessToken(refreshToken)
+	newAccessToken, err := s.tokens.RefreshAccessToken(refreshToken)
 	if err != nil {
 		return "", errs.NewServiceError("failed to refresh token")
 	}
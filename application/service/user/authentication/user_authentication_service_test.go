@@ -3,13 +3,19 @@ package authentication_test
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/goda6565/nexus-user-auth/application/service/user/authentication"
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/trusteddevice"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
 	"github.com/goda6565/nexus-user-auth/errs"
 	"github.com/goda6565/nexus-user-auth/pkg/utils"
@@ -53,18 +59,214 @@ func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error)
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
 func (m *mockUserRepository) DeleteUser(objID string) error {
 	args := m.Called(objID)
 	return args.Error(0)
 }
 
+type mockMFARepository struct {
+	mock.Mock
+}
+
+func (m *mockMFARepository) SaveTOTPFactor(factor *entity.TOTPFactor) (*entity.TOTPFactor, error) {
+	args := m.Called(factor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TOTPFactor), args.Error(1)
+}
+
+func (m *mockMFARepository) FindTOTPFactor(userObjID string) (*entity.TOTPFactor, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TOTPFactor), args.Error(1)
+}
+
+func (m *mockMFARepository) ConsumeTOTPStep(userObjID string, step int64) (bool, error) {
+	args := m.Called(userObjID, step)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockMFARepository) ReplaceRecoveryCodes(userObjID string, codes []*entity.RecoveryCode) error {
+	args := m.Called(userObjID, codes)
+	return args.Error(0)
+}
+
+func (m *mockMFARepository) UseRecoveryCode(userObjID string, codeHash string) (bool, error) {
+	args := m.Called(userObjID, codeHash)
+	return args.Bool(0), args.Error(1)
+}
+
+type mockPasskeyRepository struct {
+	mock.Mock
+}
+
+func (m *mockPasskeyRepository) CreateCredential(credential *entity.PasskeyCredential) (*entity.PasskeyCredential, error) {
+	args := m.Called(credential)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PasskeyCredential), args.Error(1)
+}
+
+func (m *mockPasskeyRepository) ListCredentials(userObjID string) ([]*entity.PasskeyCredential, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.PasskeyCredential), args.Error(1)
+}
+
+func (m *mockPasskeyRepository) UpdateCredentialUsage(credential *entity.PasskeyCredential) (bool, error) {
+	args := m.Called(credential)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPasskeyRepository) SaveSession(session *entity.PasskeySession) error {
+	args := m.Called(session)
+	return args.Error(0)
+}
+
+func (m *mockPasskeyRepository) ConsumeSession(id string) (*entity.PasskeySession, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PasskeySession), args.Error(1)
+}
+
+// --- モックの UserTrustedDeviceService ---
+
+type mockTrustedDeviceService struct {
+	mock.Mock
+}
+
+func (m *mockTrustedDeviceService) Remember(accessToken string, name string) (string, *entity.TrustedDevice, error) {
+	args := m.Called(accessToken, name)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*entity.TrustedDevice), args.Error(2)
+}
+
+func (m *mockTrustedDeviceService) IsTrusted(objID string, token string) bool {
+	args := m.Called(objID, token)
+	return args.Bool(0)
+}
+
+func (m *mockTrustedDeviceService) ListDevices(objID string) ([]*entity.TrustedDevice, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TrustedDevice), args.Error(1)
+}
+
+func (m *mockTrustedDeviceService) RevokeDevice(objID string, deviceID string) error {
+	args := m.Called(objID, deviceID)
+	return args.Error(0)
+}
+
+var _ trusteddevice.UserTrustedDeviceService = (*mockTrustedDeviceService)(nil)
+
+// --- モックの UserLockoutService ---
+
+type mockLockoutService struct {
+	mock.Mock
+}
+
+func (m *mockLockoutService) Check(email string, ip string) error {
+	args := m.Called(email, ip)
+	return args.Error(0)
+}
+
+func (m *mockLockoutService) RecordFailure(email string, ip string) error {
+	args := m.Called(email, ip)
+	return args.Error(0)
+}
+
+func (m *mockLockoutService) RecordSuccess(email string) {
+	m.Called(email)
+}
+
+func (m *mockLockoutService) Unlock(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *mockLockoutService) AdminUnlock(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+var _ lockout.UserLockoutService = (*mockLockoutService)(nil)
+
+// --- モックの UserLoginHistoryService ---
+
+type mockLoginHistoryService struct {
+	mock.Mock
+}
+
+func (m *mockLoginHistoryService) RecordSuccess(objID string, methods []string, client loginhistory.ClientInfo) {
+	m.Called(objID, methods, client)
+}
+
+func (m *mockLoginHistoryService) RecordFailure(objID string, methods []string, reason string, client loginhistory.ClientInfo) {
+	m.Called(objID, methods, reason, client)
+}
+
+func (m *mockLoginHistoryService) ListLoginHistory(objID string, page int, perPage int) (*loginhistory.LoginHistoryPage, error) {
+	args := m.Called(objID, page, perPage)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*loginhistory.LoginHistoryPage), args.Error(1)
+}
+
+var _ loginhistory.UserLoginHistoryService = (*mockLoginHistoryService)(nil)
+
+// --- モックの SessionRepository ---
+
+type mockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *mockSessionRepository) RevokeSessions(userObjID string, revokedAt time.Time) (bool, error) {
+	args := m.Called(userObjID, revokedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockSessionRepository) GetSessionsRevokedAt(userObjID string) (*time.Time, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
 // --- テストスイート ---
 
 type AuthServiceTestSuite struct {
 	suite.Suite
-	mockRepo *mockUserRepository
-	authServ authentication.UserAuthenticationService
-	testUser *entity.User
+	mockRepo    *mockUserRepository
+	mockMFA     *mockMFARepository
+	mockPasskey *mockPasskeyRepository
+	mockSession *mockSessionRepository
+	mockDevices *mockTrustedDeviceService
+	mockLockout *mockLockoutService
+	mockHistory *mockLoginHistoryService
+	authServ    authentication.UserAuthenticationService
+	testUser    *entity.User
 }
 
 // SetupSuite: JWT_SECRET_KEY のセットアップ
@@ -82,7 +284,20 @@ func (suite *AuthServiceTestSuite) TearDownSuite() {
 // SetupTest: 各テスト前のセットアップ
 func (suite *AuthServiceTestSuite) SetupTest() {
 	suite.mockRepo = new(mockUserRepository)
-	suite.authServ = authentication.NewUserAuthenticationService(suite.mockRepo)
+	suite.mockMFA = new(mockMFARepository)
+	suite.mockPasskey = new(mockPasskeyRepository)
+	suite.mockSession = new(mockSessionRepository)
+	suite.mockDevices = new(mockTrustedDeviceService)
+	// 信頼済み端末のトークンを指定しない場合は信頼しない
+	suite.mockDevices.On("IsTrusted", mock.Anything, "").Return(false).Maybe()
+	suite.mockLockout = new(mockLockoutService)
+	suite.mockLockout.On("Check", mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.mockLockout.On("RecordFailure", mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.mockLockout.On("RecordSuccess", mock.Anything).Maybe()
+	suite.mockHistory = new(mockLoginHistoryService)
+	suite.mockHistory.On("RecordSuccess", mock.Anything, mock.Anything, mock.Anything).Maybe()
+	suite.mockHistory.On("RecordFailure", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	suite.authServ = authentication.NewUserAuthenticationService(suite.mockRepo, suite.mockMFA, suite.mockPasskey, suite.mockSession, suite.mockDevices, suite.mockLockout, suite.mockHistory, utils.DefaultTokenSigner(), &authentication.Config{MFAChallengeTTL: 5 * time.Minute})
 
 	// テスト用ユーザー作成
 	emailVal, _ := value.NewUserEmail("test@example.com")
@@ -93,6 +308,9 @@ func (suite *AuthServiceTestSuite) SetupTest() {
 
 	testUser, err := entity.NewUser(emailVal, passwordVal, usernameVal)
 	suite.Require().NoError(err)
+	activatedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(testUser.Activate(activatedAt))
 	suite.testUser = testUser
 }
 
@@ -103,15 +321,158 @@ func (suite *AuthServiceTestSuite) TestUserLogin_Success() {
 	email := "test@example.com"
 	password := "correct-password"
 
-	// モック: GetUserByEmail が testUser を返す
+	// モック: GetUserByEmail が testUser を返す（二要素認証は未登録）
 	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(nil, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, nil)
 
-	accessToken, refreshToken, err := suite.authServ.UserLogin(email, password)
+	result, err := suite.authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
 	assert.NoError(suite.T(), err)
-	assert.NotEmpty(suite.T(), accessToken)
-	assert.NotEmpty(suite.T(), refreshToken)
+	assert.NotEmpty(suite.T(), result.AccessToken)
+	assert.NotEmpty(suite.T(), result.RefreshToken)
+	assert.False(suite.T(), result.MFARequired)
+	suite.mockLockout.AssertCalled(suite.T(), "RecordSuccess", email)
 
 	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockMFA.AssertExpectations(suite.T())
+	suite.mockPasskey.AssertExpectations(suite.T())
+}
+
+// UserLogin: 二要素認証が有効な場合はトークンの代わりにチャレンジトークンを返す
+func (suite *AuthServiceTestSuite) TestUserLogin_MFARequired() {
+	email := "test@example.com"
+	password := "correct-password"
+
+	factor, err := entity.NewTOTPFactor(suite.testUser.ObjID(), "JBSWY3DPEHPK3PXP")
+	suite.Require().NoError(err)
+	suite.Require().NoError(factor.Confirm(1, time.Now()))
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(factor, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, nil)
+
+	result, err := suite.authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.MFARequired)
+	assert.Equal(suite.T(), []string{authentication.MFAMethodTOTP}, result.MFAMethods)
+	assert.Empty(suite.T(), result.AccessToken, "二要素目の検証前にトークンを発行しないこと")
+	assert.Empty(suite.T(), result.RefreshToken)
+	// 二要素目の検証前に失敗回数を戻さないこと
+	suite.mockLockout.AssertNotCalled(suite.T(), "RecordSuccess", mock.Anything)
+
+	claims, err := utils.ValidateActionToken(utils.PurposeMFAChallenge, result.MFAToken)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.testUser.ObjID().Value(), claims.ObjID)
+
+	// チャレンジトークンはアクセストークンとして使えないこと
+	_, err = utils.ValidateToken(result.MFAToken)
+	assert.Error(suite.T(), err)
+}
+
+// UserLogin: 信頼済み端末からのログインでは二要素目を省略し、一要素目のみの認証としてトークンを発行する
+func (suite *AuthServiceTestSuite) TestUserLogin_TrustedDevice() {
+	email := "test@example.com"
+	password := "correct-password"
+
+	factor, err := entity.NewTOTPFactor(suite.testUser.ObjID(), "JBSWY3DPEHPK3PXP")
+	suite.Require().NoError(err)
+	suite.Require().NoError(factor.Confirm(1, time.Now()))
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(factor, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, nil)
+	suite.mockDevices.On("IsTrusted", suite.testUser.ObjID().Value(), "device-token").Return(true)
+
+	result, err := suite.authServ.UserLogin(email, password, "device-token", loginhistory.ClientInfo{})
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), result.MFARequired)
+	assert.NotEmpty(suite.T(), result.AccessToken)
+
+	claims, err := utils.ValidateToken(result.AccessToken)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), []string{utils.AMRPassword}, claims.AMR)
+	assert.Equal(suite.T(), utils.ACRSingleFactor, claims.ACR, "二要素目を省略した認証は多要素として扱わないこと")
+	suite.mockDevices.AssertExpectations(suite.T())
+}
+
+// UserLogin: 信頼されていない端末のトークンでは二要素認証を求める
+func (suite *AuthServiceTestSuite) TestUserLogin_UntrustedDevice() {
+	email := "test@example.com"
+	password := "correct-password"
+
+	factor, err := entity.NewTOTPFactor(suite.testUser.ObjID(), "JBSWY3DPEHPK3PXP")
+	suite.Require().NoError(err)
+	suite.Require().NoError(factor.Confirm(1, time.Now()))
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(factor, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, nil)
+	suite.mockDevices.On("IsTrusted", suite.testUser.ObjID().Value(), "revoked-token").Return(false)
+
+	result, err := suite.authServ.UserLogin(email, password, "revoked-token", loginhistory.ClientInfo{})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.MFARequired)
+	assert.Empty(suite.T(), result.AccessToken)
+}
+
+// UserLogin: 確認前の TOTP は二要素認証として扱わない
+func (suite *AuthServiceTestSuite) TestUserLogin_MFANotConfirmed() {
+	email := "test@example.com"
+	password := "correct-password"
+
+	factor, err := entity.NewTOTPFactor(suite.testUser.ObjID(), "JBSWY3DPEHPK3PXP")
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(factor, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, nil)
+
+	result, err := suite.authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), result.MFARequired)
+	assert.NotEmpty(suite.T(), result.AccessToken)
+}
+
+// UserLogin: パスキーを登録している場合も二要素認証を求める
+func (suite *AuthServiceTestSuite) TestUserLogin_MFARequiredByPasskey() {
+	email := "test@example.com"
+	password := "correct-password"
+
+	passkey, err := entity.NewPasskeyCredential(suite.testUser.ObjID(), []byte("credential-id"), "", []byte("public-key"), "none", nil, nil, 0, false, false)
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(nil, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return([]*entity.PasskeyCredential{passkey}, nil)
+
+	result, err := suite.authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.MFARequired)
+	assert.NotEmpty(suite.T(), result.MFAToken)
+	assert.Empty(suite.T(), result.AccessToken, "二要素目の検証前にトークンを発行しないこと")
+	assert.Equal(suite.T(), []string{authentication.MFAMethodPasskey}, result.MFAMethods)
+}
+
+// UserLogin: パスキーの登録状況を取得できない場合はログインさせない
+func (suite *AuthServiceTestSuite) TestUserLogin_PasskeyLookupFailed() {
+	email := "test@example.com"
+	password := "correct-password"
+
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(nil, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, errs.NewInfraError("db error"))
+
+	result, err := suite.authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+// UserLogin: 二要素認証の登録状況を取得できない場合はログインさせない
+func (suite *AuthServiceTestSuite) TestUserLogin_MFALookupFailed() {
+	email := "test@example.com"
+	password := "correct-password"
+
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(nil, errs.NewInfraError("db error"))
+
+	result, err := suite.authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
 }
 
 // UserLogin: 存在しないメールアドレスの場合
@@ -121,14 +482,47 @@ func (suite *AuthServiceTestSuite) TestUserLogin_InvalidEmail() {
 
 	suite.mockRepo.On("GetUserByEmail", email).Return(nil, errs.NewServiceError("user not found"))
 
-	accessToken, refreshToken, err := suite.authServ.UserLogin(email, password)
+	result, err := suite.authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
 	assert.Error(suite.T(), err)
-	assert.Empty(suite.T(), accessToken)
-	assert.Empty(suite.T(), refreshToken)
+	assert.Nil(suite.T(), result)
 
 	suite.mockRepo.AssertExpectations(suite.T())
 }
 
+// UserLogin: 失敗が続いている場合はパスワードを検証せずに拒否する
+func (suite *AuthServiceTestSuite) TestUserLogin_Locked() {
+	email := "test@example.com"
+	lockErr := &lockout.LockoutError{Err: lockout.ErrAccountLocked, RetryAfter: time.Minute}
+	suite.mockLockout = new(mockLockoutService)
+	suite.mockLockout.On("Check", email, "192.0.2.1").Return(lockErr)
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	authServ := authentication.NewUserAuthenticationService(suite.mockRepo, suite.mockMFA, suite.mockPasskey, suite.mockSession, suite.mockDevices, suite.mockLockout, suite.mockHistory, utils.DefaultTokenSigner(), &authentication.Config{})
+
+	client := loginhistory.ClientInfo{IP: "192.0.2.1", UserAgent: "Mozilla/5.0"}
+	result, err := authServ.UserLogin(email, "correct-password", "", client)
+	assert.ErrorIs(suite.T(), err, lockout.ErrAccountLocked)
+	assert.Nil(suite.T(), result)
+	suite.mockLockout.AssertNotCalled(suite.T(), "RecordSuccess", mock.Anything)
+	// 拒否した試行もロック中としてログイン履歴に記録すること
+	suite.mockHistory.AssertCalled(suite.T(), "RecordFailure", suite.testUser.ObjID().Value(), []string{utils.AMRPassword}, entity.LoginReasonAccountLocked, client)
+}
+
+// UserLogin: この失敗でロックした場合はロックのエラーを返す
+func (suite *AuthServiceTestSuite) TestUserLogin_LockedByFailure() {
+	email := "test@example.com"
+	lockErr := &lockout.LockoutError{Err: lockout.ErrAccountLocked, RetryAfter: time.Minute}
+	suite.mockLockout = new(mockLockoutService)
+	suite.mockLockout.On("Check", email, "192.0.2.1").Return(nil)
+	suite.mockLockout.On("RecordFailure", email, "192.0.2.1").Return(lockErr)
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	authServ := authentication.NewUserAuthenticationService(suite.mockRepo, suite.mockMFA, suite.mockPasskey, suite.mockSession, suite.mockDevices, suite.mockLockout, suite.mockHistory, utils.DefaultTokenSigner(), &authentication.Config{})
+
+	result, err := authServ.UserLogin(email, "wrong-password", "", loginhistory.ClientInfo{IP: "192.0.2.1"})
+	assert.ErrorIs(suite.T(), err, lockout.ErrAccountLocked)
+	assert.Nil(suite.T(), result)
+	suite.mockLockout.AssertExpectations(suite.T())
+}
+
 // UserLogin: パスワード不一致の場合
 func (suite *AuthServiceTestSuite) TestUserLogin_InvalidPassword() {
 	email := "test@example.com"
@@ -136,21 +530,187 @@ func (suite *AuthServiceTestSuite) TestUserLogin_InvalidPassword() {
 
 	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
 
-	accessToken, refreshToken, err := suite.authServ.UserLogin(email, wrongPassword)
+	result, err := suite.authServ.UserLogin(email, wrongPassword, "", loginhistory.ClientInfo{})
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockLockout.AssertCalled(suite.T(), "RecordFailure", email, "")
+	suite.mockLockout.AssertNotCalled(suite.T(), "RecordSuccess", mock.Anything)
+	suite.mockHistory.AssertCalled(suite.T(), "RecordFailure", suite.testUser.ObjID().Value(), []string{utils.AMRPassword}, entity.LoginReasonInvalidCredentials, loginhistory.ClientInfo{})
+	suite.mockHistory.AssertNotCalled(suite.T(), "RecordSuccess", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// UserLogin: メールアドレス未確認のユーザーのログインを拒否する設定の場合
+func (suite *AuthServiceTestSuite) TestUserLogin_EmailNotVerified() {
+	email := "test@example.com"
+	password := "correct-password"
+	authServ := authentication.NewUserAuthenticationService(suite.mockRepo, suite.mockMFA, suite.mockPasskey, suite.mockSession, suite.mockDevices, suite.mockLockout, suite.mockHistory, utils.DefaultTokenSigner(), &authentication.Config{RequireVerifiedEmail: true})
+
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+
+	result, err := authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
+	assert.ErrorIs(suite.T(), err, authentication.ErrEmailNotVerified)
+	assert.Nil(suite.T(), result)
+	suite.mockHistory.AssertCalled(suite.T(), "RecordFailure", suite.testUser.ObjID().Value(), []string{utils.AMRPassword}, entity.LoginReasonEmailNotVerified, loginhistory.ClientInfo{})
+
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+// UserLogin: 有効でないアカウントは状態ごとのエラーで拒否する
+func (suite *AuthServiceTestSuite) TestUserLogin_InactiveAccount() {
+	email := "test@example.com"
+	password := "correct-password"
+	changedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	emailVal, _ := value.NewUserEmail(email)
+	usernameVal, _ := value.NewUserUsername("testuser")
+
+	pending, err := entity.NewUser(emailVal, suite.testUser.Password(), usernameVal)
+	suite.Require().NoError(err)
+	suspended, err := entity.NewUser(emailVal, suite.testUser.Password(), usernameVal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suspended.Suspend("規約違反", changedAt))
+	deactivated, err := entity.NewUser(emailVal, suite.testUser.Password(), usernameVal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(deactivated.Activate(changedAt))
+	suite.Require().NoError(deactivated.Deactivate("", changedAt))
+
+	cases := []struct {
+		user *entity.User
+		err  error
+	}{
+		{pending, entity.ErrAccountPending},
+		{suspended, entity.ErrAccountSuspended},
+		{deactivated, entity.ErrAccountDeactivated},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockRepo.On("GetUserByEmail", email).Return(tc.user, nil)
+
+		result, err := suite.authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
+		assert.ErrorIs(suite.T(), err, tc.err)
+		assert.Nil(suite.T(), result)
+		suite.mockHistory.AssertCalled(suite.T(), "RecordFailure", tc.user.ObjID().Value(), []string{utils.AMRPassword}, entity.LoginReasonAccountInactive, loginhistory.ClientInfo{})
+		suite.mockHistory.AssertNotCalled(suite.T(), "RecordSuccess", mock.Anything, mock.Anything, mock.Anything)
+		suite.mockMFA.AssertNotCalled(suite.T(), "FindTOTPFactor", mock.Anything)
+	}
+}
+
+// UserLogin: 誤ったパスワードでは、アカウントの状態を明かさない
+func (suite *AuthServiceTestSuite) TestUserLogin_InactiveAccountWrongPassword() {
+	email := "test@example.com"
+	suspendedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.testUser.Suspend("規約違反", suspendedAt))
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+
+	result, err := suite.authServ.UserLogin(email, "wrong-password", "", loginhistory.ClientInfo{})
 	assert.Error(suite.T(), err)
-	assert.Empty(suite.T(), accessToken)
-	assert.Empty(suite.T(), refreshToken)
+	assert.NotErrorIs(suite.T(), err, entity.ErrAccountSuspended)
+	assert.Nil(suite.T(), result)
+}
+
+// UserLogin: メールアドレス確認済みのユーザーは設定に関わらずログインできる
+func (suite *AuthServiceTestSuite) TestUserLogin_EmailVerified() {
+	email := "test@example.com"
+	password := "correct-password"
+	authServ := authentication.NewUserAuthenticationService(suite.mockRepo, suite.mockMFA, suite.mockPasskey, suite.mockSession, suite.mockDevices, suite.mockLockout, suite.mockHistory, utils.DefaultTokenSigner(), &authentication.Config{RequireVerifiedEmail: true})
+
+	verifiedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.testUser.VerifyEmail(verifiedAt))
+	suite.mockRepo.On("GetUserByEmail", email).Return(suite.testUser, nil)
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(nil, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, nil)
+
+	result, err := authServ.UserLogin(email, password, "", loginhistory.ClientInfo{})
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), result.AccessToken)
+	assert.NotEmpty(suite.T(), result.RefreshToken)
 
 	suite.mockRepo.AssertExpectations(suite.T())
 }
 
+// CompleteLogin: パスワード以外で認証したユーザーにも同じ経路でトークンを発行する
+func (suite *AuthServiceTestSuite) TestCompleteLogin_Success() {
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(nil, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, nil)
+
+	client := loginhistory.ClientInfo{IP: "192.0.2.1", UserAgent: "Mozilla/5.0"}
+	result, err := suite.authServ.CompleteLogin(suite.testUser, utils.AMREmail, "", client)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), result.AccessToken)
+	assert.NotEmpty(suite.T(), result.RefreshToken)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByEmail")
+	// ログインの成功を発行したトークンの認証方法とともに記録すること
+	suite.mockHistory.AssertCalled(suite.T(), "RecordSuccess", suite.testUser.ObjID().Value(), []string{utils.AMREmail}, client)
+
+	// 認証方法と認証時刻がアクセストークンに含まれること
+	claims, err := utils.ValidateToken(result.AccessToken)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), []string{utils.AMREmail}, claims.AMR)
+	assert.Equal(suite.T(), utils.ACRSingleFactor, claims.ACR)
+	assert.False(suite.T(), claims.AuthTime.IsZero())
+}
+
+// CompleteLogin: 二要素認証が有効な場合はチャレンジトークンのみ返す
+func (suite *AuthServiceTestSuite) TestCompleteLogin_MFARequired() {
+	factor, err := entity.NewTOTPFactor(suite.testUser.ObjID(), "JBSWY3DPEHPK3PXP")
+	suite.Require().NoError(err)
+	suite.Require().NoError(factor.Confirm(1, time.Now()))
+	suite.mockMFA.On("FindTOTPFactor", suite.testUser.ObjID().Value()).Return(factor, nil)
+	suite.mockPasskey.On("ListCredentials", suite.testUser.ObjID().Value()).Return(nil, nil)
+
+	result, err := suite.authServ.CompleteLogin(suite.testUser, utils.AMRPassword, "", loginhistory.ClientInfo{})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.MFARequired)
+	// 二要素目の検証が終わるまではログインの成功として記録しない
+	suite.mockHistory.AssertNotCalled(suite.T(), "RecordSuccess", mock.Anything, mock.Anything, mock.Anything)
+	assert.NotEmpty(suite.T(), result.MFAToken)
+	assert.Empty(suite.T(), result.AccessToken)
+
+	// チャレンジトークンに一要素目の方法が含まれること
+	claims, err := utils.ValidateActionToken(utils.PurposeMFAChallenge, result.MFAToken)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), []string{utils.AMRPassword}, claims.AMR)
+}
+
+// CompleteLogin: サービスアカウントはログインできない
+func (suite *AuthServiceTestSuite) TestCompleteLogin_ServiceAccount() {
+	usernameVal, _ := value.NewUserUsername("ci-deployer")
+	account, err := entity.NewServiceAccountUser(usernameVal)
+	suite.Require().NoError(err)
+
+	result, err := suite.authServ.CompleteLogin(account, utils.AMREmail, "", loginhistory.ClientInfo{})
+	assert.ErrorIs(suite.T(), err, entity.ErrServiceAccount)
+	assert.Nil(suite.T(), result)
+	suite.mockHistory.AssertCalled(suite.T(), "RecordFailure", account.ObjID().Value(), []string{utils.AMREmail}, entity.LoginReasonServiceAccount, loginhistory.ClientInfo{})
+}
+
+// UserLogin: パスワード未設定のユーザー（パスワードレスで登録）はパスワードでログインできない
+func (suite *AuthServiceTestSuite) TestUserLogin_NoPassword() {
+	email := "test@example.com"
+	emailVal, _ := value.NewUserEmail(email)
+	usernameVal, _ := value.NewUserUsername("testuser")
+	user, err := entity.NewUser(emailVal, value.NoPassword(), usernameVal)
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByEmail", email).Return(user, nil)
+
+	result, err := suite.authServ.UserLogin(email, "", "", loginhistory.ClientInfo{})
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
 // UserTokenRefresh: 成功パターン
 func (suite *AuthServiceTestSuite) TestUserTokenRefresh_Success() {
 	// まず、トークン生成でリフレッシュトークンを取得
 	objID := suite.testUser.ObjID().Value()
-	_, refreshToken, err := utils.GenerateTokens(objID)
+	_, refreshToken, err := utils.GenerateTokens(objID, utils.NewAuthContext(utils.AMRPassword))
 	suite.Require().NoError(err)
 	suite.Require().NotEmpty(refreshToken)
+	suite.mockSession.On("GetSessionsRevokedAt", objID).Return(nil, nil)
+	suite.mockRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
 
 	// 実際に新しいアクセストークンを発行
 	newAccessToken, err := suite.authServ.UserTokenRefresh(refreshToken)
@@ -158,6 +718,49 @@ func (suite *AuthServiceTestSuite) TestUserTokenRefresh_Success() {
 	assert.NotEmpty(suite.T(), newAccessToken)
 }
 
+// UserTokenRefresh: トークンを無効にした日時より前に発行されたリフレッシュトークンの場合
+func (suite *AuthServiceTestSuite) TestUserTokenRefresh_Revoked() {
+	objID := suite.testUser.ObjID().Value()
+	_, refreshToken, err := utils.GenerateTokens(objID, utils.NewAuthContext(utils.AMRPassword))
+	suite.Require().NoError(err)
+	revokedAt := time.Now().Add(time.Minute)
+	suite.mockSession.On("GetSessionsRevokedAt", objID).Return(&revokedAt, nil)
+
+	newAccessToken, err := suite.authServ.UserTokenRefresh(refreshToken)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), newAccessToken)
+}
+
+// UserTokenRefresh: 無効にした日時より後に発行されたリフレッシュトークンは使えること
+func (suite *AuthServiceTestSuite) TestUserTokenRefresh_IssuedAfterRevocation() {
+	objID := suite.testUser.ObjID().Value()
+	revokedAt := time.Now().Add(-time.Minute)
+	suite.mockSession.On("GetSessionsRevokedAt", objID).Return(&revokedAt, nil)
+	suite.mockRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	_, refreshToken, err := utils.GenerateTokens(objID, utils.NewAuthContext(utils.AMRPassword))
+	suite.Require().NoError(err)
+
+	newAccessToken, err := suite.authServ.UserTokenRefresh(refreshToken)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), newAccessToken)
+}
+
+// UserTokenRefresh: 停止されたアカウントのリフレッシュトークンは使えないこと
+func (suite *AuthServiceTestSuite) TestUserTokenRefresh_Suspended() {
+	objID := suite.testUser.ObjID().Value()
+	_, refreshToken, err := utils.GenerateTokens(objID, utils.NewAuthContext(utils.AMRPassword))
+	suite.Require().NoError(err)
+	suspendedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.testUser.Suspend("規約違反", suspendedAt))
+	suite.mockSession.On("GetSessionsRevokedAt", objID).Return(nil, nil)
+	suite.mockRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+
+	newAccessToken, err := suite.authServ.UserTokenRefresh(refreshToken)
+	assert.ErrorIs(suite.T(), err, entity.ErrAccountSuspended)
+	assert.Empty(suite.T(), newAccessToken)
+}
+
 // UserTokenRefresh: 無効なリフレッシュトークンの場合
 func (suite *AuthServiceTestSuite) TestUserTokenRefresh_InvalidToken() {
 	invalidToken := "invalid.refresh.token"
@@ -0,0 +1,17 @@
+package authz
+
+import (
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	PolicyDir string // ポリシーのファイル（*.yaml / *.yml）を置くディレクトリ
+	Routes    string // AuthzMiddleware で判定するルート（policy.ParseRoutes の形式、既定は空）
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		PolicyDir: utils.GetEnvDefault("AUTHZ_POLICY_DIR", "policies"),
+		Routes:    utils.GetEnvDefault("AUTHZ_ROUTES", ""),
+	}
+}
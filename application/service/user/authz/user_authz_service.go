@@ -0,0 +1,122 @@
+package authz
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/role"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/policy"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidCheck = errs.NewServiceError("action is required")
+	ErrUserNotFound = errs.NewServiceError("user not found")
+)
+
+// Token は判定に使うアクセストークンの情報
+type Token struct {
+	IssuedAt time.Time
+	Auth     utils.AuthContext
+	OrgID    string // 切り替えた組織（個人として利用している場合は空）
+}
+
+// CheckRequest は「ユーザーが対象に操作をしてよいか」の問い合わせ
+type CheckRequest struct {
+	Action   string
+	Resource policy.Resource
+	Context  map[string]any
+	Token    Token
+}
+
+type UserAuthzService interface {
+	// Check: ポリシーを評価して、ユーザーに操作を許可するかどうかを判定する
+	Check(userObjID string, request CheckRequest) (*policy.Decision, error)
+}
+
+// userAuthzService は UserAuthzService の実装
+// ポリシーの subject には、保存されたユーザーの属性・ロールから与えられる権限・トークンのクレームを渡す
+type userAuthzService struct {
+	userRepository  repository.UserRepository
+	userRoleService role.UserRoleService
+	engine          *policy.Engine
+}
+
+// NewUserAuthzService は UserAuthzService のインスタンスを作成
+func NewUserAuthzService(userRepository repository.UserRepository, userRoleService role.UserRoleService, engine *policy.Engine) UserAuthzService {
+	return &userAuthzService{
+		userRepository:  userRepository,
+		userRoleService: userRoleService,
+		engine:          engine,
+	}
+}
+
+func (s *userAuthzService) Check(userObjID string, request CheckRequest) (*policy.Decision, error) {
+	if strings.TrimSpace(request.Action) == "" {
+		return nil, ErrInvalidCheck
+	}
+
+	subject, err := s.subject(userObjID, request.Token)
+	if err != nil {
+		return nil, err
+	}
+	decision := s.engine.Evaluate(policy.Input{
+		Subject:  subject,
+		Action:   request.Action,
+		Resource: request.Resource,
+		Context:  request.Context,
+	})
+	return &decision, nil
+}
+
+// subject は、ポリシーの条件から subject として参照するユーザーの属性を作成する
+func (s *userAuthzService) subject(userObjID string, token Token) (map[string]any, error) {
+	user, err := s.userRepository.GetUserByObjID(userObjID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	permissions, err := s.userRoleService.GetPermissions(userObjID)
+	if err != nil {
+		if errors.Is(err, role.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	claims := map[string]any{
+		"sub": userObjID,
+		"amr": nonNilStrings(token.Auth.AMR),
+		"acr": token.Auth.ACR(),
+	}
+	if !token.IssuedAt.IsZero() {
+		claims["iat"] = token.IssuedAt.Unix()
+	}
+	if !token.Auth.AuthTime.IsZero() {
+		claims["auth_time"] = token.Auth.AuthTime.Unix()
+	}
+	if token.OrgID != "" {
+		claims["org_id"] = token.OrgID
+	}
+
+	return map[string]any{
+		"uid":           user.ObjID().Value(),
+		"email":         user.Email().Value(),
+		"username":      user.Username().Value(),
+		"status":        user.Status().Value(),
+		"emailVerified": user.IsEmailVerified(),
+		"roles":         permissions.Roles,
+		"groups":        nonNilStrings(permissions.Groups),
+		"permissions":   permissions.Permissions,
+		"claims":        claims,
+	}, nil
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,241 @@
+package authz_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/authz"
+	"github.com/goda6565/nexus-user-auth/application/service/user/role"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/policy"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モック ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+// stubUserRoleService は GetPermissions のみを使うテスト用の UserRoleService
+type stubUserRoleService struct {
+	role.UserRoleService
+	permissions *role.UserPermissions
+	err         error
+}
+
+func (s *stubUserRoleService) GetPermissions(userObjID string) (*role.UserPermissions, error) {
+	return s.permissions, s.err
+}
+
+// --- テストスイート ---
+
+type UserAuthzServiceTestSuite struct {
+	suite.Suite
+	userRepo    *mockUserRepository
+	roleService *stubUserRoleService
+	service     authz.UserAuthzService
+	testUser    *entity.User
+}
+
+func TestUserAuthzServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserAuthzServiceTestSuite))
+}
+
+func (suite *UserAuthzServiceTestSuite) SetupTest() {
+	engine, err := policy.NewEngine([]policy.Policy{
+		{Name: "deny-inactive", Effect: policy.EffectDeny, Actions: []string{"*"}, Condition: `subject.status != "active"`},
+		{Name: "granted", Effect: policy.EffectAllow, Actions: []string{"*"}, Condition: `action in subject.permissions`},
+		{Name: "own-documents", Effect: policy.EffectAllow, Actions: []string{"documents:*"}, Condition: `resource.attributes.owner == subject.uid && subject.emailVerified`},
+		{Name: "mfa-for-export", Effect: policy.EffectDeny, Actions: []string{"documents:export"}, Condition: `!("mfa" in subject.claims.amr) || context.ip != "10.0.0.1"`},
+		{Name: "engineering-deploy", Effect: policy.EffectAllow, Actions: []string{"deployments:create"}, Condition: `"engineering" in subject.groups`},
+		{Name: "org-reports", Effect: policy.EffectAllow, Actions: []string{"reports:read"}, Condition: `has(subject.claims.org_id) && resource.attributes.org == subject.claims.org_id`},
+	})
+	suite.Require().NoError(err)
+
+	suite.userRepo = new(mockUserRepository)
+	suite.roleService = &stubUserRoleService{permissions: &role.UserPermissions{Roles: []string{"user"}, Permissions: []string{}}}
+	suite.service = authz.NewUserAuthzService(suite.userRepo, suite.roleService, engine)
+
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	password, _ := value.NewUserPassword("password123")
+	testUser, err := entity.NewUser(email, password, username)
+	suite.Require().NoError(err)
+	suite.Require().NoError(testUser.Activate(suite.pastTime()))
+	suite.testUser = testUser
+	suite.userRepo.On("GetUserByObjID", testUser.ObjID().Value()).Return(testUser, nil)
+}
+
+func (suite *UserAuthzServiceTestSuite) pastTime() *timeobj.TimeObj {
+	t, err := timeobj.NewTimeObj(time.Now().Add(-time.Minute))
+	suite.Require().NoError(err)
+	return t
+}
+
+func (suite *UserAuthzServiceTestSuite) uid() string {
+	return suite.testUser.ObjID().Value()
+}
+
+func (suite *UserAuthzServiceTestSuite) TestCheck_Permission() {
+	suite.roleService.permissions = &role.UserPermissions{Roles: []string{"support"}, Permissions: []string{"users:read"}}
+
+	decision, err := suite.service.Check(suite.uid(), authz.CheckRequest{Action: "users:read"})
+	suite.NoError(err)
+	suite.True(decision.Allowed)
+	suite.Equal("granted", decision.Policy)
+
+	decision, err = suite.service.Check(suite.uid(), authz.CheckRequest{Action: "users:write"})
+	suite.NoError(err)
+	suite.False(decision.Allowed)
+}
+
+func (suite *UserAuthzServiceTestSuite) TestCheck_Groups() {
+	request := authz.CheckRequest{Action: "deployments:create"}
+
+	decision, err := suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.False(decision.Allowed, "グループに所属しない場合も subject.groups を参照できること")
+
+	suite.roleService.permissions = &role.UserPermissions{Roles: []string{"user"}, Groups: []string{"engineering"}, Permissions: []string{}}
+	decision, err = suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.True(decision.Allowed)
+	suite.Equal("engineering-deploy", decision.Policy)
+}
+
+func (suite *UserAuthzServiceTestSuite) TestCheck_UserAttributes() {
+	request := authz.CheckRequest{
+		Action:   "documents:read",
+		Resource: policy.Resource{Type: "document", ID: "d-1", Attributes: map[string]any{"owner": suite.uid()}},
+	}
+
+	decision, err := suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.False(decision.Allowed, "メールアドレス未確認のユーザーには許可しないこと")
+
+	suite.Require().NoError(suite.testUser.VerifyEmail(suite.pastTime()))
+	decision, err = suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.True(decision.Allowed)
+	suite.Equal("own-documents", decision.Policy)
+
+	suite.Require().NoError(suite.testUser.Suspend("不正利用", suite.pastTime()))
+	decision, err = suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.False(decision.Allowed, "停止中のアカウントには許可しないこと")
+	suite.Equal("deny-inactive", decision.Policy)
+}
+
+func (suite *UserAuthzServiceTestSuite) TestCheck_TokenClaimsAndContext() {
+	suite.Require().NoError(suite.testUser.VerifyEmail(suite.pastTime()))
+	request := authz.CheckRequest{
+		Action:   "documents:export",
+		Resource: policy.Resource{Type: "document", Attributes: map[string]any{"owner": suite.uid()}},
+		Context:  map[string]any{"ip": "10.0.0.1"},
+		Token:    authz.Token{IssuedAt: time.Now(), Auth: utils.AuthContext{AuthTime: time.Now(), AMR: []string{utils.AMRPassword}}},
+	}
+
+	decision, err := suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.False(decision.Allowed, "トークンのクレームを評価すること")
+
+	request.Token.Auth.AMR = []string{utils.AMRPassword, utils.AMROTP, utils.AMRMFA}
+	decision, err = suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.True(decision.Allowed)
+
+	request.Context = map[string]any{"ip": "203.0.113.1"}
+	decision, err = suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.False(decision.Allowed, "リクエストの情報を評価すること")
+}
+
+// 組織に切り替えたトークンの場合は org_id をクレームとして評価できること
+func (suite *UserAuthzServiceTestSuite) TestCheck_OrgClaim() {
+	request := authz.CheckRequest{
+		Action:   "reports:read",
+		Resource: policy.Resource{Type: "report", Attributes: map[string]any{"org": "org-1"}},
+	}
+
+	decision, err := suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.False(decision.Allowed, "個人のトークンには許可しないこと")
+
+	request.Token.OrgID = "org-1"
+	decision, err = suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.True(decision.Allowed)
+	suite.Equal("org-reports", decision.Policy)
+
+	request.Token.OrgID = "org-2"
+	decision, err = suite.service.Check(suite.uid(), request)
+	suite.NoError(err)
+	suite.False(decision.Allowed, "別の組織のトークンには許可しないこと")
+}
+
+func (suite *UserAuthzServiceTestSuite) TestCheck_Error() {
+	_, err := suite.service.Check(suite.uid(), authz.CheckRequest{Action: " "})
+	suite.ErrorIs(err, authz.ErrInvalidCheck)
+
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errs.NewInfraError("record not found"))
+	_, err = suite.service.Check("unknown", authz.CheckRequest{Action: "users:read"})
+	suite.ErrorIs(err, authz.ErrUserNotFound)
+
+	suite.roleService.err = errors.New("db down")
+	_, err = suite.service.Check(suite.uid(), authz.CheckRequest{Action: "users:read"})
+	suite.Error(err)
+}
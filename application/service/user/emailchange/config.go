@@ -0,0 +1,28 @@
+package emailchange
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	ConfirmURL string              // 新しいアドレスに送る確認リンクの遷移先
+	UndoURL    string              // 元のアドレスに送る取り消しリンクの遷移先
+	ConfirmTTL time.Duration       // 確認リンクの有効期限
+	UndoTTL    time.Duration       // 取り消しリンクの有効期限（変更完了後も一定期間は元に戻せる）
+	Locale     mailer.Locale       // メール文面の言語
+	EmailKeys  value.EmailKeyRules // 現在と同じアドレスかを判定するキー（正規化したメールアドレス）の作り方
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		ConfirmURL: utils.GetEnvDefault("EMAIL_CHANGE_CONFIRM_URL", "http://localhost:3000/email-change/confirm"),
+		UndoURL:    utils.GetEnvDefault("EMAIL_CHANGE_UNDO_URL", "http://localhost:3000/email-change/undo"),
+		ConfirmTTL: utils.GetEnvDuration("EMAIL_CHANGE_CONFIRM_TTL", 24*time.Hour),
+		UndoTTL:    utils.GetEnvDuration("EMAIL_CHANGE_UNDO_TTL", 7*24*time.Hour),
+		Locale:     mailer.LocaleFromEnv(),
+	}
+}
@@ -0,0 +1,276 @@
+package emailchange
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidEmailChangeToken = errs.NewServiceError("invalid email change token")
+	ErrInvalidEmail            = errs.NewServiceError("invalid email")
+	ErrEmailAlreadyInUse       = errs.NewServiceError("email already in use")
+	ErrSameEmail               = errs.NewServiceError("new email is the same as the current email")
+	ErrEmailChangeConflict     = errs.NewServiceError("email has been changed by another request")
+)
+
+type UserEmailChangeService interface {
+	// RequestEmailChange: メールアドレス変更を受け付け、新旧のアドレスにメールを送信（認可はミドルウェアで行う）
+	// 登録を許可しないドメインへの変更は registration.ErrEmailDomainNotAllowed を返す
+	RequestEmailChange(objID string, newEmail string) error
+	// ConfirmEmailChange: 新しいアドレスに届いた確認トークンでメールアドレスを変更
+	ConfirmEmailChange(token string) (*entity.User, error)
+	// UndoEmailChange: 元のアドレスに届いた取り消しトークンで変更を取り消す（変更済みの場合は元に戻す）
+	UndoEmailChange(token string) (*entity.User, error)
+}
+
+// userEmailChangeService は UserEmailChangeService の実装
+// 確定・取り消しでは、ユーザーのアドレスと変更リクエストの状態をひとつのトランザクションで更新する
+type userEmailChangeService struct {
+	userRepository        repository.UserRepository
+	emailChangeRepository repository.EmailChangeRepository
+	transactor            repository.Transactor
+	domainPolicy          registration.DomainPolicy
+	mailer                mailer.Mailer
+	tokens                *utils.TokenSigner
+	config                *Config
+}
+
+// NewUserEmailChangeService は UserEmailChangeService のインスタンスを作成
+func NewUserEmailChangeService(userRepository repository.UserRepository, emailChangeRepository repository.EmailChangeRepository, transactor repository.Transactor, domainPolicy registration.DomainPolicy, mailer mailer.Mailer, tokens *utils.TokenSigner, config *Config) UserEmailChangeService {
+	return &userEmailChangeService{
+		userRepository:        userRepository,
+		emailChangeRepository: emailChangeRepository,
+		transactor:            transactor,
+		domainPolicy:          domainPolicy,
+		mailer:                mailer,
+		tokens:                tokens,
+		config:                config,
+	}
+}
+
+// RequestEmailChange は変更リクエストを作成し、新しいアドレスに確認リンク、元のアドレスに取り消しリンクを送信
+func (s *userEmailChangeService) RequestEmailChange(objID string, newEmail string) error {
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return errs.NewServiceError("failed to get user")
+	}
+
+	newEmailValue, err := value.NewUserEmail(newEmail)
+	if err != nil {
+		return ErrInvalidEmail
+	}
+	// 検索キーが同じアドレス（プロバイダー固有ルールで同じメールボックスを指すものを含む）は、自分自身と重複するため変更できない
+	if s.config.EmailKeys.Equal(user.Email(), newEmailValue) {
+		return ErrSameEmail
+	}
+	// 登録と同じく、使い捨て・拒否対象のドメインへは変更できない
+	if err := s.domainPolicy.Check(newEmailValue); err != nil {
+		return err
+	}
+	if s.emailInUse(newEmailValue.Value(), objID) {
+		return ErrEmailAlreadyInUse
+	}
+
+	// 新しいリクエストを優先し、確認待ちの古いリクエストは無効にする
+	if err := s.emailChangeRepository.CancelPendingEmailChanges(objID); err != nil {
+		return errs.NewServiceError("failed to cancel pending email changes")
+	}
+
+	emailChange, err := entity.NewEmailChange(user, newEmailValue, time.Now().Add(s.config.ConfirmTTL))
+	if err != nil {
+		return errs.NewServiceError("failed to create email change")
+	}
+	if _, err := s.emailChangeRepository.CreateEmailChange(emailChange); err != nil {
+		return errs.NewServiceError("failed to create email change in repository")
+	}
+
+	confirmToken, err := s.tokens.GenerateActionTokenWithRef(utils.PurposeEmailChangeConfirm, objID, newEmailValue.Value(), emailChange.ID(), s.config.ConfirmTTL)
+	if err != nil {
+		return errs.NewServiceError("failed to generate email change token")
+	}
+	confirmLink, err := utils.BuildTokenLink(s.config.ConfirmURL, confirmToken)
+	if err != nil {
+		return errs.NewServiceError("failed to build email change link")
+	}
+	undoToken, err := s.tokens.GenerateActionTokenWithRef(utils.PurposeEmailChangeUndo, objID, user.Email().Value(), emailChange.ID(), s.config.UndoTTL)
+	if err != nil {
+		return errs.NewServiceError("failed to generate email change token")
+	}
+	undoLink, err := utils.BuildTokenLink(s.config.UndoURL, undoToken)
+	if err != nil {
+		return errs.NewServiceError("failed to build email change link")
+	}
+
+	msg, err := mailer.Render(mailer.TemplateEmailChangeConfirm, s.config.Locale, newEmailValue.Value(), map[string]any{
+		"Username":  user.Username().Value(),
+		"Link":      confirmLink,
+		"ExpiresIn": s.config.ConfirmTTL,
+	})
+	if err != nil {
+		return errs.NewServiceError("failed to render email change confirmation")
+	}
+	err = s.mailer.Send(msg)
+	if err != nil {
+		return errs.NewServiceError("failed to send email change confirmation")
+	}
+
+	// 元のアドレスへの通知は失敗しても変更フローは継続する
+	msg, err = mailer.Render(mailer.TemplateEmailChangeNotice, s.config.Locale, user.Email().Value(), map[string]any{
+		"Username":  user.Username().Value(),
+		"NewEmail":  newEmailValue.Value(),
+		"Link":      undoLink,
+		"ExpiresIn": s.config.UndoTTL,
+	})
+	if err == nil {
+		err = s.mailer.Send(msg)
+	}
+	if err != nil {
+		logger.Warn("failed to send email change notice", "uid", objID, "error", err.Error())
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange は確認トークンを検証し、メールアドレスを変更する
+func (s *userEmailChangeService) ConfirmEmailChange(token string) (*entity.User, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeEmailChangeConfirm, token)
+	if err != nil {
+		return nil, ErrInvalidEmailChangeToken
+	}
+	emailChange, user, err := s.load(claims)
+	if err != nil {
+		return nil, err
+	}
+	if emailChange.Status() != entity.EmailChangePending || emailChange.IsExpired(time.Now()) {
+		return nil, ErrInvalidEmailChangeToken
+	}
+
+	// リクエスト後に別の経路でアドレスが変わっていないか
+	if !user.Email().Equals(emailChange.OldEmail()) {
+		return nil, ErrEmailChangeConflict
+	}
+	// 確定時に改めて一意性とドメインを確認する
+	if s.emailInUse(emailChange.NewEmail().Value(), claims.ObjID) {
+		return nil, ErrEmailAlreadyInUse
+	}
+	if err := s.domainPolicy.Check(emailChange.NewEmail()); err != nil {
+		return nil, err
+	}
+
+	// 新しいアドレスでリンクを開けたことをもって確認済みとする
+	now := time.Now()
+	verifiedAt, err := timeobj.NewTimeObj(now)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create verified time")
+	}
+	if err := user.ChangeEmail(emailChange.NewEmail(), verifiedAt); err != nil {
+		return nil, errs.NewServiceError("failed to change email")
+	}
+	if err := emailChange.Confirm(now); err != nil {
+		return nil, ErrInvalidEmailChangeToken
+	}
+
+	var updatedUser *entity.User
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if updatedUser, err = repos.Users.UpdateUser(user); err != nil {
+			return errs.NewServiceError("failed to update user in repository")
+		}
+		if _, err := repos.EmailChanges.UpdateEmailChange(emailChange); err != nil {
+			return errs.NewServiceError("failed to update email change in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedUser, nil
+}
+
+// UndoEmailChange は取り消しトークンを検証し、確認前なら取り消し、変更済みなら元のアドレスに戻す
+func (s *userEmailChangeService) UndoEmailChange(token string) (*entity.User, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeEmailChangeUndo, token)
+	if err != nil {
+		return nil, ErrInvalidEmailChangeToken
+	}
+	emailChange, user, err := s.load(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reverted := false
+	switch emailChange.Status() {
+	case entity.EmailChangePending:
+		if err := emailChange.Cancel(now); err != nil {
+			return nil, ErrInvalidEmailChangeToken
+		}
+	case entity.EmailChangeConfirmed:
+		if !user.Email().Equals(emailChange.NewEmail()) {
+			return nil, ErrEmailChangeConflict
+		}
+		if s.emailInUse(emailChange.OldEmail().Value(), claims.ObjID) {
+			return nil, ErrEmailAlreadyInUse
+		}
+		// 取り消しリンクは元のアドレスに届いているため、元のアドレスを確認済みとして戻す
+		verifiedAt, err := timeobj.NewTimeObj(now)
+		if err != nil {
+			return nil, errs.NewServiceError("failed to create verified time")
+		}
+		if err := user.ChangeEmail(emailChange.OldEmail(), verifiedAt); err != nil {
+			return nil, errs.NewServiceError("failed to change email")
+		}
+		if err := emailChange.Revert(now); err != nil {
+			return nil, ErrInvalidEmailChangeToken
+		}
+		reverted = true
+	default:
+		return nil, ErrInvalidEmailChangeToken
+	}
+
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if reverted {
+			if user, err = repos.Users.UpdateUser(user); err != nil {
+				return errs.NewServiceError("failed to update user in repository")
+			}
+		}
+		if _, err := repos.EmailChanges.UpdateEmailChange(emailChange); err != nil {
+			return errs.NewServiceError("failed to update email change in repository")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// load はトークンに紐づく変更リクエストとユーザーを取得する
+func (s *userEmailChangeService) load(claims *utils.ActionToken) (*entity.EmailChange, *entity.User, error) {
+	emailChange, err := s.emailChangeRepository.GetEmailChangeByID(claims.Ref)
+	if err != nil {
+		return nil, nil, ErrInvalidEmailChangeToken
+	}
+	if emailChange.UserObjID().Value() != claims.ObjID {
+		return nil, nil, ErrInvalidEmailChangeToken
+	}
+	user, err := s.userRepository.GetUserByObjID(claims.ObjID)
+	if err != nil {
+		return nil, nil, ErrInvalidEmailChangeToken
+	}
+	return emailChange, user, nil
+}
+
+// emailInUse は他のユーザーが指定のアドレスを使用しているかを確認する（検索キーが同じ自分自身のアドレスは含めない）
+func (s *userEmailChangeService) emailInUse(email string, objID string) bool {
+	user, err := s.userRepository.GetUserByEmail(email)
+	return err == nil && user.ObjID().Value() != objID
+}
@@ -0,0 +1,379 @@
+package emailchange_test
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/emailchange"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+// inMemoryEmailChangeRepository はメモリ上でリクエストを保持するテスト用リポジトリ
+type inMemoryEmailChangeRepository struct {
+	items     map[string]*entity.EmailChange
+	updateErr error // UpdateEmailChange が返すエラー
+}
+
+func (r *inMemoryEmailChangeRepository) CreateEmailChange(emailChange *entity.EmailChange) (*entity.EmailChange, error) {
+	r.items[emailChange.ID()] = emailChange
+	return emailChange, nil
+}
+
+func (r *inMemoryEmailChangeRepository) GetEmailChangeByID(id string) (*entity.EmailChange, error) {
+	emailChange, ok := r.items[id]
+	if !ok {
+		return nil, errs.NewInfraError("not found")
+	}
+	return emailChange, nil
+}
+
+func (r *inMemoryEmailChangeRepository) UpdateEmailChange(emailChange *entity.EmailChange) (*entity.EmailChange, error) {
+	if r.updateErr != nil {
+		return nil, r.updateErr
+	}
+	r.items[emailChange.ID()] = emailChange
+	return emailChange, nil
+}
+
+func (r *inMemoryEmailChangeRepository) CancelPendingEmailChanges(userObjID string) error {
+	for _, emailChange := range r.items {
+		if emailChange.UserObjID().Value() == userObjID && emailChange.Status() == entity.EmailChangePending {
+			_ = emailChange.Cancel(time.Now())
+		}
+	}
+	return nil
+}
+
+// fakeTransactor は、テスト用のリポジトリをそのまま渡す Transactor（fn がエラーを返した場合は取り消したことを記録する）
+type fakeTransactor struct {
+	repos      *repository.TxRepositories
+	rolledBack bool
+}
+
+func (t *fakeTransactor) Transaction(fn func(repos *repository.TxRepositories) error) error {
+	err := fn(t.repos)
+	if err != nil {
+		t.rolledBack = true
+	}
+	return err
+}
+
+// tokenSentTo は指定アドレス宛ての最後のメール本文のリンクから token クエリを取り出す
+func tokenSentTo(outbox *mailer.MemoryOutbox, to string) string {
+	msg := outbox.LastTo(to)
+	if msg == nil {
+		return ""
+	}
+	u, err := url.Parse(regexp.MustCompile(`https?://\S+`).FindString(msg.Body))
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("token")
+}
+
+// --- テストスイート ---
+
+// stubDomainPolicy は固定の判定結果を返すドメインポリシー
+type stubDomainPolicy struct {
+	err error
+}
+
+func (p *stubDomainPolicy) Check(email *value.UserEmail) error {
+	return p.err
+}
+
+func (p *stubDomainPolicy) Reload() error {
+	return nil
+}
+
+type UserEmailChangeServiceTestSuite struct {
+	suite.Suite
+	userRepo        *mockUserRepository
+	emailChangeRepo *inMemoryEmailChangeRepository
+	transactor      *fakeTransactor
+	domainPolicy    *stubDomainPolicy
+	outbox          *mailer.MemoryOutbox
+	service         emailchange.UserEmailChangeService
+	testUser        *entity.User
+}
+
+func TestUserEmailChangeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserEmailChangeServiceTestSuite))
+}
+
+func (suite *UserEmailChangeServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserEmailChangeServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserEmailChangeServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.emailChangeRepo = &inMemoryEmailChangeRepository{items: make(map[string]*entity.EmailChange)}
+	suite.domainPolicy = &stubDomainPolicy{}
+	suite.outbox = mailer.NewMemoryOutbox()
+	suite.transactor = &fakeTransactor{repos: &repository.TxRepositories{Users: suite.userRepo, EmailChanges: suite.emailChangeRepo}}
+	suite.service = emailchange.NewUserEmailChangeService(suite.userRepo, suite.emailChangeRepo, suite.transactor, suite.domainPolicy, suite.outbox, utils.DefaultTokenSigner(), &emailchange.Config{
+		ConfirmURL: "https://app.example.com/email-change/confirm",
+		UndoURL:    "https://app.example.com/email-change/undo",
+		ConfirmTTL: time.Hour,
+		UndoTTL:    24 * time.Hour,
+		Locale:     mailer.LocaleJa,
+	})
+
+	email, _ := value.NewUserEmail("old@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	testUser, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	suite.testUser = testUser
+
+	suite.userRepo.On("GetUserByObjID", testUser.ObjID().Value()).Return(testUser, nil)
+	suite.userRepo.On("UpdateUser", mock.Anything).Return(testUser, nil)
+}
+
+// requestChange は new@example.com への変更リクエストを作成する
+func (suite *UserEmailChangeServiceTestSuite) requestChange() {
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("not found"))
+	err := suite.service.RequestEmailChange(suite.testUser.ObjID().Value(), "new@example.com")
+	suite.Require().NoError(err)
+}
+
+// 新旧のアドレスにそれぞれメールが送られ、ユーザーのアドレスはまだ変わらないこと
+func (suite *UserEmailChangeServiceTestSuite) TestRequestEmailChange_Success() {
+	suite.requestChange()
+
+	suite.Require().Len(suite.outbox.Messages(), 2)
+	suite.NotEmpty(tokenSentTo(suite.outbox, "new@example.com"), "新しいアドレスに確認リンクが送られること")
+	suite.NotEmpty(tokenSentTo(suite.outbox, "old@example.com"), "元のアドレスに取り消しリンクが送られること")
+	suite.Equal("old@example.com", suite.testUser.Email().Value(), "確認前はアドレスが変わらないこと")
+	suite.userRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+}
+
+// 既に使われているアドレスへの変更は拒否されること
+func (suite *UserEmailChangeServiceTestSuite) TestRequestEmailChange_EmailInUse() {
+	other, _ := value.NewUserEmail("new@example.com")
+	username, _ := value.NewUserUsername("other")
+	otherUser, _ := entity.NewUser(other, value.FromHashed("hashed"), username)
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(otherUser, nil)
+
+	err := suite.service.RequestEmailChange(suite.testUser.ObjID().Value(), "new@example.com")
+	suite.ErrorIs(err, emailchange.ErrEmailAlreadyInUse)
+	suite.Empty(suite.outbox.Messages())
+}
+
+// 登録を許可しないドメインへの変更は拒否されること
+func (suite *UserEmailChangeServiceTestSuite) TestRequestEmailChange_DomainNotAllowed() {
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("not found"))
+	suite.domainPolicy.err = registration.ErrEmailDomainNotAllowed
+
+	err := suite.service.RequestEmailChange(suite.testUser.ObjID().Value(), "new@example.com")
+	suite.ErrorIs(err, registration.ErrEmailDomainNotAllowed)
+	suite.Empty(suite.outbox.Messages())
+}
+
+// 現在と同じアドレスへの変更は拒否されること
+func (suite *UserEmailChangeServiceTestSuite) TestRequestEmailChange_SameEmail() {
+	err := suite.service.RequestEmailChange(suite.testUser.ObjID().Value(), "old@example.com")
+	suite.ErrorIs(err, emailchange.ErrSameEmail)
+}
+
+// プロバイダー固有ルールを適用する場合、同じメールボックスを指すアドレスへの変更は自分自身との重複ではなく同じアドレスとして拒否されること
+func (suite *UserEmailChangeServiceTestSuite) TestRequestEmailChange_SameEmailByProviderRules() {
+	email, _ := value.NewUserEmail("foo.bar@gmail.com")
+	username, _ := value.NewUserUsername("gmailuser")
+	gmailUser, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	suite.userRepo.On("GetUserByObjID", gmailUser.ObjID().Value()).Return(gmailUser, nil)
+	suite.userRepo.On("GetUserByEmail", "foobar@gmail.com").Return(gmailUser, nil).Maybe()
+	service := emailchange.NewUserEmailChangeService(suite.userRepo, suite.emailChangeRepo, suite.transactor, suite.domainPolicy, suite.outbox, utils.DefaultTokenSigner(), &emailchange.Config{
+		ConfirmTTL: time.Hour,
+		UndoTTL:    24 * time.Hour,
+		Locale:     mailer.LocaleJa,
+		EmailKeys:  value.EmailKeyRules{ProviderRules: true},
+	})
+
+	err = service.RequestEmailChange(gmailUser.ObjID().Value(), "foobar@gmail.com")
+	suite.ErrorIs(err, emailchange.ErrSameEmail)
+	suite.Empty(suite.outbox.Messages())
+}
+
+// 確認リンクでアドレスが変更され、確認済みになること
+func (suite *UserEmailChangeServiceTestSuite) TestConfirmEmailChange_Success() {
+	suite.requestChange()
+
+	user, err := suite.service.ConfirmEmailChange(tokenSentTo(suite.outbox, "new@example.com"))
+	suite.NoError(err)
+	suite.Equal("new@example.com", user.Email().Value())
+	suite.True(user.IsEmailVerified(), "新しいアドレスが確認済みになること")
+	suite.userRepo.AssertCalled(suite.T(), "UpdateUser", suite.testUser)
+}
+
+// 変更リクエストの状態を保存できない場合は、アドレスの変更もまとめて取り消すこと
+func (suite *UserEmailChangeServiceTestSuite) TestConfirmEmailChange_RollsBack() {
+	suite.requestChange()
+	suite.emailChangeRepo.updateErr = errors.New("db down")
+
+	user, err := suite.service.ConfirmEmailChange(tokenSentTo(suite.outbox, "new@example.com"))
+	suite.Error(err)
+	suite.Nil(user)
+	suite.True(suite.transactor.rolledBack, "アドレスの変更と変更リクエストの状態の更新をまとめて取り消すこと")
+}
+
+// 確定時に他のユーザーがアドレスを使用していた場合は拒否されること
+func (suite *UserEmailChangeServiceTestSuite) TestConfirmEmailChange_EmailTakenMeanwhile() {
+	other, _ := value.NewUserEmail("new@example.com")
+	username, _ := value.NewUserUsername("other")
+	otherUser, _ := entity.NewUser(other, value.FromHashed("hashed"), username)
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("not found")).Once()
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(otherUser, nil)
+
+	err := suite.service.RequestEmailChange(suite.testUser.ObjID().Value(), "new@example.com")
+	suite.Require().NoError(err)
+
+	user, err := suite.service.ConfirmEmailChange(tokenSentTo(suite.outbox, "new@example.com"))
+	suite.ErrorIs(err, emailchange.ErrEmailAlreadyInUse)
+	suite.Nil(user)
+	suite.Equal("old@example.com", suite.testUser.Email().Value())
+}
+
+// 確認リンクは一度しか使えないこと
+func (suite *UserEmailChangeServiceTestSuite) TestConfirmEmailChange_Reused() {
+	suite.requestChange()
+	token := tokenSentTo(suite.outbox, "new@example.com")
+
+	_, err := suite.service.ConfirmEmailChange(token)
+	suite.NoError(err)
+	_, err = suite.service.ConfirmEmailChange(token)
+	suite.ErrorIs(err, emailchange.ErrInvalidEmailChangeToken)
+}
+
+// 取り消しリンクのトークンでは確認できないこと
+func (suite *UserEmailChangeServiceTestSuite) TestConfirmEmailChange_WrongPurpose() {
+	suite.requestChange()
+
+	_, err := suite.service.ConfirmEmailChange(tokenSentTo(suite.outbox, "old@example.com"))
+	suite.ErrorIs(err, emailchange.ErrInvalidEmailChangeToken)
+}
+
+// 確認前に取り消した場合、確認リンクが使えなくなること
+func (suite *UserEmailChangeServiceTestSuite) TestUndoEmailChange_BeforeConfirm() {
+	suite.requestChange()
+
+	user, err := suite.service.UndoEmailChange(tokenSentTo(suite.outbox, "old@example.com"))
+	suite.NoError(err)
+	suite.Equal("old@example.com", user.Email().Value())
+
+	_, err = suite.service.ConfirmEmailChange(tokenSentTo(suite.outbox, "new@example.com"))
+	suite.ErrorIs(err, emailchange.ErrInvalidEmailChangeToken, "取り消し後は確認できないこと")
+}
+
+// 変更後に取り消した場合、元のアドレスに戻ること
+func (suite *UserEmailChangeServiceTestSuite) TestUndoEmailChange_AfterConfirm() {
+	suite.requestChange()
+	_, err := suite.service.ConfirmEmailChange(tokenSentTo(suite.outbox, "new@example.com"))
+	suite.Require().NoError(err)
+
+	suite.userRepo.On("GetUserByEmail", "old@example.com").Return(nil, errs.NewInfraError("not found"))
+	user, err := suite.service.UndoEmailChange(tokenSentTo(suite.outbox, "old@example.com"))
+	suite.NoError(err)
+	suite.Equal("old@example.com", user.Email().Value(), "元のアドレスに戻ること")
+	suite.True(user.IsEmailVerified())
+}
+
+// 変更後の取り消しで変更リクエストの状態を保存できない場合は、元のアドレスへの戻しもまとめて取り消すこと
+func (suite *UserEmailChangeServiceTestSuite) TestUndoEmailChange_RollsBack() {
+	suite.requestChange()
+	_, err := suite.service.ConfirmEmailChange(tokenSentTo(suite.outbox, "new@example.com"))
+	suite.Require().NoError(err)
+
+	suite.userRepo.On("GetUserByEmail", "old@example.com").Return(nil, errs.NewInfraError("not found"))
+	suite.emailChangeRepo.updateErr = errors.New("db down")
+	user, err := suite.service.UndoEmailChange(tokenSentTo(suite.outbox, "old@example.com"))
+	suite.Error(err)
+	suite.Nil(user)
+	suite.True(suite.transactor.rolledBack)
+}
+
+// 不正なトークンは拒否されること
+func (suite *UserEmailChangeServiceTestSuite) TestUndoEmailChange_InvalidToken() {
+	token, err := utils.GenerateActionTokenWithRef(utils.PurposeEmailChangeUndo, suite.testUser.ObjID().Value(), "old@example.com", "unknown", time.Hour)
+	suite.Require().NoError(err)
+
+	_, err = suite.service.UndoEmailChange(token)
+	suite.ErrorIs(err, emailchange.ErrInvalidEmailChangeToken)
+}
+
+// 不正な形式のアドレスは拒否されること
+func (suite *UserEmailChangeServiceTestSuite) TestRequestEmailChange_InvalidEmail() {
+	err := suite.service.RequestEmailChange(suite.testUser.ObjID().Value(), "invalid")
+	suite.ErrorIs(err, emailchange.ErrInvalidEmail)
+	suite.Empty(suite.outbox.Messages())
+}
@@ -0,0 +1,76 @@
+package group
+
+import (
+	"sync"
+	"time"
+)
+
+// timeNowFunc は現在時刻取得用の関数。テスト用に差し替え可能にする。
+var timeNowFunc = time.Now
+
+// userGroupsCache は、ユーザーごとに解決したグループを一定時間保持する
+// グループ・所属を変更すると、影響するユーザーを特定せずにすべて破棄する（入れ子のグループの変更は多くのユーザーに影響するため）
+// 結果はプロセスのメモリに保持し、破棄も同じプロセス内でのみ行う。複数のインスタンスで動かす場合、
+// 別のインスタンスで行った変更は保持する時間（TTL）が過ぎるまで反映されない。
+type userGroupsCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	generation uint64 // 破棄するたびに増やす（破棄する前に解決を始めた結果を保持しないため）
+	entries    map[string]userGroupsCacheEntry
+	nextSweep  time.Time // 期限切れの結果をまとめて取り除く次の時刻
+}
+
+type userGroupsCacheEntry struct {
+	groups    *UserGroups
+	expiresAt time.Time
+}
+
+func newUserGroupsCache(ttl time.Duration) *userGroupsCache {
+	return &userGroupsCache{ttl: ttl, entries: make(map[string]userGroupsCacheEntry)}
+}
+
+// get は保持している結果と、現在の世代を返す
+func (c *userGroupsCache) get(userObjID string) (*UserGroups, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[userObjID]
+	if !ok {
+		return nil, c.generation, false
+	}
+	if !timeNowFunc().Before(entry.expiresAt) {
+		delete(c.entries, userObjID)
+		return nil, c.generation, false
+	}
+	return entry.groups, c.generation, true
+}
+
+// put は結果を保持する（解決を始めてから破棄された場合は保持しない）
+// 一度だけ解決したユーザーの結果が残り続けないよう、TTL ごとに期限切れの結果をまとめて取り除く
+func (c *userGroupsCache) put(userObjID string, groups *UserGroups, generation uint64) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	now := timeNowFunc()
+	if !now.Before(c.nextSweep) {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+		c.nextSweep = now.Add(c.ttl)
+	}
+	c.entries[userObjID] = userGroupsCacheEntry{groups: groups, expiresAt: now.Add(c.ttl)}
+}
+
+// invalidate はすべての結果を破棄する
+func (c *userGroupsCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	clear(c.entries)
+}
@@ -0,0 +1,28 @@
+package group
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 期限切れの結果は取得時と、次に保持するときにまとめて取り除かれること
+func TestUserGroupsCache_EvictsExpired(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	timeNowFunc = func() time.Time { return now }
+	t.Cleanup(func() { timeNowFunc = time.Now })
+
+	cache := newUserGroupsCache(time.Minute)
+	cache.put("uid-1", &UserGroups{}, 0)
+	cache.put("uid-2", &UserGroups{}, 0)
+
+	now = now.Add(2 * time.Minute)
+	_, _, ok := cache.get("uid-1")
+	assert.False(t, ok)
+	assert.NotContains(t, cache.entries, "uid-1", "取得時に期限切れの結果を取り除くこと")
+
+	cache.put("uid-3", &UserGroups{}, 0)
+	assert.NotContains(t, cache.entries, "uid-2", "一度も取得されない期限切れの結果も取り除くこと")
+	assert.Contains(t, cache.entries, "uid-3")
+}
@@ -0,0 +1,19 @@
+package group
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	CacheTTL    time.Duration // ユーザーの所属するグループを解決した結果を保持する時間（0 の場合は保持しない）
+	TokenClaims bool          // アクセストークンに groups クレーム（所属するグループの名前）を含めるか
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		CacheTTL:    utils.GetEnvDuration("GROUP_CACHE_TTL", time.Minute),
+		TokenClaims: utils.GetEnvBool("GROUP_TOKEN_CLAIMS", false),
+	}
+}
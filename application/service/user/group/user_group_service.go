@@ -0,0 +1,482 @@
+package group
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/audit"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+var (
+	ErrGroupNotFound      = errs.NewServiceError("group not found")
+	ErrInvalidGroup       = errs.NewServiceError("invalid group name, description, roles or permissions")
+	ErrGroupAlreadyExists = errs.NewServiceError("group already exists")
+	ErrRoleNotFound       = errs.NewServiceError("role not found")
+	ErrUserNotFound       = errs.NewServiceError("user not found")
+	ErrCircularMembership = errs.NewServiceError("a group cannot contain itself or a group that contains it")
+	ErrSubgroupNotFound   = errs.NewServiceError("subgroup not found")
+	ErrCannotGrant        = errs.NewServiceError("cannot grant roles or permissions the actor does not hold")
+	ErrAuditLogFailed     = audit.ErrAuditLogFailed
+)
+
+// UserGroups は、ユーザーが（入れ子のグループを通じて間接的に所属するものを含めて）所属するグループと、グループから与えられるロール・権限
+type UserGroups struct {
+	Groups      []string // グループの名前（重複なし・名前順）
+	Roles       []string // グループに与えたロール（重複なし・名前順）
+	Permissions []string // グループに直接与えた権限（重複なし・名前順）
+}
+
+type UserGroupService interface {
+	// ListGroups: すべてのグループを取得する
+	ListGroups() ([]*entity.Group, error)
+	// GetGroup: グループと、グループに直接所属するユーザー・入れ子のグループを取得する
+	GetGroup(groupObjID string) (*entity.Group, *repository.GroupMembers, error)
+	// CreateGroup: グループを作成する（操作するアカウントが持たない権限は与えられない）
+	CreateGroup(actorObjID string, name string, description string, roles []string, permissions []string) (*entity.Group, error)
+	// UpdateGroup: グループの説明とロール・権限を置き換える（操作するアカウントが持たない権限は与えられない）
+	UpdateGroup(actorObjID string, groupObjID string, description string, roles []string, permissions []string) (*entity.Group, error)
+	// DeleteGroup: グループを削除する（所属するユーザー・入れ子のグループはグループから外れる）
+	DeleteGroup(actorObjID string, groupObjID string) error
+	// AddUser: ユーザーをグループに所属させる（グループが操作するアカウントの持たない権限を与える場合は所属させられない）
+	AddUser(actorObjID string, groupObjID string, userObjID string) error
+	// RemoveUser: ユーザーをグループから外す
+	RemoveUser(actorObjID string, groupObjID string, userObjID string) error
+	// AddSubgroup: グループを入れ子のグループとして所属させる（入れ子のグループのメンバーはグループにも所属する）
+	// グループが操作するアカウントの持たない権限を与える場合は所属させられない
+	AddSubgroup(actorObjID string, groupObjID string, subgroupObjID string) error
+	// RemoveSubgroup: 入れ子のグループをグループから外す
+	RemoveSubgroup(actorObjID string, groupObjID string, subgroupObjID string) error
+	// ResolveUserGroups: ユーザーが所属するグループと、グループから与えられるロール・権限を取得する（一定時間保持する）
+	ResolveUserGroups(userObjID string) (*UserGroups, error)
+	// GroupNames: ユーザーが所属するグループの名前を取得する（トークンの groups クレーム用）
+	GroupNames(userObjID string) ([]string, error)
+	// InvalidateCache: 保持している解決の結果を破棄する（ロールの削除など、グループの外での変更を反映する）
+	InvalidateCache()
+}
+
+// userGroupService は UserGroupService の実装
+// グループの作成・変更・削除と所属の変更は監査ログと同じトランザクションで保存し（記録に失敗した場合は操作を取り消してエラーを返す）、
+// 保存した後に解決の結果を破棄する
+type userGroupService struct {
+	userRepository  repository.UserRepository
+	groupRepository repository.GroupRepository
+	roleRepository  repository.RoleRepository
+	transactor      repository.Transactor
+	cache           *userGroupsCache
+}
+
+// NewUserGroupService は UserGroupService のインスタンスを作成
+func NewUserGroupService(userRepository repository.UserRepository, groupRepository repository.GroupRepository, roleRepository repository.RoleRepository, transactor repository.Transactor, config *Config) UserGroupService {
+	return &userGroupService{
+		userRepository:  userRepository,
+		groupRepository: groupRepository,
+		roleRepository:  roleRepository,
+		transactor:      transactor,
+		cache:           newUserGroupsCache(config.CacheTTL),
+	}
+}
+
+func (s *userGroupService) ListGroups() ([]*entity.Group, error) {
+	groups, err := s.groupRepository.ListGroups()
+	if err != nil {
+		return nil, errs.NewServiceError("failed to list groups")
+	}
+	return groups, nil
+}
+
+func (s *userGroupService) GetGroup(groupObjID string) (*entity.Group, *repository.GroupMembers, error) {
+	group, err := s.findGroup(groupObjID, ErrGroupNotFound)
+	if err != nil {
+		return nil, nil, err
+	}
+	members, err := s.groupRepository.ListMembers(groupObjID)
+	if err != nil {
+		return nil, nil, errs.NewServiceError("failed to get group members from repository")
+	}
+	return group, members, nil
+}
+
+func (s *userGroupService) CreateGroup(actorObjID string, name string, description string, roles []string, permissions []string) (*entity.Group, error) {
+	groupName, err := value.NewGroupName(name)
+	if err != nil {
+		return nil, ErrInvalidGroup
+	}
+	roleValues, permissionValues, err := s.toGrants(actorObjID, roles, permissions)
+	if err != nil {
+		return nil, err
+	}
+	group, err := entity.NewGroup(groupName, description, roleValues, permissionValues)
+	if err != nil {
+		return nil, ErrInvalidGroup
+	}
+	existing, err := s.groupRepository.FindGroupByName(name)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get group from repository")
+	}
+	if existing != nil {
+		return nil, ErrGroupAlreadyExists
+	}
+	var createdGroup *entity.Group
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if createdGroup, err = repos.Groups.CreateGroup(group); err != nil {
+			return errs.NewServiceError("failed to create group")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionGroupCreate, "", groupDetail(createdGroup))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return createdGroup, nil
+}
+
+func (s *userGroupService) UpdateGroup(actorObjID string, groupObjID string, description string, roles []string, permissions []string) (*entity.Group, error) {
+	roleValues, permissionValues, err := s.toGrants(actorObjID, roles, permissions)
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.findGroup(groupObjID, ErrGroupNotFound)
+	if err != nil {
+		return nil, err
+	}
+	if err := group.Update(description, roleValues, permissionValues); err != nil {
+		return nil, ErrInvalidGroup
+	}
+	var updatedGroup *entity.Group
+	err = s.mutate(func(repos *repository.TxRepositories) error {
+		if updatedGroup, err = repos.Groups.UpdateGroup(group); err != nil {
+			return errs.NewServiceError("failed to update group")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionGroupUpdate, "", groupDetail(updatedGroup))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedGroup, nil
+}
+
+func (s *userGroupService) DeleteGroup(actorObjID string, groupObjID string) error {
+	group, err := s.findGroup(groupObjID, ErrGroupNotFound)
+	if err != nil {
+		return err
+	}
+	return s.mutate(func(repos *repository.TxRepositories) error {
+		if err := repos.Groups.DeleteGroup(groupObjID); err != nil {
+			return errs.NewServiceError("failed to delete group")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionGroupDelete, "", map[string]string{"group": group.Name().Value()})
+	})
+}
+
+func (s *userGroupService) AddUser(actorObjID string, groupObjID string, userObjID string) error {
+	group, err := s.findGroup(groupObjID, ErrGroupNotFound)
+	if err != nil {
+		return err
+	}
+	if _, err := value.NewUserObjID(userObjID); err != nil {
+		return ErrUserNotFound
+	}
+	if _, err := s.userRepository.GetUserByObjID(userObjID); err != nil {
+		return ErrUserNotFound
+	}
+	if err := s.checkMembershipGrantable(actorObjID, groupObjID); err != nil {
+		return err
+	}
+	return s.mutate(func(repos *repository.TxRepositories) error {
+		if err := repos.Groups.AddUser(groupObjID, userObjID); err != nil {
+			return errs.NewServiceError("failed to add user to group")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionGroupMemberAdd, userObjID, map[string]string{"group": group.Name().Value()})
+	})
+}
+
+func (s *userGroupService) RemoveUser(actorObjID string, groupObjID string, userObjID string) error {
+	group, err := s.findGroup(groupObjID, ErrGroupNotFound)
+	if err != nil {
+		return err
+	}
+	if _, err := value.NewUserObjID(userObjID); err != nil {
+		return ErrUserNotFound
+	}
+	return s.mutate(func(repos *repository.TxRepositories) error {
+		if err := repos.Groups.RemoveUser(groupObjID, userObjID); err != nil {
+			return errs.NewServiceError("failed to remove user from group")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionGroupMemberRemove, userObjID, map[string]string{"group": group.Name().Value()})
+	})
+}
+
+func (s *userGroupService) AddSubgroup(actorObjID string, groupObjID string, subgroupObjID string) error {
+	group, err := s.findGroup(groupObjID, ErrGroupNotFound)
+	if err != nil {
+		return err
+	}
+	subgroup, err := s.findGroup(subgroupObjID, ErrSubgroupNotFound)
+	if err != nil {
+		return err
+	}
+	// 入れ子のグループがグループ自身か、グループを（間接的に）含む場合は循環するため追加しない
+	ancestors, err := s.ancestors([]string{groupObjID})
+	if err != nil {
+		return err
+	}
+	if slices.Contains(ancestors, subgroupObjID) {
+		return ErrCircularMembership
+	}
+	if err := s.checkMembershipGrantable(actorObjID, groupObjID); err != nil {
+		return err
+	}
+	return s.mutate(func(repos *repository.TxRepositories) error {
+		if err := repos.Groups.AddSubgroup(groupObjID, subgroupObjID); err != nil {
+			return errs.NewServiceError("failed to add subgroup to group")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionGroupMemberAdd, "", map[string]string{"group": group.Name().Value(), "subgroup": subgroup.Name().Value()})
+	})
+}
+
+func (s *userGroupService) RemoveSubgroup(actorObjID string, groupObjID string, subgroupObjID string) error {
+	group, err := s.findGroup(groupObjID, ErrGroupNotFound)
+	if err != nil {
+		return err
+	}
+	subgroup, err := s.findGroup(subgroupObjID, ErrSubgroupNotFound)
+	if err != nil {
+		return err
+	}
+	return s.mutate(func(repos *repository.TxRepositories) error {
+		if err := repos.Groups.RemoveSubgroup(groupObjID, subgroupObjID); err != nil {
+			return errs.NewServiceError("failed to remove subgroup from group")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionGroupMemberRemove, "", map[string]string{"group": group.Name().Value(), "subgroup": subgroup.Name().Value()})
+	})
+}
+
+func (s *userGroupService) ResolveUserGroups(userObjID string) (*UserGroups, error) {
+	cached, generation, ok := s.cache.get(userObjID)
+	if ok {
+		return cached, nil
+	}
+
+	direct, err := s.groupRepository.FindUserGroupIDs(userObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to resolve user groups")
+	}
+	groupObjIDs, err := s.ancestors(direct)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.groupRepository.FindGroupsByIDs(groupObjIDs)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to resolve user groups")
+	}
+
+	resolved := &UserGroups{Groups: []string{}, Roles: []string{}, Permissions: []string{}}
+	for _, group := range groups {
+		resolved.Groups = append(resolved.Groups, group.Name().Value())
+		resolved.Roles = append(resolved.Roles, group.RoleNames()...)
+		resolved.Permissions = append(resolved.Permissions, group.PermissionNames()...)
+	}
+	for _, names := range [][]string{resolved.Groups, resolved.Roles, resolved.Permissions} {
+		slices.Sort(names)
+	}
+	resolved.Groups = slices.Compact(resolved.Groups)
+	resolved.Roles = slices.Compact(resolved.Roles)
+	resolved.Permissions = slices.Compact(resolved.Permissions)
+
+	s.cache.put(userObjID, resolved, generation)
+	return resolved, nil
+}
+
+func (s *userGroupService) GroupNames(userObjID string) ([]string, error) {
+	resolved, err := s.ResolveUserGroups(userObjID)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.Groups, nil
+}
+
+func (s *userGroupService) InvalidateCache() {
+	s.cache.invalidate()
+}
+
+// ancestors は、指定したグループと、それらを（入れ子を通じて間接的に）含むすべてのグループのIDを返す
+func (s *userGroupService) ancestors(groupObjIDs []string) ([]string, error) {
+	visited := make(map[string]struct{}, len(groupObjIDs))
+	result := make([]string, 0, len(groupObjIDs))
+	frontier := groupObjIDs
+	for len(frontier) > 0 {
+		next := make([]string, 0, len(frontier))
+		for _, id := range frontier {
+			if _, ok := visited[id]; ok {
+				continue
+			}
+			visited[id] = struct{}{}
+			result = append(result, id)
+			next = append(next, id)
+		}
+		if len(next) == 0 {
+			break
+		}
+		parents, err := s.groupRepository.FindParentGroupIDs(next)
+		if err != nil {
+			return nil, errs.NewServiceError("failed to resolve user groups")
+		}
+		frontier = parents
+	}
+	return result, nil
+}
+
+// findGroup はIDでグループを取得する（存在しない場合は notFound）
+func (s *userGroupService) findGroup(groupObjID string, notFound error) (*entity.Group, error) {
+	if _, err := value.NewGroupObjID(groupObjID); err != nil {
+		return nil, notFound
+	}
+	group, err := s.groupRepository.FindGroup(groupObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get group from repository")
+	}
+	if group == nil {
+		return nil, notFound
+	}
+	return group, nil
+}
+
+// toGrants はロール・権限の名前を検証して変換する（ロールは存在するもののみ指定できる）
+// ロール・権限が与える権限は、操作するアカウントが持つものに限る
+func (s *userGroupService) toGrants(actorObjID string, roles []string, permissions []string) ([]*value.UserRole, []*value.Permission, error) {
+	roleValues := make([]*value.UserRole, 0, len(roles))
+	for _, name := range roles {
+		role, err := value.NewUserRole(name)
+		if err != nil {
+			return nil, nil, ErrInvalidGroup
+		}
+		roleValues = append(roleValues, role)
+	}
+	found := []*entity.Role{}
+	if len(roles) > 0 {
+		var err error
+		found, err = s.roleRepository.FindRolesByNames(roles)
+		if err != nil {
+			return nil, nil, errs.NewServiceError("failed to get roles from repository")
+		}
+		for _, name := range roles {
+			if !slices.ContainsFunc(found, func(role *entity.Role) bool { return role.Name().Value() == name }) {
+				return nil, nil, ErrRoleNotFound
+			}
+		}
+	}
+	permissionValues := make([]*value.Permission, 0, len(permissions))
+	for _, name := range permissions {
+		permission, err := value.NewPermission(name)
+		if err != nil {
+			return nil, nil, ErrInvalidGroup
+		}
+		permissionValues = append(permissionValues, permission)
+	}
+	if err := s.checkGrantable(actorObjID, found, permissions); err != nil {
+		return nil, nil, err
+	}
+	return roleValues, permissionValues, nil
+}
+
+// checkMembershipGrantable は、グループ（と、グループを含むグループ）が与えるロール・権限を
+// 操作するアカウントがすべて持っているかを確認する（所属させると、それらがメンバーに与えられるため）
+func (s *userGroupService) checkMembershipGrantable(actorObjID string, groupObjID string) error {
+	groupObjIDs, err := s.ancestors([]string{groupObjID})
+	if err != nil {
+		return err
+	}
+	groups, err := s.groupRepository.FindGroupsByIDs(groupObjIDs)
+	if err != nil {
+		return errs.NewServiceError("failed to get groups from repository")
+	}
+	roleNames := []string{}
+	permissions := []string{}
+	for _, group := range groups {
+		roleNames = append(roleNames, group.RoleNames()...)
+		permissions = append(permissions, group.PermissionNames()...)
+	}
+	roles := []*entity.Role{}
+	if len(roleNames) > 0 {
+		slices.Sort(roleNames)
+		roles, err = s.roleRepository.FindRolesByNames(slices.Compact(roleNames))
+		if err != nil {
+			return errs.NewServiceError("failed to get roles from repository")
+		}
+	}
+	return s.checkGrantable(actorObjID, roles, permissions)
+}
+
+// checkGrantable は、ロールと権限が与える権限を操作するアカウントがすべて持っているかを確認する
+// 持っていない権限を与えられると、groups:write だけを持つアカウントがグループを通じて admin を得られてしまう
+func (s *userGroupService) checkGrantable(actorObjID string, roles []*entity.Role, permissions []string) error {
+	required := slices.Clone(permissions)
+	for _, role := range roles {
+		required = append(required, role.PermissionNames()...)
+	}
+	if len(required) == 0 {
+		return nil
+	}
+	held, err := s.heldPermissions(actorObjID)
+	if err != nil {
+		return err
+	}
+	for _, permission := range required {
+		if !slices.ContainsFunc(held, func(p *value.Permission) bool { return p.Grants(permission) }) {
+			return ErrCannotGrant
+		}
+	}
+	return nil
+}
+
+// heldPermissions は、操作するアカウントが直接または所属するグループを通じて持つ権限を返す
+func (s *userGroupService) heldPermissions(actorObjID string) ([]*value.Permission, error) {
+	actor, err := s.userRepository.GetUserByObjID(actorObjID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get actor from repository")
+	}
+	groups, err := s.ResolveUserGroups(actorObjID)
+	if err != nil {
+		return nil, err
+	}
+	names := slices.Clone(groups.Permissions)
+	roleNames := append(actor.RoleNames(), groups.Roles...)
+	if len(roleNames) > 0 {
+		slices.Sort(roleNames)
+		roles, err := s.roleRepository.FindRolesByNames(slices.Compact(roleNames))
+		if err != nil {
+			return nil, errs.NewServiceError("failed to get roles from repository")
+		}
+		for _, role := range roles {
+			names = append(names, role.PermissionNames()...)
+		}
+	}
+	held := make([]*value.Permission, 0, len(names))
+	for _, name := range names {
+		if permission, err := value.NewPermission(name); err == nil {
+			held = append(held, permission)
+		}
+	}
+	return held, nil
+}
+
+// mutate は、グループや所属の変更と監査ログの記録を fn でひとつのトランザクションとして行い、保存できた場合に解決の結果を破棄する
+func (s *userGroupService) mutate(fn func(repos *repository.TxRepositories) error) error {
+	if err := s.transactor.Transaction(fn); err != nil {
+		return err
+	}
+	s.cache.invalidate()
+	return nil
+}
+
+// groupDetail は、グループの作成・変更の監査ログに記録する内容を返す
+func groupDetail(group *entity.Group) map[string]string {
+	return map[string]string{
+		"group":       group.Name().Value(),
+		"roles":       strings.Join(group.RoleNames(), ","),
+		"permissions": strings.Join(group.PermissionNames(), ","),
+	}
+}
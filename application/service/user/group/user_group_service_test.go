@@ -0,0 +1,588 @@
+package group_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/group"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+type mockRoleRepository struct {
+	mock.Mock
+}
+
+func (m *mockRoleRepository) ListRoles() ([]*entity.Role, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) FindRole(name string) (*entity.Role, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) FindRolesByNames(names []string) ([]*entity.Role, error) {
+	args := m.Called(names)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) CreateRole(role *entity.Role) (*entity.Role, error) {
+	args := m.Called(role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) EnsureRoles(roles []*entity.Role) error {
+	args := m.Called(roles)
+	return args.Error(0)
+}
+
+func (m *mockRoleRepository) UpdateRole(role *entity.Role) (*entity.Role, error) {
+	args := m.Called(role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) DeleteRole(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// memoryGroupRepository はグループと所属をメモリ上に保持するテスト用リポジトリ
+type memoryGroupRepository struct {
+	groups       map[string]*entity.Group
+	members      map[string][]string // グループのID → ユーザーのID
+	subgroups    map[string][]string // 親のグループのID → 入れ子のグループのID
+	resolveCalls int                 // FindUserGroupIDs が呼ばれた回数
+}
+
+func newMemoryGroupRepository() *memoryGroupRepository {
+	return &memoryGroupRepository{
+		groups:    map[string]*entity.Group{},
+		members:   map[string][]string{},
+		subgroups: map[string][]string{},
+	}
+}
+
+func (r *memoryGroupRepository) ListGroups() ([]*entity.Group, error) {
+	groups := make([]*entity.Group, 0, len(r.groups))
+	for _, g := range r.groups {
+		groups = append(groups, g)
+	}
+	slices.SortFunc(groups, func(a, b *entity.Group) int {
+		return strings.Compare(a.Name().Value(), b.Name().Value())
+	})
+	return groups, nil
+}
+
+func (r *memoryGroupRepository) FindGroup(groupObjID string) (*entity.Group, error) {
+	return r.groups[groupObjID], nil
+}
+
+func (r *memoryGroupRepository) FindGroupByName(name string) (*entity.Group, error) {
+	for _, g := range r.groups {
+		if g.Name().Value() == name {
+			return g, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryGroupRepository) FindGroupsByIDs(groupObjIDs []string) ([]*entity.Group, error) {
+	groups := make([]*entity.Group, 0, len(groupObjIDs))
+	for _, id := range groupObjIDs {
+		if g, ok := r.groups[id]; ok {
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+func (r *memoryGroupRepository) CreateGroup(g *entity.Group) (*entity.Group, error) {
+	r.groups[g.ObjID().Value()] = g
+	return g, nil
+}
+
+func (r *memoryGroupRepository) UpdateGroup(g *entity.Group) (*entity.Group, error) {
+	r.groups[g.ObjID().Value()] = g
+	return g, nil
+}
+
+func (r *memoryGroupRepository) DeleteGroup(groupObjID string) error {
+	delete(r.groups, groupObjID)
+	delete(r.members, groupObjID)
+	delete(r.subgroups, groupObjID)
+	for parent, children := range r.subgroups {
+		r.subgroups[parent] = slices.DeleteFunc(children, func(id string) bool { return id == groupObjID })
+	}
+	return nil
+}
+
+func (r *memoryGroupRepository) ListMembers(groupObjID string) (*repository.GroupMembers, error) {
+	return &repository.GroupMembers{
+		UserObjIDs:  append([]string{}, r.members[groupObjID]...),
+		GroupObjIDs: append([]string{}, r.subgroups[groupObjID]...),
+	}, nil
+}
+
+func (r *memoryGroupRepository) AddUser(groupObjID string, userObjID string) error {
+	if !slices.Contains(r.members[groupObjID], userObjID) {
+		r.members[groupObjID] = append(r.members[groupObjID], userObjID)
+	}
+	return nil
+}
+
+func (r *memoryGroupRepository) RemoveUser(groupObjID string, userObjID string) error {
+	r.members[groupObjID] = slices.DeleteFunc(r.members[groupObjID], func(id string) bool { return id == userObjID })
+	return nil
+}
+
+func (r *memoryGroupRepository) AddSubgroup(parentObjID string, childObjID string) error {
+	if !slices.Contains(r.subgroups[parentObjID], childObjID) {
+		r.subgroups[parentObjID] = append(r.subgroups[parentObjID], childObjID)
+	}
+	return nil
+}
+
+func (r *memoryGroupRepository) RemoveSubgroup(parentObjID string, childObjID string) error {
+	r.subgroups[parentObjID] = slices.DeleteFunc(r.subgroups[parentObjID], func(id string) bool { return id == childObjID })
+	return nil
+}
+
+func (r *memoryGroupRepository) FindUserGroupIDs(userObjID string) ([]string, error) {
+	r.resolveCalls++
+	ids := []string{}
+	for groupObjID, users := range r.members {
+		if slices.Contains(users, userObjID) {
+			ids = append(ids, groupObjID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memoryGroupRepository) FindParentGroupIDs(groupObjIDs []string) ([]string, error) {
+	ids := []string{}
+	for parent, children := range r.subgroups {
+		for _, child := range children {
+			if slices.Contains(groupObjIDs, child) && !slices.Contains(ids, parent) {
+				ids = append(ids, parent)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// recordingAuditLogRepository は記録された監査ログを保持するテスト用リポジトリ
+type recordingAuditLogRepository struct {
+	logs []*entity.AuditLog
+	err  error
+}
+
+func (r *recordingAuditLogRepository) SaveAuditLog(log *entity.AuditLog) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+// fakeTransactor は、テスト用のリポジトリをそのまま渡す Transactor（fn がエラーを返した場合は取り消したことを記録する）
+type fakeTransactor struct {
+	repos      *repository.TxRepositories
+	rolledBack bool
+}
+
+func (t *fakeTransactor) Transaction(fn func(repos *repository.TxRepositories) error) error {
+	err := fn(t.repos)
+	if err != nil {
+		t.rolledBack = true
+	}
+	return err
+}
+
+// --- テストスイート ---
+
+const actorObjID = "11111111-1111-1111-1111-111111111111"
+
+type UserGroupServiceTestSuite struct {
+	suite.Suite
+	userRepo   *mockUserRepository
+	groupRepo  *memoryGroupRepository
+	roleRepo   *mockRoleRepository
+	auditRepo  *recordingAuditLogRepository
+	transactor *fakeTransactor
+	service    group.UserGroupService
+}
+
+func TestUserGroupServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserGroupServiceTestSuite))
+}
+
+func (suite *UserGroupServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.groupRepo = newMemoryGroupRepository()
+	suite.roleRepo = new(mockRoleRepository)
+	suite.auditRepo = &recordingAuditLogRepository{}
+	suite.transactor = &fakeTransactor{repos: &repository.TxRepositories{Users: suite.userRepo, Groups: suite.groupRepo, Roles: suite.roleRepo, AuditLogs: suite.auditRepo}}
+	suite.service = group.NewUserGroupService(suite.userRepo, suite.groupRepo, suite.roleRepo, suite.transactor, &group.Config{CacheTTL: time.Minute})
+	// 監査ログに記録する、操作したアカウントの種類の取得（操作するアカウントは admin を持つ）
+	suite.userRepo.On("GetUserByObjID", actorObjID).Return(suite.newActor(actorObjID, value.KindUser, value.Admin), nil).Maybe()
+	suite.roleRepo.On("FindRolesByNames", []string{value.Admin}).Return([]*entity.Role{suite.newRole(value.Admin, value.PermissionAll)}, nil).Maybe()
+}
+
+// newActor は操作するアカウントを生成する
+func (suite *UserGroupServiceTestSuite) newActor(objID string, kind string, roles ...string) *entity.User {
+	objIDValue, _ := value.NewUserObjID(objID)
+	email, _ := value.NewUserEmail("actor@example.com")
+	username, _ := value.NewUserUsername("actor")
+	userKind, _ := value.NewUserKind(kind)
+	roleValues := make([]*value.UserRole, 0, len(roles))
+	for _, name := range roles {
+		role, err := value.NewUserRole(name)
+		suite.Require().NoError(err)
+		roleValues = append(roleValues, role)
+	}
+	actor, err := entity.BuildUser(objIDValue, email, value.NoPassword(), username, nil, nil, nil, roleValues, nil, "", nil, nil, userKind)
+	suite.Require().NoError(err)
+	return actor
+}
+
+// newRole は指定した権限を持つロールを生成する
+func (suite *UserGroupServiceTestSuite) newRole(name string, permissions ...string) *entity.Role {
+	roleName, err := value.NewUserRole(name)
+	suite.Require().NoError(err)
+	permissionValues := make([]*value.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		p, err := value.NewPermission(permission)
+		suite.Require().NoError(err)
+		permissionValues = append(permissionValues, p)
+	}
+	r, err := entity.BuildRole(roleName, "", permissionValues)
+	suite.Require().NoError(err)
+	return r
+}
+
+// newUser はユーザーを生成し、IDで取得できるようにする
+func (suite *UserGroupServiceTestSuite) newUser() *entity.User {
+	email, _ := value.NewUserEmail("member@example.com")
+	username, _ := value.NewUserUsername("member")
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	suite.userRepo.On("GetUserByObjID", user.ObjID().Value()).Return(user, nil)
+	return user
+}
+
+// createGroup はロール・権限を指定してグループを作成する
+func (suite *UserGroupServiceTestSuite) createGroup(name string, roles []string, permissions []string) *entity.Group {
+	if len(roles) > 0 {
+		found := make([]*entity.Role, 0, len(roles))
+		for _, r := range roles {
+			found = append(found, suite.newRole(r))
+		}
+		suite.roleRepo.On("FindRolesByNames", roles).Return(found, nil).Once()
+	}
+	created, err := suite.service.CreateGroup(actorObjID, name, "", roles, permissions)
+	suite.Require().NoError(err)
+	return created
+}
+
+// ----- CreateGroup / UpdateGroup / DeleteGroup のテスト -----
+
+func (suite *UserGroupServiceTestSuite) TestCreateGroup() {
+	created := suite.createGroup("engineering", []string{"support"}, []string{value.PermissionUsersRead})
+	suite.Equal("engineering", created.Name().Value())
+	suite.Equal([]string{"support"}, created.RoleNames())
+	suite.Equal([]string{value.PermissionUsersRead}, created.PermissionNames())
+
+	suite.Require().Len(suite.auditRepo.logs, 1)
+	log := suite.auditRepo.logs[0]
+	suite.Equal(entity.AuditActionGroupCreate, log.Action())
+	suite.Equal(map[string]string{"group": "engineering", "roles": "support", "permissions": "users:read"}, log.Detail())
+}
+
+func (suite *UserGroupServiceTestSuite) TestCreateGroup_Invalid() {
+	suite.createGroup("engineering", nil, nil)
+
+	_, err := suite.service.CreateGroup(actorObjID, "engineering", "", nil, nil)
+	suite.ErrorIs(err, group.ErrGroupAlreadyExists)
+	_, err = suite.service.CreateGroup(actorObjID, "Engineering Team", "", nil, nil)
+	suite.ErrorIs(err, group.ErrInvalidGroup)
+	_, err = suite.service.CreateGroup(actorObjID, "sales", "", nil, []string{"users:delete"})
+	suite.ErrorIs(err, group.ErrInvalidGroup, "定義されていない権限は指定できないこと")
+
+	suite.roleRepo.On("FindRolesByNames", []string{"missing"}).Return([]*entity.Role{}, nil)
+	_, err = suite.service.CreateGroup(actorObjID, "sales", "", []string{"missing"}, nil)
+	suite.ErrorIs(err, group.ErrRoleNotFound, "存在しないロールは指定できないこと")
+	suite.Len(suite.auditRepo.logs, 1)
+}
+
+func (suite *UserGroupServiceTestSuite) TestUpdateGroup() {
+	created := suite.createGroup("engineering", nil, nil)
+	suite.roleRepo.On("FindRolesByNames", []string{"support"}).Return([]*entity.Role{suite.newRole("support")}, nil)
+
+	updated, err := suite.service.UpdateGroup(actorObjID, created.ObjID().Value(), "開発チーム", []string{"support"}, []string{value.PermissionUsersWrite})
+	suite.NoError(err)
+	suite.Equal("開発チーム", updated.Description())
+	suite.Equal([]string{"support"}, updated.RoleNames())
+	suite.Equal([]string{value.PermissionUsersWrite}, updated.PermissionNames())
+	suite.Equal(entity.AuditActionGroupUpdate, suite.auditRepo.logs[1].Action())
+
+	_, err = suite.service.UpdateGroup(actorObjID, "22222222-2222-2222-2222-222222222222", "", nil, nil)
+	suite.ErrorIs(err, group.ErrGroupNotFound)
+	_, err = suite.service.UpdateGroup(actorObjID, "not-a-uuid", "", nil, nil)
+	suite.ErrorIs(err, group.ErrGroupNotFound)
+}
+
+func (suite *UserGroupServiceTestSuite) TestDeleteGroup() {
+	created := suite.createGroup("engineering", nil, nil)
+
+	suite.NoError(suite.service.DeleteGroup(actorObjID, created.ObjID().Value()))
+	_, _, err := suite.service.GetGroup(created.ObjID().Value())
+	suite.ErrorIs(err, group.ErrGroupNotFound)
+	suite.Equal(entity.AuditActionGroupDelete, suite.auditRepo.logs[1].Action())
+	suite.Equal(map[string]string{"group": "engineering"}, suite.auditRepo.logs[1].Detail())
+
+	suite.ErrorIs(suite.service.DeleteGroup(actorObjID, created.ObjID().Value()), group.ErrGroupNotFound)
+}
+
+func (suite *UserGroupServiceTestSuite) TestCreateAndUpdateGroup_CannotGrantPermissionsNotHeld() {
+	// groups:write だけを持つアカウント
+	writerObjID := "44444444-4444-4444-4444-444444444444"
+	suite.userRepo.On("GetUserByObjID", writerObjID).Return(suite.newActor(writerObjID, value.KindUser, "group-manager"), nil)
+	suite.roleRepo.On("FindRolesByNames", []string{"group-manager"}).Return([]*entity.Role{suite.newRole("group-manager", value.PermissionGroupsWrite)}, nil)
+	suite.roleRepo.On("FindRolesByNames", []string{value.Admin}).Return([]*entity.Role{suite.newRole(value.Admin, value.PermissionAll)}, nil)
+
+	_, err := suite.service.CreateGroup(writerObjID, "escalation", "", []string{value.Admin}, nil)
+	suite.ErrorIs(err, group.ErrCannotGrant, "持たない権限を与えるロールはグループに与えられないこと")
+	_, err = suite.service.CreateGroup(writerObjID, "escalation", "", nil, []string{value.PermissionUsersWrite})
+	suite.ErrorIs(err, group.ErrCannotGrant, "持たない権限はグループに与えられないこと")
+	created, err := suite.service.CreateGroup(writerObjID, "managers", "", nil, []string{value.PermissionGroupsWrite})
+	suite.NoError(err, "持っている権限は与えられること")
+
+	_, err = suite.service.UpdateGroup(writerObjID, created.ObjID().Value(), "", []string{value.Admin}, nil)
+	suite.ErrorIs(err, group.ErrCannotGrant)
+	suite.Len(suite.auditRepo.logs, 1)
+}
+
+// ----- 所属の変更のテスト -----
+
+func (suite *UserGroupServiceTestSuite) TestAddAndRemoveUser() {
+	created := suite.createGroup("engineering", nil, nil)
+	user := suite.newUser()
+	userObjID := user.ObjID().Value()
+
+	suite.NoError(suite.service.AddUser(actorObjID, created.ObjID().Value(), userObjID))
+	_, members, err := suite.service.GetGroup(created.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{userObjID}, members.UserObjIDs)
+	log := suite.auditRepo.logs[1]
+	suite.Equal(entity.AuditActionGroupMemberAdd, log.Action())
+	suite.Equal(userObjID, log.TargetObjID())
+
+	suite.NoError(suite.service.RemoveUser(actorObjID, created.ObjID().Value(), userObjID))
+	_, members, err = suite.service.GetGroup(created.ObjID().Value())
+	suite.NoError(err)
+	suite.Empty(members.UserObjIDs)
+	suite.Equal(entity.AuditActionGroupMemberRemove, suite.auditRepo.logs[2].Action())
+}
+
+func (suite *UserGroupServiceTestSuite) TestAddUserAndSubgroup_CannotGrantPermissionsNotHeld() {
+	// 親のグループが admin を与えるため、子のグループのメンバーも admin を得る
+	admins := suite.createGroup("admins", []string{value.Admin}, nil)
+	child := suite.createGroup("backend", nil, nil)
+	suite.NoError(suite.service.AddSubgroup(actorObjID, admins.ObjID().Value(), child.ObjID().Value()))
+
+	writerObjID := "44444444-4444-4444-4444-444444444444"
+	suite.userRepo.On("GetUserByObjID", writerObjID).Return(suite.newActor(writerObjID, value.KindUser, "group-manager"), nil)
+	suite.roleRepo.On("FindRolesByNames", []string{"group-manager"}).Return([]*entity.Role{suite.newRole("group-manager", value.PermissionGroupsWrite)}, nil)
+	other := suite.createGroup("sales", nil, nil)
+
+	suite.ErrorIs(suite.service.AddUser(writerObjID, child.ObjID().Value(), writerObjID), group.ErrCannotGrant, "admin を与えるグループには自身も所属させられないこと")
+	suite.ErrorIs(suite.service.AddSubgroup(writerObjID, child.ObjID().Value(), other.ObjID().Value()), group.ErrCannotGrant)
+	_, members, err := suite.service.GetGroup(child.ObjID().Value())
+	suite.NoError(err)
+	suite.Empty(members.UserObjIDs)
+	suite.Empty(members.GroupObjIDs)
+
+	suite.NoError(suite.service.AddUser(writerObjID, other.ObjID().Value(), writerObjID), "権限を与えないグループには所属させられること")
+}
+
+func (suite *UserGroupServiceTestSuite) TestAddUser_NotFound() {
+	created := suite.createGroup("engineering", nil, nil)
+	missing := "33333333-3333-3333-3333-333333333333"
+	suite.userRepo.On("GetUserByObjID", missing).Return(nil, errs.NewInfraError("record not found"))
+
+	suite.ErrorIs(suite.service.AddUser(actorObjID, created.ObjID().Value(), missing), group.ErrUserNotFound)
+	suite.ErrorIs(suite.service.AddUser(actorObjID, created.ObjID().Value(), "not-a-uuid"), group.ErrUserNotFound)
+	suite.ErrorIs(suite.service.AddUser(actorObjID, "22222222-2222-2222-2222-222222222222", missing), group.ErrGroupNotFound)
+	suite.Len(suite.auditRepo.logs, 1)
+}
+
+// 監査ログを記録できない場合は、所属の変更を取り消してエラーを返すこと
+func (suite *UserGroupServiceTestSuite) TestAuditFailureFailsAction() {
+	created := suite.createGroup("engineering", nil, nil)
+	user := suite.newUser()
+	suite.auditRepo.err = errors.New("db down")
+
+	suite.ErrorIs(suite.service.AddUser(actorObjID, created.ObjID().Value(), user.ObjID().Value()), group.ErrAuditLogFailed)
+	suite.True(suite.transactor.rolledBack, "操作と監査ログの記録をまとめて取り消すこと")
+}
+
+func (suite *UserGroupServiceTestSuite) TestAddSubgroup_Circular() {
+	parent := suite.createGroup("engineering", nil, nil)
+	child := suite.createGroup("backend", nil, nil)
+	grandchild := suite.createGroup("database", nil, nil)
+	suite.NoError(suite.service.AddSubgroup(actorObjID, parent.ObjID().Value(), child.ObjID().Value()))
+	suite.NoError(suite.service.AddSubgroup(actorObjID, child.ObjID().Value(), grandchild.ObjID().Value()))
+
+	suite.ErrorIs(suite.service.AddSubgroup(actorObjID, parent.ObjID().Value(), parent.ObjID().Value()), group.ErrCircularMembership, "グループ自身は含められないこと")
+	suite.ErrorIs(suite.service.AddSubgroup(actorObjID, child.ObjID().Value(), parent.ObjID().Value()), group.ErrCircularMembership)
+	suite.ErrorIs(suite.service.AddSubgroup(actorObjID, grandchild.ObjID().Value(), parent.ObjID().Value()), group.ErrCircularMembership, "間接的に含むグループも含められないこと")
+	suite.ErrorIs(suite.service.AddSubgroup(actorObjID, parent.ObjID().Value(), "22222222-2222-2222-2222-222222222222"), group.ErrSubgroupNotFound)
+
+	suite.NoError(suite.service.RemoveSubgroup(actorObjID, parent.ObjID().Value(), child.ObjID().Value()))
+	suite.NoError(suite.service.AddSubgroup(actorObjID, grandchild.ObjID().Value(), parent.ObjID().Value()), "外した後は循環しないため含められること")
+}
+
+// ----- ResolveUserGroups のテスト -----
+
+func (suite *UserGroupServiceTestSuite) TestResolveUserGroups_Nested() {
+	parent := suite.createGroup("engineering", []string{"support"}, []string{value.PermissionUsersRead})
+	child := suite.createGroup("backend", []string{"auditor", "support"}, []string{value.PermissionRolesRead})
+	suite.createGroup("sales", []string{"sales"}, nil)
+	suite.roleRepo.On("FindRolesByNames", []string{"support"}).Return([]*entity.Role{suite.newRole("support")}, nil).Once()
+	suite.NoError(suite.service.AddSubgroup(actorObjID, parent.ObjID().Value(), child.ObjID().Value()))
+	user := suite.newUser()
+	suite.roleRepo.On("FindRolesByNames", []string{"auditor", "support"}).Return([]*entity.Role{suite.newRole("auditor"), suite.newRole("support")}, nil).Once()
+	suite.NoError(suite.service.AddUser(actorObjID, child.ObjID().Value(), user.ObjID().Value()))
+
+	resolved, err := suite.service.ResolveUserGroups(user.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{"backend", "engineering"}, resolved.Groups, "入れ子のグループを通じて親のグループにも所属すること")
+	suite.Equal([]string{"auditor", "support"}, resolved.Roles)
+	suite.Equal([]string{value.PermissionRolesRead, value.PermissionUsersRead}, resolved.Permissions)
+
+	names, err := suite.service.GroupNames(user.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{"backend", "engineering"}, names)
+}
+
+func (suite *UserGroupServiceTestSuite) TestResolveUserGroups_NoGroups() {
+	resolved, err := suite.service.ResolveUserGroups("33333333-3333-3333-3333-333333333333")
+	suite.NoError(err)
+	suite.NotNil(resolved.Groups, "所属しない場合も空の一覧を返すこと")
+	suite.Empty(resolved.Groups)
+	suite.Empty(resolved.Roles)
+	suite.Empty(resolved.Permissions)
+}
+
+func (suite *UserGroupServiceTestSuite) TestResolveUserGroups_Cache() {
+	first := suite.createGroup("engineering", nil, nil)
+	second := suite.createGroup("sales", nil, nil)
+	user := suite.newUser()
+	userObjID := user.ObjID().Value()
+	suite.NoError(suite.service.AddUser(actorObjID, first.ObjID().Value(), userObjID))
+
+	resolved, err := suite.service.ResolveUserGroups(userObjID)
+	suite.NoError(err)
+	suite.Equal([]string{"engineering"}, resolved.Groups)
+	_, err = suite.service.ResolveUserGroups(userObjID)
+	suite.NoError(err)
+	suite.Equal(1, suite.groupRepo.resolveCalls, "保持している結果を返すこと")
+
+	suite.NoError(suite.service.AddUser(actorObjID, second.ObjID().Value(), userObjID))
+	resolved, err = suite.service.ResolveUserGroups(userObjID)
+	suite.NoError(err)
+	suite.Equal([]string{"engineering", "sales"}, resolved.Groups, "所属の変更で保持している結果を破棄すること")
+	suite.Equal(2, suite.groupRepo.resolveCalls)
+
+	suite.service.InvalidateCache()
+	_, err = suite.service.ResolveUserGroups(userObjID)
+	suite.NoError(err)
+	suite.Equal(3, suite.groupRepo.resolveCalls)
+}
+
+func (suite *UserGroupServiceTestSuite) TestResolveUserGroups_CacheDisabled() {
+	service := group.NewUserGroupService(suite.userRepo, suite.groupRepo, suite.roleRepo, suite.transactor, &group.Config{CacheTTL: 0})
+	userObjID := "33333333-3333-3333-3333-333333333333"
+
+	for range 2 {
+		_, err := service.ResolveUserGroups(userObjID)
+		suite.NoError(err)
+	}
+	suite.Equal(2, suite.groupRepo.resolveCalls, "保持する時間が0の場合は毎回解決すること")
+}
@@ -0,0 +1,38 @@
+package lockout
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	FailureWindow    time.Duration       // 最後の失敗からこの時間が経過すると失敗回数を数え直す
+	BackoffThreshold int                 // アカウントごとの失敗回数がこの回数に達すると次の試行まで待たせる
+	BackoffBase      time.Duration       // 最初の待ち時間（失敗のたびに倍になる）
+	BackoffMax       time.Duration       // 待ち時間の上限
+	LockThreshold    int                 // アカウントごとの失敗回数がこの回数に達するとアカウントをロックする
+	LockDuration     time.Duration       // アカウントをロックする時間
+	IPThreshold      int                 // 接続元 IP アドレスごとの失敗回数がこの回数に達するとその IP アドレスからの試行を拒否する
+	IPBlockDuration  time.Duration       // 接続元 IP アドレスからの試行を拒否する時間
+	UnlockURL        string              // ロック解除リンクの遷移先（token クエリが付与される）
+	Locale           mailer.Locale       // メール文面の言語
+	EmailKeys        value.EmailKeyRules // アカウントごとの失敗を数えるキー（正規化したメールアドレス）の作り方
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		FailureWindow:    utils.GetEnvDuration("LOGIN_FAILURE_WINDOW", time.Hour),
+		BackoffThreshold: utils.GetEnvInt("LOGIN_BACKOFF_THRESHOLD", 3),
+		BackoffBase:      utils.GetEnvDuration("LOGIN_BACKOFF_BASE", time.Second),
+		BackoffMax:       utils.GetEnvDuration("LOGIN_BACKOFF_MAX", 5*time.Minute),
+		LockThreshold:    utils.GetEnvInt("LOGIN_LOCK_THRESHOLD", 10),
+		LockDuration:     utils.GetEnvDuration("LOGIN_LOCK_DURATION", 30*time.Minute),
+		IPThreshold:      utils.GetEnvInt("LOGIN_IP_THRESHOLD", 50),
+		IPBlockDuration:  utils.GetEnvDuration("LOGIN_IP_BLOCK_DURATION", 15*time.Minute),
+		UnlockURL:        utils.GetEnvDefault("LOGIN_UNLOCK_URL", "http://localhost:3000/unlock"),
+		Locale:           mailer.LocaleFromEnv(),
+	}
+}
@@ -0,0 +1,218 @@
+package lockout
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrLoginThrottled     = errs.NewServiceError("too many login attempts, try again later")
+	ErrAccountLocked      = errs.NewServiceError("account is temporarily locked due to too many failed login attempts")
+	ErrInvalidUnlockToken = errs.NewServiceError("invalid or expired unlock token")
+	ErrUserNotFound       = errs.NewServiceError("user not found")
+)
+
+// LockoutError は、ログインの試行を拒否した理由（ErrLoginThrottled または ErrAccountLocked）と再試行できるまでの時間
+// errors.Is で理由を判定し、errors.As で RetryAfter を取り出す。
+type LockoutError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *LockoutError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LockoutError) Unwrap() error {
+	return e.Err
+}
+
+type UserLockoutService interface {
+	// Check: ログインを試行できるか判定する（できない場合は *LockoutError を返す）
+	Check(email string, ip string) error
+	// RecordFailure: ログインの失敗を記録し、しきい値に達した場合はロックする（ロックした場合は *LockoutError を返す）
+	RecordFailure(email string, ip string) error
+	// RecordSuccess: ログインの成功を記録し、アカウントの失敗回数を戻す
+	RecordSuccess(email string)
+	// Unlock: メールで送信したリンクのトークンでアカウントのロックを解除
+	Unlock(token string) error
+	// AdminUnlock: 管理者がユーザーのアカウントのロックを解除
+	AdminUnlock(objID string) error
+}
+
+// userLockoutService は UserLockoutService の実装
+type userLockoutService struct {
+	userRepository         repository.UserRepository
+	loginFailureRepository repository.LoginFailureRepository
+	mailer                 mailer.Mailer
+	tokens                 *utils.TokenSigner
+	config                 *Config
+}
+
+// NewUserLockoutService は UserLockoutService のインスタンスを作成
+func NewUserLockoutService(userRepository repository.UserRepository, loginFailureRepository repository.LoginFailureRepository, mailer mailer.Mailer, tokens *utils.TokenSigner, config *Config) UserLockoutService {
+	return &userLockoutService{
+		userRepository:         userRepository,
+		loginFailureRepository: loginFailureRepository,
+		mailer:                 mailer,
+		tokens:                 tokens,
+		config:                 config,
+	}
+}
+
+// Check はアカウントと接続元 IP アドレスの失敗の記録から、ログインを試行できるか判定する
+// 登録の有無を推測されないよう、未登録のメールアドレスも同じように判定する
+func (s *userLockoutService) Check(email string, ip string) error {
+	now := time.Now()
+	account, err := s.loginFailureRepository.FindFailure(entity.LoginFailureScopeAccount, s.accountKey(email))
+	if err != nil {
+		return errs.NewServiceError("failed to get login failures")
+	}
+	if account != nil {
+		if account.IsLocked(now) {
+			return &LockoutError{Err: ErrAccountLocked, RetryAfter: account.LockedUntil().Sub(now)}
+		}
+		if until := account.BackoffUntil(s.config.BackoffThreshold, s.config.BackoffBase, s.config.BackoffMax); now.Before(until) {
+			return &LockoutError{Err: ErrLoginThrottled, RetryAfter: until.Sub(now)}
+		}
+	}
+
+	if ip == "" {
+		return nil
+	}
+	client, err := s.loginFailureRepository.FindFailure(entity.LoginFailureScopeIP, ip)
+	if err != nil {
+		return errs.NewServiceError("failed to get login failures")
+	}
+	if client != nil && client.IsLocked(now) {
+		return &LockoutError{Err: ErrLoginThrottled, RetryAfter: client.LockedUntil().Sub(now)}
+	}
+	return nil
+}
+
+// RecordFailure はアカウントと接続元 IP アドレスの失敗回数を加算し、しきい値に達した場合はロックする
+// アカウントをロックした場合は、登録済みのユーザーにロック解除のリンクを含むメールを送信する
+func (s *userLockoutService) RecordFailure(email string, ip string) error {
+	now := time.Now()
+	var lockErr error
+
+	key := s.accountKey(email)
+	account, err := s.loginFailureRepository.RecordFailure(entity.LoginFailureScopeAccount, key, now, s.config.FailureWindow)
+	if err != nil {
+		logger.Warn("failed to record login failure", "scope", entity.LoginFailureScopeAccount, "error", err.Error())
+	} else if s.config.LockThreshold > 0 && account.Failures() >= s.config.LockThreshold && !account.IsLocked(now) {
+		until := now.Add(s.config.LockDuration)
+		if err := s.loginFailureRepository.LockFailure(entity.LoginFailureScopeAccount, key, until); err != nil {
+			logger.Warn("failed to lock account", "error", err.Error())
+		} else {
+			s.notifyLocked(email, until)
+			lockErr = &LockoutError{Err: ErrAccountLocked, RetryAfter: s.config.LockDuration}
+		}
+	}
+
+	if ip == "" {
+		return lockErr
+	}
+	client, err := s.loginFailureRepository.RecordFailure(entity.LoginFailureScopeIP, ip, now, s.config.FailureWindow)
+	if err != nil {
+		logger.Warn("failed to record login failure", "scope", entity.LoginFailureScopeIP, "error", err.Error())
+	} else if s.config.IPThreshold > 0 && client.Failures() >= s.config.IPThreshold && !client.IsLocked(now) {
+		if err := s.loginFailureRepository.LockFailure(entity.LoginFailureScopeIP, ip, now.Add(s.config.IPBlockDuration)); err != nil {
+			logger.Warn("failed to block ip address", "error", err.Error())
+		} else if lockErr == nil {
+			lockErr = &LockoutError{Err: ErrLoginThrottled, RetryAfter: s.config.IPBlockDuration}
+		}
+	}
+	return lockErr
+}
+
+// RecordSuccess はアカウントの失敗回数を戻す
+// 接続元 IP アドレスの失敗回数は、攻撃者が自身のアカウントへのログインで戻せないよう維持する
+func (s *userLockoutService) RecordSuccess(email string) {
+	if err := s.loginFailureRepository.ResetFailures(entity.LoginFailureScopeAccount, s.accountKey(email)); err != nil {
+		logger.Warn("failed to reset login failures", "error", err.Error())
+	}
+}
+
+// Unlock はロック解除のトークンを検証し、アカウントのロックを解除する
+// トークンは発行したときのロックにのみ有効で、解除後や別のロックには使用できない
+func (s *userLockoutService) Unlock(token string) error {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeAccountUnlock, token)
+	if err != nil {
+		return ErrInvalidUnlockToken
+	}
+	key := s.accountKey(claims.Email)
+	account, err := s.loginFailureRepository.FindFailure(entity.LoginFailureScopeAccount, key)
+	if err != nil {
+		return errs.NewServiceError("failed to get login failures")
+	}
+	if account == nil || account.LockedUntil() == nil || strconv.FormatInt(account.LockedUntil().Unix(), 10) != claims.Ref {
+		return ErrInvalidUnlockToken
+	}
+	if err := s.loginFailureRepository.ResetFailures(entity.LoginFailureScopeAccount, key); err != nil {
+		return errs.NewServiceError("failed to unlock account")
+	}
+	return nil
+}
+
+// AdminUnlock はユーザーのアカウントのロックを解除する
+func (s *userLockoutService) AdminUnlock(objID string) error {
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+	if err := s.loginFailureRepository.ResetFailures(entity.LoginFailureScopeAccount, s.accountKey(user.Email().Value())); err != nil {
+		return errs.NewServiceError("failed to unlock account")
+	}
+	return nil
+}
+
+// notifyLocked は登録済みのユーザーにアカウントのロックとロック解除のリンクを通知する
+// 通知できなくてもロックは維持する（一定時間後に自動的に解除される）
+func (s *userLockoutService) notifyLocked(email string, until time.Time) {
+	user, err := s.userRepository.GetUserByEmail(email)
+	if err != nil {
+		return
+	}
+	token, err := s.tokens.GenerateActionTokenWithRef(utils.PurposeAccountUnlock, user.ObjID().Value(), user.Email().Value(), strconv.FormatInt(until.Unix(), 10), s.config.LockDuration)
+	if err != nil {
+		logger.Warn("failed to generate unlock token", "error", err.Error())
+		return
+	}
+	link, err := utils.BuildTokenLink(s.config.UnlockURL, token)
+	if err != nil {
+		logger.Warn("failed to build unlock link", "error", err.Error())
+		return
+	}
+	msg, err := mailer.Render(mailer.TemplateAccountLocked, s.config.Locale, user.Email().Value(), map[string]any{
+		"Username":  user.Username().Value(),
+		"Link":      link,
+		"ExpiresIn": s.config.LockDuration,
+	})
+	if err != nil {
+		logger.Warn("failed to render account locked email", "error", err.Error())
+		return
+	}
+	if err := s.mailer.Send(msg); err != nil {
+		logger.Warn("failed to send account locked email", "error", err.Error())
+	}
+}
+
+// accountKey はアカウントごとの失敗を数えるためのキー（正規化したメールアドレス）を返す
+// メールアドレスとして不正な入力も、大文字小文字を区別せずに数える
+func (s *userLockoutService) accountKey(email string) string {
+	emailValue, err := value.NewUserEmail(email)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(email))
+	}
+	return s.config.EmailKeys.Key(emailValue)
+}
@@ -0,0 +1,319 @@
+package lockout_test
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+// inMemoryLoginFailureRepository はメモリ上でログイン失敗を保持するテスト用リポジトリ
+type inMemoryLoginFailureRepository struct {
+	items map[string]*entity.LoginFailure
+}
+
+func (r *inMemoryLoginFailureRepository) FindFailure(scope string, key string) (*entity.LoginFailure, error) {
+	return r.items[scope+":"+key], nil
+}
+
+func (r *inMemoryLoginFailureRepository) RecordFailure(scope string, key string, failedAt time.Time, window time.Duration) (*entity.LoginFailure, error) {
+	failures, lockedUntil := 1, (*time.Time)(nil)
+	if f, ok := r.items[scope+":"+key]; ok && !f.LastFailedAt().Before(failedAt.Add(-window)) {
+		failures, lockedUntil = f.Failures()+1, f.LockedUntil()
+	}
+	return r.put(scope, key, failures, failedAt, lockedUntil)
+}
+
+func (r *inMemoryLoginFailureRepository) LockFailure(scope string, key string, until time.Time) error {
+	if f, ok := r.items[scope+":"+key]; ok {
+		_, err := r.put(scope, key, f.Failures(), f.LastFailedAt(), &until)
+		return err
+	}
+	return nil
+}
+
+func (r *inMemoryLoginFailureRepository) ResetFailures(scope string, key string) error {
+	if f, ok := r.items[scope+":"+key]; ok {
+		_, err := r.put(scope, key, 0, f.LastFailedAt(), nil)
+		return err
+	}
+	return nil
+}
+
+func (r *inMemoryLoginFailureRepository) put(scope string, key string, failures int, lastFailedAt time.Time, lockedUntil *time.Time) (*entity.LoginFailure, error) {
+	f, err := entity.BuildLoginFailure(scope, key, failures, lastFailedAt, lockedUntil)
+	if err != nil {
+		return nil, err
+	}
+	r.items[scope+":"+key] = f
+	return f, nil
+}
+
+// backdate は最後の失敗日時をずらす（待ち時間の経過の確認用）
+func (r *inMemoryLoginFailureRepository) backdate(d time.Duration) {
+	for k, f := range r.items {
+		r.items[k], _ = entity.BuildLoginFailure(f.Scope(), f.Key(), f.Failures(), f.LastFailedAt().Add(-d), f.LockedUntil())
+	}
+}
+
+// unlockToken は指定アドレス宛ての最後のメール本文から token クエリを取り出す
+func unlockToken(outbox *mailer.MemoryOutbox, to string) string {
+	msg := outbox.LastTo(to)
+	if msg == nil {
+		return ""
+	}
+	u, err := url.Parse(regexp.MustCompile(`https?://\S+`).FindString(msg.Body))
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("token")
+}
+
+// --- テストスイート ---
+
+type UserLockoutServiceTestSuite struct {
+	suite.Suite
+	userRepo    *mockUserRepository
+	failureRepo *inMemoryLoginFailureRepository
+	outbox      *mailer.MemoryOutbox
+	config      *lockout.Config
+	service     lockout.UserLockoutService
+	testUser    *entity.User
+}
+
+func TestUserLockoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserLockoutServiceTestSuite))
+}
+
+func (suite *UserLockoutServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserLockoutServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserLockoutServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.failureRepo = &inMemoryLoginFailureRepository{items: make(map[string]*entity.LoginFailure)}
+	suite.outbox = mailer.NewMemoryOutbox()
+	suite.config = &lockout.Config{
+		FailureWindow:    time.Hour,
+		BackoffThreshold: 3,
+		BackoffBase:      time.Minute,
+		BackoffMax:       10 * time.Minute,
+		LockThreshold:    5,
+		LockDuration:     30 * time.Minute,
+		IPThreshold:      8,
+		IPBlockDuration:  15 * time.Minute,
+		UnlockURL:        "https://app.example.com/unlock",
+		Locale:           mailer.LocaleJa,
+	}
+	suite.service = lockout.NewUserLockoutService(suite.userRepo, suite.failureRepo, suite.outbox, utils.DefaultTokenSigner(), suite.config)
+
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	testUser, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	suite.testUser = testUser
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(suite.testUser, nil).Maybe()
+}
+
+// fail は失敗を n 回記録し、最後の結果を返す（待ち時間は経過したものとして扱う）
+func (suite *UserLockoutServiceTestSuite) fail(email string, ip string, n int) error {
+	var err error
+	for i := 0; i < n; i++ {
+		suite.failureRepo.backdate(suite.config.BackoffMax)
+		err = suite.service.RecordFailure(email, ip)
+	}
+	return err
+}
+
+// しきい値までは待たせず、しきい値に達すると再試行できるまでの時間を返すこと
+func (suite *UserLockoutServiceTestSuite) TestCheck_Backoff() {
+	suite.NoError(suite.fail("test@example.com", "", 2))
+	suite.NoError(suite.service.Check("test@example.com", ""))
+
+	suite.NoError(suite.fail("test@example.com", "", 1))
+	err := suite.service.Check("Test@Example.com", "")
+	suite.ErrorIs(err, lockout.ErrLoginThrottled, "大文字小文字を区別せずに数えること")
+	var lockoutErr *lockout.LockoutError
+	suite.Require().ErrorAs(err, &lockoutErr)
+	suite.InDelta(time.Minute.Seconds(), lockoutErr.RetryAfter.Seconds(), 1)
+
+	// 待ち時間が経過すれば試行できること
+	suite.failureRepo.backdate(time.Minute)
+	suite.NoError(suite.service.Check("test@example.com", ""))
+}
+
+// プロバイダー固有ルールを適用する場合は、同じメールボックスを指すアドレスの失敗をまとめて数えること
+func (suite *UserLockoutServiceTestSuite) TestCheck_ProviderRules() {
+	suite.config.EmailKeys = value.EmailKeyRules{ProviderRules: true}
+	suite.userRepo.On("GetUserByEmail", mock.Anything).Return(nil, errs.NewInfraError("not found"))
+
+	suite.NoError(suite.fail("first.last@gmail.com", "", 2))
+	suite.NoError(suite.fail("firstlast+alias@googlemail.com", "", 1))
+	suite.ErrorIs(suite.service.Check("First.Last+other@gmail.com", ""), lockout.ErrLoginThrottled)
+}
+
+// しきい値に達するとロックし、解除リンクを含むメールを送信すること
+func (suite *UserLockoutServiceTestSuite) TestRecordFailure_Lock() {
+	suite.NoError(suite.fail("test@example.com", "", 4))
+	suite.Empty(suite.outbox.Messages())
+
+	err := suite.fail("test@example.com", "", 1)
+	suite.ErrorIs(err, lockout.ErrAccountLocked)
+	var lockoutErr *lockout.LockoutError
+	suite.Require().ErrorAs(err, &lockoutErr)
+	suite.Equal(30*time.Minute, lockoutErr.RetryAfter)
+
+	err = suite.service.Check("test@example.com", "")
+	suite.ErrorIs(err, lockout.ErrAccountLocked)
+	suite.Len(suite.outbox.MessagesTo("test@example.com"), 1)
+	suite.NotEmpty(unlockToken(suite.outbox, "test@example.com"))
+}
+
+// 未登録のメールアドレスも同じようにロックし、メールは送信しないこと
+func (suite *UserLockoutServiceTestSuite) TestRecordFailure_UnknownEmail() {
+	suite.userRepo.On("GetUserByEmail", "unknown@example.com").Return(nil, errs.NewInfraError("not found"))
+
+	err := suite.fail("unknown@example.com", "", 5)
+	suite.ErrorIs(err, lockout.ErrAccountLocked)
+	suite.ErrorIs(suite.service.Check("unknown@example.com", ""), lockout.ErrAccountLocked)
+	suite.Empty(suite.outbox.Messages())
+}
+
+// 接続元 IP アドレスごとの失敗がしきい値に達すると、別のアカウントでも試行を拒否すること
+func (suite *UserLockoutServiceTestSuite) TestRecordFailure_IPBlock() {
+	suite.userRepo.On("GetUserByEmail", mock.Anything).Return(nil, errs.NewInfraError("not found"))
+	for i := 0; i < 7; i++ {
+		suite.NoError(suite.fail("user"+string(rune('a'+i))+"@example.com", "192.0.2.1", 1))
+	}
+	err := suite.fail("other@example.com", "192.0.2.1", 1)
+	suite.ErrorIs(err, lockout.ErrLoginThrottled)
+
+	suite.ErrorIs(suite.service.Check("new@example.com", "192.0.2.1"), lockout.ErrLoginThrottled)
+	suite.NoError(suite.service.Check("new@example.com", "192.0.2.2"), "別の IP アドレスからは試行できること")
+}
+
+// ログインに成功するとアカウントの失敗回数が戻ること
+func (suite *UserLockoutServiceTestSuite) TestRecordSuccess() {
+	suite.NoError(suite.fail("test@example.com", "192.0.2.1", 3))
+	suite.service.RecordSuccess("test@example.com")
+	suite.NoError(suite.service.Check("test@example.com", "192.0.2.1"))
+
+	f, err := suite.failureRepo.FindFailure(entity.LoginFailureScopeIP, "192.0.2.1")
+	suite.Require().NoError(err)
+	suite.Equal(3, f.Failures(), "接続元 IP アドレスの失敗回数は戻さないこと")
+}
+
+// メールのリンクでロックを解除でき、同じリンクは再利用できないこと
+func (suite *UserLockoutServiceTestSuite) TestUnlock() {
+	suite.ErrorIs(suite.fail("test@example.com", "", 5), lockout.ErrAccountLocked)
+	token := unlockToken(suite.outbox, "test@example.com")
+
+	suite.NoError(suite.service.Unlock(token))
+	suite.NoError(suite.service.Check("test@example.com", ""))
+
+	suite.ErrorIs(suite.service.Unlock(token), lockout.ErrInvalidUnlockToken, "解除済みのロックには使用できないこと")
+	suite.ErrorIs(suite.service.Unlock("invalid-token"), lockout.ErrInvalidUnlockToken)
+}
+
+// 別のロックのリンクでは解除できないこと
+func (suite *UserLockoutServiceTestSuite) TestUnlock_StaleToken() {
+	suite.ErrorIs(suite.fail("test@example.com", "", 5), lockout.ErrAccountLocked)
+	token := unlockToken(suite.outbox, "test@example.com")
+	suite.NoError(suite.service.Unlock(token))
+
+	// 2 回目のロック（ロックの期限は秒単位でずらす）
+	suite.failureRepo.backdate(2 * time.Hour)
+	suite.ErrorIs(suite.fail("test@example.com", "", 5), lockout.ErrAccountLocked)
+	f, err := suite.failureRepo.FindFailure(entity.LoginFailureScopeAccount, "test@example.com")
+	suite.Require().NoError(err)
+	until := f.LockedUntil().Add(time.Second)
+	suite.Require().NoError(suite.failureRepo.LockFailure(entity.LoginFailureScopeAccount, "test@example.com", until))
+
+	suite.ErrorIs(suite.service.Unlock(token), lockout.ErrInvalidUnlockToken)
+	suite.ErrorIs(suite.service.Check("test@example.com", ""), lockout.ErrAccountLocked)
+}
+
+// 管理者がロックを解除できること
+func (suite *UserLockoutServiceTestSuite) TestAdminUnlock() {
+	suite.ErrorIs(suite.fail("test@example.com", "", 5), lockout.ErrAccountLocked)
+	suite.userRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+
+	suite.NoError(suite.service.AdminUnlock(suite.testUser.ObjID().Value()))
+	suite.NoError(suite.service.Check("test@example.com", ""))
+}
+
+func (suite *UserLockoutServiceTestSuite) TestAdminUnlock_UserNotFound() {
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errors.New("not found"))
+
+	suite.ErrorIs(suite.service.AdminUnlock("unknown"), lockout.ErrUserNotFound)
+}
@@ -0,0 +1,33 @@
+package loginalert
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// 新しい端末・接続元からのログインの通知の方法
+const (
+	NotifierEmail   = "email"   // ユーザーのメールアドレスに送信する
+	NotifierWebhook = "webhook" // WebhookURL に JSON で POST する（プッシュ通知などは受信側で行う）
+	NotifierNone    = "none"    // 通知しない
+)
+
+type Config struct {
+	Notifier   string        // 通知の方法（NotifierEmail / NotifierWebhook / NotifierNone）
+	WebhookURL string        // NotifierWebhook の場合の通知先
+	SecureURL  string        // アカウント保護リンクの遷移先（token クエリが付与される）
+	LinkTTL    time.Duration // アカウント保護リンクの有効期限
+	Locale     mailer.Locale // メール文面の言語
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		Notifier:   utils.GetEnvDefault("LOGIN_ALERT_NOTIFIER", NotifierEmail),
+		WebhookURL: utils.GetEnvDefault("LOGIN_ALERT_WEBHOOK_URL", ""),
+		SecureURL:  utils.GetEnvDefault("LOGIN_ALERT_SECURE_URL", "http://localhost:3000/secure-account"),
+		LinkTTL:    utils.GetEnvDuration("LOGIN_ALERT_LINK_TTL", 7*24*time.Hour),
+		Locale:     mailer.LocaleFromEnv(),
+	}
+}
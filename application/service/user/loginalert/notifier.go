@@ -0,0 +1,135 @@
+package loginalert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+)
+
+// Notification は、新しい端末・接続元からのログインの通知の内容
+type Notification struct {
+	UserObjID  string
+	Email      string
+	Username   string
+	Device     string // ブラウザと OS の種類（"Chrome on macOS" など）
+	IPAddress  string
+	UserAgent  string
+	OccurredAt time.Time
+	SecureLink string        // すべてのトークンを無効にし、パスワードの再設定を始めるリンク
+	LinkTTL    time.Duration // SecureLink の有効期限
+}
+
+// Notifier は、新しい端末・接続元からのログインをユーザーに知らせる通知の方法
+type Notifier interface {
+	// NotifyNewDevice: 新しい端末・接続元からのログインを通知
+	NotifyNewDevice(notification *Notification) error
+}
+
+// NewNotifier は、設定に応じた Notifier を返します。NotifierNone の場合は nil を返します。
+func NewNotifier(config *Config, mailSender mailer.Mailer) (Notifier, error) {
+	switch config.Notifier {
+	case NotifierEmail:
+		return NewMailNotifier(mailSender, config.Locale), nil
+	case NotifierWebhook:
+		if config.WebhookURL == "" {
+			return nil, errs.NewServiceError("LOGIN_ALERT_WEBHOOK_URL is required for the webhook notifier")
+		}
+		return NewWebhookNotifier(config.WebhookURL), nil
+	case NotifierNone:
+		return nil, nil
+	default:
+		return nil, errs.NewServiceError(fmt.Sprintf("unknown login alert notifier: %s", config.Notifier))
+	}
+}
+
+// mailNotifier は、ユーザーのメールアドレスに通知する Notifier の実装
+type mailNotifier struct {
+	mailer mailer.Mailer
+	locale mailer.Locale
+}
+
+// NewMailNotifier は、メールで通知する Notifier を返します。
+func NewMailNotifier(mailSender mailer.Mailer, locale mailer.Locale) Notifier {
+	return &mailNotifier{mailer: mailSender, locale: locale}
+}
+
+func (n *mailNotifier) NotifyNewDevice(notification *Notification) error {
+	msg, err := mailer.Render(mailer.TemplateNewDeviceLogin, n.locale, notification.Email, map[string]any{
+		"Username":   notification.Username,
+		"Device":     notification.Device,
+		"IPAddress":  notification.IPAddress,
+		"OccurredAt": notification.OccurredAt,
+		"Link":       notification.SecureLink,
+		"ExpiresIn":  notification.LinkTTL,
+	})
+	if err != nil {
+		return errs.NewServiceError("failed to render new device login email")
+	}
+	if err := n.mailer.Send(msg); err != nil {
+		return errs.NewServiceError("failed to send new device login email")
+	}
+	return nil
+}
+
+// webhookNotification は Webhook で送信する通知の本文
+type webhookNotification struct {
+	Type       string    `json:"type"`
+	UID        string    `json:"uid"`
+	Email      string    `json:"email"`
+	Device     string    `json:"device"`
+	IPAddress  string    `json:"ipAddress"`
+	UserAgent  string    `json:"userAgent"`
+	OccurredAt time.Time `json:"occurredAt"`
+	SecureLink string    `json:"secureLink"`
+}
+
+// webhookNotifier は、通知を JSON で指定の URL に POST する Notifier の実装
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier は、Webhook で通知する Notifier を返します。
+func NewWebhookNotifier(url string) Notifier {
+	return &webhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *webhookNotifier) NotifyNewDevice(notification *Notification) error {
+	body, err := json.Marshal(webhookNotification{
+		Type:       "new_device_login",
+		UID:        notification.UserObjID,
+		Email:      notification.Email,
+		Device:     notification.Device,
+		IPAddress:  notification.IPAddress,
+		UserAgent:  notification.UserAgent,
+		OccurredAt: notification.OccurredAt,
+		SecureLink: notification.SecureLink,
+	})
+	if err != nil {
+		return errs.NewServiceError("failed to encode new device login notification")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return errs.NewServiceError("failed to create new device login webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return errs.NewServiceError(fmt.Sprintf("failed to send new device login webhook: %v", err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errs.NewServiceError(fmt.Sprintf("new device login webhook returned status %d", resp.StatusCode))
+	}
+	return nil
+}
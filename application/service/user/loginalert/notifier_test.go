@@ -0,0 +1,95 @@
+package loginalert_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginalert"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+)
+
+func newNotification() *loginalert.Notification {
+	return &loginalert.Notification{
+		UserObjID:  "123e4567-e89b-12d3-a456-426614174000",
+		Email:      "test@example.com",
+		Username:   "testuser",
+		Device:     "Firefox on Windows",
+		IPAddress:  "198.51.100.7",
+		UserAgent:  "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:131.0) Gecko/20100101 Firefox/131.0",
+		OccurredAt: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		SecureLink: "https://app.example.com/secure-account?token=abc",
+		LinkTTL:    7 * 24 * time.Hour,
+	}
+}
+
+func TestMailNotifier(t *testing.T) {
+	outbox := mailer.NewMemoryOutbox()
+
+	err := loginalert.NewMailNotifier(outbox, mailer.LocaleJa).NotifyNewDevice(newNotification())
+	assert.NoError(t, err)
+	msg := outbox.LastTo("test@example.com")
+	if assert.NotNil(t, msg) {
+		assert.Contains(t, msg.Body, "Firefox on Windows")
+		assert.Contains(t, msg.Body, "198.51.100.7")
+		assert.Contains(t, msg.Body, "2026-10-19 12:00:00 UTC")
+		assert.Contains(t, msg.Body, "https://app.example.com/secure-account?token=abc")
+		assert.Contains(t, msg.Body, "7日間")
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := loginalert.NewWebhookNotifier(server.URL).NotifyNewDevice(newNotification())
+	assert.NoError(t, err)
+	assert.Equal(t, "new_device_login", received["type"])
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", received["uid"])
+	assert.Equal(t, "Firefox on Windows", received["device"])
+	assert.Equal(t, "https://app.example.com/secure-account?token=abc", received["secureLink"])
+}
+
+func TestWebhookNotifier_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	err := loginalert.NewWebhookNotifier(server.URL).NotifyNewDevice(newNotification())
+	assert.Error(t, err, "2xx 以外はエラーとして再試行させること")
+}
+
+func TestNewNotifier(t *testing.T) {
+	outbox := mailer.NewMemoryOutbox()
+
+	notifier, err := loginalert.NewNotifier(&loginalert.Config{Notifier: loginalert.NotifierEmail}, outbox)
+	assert.NoError(t, err)
+	assert.NotNil(t, notifier)
+
+	notifier, err = loginalert.NewNotifier(&loginalert.Config{Notifier: loginalert.NotifierWebhook, WebhookURL: "https://hooks.example.com"}, outbox)
+	assert.NoError(t, err)
+	assert.NotNil(t, notifier)
+
+	_, err = loginalert.NewNotifier(&loginalert.Config{Notifier: loginalert.NotifierWebhook}, outbox)
+	assert.Error(t, err, "Webhook の通知先が必要")
+
+	notifier, err = loginalert.NewNotifier(&loginalert.Config{Notifier: loginalert.NotifierNone}, outbox)
+	assert.NoError(t, err)
+	assert.Nil(t, notifier)
+
+	_, err = loginalert.NewNotifier(&loginalert.Config{Notifier: "sms"}, outbox)
+	assert.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package loginalert
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/event"
+)
+
+// loginAlertPublisher は、新しい端末・接続元からのログインのイベントを受けてユーザーに通知する Publisher の実装
+type loginAlertPublisher struct {
+	loginAlertService UserLoginAlertService
+}
+
+// NewLoginAlertPublisher は、新しい端末・接続元からのログインを通知する Publisher を返します。
+func NewLoginAlertPublisher(loginAlertService UserLoginAlertService) event.Publisher {
+	return &loginAlertPublisher{
+		loginAlertService: loginAlertService,
+	}
+}
+
+func (p *loginAlertPublisher) Publish(e *event.Event) error {
+	if e.Type != event.TypeUserNewDeviceLogin {
+		return nil
+	}
+	var login event.LoginPayload
+	if err := e.DecodePayload(&login); err != nil {
+		return err
+	}
+	// 通知に失敗した場合はエラーを返して再試行させる（再配信により同じ通知が重複して届く可能性がある）
+	return p.loginAlertService.NotifyNewDevice(&login)
+}
@@ -0,0 +1,32 @@
+package loginalert_test
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginalert"
+	"github.com/goda6565/nexus-user-auth/domain/event"
+)
+
+// 新しい端末からのログインのイベントで通知すること
+func (suite *UserLoginAlertServiceTestSuite) TestPublisher_NewDeviceLogin() {
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+	e, err := event.NewEvent(event.TypeUserNewDeviceLogin, suite.testUser.ObjID().Value(), suite.newLogin())
+	suite.Require().NoError(err)
+
+	err = loginalert.NewLoginAlertPublisher(suite.service).Publish(e)
+	suite.NoError(err)
+	suite.Require().Len(suite.notifier.notifications, 1)
+	suite.Equal("198.51.100.7", suite.notifier.notifications[0].IPAddress)
+	suite.WithinDuration(time.Now(), suite.notifier.notifications[0].OccurredAt, time.Minute)
+}
+
+// 他の種類のイベントは無視すること
+func (suite *UserLoginAlertServiceTestSuite) TestPublisher_OtherEvent() {
+	e, err := event.NewEvent(event.TypeUserUpdated, suite.testUser.ObjID().Value(), event.UserPayload{UID: suite.testUser.ObjID().Value()})
+	suite.Require().NoError(err)
+
+	err = loginalert.NewLoginAlertPublisher(suite.service).Publish(e)
+	suite.NoError(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByObjID")
+	suite.Empty(suite.notifier.notifications)
+}
@@ -0,0 +1,103 @@
+package loginalert
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/passwordreset"
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidSecureToken = errs.NewServiceError("invalid or expired secure account token")
+)
+
+type UserLoginAlertService interface {
+	// NotifyNewDevice: 新しい端末・接続元からのログインを、アカウントを保護するリンクとともにユーザーに通知する
+	NotifyNewDevice(login *event.LoginPayload) error
+	// SecureAccount: 通知のリンクのトークンで、発行済みのトークンと信頼済み端末をすべて無効にし、パスワードの再設定を始める
+	// パスワード再設定のトークンを返す
+	SecureAccount(token string) (string, error)
+}
+
+// userLoginAlertService は UserLoginAlertService の実装
+type userLoginAlertService struct {
+	userRepository          repository.UserRepository
+	sessionRepository       repository.SessionRepository
+	trustedDeviceRepository repository.TrustedDeviceRepository
+	passwordReset           passwordreset.UserPasswordResetService
+	notifier                Notifier
+	tokens                  *utils.TokenSigner
+	config                  *Config
+}
+
+// NewUserLoginAlertService は UserLoginAlertService のインスタンスを作成
+func NewUserLoginAlertService(userRepository repository.UserRepository, sessionRepository repository.SessionRepository, trustedDeviceRepository repository.TrustedDeviceRepository, passwordReset passwordreset.UserPasswordResetService, notifier Notifier, tokens *utils.TokenSigner, config *Config) UserLoginAlertService {
+	return &userLoginAlertService{
+		userRepository:          userRepository,
+		sessionRepository:       sessionRepository,
+		trustedDeviceRepository: trustedDeviceRepository,
+		passwordReset:           passwordReset,
+		notifier:                notifier,
+		tokens:                  tokens,
+		config:                  config,
+	}
+}
+
+// NotifyNewDevice はアカウントを保護するリンクを発行し、ユーザーに通知する
+func (s *userLoginAlertService) NotifyNewDevice(login *event.LoginPayload) error {
+	user, err := s.userRepository.GetUserByObjID(login.UID)
+	if err != nil {
+		return errs.NewServiceError("failed to get user")
+	}
+	token, err := s.tokens.GenerateActionTokenWithRef(utils.PurposeSecureAccount, user.ObjID().Value(), user.Email().Value(), login.LoginEventID, s.config.LinkTTL)
+	if err != nil {
+		return errs.NewServiceError("failed to generate secure account token")
+	}
+	link, err := utils.BuildTokenLink(s.config.SecureURL, token)
+	if err != nil {
+		return errs.NewServiceError("failed to build secure account link")
+	}
+	return s.notifier.NotifyNewDevice(&Notification{
+		UserObjID:  user.ObjID().Value(),
+		Email:      user.Email().Value(),
+		Username:   user.Username().Value(),
+		Device:     login.Device,
+		IPAddress:  login.IPAddress,
+		UserAgent:  login.UserAgent,
+		OccurredAt: login.OccurredAt,
+		SecureLink: link,
+		LinkTTL:    s.config.LinkTTL,
+	})
+}
+
+// SecureAccount はトークンを検証し、不正にログインした第三者が使えないようアカウントを保護する
+// 発行済みのトークンをすべて無効にし、二要素目を省略できる信頼済み端末も取り消したうえで、パスワードの再設定のトークンを発行する
+func (s *userLoginAlertService) SecureAccount(token string) (string, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeSecureAccount, token)
+	if err != nil {
+		return "", ErrInvalidSecureToken
+	}
+	user, err := s.userRepository.GetUserByObjID(claims.ObjID)
+	if err != nil {
+		return "", ErrInvalidSecureToken
+	}
+	objID := user.ObjID().Value()
+
+	if _, err := s.sessionRepository.RevokeSessions(objID, time.Now()); err != nil {
+		return "", errs.NewServiceError("failed to revoke sessions")
+	}
+	devices, err := s.trustedDeviceRepository.ListDevices(objID)
+	if err != nil {
+		return "", errs.NewServiceError("failed to get trusted devices")
+	}
+	for _, device := range devices {
+		if _, err := s.trustedDeviceRepository.DeleteDevice(objID, device.ID()); err != nil {
+			return "", errs.NewServiceError("failed to revoke trusted device")
+		}
+	}
+
+	return s.passwordReset.IssueResetToken(user)
+}
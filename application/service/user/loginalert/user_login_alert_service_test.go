@@ -0,0 +1,306 @@
+package loginalert_test
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginalert"
+	"github.com/goda6565/nexus-user-auth/application/service/user/passwordreset"
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+type mockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *mockSessionRepository) RevokeSessions(userObjID string, revokedAt time.Time) (bool, error) {
+	args := m.Called(userObjID, revokedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockSessionRepository) GetSessionsRevokedAt(userObjID string) (*time.Time, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+// inMemoryTrustedDeviceRepository はメモリ上で信頼済み端末を保持するテスト用リポジトリ
+type inMemoryTrustedDeviceRepository struct {
+	devices []*entity.TrustedDevice
+}
+
+func (r *inMemoryTrustedDeviceRepository) CreateDevice(device *entity.TrustedDevice) (*entity.TrustedDevice, error) {
+	r.devices = append(r.devices, device)
+	return device, nil
+}
+
+func (r *inMemoryTrustedDeviceRepository) FindDevice(id string) (*entity.TrustedDevice, error) {
+	for _, device := range r.devices {
+		if device.ID() == id {
+			return device, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryTrustedDeviceRepository) ListDevices(userObjID string) ([]*entity.TrustedDevice, error) {
+	var devices []*entity.TrustedDevice
+	for _, device := range r.devices {
+		if device.UserObjID().Value() == userObjID {
+			devices = append(devices, device)
+		}
+	}
+	return devices, nil
+}
+
+func (r *inMemoryTrustedDeviceRepository) TouchDevice(id string, usedAt time.Time) error {
+	return nil
+}
+
+func (r *inMemoryTrustedDeviceRepository) DeleteDevice(userObjID string, id string) (bool, error) {
+	for i, device := range r.devices {
+		if device.UserObjID().Value() == userObjID && device.ID() == id {
+			r.devices = append(r.devices[:i], r.devices[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// recordingNotifier は通知の内容を記録するテスト用の Notifier
+type recordingNotifier struct {
+	notifications []*loginalert.Notification
+	err           error
+}
+
+func (n *recordingNotifier) NotifyNewDevice(notification *loginalert.Notification) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+// --- テストスイート ---
+
+type UserLoginAlertServiceTestSuite struct {
+	suite.Suite
+	mockRepo      *mockUserRepository
+	mockSession   *mockSessionRepository
+	devices       *inMemoryTrustedDeviceRepository
+	notifier      *recordingNotifier
+	passwordReset passwordreset.UserPasswordResetService
+	service       loginalert.UserLoginAlertService
+	testUser      *entity.User
+}
+
+func TestUserLoginAlertServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserLoginAlertServiceTestSuite))
+}
+
+func (suite *UserLoginAlertServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserLoginAlertServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserLoginAlertServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mockUserRepository)
+	suite.mockSession = new(mockSessionRepository)
+	suite.devices = &inMemoryTrustedDeviceRepository{}
+	suite.notifier = &recordingNotifier{}
+	suite.passwordReset = passwordreset.NewUserPasswordResetService(suite.mockRepo, suite.mockSession, utils.DefaultTokenSigner(), &passwordreset.Config{TokenTTL: time.Hour})
+	suite.service = loginalert.NewUserLoginAlertService(suite.mockRepo, suite.mockSession, suite.devices, suite.passwordReset, suite.notifier, utils.DefaultTokenSigner(), &loginalert.Config{
+		SecureURL: "https://app.example.com/secure-account",
+		LinkTTL:   24 * time.Hour,
+	})
+
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	password, _ := value.NewUserPassword("oldpassword123")
+	testUser, err := entity.NewUser(email, password, username)
+	suite.Require().NoError(err)
+	suite.testUser = testUser
+}
+
+func (suite *UserLoginAlertServiceTestSuite) newLogin() *event.LoginPayload {
+	return &event.LoginPayload{
+		UID:          suite.testUser.ObjID().Value(),
+		LoginEventID: "login-event-1",
+		IPAddress:    "198.51.100.7",
+		UserAgent:    "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:131.0) Gecko/20100101 Firefox/131.0",
+		Device:       "Firefox on Windows",
+		OccurredAt:   time.Now(),
+	}
+}
+
+// secureToken は通知のリンクからトークンを取り出す
+func (suite *UserLoginAlertServiceTestSuite) secureToken(notification *loginalert.Notification) string {
+	link, err := url.Parse(notification.SecureLink)
+	suite.Require().NoError(err)
+	suite.Equal("app.example.com", link.Host)
+	token := link.Query().Get("token")
+	suite.Require().NotEmpty(token)
+	return token
+}
+
+// ----- NotifyNewDevice のテスト -----
+
+func (suite *UserLoginAlertServiceTestSuite) TestNotifyNewDevice() {
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+	login := suite.newLogin()
+
+	err := suite.service.NotifyNewDevice(login)
+	suite.NoError(err)
+	suite.Require().Len(suite.notifier.notifications, 1)
+	notification := suite.notifier.notifications[0]
+	suite.Equal("test@example.com", notification.Email)
+	suite.Equal("testuser", notification.Username)
+	suite.Equal("Firefox on Windows", notification.Device)
+	suite.Equal("198.51.100.7", notification.IPAddress)
+	suite.Equal(24*time.Hour, notification.LinkTTL)
+
+	claims, err := utils.ValidateActionToken(utils.PurposeSecureAccount, suite.secureToken(notification))
+	suite.Require().NoError(err)
+	suite.Equal(suite.testUser.ObjID().Value(), claims.ObjID)
+	suite.Equal("login-event-1", claims.Ref)
+}
+
+// 通知に失敗した場合はエラーを返すこと（アウトボックスから再試行させる）
+func (suite *UserLoginAlertServiceTestSuite) TestNotifyNewDevice_Error() {
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+	suite.notifier.err = errors.New("smtp error")
+
+	suite.Error(suite.service.NotifyNewDevice(suite.newLogin()))
+}
+
+func (suite *UserLoginAlertServiceTestSuite) TestNotifyNewDevice_UserNotFound() {
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(nil, errs.NewInfraError("not found"))
+
+	suite.Error(suite.service.NotifyNewDevice(suite.newLogin()))
+	suite.Empty(suite.notifier.notifications)
+}
+
+// ----- SecureAccount のテスト -----
+
+// トークンと信頼済み端末を無効にし、パスワードを再設定できるトークンを返すこと
+func (suite *UserLoginAlertServiceTestSuite) TestSecureAccount() {
+	objID := suite.testUser.ObjID().Value()
+	suite.mockRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.Require().NoError(suite.service.NotifyNewDevice(suite.newLogin()))
+	token := suite.secureToken(suite.notifier.notifications[0])
+
+	device, err := entity.NewTrustedDevice(suite.testUser.ObjID(), "Firefox on Windows", time.Hour)
+	suite.Require().NoError(err)
+	_, _ = suite.devices.CreateDevice(device)
+	otherObjID, _ := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174999")
+	otherDevice, err := entity.NewTrustedDevice(otherObjID, "Chrome on macOS", time.Hour)
+	suite.Require().NoError(err)
+	_, _ = suite.devices.CreateDevice(otherDevice)
+	suite.mockSession.On("RevokeSessions", objID, mock.Anything).Return(true, nil)
+
+	resetToken, err := suite.service.SecureAccount(token)
+	suite.Require().NoError(err)
+	suite.NotEmpty(resetToken)
+	suite.mockSession.AssertCalled(suite.T(), "RevokeSessions", objID, mock.Anything)
+	suite.Require().Len(suite.devices.devices, 1, "他のユーザーの信頼済み端末は取り消さないこと")
+	suite.Equal(otherDevice.ID(), suite.devices.devices[0].ID())
+
+	// 返されたトークンでパスワードを再設定できること
+	suite.mockRepo.On("UpdateUser", suite.testUser).Return(suite.testUser, nil)
+	suite.NoError(suite.passwordReset.ResetPassword(resetToken, "newpassword456"))
+	suite.True(suite.testUser.Password().Verify("newpassword456"))
+}
+
+func (suite *UserLoginAlertServiceTestSuite) TestSecureAccount_InvalidToken() {
+	_, err := suite.service.SecureAccount("invalid.token")
+	suite.ErrorIs(err, loginalert.ErrInvalidSecureToken)
+
+	// 別の用途のトークンは使えないこと
+	token, err := utils.GenerateActionToken(utils.PurposeAccountUnlock, suite.testUser.ObjID().Value(), "test@example.com", time.Hour)
+	suite.Require().NoError(err)
+	_, err = suite.service.SecureAccount(token)
+	suite.ErrorIs(err, loginalert.ErrInvalidSecureToken)
+	suite.mockSession.AssertNotCalled(suite.T(), "RevokeSessions", mock.Anything, mock.Anything)
+}
+
+func (suite *UserLoginAlertServiceTestSuite) TestSecureAccount_RevokeError() {
+	objID := suite.testUser.ObjID().Value()
+	token, err := utils.GenerateActionTokenWithRef(utils.PurposeSecureAccount, objID, "test@example.com", "login-event-1", time.Hour)
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.mockSession.On("RevokeSessions", objID, mock.Anything).Return(false, errors.New("db error"))
+
+	resetToken, err := suite.service.SecureAccount(token)
+	suite.Error(err)
+	suite.NotErrorIs(err, loginalert.ErrInvalidSecureToken)
+	suite.Empty(resetToken, "トークンを無効にできない場合はパスワードの再設定を始めないこと")
+}
@@ -0,0 +1,23 @@
+package loginhistory
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	DefaultPerPage       int           // 1 ページあたりの件数（指定されなかった場合）
+	MaxPerPage           int           // 1 ページあたりの件数の上限
+	NewDeviceWindow      time.Duration // この期間に成功したログインと端末・接続元を比較する（0 の場合は新しい端末を判定しない）
+	NewDeviceHistorySize int           // 比較する成功したログインの最大件数
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		DefaultPerPage:       utils.GetEnvInt("LOGIN_HISTORY_PER_PAGE", 20),
+		MaxPerPage:           utils.GetEnvInt("LOGIN_HISTORY_MAX_PER_PAGE", 100),
+		NewDeviceWindow:      utils.GetEnvDuration("LOGIN_NEW_DEVICE_WINDOW", 90*24*time.Hour),
+		NewDeviceHistorySize: utils.GetEnvInt("LOGIN_NEW_DEVICE_HISTORY_SIZE", 50),
+	}
+}
@@ -0,0 +1,121 @@
+package loginhistory
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+var (
+	ErrInvalidPage = errs.NewServiceError("invalid page")
+)
+
+// ClientInfo はログインを試行したクライアントの情報
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// LoginHistoryPage はログイン履歴の 1 ページ
+type LoginHistoryPage struct {
+	Events  []*entity.LoginEvent
+	Page    int
+	PerPage int
+	Total   int64
+}
+
+type UserLoginHistoryService interface {
+	// RecordSuccess: ログインの成功を記録し、最終ログイン日時を更新する（methods は発行したトークンの amr）
+	RecordSuccess(objID string, methods []string, client ClientInfo)
+	// RecordFailure: 登録済みのユーザーのログインの失敗を記録する（reason は entity.LoginReason*）
+	RecordFailure(objID string, methods []string, reason string, client ClientInfo)
+	// ListLoginHistory: ユーザーのログイン履歴を新しい順に取得する（page は 1 から、perPage が 0 の場合は既定の件数）
+	ListLoginHistory(objID string, page int, perPage int) (*LoginHistoryPage, error)
+}
+
+// userLoginHistoryService は UserLoginHistoryService の実装
+type userLoginHistoryService struct {
+	loginEventRepository repository.LoginEventRepository
+	config               *Config
+}
+
+// NewUserLoginHistoryService は UserLoginHistoryService のインスタンスを作成
+func NewUserLoginHistoryService(loginEventRepository repository.LoginEventRepository, config *Config) UserLoginHistoryService {
+	return &userLoginHistoryService{
+		loginEventRepository: loginEventRepository,
+		config:               config,
+	}
+}
+
+// RecordSuccess はログインの成功を記録する。記録に失敗してもログインは妨げない
+// 最近成功したログインのいずれとも端末・接続元が異なる場合は、新しい端末からのログインとして記録し、ユーザーに通知する
+func (s *userLoginHistoryService) RecordSuccess(objID string, methods []string, client ClientInfo) {
+	s.record(objID, entity.LoginSucceeded, "", methods, client)
+}
+
+// RecordFailure はログインの失敗を記録する。記録に失敗してもログインの結果は変えない
+func (s *userLoginHistoryService) RecordFailure(objID string, methods []string, reason string, client ClientInfo) {
+	s.record(objID, entity.LoginFailed, reason, methods, client)
+}
+
+func (s *userLoginHistoryService) record(objID string, result entity.LoginEventResult, reason string, methods []string, client ClientInfo) {
+	userObjID, err := value.NewUserObjID(objID)
+	if err != nil {
+		logger.Warn("failed to record login event", "objID", objID, "error", err.Error())
+		return
+	}
+	event, err := entity.NewLoginEvent(userObjID, result, reason, methods, client.IP, client.UserAgent)
+	if err != nil {
+		logger.Warn("failed to record login event", "objID", objID, "error", err.Error())
+		return
+	}
+	if event.IsSucceeded() && s.isNewDevice(event) {
+		if err := event.MarkNewDevice(); err != nil {
+			logger.Warn("failed to mark new device login", "objID", objID, "error", err.Error())
+		}
+	}
+	if err := s.loginEventRepository.SaveLoginEvent(event); err != nil {
+		logger.Warn("failed to record login event", "objID", objID, "error", err.Error())
+	}
+}
+
+// isNewDevice は、ログインの端末・接続元が最近成功したログインのいずれとも異なるかを判定する
+// 初めてのログイン（最近成功したログインがない場合）や、記録を取得できない場合は新しい端末とみなさない
+func (s *userLoginHistoryService) isNewDevice(event *entity.LoginEvent) bool {
+	if s.config.NewDeviceWindow <= 0 {
+		return false
+	}
+	recent, err := s.loginEventRepository.ListSucceededLoginEvents(event.UserObjID().Value(), event.OccurredAt().Add(-s.config.NewDeviceWindow), s.config.NewDeviceHistorySize)
+	if err != nil {
+		logger.Warn("failed to get recent login events", "objID", event.UserObjID().Value(), "error", err.Error())
+		return false
+	}
+	if len(recent) == 0 {
+		return false
+	}
+	fingerprint := event.Fingerprint()
+	for _, previous := range recent {
+		if previous.Fingerprint().Equals(fingerprint) {
+			return false
+		}
+	}
+	return true
+}
+
+// ListLoginHistory はユーザーのログイン履歴の指定されたページを返す
+func (s *userLoginHistoryService) ListLoginHistory(objID string, page int, perPage int) (*LoginHistoryPage, error) {
+	if perPage == 0 {
+		perPage = s.config.DefaultPerPage
+	}
+	if page < 1 || perPage < 1 || perPage > s.config.MaxPerPage {
+		return nil, ErrInvalidPage
+	}
+
+	events, total, err := s.loginEventRepository.ListLoginEvents(objID, (page-1)*perPage, perPage)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get login history")
+	}
+	return &LoginHistoryPage{Events: events, Page: page, PerPage: perPage, Total: total}, nil
+}
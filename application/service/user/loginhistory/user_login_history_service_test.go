@@ -0,0 +1,175 @@
+package loginhistory_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+// --- モック ---
+
+// inMemoryLoginEventRepository はメモリ上でログインの記録を保持するテスト用リポジトリ
+type inMemoryLoginEventRepository struct {
+	events []*entity.LoginEvent
+	err    error
+}
+
+func (r *inMemoryLoginEventRepository) SaveLoginEvent(event *entity.LoginEvent) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *inMemoryLoginEventRepository) ListLoginEvents(userObjID string, offset int, limit int) ([]*entity.LoginEvent, int64, error) {
+	if r.err != nil {
+		return nil, 0, r.err
+	}
+	var matched []*entity.LoginEvent
+	for i := len(r.events) - 1; i >= 0; i-- {
+		if r.events[i].UserObjID().Value() == userObjID {
+			matched = append(matched, r.events[i])
+		}
+	}
+	total := int64(len(matched))
+	if offset >= len(matched) {
+		return nil, total, nil
+	}
+	return matched[offset:min(offset+limit, len(matched))], total, nil
+}
+
+func (r *inMemoryLoginEventRepository) ListSucceededLoginEvents(userObjID string, since time.Time, limit int) ([]*entity.LoginEvent, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var matched []*entity.LoginEvent
+	for i := len(r.events) - 1; i >= 0 && len(matched) < limit; i-- {
+		event := r.events[i]
+		if event.UserObjID().Value() == userObjID && event.IsSucceeded() && !event.OccurredAt().Before(since) {
+			matched = append(matched, event)
+		}
+	}
+	return matched, nil
+}
+
+// --- テストスイート ---
+
+const testUserObjID = "123e4567-e89b-12d3-a456-426614174000"
+
+type UserLoginHistoryServiceTestSuite struct {
+	suite.Suite
+	repo    *inMemoryLoginEventRepository
+	service loginhistory.UserLoginHistoryService
+	client  loginhistory.ClientInfo
+}
+
+func (suite *UserLoginHistoryServiceTestSuite) SetupTest() {
+	suite.repo = &inMemoryLoginEventRepository{}
+	suite.service = loginhistory.NewUserLoginHistoryService(suite.repo, &loginhistory.Config{DefaultPerPage: 2, MaxPerPage: 10, NewDeviceWindow: 24 * time.Hour, NewDeviceHistorySize: 10})
+	suite.client = loginhistory.ClientInfo{IP: "192.0.2.1", UserAgent: "Mozilla/5.0"}
+}
+
+func TestUserLoginHistoryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserLoginHistoryServiceTestSuite))
+}
+
+// 成功と失敗がクライアントの情報とともに記録されること
+func (suite *UserLoginHistoryServiceTestSuite) TestRecord() {
+	suite.service.RecordFailure(testUserObjID, []string{"pwd"}, entity.LoginReasonInvalidCredentials, suite.client)
+	suite.service.RecordSuccess(testUserObjID, []string{"pwd", "otp", "mfa"}, suite.client)
+
+	suite.Require().Len(suite.repo.events, 2)
+	failed := suite.repo.events[0]
+	suite.False(failed.IsSucceeded())
+	suite.Equal(entity.LoginReasonInvalidCredentials, failed.Reason())
+	suite.Equal("192.0.2.1", failed.IPAddress())
+	suite.Equal("Mozilla/5.0", failed.UserAgent())
+	succeeded := suite.repo.events[1]
+	suite.True(succeeded.IsSucceeded())
+	suite.Equal([]string{"pwd", "otp", "mfa"}, succeeded.Methods())
+}
+
+// 記録に失敗してもパニックしないこと（ログインを妨げない）
+func (suite *UserLoginHistoryServiceTestSuite) TestRecord_Error() {
+	suite.repo.err = errors.New("db error")
+	suite.NotPanics(func() {
+		suite.service.RecordSuccess(testUserObjID, []string{"pwd"}, suite.client)
+	})
+	suite.NotPanics(func() {
+		suite.service.RecordFailure("invalid", []string{"pwd"}, entity.LoginReasonInvalidCredentials, suite.client)
+	})
+}
+
+// 最近成功したログインと端末・接続元が異なる場合のみ、新しい端末からのログインとして記録すること
+func (suite *UserLoginHistoryServiceTestSuite) TestRecordSuccess_NewDevice() {
+	chrome := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0.0.0 Safari/537.36"
+	firefox := "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:131.0) Gecko/20100101 Firefox/131.0"
+
+	// 初めてのログインは新しい端末とみなさない
+	suite.service.RecordSuccess(testUserObjID, []string{"pwd"}, loginhistory.ClientInfo{IP: "192.0.2.1", UserAgent: chrome})
+	// 失敗したログインは比較の対象にならない
+	suite.service.RecordFailure(testUserObjID, []string{"pwd"}, entity.LoginReasonInvalidCredentials, loginhistory.ClientInfo{IP: "198.51.100.1", UserAgent: firefox})
+	// 同じネットワーク・同じ種類のブラウザからのログイン
+	suite.service.RecordSuccess(testUserObjID, []string{"pwd"}, loginhistory.ClientInfo{IP: "192.0.2.99", UserAgent: chrome})
+	// 別のネットワーク・別のブラウザからのログイン
+	suite.service.RecordSuccess(testUserObjID, []string{"pwd"}, loginhistory.ClientInfo{IP: "198.51.100.1", UserAgent: firefox})
+	// 一度ログインした端末・接続元からのログイン
+	suite.service.RecordSuccess(testUserObjID, []string{"pwd"}, loginhistory.ClientInfo{IP: "198.51.100.2", UserAgent: firefox})
+
+	suite.Require().Len(suite.repo.events, 5)
+	suite.False(suite.repo.events[0].IsNewDevice())
+	suite.False(suite.repo.events[1].IsNewDevice())
+	suite.False(suite.repo.events[2].IsNewDevice())
+	suite.True(suite.repo.events[3].IsNewDevice())
+	suite.False(suite.repo.events[4].IsNewDevice())
+}
+
+// 判定の期間が 0 の場合は新しい端末を判定しないこと
+func (suite *UserLoginHistoryServiceTestSuite) TestRecordSuccess_NewDeviceDisabled() {
+	service := loginhistory.NewUserLoginHistoryService(suite.repo, &loginhistory.Config{DefaultPerPage: 2, MaxPerPage: 10})
+	service.RecordSuccess(testUserObjID, []string{"pwd"}, loginhistory.ClientInfo{IP: "192.0.2.1", UserAgent: "curl/8.4.0"})
+	service.RecordSuccess(testUserObjID, []string{"pwd"}, loginhistory.ClientInfo{IP: "198.51.100.1", UserAgent: "Mozilla/5.0"})
+
+	suite.Require().Len(suite.repo.events, 2)
+	suite.False(suite.repo.events[1].IsNewDevice())
+}
+
+func (suite *UserLoginHistoryServiceTestSuite) TestListLoginHistory() {
+	for range 3 {
+		suite.service.RecordSuccess(testUserObjID, []string{"pwd"}, suite.client)
+	}
+
+	// 件数を指定しない場合は既定の件数
+	page, err := suite.service.ListLoginHistory(testUserObjID, 1, 0)
+	suite.Require().NoError(err)
+	suite.Len(page.Events, 2)
+	suite.Equal(1, page.Page)
+	suite.Equal(2, page.PerPage)
+	suite.Equal(int64(3), page.Total)
+	suite.Equal(suite.repo.events[2].ID(), page.Events[0].ID(), "新しい順に返すこと")
+
+	page, err = suite.service.ListLoginHistory(testUserObjID, 2, 2)
+	suite.Require().NoError(err)
+	suite.Len(page.Events, 1)
+	suite.Equal(suite.repo.events[0].ID(), page.Events[0].ID())
+}
+
+func (suite *UserLoginHistoryServiceTestSuite) TestListLoginHistory_InvalidPage() {
+	for _, tc := range []struct{ page, perPage int }{{0, 10}, {1, -1}, {1, 11}} {
+		_, err := suite.service.ListLoginHistory(testUserObjID, tc.page, tc.perPage)
+		suite.ErrorIs(err, loginhistory.ErrInvalidPage)
+	}
+}
+
+func (suite *UserLoginHistoryServiceTestSuite) TestListLoginHistory_Error() {
+	suite.repo.err = errors.New("db error")
+	_, err := suite.service.ListLoginHistory(testUserObjID, 1, 10)
+	suite.Error(err)
+	suite.NotErrorIs(err, loginhistory.ErrInvalidPage)
+}
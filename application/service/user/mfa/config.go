@@ -0,0 +1,25 @@
+package mfa
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	Issuer            string        // 認証アプリに表示するサービス名
+	Skew              int64         // 端末の時刻ずれとして許容する前後のタイムステップ数
+	RecoveryCodeCount int           // 登録完了時に発行するリカバリーコードの数
+	ChallengeTTL      time.Duration // パスワード認証後、二要素認証を完了するまでの有効期限
+	MaxAttempts       int           // 1 つのチャレンジトークンでコードを検証できる回数
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		Issuer:            utils.GetEnvDefault("MFA_TOTP_ISSUER", "Nexus User Auth"),
+		Skew:              int64(utils.GetEnvInt("MFA_TOTP_SKEW", 1)),
+		RecoveryCodeCount: utils.GetEnvInt("MFA_RECOVERY_CODE_COUNT", 10),
+		ChallengeTTL:      utils.GetEnvDuration("MFA_CHALLENGE_TTL", 5*time.Minute),
+		MaxAttempts:       utils.GetEnvInt("MFA_MAX_ATTEMPTS", 5),
+	}
+}
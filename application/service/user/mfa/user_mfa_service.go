@@ -0,0 +1,311 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/totp"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrMFAAlreadyEnabled = errs.NewServiceError("two-factor authentication is already enabled")
+	ErrMFANotEnrolled    = errs.NewServiceError("two-factor authentication enrollment has not been started")
+	ErrInvalidMFACode    = errs.NewServiceError("invalid two-factor authentication code")
+	ErrInvalidMFAToken   = errs.NewServiceError("invalid mfa token")
+	ErrTooManyAttempts   = errs.NewServiceError("too many two-factor authentication attempts with this mfa token; log in again")
+)
+
+// TOTPEnrollment は、認証アプリに登録するための情報
+type TOTPEnrollment struct {
+	Secret string // 手入力用の Base32 シークレット
+	URI    string // otpauth:// URI
+	QRCode string // URI を QR コードにした PNG の data URI
+}
+
+type UserMFAService interface {
+	// BeginTOTPEnrollment: TOTP のシークレットを発行（確認が完了するまでログインには使われない）
+	BeginTOTPEnrollment(objID string) (*TOTPEnrollment, error)
+	// ConfirmTOTPEnrollment: 最初のコードで登録を完了し、リカバリーコードを発行
+	ConfirmTOTPEnrollment(objID string, code string) (recoveryCodes []string, err error)
+	// VerifyMFA: ログイン時のチャレンジトークンとコード（TOTP またはリカバリーコード）を検証し、トークンを発行
+	// 1 つのチャレンジトークンで試行できる回数を超えた場合は ErrTooManyAttempts、失敗が続いている場合は *lockout.LockoutError を返す
+	VerifyMFA(mfaToken string, code string, client loginhistory.ClientInfo) (accessToken string, refreshToken string, err error)
+	// VerifyCode: ログイン済みユーザーのコード（TOTP またはリカバリーコード）を検証（再認証用）
+	// 失敗が続いている場合は *lockout.LockoutError を返す
+	VerifyCode(objID string, code string) error
+}
+
+// userMFAService は UserMFAService の実装
+// コードの誤りはパスワードの誤りと同じくアカウント・接続元ごとの失敗として数え、続く場合はロックする
+type userMFAService struct {
+	userRepository         repository.UserRepository
+	mfaRepository          repository.MFARepository
+	loginFailureRepository repository.LoginFailureRepository
+	lockout                lockout.UserLockoutService
+	loginHistory           loginhistory.UserLoginHistoryService
+	tokens                 *utils.TokenSigner
+	config                 *Config
+}
+
+// NewUserMFAService は UserMFAService のインスタンスを作成
+func NewUserMFAService(userRepository repository.UserRepository, mfaRepository repository.MFARepository, loginFailureRepository repository.LoginFailureRepository, lockout lockout.UserLockoutService, loginHistory loginhistory.UserLoginHistoryService, tokens *utils.TokenSigner, config *Config) UserMFAService {
+	return &userMFAService{
+		userRepository:         userRepository,
+		mfaRepository:          mfaRepository,
+		loginFailureRepository: loginFailureRepository,
+		lockout:                lockout,
+		loginHistory:           loginHistory,
+		tokens:                 tokens,
+		config:                 config,
+	}
+}
+
+// BeginTOTPEnrollment は新しいシークレットを発行する。確認前の登録がある場合は置き換える
+func (s *userMFAService) BeginTOTPEnrollment(objID string) (*TOTPEnrollment, error) {
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get user")
+	}
+	current, err := s.mfaRepository.FindTOTPFactor(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get totp factor")
+	}
+	if current != nil && current.IsConfirmed() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errs.NewServiceError("failed to generate totp secret")
+	}
+	factor, err := entity.NewTOTPFactor(user.ObjID(), secret)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create totp factor")
+	}
+	if _, err := s.mfaRepository.SaveTOTPFactor(factor); err != nil {
+		return nil, errs.NewServiceError("failed to save totp factor in repository")
+	}
+
+	uri := totp.KeyURI(s.config.Issuer, user.Email().Value(), secret)
+	qrCode, err := totp.QRCodeDataURI(uri)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to generate qr code")
+	}
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    uri,
+		QRCode: qrCode,
+	}, nil
+}
+
+// ConfirmTOTPEnrollment は認証アプリのコードを検証して登録を完了し、リカバリーコードを発行する
+func (s *userMFAService) ConfirmTOTPEnrollment(objID string, code string) ([]string, error) {
+	factor, err := s.mfaRepository.FindTOTPFactor(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get totp factor")
+	}
+	if factor == nil {
+		return nil, ErrMFANotEnrolled
+	}
+	if factor.IsConfirmed() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	now := time.Now()
+	step, ok := totp.Validate(factor.Secret(), code, now, s.config.Skew)
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+	if err := factor.Confirm(step, now); err != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	// リカバリーコードは平文をこの応答でのみ返し、保存するのはハッシュのみ
+	recoveryCodes := make([]string, 0, s.config.RecoveryCodeCount)
+	entities := make([]*entity.RecoveryCode, 0, s.config.RecoveryCodeCount)
+	for range s.config.RecoveryCodeCount {
+		plain, err := generateRecoveryCode()
+		if err != nil {
+			return nil, errs.NewServiceError("failed to generate recovery code")
+		}
+		recoveryCode, err := entity.NewRecoveryCode(factor.UserObjID(), plain)
+		if err != nil {
+			return nil, errs.NewServiceError("failed to create recovery code")
+		}
+		recoveryCodes = append(recoveryCodes, plain)
+		entities = append(entities, recoveryCode)
+	}
+	if err := s.mfaRepository.ReplaceRecoveryCodes(objID, entities); err != nil {
+		return nil, errs.NewServiceError("failed to save recovery codes in repository")
+	}
+	if _, err := s.mfaRepository.SaveTOTPFactor(factor); err != nil {
+		return nil, errs.NewServiceError("failed to save totp factor in repository")
+	}
+	return recoveryCodes, nil
+}
+
+// VerifyMFA はチャレンジトークンと二要素目のコードを検証し、アクセストークンとリフレッシュトークンを発行する
+// チャレンジトークンごとの試行回数を数え、上限を超えた場合はそのトークンでは検証しない（ログインからやり直す）
+// コードの誤り・ロック中の試行・ログインの成功はログイン履歴に記録し、ログインの成功でアカウントの失敗回数を戻す
+func (s *userMFAService) VerifyMFA(mfaToken string, code string, client loginhistory.ClientInfo) (string, string, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeMFAChallenge, mfaToken)
+	if err != nil {
+		return "", "", ErrInvalidMFAToken
+	}
+	user, err := s.userRepository.GetUserByObjID(claims.ObjID)
+	if err != nil {
+		return "", "", ErrInvalidMFAToken
+	}
+	factor, err := s.mfaRepository.FindTOTPFactor(claims.ObjID)
+	if err != nil {
+		return "", "", errs.NewServiceError("failed to get totp factor")
+	}
+	if factor == nil || !factor.IsConfirmed() {
+		return "", "", ErrInvalidMFAToken
+	}
+
+	auth := utils.NewAuthContext(append(claims.AMR, utils.AMROTP)...)
+	email := user.Email().Value()
+	if err := s.lockout.Check(email, client.IP); err != nil {
+		reason := entity.LoginReasonThrottled
+		if errors.Is(err, lockout.ErrAccountLocked) {
+			reason = entity.LoginReasonAccountLocked
+		}
+		s.loginHistory.RecordFailure(claims.ObjID, auth.AMR, reason, client)
+		return "", "", err
+	}
+	// 試行は検証の前に数え、同時に送られたリクエストでも上限を超えて検証しない
+	attempts, err := s.loginFailureRepository.RecordFailure(entity.LoginFailureScopeMFAChallenge, challengeKey(mfaToken), time.Now(), s.config.ChallengeTTL)
+	if err != nil {
+		return "", "", errs.NewServiceError("failed to record mfa attempt")
+	}
+	if attempts.Failures() > s.config.MaxAttempts {
+		return "", "", ErrTooManyAttempts
+	}
+
+	if err := s.verifyCode(factor, code); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			s.loginHistory.RecordFailure(claims.ObjID, auth.AMR, entity.LoginReasonInvalidMFACode, client)
+			// この失敗でロックした場合はロックのエラーを返す
+			if lockErr := s.lockout.RecordFailure(email, client.IP); lockErr != nil {
+				return "", "", lockErr
+			}
+		}
+		return "", "", err
+	}
+	// チャレンジトークンの発行後に停止されたアカウントなどにはトークンを発行しない
+	if err := user.CheckActive(); err != nil {
+		s.loginHistory.RecordFailure(claims.ObjID, auth.AMR, entity.LoginReasonAccountInactive, client)
+		return "", "", err
+	}
+
+	accessToken, refreshToken, err := s.tokens.GenerateTokens(claims.ObjID, auth)
+	if err != nil {
+		return "", "", errs.NewServiceError("failed to generate tokens")
+	}
+	s.lockout.RecordSuccess(email)
+	s.loginHistory.RecordSuccess(claims.ObjID, auth.AMR, client)
+	return accessToken, refreshToken, nil
+}
+
+// VerifyCode は確認済みの TOTP に対してコードを検証する。TOTP が有効でない場合は ErrMFANotEnrolled を返す
+// コードの誤りはログインと同じくアカウントの失敗として数え、ロック中は検証しない
+func (s *userMFAService) VerifyCode(objID string, code string) error {
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return errs.NewServiceError("failed to get user")
+	}
+	factor, err := s.mfaRepository.FindTOTPFactor(objID)
+	if err != nil {
+		return errs.NewServiceError("failed to get totp factor")
+	}
+	if factor == nil || !factor.IsConfirmed() {
+		return ErrMFANotEnrolled
+	}
+	email := user.Email().Value()
+	if err := s.lockout.Check(email, ""); err != nil {
+		return err
+	}
+	if err := s.verifyCode(factor, code); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			if lockErr := s.lockout.RecordFailure(email, ""); lockErr != nil {
+				return lockErr
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// challengeKey はチャレンジトークンごとの試行を数えるためのキー（トークンの SHA-256）を返す
+func challengeKey(mfaToken string) string {
+	sum := sha256.Sum256([]byte(mfaToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyCode は TOTP のコード（数字のみ）またはリカバリーコードを検証し、使用済みにする
+func (s *userMFAService) verifyCode(factor *entity.TOTPFactor, code string) error {
+	objID := factor.UserObjID().Value()
+	code = strings.TrimSpace(code)
+
+	if isTOTPCode(code) {
+		step, ok := totp.Validate(factor.Secret(), code, time.Now(), s.config.Skew)
+		if !ok {
+			return ErrInvalidMFACode
+		}
+		// 一度受け付けたコード（およびそれ以前のコード）は再利用できない
+		consumed, err := s.mfaRepository.ConsumeTOTPStep(objID, step)
+		if err != nil {
+			return errs.NewServiceError("failed to record totp usage")
+		}
+		if !consumed {
+			return ErrInvalidMFACode
+		}
+		return nil
+	}
+
+	used, err := s.mfaRepository.UseRecoveryCode(objID, entity.HashRecoveryCode(code))
+	if err != nil {
+		return errs.NewServiceError("failed to use recovery code")
+	}
+	if !used {
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+func isTOTPCode(code string) bool {
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateRecoveryCode は "xxxxx-xxxxx" 形式のリカバリーコードを生成する（50 ビット）
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, 7)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(buf))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
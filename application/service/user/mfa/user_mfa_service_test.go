@@ -0,0 +1,488 @@
+package mfa_test
+
+import (
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/mfa"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/pkg/totp"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+// inMemoryMFARepository はメモリ上で TOTP とリカバリーコードを保持するテスト用リポジトリ
+type inMemoryMFARepository struct {
+	factors       map[string]*entity.TOTPFactor
+	recoveryCodes map[string][]*entity.RecoveryCode
+	usedCodes     map[string]bool
+}
+
+func (r *inMemoryMFARepository) SaveTOTPFactor(factor *entity.TOTPFactor) (*entity.TOTPFactor, error) {
+	r.factors[factor.UserObjID().Value()] = factor
+	return factor, nil
+}
+
+func (r *inMemoryMFARepository) FindTOTPFactor(userObjID string) (*entity.TOTPFactor, error) {
+	return r.factors[userObjID], nil
+}
+
+func (r *inMemoryMFARepository) ConsumeTOTPStep(userObjID string, step int64) (bool, error) {
+	factor, ok := r.factors[userObjID]
+	if !ok || !factor.IsConfirmed() || factor.LastUsedStep() >= step {
+		return false, nil
+	}
+	rebuilt, _ := entity.BuildTOTPFactor(factor.UserObjID(), factor.Secret(), factor.ConfirmedAt(), step)
+	r.factors[userObjID] = rebuilt
+	return true, nil
+}
+
+func (r *inMemoryMFARepository) ReplaceRecoveryCodes(userObjID string, codes []*entity.RecoveryCode) error {
+	r.recoveryCodes[userObjID] = codes
+	return nil
+}
+
+func (r *inMemoryMFARepository) UseRecoveryCode(userObjID string, codeHash string) (bool, error) {
+	for _, code := range r.recoveryCodes[userObjID] {
+		if code.CodeHash() == codeHash && !r.usedCodes[code.ID()] {
+			r.usedCodes[code.ID()] = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// recordingLoginHistory は記録されたログインの成功・失敗を保持するテスト用の UserLoginHistoryService
+type recordingLoginHistory struct {
+	successes []string // 成功したユーザーID
+	failures  []string // 失敗の理由
+}
+
+func (r *recordingLoginHistory) RecordSuccess(objID string, methods []string, client loginhistory.ClientInfo) {
+	r.successes = append(r.successes, objID)
+}
+
+func (r *recordingLoginHistory) RecordFailure(objID string, methods []string, reason string, client loginhistory.ClientInfo) {
+	r.failures = append(r.failures, reason)
+}
+
+func (r *recordingLoginHistory) ListLoginHistory(objID string, page int, perPage int) (*loginhistory.LoginHistoryPage, error) {
+	return &loginhistory.LoginHistoryPage{}, nil
+}
+
+// inMemoryLoginFailureRepository はメモリ上で試行回数を保持するテスト用リポジトリ
+type inMemoryLoginFailureRepository struct {
+	items map[string]*entity.LoginFailure
+}
+
+func (r *inMemoryLoginFailureRepository) FindFailure(scope string, key string) (*entity.LoginFailure, error) {
+	return r.items[scope+":"+key], nil
+}
+
+func (r *inMemoryLoginFailureRepository) RecordFailure(scope string, key string, failedAt time.Time, window time.Duration) (*entity.LoginFailure, error) {
+	failures := 1
+	if f, ok := r.items[scope+":"+key]; ok && !f.LastFailedAt().Before(failedAt.Add(-window)) {
+		failures = f.Failures() + 1
+	}
+	f, err := entity.BuildLoginFailure(scope, key, failures, failedAt, nil)
+	if err != nil {
+		return nil, err
+	}
+	r.items[scope+":"+key] = f
+	return f, nil
+}
+
+func (r *inMemoryLoginFailureRepository) LockFailure(scope string, key string, until time.Time) error {
+	return nil
+}
+
+func (r *inMemoryLoginFailureRepository) ResetFailures(scope string, key string) error {
+	delete(r.items, scope+":"+key)
+	return nil
+}
+
+// countingLockout は失敗回数が threshold に達するとロックするテスト用の UserLockoutService
+type countingLockout struct {
+	threshold int
+	failures  map[string]int // メールアドレスごとの失敗回数
+	successes []string
+}
+
+func (l *countingLockout) Check(email string, ip string) error {
+	if l.failures[email] >= l.threshold {
+		return &lockout.LockoutError{Err: lockout.ErrAccountLocked, RetryAfter: time.Minute}
+	}
+	return nil
+}
+
+func (l *countingLockout) RecordFailure(email string, ip string) error {
+	l.failures[email]++
+	if l.failures[email] >= l.threshold {
+		return &lockout.LockoutError{Err: lockout.ErrAccountLocked, RetryAfter: time.Minute}
+	}
+	return nil
+}
+
+func (l *countingLockout) RecordSuccess(email string) {
+	l.successes = append(l.successes, email)
+	delete(l.failures, email)
+}
+
+func (l *countingLockout) Unlock(token string) error {
+	return nil
+}
+
+func (l *countingLockout) AdminUnlock(objID string) error {
+	return nil
+}
+
+// --- テストスイート ---
+
+type UserMFAServiceTestSuite struct {
+	suite.Suite
+	userRepo *mockUserRepository
+	mfaRepo  *inMemoryMFARepository
+	lockout  *countingLockout
+	history  *recordingLoginHistory
+	service  mfa.UserMFAService
+	testUser *entity.User
+}
+
+func TestUserMFAServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserMFAServiceTestSuite))
+}
+
+func (suite *UserMFAServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserMFAServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserMFAServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.mfaRepo = &inMemoryMFARepository{
+		factors:       make(map[string]*entity.TOTPFactor),
+		recoveryCodes: make(map[string][]*entity.RecoveryCode),
+		usedCodes:     make(map[string]bool),
+	}
+	suite.lockout = &countingLockout{threshold: 10, failures: make(map[string]int)}
+	suite.history = &recordingLoginHistory{}
+	failures := &inMemoryLoginFailureRepository{items: make(map[string]*entity.LoginFailure)}
+	suite.service = mfa.NewUserMFAService(suite.userRepo, suite.mfaRepo, failures, suite.lockout, suite.history, utils.DefaultTokenSigner(), &mfa.Config{
+		Issuer:            "Nexus",
+		Skew:              1,
+		RecoveryCodeCount: 10,
+		ChallengeTTL:      5 * time.Minute,
+		MaxAttempts:       3,
+	})
+
+	email, _ := value.NewUserEmail("mfa@example.com")
+	username, _ := value.NewUserUsername("mfauser")
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	activatedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(user.Activate(activatedAt))
+	suite.testUser = user
+	suite.userRepo.On("GetUserByObjID", user.ObjID().Value()).Return(user, nil)
+}
+
+// currentCode は現在時刻の TOTP コードを返す（offset で前後のステップを指定する）
+func (suite *UserMFAServiceTestSuite) currentCode(secret string, offset int64) string {
+	code, err := totp.CodeAt(secret, totp.Step(time.Now())+offset)
+	suite.Require().NoError(err)
+	return code
+}
+
+// enroll は TOTP の登録を完了し、シークレットとリカバリーコードを返す
+func (suite *UserMFAServiceTestSuite) enroll() (string, []string) {
+	enrollment, err := suite.service.BeginTOTPEnrollment(suite.testUser.ObjID().Value())
+	suite.Require().NoError(err)
+	codes, err := suite.service.ConfirmTOTPEnrollment(suite.testUser.ObjID().Value(), suite.currentCode(enrollment.Secret, -1))
+	suite.Require().NoError(err)
+	return enrollment.Secret, codes
+}
+
+func (suite *UserMFAServiceTestSuite) challengeToken() string {
+	token, err := utils.GenerateActionTokenWithAMR(utils.PurposeMFAChallenge, suite.testUser.ObjID().Value(), []string{utils.AMRPassword}, time.Minute)
+	suite.Require().NoError(err)
+	return token
+}
+
+func (suite *UserMFAServiceTestSuite) TestBeginTOTPEnrollment() {
+	objID := suite.testUser.ObjID().Value()
+
+	enrollment, err := suite.service.BeginTOTPEnrollment(objID)
+	suite.NoError(err)
+	suite.NotEmpty(enrollment.Secret)
+	suite.True(strings.HasPrefix(enrollment.QRCode, "data:image/png;base64,"))
+
+	uri, err := url.Parse(enrollment.URI)
+	suite.NoError(err)
+	suite.Equal("otpauth", uri.Scheme)
+	suite.Equal(enrollment.Secret, uri.Query().Get("secret"))
+	suite.Equal("Nexus", uri.Query().Get("issuer"))
+	suite.Contains(enrollment.URI, "mfa@example.com", "アカウント名にメールアドレスが含まれること")
+
+	// 確認前の登録はまだ有効ではないこと
+	factor, _ := suite.mfaRepo.FindTOTPFactor(objID)
+	suite.False(factor.IsConfirmed())
+
+	// 確認前であれば発行し直せること
+	again, err := suite.service.BeginTOTPEnrollment(objID)
+	suite.NoError(err)
+	suite.NotEqual(enrollment.Secret, again.Secret)
+}
+
+func (suite *UserMFAServiceTestSuite) TestConfirmTOTPEnrollment() {
+	objID := suite.testUser.ObjID().Value()
+	enrollment, err := suite.service.BeginTOTPEnrollment(objID)
+	suite.Require().NoError(err)
+
+	_, err = suite.service.ConfirmTOTPEnrollment(objID, "000000")
+	suite.ErrorIs(err, mfa.ErrInvalidMFACode, "誤ったコードでは登録を完了できないこと")
+
+	codes, err := suite.service.ConfirmTOTPEnrollment(objID, suite.currentCode(enrollment.Secret, 0))
+	suite.NoError(err)
+	suite.Len(codes, 10)
+	suite.Regexp(`^[a-z2-7]{5}-[a-z2-7]{5}$`, codes[0])
+
+	factor, _ := suite.mfaRepo.FindTOTPFactor(objID)
+	suite.True(factor.IsConfirmed())
+
+	// 登録済みの場合は再登録できないこと
+	_, err = suite.service.BeginTOTPEnrollment(objID)
+	suite.ErrorIs(err, mfa.ErrMFAAlreadyEnabled)
+	_, err = suite.service.ConfirmTOTPEnrollment(objID, suite.currentCode(enrollment.Secret, 0))
+	suite.ErrorIs(err, mfa.ErrMFAAlreadyEnabled)
+}
+
+func (suite *UserMFAServiceTestSuite) TestConfirmTOTPEnrollment_NotStarted() {
+	_, err := suite.service.ConfirmTOTPEnrollment(suite.testUser.ObjID().Value(), "123456")
+	suite.ErrorIs(err, mfa.ErrMFANotEnrolled)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_TOTP() {
+	secret, _ := suite.enroll()
+
+	accessToken, refreshToken, err := suite.service.VerifyMFA(suite.challengeToken(), suite.currentCode(secret, 0), loginhistory.ClientInfo{})
+	suite.NoError(err)
+	suite.NotEmpty(refreshToken)
+	claims, err := utils.ValidateToken(accessToken)
+	suite.NoError(err)
+	suite.Equal(suite.testUser.ObjID().Value(), claims.ObjID)
+	// 一要素目の方法を引き継ぎ、多要素認証として扱われること
+	suite.ElementsMatch([]string{utils.AMRPassword, utils.AMROTP, utils.AMRMFA}, claims.AMR)
+	suite.Equal(utils.ACRMultiFactor, claims.ACR)
+	suite.Equal([]string{suite.testUser.ObjID().Value()}, suite.history.successes, "ログインの成功を記録すること")
+	suite.Equal([]string{"mfa@example.com"}, suite.lockout.successes, "二要素目の検証後に失敗回数を戻すこと")
+
+	// 一度使ったコードは再利用できないこと
+	_, _, err = suite.service.VerifyMFA(suite.challengeToken(), suite.currentCode(secret, 0), loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFACode)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_SuspendedAccount() {
+	secret, _ := suite.enroll()
+	suspendedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.testUser.Suspend("規約違反", suspendedAt))
+
+	accessToken, refreshToken, err := suite.service.VerifyMFA(suite.challengeToken(), suite.currentCode(secret, 0), loginhistory.ClientInfo{})
+	suite.ErrorIs(err, entity.ErrAccountSuspended)
+	suite.Empty(accessToken)
+	suite.Empty(refreshToken)
+	suite.Empty(suite.history.successes)
+	suite.Equal([]string{entity.LoginReasonAccountInactive}, suite.history.failures)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_EnrollmentCodeCannotBeReused() {
+	enrollment, err := suite.service.BeginTOTPEnrollment(suite.testUser.ObjID().Value())
+	suite.Require().NoError(err)
+	code := suite.currentCode(enrollment.Secret, 0)
+	_, err = suite.service.ConfirmTOTPEnrollment(suite.testUser.ObjID().Value(), code)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.service.VerifyMFA(suite.challengeToken(), code, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFACode, "登録の確認に使ったコードではログインできないこと")
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_RecoveryCode() {
+	_, codes := suite.enroll()
+
+	// 大文字や区切りなしで入力しても受け付けること
+	input := strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))
+	_, _, err := suite.service.VerifyMFA(suite.challengeToken(), input, loginhistory.ClientInfo{})
+	suite.NoError(err)
+
+	_, _, err = suite.service.VerifyMFA(suite.challengeToken(), codes[0], loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFACode, "使用済みのリカバリーコードは使えないこと")
+
+	_, _, err = suite.service.VerifyMFA(suite.challengeToken(), codes[1], loginhistory.ClientInfo{})
+	suite.NoError(err)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_InvalidCode() {
+	suite.enroll()
+
+	_, _, err := suite.service.VerifyMFA(suite.challengeToken(), "000000", loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFACode)
+	_, _, err = suite.service.VerifyMFA(suite.challengeToken(), "zzzzz-zzzzz", loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFACode)
+	suite.Equal([]string{entity.LoginReasonInvalidMFACode, entity.LoginReasonInvalidMFACode}, suite.history.failures, "コードの誤りを記録すること")
+	suite.Empty(suite.history.successes)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_TooManyAttempts() {
+	secret, _ := suite.enroll()
+	token := suite.challengeToken()
+
+	for range 3 {
+		_, _, err := suite.service.VerifyMFA(token, "000000", loginhistory.ClientInfo{})
+		suite.ErrorIs(err, mfa.ErrInvalidMFACode)
+	}
+	// 上限を超えた後は、正しいコードでもそのチャレンジトークンでは検証しないこと
+	_, _, err := suite.service.VerifyMFA(token, suite.currentCode(secret, 0), loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrTooManyAttempts)
+	suite.Empty(suite.history.successes)
+
+	// 新しいチャレンジトークンでは検証できること
+	_, _, err = suite.service.VerifyMFA(suite.challengeToken(), suite.currentCode(secret, 0), loginhistory.ClientInfo{})
+	suite.NoError(err)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_Lockout() {
+	secret, _ := suite.enroll()
+	suite.lockout.threshold = 2
+
+	_, _, err := suite.service.VerifyMFA(suite.challengeToken(), "000000", loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFACode)
+	// コードの誤りもアカウントの失敗として数え、しきい値に達するとロックすること
+	_, _, err = suite.service.VerifyMFA(suite.challengeToken(), "000000", loginhistory.ClientInfo{})
+	suite.ErrorIs(err, lockout.ErrAccountLocked)
+	suite.Equal(2, suite.lockout.failures["mfa@example.com"])
+
+	// ロック中は正しいコードでも検証しないこと
+	_, _, err = suite.service.VerifyMFA(suite.challengeToken(), suite.currentCode(secret, 0), loginhistory.ClientInfo{})
+	suite.ErrorIs(err, lockout.ErrAccountLocked)
+	suite.Equal(entity.LoginReasonAccountLocked, suite.history.failures[len(suite.history.failures)-1])
+	suite.Empty(suite.history.successes)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_InvalidToken() {
+	secret, _ := suite.enroll()
+	code := suite.currentCode(secret, 0)
+
+	_, _, err := suite.service.VerifyMFA("invalid.token", code, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFAToken)
+
+	// 他の用途のトークンやアクセストークンは使えないこと
+	other, _ := utils.GenerateActionToken(utils.PurposeEmailVerification, suite.testUser.ObjID().Value(), "mfa@example.com", time.Minute)
+	_, _, err = suite.service.VerifyMFA(other, code, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFAToken)
+	accessToken, _, _ := utils.GenerateTokens(suite.testUser.ObjID().Value(), utils.NewAuthContext(utils.AMRPassword))
+	_, _, err = suite.service.VerifyMFA(accessToken, code, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFAToken)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyMFA_NotEnrolled() {
+	_, _, err := suite.service.VerifyMFA(suite.challengeToken(), "123456", loginhistory.ClientInfo{})
+	suite.ErrorIs(err, mfa.ErrInvalidMFAToken)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyCode() {
+	secret, codes := suite.enroll()
+	objID := suite.testUser.ObjID().Value()
+
+	suite.NoError(suite.service.VerifyCode(objID, suite.currentCode(secret, 0)))
+	// 一度使ったコードは再利用できないこと
+	suite.ErrorIs(suite.service.VerifyCode(objID, suite.currentCode(secret, 0)), mfa.ErrInvalidMFACode)
+	suite.NoError(suite.service.VerifyCode(objID, codes[0]))
+	suite.ErrorIs(suite.service.VerifyCode(objID, "000000"), mfa.ErrInvalidMFACode)
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyCode_Lockout() {
+	secret, _ := suite.enroll()
+	objID := suite.testUser.ObjID().Value()
+	suite.lockout.threshold = 2
+
+	suite.ErrorIs(suite.service.VerifyCode(objID, "000000"), mfa.ErrInvalidMFACode)
+	suite.ErrorIs(suite.service.VerifyCode(objID, "000000"), lockout.ErrAccountLocked, "コードの誤りが続くとロックすること")
+	suite.ErrorIs(suite.service.VerifyCode(objID, suite.currentCode(secret, 0)), lockout.ErrAccountLocked, "ロック中は検証しないこと")
+}
+
+func (suite *UserMFAServiceTestSuite) TestVerifyCode_NotEnrolled() {
+	err := suite.service.VerifyCode(suite.testUser.ObjID().Value(), "123456")
+	suite.ErrorIs(err, mfa.ErrMFANotEnrolled)
+
+	// 確認前の登録ではコードを検証しないこと
+	enrollment, err := suite.service.BeginTOTPEnrollment(suite.testUser.ObjID().Value())
+	suite.Require().NoError(err)
+	err = suite.service.VerifyCode(suite.testUser.ObjID().Value(), suite.currentCode(enrollment.Secret, 0))
+	suite.ErrorIs(err, mfa.ErrMFANotEnrolled)
+}
@@ -0,0 +1,35 @@
+package passkey
+
+import (
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	RPID          string        // Relying Party ID（スキームとポートを除いたドメイン）
+	RPDisplayName string        // 認証器に表示するサービス名
+	RPOrigins     []string      // 許可するオリジン（フロントエンドの URL）
+	SessionTTL    time.Duration // セレモニーの開始から完了までの有効期限
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		RPID:          utils.GetEnvDefault("WEBAUTHN_RP_ID", "localhost"),
+		RPDisplayName: utils.GetEnvDefault("WEBAUTHN_RP_NAME", "Nexus User Auth"),
+		RPOrigins:     splitList(utils.GetEnvDefault("WEBAUTHN_RP_ORIGINS", "http://localhost:3000")),
+		SessionTTL:    utils.GetEnvDuration("WEBAUTHN_SESSION_TTL", 5*time.Minute),
+	}
+}
+
+// splitList はカンマ区切りの文字列を分割する
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
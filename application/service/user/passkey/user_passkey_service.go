@@ -0,0 +1,405 @@
+package passkey
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidPasskeySession = errs.NewServiceError("invalid or expired passkey session")
+	ErrInvalidPasskey        = errs.NewServiceError("passkey verification failed")
+	ErrNoPasskeys            = errs.NewServiceError("no passkeys registered")
+	ErrInvalidMFAToken       = errs.NewServiceError("invalid mfa token")
+	ErrInvalidPasskeyName    = errs.NewServiceError("passkey name must be at most 100 characters")
+)
+
+// Ceremony は、WebAuthn のセレモニーを開始した結果
+// Options をそのまま navigator.credentials.create / get に渡し、その応答を SessionID とともに送り返す。
+type Ceremony struct {
+	SessionID string
+	Options   json.RawMessage
+}
+
+type UserPasskeyService interface {
+	// BeginRegistration: パスキーの登録を開始
+	BeginRegistration(objID string) (*Ceremony, error)
+	// FinishRegistration: 認証器の応答（attestation）を検証してパスキーを登録
+	FinishRegistration(objID string, sessionID string, name string, credential []byte) (*entity.PasskeyCredential, error)
+	// ListPasskeys: 登録済みのパスキーを取得
+	ListPasskeys(objID string) ([]*entity.PasskeyCredential, error)
+	// BeginLogin: パスキーのみでのログインを開始（ユーザーは認証器が選択する）
+	BeginLogin() (*Ceremony, error)
+	// FinishLogin: 認証器の応答（assertion）を検証し、トークンを発行
+	FinishLogin(sessionID string, credential []byte, client loginhistory.ClientInfo) (accessToken string, refreshToken string, err error)
+	// BeginMFA: パスワード認証後の二要素目として、パスキーでの認証を開始
+	BeginMFA(mfaToken string) (*Ceremony, error)
+	// FinishMFA: 二要素目の応答を検証し、トークンを発行
+	FinishMFA(mfaToken string, sessionID string, credential []byte, client loginhistory.ClientInfo) (accessToken string, refreshToken string, err error)
+}
+
+// userPasskeyService は UserPasskeyService の実装
+type userPasskeyService struct {
+	userRepository    repository.UserRepository
+	passkeyRepository repository.PasskeyRepository
+	lockout           lockout.UserLockoutService
+	loginHistory      loginhistory.UserLoginHistoryService
+	webAuthn          *webauthn.WebAuthn
+	tokens            *utils.TokenSigner
+	config            *Config
+}
+
+// NewUserPasskeyService は UserPasskeyService のインスタンスを作成
+func NewUserPasskeyService(userRepository repository.UserRepository, passkeyRepository repository.PasskeyRepository, lockout lockout.UserLockoutService, loginHistory loginhistory.UserLoginHistoryService, tokens *utils.TokenSigner, config *Config) (UserPasskeyService, error) {
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          config.RPID,
+		RPDisplayName: config.RPDisplayName,
+		RPOrigins:     config.RPOrigins,
+	})
+	if err != nil {
+		return nil, errs.NewServiceError("invalid webauthn config: " + err.Error())
+	}
+	return &userPasskeyService{
+		userRepository:    userRepository,
+		passkeyRepository: passkeyRepository,
+		lockout:           lockout,
+		loginHistory:      loginHistory,
+		webAuthn:          webAuthn,
+		tokens:            tokens,
+		config:            config,
+	}, nil
+}
+
+// BeginRegistration は登録オプションを発行する
+// パスキーのみでのログインに使えるよう、認証器内にクレデンシャルを保存（resident key）させる。
+func (s *userPasskeyService) BeginRegistration(objID string) (*Ceremony, error) {
+	user, err := s.loadUser(objID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 登録済みの認証器で重複して登録しないよう除外する
+	exclusions := make([]protocol.CredentialDescriptor, 0, len(user.credentials))
+	for _, credential := range user.WebAuthnCredentials() {
+		exclusions = append(exclusions, credential.Descriptor())
+	}
+	creation, sessionData, err := s.webAuthn.BeginRegistration(user,
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+		webauthn.WithExclusions(exclusions),
+	)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to begin passkey registration")
+	}
+	return s.startCeremony(user.user, entity.PasskeyCeremonyRegistration, creation, sessionData)
+}
+
+// FinishRegistration は認証器の応答を検証してパスキーを登録する
+func (s *userPasskeyService) FinishRegistration(objID string, sessionID string, name string, credential []byte) (*entity.PasskeyCredential, error) {
+	if utf8.RuneCountInString(name) > entity.PasskeyNameMaxLength {
+		return nil, ErrInvalidPasskeyName
+	}
+	sessionData, err := s.consumeSession(sessionID, entity.PasskeyCeremonyRegistration, objID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.loadUser(objID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(credential))
+	if err != nil {
+		return nil, ErrInvalidPasskey
+	}
+	created, err := s.webAuthn.CreateCredential(user, *sessionData, parsed)
+	if err != nil {
+		return nil, ErrInvalidPasskey
+	}
+
+	transports := make([]string, 0, len(created.Transport))
+	for _, transport := range created.Transport {
+		transports = append(transports, string(transport))
+	}
+	passkey, err := entity.NewPasskeyCredential(
+		user.user.ObjID(),
+		created.ID,
+		name,
+		created.PublicKey,
+		created.AttestationType,
+		transports,
+		created.Authenticator.AAGUID,
+		created.Authenticator.SignCount,
+		created.Flags.BackupEligible,
+		created.Flags.BackupState,
+	)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create passkey")
+	}
+	if _, err := s.passkeyRepository.CreateCredential(passkey); err != nil {
+		return nil, errs.NewServiceError("failed to save passkey in repository")
+	}
+	return passkey, nil
+}
+
+// ListPasskeys は登録済みのパスキーを返す
+func (s *userPasskeyService) ListPasskeys(objID string) ([]*entity.PasskeyCredential, error) {
+	credentials, err := s.passkeyRepository.ListCredentials(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get passkeys")
+	}
+	return credentials, nil
+}
+
+// BeginLogin はパスキーのみでのログインのオプションを発行する
+// パスワードを使わないため、認証器での本人確認（生体認証・PIN）を必須にする。
+func (s *userPasskeyService) BeginLogin() (*Ceremony, error) {
+	assertion, sessionData, err := s.webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		return nil, errs.NewServiceError("failed to begin passkey login")
+	}
+	return s.startCeremony(nil, entity.PasskeyCeremonyLogin, assertion, sessionData)
+}
+
+// FinishLogin は認証器の応答を検証し、応答に含まれるユーザーハンドルのユーザーとしてトークンを発行する
+func (s *userPasskeyService) FinishLogin(sessionID string, credential []byte, client loginhistory.ClientInfo) (string, string, error) {
+	sessionData, err := s.consumeSession(sessionID, entity.PasskeyCeremonyLogin, "")
+	if err != nil {
+		return "", "", err
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(credential))
+	if err != nil {
+		return "", "", ErrInvalidPasskey
+	}
+
+	var user *webauthnUser
+	validated, err := s.webAuthn.ValidateDiscoverableLogin(func(_, userHandle []byte) (webauthn.User, error) {
+		user, err = s.loadUser(string(userHandle))
+		return user, err
+	}, *sessionData, parsed)
+	if err != nil {
+		return "", "", ErrInvalidPasskey
+	}
+
+	return s.completeLogin(user, validated, utils.NewAuthContext(utils.AMRHardwareKey), client)
+}
+
+// BeginMFA はチャレンジトークンのユーザーが登録したパスキーでの認証オプションを発行する
+func (s *userPasskeyService) BeginMFA(mfaToken string) (*Ceremony, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeMFAChallenge, mfaToken)
+	if err != nil {
+		return nil, ErrInvalidMFAToken
+	}
+	user, err := s.loadUser(claims.ObjID)
+	if err != nil {
+		return nil, ErrInvalidMFAToken
+	}
+	if len(user.credentials) == 0 {
+		return nil, ErrNoPasskeys
+	}
+
+	assertion, sessionData, err := s.webAuthn.BeginLogin(user, webauthn.WithUserVerification(protocol.VerificationPreferred))
+	if err != nil {
+		return nil, errs.NewServiceError("failed to begin passkey authentication")
+	}
+	return s.startCeremony(user.user, entity.PasskeyCeremonyMFA, assertion, sessionData)
+}
+
+// FinishMFA は二要素目の応答を検証し、トークンを発行する。検証に失敗した場合はログイン履歴に記録する
+func (s *userPasskeyService) FinishMFA(mfaToken string, sessionID string, credential []byte, client loginhistory.ClientInfo) (string, string, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeMFAChallenge, mfaToken)
+	if err != nil {
+		return "", "", ErrInvalidMFAToken
+	}
+	// 別のユーザーのチャレンジトークンで開始したセッションは使えない
+	sessionData, err := s.consumeSession(sessionID, entity.PasskeyCeremonyMFA, claims.ObjID)
+	if err != nil {
+		return "", "", err
+	}
+	user, err := s.loadUser(claims.ObjID)
+	if err != nil {
+		return "", "", ErrInvalidMFAToken
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(credential))
+	if err != nil {
+		return "", "", ErrInvalidPasskey
+	}
+	// 一要素目の方法（チャレンジトークンに含まれる）とパスキーを合わせた認証として扱う
+	auth := utils.NewAuthContext(append(claims.AMR, utils.AMRHardwareKey)...)
+	validated, err := s.webAuthn.ValidateLogin(user, *sessionData, parsed)
+	if err != nil {
+		s.loginHistory.RecordFailure(claims.ObjID, auth.AMR, entity.LoginReasonInvalidPasskey, client)
+		return "", "", ErrInvalidPasskey
+	}
+
+	accessToken, refreshToken, err := s.completeLogin(user, validated, auth, client)
+	if errors.Is(err, ErrInvalidPasskey) {
+		s.loginHistory.RecordFailure(claims.ObjID, auth.AMR, entity.LoginReasonInvalidPasskey, client)
+	}
+	return accessToken, refreshToken, err
+}
+
+// completeLogin は使われたパスキーの署名カウンターを更新し、トークンを発行する（ログインの成功はログイン履歴に記録する）
+// 有効でないアカウント（有効化待ち・停止・無効化）にはトークンを発行しない
+func (s *userPasskeyService) completeLogin(user *webauthnUser, validated *webauthn.Credential, auth utils.AuthContext, client loginhistory.ClientInfo) (string, string, error) {
+	if err := user.user.CheckActive(); err != nil {
+		s.loginHistory.RecordFailure(user.user.ObjID().Value(), auth.AMR, entity.LoginReasonAccountInactive, client)
+		return "", "", err
+	}
+	if validated.Authenticator.CloneWarning {
+		return "", "", ErrInvalidPasskey
+	}
+	passkey := user.find(validated.ID)
+	if passkey == nil {
+		return "", "", ErrInvalidPasskey
+	}
+	if err := passkey.RecordUse(validated.Authenticator.SignCount, validated.Flags.BackupState, time.Now()); err != nil {
+		return "", "", ErrInvalidPasskey
+	}
+	updated, err := s.passkeyRepository.UpdateCredentialUsage(passkey)
+	if err != nil {
+		return "", "", errs.NewServiceError("failed to record passkey usage")
+	}
+	if !updated {
+		return "", "", ErrInvalidPasskey
+	}
+
+	accessToken, refreshToken, err := s.tokens.GenerateTokens(user.user.ObjID().Value(), auth)
+	if err != nil {
+		return "", "", errs.NewServiceError("failed to generate tokens")
+	}
+	// パスワードの検証後に二要素目としてパスキーを使った場合も、ここでアカウントの失敗回数を戻す
+	s.lockout.RecordSuccess(user.user.Email().Value())
+	s.loginHistory.RecordSuccess(user.user.ObjID().Value(), auth.AMR, client)
+	return accessToken, refreshToken, nil
+}
+
+// startCeremony はセレモニーの状態を保存し、クライアントに渡すオプションを返す
+func (s *userPasskeyService) startCeremony(user *entity.User, ceremony entity.PasskeyCeremony, options any, sessionData *webauthn.SessionData) (*Ceremony, error) {
+	data, err := json.Marshal(sessionData)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to encode passkey session")
+	}
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to encode passkey options")
+	}
+
+	var session *entity.PasskeySession
+	if user != nil {
+		session, err = entity.NewPasskeySession(user.ObjID(), ceremony, data, s.config.SessionTTL)
+	} else {
+		session, err = entity.NewPasskeySession(nil, ceremony, data, s.config.SessionTTL)
+	}
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create passkey session")
+	}
+	if err := s.passkeyRepository.SaveSession(session); err != nil {
+		return nil, errs.NewServiceError("failed to save passkey session in repository")
+	}
+	return &Ceremony{SessionID: session.ID(), Options: optionsJSON}, nil
+}
+
+// consumeSession はセレモニーの状態を取得して使用済みにする
+// セレモニーの種類・ユーザー（objID が空の場合は確認しない）・有効期限が一致しない場合は無効とする。
+func (s *userPasskeyService) consumeSession(sessionID string, ceremony entity.PasskeyCeremony, objID string) (*webauthn.SessionData, error) {
+	session, err := s.passkeyRepository.ConsumeSession(sessionID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get passkey session")
+	}
+	if session == nil || session.Ceremony() != ceremony || session.IsExpired(time.Now()) {
+		return nil, ErrInvalidPasskeySession
+	}
+	if objID != "" && (session.UserObjID() == nil || session.UserObjID().Value() != objID) {
+		return nil, ErrInvalidPasskeySession
+	}
+
+	var sessionData webauthn.SessionData
+	if err := json.Unmarshal(session.Data(), &sessionData); err != nil {
+		return nil, errs.NewServiceError("failed to decode passkey session")
+	}
+	return &sessionData, nil
+}
+
+// loadUser はユーザーと登録済みのパスキーを取得する
+func (s *userPasskeyService) loadUser(objID string) (*webauthnUser, error) {
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get user")
+	}
+	credentials, err := s.passkeyRepository.ListCredentials(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get passkeys")
+	}
+	return &webauthnUser{user: user, credentials: credentials}, nil
+}
+
+// webauthnUser は、ユーザーを WebAuthn ライブラリの webauthn.User として扱うためのアダプター
+// ユーザーハンドルにはメールアドレスなどの個人情報を含めず、オブジェクトIDを使う。
+type webauthnUser struct {
+	user        *entity.User
+	credentials []*entity.PasskeyCredential
+}
+
+func (u *webauthnUser) WebAuthnID() []byte {
+	return []byte(u.user.ObjID().Value())
+}
+
+func (u *webauthnUser) WebAuthnName() string {
+	return u.user.Email().Value()
+}
+
+func (u *webauthnUser) WebAuthnDisplayName() string {
+	return u.user.Username().Value()
+}
+
+func (u *webauthnUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(u.credentials))
+	for _, passkey := range u.credentials {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(passkey.Transports()))
+		for _, transport := range passkey.Transports() {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:              passkey.CredentialID(),
+			PublicKey:       passkey.PublicKey(),
+			AttestationType: passkey.AttestationType(),
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: passkey.BackupEligible(),
+				BackupState:    passkey.BackupState(),
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    passkey.AAGUID(),
+				SignCount: passkey.SignCount(),
+			},
+		})
+	}
+	return credentials
+}
+
+// find はクレデンシャルIDに一致するパスキーを返す
+func (u *webauthnUser) find(credentialID []byte) *entity.PasskeyCredential {
+	for _, passkey := range u.credentials {
+		if bytes.Equal(passkey.CredentialID(), credentialID) {
+			return passkey
+		}
+	}
+	return nil
+}
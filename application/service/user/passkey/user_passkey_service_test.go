@@ -0,0 +1,519 @@
+package passkey_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/passkey"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+const (
+	testRPID   = "localhost"
+	testOrigin = "http://localhost:3000"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+// inMemoryPasskeyRepository はメモリ上でパスキーとセレモニーの状態を保持するテスト用リポジトリ
+type inMemoryPasskeyRepository struct {
+	credentials []*entity.PasskeyCredential
+	sessions    map[string]*entity.PasskeySession
+}
+
+func (r *inMemoryPasskeyRepository) CreateCredential(credential *entity.PasskeyCredential) (*entity.PasskeyCredential, error) {
+	for _, c := range r.credentials {
+		if string(c.CredentialID()) == string(credential.CredentialID()) {
+			return nil, errors.New("duplicate credential id")
+		}
+	}
+	r.credentials = append(r.credentials, copyCredential(credential))
+	return credential, nil
+}
+
+func (r *inMemoryPasskeyRepository) ListCredentials(userObjID string) ([]*entity.PasskeyCredential, error) {
+	var result []*entity.PasskeyCredential
+	for _, c := range r.credentials {
+		if c.UserObjID().Value() == userObjID {
+			result = append(result, copyCredential(c))
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryPasskeyRepository) UpdateCredentialUsage(credential *entity.PasskeyCredential) (bool, error) {
+	for i, c := range r.credentials {
+		if c.ID() != credential.ID() {
+			continue
+		}
+		if credential.SignCount() != 0 && c.SignCount() >= credential.SignCount() {
+			return false, nil
+		}
+		r.credentials[i] = copyCredential(credential)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (r *inMemoryPasskeyRepository) SaveSession(session *entity.PasskeySession) error {
+	r.sessions[session.ID()] = session
+	return nil
+}
+
+func (r *inMemoryPasskeyRepository) ConsumeSession(id string) (*entity.PasskeySession, error) {
+	session := r.sessions[id]
+	delete(r.sessions, id)
+	return session, nil
+}
+
+// copyCredential は保存済みの値がサービス側の変更に影響されないよう複製する
+func copyCredential(c *entity.PasskeyCredential) *entity.PasskeyCredential {
+	copied, _ := entity.BuildPasskeyCredential(c.ID(), c.UserObjID(), c.CredentialID(), c.Name(), c.PublicKey(), c.AttestationType(), c.Transports(), c.AAGUID(), c.SignCount(), c.BackupEligible(), c.BackupState(), c.CreatedAt(), c.LastUsedAt())
+	return copied
+}
+
+// recordingLoginHistory は記録されたログインの成功・失敗を保持するテスト用の UserLoginHistoryService
+type recordingLoginHistory struct {
+	successes []string // 成功したユーザーID
+	failures  []string // 失敗の理由
+}
+
+func (r *recordingLoginHistory) RecordSuccess(objID string, methods []string, client loginhistory.ClientInfo) {
+	r.successes = append(r.successes, objID)
+}
+
+func (r *recordingLoginHistory) RecordFailure(objID string, methods []string, reason string, client loginhistory.ClientInfo) {
+	r.failures = append(r.failures, reason)
+}
+
+func (r *recordingLoginHistory) ListLoginHistory(objID string, page int, perPage int) (*loginhistory.LoginHistoryPage, error) {
+	return &loginhistory.LoginHistoryPage{}, nil
+}
+
+// recordingLockout はログインの成功を記録するテスト用の UserLockoutService
+type recordingLockout struct {
+	successes []string // 失敗回数を戻したメールアドレス
+}
+
+func (l *recordingLockout) Check(email string, ip string) error {
+	return nil
+}
+
+func (l *recordingLockout) RecordFailure(email string, ip string) error {
+	return nil
+}
+
+func (l *recordingLockout) RecordSuccess(email string) {
+	l.successes = append(l.successes, email)
+}
+
+func (l *recordingLockout) Unlock(token string) error {
+	return nil
+}
+
+func (l *recordingLockout) AdminUnlock(objID string) error {
+	return nil
+}
+
+// --- テストスイート ---
+
+type UserPasskeyServiceTestSuite struct {
+	suite.Suite
+	userRepo      *mockUserRepository
+	passkeyRepo   *inMemoryPasskeyRepository
+	lockout       *recordingLockout
+	history       *recordingLoginHistory
+	service       passkey.UserPasskeyService
+	authenticator *tester.SoftwareAuthenticator
+	testUser      *entity.User
+	otherUser     *entity.User
+}
+
+func TestUserPasskeyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPasskeyServiceTestSuite))
+}
+
+func (suite *UserPasskeyServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserPasskeyServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserPasskeyServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.passkeyRepo = &inMemoryPasskeyRepository{sessions: make(map[string]*entity.PasskeySession)}
+	suite.lockout = &recordingLockout{}
+	suite.history = &recordingLoginHistory{}
+	service, err := passkey.NewUserPasskeyService(suite.userRepo, suite.passkeyRepo, suite.lockout, suite.history, utils.DefaultTokenSigner(), &passkey.Config{
+		RPID:          testRPID,
+		RPDisplayName: "Nexus",
+		RPOrigins:     []string{testOrigin},
+		SessionTTL:    time.Minute,
+	})
+	suite.Require().NoError(err)
+	suite.service = service
+	suite.authenticator = tester.NewSoftwareAuthenticator(testRPID, testOrigin)
+
+	suite.testUser = suite.newUser("passkey@example.com", "passkeyuser")
+	suite.otherUser = suite.newUser("other@example.com", "otheruser")
+}
+
+func (suite *UserPasskeyServiceTestSuite) newUser(address string, name string) *entity.User {
+	email, _ := value.NewUserEmail(address)
+	username, _ := value.NewUserUsername(name)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	activatedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(user.Activate(activatedAt))
+	suite.userRepo.On("GetUserByObjID", user.ObjID().Value()).Return(user, nil)
+	return user
+}
+
+// register はソフトウェア認証器でパスキーを登録する
+func (suite *UserPasskeyServiceTestSuite) register(user *entity.User, authenticator *tester.SoftwareAuthenticator) *entity.PasskeyCredential {
+	objID := user.ObjID().Value()
+	ceremony, err := suite.service.BeginRegistration(objID)
+	suite.Require().NoError(err)
+	response, err := authenticator.Register(ceremony.Options)
+	suite.Require().NoError(err)
+	credential, err := suite.service.FinishRegistration(objID, ceremony.SessionID, "MacBook", response)
+	suite.Require().NoError(err)
+	return credential
+}
+
+func (suite *UserPasskeyServiceTestSuite) challengeToken(user *entity.User) string {
+	token, err := utils.GenerateActionTokenWithAMR(utils.PurposeMFAChallenge, user.ObjID().Value(), []string{utils.AMRPassword}, time.Minute)
+	suite.Require().NoError(err)
+	return token
+}
+
+// ----- 登録のテスト -----
+
+func (suite *UserPasskeyServiceTestSuite) TestRegistration() {
+	credential := suite.register(suite.testUser, suite.authenticator)
+
+	suite.Equal(suite.authenticator.Credentials()[0].ID, credential.CredentialID())
+	suite.Equal("MacBook", credential.Name())
+	suite.Equal("none", credential.AttestationType())
+
+	passkeys, err := suite.service.ListPasskeys(suite.testUser.ObjID().Value())
+	suite.NoError(err)
+	suite.Require().Len(passkeys, 1)
+	suite.Equal(credential.ID(), passkeys[0].ID())
+
+	// ユーザーハンドルにはオブジェクトIDが使われること
+	suite.Equal(suite.testUser.ObjID().Value(), string(suite.authenticator.Credentials()[0].UserHandle))
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestRegistration_ExcludesRegisteredCredentials() {
+	suite.register(suite.testUser, suite.authenticator)
+
+	ceremony, err := suite.service.BeginRegistration(suite.testUser.ObjID().Value())
+	suite.NoError(err)
+	suite.Contains(string(ceremony.Options), "excludeCredentials", "登録済みのパスキーを除外すること")
+	suite.Contains(string(ceremony.Options), `"residentKey":"required"`, "パスキーのみでのログインに使えること")
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestFinishRegistration_WrongOrigin() {
+	objID := suite.testUser.ObjID().Value()
+	ceremony, err := suite.service.BeginRegistration(objID)
+	suite.Require().NoError(err)
+
+	// フィッシングサイトで作成されたクレデンシャルは受け付けないこと
+	phishing := tester.NewSoftwareAuthenticator(testRPID, "https://evil.example.com")
+	response, err := phishing.Register(ceremony.Options)
+	suite.Require().NoError(err)
+
+	_, err = suite.service.FinishRegistration(objID, ceremony.SessionID, "", response)
+	suite.ErrorIs(err, passkey.ErrInvalidPasskey)
+	passkeys, _ := suite.service.ListPasskeys(objID)
+	suite.Empty(passkeys)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestFinishRegistration_InvalidSession() {
+	objID := suite.testUser.ObjID().Value()
+	ceremony, err := suite.service.BeginRegistration(objID)
+	suite.Require().NoError(err)
+	response, err := suite.authenticator.Register(ceremony.Options)
+	suite.Require().NoError(err)
+
+	// 別のユーザーが開始したセッションは使えないこと
+	_, err = suite.service.FinishRegistration(suite.otherUser.ObjID().Value(), ceremony.SessionID, "", response)
+	suite.ErrorIs(err, passkey.ErrInvalidPasskeySession)
+
+	// セッションは一度しか使えないこと
+	_, err = suite.service.FinishRegistration(objID, ceremony.SessionID, "", response)
+	suite.ErrorIs(err, passkey.ErrInvalidPasskeySession)
+
+	_, err = suite.service.FinishRegistration(objID, "unknown", "", response)
+	suite.ErrorIs(err, passkey.ErrInvalidPasskeySession)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestFinishRegistration_InvalidName() {
+	objID := suite.testUser.ObjID().Value()
+	ceremony, err := suite.service.BeginRegistration(objID)
+	suite.Require().NoError(err)
+	response, err := suite.authenticator.Register(ceremony.Options)
+	suite.Require().NoError(err)
+
+	_, err = suite.service.FinishRegistration(objID, ceremony.SessionID, strings.Repeat("a", entity.PasskeyNameMaxLength+1), response)
+	suite.ErrorIs(err, passkey.ErrInvalidPasskeyName)
+
+	// 名前の誤りではセッションを消費しないこと
+	_, err = suite.service.FinishRegistration(objID, ceremony.SessionID, "MacBook", response)
+	suite.NoError(err)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestFinishRegistration_ExpiredSession() {
+	objID := suite.testUser.ObjID().Value()
+	ceremony, err := suite.service.BeginRegistration(objID)
+	suite.Require().NoError(err)
+	response, err := suite.authenticator.Register(ceremony.Options)
+	suite.Require().NoError(err)
+
+	// 有効期限切れのセッションに置き換える
+	session := suite.passkeyRepo.sessions[ceremony.SessionID]
+	expired, err := entity.BuildPasskeySession(session.ID(), session.UserObjID(), session.Ceremony(), session.Data(), time.Now().Add(-time.Second))
+	suite.Require().NoError(err)
+	suite.passkeyRepo.sessions[ceremony.SessionID] = expired
+
+	_, err = suite.service.FinishRegistration(objID, ceremony.SessionID, "", response)
+	suite.ErrorIs(err, passkey.ErrInvalidPasskeySession)
+}
+
+// ----- パスキーのみでのログインのテスト -----
+
+func (suite *UserPasskeyServiceTestSuite) TestLogin() {
+	suite.register(suite.testUser, suite.authenticator)
+
+	ceremony, err := suite.service.BeginLogin()
+	suite.Require().NoError(err)
+	suite.NotContains(string(ceremony.Options), "allowCredentials", "ユーザーを特定せずに開始すること")
+	response, err := suite.authenticator.Login(ceremony.Options)
+	suite.Require().NoError(err)
+
+	accessToken, refreshToken, err := suite.service.FinishLogin(ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.NoError(err)
+	suite.NotEmpty(refreshToken)
+	claims, err := utils.ValidateToken(accessToken)
+	suite.NoError(err)
+	suite.Equal(suite.testUser.ObjID().Value(), claims.ObjID, "認証器が選択したユーザーとしてログインすること")
+	suite.Equal([]string{utils.AMRHardwareKey}, claims.AMR)
+	suite.Equal(utils.ACRMultiFactor, claims.ACR, "パスキーは単体で多要素認証として扱うこと")
+	suite.Equal([]string{suite.testUser.ObjID().Value()}, suite.history.successes, "ログインの成功を記録すること")
+
+	// 署名カウンターと最終使用日時が記録されること
+	passkeys, _ := suite.service.ListPasskeys(suite.testUser.ObjID().Value())
+	suite.Equal(uint32(1), passkeys[0].SignCount())
+	suite.NotNil(passkeys[0].LastUsedAt())
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestLogin_DeactivatedAccount() {
+	suite.register(suite.testUser, suite.authenticator)
+	deactivatedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.testUser.Deactivate("", deactivatedAt))
+
+	ceremony, err := suite.service.BeginLogin()
+	suite.Require().NoError(err)
+	response, err := suite.authenticator.Login(ceremony.Options)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.service.FinishLogin(ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, entity.ErrAccountDeactivated)
+	suite.Empty(suite.history.successes)
+	suite.Equal([]string{entity.LoginReasonAccountInactive}, suite.history.failures)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestLogin_RequiresUserVerification() {
+	suite.register(suite.testUser, suite.authenticator)
+
+	ceremony, err := suite.service.BeginLogin()
+	suite.Require().NoError(err)
+	suite.authenticator.UserVerified = false
+	response, err := suite.authenticator.Login(ceremony.Options)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.service.FinishLogin(ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, passkey.ErrInvalidPasskey)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestLogin_ClonedAuthenticator() {
+	suite.register(suite.testUser, suite.authenticator)
+
+	ceremony, err := suite.service.BeginLogin()
+	suite.Require().NoError(err)
+	response, err := suite.authenticator.Login(ceremony.Options)
+	suite.Require().NoError(err)
+	_, _, err = suite.service.FinishLogin(ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.Require().NoError(err)
+
+	// 複製された認証器は署名カウンターが巻き戻るため拒否すること
+	suite.authenticator.Credentials()[0].SignCount = 0
+	ceremony, err = suite.service.BeginLogin()
+	suite.Require().NoError(err)
+	response, err = suite.authenticator.Login(ceremony.Options)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.service.FinishLogin(ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, passkey.ErrInvalidPasskey)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestLogin_UnknownCredential() {
+	suite.register(suite.testUser, suite.authenticator)
+
+	// 登録していない認証器の応答は受け付けないこと
+	unknown := tester.NewSoftwareAuthenticator(testRPID, testOrigin)
+	_, err := unknown.Register([]byte(`{"publicKey":{"challenge":"AAAA","user":{"id":"` + base64.RawURLEncoding.EncodeToString([]byte(suite.testUser.ObjID().Value())) + `"}}}`))
+	suite.Require().NoError(err)
+
+	ceremony, err := suite.service.BeginLogin()
+	suite.Require().NoError(err)
+	response, err := unknown.Login(ceremony.Options)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.service.FinishLogin(ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, passkey.ErrInvalidPasskey)
+}
+
+// ----- 二要素目としての認証のテスト -----
+
+func (suite *UserPasskeyServiceTestSuite) TestMFA() {
+	credential := suite.register(suite.testUser, suite.authenticator)
+	mfaToken := suite.challengeToken(suite.testUser)
+
+	ceremony, err := suite.service.BeginMFA(mfaToken)
+	suite.Require().NoError(err)
+	suite.Contains(string(ceremony.Options), "allowCredentials", "ユーザーのパスキーに限定すること")
+	// 二要素目では本人確認（UV）を必須にしない
+	suite.authenticator.UserVerified = false
+	response, err := suite.authenticator.Login(ceremony.Options)
+	suite.Require().NoError(err)
+
+	accessToken, refreshToken, err := suite.service.FinishMFA(mfaToken, ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.NoError(err)
+	suite.NotEmpty(refreshToken)
+	claims, err := utils.ValidateToken(accessToken)
+	suite.NoError(err)
+	suite.Equal(credential.UserObjID().Value(), claims.ObjID)
+	suite.ElementsMatch([]string{utils.AMRPassword, utils.AMRHardwareKey, utils.AMRMFA}, claims.AMR)
+	suite.Equal([]string{"passkey@example.com"}, suite.lockout.successes, "二要素目の検証後に失敗回数を戻すこと")
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestMFA_InvalidToken() {
+	suite.register(suite.testUser, suite.authenticator)
+
+	_, err := suite.service.BeginMFA("invalid")
+	suite.ErrorIs(err, passkey.ErrInvalidMFAToken)
+
+	// アクセストークンはチャレンジトークンとして使えないこと
+	accessToken, _, err := utils.GenerateTokens(suite.testUser.ObjID().Value(), utils.NewAuthContext(utils.AMRHardwareKey))
+	suite.Require().NoError(err)
+	_, err = suite.service.BeginMFA(accessToken)
+	suite.ErrorIs(err, passkey.ErrInvalidMFAToken)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestMFA_NoPasskeys() {
+	_, err := suite.service.BeginMFA(suite.challengeToken(suite.testUser))
+	suite.ErrorIs(err, passkey.ErrNoPasskeys)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestMFA_OtherUsersSession() {
+	suite.register(suite.testUser, suite.authenticator)
+	otherAuthenticator := tester.NewSoftwareAuthenticator(testRPID, testOrigin)
+	suite.register(suite.otherUser, otherAuthenticator)
+
+	ceremony, err := suite.service.BeginMFA(suite.challengeToken(suite.otherUser))
+	suite.Require().NoError(err)
+	response, err := otherAuthenticator.Login(ceremony.Options)
+	suite.Require().NoError(err)
+
+	// 別のユーザーのチャレンジトークンで開始したセッションは使えないこと
+	_, _, err = suite.service.FinishMFA(suite.challengeToken(suite.testUser), ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, passkey.ErrInvalidPasskeySession)
+}
+
+func (suite *UserPasskeyServiceTestSuite) TestMFA_OtherUsersCredential() {
+	suite.register(suite.testUser, suite.authenticator)
+	otherAuthenticator := tester.NewSoftwareAuthenticator(testRPID, testOrigin)
+	suite.register(suite.otherUser, otherAuthenticator)
+
+	mfaToken := suite.challengeToken(suite.testUser)
+	ceremony, err := suite.service.BeginMFA(mfaToken)
+	suite.Require().NoError(err)
+	// 許可リストに含まれない他人のパスキーで応答する
+	response, err := otherAuthenticator.Login([]byte(`{"publicKey":{"challenge":"AAAA"}}`))
+	suite.Require().NoError(err)
+
+	_, _, err = suite.service.FinishMFA(mfaToken, ceremony.SessionID, response, loginhistory.ClientInfo{})
+	suite.ErrorIs(err, passkey.ErrInvalidPasskey)
+	suite.Equal([]string{entity.LoginReasonInvalidPasskey}, suite.history.failures, "パスキーの検証の失敗を記録すること")
+	suite.Empty(suite.history.successes)
+}
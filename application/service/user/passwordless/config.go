@@ -0,0 +1,28 @@
+package passwordless
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	LoginURL      string        // マジックリンクの遷移先（token クエリが付与される）
+	TTL           time.Duration // リンク・コードの有効期限
+	MaxAttempts   int           // 1 つのコードに対して検証を試行できる回数
+	StartInterval time.Duration // 同じメールアドレスへの送信の最小間隔
+	AllowSignup   bool          // 未登録のメールアドレスでの新規登録を許可する
+	Locale        mailer.Locale // メール文面の言語
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		LoginURL:      utils.GetEnvDefault("PASSWORDLESS_LOGIN_URL", "http://localhost:3000/passwordless"),
+		TTL:           utils.GetEnvDuration("PASSWORDLESS_TTL", 10*time.Minute),
+		MaxAttempts:   utils.GetEnvInt("PASSWORDLESS_MAX_ATTEMPTS", 5),
+		StartInterval: utils.GetEnvDuration("PASSWORDLESS_START_INTERVAL", time.Minute),
+		AllowSignup:   utils.GetEnvBool("PASSWORDLESS_ALLOW_SIGNUP", true),
+		Locale:        mailer.LocaleFromEnv(),
+	}
+}
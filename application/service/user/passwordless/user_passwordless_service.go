@@ -0,0 +1,298 @@
+package passwordless
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/authentication"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidEmail    = errs.NewServiceError("invalid email")
+	ErrStartThrottled  = errs.NewServiceError("passwordless login start is throttled")
+	ErrInvalidCode     = errs.NewServiceError("invalid or expired passwordless code")
+	ErrTooManyAttempts = errs.NewServiceError("too many passwordless code attempts")
+)
+
+// codeDigits はワンタイムコードの桁数
+const codeDigits = 6
+
+type UserPasswordlessService interface {
+	// Start: マジックリンクとワンタイムコードを含むメールを送信（同じメールアドレスへの送信は一定間隔でスロットリングする）
+	Start(email string) error
+	// VerifyLink: マジックリンクのトークンを検証してログインする（deviceToken は信頼済み端末のトークン）
+	VerifyLink(token string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error)
+	// VerifyCode: メールアドレスとワンタイムコードを検証してログインする（deviceToken は信頼済み端末のトークン）
+	VerifyCode(email string, code string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error)
+}
+
+// userPasswordlessService は UserPasswordlessService の実装
+type userPasswordlessService struct {
+	userRepository         repository.UserRepository
+	passwordlessRepository repository.PasswordlessRepository
+	sessionRepository      repository.SessionRepository
+	authService            authentication.UserAuthenticationService
+	domainPolicy           registration.DomainPolicy
+	mailer                 mailer.Mailer
+	tokens                 *utils.TokenSigner
+	config                 *Config
+}
+
+// NewUserPasswordlessService は UserPasswordlessService のインスタンスを作成
+func NewUserPasswordlessService(userRepository repository.UserRepository, passwordlessRepository repository.PasswordlessRepository, sessionRepository repository.SessionRepository, authService authentication.UserAuthenticationService, domainPolicy registration.DomainPolicy, mailer mailer.Mailer, tokens *utils.TokenSigner, config *Config) UserPasswordlessService {
+	return &userPasswordlessService{
+		userRepository:         userRepository,
+		passwordlessRepository: passwordlessRepository,
+		sessionRepository:      sessionRepository,
+		authService:            authService,
+		domainPolicy:           domainPolicy,
+		mailer:                 mailer,
+		tokens:                 tokens,
+		config:                 config,
+	}
+}
+
+// Start はマジックリンクとワンタイムコードを含むメールを送信する
+// メールアドレスの存在有無を推測されないよう、未登録で新規登録できない場合もエラーを返さない
+func (s *userPasswordlessService) Start(email string) error {
+	emailValue, err := value.NewUserEmail(email)
+	if err != nil {
+		return ErrInvalidEmail
+	}
+
+	// 送信間隔の判定は DB に保存したチャレンジで行う（複数インスタンスでも共有される）
+	latest, err := s.passwordlessRepository.FindLatestChallenge(emailValue)
+	if err != nil {
+		return errs.NewServiceError("failed to get passwordless challenge")
+	}
+	if latest != nil && time.Since(latest.CreatedAt()) < s.config.StartInterval {
+		return ErrStartThrottled
+	}
+
+	to := emailValue.Value()
+	user, err := s.userRepository.GetUserByEmail(emailValue.Value())
+	if err != nil {
+		if !s.config.AllowSignup {
+			logger.Info("passwordless login requested for unknown email")
+			return nil
+		}
+		// 新規登録になる場合は通常の登録と同じドメインの制限を適用する
+		if err := s.domainPolicy.Check(emailValue); err != nil {
+			logger.Info("passwordless signup requested for disallowed email domain")
+			return nil
+		}
+	} else if user.IsServiceAccount() {
+		// サービスアカウントはログインできないため、コードを送らない
+		logger.Info("passwordless login requested for service account")
+		return nil
+	} else {
+		to = user.Email().Value()
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return errs.NewServiceError("failed to generate passwordless code")
+	}
+	challenge, err := entity.NewPasswordlessChallenge(emailValue, code, s.config.TTL)
+	if err != nil {
+		return errs.NewServiceError("failed to create passwordless challenge")
+	}
+	if _, err := s.passwordlessRepository.CreateChallenge(challenge); err != nil {
+		return errs.NewServiceError("failed to save passwordless challenge")
+	}
+
+	token, err := s.tokens.GenerateActionTokenWithRef(utils.PurposePasswordlessLogin, "", emailValue.Value(), challenge.ID(), s.config.TTL)
+	if err != nil {
+		return errs.NewServiceError("failed to generate passwordless token")
+	}
+	link, err := utils.BuildTokenLink(s.config.LoginURL, token)
+	if err != nil {
+		return errs.NewServiceError("failed to build passwordless link")
+	}
+
+	msg, err := mailer.Render(mailer.TemplatePasswordlessLogin, s.config.Locale, to, map[string]any{
+		"Link":      link,
+		"Code":      code,
+		"ExpiresIn": s.config.TTL,
+	})
+	if err != nil {
+		return errs.NewServiceError("failed to render passwordless email")
+	}
+	if err := s.mailer.Send(msg); err != nil {
+		return errs.NewServiceError("failed to send passwordless email")
+	}
+	return nil
+}
+
+// VerifyLink はマジックリンクのトークンを検証してログインする
+func (s *userPasswordlessService) VerifyLink(token string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposePasswordlessLogin, token)
+	if err != nil {
+		return nil, ErrInvalidCode
+	}
+	challenge, err := s.passwordlessRepository.FindChallenge(claims.Ref)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get passwordless challenge")
+	}
+	if challenge == nil || challenge.Email().Value() != claims.Email {
+		return nil, ErrInvalidCode
+	}
+	return s.complete(challenge, deviceToken, client)
+}
+
+// VerifyCode はメールアドレスとワンタイムコードを検証してログインする
+// 有効なのは最後に送信したコードのみで、試行回数の上限に達したコードは正しくても使用できない
+func (s *userPasswordlessService) VerifyCode(email string, code string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	emailValue, err := value.NewUserEmail(email)
+	if err != nil {
+		return nil, ErrInvalidCode
+	}
+	challenge, err := s.passwordlessRepository.FindLatestChallenge(emailValue)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get passwordless challenge")
+	}
+	if challenge == nil || challenge.IsConsumed() || challenge.IsExpired(time.Now()) {
+		return nil, ErrInvalidCode
+	}
+
+	// 照合前に試行回数を加算する（条件付き更新のため、同時リクエストでも上限を超えない）
+	ok, err := s.passwordlessRepository.IncrementAttempts(challenge.ID(), s.config.MaxAttempts)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to record passwordless attempt")
+	}
+	if !ok {
+		return nil, ErrTooManyAttempts
+	}
+	if !challenge.MatchesCode(code) {
+		return nil, ErrInvalidCode
+	}
+	return s.complete(challenge, deviceToken, client)
+}
+
+// complete はチャレンジを使用済みにし、UserLogin と同じ経路でトークンを発行する
+// 未登録のメールアドレスの場合はユーザーを作成し、メールアドレスを確認済みにする
+// 確認前の登録済みのユーザーの場合は、メールアドレスを確認済みにしたうえで、登録した人のパスワードとトークンを無効にする
+func (s *userPasswordlessService) complete(challenge *entity.PasswordlessChallenge, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	if challenge.IsConsumed() || challenge.IsExpired(time.Now()) {
+		return nil, ErrInvalidCode
+	}
+	// 使用済みにできたリクエストのみがログインできる（リンクとコードのどちらで使っても一度限り）
+	ok, err := s.passwordlessRepository.ConsumeChallenge(challenge.ID())
+	if err != nil {
+		return nil, errs.NewServiceError("failed to consume passwordless challenge")
+	}
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+
+	verifiedAt, err := timeobj.NewTimeObj(time.Now())
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create verified time")
+	}
+
+	user, err := s.userRepository.GetUserByEmail(challenge.Email().Value())
+	if err != nil {
+		if !s.config.AllowSignup {
+			return nil, ErrInvalidCode
+		}
+		user, err = s.signup(challenge.Email(), verifiedAt)
+		if err != nil {
+			return nil, err
+		}
+	} else if !user.IsEmailVerified() && claimable(user) {
+		if user, err = s.claim(user, verifiedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.authService.CompleteLogin(user, utils.AMREmail, deviceToken, client)
+}
+
+// claim はメールで受け取ったリンク・コードで認証できた、確認前のユーザーのメールアドレスを確認済みにする
+// 確認前のアドレスは他人が先に登録した可能性がある（アカウントの事前乗っ取り）ため、
+// 登録時のパスワードを取り除き、発行済みのトークンを無効にして、アドレスの持ち主だけが使えるようにする
+func (s *userPasswordlessService) claim(user *entity.User, verifiedAt *timeobj.TimeObj) (*entity.User, error) {
+	if err := user.VerifyEmail(verifiedAt); err != nil {
+		return nil, errs.NewServiceError("failed to verify email")
+	}
+	user.ClearPassword()
+	updated, err := s.userRepository.UpdateUser(user)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to update user in repository")
+	}
+	if _, err := s.sessionRepository.RevokeSessions(updated.ObjID().Value(), time.Now()); err != nil {
+		return nil, errs.NewServiceError("failed to revoke sessions")
+	}
+	return updated, nil
+}
+
+// claimable は、パスワードレスログインでメールアドレスを確認してよいユーザーかどうかを返す
+// 停止・無効化したアカウントとサービスアカウントは変更せず、CompleteLogin で状態に応じて拒否する
+// 有効化待ちのアカウントは、メールアドレスの確認とともに有効になる
+func claimable(user *entity.User) bool {
+	if user.IsServiceAccount() {
+		return false
+	}
+	err := user.CheckActive()
+	return err == nil || errors.Is(err, entity.ErrAccountPending)
+}
+
+// signup はパスワードを設定せず、メールアドレス確認済みのユーザーを作成する
+func (s *userPasswordlessService) signup(email *value.UserEmail, verifiedAt *timeobj.TimeObj) (*entity.User, error) {
+	username, err := value.NewUserUsername(usernameFromEmail(email))
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create user username")
+	}
+	user, err := entity.NewUser(email, value.NoPassword(), username)
+	if err != nil {
+		return nil, err
+	}
+	if err := user.VerifyEmail(verifiedAt); err != nil {
+		return nil, errs.NewServiceError("failed to verify email")
+	}
+	created, err := s.userRepository.CreateUser(user)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create user in repository")
+	}
+	return created, nil
+}
+
+// usernameFromEmail はメールアドレスのローカル部から初期のユーザー名を作る（3 文字以上 50 文字以内に収める）
+func usernameFromEmail(email *value.UserEmail) string {
+	local, _, _ := strings.Cut(email.Value(), "@")
+	if utf8.RuneCountInString(local) > 50 {
+		local = string([]rune(local)[:50])
+	}
+	if utf8.RuneCountInString(local) < 3 {
+		local += "_user"
+	}
+	return local
+}
+
+// generateCode は codeDigits 桁の数字のワンタイムコードを生成する
+func generateCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < codeDigits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", codeDigits, n.Int64()), nil
+}
@@ -0,0 +1,442 @@
+package passwordless_test
+
+import (
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/authentication"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/passwordless"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モック ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+type mockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *mockSessionRepository) RevokeSessions(userObjID string, revokedAt time.Time) (bool, error) {
+	args := m.Called(userObjID, revokedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockSessionRepository) GetSessionsRevokedAt(userObjID string) (*time.Time, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+type mockAuthService struct {
+	mock.Mock
+}
+
+func (m *mockAuthService) UserLogin(email string, password string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	args := m.Called(email, password, deviceToken, client)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authentication.LoginResult), args.Error(1)
+}
+
+func (m *mockAuthService) CompleteLogin(user *entity.User, method string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	args := m.Called(user, method, deviceToken, client)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authentication.LoginResult), args.Error(1)
+}
+
+func (m *mockAuthService) UserTokenRefresh(refreshToken string) (string, error) {
+	args := m.Called(refreshToken)
+	return args.String(0), args.Error(1)
+}
+
+// stubDomainPolicy は固定の判定結果を返すドメインポリシー
+type stubDomainPolicy struct {
+	err error
+}
+
+func (p *stubDomainPolicy) Check(email *value.UserEmail) error {
+	return p.err
+}
+
+func (p *stubDomainPolicy) Reload() error {
+	return nil
+}
+
+// inMemoryPasswordlessRepository はメモリ上でチャレンジを保持するテスト用リポジトリ
+type inMemoryPasswordlessRepository struct {
+	items []*entity.PasswordlessChallenge
+}
+
+func (r *inMemoryPasswordlessRepository) CreateChallenge(challenge *entity.PasswordlessChallenge) (*entity.PasswordlessChallenge, error) {
+	r.items = append(r.items, challenge)
+	return challenge, nil
+}
+
+func (r *inMemoryPasswordlessRepository) FindChallenge(id string) (*entity.PasswordlessChallenge, error) {
+	for _, c := range r.items {
+		if c.ID() == id {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryPasswordlessRepository) FindLatestChallenge(email *value.UserEmail) (*entity.PasswordlessChallenge, error) {
+	for i := len(r.items) - 1; i >= 0; i-- {
+		if r.items[i].Email().Equals(email) {
+			return r.items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryPasswordlessRepository) IncrementAttempts(id string, maxAttempts int) (bool, error) {
+	for i, c := range r.items {
+		if c.ID() != id {
+			continue
+		}
+		if c.IsConsumed() || c.Attempts() >= maxAttempts {
+			return false, nil
+		}
+		r.items[i], _ = entity.BuildPasswordlessChallenge(c.ID(), c.Email(), c.CodeHash(), c.Attempts()+1, c.ExpiresAt(), c.ConsumedAt(), c.CreatedAt())
+		return true, nil
+	}
+	return false, nil
+}
+
+func (r *inMemoryPasswordlessRepository) ConsumeChallenge(id string) (bool, error) {
+	for i, c := range r.items {
+		if c.ID() != id {
+			continue
+		}
+		if c.IsConsumed() {
+			return false, nil
+		}
+		now := time.Now()
+		r.items[i], _ = entity.BuildPasswordlessChallenge(c.ID(), c.Email(), c.CodeHash(), c.Attempts(), c.ExpiresAt(), &now, c.CreatedAt())
+		return true, nil
+	}
+	return false, nil
+}
+
+// backdate はチャレンジの作成日時・有効期限をずらす（送信間隔・期限切れの確認用）
+func (r *inMemoryPasswordlessRepository) backdate(d time.Duration) {
+	for i, c := range r.items {
+		r.items[i], _ = entity.BuildPasswordlessChallenge(c.ID(), c.Email(), c.CodeHash(), c.Attempts(), c.ExpiresAt().Add(-d), c.ConsumedAt(), c.CreatedAt().Add(-d))
+	}
+}
+
+// sentTo は指定アドレス宛ての最後のメール本文から token クエリとワンタイムコードを取り出す
+func sentTo(outbox *mailer.MemoryOutbox, to string) (token string, code string) {
+	msg := outbox.LastTo(to)
+	if msg == nil {
+		return "", ""
+	}
+	u, err := url.Parse(regexp.MustCompile(`https?://\S+`).FindString(msg.Body))
+	if err != nil {
+		return "", ""
+	}
+	return u.Query().Get("token"), regexp.MustCompile(`\b\d{6}\b`).FindString(msg.Body)
+}
+
+// --- テストスイート ---
+
+type UserPasswordlessServiceTestSuite struct {
+	suite.Suite
+	userRepo         *mockUserRepository
+	passwordlessRepo *inMemoryPasswordlessRepository
+	sessionRepo      *mockSessionRepository
+	authService      *mockAuthService
+	domainPolicy     *stubDomainPolicy
+	outbox           *mailer.MemoryOutbox
+	config           *passwordless.Config
+	service          passwordless.UserPasswordlessService
+	testUser         *entity.User
+	loginResult      *authentication.LoginResult
+	client           loginhistory.ClientInfo
+}
+
+func TestUserPasswordlessServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPasswordlessServiceTestSuite))
+}
+
+func (suite *UserPasswordlessServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserPasswordlessServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserPasswordlessServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.passwordlessRepo = &inMemoryPasswordlessRepository{}
+	suite.sessionRepo = new(mockSessionRepository)
+	suite.authService = new(mockAuthService)
+	suite.domainPolicy = &stubDomainPolicy{}
+	suite.outbox = mailer.NewMemoryOutbox()
+	suite.client = loginhistory.ClientInfo{IP: "192.0.2.1", UserAgent: "Mozilla/5.0"}
+	suite.config = &passwordless.Config{
+		LoginURL:      "https://app.example.com/passwordless",
+		TTL:           10 * time.Minute,
+		MaxAttempts:   3,
+		StartInterval: time.Minute,
+		AllowSignup:   true,
+		Locale:        mailer.LocaleJa,
+	}
+	suite.service = passwordless.NewUserPasswordlessService(suite.userRepo, suite.passwordlessRepo, suite.sessionRepo, suite.authService, suite.domainPolicy, suite.outbox, utils.DefaultTokenSigner(), suite.config)
+
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	testUser, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	suite.testUser = testUser
+	suite.loginResult = &authentication.LoginResult{AccessToken: "access", RefreshToken: "refresh"}
+}
+
+// 登録済みのユーザーにリンクとコードを送信し、リンクでログインできること
+func (suite *UserPasswordlessServiceTestSuite) TestVerifyLink_Success() {
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(suite.testUser, nil)
+	suite.userRepo.On("UpdateUser", suite.testUser).Return(suite.testUser, nil)
+	suite.sessionRepo.On("RevokeSessions", suite.testUser.ObjID().Value(), mock.Anything).Return(true, nil)
+	suite.authService.On("CompleteLogin", suite.testUser, utils.AMREmail, "", suite.client).Return(suite.loginResult, nil)
+
+	suite.Require().NoError(suite.service.Start("test@example.com"))
+	token, code := sentTo(suite.outbox, "test@example.com")
+	suite.NotEmpty(token, "リンクが送信されること")
+	suite.Len(code, 6, "6 桁のコードが送信されること")
+
+	result, err := suite.service.VerifyLink(token, "", suite.client)
+	suite.NoError(err)
+	suite.Equal(suite.loginResult, result, "UserLogin と同じ経路でトークンを発行すること")
+	suite.True(suite.testUser.IsEmailVerified(), "初回の利用でメールアドレスを確認済みにすること")
+	suite.False(suite.testUser.Password().IsSet(), "確認前に登録されたパスワードは使えなくすること")
+	suite.sessionRepo.AssertCalled(suite.T(), "RevokeSessions", suite.testUser.ObjID().Value(), mock.Anything)
+
+	// 同じリンク・コードは再利用できないこと
+	_, err = suite.service.VerifyLink(token, "", suite.client)
+	suite.ErrorIs(err, passwordless.ErrInvalidCode)
+	_, err = suite.service.VerifyCode("test@example.com", code, "", suite.client)
+	suite.ErrorIs(err, passwordless.ErrInvalidCode)
+	suite.authService.AssertNumberOfCalls(suite.T(), "CompleteLogin", 1)
+}
+
+// 停止したアカウントは、メールアドレスの確認を含めて変更せずにログインを拒否すること
+func (suite *UserPasswordlessServiceTestSuite) TestVerifyLink_SuspendedNotModified() {
+	suspendedAt, _ := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(suite.testUser.Suspend("規約違反", suspendedAt))
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(suite.testUser, nil)
+	suite.authService.On("CompleteLogin", suite.testUser, utils.AMREmail, "", suite.client).Return(nil, entity.ErrAccountSuspended)
+
+	suite.Require().NoError(suite.service.Start("test@example.com"))
+	token, _ := sentTo(suite.outbox, "test@example.com")
+
+	_, err := suite.service.VerifyLink(token, "", suite.client)
+	suite.ErrorIs(err, entity.ErrAccountSuspended)
+	suite.False(suite.testUser.IsEmailVerified())
+	suite.True(suite.testUser.Password().IsSet())
+	suite.userRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+	suite.sessionRepo.AssertNotCalled(suite.T(), "RevokeSessions", mock.Anything, mock.Anything)
+}
+
+// コードでログインできること（確認済みのユーザーは更新しない）
+func (suite *UserPasswordlessServiceTestSuite) TestVerifyCode_Success() {
+	verifiedAt, _ := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(suite.testUser.VerifyEmail(verifiedAt))
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(suite.testUser, nil)
+	suite.authService.On("CompleteLogin", suite.testUser, utils.AMREmail, "", suite.client).Return(suite.loginResult, nil)
+
+	suite.Require().NoError(suite.service.Start("test@example.com"))
+	_, code := sentTo(suite.outbox, "test@example.com")
+
+	result, err := suite.service.VerifyCode(" Test@Example.com ", code, "", suite.client)
+	suite.NoError(err)
+	suite.Equal(suite.loginResult, result)
+	suite.userRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+
+	_, err = suite.service.VerifyCode("test@example.com", code, "", suite.client)
+	suite.ErrorIs(err, passwordless.ErrInvalidCode, "コードは一度しか使えないこと")
+}
+
+// 誤ったコードの試行回数が上限に達すると、正しいコードでもログインできないこと
+func (suite *UserPasswordlessServiceTestSuite) TestVerifyCode_TooManyAttempts() {
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(suite.testUser, nil)
+	suite.Require().NoError(suite.service.Start("test@example.com"))
+	_, code := sentTo(suite.outbox, "test@example.com")
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	for i := 0; i < suite.config.MaxAttempts; i++ {
+		_, err := suite.service.VerifyCode("test@example.com", wrong, "", suite.client)
+		suite.ErrorIs(err, passwordless.ErrInvalidCode)
+	}
+	_, err := suite.service.VerifyCode("test@example.com", code, "", suite.client)
+	suite.ErrorIs(err, passwordless.ErrTooManyAttempts)
+	suite.authService.AssertNotCalled(suite.T(), "CompleteLogin", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// 期限切れのコード・リンクは使用できないこと
+func (suite *UserPasswordlessServiceTestSuite) TestVerify_Expired() {
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(suite.testUser, nil)
+	suite.Require().NoError(suite.service.Start("test@example.com"))
+	_, code := sentTo(suite.outbox, "test@example.com")
+	suite.passwordlessRepo.backdate(time.Hour)
+
+	_, err := suite.service.VerifyCode("test@example.com", code, "", suite.client)
+	suite.ErrorIs(err, passwordless.ErrInvalidCode)
+	suite.authService.AssertNotCalled(suite.T(), "CompleteLogin", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// 送信前のコードや改ざんされたリンクは拒否すること
+func (suite *UserPasswordlessServiceTestSuite) TestVerify_Invalid() {
+	_, err := suite.service.VerifyCode("test@example.com", "123456", "", suite.client)
+	suite.ErrorIs(err, passwordless.ErrInvalidCode)
+	_, err = suite.service.VerifyLink("invalid-token", "", suite.client)
+	suite.ErrorIs(err, passwordless.ErrInvalidCode)
+}
+
+// 未登録のメールアドレスの場合は、初回のログインでメールアドレス確認済み・パスワード未設定のユーザーを作成すること
+func (suite *UserPasswordlessServiceTestSuite) TestVerifyCode_Signup() {
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("not found"))
+	var created *entity.User
+	suite.userRepo.On("CreateUser", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(*entity.User)
+	}).Return(suite.testUser, nil)
+	suite.authService.On("CompleteLogin", suite.testUser, utils.AMREmail, "", suite.client).Return(suite.loginResult, nil)
+
+	suite.Require().NoError(suite.service.Start("new@example.com"))
+	_, code := sentTo(suite.outbox, "new@example.com")
+
+	result, err := suite.service.VerifyCode("new@example.com", code, "", suite.client)
+	suite.NoError(err)
+	suite.Equal(suite.loginResult, result)
+	suite.Require().NotNil(created)
+	suite.Equal("new@example.com", created.Email().Value())
+	suite.Equal("new", created.Username().Value())
+	suite.True(created.IsEmailVerified())
+	suite.False(created.Password().IsSet(), "パスワードは設定しないこと")
+}
+
+// 新規登録を許可しない場合、未登録のメールアドレスには送信しないがエラーも返さないこと
+func (suite *UserPasswordlessServiceTestSuite) TestStart_SignupDisabled() {
+	suite.config.AllowSignup = false
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("not found"))
+
+	suite.NoError(suite.service.Start("new@example.com"))
+	suite.Empty(suite.outbox.Messages())
+}
+
+// サービスアカウントはログインできないため、送信せずエラーも返さないこと
+func (suite *UserPasswordlessServiceTestSuite) TestStart_ServiceAccount() {
+	username, _ := value.NewUserUsername("ci-deployer")
+	account, err := entity.NewServiceAccountUser(username)
+	suite.Require().NoError(err)
+	suite.userRepo.On("GetUserByEmail", account.Email().Value()).Return(account, nil)
+
+	suite.NoError(suite.service.Start(account.Email().Value()))
+	suite.Empty(suite.outbox.Messages())
+}
+
+// 登録を許可しないドメインの場合も送信せず、エラーを返さないこと
+func (suite *UserPasswordlessServiceTestSuite) TestStart_DomainNotAllowed() {
+	suite.domainPolicy.err = registration.ErrEmailDomainNotAllowed
+	suite.userRepo.On("GetUserByEmail", "new@example.com").Return(nil, errs.NewInfraError("not found"))
+
+	suite.NoError(suite.service.Start("new@example.com"))
+	suite.Empty(suite.outbox.Messages())
+}
+
+// 同じメールアドレスへの送信は一定間隔でスロットリングし、新しいコードのみ有効にすること
+func (suite *UserPasswordlessServiceTestSuite) TestStart_Throttled() {
+	suite.userRepo.On("GetUserByEmail", "test@example.com").Return(suite.testUser, nil)
+
+	suite.Require().NoError(suite.service.Start("test@example.com"))
+	_, oldCode := sentTo(suite.outbox, "test@example.com")
+	suite.ErrorIs(suite.service.Start("TEST@example.com"), passwordless.ErrStartThrottled)
+
+	suite.passwordlessRepo.backdate(2 * time.Minute)
+	suite.NoError(suite.service.Start("test@example.com"))
+	suite.Len(suite.outbox.MessagesTo("test@example.com"), 2)
+
+	_, newCode := sentTo(suite.outbox, "test@example.com")
+	if oldCode != newCode {
+		_, err := suite.service.VerifyCode("test@example.com", oldCode, "", suite.client)
+		suite.ErrorIs(err, passwordless.ErrInvalidCode, "以前に送信したコードは使用できないこと")
+	}
+}
+
+// 無効な形式のメールアドレスは拒否すること
+func (suite *UserPasswordlessServiceTestSuite) TestStart_InvalidEmail() {
+	suite.ErrorIs(suite.service.Start("invalid"), passwordless.ErrInvalidEmail)
+}
@@ -0,0 +1,17 @@
+package passwordreset
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	TokenTTL time.Duration // パスワード再設定のトークンの有効期限
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		TokenTTL: utils.GetEnvDuration("PASSWORD_RESET_TTL", time.Hour),
+	}
+}
@@ -0,0 +1,92 @@
+package passwordreset
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidResetToken = errs.NewServiceError("invalid or expired password reset token")
+	ErrInvalidPassword   = errs.NewServiceError("password must be 8 to 64 characters and contain both letters and digits")
+)
+
+type UserPasswordResetService interface {
+	// IssueResetToken: パスワードを再設定するためのトークンを発行する（パスワードを変更すると使えなくなる）
+	IssueResetToken(user *entity.User) (string, error)
+	// ResetPassword: 再設定のトークンでパスワードを変更し、発行済みのトークンをすべて無効にする
+	ResetPassword(token string, newPassword string) error
+}
+
+// userPasswordResetService は UserPasswordResetService の実装
+type userPasswordResetService struct {
+	userRepository    repository.UserRepository
+	sessionRepository repository.SessionRepository
+	tokens            *utils.TokenSigner
+	config            *Config
+}
+
+// NewUserPasswordResetService は UserPasswordResetService のインスタンスを作成
+func NewUserPasswordResetService(userRepository repository.UserRepository, sessionRepository repository.SessionRepository, tokens *utils.TokenSigner, config *Config) UserPasswordResetService {
+	return &userPasswordResetService{
+		userRepository:    userRepository,
+		sessionRepository: sessionRepository,
+		tokens:            tokens,
+		config:            config,
+	}
+}
+
+// IssueResetToken は現在のパスワードに紐づいた再設定のトークンを発行する
+func (s *userPasswordResetService) IssueResetToken(user *entity.User) (string, error) {
+	token, err := s.tokens.GenerateActionTokenWithRef(utils.PurposePasswordReset, user.ObjID().Value(), user.Email().Value(), passwordStamp(user), s.config.TokenTTL)
+	if err != nil {
+		return "", errs.NewServiceError("failed to generate password reset token")
+	}
+	return token, nil
+}
+
+// ResetPassword はトークンを検証してパスワードを変更する
+// トークンは発行したときのパスワードにのみ有効なため、一度パスワードを変更すると再び使うことはできない
+// 変更前に発行したトークン（不正にログインした第三者のものを含む）はすべて無効にする
+func (s *userPasswordResetService) ResetPassword(token string, newPassword string) error {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposePasswordReset, token)
+	if err != nil {
+		return ErrInvalidResetToken
+	}
+	user, err := s.userRepository.GetUserByObjID(claims.ObjID)
+	if err != nil {
+		return ErrInvalidResetToken
+	}
+	if subtle.ConstantTimeCompare([]byte(passwordStamp(user)), []byte(claims.Ref)) != 1 {
+		return ErrInvalidResetToken
+	}
+
+	passwordValue, err := value.NewUserPassword(newPassword)
+	if err != nil {
+		return ErrInvalidPassword
+	}
+	// 無効にできなかった場合にトークンで再試行できるよう、パスワードの変更より先に無効にする
+	if _, err := s.sessionRepository.RevokeSessions(user.ObjID().Value(), time.Now()); err != nil {
+		return errs.NewServiceError("failed to revoke sessions")
+	}
+	if err := user.ChangePassword(passwordValue); err != nil {
+		return ErrInvalidPassword
+	}
+	if _, err := s.userRepository.UpdateUser(user); err != nil {
+		return errs.NewServiceError("failed to update password")
+	}
+	return nil
+}
+
+// passwordStamp は現在のパスワードのハッシュから導出した値を返す（トークンにハッシュそのものは含めない）
+func passwordStamp(user *entity.User) string {
+	sum := sha256.Sum256([]byte(user.Password().Value()))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,210 @@
+package passwordreset_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/passwordreset"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+type mockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *mockSessionRepository) RevokeSessions(userObjID string, revokedAt time.Time) (bool, error) {
+	args := m.Called(userObjID, revokedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockSessionRepository) GetSessionsRevokedAt(userObjID string) (*time.Time, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+// --- テストスイート ---
+
+type UserPasswordResetServiceTestSuite struct {
+	suite.Suite
+	mockRepo    *mockUserRepository
+	mockSession *mockSessionRepository
+	service     passwordreset.UserPasswordResetService
+	testUser    *entity.User
+}
+
+func TestUserPasswordResetServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPasswordResetServiceTestSuite))
+}
+
+func (suite *UserPasswordResetServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserPasswordResetServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserPasswordResetServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mockUserRepository)
+	suite.mockSession = new(mockSessionRepository)
+	suite.service = passwordreset.NewUserPasswordResetService(suite.mockRepo, suite.mockSession, utils.DefaultTokenSigner(), &passwordreset.Config{TokenTTL: time.Hour})
+
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	password, _ := value.NewUserPassword("oldpassword123")
+	testUser, err := entity.NewUser(email, password, username)
+	suite.Require().NoError(err)
+	suite.testUser = testUser
+}
+
+// パスワードを変更し、発行済みのトークンを無効にすること
+func (suite *UserPasswordResetServiceTestSuite) TestResetPassword_Success() {
+	objID := suite.testUser.ObjID().Value()
+	token, err := suite.service.IssueResetToken(suite.testUser)
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.mockSession.On("RevokeSessions", objID, mock.Anything).Return(true, nil).Once()
+	suite.mockRepo.On("UpdateUser", suite.testUser).Return(suite.testUser, nil).Once()
+
+	err = suite.service.ResetPassword(token, "newpassword456")
+	suite.NoError(err)
+	suite.True(suite.testUser.Password().Verify("newpassword456"))
+	suite.mockSession.AssertExpectations(suite.T())
+	suite.mockRepo.AssertExpectations(suite.T())
+
+	// パスワードを変更した後は同じトークンを使えないこと
+	err = suite.service.ResetPassword(token, "anotherpassword789")
+	suite.ErrorIs(err, passwordreset.ErrInvalidResetToken)
+	suite.True(suite.testUser.Password().Verify("newpassword456"))
+}
+
+// パスワードを設定していないユーザー（パスワードレスログインで登録したユーザー）も再設定できること
+func (suite *UserPasswordResetServiceTestSuite) TestResetPassword_NoPassword() {
+	email, _ := value.NewUserEmail("passwordless@example.com")
+	username, _ := value.NewUserUsername("passwordless")
+	user, err := entity.NewUser(email, value.NoPassword(), username)
+	suite.Require().NoError(err)
+	token, err := suite.service.IssueResetToken(user)
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByObjID", user.ObjID().Value()).Return(user, nil)
+	suite.mockSession.On("RevokeSessions", user.ObjID().Value(), mock.Anything).Return(true, nil)
+	suite.mockRepo.On("UpdateUser", user).Return(user, nil)
+
+	suite.NoError(suite.service.ResetPassword(token, "newpassword456"))
+	suite.True(user.Password().Verify("newpassword456"))
+}
+
+func (suite *UserPasswordResetServiceTestSuite) TestResetPassword_InvalidToken() {
+	err := suite.service.ResetPassword("invalid.token", "newpassword456")
+	suite.ErrorIs(err, passwordreset.ErrInvalidResetToken)
+
+	// 別の用途のトークンは使えないこと
+	token, err := utils.GenerateActionToken(utils.PurposeEmailVerification, suite.testUser.ObjID().Value(), "test@example.com", time.Hour)
+	suite.Require().NoError(err)
+	err = suite.service.ResetPassword(token, "newpassword456")
+	suite.ErrorIs(err, passwordreset.ErrInvalidResetToken)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+}
+
+func (suite *UserPasswordResetServiceTestSuite) TestResetPassword_UserNotFound() {
+	token, err := suite.service.IssueResetToken(suite.testUser)
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(nil, errs.NewInfraError("not found"))
+
+	err = suite.service.ResetPassword(token, "newpassword456")
+	suite.ErrorIs(err, passwordreset.ErrInvalidResetToken)
+}
+
+func (suite *UserPasswordResetServiceTestSuite) TestResetPassword_InvalidPassword() {
+	token, err := suite.service.IssueResetToken(suite.testUser)
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+
+	err = suite.service.ResetPassword(token, "short")
+	suite.ErrorIs(err, passwordreset.ErrInvalidPassword)
+	suite.True(suite.testUser.Password().Verify("oldpassword123"))
+	suite.mockSession.AssertNotCalled(suite.T(), "RevokeSessions", mock.Anything, mock.Anything)
+}
+
+// トークンを無効にできない場合はパスワードを変更せず、同じトークンで再試行できること
+func (suite *UserPasswordResetServiceTestSuite) TestResetPassword_RevokeError() {
+	objID := suite.testUser.ObjID().Value()
+	token, err := suite.service.IssueResetToken(suite.testUser)
+	suite.Require().NoError(err)
+	suite.mockRepo.On("GetUserByObjID", objID).Return(suite.testUser, nil)
+	suite.mockSession.On("RevokeSessions", objID, mock.Anything).Return(false, errors.New("db error")).Once()
+
+	err = suite.service.ResetPassword(token, "newpassword456")
+	suite.Error(err)
+	suite.NotErrorIs(err, passwordreset.ErrInvalidResetToken)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+
+	suite.mockSession.On("RevokeSessions", objID, mock.Anything).Return(true, nil).Once()
+	suite.mockRepo.On("UpdateUser", mock.Anything).Return(suite.testUser, nil)
+	suite.NoError(suite.service.ResetPassword(token, "newpassword456"))
+}
@@ -2,20 +2,51 @@ package profile
 
 import (
 	"fmt"
+	"time"
+	"unicode/utf8"
 
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/domain/user/repository"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
 	"github.com/goda6565/nexus-user-auth/errs"
 )
 
+var (
+	ErrInvalidDeactivationReason = errs.NewServiceError(fmt.Sprintf("reason must be at most %d characters", entity.UserStatusReasonMaxLength))
+	ErrInvalidUsername           = errs.NewServiceError("username must be between 3 and 50 characters and cannot be removed")
+	ErrInvalidAvatarURL          = errs.NewServiceError("avatarURL must be a valid URL or null")
+)
+
+// 部分更新（UserPatch）で変更できるフィールドの名前（API のフィールド名と同じ）
+const (
+	FieldUsername  = "username"
+	FieldAvatarURL = "avatarURL"
+)
+
+// PatchValue は、部分更新で指定されたフィールドの値（Null の場合は値を消す）
+type PatchValue struct {
+	Value string
+	Null  bool
+}
+
+// ProfilePatch は、JSON Merge Patch（RFC 7396）によるプロフィールの変更。nil のフィールドは変更しない
+type ProfilePatch struct {
+	Username  *PatchValue
+	AvatarURL *PatchValue
+}
+
 type UserProfileService interface {
 	// UserGet: ユーザー情報取得(認可はミドルウェアで行う)
 	UserGet(objID string) (*entity.User, error)
 	// UserUpdate: ユーザー情報更新(認可はミドルウェアで行う)
 	UserUpdate(objID string, username string, avatarURL string) (*entity.User, error)
+	// UserPatch: ユーザー情報の部分更新。更新後のユーザーと値が変わったフィールドの名前を返す(認可はミドルウェアで行う)
+	UserPatch(objID string, patch ProfilePatch) (*entity.User, []string, error)
 	// UserDelete: ユーザー削除(認可はミドルウェアで行う)
 	UserDelete(objID string) error
+	// UserDeactivate: ユーザー自身によるアカウントの無効化(認可はミドルウェアで行う)
+	UserDeactivate(objID string, reason string) error
 }
 
 type userProfileService struct {
@@ -45,7 +76,6 @@ func (s *userProfileService) UserUpdate(objID string, username string, avatarURL
 	}
 
 	// ユーザー名の更新
-	fmt.Println("username: ", username) 
 	newUsername, err := value.NewUserUsername(username)
 	if err != nil {
 		return nil, errs.NewServiceError("failed to create user username")
@@ -70,6 +100,85 @@ func (s *userProfileService) UserUpdate(objID string, username string, avatarURL
 	return updatedUser, nil
 }
 
+// UserPatch: 指定されたフィールドのみ値オブジェクトで検証して変更する
+// 値が変わらない場合は保存せず、変わったフィールドの名前を空で返す
+func (s *userProfileService) UserPatch(objID string, patch ProfilePatch) (*entity.User, []string, error) {
+	// ユーザーを取得する前にすべてのフィールドを検証する
+	var newUsername *value.UserUsername
+	if patch.Username != nil {
+		if patch.Username.Null {
+			return nil, nil, ErrInvalidUsername
+		}
+		username, err := value.NewUserUsername(patch.Username.Value)
+		if err != nil {
+			return nil, nil, ErrInvalidUsername
+		}
+		newUsername = username
+	}
+	var newAvatarURL *value.UserAvatarURL
+	if patch.AvatarURL != nil && !patch.AvatarURL.Null {
+		avatarURL, err := value.NewUserAvatarURL(patch.AvatarURL.Value)
+		if err != nil {
+			return nil, nil, ErrInvalidAvatarURL
+		}
+		newAvatarURL = avatarURL
+	}
+
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return nil, nil, errs.NewServiceError("failed to get user")
+	}
+
+	changed := []string{}
+	if newUsername != nil && newUsername.Value() != user.Username().Value() {
+		user.ChangeUsername(newUsername)
+		changed = append(changed, FieldUsername)
+	}
+	if patch.AvatarURL != nil && avatarURLValue(newAvatarURL) != avatarURLValue(user.AvatarURL()) {
+		user.ChangeAvatarURL(newAvatarURL)
+		changed = append(changed, FieldAvatarURL)
+	}
+	if len(changed) == 0 {
+		return user, changed, nil
+	}
+
+	updatedUser, err := s.userRepository.UpdateUser(user)
+	if err != nil {
+		return nil, nil, errs.NewServiceError("failed to update user in repository")
+	}
+	return updatedUser, changed, nil
+}
+
+// avatarURLValue はアバターURLの値を返す（未設定の場合は空文字）
+func avatarURLValue(avatarURL *value.UserAvatarURL) string {
+	if avatarURL == nil {
+		return ""
+	}
+	return avatarURL.Value()
+}
+
+// UserDeactivate: アカウントを無効にする（無効にしたアカウントはログイン・API の利用ができなくなる）
+func (s *userProfileService) UserDeactivate(objID string, reason string) error {
+	if utf8.RuneCountInString(reason) > entity.UserStatusReasonMaxLength {
+		return ErrInvalidDeactivationReason
+	}
+	user, err := s.userRepository.GetUserByObjID(objID)
+	if err != nil {
+		return errs.NewServiceError("failed to get user")
+	}
+	deactivatedAt, err := timeobj.NewTimeObj(time.Now())
+	if err != nil {
+		return errs.NewServiceError("failed to create deactivated at")
+	}
+	if err := user.Deactivate(reason, deactivatedAt); err != nil {
+		return errs.NewServiceError("failed to deactivate user: " + err.Error())
+	}
+	if _, err := s.userRepository.UpdateUser(user); err != nil {
+		return errs.NewServiceError("failed to update user in repository")
+	}
+	return nil
+}
+
 func (s *userProfileService) UserDelete(objID string) error {
 	err := s.userRepository.DeleteUser(objID)
 	if err != nil {
@@ -1,14 +1,18 @@
 package profile_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/goda6565/nexus-user-auth/application/service/user/profile"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
 	"github.com/goda6565/nexus-user-auth/errs"
 )
@@ -54,6 +58,14 @@ func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error)
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
 func (m *mockUserRepository) DeleteUser(objID string) error {
 	args := m.Called(objID)
 	return args.Error(0)
@@ -203,3 +215,156 @@ func (suite *UserProfileServiceTestSuite) TestUserUpdate_InvalidUsername() {
 
 	suite.mockRepo.AssertExpectations(suite.T())
 }
+
+// newActiveUser は有効な状態のユーザーを生成する
+func (suite *UserProfileServiceTestSuite) newActiveUser() *entity.User {
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	activatedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(user.Activate(activatedAt))
+	return user
+}
+
+// TestUserDeactivate_Success は、アカウントを無効にして理由とともに保存するケース
+func (suite *UserProfileServiceTestSuite) TestUserDeactivate_Success() {
+	user := suite.newActiveUser()
+	objID := user.ObjID().Value()
+	suite.mockRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything).Return(user, nil)
+
+	err := suite.service.UserDeactivate(objID, " 使わなくなったため ")
+
+	assert.NoError(suite.T(), err)
+	updated := suite.mockRepo.Calls[1].Arguments.Get(0).(*entity.User)
+	assert.Equal(suite.T(), value.StatusDeactivated, updated.Status().Value())
+	assert.Equal(suite.T(), "使わなくなったため", updated.StatusReason())
+	assert.NotNil(suite.T(), updated.StatusChangedAt())
+	assert.ErrorIs(suite.T(), updated.CheckActive(), entity.ErrAccountDeactivated)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+// TestUserDeactivate_ReasonTooLong は、理由が長すぎる場合にユーザーを取得せずにエラーを返すケース
+func (suite *UserProfileServiceTestSuite) TestUserDeactivate_ReasonTooLong() {
+	reason := strings.Repeat("a", entity.UserStatusReasonMaxLength+1)
+
+	err := suite.service.UserDeactivate("user-123", reason)
+
+	assert.ErrorIs(suite.T(), err, profile.ErrInvalidDeactivationReason)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByObjID", mock.Anything)
+}
+
+// TestUserDeactivate_AlreadyDeactivated は、無効化済みのアカウントは保存しないケース
+func (suite *UserProfileServiceTestSuite) TestUserDeactivate_AlreadyDeactivated() {
+	user := suite.newActiveUser()
+	deactivatedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(user.Deactivate("", deactivatedAt))
+	objID := user.ObjID().Value()
+	suite.mockRepo.On("GetUserByObjID", objID).Return(user, nil)
+
+	err = suite.service.UserDeactivate(objID, "")
+
+	assert.Error(suite.T(), err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+}
+
+// newUserWithAvatar はアバターを設定した有効なユーザーを生成する
+func (suite *UserProfileServiceTestSuite) newUserWithAvatar() *entity.User {
+	user := suite.newActiveUser()
+	avatarURL, err := value.NewUserAvatarURL("https://example.com/avatar.png")
+	suite.Require().NoError(err)
+	user.ChangeAvatarURL(avatarURL)
+	return user
+}
+
+// TestUserPatch_OnlyGivenFields は、指定したフィールドのみ変更し、変わったフィールドを返すケース
+func (suite *UserProfileServiceTestSuite) TestUserPatch_OnlyGivenFields() {
+	user := suite.newUserWithAvatar()
+	objID := user.ObjID().Value()
+	suite.mockRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything).Return(user, nil)
+
+	result, changed, err := suite.service.UserPatch(objID, profile.ProfilePatch{
+		Username: &profile.PatchValue{Value: "renamed"},
+	})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), []string{profile.FieldUsername}, changed)
+	assert.Equal(suite.T(), "renamed", result.Username().Value())
+	assert.Equal(suite.T(), "https://example.com/avatar.png", result.AvatarURL().Value(), "指定しないフィールドは変更しないこと")
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+// TestUserPatch_ClearAvatarURL は、null を指定したアバターURLを消すケース
+func (suite *UserProfileServiceTestSuite) TestUserPatch_ClearAvatarURL() {
+	user := suite.newUserWithAvatar()
+	objID := user.ObjID().Value()
+	suite.mockRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything).Return(user, nil)
+
+	result, changed, err := suite.service.UserPatch(objID, profile.ProfilePatch{
+		Username:  &profile.PatchValue{Value: "testuser"},
+		AvatarURL: &profile.PatchValue{Null: true},
+	})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), []string{profile.FieldAvatarURL}, changed, "値が変わらないユーザー名は含めないこと")
+	assert.Nil(suite.T(), result.AvatarURL())
+	assert.Equal(suite.T(), "testuser", result.Username().Value())
+}
+
+// TestUserPatch_NoChange は、値が変わらない場合は保存しないケース
+func (suite *UserProfileServiceTestSuite) TestUserPatch_NoChange() {
+	user := suite.newActiveUser()
+	objID := user.ObjID().Value()
+	suite.mockRepo.On("GetUserByObjID", objID).Return(user, nil)
+
+	result, changed, err := suite.service.UserPatch(objID, profile.ProfilePatch{
+		AvatarURL: &profile.PatchValue{Null: true},
+	})
+
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), changed)
+	assert.NotNil(suite.T(), changed, "変更がない場合も空のリストを返すこと")
+	assert.Equal(suite.T(), user, result)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+}
+
+// TestUserPatch_Invalid は、値オブジェクトで検証できない値はユーザーを取得せずにエラーを返すケース
+func (suite *UserProfileServiceTestSuite) TestUserPatch_Invalid() {
+	cases := []struct {
+		name  string
+		patch profile.ProfilePatch
+		err   error
+	}{
+		{"短すぎるユーザー名", profile.ProfilePatch{Username: &profile.PatchValue{Value: "ab"}}, profile.ErrInvalidUsername},
+		{"ユーザー名の削除", profile.ProfilePatch{Username: &profile.PatchValue{Null: true}}, profile.ErrInvalidUsername},
+		{"URL でないアバター", profile.ProfilePatch{AvatarURL: &profile.PatchValue{Value: "not a url"}}, profile.ErrInvalidAvatarURL},
+		{"空文字のアバター", profile.ProfilePatch{AvatarURL: &profile.PatchValue{Value: ""}}, profile.ErrInvalidAvatarURL},
+	}
+	for _, tc := range cases {
+		result, changed, err := suite.service.UserPatch("user-123", tc.patch)
+
+		assert.ErrorIs(suite.T(), err, tc.err, tc.name)
+		assert.Nil(suite.T(), result, tc.name)
+		assert.Nil(suite.T(), changed, tc.name)
+	}
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByObjID", mock.Anything)
+}
+
+// TestUserPatch_UpdateUser_Error は、保存に失敗した場合にエラーを返すケース
+func (suite *UserProfileServiceTestSuite) TestUserPatch_UpdateUser_Error() {
+	user := suite.newActiveUser()
+	objID := user.ObjID().Value()
+	suite.mockRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything).Return(nil, errs.NewInfraError("更新に失敗しました"))
+
+	_, _, err := suite.service.UserPatch(objID, profile.ProfilePatch{
+		AvatarURL: &profile.PatchValue{Value: "https://example.com/new.png"},
+	})
+
+	assert.Error(suite.T(), err)
+}
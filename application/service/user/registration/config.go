@@ -0,0 +1,32 @@
+package registration
+
+import (
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		Allowlist:       splitList(utils.GetEnvDefault("REGISTRATION_DOMAIN_ALLOWLIST", "")),
+		AllowlistOnly:   utils.GetEnvBool("REGISTRATION_DOMAIN_ALLOWLIST_ONLY", false),
+		Denylist:        splitList(utils.GetEnvDefault("REGISTRATION_DOMAIN_DENYLIST", "")),
+		BlockDisposable: utils.GetEnvBool("REGISTRATION_BLOCK_DISPOSABLE", true),
+		DisposableFile:  utils.GetEnvDefault("REGISTRATION_DISPOSABLE_DOMAINS_FILE", ""),
+		ReloadInterval:  utils.GetEnvDuration("REGISTRATION_DISPOSABLE_RELOAD_INTERVAL", time.Minute),
+		RequireMX:       utils.GetEnvBool("REGISTRATION_REQUIRE_MX", false),
+		DNSTimeout:      utils.GetEnvDuration("REGISTRATION_DNS_TIMEOUT", 3*time.Second),
+	}
+}
+
+// splitList はカンマ区切りの文字列を分割する
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
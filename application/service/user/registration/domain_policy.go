@@ -0,0 +1,227 @@
+package registration
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+var ErrEmailDomainNotAllowed = errs.NewServiceError("email domain is not allowed")
+
+//go:embed disposable_domains.txt
+var bundledDisposableDomains string
+
+// Resolver は MX/DNS の確認に使う名前解決（*net.Resolver が満たす。テストではスタブに差し替える）
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// DomainPolicy は登録を受け付けるメールアドレスのドメインを判定する
+type DomainPolicy interface {
+	// Check: 登録を許可しないドメインの場合は ErrEmailDomainNotAllowed を返す
+	Check(email *value.UserEmail) error
+	// Reload: 使い捨てドメイン一覧を読み込み直す（ファイルの更新は一定間隔で自動的にも反映される）
+	Reload() error
+}
+
+type Config struct {
+	Allowlist       []string      // 常に許可するドメイン（他の判定より優先）
+	AllowlistOnly   bool          // true の場合、Allowlist 以外のドメインを拒否する
+	Denylist        []string      // 拒否するドメイン
+	BlockDisposable bool          // 使い捨てメールのドメインを拒否する
+	DisposableFile  string        // 使い捨てドメイン一覧のファイル（空の場合は同梱の一覧を使う）
+	ReloadInterval  time.Duration // DisposableFile の更新を確認する間隔
+	RequireMX       bool          // MX（または A/AAAA）レコードが解決できないドメインを拒否する
+	DNSTimeout      time.Duration
+
+	RequireVerifiedEmail bool // メールアドレスを確認するまでアカウントを有効にしない（EMAIL_VERIFICATION_POLICY=login）
+}
+
+// domainPolicy は DomainPolicy の実装
+type domainPolicy struct {
+	config   *Config
+	resolver Resolver
+	now      func() time.Time
+
+	mu               sync.RWMutex
+	disposable       map[string]struct{}
+	disposableMod    time.Time // 読み込んだファイルの更新日時
+	disposableSynced time.Time // 最後にファイルの更新を確認した日時
+}
+
+// NewDomainPolicy は DomainPolicy のインスタンスを作成
+func NewDomainPolicy(config *Config, resolver Resolver) (DomainPolicy, error) {
+	p := &domainPolicy{
+		config:   config,
+		resolver: resolver,
+		now:      time.Now,
+	}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Reload は使い捨てドメイン一覧を読み込み直す
+func (p *domainPolicy) Reload() error {
+	if p.config.DisposableFile == "" {
+		domains, _ := parseDomainList(strings.NewReader(bundledDisposableDomains))
+		p.mu.Lock()
+		p.disposable = domains
+		p.mu.Unlock()
+		return nil
+	}
+
+	info, err := os.Stat(p.config.DisposableFile)
+	if err != nil {
+		return errs.NewServiceError("failed to stat disposable domain list")
+	}
+	f, err := os.Open(p.config.DisposableFile)
+	if err != nil {
+		return errs.NewServiceError("failed to open disposable domain list")
+	}
+	defer f.Close()
+	domains, err := parseDomainList(f)
+	if err != nil {
+		return errs.NewServiceError("failed to read disposable domain list")
+	}
+
+	p.mu.Lock()
+	p.disposable = domains
+	p.disposableMod = info.ModTime()
+	p.disposableSynced = p.now()
+	p.mu.Unlock()
+	return nil
+}
+
+// reloadIfModified は一定間隔でファイルの更新を確認し、更新されていれば読み込み直す
+func (p *domainPolicy) reloadIfModified() {
+	if p.config.DisposableFile == "" {
+		return
+	}
+	p.mu.RLock()
+	due := p.now().Sub(p.disposableSynced) >= p.config.ReloadInterval
+	lastMod := p.disposableMod
+	p.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	info, err := os.Stat(p.config.DisposableFile)
+	if err == nil && !info.ModTime().Equal(lastMod) {
+		err = p.Reload()
+	}
+	if err != nil {
+		// 読み込みに失敗した場合は直前の一覧を使い続ける
+		logger.Warn("failed to reload disposable domain list", "file", p.config.DisposableFile, "error", err.Error())
+	}
+	p.mu.Lock()
+	p.disposableSynced = p.now()
+	p.mu.Unlock()
+}
+
+func (p *domainPolicy) Check(email *value.UserEmail) error {
+	_, domain, _ := strings.Cut(email.Value(), "@")
+
+	if matchDomain(domain, p.config.Allowlist) {
+		return nil
+	}
+	if p.config.AllowlistOnly {
+		return ErrEmailDomainNotAllowed
+	}
+	if matchDomain(domain, p.config.Denylist) {
+		return ErrEmailDomainNotAllowed
+	}
+	if p.config.BlockDisposable {
+		p.reloadIfModified()
+		p.mu.RLock()
+		disposable := matchDomainSet(domain, p.disposable)
+		p.mu.RUnlock()
+		if disposable {
+			return ErrEmailDomainNotAllowed
+		}
+	}
+	if p.config.RequireMX && !p.resolvable(domain) {
+		return ErrEmailDomainNotAllowed
+	}
+	return nil
+}
+
+// resolvable はドメインがメールを受信できるか（MX、なければ A/AAAA）を確認する
+// DNS の一時的な障害で登録できなくならないよう、存在しないと確定した場合のみ false を返す
+func (p *domainPolicy) resolvable(domain string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.DNSTimeout)
+	defer cancel()
+
+	mx, err := p.resolver.LookupMX(ctx, domain)
+	if err == nil && len(mx) > 0 {
+		// "." の MX は受信しないことの明示（RFC 7505）
+		return !(len(mx) == 1 && mx[0].Host == ".")
+	}
+	if err != nil && !isNotFound(err) {
+		logger.Warn("mx lookup failed", "domain", domain, "error", err.Error())
+		return true
+	}
+
+	hosts, err := p.resolver.LookupHost(ctx, domain)
+	if err != nil && !isNotFound(err) {
+		logger.Warn("host lookup failed", "domain", domain, "error", err.Error())
+		return true
+	}
+	return len(hosts) > 0
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// matchDomain はドメインがリストのいずれか（またはそのサブドメイン）に該当するかを判定する
+func matchDomain(domain string, list []string) bool {
+	for _, d := range list {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if d != "" && (domain == d || strings.HasSuffix(domain, "."+d)) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchDomainSet(domain string, set map[string]struct{}) bool {
+	for {
+		if _, ok := set[domain]; ok {
+			return true
+		}
+		_, parent, found := strings.Cut(domain, ".")
+		if !found {
+			return false
+		}
+		domain = parent
+	}
+}
+
+// parseDomainList は1行1ドメインの一覧を読み込む（空行と # 以降は無視する）
+func parseDomainList(r io.Reader) (map[string]struct{}, error) {
+	domains := make(map[string]struct{})
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.ToLower(strings.TrimSpace(line))
+		if line != "" {
+			domains[line] = struct{}{}
+		}
+	}
+	return domains, scanner.Err()
+}
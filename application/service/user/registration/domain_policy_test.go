@@ -0,0 +1,139 @@
+package registration_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+// stubResolver はドメインごとに MX/ホストの解決結果を返すスタブ
+type stubResolver struct {
+	mx    map[string][]*net.MX
+	hosts map[string][]string
+	err   error // 設定されている場合は一時的な障害として返す
+}
+
+func (r *stubResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if mx, ok := r.mx[name]; ok {
+		return mx, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *stubResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if hosts, ok := r.hosts[host]; ok {
+		return hosts, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func mustEmail(t *testing.T, s string) *value.UserEmail {
+	email, err := value.NewUserEmail(s)
+	require.NoError(t, err)
+	return email
+}
+
+func TestDomainPolicy_Disposable(t *testing.T) {
+	policy, err := registration.NewDomainPolicy(&registration.Config{BlockDisposable: true}, nil)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@mailinator.com")), registration.ErrEmailDomainNotAllowed)
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@sub.yopmail.com")), registration.ErrEmailDomainNotAllowed, "サブドメインも対象")
+	assert.NoError(t, policy.Check(mustEmail(t, "user@example.com")))
+
+	// 無効の場合は許可する
+	policy, err = registration.NewDomainPolicy(&registration.Config{}, nil)
+	require.NoError(t, err)
+	assert.NoError(t, policy.Check(mustEmail(t, "user@mailinator.com")))
+}
+
+func TestDomainPolicy_AllowAndDenylist(t *testing.T) {
+	policy, err := registration.NewDomainPolicy(&registration.Config{
+		Allowlist:       []string{"mailinator.com"},
+		Denylist:        []string{"Blocked.example"},
+		BlockDisposable: true,
+	}, nil)
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.Check(mustEmail(t, "user@mailinator.com")), "許可リストは使い捨て判定より優先")
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@blocked.example")), registration.ErrEmailDomainNotAllowed)
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@mail.blocked.example")), registration.ErrEmailDomainNotAllowed)
+	assert.NoError(t, policy.Check(mustEmail(t, "user@notblocked.example")), "接尾辞が一致するだけの別ドメインは対象外")
+}
+
+func TestDomainPolicy_AllowlistOnly(t *testing.T) {
+	policy, err := registration.NewDomainPolicy(&registration.Config{
+		Allowlist:     []string{"corp.example"},
+		AllowlistOnly: true,
+	}, nil)
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.Check(mustEmail(t, "user@corp.example")))
+	assert.NoError(t, policy.Check(mustEmail(t, "user@tokyo.corp.example")))
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@example.com")), registration.ErrEmailDomainNotAllowed)
+}
+
+func TestDomainPolicy_ReloadFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "disposable.txt")
+	require.NoError(t, os.WriteFile(file, []byte("# comment\nthrowaway.example\n"), 0o644))
+
+	policy, err := registration.NewDomainPolicy(&registration.Config{
+		BlockDisposable: true,
+		DisposableFile:  file,
+		ReloadInterval:  0,
+	}, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@throwaway.example")), registration.ErrEmailDomainNotAllowed)
+	assert.NoError(t, policy.Check(mustEmail(t, "user@mailinator.com")), "ファイル指定時は同梱の一覧を使わない")
+
+	// ファイルを更新すると再起動なしで反映されること
+	require.NoError(t, os.WriteFile(file, []byte("another.example\n"), 0o644))
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(file, future, future))
+
+	assert.NoError(t, policy.Check(mustEmail(t, "user@throwaway.example")))
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@another.example")), registration.ErrEmailDomainNotAllowed)
+}
+
+func TestDomainPolicy_MissingFile(t *testing.T) {
+	_, err := registration.NewDomainPolicy(&registration.Config{DisposableFile: filepath.Join(t.TempDir(), "missing.txt")}, nil)
+	assert.Error(t, err)
+}
+
+func TestDomainPolicy_RequireMX(t *testing.T) {
+	resolver := &stubResolver{
+		mx:    map[string][]*net.MX{"mx.example": {{Host: "mail.mx.example.", Pref: 10}}, "nullmx.example": {{Host: ".", Pref: 0}}},
+		hosts: map[string][]string{"a-only.example": {"192.0.2.1"}},
+	}
+	policy, err := registration.NewDomainPolicy(&registration.Config{RequireMX: true, DNSTimeout: time.Second}, resolver)
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.Check(mustEmail(t, "user@mx.example")))
+	assert.NoError(t, policy.Check(mustEmail(t, "user@a-only.example")), "MX がなくても A レコードがあれば許可")
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@nullmx.example")), registration.ErrEmailDomainNotAllowed, "Null MX は受信しない")
+	assert.ErrorIs(t, policy.Check(mustEmail(t, "user@nowhere.example")), registration.ErrEmailDomainNotAllowed)
+}
+
+func TestDomainPolicy_RequireMX_TemporaryFailure(t *testing.T) {
+	resolver := &stubResolver{err: errors.New("i/o timeout")}
+	policy, err := registration.NewDomainPolicy(&registration.Config{RequireMX: true, DNSTimeout: time.Second}, resolver)
+	require.NoError(t, err)
+
+	assert.NoError(t, policy.Check(mustEmail(t, "user@example.com")), "DNS の一時的な障害では拒否しない")
+}
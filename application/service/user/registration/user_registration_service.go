@@ -1,6 +1,9 @@
 package registration
 
 import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/domain/user/repository"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
@@ -15,12 +18,16 @@ type UserRegistrationService interface {
 // UserRegistrationServiceの実装
 type userRegistrationService struct {
 	userRepository repository.UserRepository
+	domainPolicy   DomainPolicy
+	config         *Config
 }
 
 // NewUserRegistrationService: UserRegistrationServiceを生成
-func NewUserRegistrationService(userRepository repository.UserRepository) UserRegistrationService {
+func NewUserRegistrationService(userRepository repository.UserRepository, domainPolicy DomainPolicy, config *Config) UserRegistrationService {
 	return &userRegistrationService{
 		userRepository: userRepository,
+		domainPolicy:   domainPolicy,
+		config:         config,
 	}
 }
 
@@ -31,6 +38,10 @@ func (s *userRegistrationService) UserRegister(email string, password string, us
 	if err != nil {
 		return nil, errs.NewServiceError("failed to create user email")
 	}
+	// 使い捨て・拒否対象のドメインは登録を受け付けない
+	if err := s.domainPolicy.Check(emailValue); err != nil {
+		return nil, err
+	}
 	passwordValue, err := value.NewUserPassword(password)
 	if err != nil {
 		return nil, errs.NewServiceError("failed to create user password")
@@ -44,6 +55,16 @@ func (s *userRegistrationService) UserRegister(email string, password string, us
 	if err != nil {
 		return nil, err
 	}
+	// メールアドレスの確認を求めない場合は、登録と同時にアカウントを有効にする
+	if !s.config.RequireVerifiedEmail {
+		activatedAt, err := timeobj.NewTimeObj(time.Now())
+		if err != nil {
+			return nil, errs.NewServiceError("failed to create activated at")
+		}
+		if err := userEntity.Activate(activatedAt); err != nil {
+			return nil, err
+		}
+	}
 
 	// ユーザー作成
 	createdUser, err := s.userRepository.CreateUser(userEntity)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
 	"github.com/goda6565/nexus-user-auth/errs"
 )
@@ -53,6 +54,14 @@ func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error)
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
 func (m *mockUserRepository) DeleteUser(objID string) error {
 	args := m.Called(objID)
 	return args.Error(0)
@@ -71,7 +80,10 @@ func TestUserServiceTestSuite(t *testing.T) {
 
 func (suite *UserServiceTestSuite) SetupTest() {
 	suite.repo = NewMockUserRepository()
-	suite.userService = registration.NewUserRegistrationService(suite.repo)
+	config := &registration.Config{BlockDisposable: true}
+	domainPolicy, err := registration.NewDomainPolicy(config, nil)
+	suite.Require().NoError(err)
+	suite.userService = registration.NewUserRegistrationService(suite.repo, domainPolicy, config)
 }
 
 // 正常な登録処理のテスト
@@ -133,3 +145,43 @@ func (suite *UserServiceTestSuite) TestRegister_RepositoryError() {
 	suite.Nil(createdUser)
 	suite.Contains(err.Error(), "repository")
 }
+
+// 使い捨てメールのドメインでは登録できないこと
+func (suite *UserServiceTestSuite) TestRegister_DisposableDomain() {
+	createdUser, err := suite.userService.UserRegister("test@mailinator.com", "Password123!", "testuser")
+	suite.ErrorIs(err, registration.ErrEmailDomainNotAllowed)
+	suite.Nil(createdUser)
+	suite.repo.AssertNotCalled(suite.T(), "CreateUser", mock.Anything)
+}
+
+// メールアドレスの確認を求めない場合は、登録と同時にアカウントを有効にすること
+func (suite *UserServiceTestSuite) TestRegister_Active() {
+	var saved *entity.User
+	suite.repo.
+		On("CreateUser", mock.AnythingOfType("*entity.User")).
+		Run(func(args mock.Arguments) { saved = args.Get(0).(*entity.User) }).
+		Return(&entity.User{}, nil)
+
+	_, err := suite.userService.UserRegister("test@example.com", "Password123!", "testuser")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(saved)
+	suite.Equal(value.StatusActive, saved.Status().Value())
+}
+
+// メールアドレスの確認を求める場合は、確認まで有効化待ちにすること
+func (suite *UserServiceTestSuite) TestRegister_PendingUntilVerified() {
+	config := &registration.Config{RequireVerifiedEmail: true}
+	domainPolicy, err := registration.NewDomainPolicy(config, nil)
+	suite.Require().NoError(err)
+	service := registration.NewUserRegistrationService(suite.repo, domainPolicy, config)
+	var saved *entity.User
+	suite.repo.
+		On("CreateUser", mock.AnythingOfType("*entity.User")).
+		Run(func(args mock.Arguments) { saved = args.Get(0).(*entity.User) }).
+		Return(&entity.User{}, nil)
+
+	_, err = service.UserRegister("test@example.com", "Password123!", "testuser")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(saved)
+	suite.ErrorIs(saved.CheckActive(), entity.ErrAccountPending)
+}
@@ -0,0 +1,325 @@
+package role
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/audit"
+	"github.com/goda6565/nexus-user-auth/application/service/user/group"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+var (
+	ErrRoleNotFound      = errs.NewServiceError("role not found")
+	ErrInvalidRole       = errs.NewServiceError("invalid role name, description or permissions")
+	ErrRoleAlreadyExists = errs.NewServiceError("role already exists")
+	ErrBuiltInRole       = errs.NewServiceError("built-in roles cannot be changed or deleted")
+	ErrUserNotFound      = errs.NewServiceError("user not found")
+	ErrCannotGrant       = errs.NewServiceError("cannot grant permissions the actor does not hold")
+	ErrAuditLogFailed    = audit.ErrAuditLogFailed
+)
+
+// UserPermissions はユーザーのロールと、ロールから与えられる権限
+// グループを使う場合は、所属するグループと、グループから与えられるロール・権限を含む
+type UserPermissions struct {
+	Roles       []string // 重複なし・名前順
+	Groups      []string // 重複なし・名前順
+	Permissions []string // 重複なし・名前順
+}
+
+// GrantsAll: permissions のすべてを、ユーザーのいずれかの権限が与えるかどうか（* はすべての権限を与える）
+func (p *UserPermissions) GrantsAll(permissions []string) bool {
+	for _, permission := range permissions {
+		if !slices.ContainsFunc(p.Permissions, func(name string) bool {
+			granted, err := value.NewPermission(name)
+			return err == nil && granted.Grants(permission)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// GroupResolver はユーザーが所属するグループを解決する（group.UserGroupService が満たす）
+type GroupResolver interface {
+	ResolveUserGroups(userObjID string) (*group.UserGroups, error)
+	InvalidateCache()
+}
+
+type UserRoleService interface {
+	// ListRoles: すべてのロールを取得する
+	ListRoles() ([]*entity.Role, error)
+	// GetRole: ロールを取得する
+	GetRole(name string) (*entity.Role, error)
+	// CreateRole: ロールを作成する（操作するアカウント自身が持たない権限は与えられない）
+	CreateRole(actorObjID string, name string, description string, permissions []string) (*entity.Role, error)
+	// UpdateRole: ロールの説明と権限を置き換える（組み込みのロールは変更できない）
+	// 操作するアカウント自身が持たない権限は追加できない
+	UpdateRole(actorObjID string, name string, description string, permissions []string) (*entity.Role, error)
+	// DeleteRole: ロールを削除し、ロールを持つユーザー・グループからも取り除く（組み込みのロールは削除できない）
+	DeleteRole(actorObjID string, name string) error
+	// GetPermissions: ユーザーのロールと権限を取得する
+	GetPermissions(userObjID string) (*UserPermissions, error)
+	// HasPermission: ユーザーがいずれかのロール、または所属するグループで permission を与えられているかどうか
+	HasPermission(userObjID string, permission string) (bool, error)
+}
+
+// userRoleService は UserRoleService の実装
+// ロールの作成・変更・削除は監査ログと同じトランザクションで保存し、記録に失敗した場合は操作を取り消してエラーを返す
+type userRoleService struct {
+	userRepository repository.UserRepository
+	roleRepository repository.RoleRepository
+	transactor     repository.Transactor
+	groups         GroupResolver // nil の場合はグループを使わない
+}
+
+// NewUserRoleService は UserRoleService のインスタンスを作成
+func NewUserRoleService(userRepository repository.UserRepository, roleRepository repository.RoleRepository, transactor repository.Transactor, groups GroupResolver) UserRoleService {
+	return &userRoleService{
+		userRepository: userRepository,
+		roleRepository: roleRepository,
+		transactor:     transactor,
+		groups:         groups,
+	}
+}
+
+// ListRoles はすべてのロールを返す
+func (s *userRoleService) ListRoles() ([]*entity.Role, error) {
+	roles, err := s.roleRepository.ListRoles()
+	if err != nil {
+		return nil, errs.NewServiceError("failed to list roles")
+	}
+	return roles, nil
+}
+
+// GetRole は名前でロールを返す（存在しない場合は ErrRoleNotFound）
+func (s *userRoleService) GetRole(name string) (*entity.Role, error) {
+	return s.findRole(name)
+}
+
+// CreateRole は権限を検証してロールを作成する（組み込みのロールと同じ名前は使えない）
+func (s *userRoleService) CreateRole(actorObjID string, name string, description string, permissions []string) (*entity.Role, error) {
+	roleName, err := value.NewUserRole(name)
+	if err != nil {
+		return nil, ErrInvalidRole
+	}
+	if roleName.IsBuiltIn() {
+		return nil, ErrRoleAlreadyExists
+	}
+	permissionValues, err := toPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	role, err := entity.NewRole(roleName, description, permissionValues)
+	if err != nil {
+		return nil, ErrInvalidRole
+	}
+	existing, err := s.roleRepository.FindRole(name)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get role from repository")
+	}
+	if existing != nil {
+		return nil, ErrRoleAlreadyExists
+	}
+	if err := s.checkGrantable(actorObjID, role.PermissionNames()); err != nil {
+		return nil, err
+	}
+	var createdRole *entity.Role
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if createdRole, err = repos.Roles.CreateRole(role); err != nil {
+			return errs.NewServiceError("failed to create role")
+		}
+		return s.audit(repos, actorObjID, entity.AuditActionRoleCreate, createdRole)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return createdRole, nil
+}
+
+// UpdateRole はロールの説明と権限を置き換える
+// ロールの権限は判定のたびに読み込むため、ロールを持つユーザーの発行済みのトークンにも即座に反映される
+func (s *userRoleService) UpdateRole(actorObjID string, name string, description string, permissions []string) (*entity.Role, error) {
+	permissionValues, err := toPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	role, err := s.findRole(name)
+	if err != nil {
+		return nil, err
+	}
+	if role.IsBuiltIn() {
+		return nil, ErrBuiltInRole
+	}
+	// 取り除く権限は確認しない（追加する権限のみ、操作するアカウントが持っている必要がある）
+	added := make([]string, 0, len(permissionValues))
+	for _, permission := range permissionValues {
+		if !slices.Contains(role.PermissionNames(), permission.Value()) {
+			added = append(added, permission.Value())
+		}
+	}
+	if err := s.checkGrantable(actorObjID, added); err != nil {
+		return nil, err
+	}
+	if err := role.Update(description, permissionValues); err != nil {
+		return nil, ErrInvalidRole
+	}
+	var updatedRole *entity.Role
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if updatedRole, err = repos.Roles.UpdateRole(role); err != nil {
+			return errs.NewServiceError("failed to update role")
+		}
+		return s.audit(repos, actorObjID, entity.AuditActionRoleUpdate, updatedRole)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updatedRole, nil
+}
+
+// DeleteRole はロールを削除する
+// ロールを持つユーザー・グループからの取り除きはリポジトリで行い、グループの解決の結果は破棄する
+func (s *userRoleService) DeleteRole(actorObjID string, name string) error {
+	role, err := s.findRole(name)
+	if err != nil {
+		return err
+	}
+	if role.IsBuiltIn() {
+		return ErrBuiltInRole
+	}
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if err := repos.Roles.DeleteRole(name); err != nil {
+			return errs.NewServiceError("failed to delete role")
+		}
+		return s.audit(repos, actorObjID, entity.AuditActionRoleDelete, role)
+	})
+	if err != nil {
+		return err
+	}
+	if s.groups != nil {
+		// グループから取り除かれたロールを解決の結果に反映する
+		s.groups.InvalidateCache()
+	}
+	return nil
+}
+
+// GetPermissions は、ユーザーが直接または所属するグループを通じて持つロールと、それらが与える権限を返す
+func (s *userRoleService) GetPermissions(userObjID string) (*UserPermissions, error) {
+	grants, err := s.userGrants(userObjID)
+	if err != nil {
+		return nil, err
+	}
+	permissions := slices.Clone(grants.groups.Permissions)
+	for _, role := range grants.roles {
+		permissions = append(permissions, role.PermissionNames()...)
+	}
+	slices.Sort(permissions)
+	return &UserPermissions{
+		Roles:       grants.roleNames,
+		Groups:      grants.groups.Groups,
+		Permissions: slices.Compact(permissions),
+	}, nil
+}
+
+// HasPermission は、ユーザーのロールか所属するグループが permission を与えるかどうかを返す（* はすべての権限を与える）
+func (s *userRoleService) HasPermission(userObjID string, permission string) (bool, error) {
+	grants, err := s.userGrants(userObjID)
+	if err != nil {
+		return false, err
+	}
+	if slices.ContainsFunc(grants.roles, func(role *entity.Role) bool {
+		return role.Grants(permission)
+	}) {
+		return true, nil
+	}
+	for _, name := range grants.groups.Permissions {
+		granted, err := value.NewPermission(name)
+		if err == nil && granted.Grants(permission) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// findRole は名前でロールを取得する（存在しない場合は ErrRoleNotFound）
+func (s *userRoleService) findRole(name string) (*entity.Role, error) {
+	role, err := s.roleRepository.FindRole(name)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get role from repository")
+	}
+	if role == nil {
+		return nil, ErrRoleNotFound
+	}
+	return role, nil
+}
+
+// checkGrantable は、ロールに与える権限を操作するアカウントがすべて持っているかを確認する
+// 持っていない権限を与えられると、roles:write だけを持つアカウントが自分のロールに * を追加できてしまう
+func (s *userRoleService) checkGrantable(actorObjID string, permissions []string) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+	held, err := s.GetPermissions(actorObjID)
+	if err != nil {
+		return errs.NewServiceError("failed to get permissions of the actor")
+	}
+	if !held.GrantsAll(permissions) {
+		return ErrCannotGrant
+	}
+	return nil
+}
+
+// userGrants は、ユーザーが直接または所属するグループを通じて持つロールと、グループから与えられる権限
+type userGrants struct {
+	roleNames []string // 重複なし・名前順
+	roles     []*entity.Role
+	groups    *group.UserGroups
+}
+
+// userGrants はユーザーが持つロールと、所属するグループを取得する
+func (s *userRoleService) userGrants(userObjID string) (*userGrants, error) {
+	user, err := s.userRepository.GetUserByObjID(userObjID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	groups := &group.UserGroups{Groups: []string{}, Roles: []string{}, Permissions: []string{}}
+	if s.groups != nil {
+		groups, err = s.groups.ResolveUserGroups(userObjID)
+		if err != nil {
+			return nil, errs.NewServiceError("failed to resolve user groups")
+		}
+	}
+	roleNames := append(user.RoleNames(), groups.Roles...)
+	slices.Sort(roleNames)
+	roleNames = slices.Compact(roleNames)
+	roles, err := s.roleRepository.FindRolesByNames(roleNames)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get roles from repository")
+	}
+	return &userGrants{roleNames: roleNames, roles: roles, groups: groups}, nil
+}
+
+// audit はロールの操作を、操作と同じトランザクションの監査ログに記録する
+func (s *userRoleService) audit(repos *repository.TxRepositories, actorObjID string, action string, role *entity.Role) error {
+	detail := map[string]string{"role": role.Name().Value()}
+	if action != entity.AuditActionRoleDelete {
+		detail["permissions"] = strings.Join(role.PermissionNames(), ",")
+	}
+	return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, action, "", detail)
+}
+
+// toPermissions は権限の名前を検証して権限に変換する
+func toPermissions(names []string) ([]*value.Permission, error) {
+	permissions := make([]*value.Permission, 0, len(names))
+	for _, name := range names {
+		permission, err := value.NewPermission(name)
+		if err != nil {
+			return nil, ErrInvalidRole
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, nil
+}
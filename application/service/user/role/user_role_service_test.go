@@ -0,0 +1,475 @@
+package role_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/group"
+	"github.com/goda6565/nexus-user-auth/application/service/user/role"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+type mockRoleRepository struct {
+	mock.Mock
+}
+
+func (m *mockRoleRepository) ListRoles() ([]*entity.Role, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) FindRole(name string) (*entity.Role, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) FindRolesByNames(names []string) ([]*entity.Role, error) {
+	args := m.Called(names)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) CreateRole(role *entity.Role) (*entity.Role, error) {
+	args := m.Called(role)
+	if fn, ok := args.Get(0).(func(*entity.Role) *entity.Role); ok {
+		return fn(role), args.Error(1)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) EnsureRoles(roles []*entity.Role) error {
+	args := m.Called(roles)
+	return args.Error(0)
+}
+
+func (m *mockRoleRepository) UpdateRole(role *entity.Role) (*entity.Role, error) {
+	args := m.Called(role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockRoleRepository) DeleteRole(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// recordingAuditLogRepository は記録された監査ログを保持するテスト用リポジトリ
+type recordingAuditLogRepository struct {
+	logs []*entity.AuditLog
+	err  error
+}
+
+func (r *recordingAuditLogRepository) SaveAuditLog(log *entity.AuditLog) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+// fakeTransactor は、テスト用のリポジトリをそのまま渡す Transactor（fn がエラーを返した場合は取り消したことを記録する）
+type fakeTransactor struct {
+	repos      *repository.TxRepositories
+	rolledBack bool
+}
+
+func (t *fakeTransactor) Transaction(fn func(repos *repository.TxRepositories) error) error {
+	err := fn(t.repos)
+	if err != nil {
+		t.rolledBack = true
+	}
+	return err
+}
+
+// stubGroupResolver は決まったグループを返すテスト用の GroupResolver
+type stubGroupResolver struct {
+	groups      *group.UserGroups
+	invalidated int
+}
+
+func (r *stubGroupResolver) ResolveUserGroups(userObjID string) (*group.UserGroups, error) {
+	return r.groups, nil
+}
+
+func (r *stubGroupResolver) InvalidateCache() {
+	r.invalidated++
+}
+
+// --- テストスイート ---
+
+const actorObjID = "11111111-1111-1111-1111-111111111111"
+
+// actorRole は操作するアカウントが持つロール（すべての権限を与える）
+const actorRole = "operator"
+
+type UserRoleServiceTestSuite struct {
+	suite.Suite
+	userRepo   *mockUserRepository
+	roleRepo   *mockRoleRepository
+	auditRepo  *recordingAuditLogRepository
+	transactor *fakeTransactor
+	groups     *stubGroupResolver
+	service    role.UserRoleService
+}
+
+func TestUserRoleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserRoleServiceTestSuite))
+}
+
+func (suite *UserRoleServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.roleRepo = new(mockRoleRepository)
+	suite.auditRepo = &recordingAuditLogRepository{}
+	suite.groups = &stubGroupResolver{groups: &group.UserGroups{Groups: []string{}, Roles: []string{}, Permissions: []string{}}}
+	suite.transactor = &fakeTransactor{repos: &repository.TxRepositories{Users: suite.userRepo, Roles: suite.roleRepo, AuditLogs: suite.auditRepo}}
+	suite.service = role.NewUserRoleService(suite.userRepo, suite.roleRepo, suite.transactor, suite.groups)
+	// 監査ログに記録する、操作したアカウントの種類の取得
+	suite.userRepo.On("GetUserByObjID", actorObjID).Return(suite.newActor(actorObjID, value.KindUser, actorRole), nil).Maybe()
+	suite.roleRepo.On("FindRolesByNames", []string{actorRole}).Return([]*entity.Role{suite.newRole(actorRole, value.PermissionAll)}, nil).Maybe()
+}
+
+// newActor は指定したロールを持つ、操作するアカウントを生成する
+func (suite *UserRoleServiceTestSuite) newActor(id string, kind string, roles ...string) *entity.User {
+	objID, _ := value.NewUserObjID(id)
+	email, _ := value.NewUserEmail("actor@example.com")
+	username, _ := value.NewUserUsername("actor")
+	userKind, _ := value.NewUserKind(kind)
+	roleValues := make([]*value.UserRole, 0, len(roles))
+	for _, name := range roles {
+		r, err := value.NewUserRole(name)
+		suite.Require().NoError(err)
+		roleValues = append(roleValues, r)
+	}
+	actor, err := entity.BuildUser(objID, email, value.NoPassword(), username, nil, nil, nil, roleValues, nil, "", nil, nil, userKind)
+	suite.Require().NoError(err)
+	return actor
+}
+
+// newRole は指定した権限を持つロールを生成する
+func (suite *UserRoleServiceTestSuite) newRole(name string, permissions ...string) *entity.Role {
+	roleName, err := value.NewUserRole(name)
+	suite.Require().NoError(err)
+	values := make([]*value.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		p, err := value.NewPermission(permission)
+		suite.Require().NoError(err)
+		values = append(values, p)
+	}
+	r, err := entity.BuildRole(roleName, "", values)
+	suite.Require().NoError(err)
+	return r
+}
+
+// newUser は指定したロールを持つユーザーを生成する
+func (suite *UserRoleServiceTestSuite) newUser(roles ...string) *entity.User {
+	email, _ := value.NewUserEmail("member@example.com")
+	username, _ := value.NewUserUsername("member")
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	values := make([]*value.UserRole, 0, len(roles))
+	for _, name := range roles {
+		r, err := value.NewUserRole(name)
+		suite.Require().NoError(err)
+		values = append(values, r)
+	}
+	suite.Require().NoError(user.ChangeRoles(values))
+	return user
+}
+
+// ----- GetPermissions / HasPermission のテスト -----
+
+func (suite *UserRoleServiceTestSuite) TestGetPermissions() {
+	user := suite.newUser("support", "auditor")
+	objID := user.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.roleRepo.On("FindRolesByNames", []string{"auditor", "support"}).Return([]*entity.Role{
+		suite.newRole("auditor", value.PermissionUsersRead, value.PermissionRolesRead),
+		suite.newRole("support", value.PermissionUsersRead, value.PermissionUsersWrite),
+	}, nil)
+
+	permissions, err := suite.service.GetPermissions(objID)
+	suite.NoError(err)
+	suite.Equal([]string{"auditor", "support"}, permissions.Roles)
+	suite.Equal([]string{value.PermissionRolesRead, value.PermissionUsersRead, value.PermissionUsersWrite}, permissions.Permissions, "ロールの権限を重複なく名前順にまとめること")
+}
+
+func (suite *UserRoleServiceTestSuite) TestGetPermissions_NoPermissions() {
+	user := suite.newUser(value.RegularUser)
+	objID := user.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.roleRepo.On("FindRolesByNames", []string{value.RegularUser}).Return([]*entity.Role{suite.newRole(value.RegularUser)}, nil)
+
+	permissions, err := suite.service.GetPermissions(objID)
+	suite.NoError(err)
+	suite.NotNil(permissions.Permissions, "権限がない場合も空の一覧を返すこと")
+	suite.Empty(permissions.Permissions)
+}
+
+func (suite *UserRoleServiceTestSuite) TestHasPermission() {
+	user := suite.newUser("support")
+	objID := user.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.roleRepo.On("FindRolesByNames", []string{"support"}).Return([]*entity.Role{suite.newRole("support", value.PermissionUsersRead)}, nil)
+
+	allowed, err := suite.service.HasPermission(objID, value.PermissionUsersRead)
+	suite.NoError(err)
+	suite.True(allowed)
+	allowed, err = suite.service.HasPermission(objID, value.PermissionUsersWrite)
+	suite.NoError(err)
+	suite.False(allowed, "ロールが与えない権限は持たないこと")
+}
+
+func (suite *UserRoleServiceTestSuite) TestHasPermission_Admin() {
+	user := suite.newUser(value.Admin)
+	objID := user.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.roleRepo.On("FindRolesByNames", []string{value.Admin}).Return([]*entity.Role{suite.newRole(value.Admin, value.PermissionAll)}, nil)
+
+	for _, permission := range []string{value.PermissionUsersWrite, value.PermissionRolesWrite} {
+		allowed, err := suite.service.HasPermission(objID, permission)
+		suite.NoError(err)
+		suite.True(allowed, "* を持つロールはすべての権限を持つこと")
+	}
+}
+
+func (suite *UserRoleServiceTestSuite) TestGetPermissions_Groups() {
+	user := suite.newUser(value.RegularUser)
+	objID := user.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", objID).Return(user, nil)
+	suite.groups.groups = &group.UserGroups{Groups: []string{"backend", "engineering"}, Roles: []string{"support"}, Permissions: []string{value.PermissionRolesRead}}
+	suite.roleRepo.On("FindRolesByNames", []string{"support", value.RegularUser}).Return([]*entity.Role{
+		suite.newRole("support", value.PermissionUsersRead),
+		suite.newRole(value.RegularUser),
+	}, nil)
+
+	permissions, err := suite.service.GetPermissions(objID)
+	suite.NoError(err)
+	suite.Equal([]string{"support", value.RegularUser}, permissions.Roles, "グループから与えられたロールを含むこと")
+	suite.Equal([]string{"backend", "engineering"}, permissions.Groups)
+	suite.Equal([]string{value.PermissionRolesRead, value.PermissionUsersRead}, permissions.Permissions, "グループに直接与えた権限を含むこと")
+
+	for _, permission := range []string{value.PermissionUsersRead, value.PermissionRolesRead} {
+		allowed, err := suite.service.HasPermission(objID, permission)
+		suite.NoError(err)
+		suite.True(allowed)
+	}
+	allowed, err := suite.service.HasPermission(objID, value.PermissionRolesWrite)
+	suite.NoError(err)
+	suite.False(allowed)
+}
+
+func (suite *UserRoleServiceTestSuite) TestHasPermission_Error() {
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errs.NewInfraError("record not found"))
+	_, err := suite.service.HasPermission("unknown", value.PermissionUsersRead)
+	suite.ErrorIs(err, role.ErrUserNotFound)
+
+	user := suite.newUser("support")
+	suite.userRepo.On("GetUserByObjID", user.ObjID().Value()).Return(user, nil)
+	suite.roleRepo.On("FindRolesByNames", mock.Anything).Return(nil, errors.New("db down"))
+	allowed, err := suite.service.HasPermission(user.ObjID().Value(), value.PermissionUsersRead)
+	suite.Error(err)
+	suite.False(allowed)
+}
+
+// ----- CreateRole のテスト -----
+
+func (suite *UserRoleServiceTestSuite) TestCreateRole() {
+	suite.roleRepo.On("FindRole", "support").Return(nil, nil)
+	suite.roleRepo.On("CreateRole", mock.Anything).Return(func(r *entity.Role) *entity.Role { return r }, nil)
+
+	created, err := suite.service.CreateRole(actorObjID, "support", "サポート担当", []string{value.PermissionUsersWrite, value.PermissionUsersRead})
+	suite.Require().NoError(err)
+	suite.Equal("support", created.Name().Value())
+	suite.Equal([]string{value.PermissionUsersRead, value.PermissionUsersWrite}, created.PermissionNames())
+
+	suite.Require().Len(suite.auditRepo.logs, 1)
+	log := suite.auditRepo.logs[0]
+	suite.Equal(entity.AuditActionRoleCreate, log.Action())
+	suite.Equal(map[string]string{"role": "support", "permissions": "users:read,users:write"}, log.Detail())
+}
+
+func (suite *UserRoleServiceTestSuite) TestCreateRole_Invalid() {
+	suite.roleRepo.On("FindRole", "support").Return(suite.newRole("support"), nil)
+
+	_, err := suite.service.CreateRole(actorObjID, "support", "", nil)
+	suite.ErrorIs(err, role.ErrRoleAlreadyExists)
+	_, err = suite.service.CreateRole(actorObjID, value.Admin, "", nil)
+	suite.ErrorIs(err, role.ErrRoleAlreadyExists, "組み込みのロールの名前では作成できないこと")
+	_, err = suite.service.CreateRole(actorObjID, "Support Team", "", nil)
+	suite.ErrorIs(err, role.ErrInvalidRole)
+	_, err = suite.service.CreateRole(actorObjID, "new-role", "", []string{"users:delete"})
+	suite.ErrorIs(err, role.ErrInvalidRole, "定義されていない権限は指定できないこと")
+	suite.roleRepo.AssertNotCalled(suite.T(), "CreateRole", mock.Anything)
+	suite.Empty(suite.auditRepo.logs)
+}
+
+// ----- UpdateRole / DeleteRole のテスト -----
+
+func (suite *UserRoleServiceTestSuite) TestUpdateRole() {
+	existing := suite.newRole("support", value.PermissionUsersRead)
+	suite.roleRepo.On("FindRole", "support").Return(existing, nil)
+	suite.roleRepo.On("UpdateRole", existing).Return(existing, nil)
+
+	updated, err := suite.service.UpdateRole(actorObjID, "support", "サポート責任者", []string{value.PermissionUsersWrite})
+	suite.NoError(err)
+	suite.Equal("サポート責任者", updated.Description())
+	suite.Equal([]string{value.PermissionUsersWrite}, updated.PermissionNames())
+	suite.Equal(entity.AuditActionRoleUpdate, suite.auditRepo.logs[0].Action())
+}
+
+func (suite *UserRoleServiceTestSuite) TestUpdateRole_Invalid() {
+	suite.roleRepo.On("FindRole", value.Admin).Return(suite.newRole(value.Admin, value.PermissionAll), nil)
+	suite.roleRepo.On("FindRole", "missing").Return(nil, nil)
+
+	_, err := suite.service.UpdateRole(actorObjID, value.Admin, "", nil)
+	suite.ErrorIs(err, role.ErrBuiltInRole)
+	_, err = suite.service.UpdateRole(actorObjID, "missing", "", nil)
+	suite.ErrorIs(err, role.ErrRoleNotFound)
+	_, err = suite.service.UpdateRole(actorObjID, "missing", "", []string{"unknown"})
+	suite.ErrorIs(err, role.ErrInvalidRole)
+	suite.roleRepo.AssertNotCalled(suite.T(), "UpdateRole", mock.Anything)
+	suite.Empty(suite.auditRepo.logs)
+}
+
+// roles:write だけを持つアカウントは、自分のロールに持たない権限を追加したり、持たない権限のロールを作成したりできないこと
+func (suite *UserRoleServiceTestSuite) TestUpdateRole_CannotGrant() {
+	const editorObjID = "22222222-2222-2222-2222-222222222222"
+	editorRole := suite.newRole("role-editor", value.PermissionRolesWrite)
+	suite.userRepo.On("GetUserByObjID", editorObjID).Return(suite.newActor(editorObjID, value.KindUser, "role-editor"), nil)
+	suite.roleRepo.On("FindRolesByNames", []string{"role-editor"}).Return([]*entity.Role{editorRole}, nil)
+	suite.roleRepo.On("FindRole", "role-editor").Return(editorRole, nil)
+	suite.roleRepo.On("FindRole", "escalated").Return(nil, nil)
+
+	_, err := suite.service.UpdateRole(editorObjID, "role-editor", "", []string{value.PermissionRolesWrite, value.PermissionAll})
+	suite.ErrorIs(err, role.ErrCannotGrant, "自分のロールに * を追加できないこと")
+	_, err = suite.service.CreateRole(editorObjID, "escalated", "", []string{value.PermissionUsersWrite})
+	suite.ErrorIs(err, role.ErrCannotGrant, "持たない権限のロールは作成できないこと")
+	suite.roleRepo.AssertNotCalled(suite.T(), "UpdateRole", mock.Anything)
+	suite.roleRepo.AssertNotCalled(suite.T(), "CreateRole", mock.Anything)
+	suite.Empty(suite.auditRepo.logs)
+
+	// 持っている権限の範囲であれば、権限を取り除くことも含めて変更できること
+	suite.roleRepo.On("UpdateRole", editorRole).Return(editorRole, nil)
+	updated, err := suite.service.UpdateRole(editorObjID, "role-editor", "", []string{value.PermissionRolesWrite})
+	suite.NoError(err)
+	suite.Equal([]string{value.PermissionRolesWrite}, updated.PermissionNames())
+}
+
+func (suite *UserRoleServiceTestSuite) TestDeleteRole() {
+	suite.roleRepo.On("FindRole", "support").Return(suite.newRole("support"), nil)
+	suite.roleRepo.On("DeleteRole", "support").Return(nil)
+
+	suite.NoError(suite.service.DeleteRole(actorObjID, "support"))
+	suite.roleRepo.AssertCalled(suite.T(), "DeleteRole", "support")
+	suite.Equal(1, suite.groups.invalidated, "グループの解決の結果を破棄すること")
+	suite.Equal(entity.AuditActionRoleDelete, suite.auditRepo.logs[0].Action())
+	suite.Equal(map[string]string{"role": "support"}, suite.auditRepo.logs[0].Detail())
+}
+
+func (suite *UserRoleServiceTestSuite) TestDeleteRole_Invalid() {
+	suite.roleRepo.On("FindRole", value.RegularUser).Return(suite.newRole(value.RegularUser), nil)
+	suite.roleRepo.On("FindRole", "missing").Return(nil, nil)
+
+	suite.ErrorIs(suite.service.DeleteRole(actorObjID, value.RegularUser), role.ErrBuiltInRole)
+	suite.ErrorIs(suite.service.DeleteRole(actorObjID, "missing"), role.ErrRoleNotFound)
+	suite.roleRepo.AssertNotCalled(suite.T(), "DeleteRole", mock.Anything)
+}
+
+// 監査ログを記録できない場合は、ロールの操作を取り消してエラーを返すこと
+func (suite *UserRoleServiceTestSuite) TestAuditFailureFailsAction() {
+	suite.auditRepo.err = errors.New("db down")
+	suite.roleRepo.On("FindRole", "support").Return(suite.newRole("support"), nil)
+	suite.roleRepo.On("DeleteRole", "support").Return(nil)
+
+	suite.ErrorIs(suite.service.DeleteRole(actorObjID, "support"), role.ErrAuditLogFailed)
+	suite.True(suite.transactor.rolledBack, "操作と監査ログの記録をまとめて取り消すこと")
+	suite.Zero(suite.groups.invalidated, "取り消した操作はグループの解決の結果に反映しないこと")
+}
+
+// ----- ListRoles / GetRole のテスト -----
+
+func (suite *UserRoleServiceTestSuite) TestListAndGetRole() {
+	roles := []*entity.Role{suite.newRole(value.Admin, value.PermissionAll), suite.newRole(value.RegularUser)}
+	suite.roleRepo.On("ListRoles").Return(roles, nil)
+	suite.roleRepo.On("FindRole", value.Admin).Return(roles[0], nil)
+	suite.roleRepo.On("FindRole", "missing").Return(nil, nil)
+
+	listed, err := suite.service.ListRoles()
+	suite.NoError(err)
+	suite.Equal(roles, listed)
+	found, err := suite.service.GetRole(value.Admin)
+	suite.NoError(err)
+	suite.Equal(roles[0], found)
+	_, err = suite.service.GetRole("missing")
+	suite.ErrorIs(err, role.ErrRoleNotFound)
+}
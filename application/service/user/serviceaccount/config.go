@@ -0,0 +1,17 @@
+package serviceaccount
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	TokenTTL time.Duration // クライアント資格情報で発行するアクセストークンの有効期限
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		TokenTTL: utils.GetEnvDuration("SERVICE_ACCOUNT_TOKEN_TTL", time.Hour),
+	}
+}
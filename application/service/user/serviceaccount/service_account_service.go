@@ -0,0 +1,224 @@
+package serviceaccount
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/apikey"
+	"github.com/goda6565/nexus-user-auth/application/service/user/audit"
+	orgRepository "github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidServiceAccount  = errs.NewServiceError("invalid service account name or description")
+	ErrServiceAccountNotFound = errs.NewServiceError("service account not found")
+	ErrOrganizationNotFound   = errs.NewServiceError("organization not found")
+	ErrInvalidClient          = errs.NewServiceError("invalid client credentials")
+	ErrInvalidScope           = errs.NewServiceError("requested scope is not granted to the api key")
+	ErrAuditLogFailed         = audit.ErrAuditLogFailed
+)
+
+// IssuedToken は、クライアント資格情報で発行したアクセストークン
+type IssuedToken struct {
+	AccessToken string
+	ExpiresIn   time.Duration
+	Scopes      []string
+}
+
+type ServiceAccountService interface {
+	// ListServiceAccounts: すべてのサービスアカウントを取得する
+	ListServiceAccounts() ([]*entity.ServiceAccount, error)
+	// GetServiceAccount: サービスアカウントを取得する
+	GetServiceAccount(objID string) (*entity.ServiceAccount, error)
+	// CreateServiceAccount: サービスアカウントを作成する（orgObjID を指定した場合はその組織が所有する）
+	CreateServiceAccount(actorObjID string, name string, description string, orgObjID string) (*entity.ServiceAccount, error)
+	// DeleteServiceAccount: サービスアカウントを削除する（API キーも使えなくなる）
+	DeleteServiceAccount(actorObjID string, objID string) error
+	// ListAPIKeys: サービスアカウントの API キーを取得する
+	ListAPIKeys(objID string) ([]*entity.APIKey, error)
+	// CreateAPIKey: サービスアカウントの API キーを作成し、キーそのもの（作成時にのみ返す）とともに返す
+	CreateAPIKey(actorObjID string, objID string, name string, scopes []string, expiresAt *time.Time) (secret string, key *entity.APIKey, err error)
+	// RevokeAPIKey: サービスアカウントの API キーを取り消す
+	RevokeAPIKey(actorObjID string, objID string, keyID string) error
+	// IssueToken: クライアント資格情報（サービスアカウントの ID と API キー）でアクセストークンを発行する
+	IssueToken(clientID string, clientSecret string, scope string) (*IssuedToken, error)
+}
+
+// serviceAccountService は ServiceAccountService の実装
+// サービスアカウントの作成・削除と API キーの発行・取り消しは監査ログと同じトランザクションで保存し、
+// 記録に失敗した場合は操作を取り消してエラーを返す
+type serviceAccountService struct {
+	userRepository           repository.UserRepository
+	serviceAccountRepository repository.ServiceAccountRepository
+	organizationRepository   orgRepository.OrganizationRepository
+	transactor               repository.Transactor
+	apiKeys                  apikey.UserAPIKeyService
+	tokens                   *utils.TokenSigner
+	config                   *Config
+}
+
+// NewServiceAccountService は ServiceAccountService のインスタンスを作成
+func NewServiceAccountService(userRepository repository.UserRepository, serviceAccountRepository repository.ServiceAccountRepository, organizationRepository orgRepository.OrganizationRepository, transactor repository.Transactor, apiKeys apikey.UserAPIKeyService, tokens *utils.TokenSigner, config *Config) ServiceAccountService {
+	return &serviceAccountService{
+		userRepository:           userRepository,
+		serviceAccountRepository: serviceAccountRepository,
+		organizationRepository:   organizationRepository,
+		transactor:               transactor,
+		apiKeys:                  apiKeys,
+		tokens:                   tokens,
+		config:                   config,
+	}
+}
+
+func (s *serviceAccountService) ListServiceAccounts() ([]*entity.ServiceAccount, error) {
+	accounts, err := s.serviceAccountRepository.ListServiceAccounts()
+	if err != nil {
+		return nil, errs.NewServiceError("failed to list service accounts")
+	}
+	return accounts, nil
+}
+
+func (s *serviceAccountService) GetServiceAccount(objID string) (*entity.ServiceAccount, error) {
+	account, err := s.serviceAccountRepository.FindServiceAccount(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get service account")
+	}
+	if account == nil {
+		return nil, ErrServiceAccountNotFound
+	}
+	return account, nil
+}
+
+func (s *serviceAccountService) CreateServiceAccount(actorObjID string, name string, description string, orgObjID string) (*entity.ServiceAccount, error) {
+	username, err := value.NewUserUsername(name)
+	if err != nil {
+		return nil, ErrInvalidServiceAccount
+	}
+	actor, err := value.NewUserObjID(actorObjID)
+	if err != nil {
+		return nil, ErrInvalidServiceAccount
+	}
+	if orgObjID != "" {
+		organization, err := s.organizationRepository.FindOrganization(orgObjID)
+		if err != nil {
+			return nil, errs.NewServiceError("failed to get organization")
+		}
+		if organization == nil {
+			return nil, ErrOrganizationNotFound
+		}
+	}
+	account, err := entity.NewServiceAccount(username, description, orgObjID, actor)
+	if err != nil {
+		return nil, ErrInvalidServiceAccount
+	}
+	err = s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if _, err := repos.ServiceAccounts.CreateServiceAccount(account); err != nil {
+			return errs.NewServiceError("failed to create service account")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionServiceAccountCreate, account.ObjID().Value(), map[string]string{
+			"name":  account.User().Username().Value(),
+			"owner": account.OwnerType() + ":" + account.OwnerID(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func (s *serviceAccountService) DeleteServiceAccount(actorObjID string, objID string) error {
+	account, err := s.GetServiceAccount(objID)
+	if err != nil {
+		return err
+	}
+	// 組織が所有する場合の組織のメンバーシップは、ユーザーの削除と同じトランザクションでリポジトリが削除する
+	return s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if err := repos.Users.DeleteUser(objID); err != nil {
+			return errs.NewServiceError("failed to delete service account")
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionServiceAccountDelete, objID, map[string]string{"name": account.User().Username().Value()})
+	})
+}
+
+func (s *serviceAccountService) ListAPIKeys(objID string) ([]*entity.APIKey, error) {
+	if _, err := s.GetServiceAccount(objID); err != nil {
+		return nil, err
+	}
+	return s.apiKeys.ListAPIKeys(objID)
+}
+
+func (s *serviceAccountService) CreateAPIKey(actorObjID string, objID string, name string, scopes []string, expiresAt *time.Time) (string, *entity.APIKey, error) {
+	if _, err := s.GetServiceAccount(objID); err != nil {
+		return "", nil, err
+	}
+	var secret string
+	var key *entity.APIKey
+	err := s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		var err error
+		if secret, key, err = s.apiKeys.WithRepository(repos.APIKeys).CreateAPIKey(objID, name, scopes, expiresAt); err != nil {
+			return err
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionServiceAccountKeyCreate, objID, map[string]string{
+			"key":    key.ID(),
+			"scopes": strings.Join(key.ScopeNames(), ","),
+		})
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return secret, key, nil
+}
+
+func (s *serviceAccountService) RevokeAPIKey(actorObjID string, objID string, keyID string) error {
+	if _, err := s.GetServiceAccount(objID); err != nil {
+		return err
+	}
+	return s.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if err := s.apiKeys.WithRepository(repos.APIKeys).RevokeAPIKey(objID, keyID); err != nil {
+			return err
+		}
+		return audit.Record(s.userRepository, repos.AuditLogs, actorObjID, entity.AuditActionServiceAccountKeyRevoke, objID, map[string]string{"key": keyID})
+	})
+}
+
+// IssueToken はクライアント資格情報（OAuth 2.0 の client_credentials）でアクセストークンを発行する
+// client_secret にはサービスアカウントの API キーを使い、トークンのスコープはキーのスコープの範囲に限る（scope を省略した場合はキーのスコープ）
+// 資格情報の誤り・サービスアカウント以外のキー・有効でないアカウントは区別せず ErrInvalidClient を返す
+func (s *serviceAccountService) IssueToken(clientID string, clientSecret string, scope string) (*IssuedToken, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, ErrInvalidClient
+	}
+	key, err := s.apiKeys.Authenticate(clientSecret)
+	if err != nil || key.UserObjID().Value() != clientID {
+		return nil, ErrInvalidClient
+	}
+	account, err := s.serviceAccountRepository.FindServiceAccount(clientID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get service account")
+	}
+	if account == nil || account.User().CheckActive() != nil {
+		return nil, ErrInvalidClient
+	}
+
+	scopes := key.ScopeNames()
+	if requested := strings.Fields(scope); len(requested) > 0 {
+		for _, name := range requested {
+			if !key.Grants(name) {
+				return nil, ErrInvalidScope
+			}
+		}
+		scopes = slices.Compact(slices.Sorted(slices.Values(requested)))
+	}
+
+	token, err := s.tokens.GenerateServiceAccountToken(clientID, scopes, s.config.TokenTTL)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to generate token")
+	}
+	return &IssuedToken{AccessToken: token, ExpiresIn: s.config.TokenTTL, Scopes: scopes}, nil
+}
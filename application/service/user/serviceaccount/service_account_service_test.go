@@ -0,0 +1,360 @@
+package serviceaccount_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/apikey"
+	"github.com/goda6565/nexus-user-auth/application/service/user/serviceaccount"
+	orgEntity "github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	orgRepository "github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	orgValue "github.com/goda6565/nexus-user-auth/domain/organization/value"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- テスト用リポジトリ ---
+
+// memoryServiceAccountRepository はサービスアカウントをメモリ上に保持するテスト用リポジトリ
+// ユーザーとしての取得・削除（UserRepository）も同じデータで扱う
+type memoryServiceAccountRepository struct {
+	repository.UserRepository
+	accounts []*entity.ServiceAccount
+	users    map[string]*entity.User
+}
+
+func (r *memoryServiceAccountRepository) CreateServiceAccount(account *entity.ServiceAccount) (*entity.ServiceAccount, error) {
+	r.accounts = append(r.accounts, account)
+	r.users[account.ObjID().Value()] = account.User()
+	return account, nil
+}
+
+func (r *memoryServiceAccountRepository) FindServiceAccount(objID string) (*entity.ServiceAccount, error) {
+	for _, account := range r.accounts {
+		if account.ObjID().Value() == objID {
+			return account, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryServiceAccountRepository) ListServiceAccounts() ([]*entity.ServiceAccount, error) {
+	return r.accounts, nil
+}
+
+func (r *memoryServiceAccountRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	user, ok := r.users[objID]
+	if !ok {
+		return nil, errs.NewInfraError("record not found")
+	}
+	return user, nil
+}
+
+func (r *memoryServiceAccountRepository) DeleteUser(objID string) error {
+	r.accounts = slices.DeleteFunc(r.accounts, func(account *entity.ServiceAccount) bool {
+		return account.ObjID().Value() == objID
+	})
+	delete(r.users, objID)
+	return nil
+}
+
+// stubOrganizationRepository は、指定した組織のみ存在するテスト用リポジトリ
+type stubOrganizationRepository struct {
+	orgRepository.OrganizationRepository
+	organizations map[string]*orgEntity.Organization
+}
+
+func (r *stubOrganizationRepository) FindOrganization(orgObjID string) (*orgEntity.Organization, error) {
+	return r.organizations[orgObjID], nil
+}
+
+// memoryAPIKeyRepository は API キーをメモリ上に保持するテスト用リポジトリ
+type memoryAPIKeyRepository struct {
+	keys []*entity.APIKey
+}
+
+func (r *memoryAPIKeyRepository) CreateAPIKey(key *entity.APIKey) (*entity.APIKey, error) {
+	r.keys = append(r.keys, key)
+	return key, nil
+}
+
+func (r *memoryAPIKeyRepository) FindAPIKeyByHash(secretHash string) (*entity.APIKey, error) {
+	for _, key := range r.keys {
+		if key.SecretHash() == secretHash {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryAPIKeyRepository) ListAPIKeys(userObjID string) ([]*entity.APIKey, error) {
+	var keys []*entity.APIKey
+	for _, key := range r.keys {
+		if key.UserObjID().Value() == userObjID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (r *memoryAPIKeyRepository) TouchAPIKey(id string, usedAt time.Time) error {
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) DeleteAPIKey(userObjID string, id string) (bool, error) {
+	before := len(r.keys)
+	r.keys = slices.DeleteFunc(r.keys, func(key *entity.APIKey) bool {
+		return key.ID() == id && key.UserObjID().Value() == userObjID
+	})
+	return len(r.keys) < before, nil
+}
+
+// recordingAuditLogRepository は記録された監査ログを保持するテスト用リポジトリ
+type recordingAuditLogRepository struct {
+	logs []*entity.AuditLog
+	err  error
+}
+
+func (r *recordingAuditLogRepository) SaveAuditLog(log *entity.AuditLog) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+// fakeTransactor は、テスト用のリポジトリをそのまま渡す Transactor（fn がエラーを返した場合は取り消したことを記録する）
+type fakeTransactor struct {
+	repos      *repository.TxRepositories
+	rolledBack bool
+}
+
+func (t *fakeTransactor) Transaction(fn func(repos *repository.TxRepositories) error) error {
+	err := fn(t.repos)
+	if err != nil {
+		t.rolledBack = true
+	}
+	return err
+}
+
+// --- テストスイート ---
+
+const (
+	actorObjID = "11111111-1111-1111-1111-111111111111"
+	orgObjID   = "33333333-3333-3333-3333-333333333333"
+)
+
+type ServiceAccountServiceTestSuite struct {
+	suite.Suite
+	accountRepo *memoryServiceAccountRepository
+	orgRepo     *stubOrganizationRepository
+	apiKeyRepo  *memoryAPIKeyRepository
+	auditRepo   *recordingAuditLogRepository
+	transactor  *fakeTransactor
+	tokens      *utils.TokenSigner
+	service     serviceaccount.ServiceAccountService
+}
+
+func TestServiceAccountServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceAccountServiceTestSuite))
+}
+
+func (suite *ServiceAccountServiceTestSuite) SetupTest() {
+	suite.accountRepo = &memoryServiceAccountRepository{users: map[string]*entity.User{}}
+	orgID, err := orgValue.NewOrgObjID(orgObjID)
+	suite.Require().NoError(err)
+	orgName, err := orgValue.NewOrgName("Acme")
+	suite.Require().NoError(err)
+	orgSlug, err := orgValue.NewOrgSlug("acme")
+	suite.Require().NoError(err)
+	organization, err := orgEntity.BuildOrganization(orgID, orgName, orgSlug, time.Now())
+	suite.Require().NoError(err)
+	suite.orgRepo = &stubOrganizationRepository{organizations: map[string]*orgEntity.Organization{orgObjID: organization}}
+	suite.apiKeyRepo = &memoryAPIKeyRepository{}
+	suite.auditRepo = &recordingAuditLogRepository{}
+	suite.tokens = utils.NewTokenSigner([]byte("test-secret"), "")
+	apiKeys := apikey.NewUserAPIKeyService(suite.apiKeyRepo, &apikey.Config{MaxPerUser: 3, MaxTTL: 24 * time.Hour})
+	suite.transactor = &fakeTransactor{repos: &repository.TxRepositories{Users: suite.accountRepo, ServiceAccounts: suite.accountRepo, APIKeys: suite.apiKeyRepo, AuditLogs: suite.auditRepo}}
+	suite.service = serviceaccount.NewServiceAccountService(suite.accountRepo, suite.accountRepo, suite.orgRepo, suite.transactor, apiKeys, suite.tokens, &serviceaccount.Config{TokenTTL: time.Hour})
+}
+
+// lastAudit は最後に記録された監査ログを返す
+func (suite *ServiceAccountServiceTestSuite) lastAudit() *entity.AuditLog {
+	suite.Require().NotEmpty(suite.auditRepo.logs, "監査ログが記録されていること")
+	return suite.auditRepo.logs[len(suite.auditRepo.logs)-1]
+}
+
+// createAccount はサービスアカウントを作成する
+func (suite *ServiceAccountServiceTestSuite) createAccount(name string) *entity.ServiceAccount {
+	account, err := suite.service.CreateServiceAccount(actorObjID, name, "", "")
+	suite.Require().NoError(err)
+	return account
+}
+
+// ----- CreateServiceAccount のテスト -----
+
+func (suite *ServiceAccountServiceTestSuite) TestCreateServiceAccount() {
+	account, err := suite.service.CreateServiceAccount(actorObjID, "ci-deployer", "デプロイ用", "")
+	suite.Require().NoError(err)
+	suite.True(account.User().IsServiceAccount())
+	suite.Equal(entity.ServiceAccountOwnerAdmin, account.OwnerType())
+	suite.Equal(actorObjID, account.OwnerID(), "組織を指定しない場合は作成した管理者が所有すること")
+	suite.Equal(entity.AuditActionServiceAccountCreate, suite.lastAudit().Action())
+	suite.Equal(account.ObjID().Value(), suite.lastAudit().TargetObjID())
+
+	owned, err := suite.service.CreateServiceAccount(actorObjID, "org-bot", "", orgObjID)
+	suite.Require().NoError(err)
+	suite.Equal(entity.ServiceAccountOwnerOrganization, owned.OwnerType())
+	suite.Equal(orgObjID, owned.OwnerID())
+}
+
+func (suite *ServiceAccountServiceTestSuite) TestCreateServiceAccount_Invalid() {
+	_, err := suite.service.CreateServiceAccount(actorObjID, "", "", "")
+	suite.ErrorIs(err, serviceaccount.ErrInvalidServiceAccount)
+	_, err = suite.service.CreateServiceAccount(actorObjID, "ci-deployer", "", "44444444-4444-4444-4444-444444444444")
+	suite.ErrorIs(err, serviceaccount.ErrOrganizationNotFound)
+	suite.Empty(suite.accountRepo.accounts)
+	suite.Empty(suite.auditRepo.logs)
+}
+
+// ----- DeleteServiceAccount のテスト -----
+
+func (suite *ServiceAccountServiceTestSuite) TestDeleteServiceAccount() {
+	account := suite.createAccount("ci-deployer")
+	owned, err := suite.service.CreateServiceAccount(actorObjID, "org-bot", "", orgObjID)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.service.DeleteServiceAccount(actorObjID, account.ObjID().Value()))
+	_, err = suite.service.GetServiceAccount(account.ObjID().Value())
+	suite.ErrorIs(err, serviceaccount.ErrServiceAccountNotFound)
+	suite.Equal(entity.AuditActionServiceAccountDelete, suite.lastAudit().Action())
+
+	// 組織が所有する場合も削除できること（組織のメンバーシップはユーザーの削除とともにリポジトリが削除する）
+	suite.NoError(suite.service.DeleteServiceAccount(actorObjID, owned.ObjID().Value()))
+	_, err = suite.service.GetServiceAccount(owned.ObjID().Value())
+	suite.ErrorIs(err, serviceaccount.ErrServiceAccountNotFound)
+
+	suite.ErrorIs(suite.service.DeleteServiceAccount(actorObjID, account.ObjID().Value()), serviceaccount.ErrServiceAccountNotFound)
+}
+
+// ----- API キーのテスト -----
+
+func (suite *ServiceAccountServiceTestSuite) TestAPIKeys() {
+	account := suite.createAccount("ci-deployer")
+	objID := account.ObjID().Value()
+
+	secret, key, err := suite.service.CreateAPIKey(actorObjID, objID, "deploy", []string{value.PermissionUsersRead}, nil)
+	suite.Require().NoError(err)
+	suite.NotEmpty(secret)
+	suite.Equal(objID, key.UserObjID().Value(), "サービスアカウントのキーとして作成すること")
+	suite.Equal(entity.AuditActionServiceAccountKeyCreate, suite.lastAudit().Action())
+	suite.Equal(key.ID(), suite.lastAudit().Detail()["key"])
+
+	keys, err := suite.service.ListAPIKeys(objID)
+	suite.NoError(err)
+	suite.Len(keys, 1)
+
+	suite.NoError(suite.service.RevokeAPIKey(actorObjID, objID, key.ID()))
+	suite.Equal(entity.AuditActionServiceAccountKeyRevoke, suite.lastAudit().Action())
+	suite.ErrorIs(suite.service.RevokeAPIKey(actorObjID, objID, key.ID()), apikey.ErrAPIKeyNotFound)
+
+	_, _, err = suite.service.CreateAPIKey(actorObjID, objID, "deploy", []string{"unknown:scope"}, nil)
+	suite.ErrorIs(err, apikey.ErrInvalidAPIKey)
+}
+
+// サービスアカウントではないユーザーの API キーは管理できないこと
+func (suite *ServiceAccountServiceTestSuite) TestAPIKeys_NotServiceAccount() {
+	_, _, err := suite.service.CreateAPIKey(actorObjID, actorObjID, "deploy", []string{value.APIKeyScopeAll}, nil)
+	suite.ErrorIs(err, serviceaccount.ErrServiceAccountNotFound)
+	_, err = suite.service.ListAPIKeys(actorObjID)
+	suite.ErrorIs(err, serviceaccount.ErrServiceAccountNotFound)
+	suite.Empty(suite.apiKeyRepo.keys)
+}
+
+// 監査ログを記録できない場合は、API キーの発行を取り消してエラーを返すこと（キーそのものも返さない）
+func (suite *ServiceAccountServiceTestSuite) TestAuditFailureFailsAction() {
+	account := suite.createAccount("ci-deployer")
+	suite.auditRepo.err = errors.New("db down")
+
+	secret, key, err := suite.service.CreateAPIKey(actorObjID, account.ObjID().Value(), "deploy", []string{value.PermissionUsersRead}, nil)
+	suite.ErrorIs(err, serviceaccount.ErrAuditLogFailed)
+	suite.Empty(secret)
+	suite.Nil(key)
+	suite.True(suite.transactor.rolledBack, "操作と監査ログの記録をまとめて取り消すこと")
+}
+
+// ----- IssueToken のテスト -----
+
+func (suite *ServiceAccountServiceTestSuite) TestIssueToken() {
+	account := suite.createAccount("ci-deployer")
+	objID := account.ObjID().Value()
+	secret, _, err := suite.service.CreateAPIKey(actorObjID, objID, "deploy", []string{value.PermissionUsersRead, value.APIKeyScopeProfileRead}, nil)
+	suite.Require().NoError(err)
+
+	// scope を省略した場合はキーのスコープ
+	issued, err := suite.service.IssueToken(objID, secret, "")
+	suite.Require().NoError(err)
+	suite.Equal(time.Hour, issued.ExpiresIn)
+	suite.Equal([]string{value.APIKeyScopeProfileRead, value.PermissionUsersRead}, issued.Scopes)
+	claims, err := suite.tokens.ValidateToken(issued.AccessToken)
+	suite.Require().NoError(err)
+	suite.Equal(objID, claims.ObjID)
+	suite.True(claims.IsServiceAccount(), "サービスアカウントのトークンであること")
+	suite.Equal(issued.Scopes, claims.Scopes)
+
+	// キーのスコープの範囲に限定できる
+	issued, err = suite.service.IssueToken(objID, secret, value.PermissionUsersRead)
+	suite.Require().NoError(err)
+	suite.Equal([]string{value.PermissionUsersRead}, issued.Scopes)
+
+	_, err = suite.service.IssueToken(objID, secret, value.PermissionUsersWrite)
+	suite.ErrorIs(err, serviceaccount.ErrInvalidScope, "キーにないスコープは要求できないこと")
+}
+
+func (suite *ServiceAccountServiceTestSuite) TestIssueToken_InvalidClient() {
+	account := suite.createAccount("ci-deployer")
+	objID := account.ObjID().Value()
+	secret, _, err := suite.service.CreateAPIKey(actorObjID, objID, "deploy", []string{value.APIKeyScopeAll}, nil)
+	suite.Require().NoError(err)
+	other := suite.createAccount("other-bot")
+
+	cases := []struct {
+		name     string
+		clientID string
+		secret   string
+	}{
+		{"キーの誤り", objID, entity.APIKeyPrefix + "wrong"},
+		{"別のサービスアカウントの ID", other.ObjID().Value(), secret},
+		{"ID の指定なし", "", secret},
+		{"キーの指定なし", objID, ""},
+	}
+	for _, tc := range cases {
+		_, err := suite.service.IssueToken(tc.clientID, tc.secret, "")
+		suite.ErrorIs(err, serviceaccount.ErrInvalidClient, tc.name)
+	}
+
+	// 停止したサービスアカウントにはトークンを発行しない
+	suspendedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(account.User().Suspend("鍵の漏洩", suspendedAt))
+	_, err = suite.service.IssueToken(objID, secret, "")
+	suite.ErrorIs(err, serviceaccount.ErrInvalidClient)
+}
+
+// 人のユーザーの API キーではトークンを発行できないこと
+func (suite *ServiceAccountServiceTestSuite) TestIssueToken_UserAPIKey() {
+	apiKeys := apikey.NewUserAPIKeyService(suite.apiKeyRepo, &apikey.Config{MaxPerUser: 3})
+	secret, _, err := apiKeys.CreateAPIKey(actorObjID, "CI", []string{value.APIKeyScopeAll}, nil)
+	suite.Require().NoError(err)
+
+	_, err = suite.service.IssueToken(actorObjID, secret, "")
+	suite.True(errors.Is(err, serviceaccount.ErrInvalidClient))
+}
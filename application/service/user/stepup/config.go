@@ -0,0 +1,21 @@
+package stepup
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	MaxAge      time.Duration // 重要な操作を許可する、認証からの経過時間の上限
+	RequiredACR string        // 重要な操作に必要な認証の強度（aal1 / aal2）
+	ReauthURL   string        // 再認証のエンドポイント（ステップアップが必要な場合にクライアントへ案内する）
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		MaxAge:      utils.GetEnvDuration("STEP_UP_MAX_AGE", 10*time.Minute),
+		RequiredACR: utils.GetEnvDefault("STEP_UP_ACR", utils.ACRSingleFactor),
+		ReauthURL:   "/api/v1/auth/reauthenticate",
+	}
+}
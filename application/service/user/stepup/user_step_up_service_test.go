@@ -0,0 +1,343 @@
+package stepup_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/mfa"
+	"github.com/goda6565/nexus-user-auth/application/service/user/stepup"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モック ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+type mockMFARepository struct {
+	mock.Mock
+}
+
+func (m *mockMFARepository) SaveTOTPFactor(factor *entity.TOTPFactor) (*entity.TOTPFactor, error) {
+	args := m.Called(factor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TOTPFactor), args.Error(1)
+}
+
+func (m *mockMFARepository) FindTOTPFactor(userObjID string) (*entity.TOTPFactor, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TOTPFactor), args.Error(1)
+}
+
+func (m *mockMFARepository) ConsumeTOTPStep(userObjID string, step int64) (bool, error) {
+	args := m.Called(userObjID, step)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockMFARepository) ReplaceRecoveryCodes(userObjID string, codes []*entity.RecoveryCode) error {
+	args := m.Called(userObjID, codes)
+	return args.Error(0)
+}
+
+func (m *mockMFARepository) UseRecoveryCode(userObjID string, codeHash string) (bool, error) {
+	args := m.Called(userObjID, codeHash)
+	return args.Bool(0), args.Error(1)
+}
+
+type mockLockoutService struct {
+	mock.Mock
+}
+
+func (m *mockLockoutService) Check(email string, ip string) error {
+	args := m.Called(email, ip)
+	return args.Error(0)
+}
+
+func (m *mockLockoutService) RecordFailure(email string, ip string) error {
+	args := m.Called(email, ip)
+	return args.Error(0)
+}
+
+func (m *mockLockoutService) RecordSuccess(email string) {
+	m.Called(email)
+}
+
+func (m *mockLockoutService) Unlock(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *mockLockoutService) AdminUnlock(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+var _ lockout.UserLockoutService = (*mockLockoutService)(nil)
+
+type mockMFAService struct {
+	mock.Mock
+}
+
+func (m *mockMFAService) BeginTOTPEnrollment(objID string) (*mfa.TOTPEnrollment, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mfa.TOTPEnrollment), args.Error(1)
+}
+
+func (m *mockMFAService) ConfirmTOTPEnrollment(objID string, code string) ([]string, error) {
+	args := m.Called(objID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockMFAService) VerifyMFA(mfaToken string, code string, client loginhistory.ClientInfo) (string, string, error) {
+	args := m.Called(mfaToken, code, client)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *mockMFAService) VerifyCode(objID string, code string) error {
+	args := m.Called(objID, code)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+
+type UserStepUpServiceTestSuite struct {
+	suite.Suite
+	userRepo   *mockUserRepository
+	mfaRepo    *mockMFARepository
+	mfaService *mockMFAService
+	lockout    *mockLockoutService
+	service    stepup.UserStepUpService
+	testUser   *entity.User
+	objID      string
+}
+
+func TestUserStepUpServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserStepUpServiceTestSuite))
+}
+
+func (suite *UserStepUpServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserStepUpServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserStepUpServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.mfaRepo = new(mockMFARepository)
+	suite.mfaService = new(mockMFAService)
+	suite.lockout = new(mockLockoutService)
+	suite.lockout.On("Check", mock.Anything, "").Return(nil).Maybe()
+	suite.lockout.On("RecordFailure", mock.Anything, "").Return(nil).Maybe()
+	suite.service = stepup.NewUserStepUpService(suite.userRepo, suite.mfaRepo, suite.mfaService, suite.lockout, utils.DefaultTokenSigner())
+
+	suite.testUser = suite.newUser(value.NewUserPassword)
+	suite.objID = suite.testUser.ObjID().Value()
+}
+
+func (suite *UserStepUpServiceTestSuite) newUser(password func(string) (*value.UserPassword, error)) *entity.User {
+	email, _ := value.NewUserEmail("stepup@example.com")
+	username, _ := value.NewUserUsername("stepupuser")
+	pw, err := password("password123")
+	suite.Require().NoError(err)
+	user, err := entity.NewUser(email, pw, username)
+	suite.Require().NoError(err)
+	suite.userRepo.On("GetUserByObjID", user.ObjID().Value()).Return(user, nil)
+	return user
+}
+
+func (suite *UserStepUpServiceTestSuite) confirmedFactor() *entity.TOTPFactor {
+	factor, err := entity.NewTOTPFactor(suite.testUser.ObjID(), "JBSWY3DPEHPK3PXP")
+	suite.Require().NoError(err)
+	suite.Require().NoError(factor.Confirm(1, time.Now()))
+	return factor
+}
+
+// パスワードで再認証すると、認証時刻を更新したトークンが発行されること
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_Password() {
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(nil, nil)
+
+	accessToken, refreshToken, err := suite.service.Reauthenticate(suite.objID, "", "password123", "")
+	suite.Require().NoError(err)
+	suite.NotEmpty(refreshToken)
+	claims, err := utils.ValidateToken(accessToken)
+	suite.Require().NoError(err)
+	suite.Equal(suite.objID, claims.ObjID)
+	suite.Equal([]string{utils.AMRPassword}, claims.AMR)
+	suite.Equal(utils.ACRSingleFactor, claims.ACR)
+	suite.WithinDuration(time.Now(), claims.AuthTime, 2*time.Second)
+}
+
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_WrongPassword() {
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(nil, nil)
+
+	_, _, err := suite.service.Reauthenticate(suite.objID, "", "wrongpassword1", "")
+	suite.ErrorIs(err, stepup.ErrInvalidPassword)
+	suite.lockout.AssertCalled(suite.T(), "RecordFailure", "stepup@example.com", "")
+}
+
+// 失敗が続いてロックされている場合は、パスワードを確認せずにエラーを返すこと
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_Locked() {
+	suite.lockout = new(mockLockoutService)
+	suite.service = stepup.NewUserStepUpService(suite.userRepo, suite.mfaRepo, suite.mfaService, suite.lockout, utils.DefaultTokenSigner())
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(nil, nil)
+	suite.lockout.On("Check", "stepup@example.com", "").Return(&lockout.LockoutError{Err: lockout.ErrAccountLocked, RetryAfter: time.Minute})
+
+	_, _, err := suite.service.Reauthenticate(suite.objID, "", "password123", "")
+	suite.ErrorIs(err, lockout.ErrAccountLocked)
+	suite.lockout.AssertNotCalled(suite.T(), "RecordFailure", mock.Anything, mock.Anything)
+}
+
+// 失敗がしきい値に達した場合はロックのエラーを返すこと
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_WrongPasswordLocks() {
+	suite.lockout = new(mockLockoutService)
+	suite.service = stepup.NewUserStepUpService(suite.userRepo, suite.mfaRepo, suite.mfaService, suite.lockout, utils.DefaultTokenSigner())
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(nil, nil)
+	suite.lockout.On("Check", "stepup@example.com", "").Return(nil)
+	suite.lockout.On("RecordFailure", "stepup@example.com", "").Return(&lockout.LockoutError{Err: lockout.ErrAccountLocked, RetryAfter: time.Minute})
+
+	_, _, err := suite.service.Reauthenticate(suite.objID, "", "wrongpassword1", "")
+	suite.ErrorIs(err, lockout.ErrAccountLocked)
+}
+
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_PasswordRequired() {
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(nil, nil)
+
+	_, _, err := suite.service.Reauthenticate(suite.objID, "", "", "123456")
+	suite.ErrorIs(err, stepup.ErrPasswordRequired)
+}
+
+// 認証アプリが有効な場合はコードが必須で、パスワードと合わせると多要素認証になること
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_PasswordAndCode() {
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(suite.confirmedFactor(), nil)
+	suite.mfaService.On("VerifyCode", suite.objID, "123456").Return(nil)
+
+	accessToken, _, err := suite.service.Reauthenticate(suite.objID, "", "password123", "123456")
+	suite.Require().NoError(err)
+	claims, err := utils.ValidateToken(accessToken)
+	suite.Require().NoError(err)
+	suite.ElementsMatch([]string{utils.AMRPassword, utils.AMROTP, utils.AMRMFA}, claims.AMR)
+	suite.Equal(utils.ACRMultiFactor, claims.ACR)
+}
+
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_CodeOnly() {
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(suite.confirmedFactor(), nil)
+	suite.mfaService.On("VerifyCode", suite.objID, "123456").Return(nil)
+
+	accessToken, _, err := suite.service.Reauthenticate(suite.objID, "", "", "123456")
+	suite.Require().NoError(err)
+	claims, err := utils.ValidateToken(accessToken)
+	suite.Require().NoError(err)
+	suite.Equal([]string{utils.AMROTP}, claims.AMR)
+}
+
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_CodeRequired() {
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(suite.confirmedFactor(), nil)
+
+	_, _, err := suite.service.Reauthenticate(suite.objID, "", "password123", "")
+	suite.ErrorIs(err, stepup.ErrCodeRequired)
+	suite.mfaService.AssertNotCalled(suite.T(), "VerifyCode", mock.Anything, mock.Anything)
+}
+
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_InvalidCode() {
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(suite.confirmedFactor(), nil)
+	suite.mfaService.On("VerifyCode", suite.objID, "000000").Return(mfa.ErrInvalidMFACode)
+
+	_, _, err := suite.service.Reauthenticate(suite.objID, "", "password123", "000000")
+	suite.ErrorIs(err, stepup.ErrInvalidCode)
+}
+
+// パスワードが誤っている場合はコードを消費しないこと
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_WrongPasswordWithCode() {
+	suite.mfaRepo.On("FindTOTPFactor", suite.objID).Return(suite.confirmedFactor(), nil)
+
+	_, _, err := suite.service.Reauthenticate(suite.objID, "", "wrongpassword1", "123456")
+	suite.ErrorIs(err, stepup.ErrInvalidPassword)
+	suite.mfaService.AssertNotCalled(suite.T(), "VerifyCode", mock.Anything, mock.Anything)
+}
+
+// パスワードも認証アプリもないユーザーはログインし直す必要があること
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_Unavailable() {
+	user := suite.newUser(func(string) (*value.UserPassword, error) { return value.NoPassword(), nil })
+	suite.mfaRepo.On("FindTOTPFactor", user.ObjID().Value()).Return(nil, nil)
+
+	_, _, err := suite.service.Reauthenticate(user.ObjID().Value(), "", "", "")
+	suite.ErrorIs(err, stepup.ErrReauthUnavailable)
+}
+
+func (suite *UserStepUpServiceTestSuite) TestReauthenticate_UserNotFound() {
+	suite.userRepo.On("GetUserByObjID", "unknown").Return(nil, errors.New("not found"))
+
+	_, _, err := suite.service.Reauthenticate("unknown", "", "password123", "")
+	suite.Error(err)
+}
@@ -0,0 +1,19 @@
+package trusteddevice
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	TTL       time.Duration // 信頼済み端末の有効期限（期限後は再び二要素認証を求める）
+	MFAMaxAge time.Duration // 端末を記憶できる、二要素認証からの経過時間の上限
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		TTL:       utils.GetEnvDuration("TRUSTED_DEVICE_TTL", 30*24*time.Hour),
+		MFAMaxAge: utils.GetEnvDuration("TRUSTED_DEVICE_MFA_MAX_AGE", 5*time.Minute),
+	}
+}
@@ -0,0 +1,125 @@
+package trusteddevice
+
+import (
+	"slices"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrMFARequired    = errs.NewServiceError("a recent two-factor authentication is required to trust a device")
+	ErrDeviceNotFound = errs.NewServiceError("trusted device not found")
+)
+
+type UserTrustedDeviceService interface {
+	// Remember: 二要素認証の直後に発行されたアクセストークンの端末を記憶し、信頼済み端末のトークンを発行
+	Remember(accessToken string, name string) (token string, device *entity.TrustedDevice, err error)
+	// IsTrusted: 信頼済み端末のトークンが、指定のユーザーの有効な端末のものかどうか
+	IsTrusted(objID string, token string) bool
+	// ListDevices: ユーザーの信頼済み端末を取得
+	ListDevices(objID string) ([]*entity.TrustedDevice, error)
+	// RevokeDevice: ユーザーの信頼済み端末を取り消す
+	RevokeDevice(objID string, deviceID string) error
+}
+
+// userTrustedDeviceService は UserTrustedDeviceService の実装
+type userTrustedDeviceService struct {
+	userRepository          repository.UserRepository
+	trustedDeviceRepository repository.TrustedDeviceRepository
+	tokens                  *utils.TokenSigner
+	config                  *Config
+}
+
+// NewUserTrustedDeviceService は UserTrustedDeviceService のインスタンスを作成
+func NewUserTrustedDeviceService(userRepository repository.UserRepository, trustedDeviceRepository repository.TrustedDeviceRepository, tokens *utils.TokenSigner, config *Config) UserTrustedDeviceService {
+	return &userTrustedDeviceService{
+		userRepository:          userRepository,
+		trustedDeviceRepository: trustedDeviceRepository,
+		tokens:                  tokens,
+		config:                  config,
+	}
+}
+
+// Remember は端末を記憶する
+// 二要素認証を経たこと（amr に mfa を含むこと）と認証の直後であることをアクセストークンで確認するため、
+// 二要素目の検証で発行したトークン以外では端末を記憶できない。
+func (s *userTrustedDeviceService) Remember(accessToken string, name string) (string, *entity.TrustedDevice, error) {
+	claims, err := s.tokens.ValidateToken(accessToken)
+	if err != nil {
+		return "", nil, ErrMFARequired
+	}
+	if !slices.Contains(claims.AMR, utils.AMRMFA) || claims.AuthTime.IsZero() || time.Since(claims.AuthTime) > s.config.MFAMaxAge {
+		return "", nil, ErrMFARequired
+	}
+	user, err := s.userRepository.GetUserByObjID(claims.ObjID)
+	if err != nil {
+		return "", nil, errs.NewServiceError("failed to get user")
+	}
+
+	device, err := entity.NewTrustedDevice(user.ObjID(), name, s.config.TTL)
+	if err != nil {
+		return "", nil, errs.NewServiceError("failed to create trusted device")
+	}
+	if _, err := s.trustedDeviceRepository.CreateDevice(device); err != nil {
+		return "", nil, errs.NewServiceError("failed to save trusted device in repository")
+	}
+	token, err := s.tokens.GenerateActionTokenWithRef(utils.PurposeTrustedDevice, claims.ObjID, "", device.ID(), s.config.TTL)
+	if err != nil {
+		return "", nil, errs.NewServiceError("failed to generate trusted device token")
+	}
+	return token, device, nil
+}
+
+// IsTrusted は信頼済み端末のトークンを検証する。署名・有効期限に加え、端末の記録が残っていることを確認する
+// 判定できない場合は信頼しない（二要素認証を求める）ため、エラーは返さずログに記録する。
+func (s *userTrustedDeviceService) IsTrusted(objID string, token string) bool {
+	if token == "" {
+		return false
+	}
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeTrustedDevice, token)
+	if err != nil || claims.ObjID != objID {
+		return false
+	}
+	device, err := s.trustedDeviceRepository.FindDevice(claims.Ref)
+	if err != nil {
+		logger.Warn("failed to get trusted device", "device", claims.Ref, "error", err.Error())
+		return false
+	}
+	now := time.Now()
+	if device == nil || device.UserObjID().Value() != objID || device.IsExpired(now) {
+		return false
+	}
+	if err := s.trustedDeviceRepository.TouchDevice(device.ID(), now); err != nil {
+		logger.Warn("failed to update trusted device usage", "device", device.ID(), "error", err.Error())
+	}
+	return true
+}
+
+// ListDevices はユーザーの有効な信頼済み端末を返す（有効期限切れの端末は含めない）
+func (s *userTrustedDeviceService) ListDevices(objID string) ([]*entity.TrustedDevice, error) {
+	devices, err := s.trustedDeviceRepository.ListDevices(objID)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to get trusted devices")
+	}
+	now := time.Now()
+	return slices.DeleteFunc(devices, func(device *entity.TrustedDevice) bool {
+		return device.IsExpired(now)
+	}), nil
+}
+
+// RevokeDevice は信頼済み端末を取り消す。以降その端末のトークンではログイン時の二要素認証を省略できない
+func (s *userTrustedDeviceService) RevokeDevice(objID string, deviceID string) error {
+	deleted, err := s.trustedDeviceRepository.DeleteDevice(objID, deviceID)
+	if err != nil {
+		return errs.NewServiceError("failed to delete trusted device")
+	}
+	if !deleted {
+		return ErrDeviceNotFound
+	}
+	return nil
+}
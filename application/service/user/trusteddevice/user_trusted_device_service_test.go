@@ -0,0 +1,287 @@
+package trusteddevice_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/trusteddevice"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モック ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+// inMemoryTrustedDeviceRepository はメモリ上で信頼済み端末を保持するテスト用リポジトリ
+type inMemoryTrustedDeviceRepository struct {
+	devices []*entity.TrustedDevice
+	err     error
+}
+
+func (r *inMemoryTrustedDeviceRepository) CreateDevice(device *entity.TrustedDevice) (*entity.TrustedDevice, error) {
+	r.devices = append(r.devices, device)
+	return device, nil
+}
+
+func (r *inMemoryTrustedDeviceRepository) FindDevice(id string) (*entity.TrustedDevice, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, device := range r.devices {
+		if device.ID() == id {
+			return device, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryTrustedDeviceRepository) ListDevices(userObjID string) ([]*entity.TrustedDevice, error) {
+	var devices []*entity.TrustedDevice
+	for _, device := range r.devices {
+		if device.UserObjID().Value() == userObjID {
+			devices = append(devices, device)
+		}
+	}
+	return devices, nil
+}
+
+func (r *inMemoryTrustedDeviceRepository) TouchDevice(id string, usedAt time.Time) error {
+	for i, device := range r.devices {
+		if device.ID() == id {
+			r.devices[i], _ = entity.BuildTrustedDevice(device.ID(), device.UserObjID(), device.Name(), device.ExpiresAt(), device.CreatedAt(), &usedAt)
+		}
+	}
+	return nil
+}
+
+func (r *inMemoryTrustedDeviceRepository) DeleteDevice(userObjID string, id string) (bool, error) {
+	for i, device := range r.devices {
+		if device.ID() == id && device.UserObjID().Value() == userObjID {
+			r.devices = append(r.devices[:i], r.devices[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// --- テストスイート ---
+
+type UserTrustedDeviceServiceTestSuite struct {
+	suite.Suite
+	userRepo   *mockUserRepository
+	deviceRepo *inMemoryTrustedDeviceRepository
+	service    trusteddevice.UserTrustedDeviceService
+	testUser   *entity.User
+	objID      string
+}
+
+func TestUserTrustedDeviceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserTrustedDeviceServiceTestSuite))
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) SetupTest() {
+	suite.userRepo = new(mockUserRepository)
+	suite.deviceRepo = &inMemoryTrustedDeviceRepository{}
+	suite.service = trusteddevice.NewUserTrustedDeviceService(suite.userRepo, suite.deviceRepo, utils.DefaultTokenSigner(), &trusteddevice.Config{
+		TTL:       30 * 24 * time.Hour,
+		MFAMaxAge: 5 * time.Minute,
+	})
+
+	email, _ := value.NewUserEmail("device@example.com")
+	username, _ := value.NewUserUsername("deviceuser")
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	suite.testUser = user
+	suite.objID = user.ObjID().Value()
+	suite.userRepo.On("GetUserByObjID", suite.objID).Return(user, nil)
+}
+
+// accessToken は指定の認証の情報を持つアクセストークンを返す
+func (suite *UserTrustedDeviceServiceTestSuite) accessToken(auth utils.AuthContext) string {
+	token, _, err := utils.GenerateTokens(suite.objID, auth)
+	suite.Require().NoError(err)
+	return token
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) remember() (string, *entity.TrustedDevice) {
+	token, device, err := suite.service.Remember(suite.accessToken(utils.NewAuthContext(utils.AMRPassword, utils.AMROTP)), "MacBook")
+	suite.Require().NoError(err)
+	return token, device
+}
+
+// ----- Remember のテスト -----
+
+func (suite *UserTrustedDeviceServiceTestSuite) TestRemember() {
+	token, device := suite.remember()
+
+	suite.NotEmpty(token)
+	suite.Equal("MacBook", device.Name())
+	suite.Equal(suite.objID, device.UserObjID().Value())
+	suite.Len(suite.deviceRepo.devices, 1)
+	suite.True(suite.service.IsTrusted(suite.objID, token))
+}
+
+// 二要素認証を経ていないトークンでは端末を記憶できないこと
+func (suite *UserTrustedDeviceServiceTestSuite) TestRemember_SingleFactor() {
+	_, _, err := suite.service.Remember(suite.accessToken(utils.NewAuthContext(utils.AMRPassword)), "MacBook")
+	suite.ErrorIs(err, trusteddevice.ErrMFARequired)
+
+	// パスキーのみのログインも二要素目の検証ではないため記憶しない
+	_, _, err = suite.service.Remember(suite.accessToken(utils.NewAuthContext(utils.AMRHardwareKey)), "MacBook")
+	suite.ErrorIs(err, trusteddevice.ErrMFARequired)
+	suite.Empty(suite.deviceRepo.devices)
+}
+
+// 二要素認証から時間が経ったトークンでは端末を記憶できないこと
+func (suite *UserTrustedDeviceServiceTestSuite) TestRemember_Stale() {
+	auth := utils.NewAuthContext(utils.AMRPassword, utils.AMROTP)
+	auth.AuthTime = time.Now().Add(-10 * time.Minute)
+
+	_, _, err := suite.service.Remember(suite.accessToken(auth), "MacBook")
+	suite.ErrorIs(err, trusteddevice.ErrMFARequired)
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) TestRemember_InvalidToken() {
+	_, _, err := suite.service.Remember("invalid.token", "MacBook")
+	suite.ErrorIs(err, trusteddevice.ErrMFARequired)
+}
+
+// ----- IsTrusted のテスト -----
+
+func (suite *UserTrustedDeviceServiceTestSuite) TestIsTrusted_RecordsUsage() {
+	token, device := suite.remember()
+	suite.Nil(device.LastUsedAt())
+
+	suite.True(suite.service.IsTrusted(suite.objID, token))
+	suite.NotNil(suite.deviceRepo.devices[0].LastUsedAt(), "最終使用日時を記録すること")
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) TestIsTrusted_OtherUser() {
+	token, _ := suite.remember()
+
+	suite.False(suite.service.IsTrusted("00000000-0000-0000-0000-000000000000", token), "他のユーザーのログインには使えないこと")
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) TestIsTrusted_InvalidToken() {
+	suite.False(suite.service.IsTrusted(suite.objID, ""))
+	suite.False(suite.service.IsTrusted(suite.objID, "invalid.token"))
+
+	// 他の用途のトークンは使えないこと
+	other, err := utils.GenerateActionToken(utils.PurposeMFAChallenge, suite.objID, "", time.Minute)
+	suite.Require().NoError(err)
+	suite.False(suite.service.IsTrusted(suite.objID, other))
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) TestIsTrusted_Expired() {
+	token, device := suite.remember()
+	expired, err := entity.BuildTrustedDevice(device.ID(), device.UserObjID(), device.Name(), time.Now().Add(-time.Minute), device.CreatedAt(), nil)
+	suite.Require().NoError(err)
+	suite.deviceRepo.devices[0] = expired
+
+	suite.False(suite.service.IsTrusted(suite.objID, token))
+}
+
+// 取り消した端末のトークンは署名が有効でも使えないこと
+func (suite *UserTrustedDeviceServiceTestSuite) TestIsTrusted_Revoked() {
+	token, device := suite.remember()
+	suite.Require().NoError(suite.service.RevokeDevice(suite.objID, device.ID()))
+
+	suite.False(suite.service.IsTrusted(suite.objID, token))
+}
+
+// リポジトリのエラー時は信頼しないこと
+func (suite *UserTrustedDeviceServiceTestSuite) TestIsTrusted_RepositoryError() {
+	token, _ := suite.remember()
+	suite.deviceRepo.err = errors.New("db error")
+
+	suite.False(suite.service.IsTrusted(suite.objID, token))
+}
+
+// ----- ListDevices / RevokeDevice のテスト -----
+
+func (suite *UserTrustedDeviceServiceTestSuite) TestListDevices_ExcludesExpired() {
+	suite.remember()
+	_, device := suite.remember()
+	expired, err := entity.BuildTrustedDevice(device.ID(), device.UserObjID(), "Old", time.Now().Add(-time.Minute), device.CreatedAt(), nil)
+	suite.Require().NoError(err)
+	suite.deviceRepo.devices[1] = expired
+
+	devices, err := suite.service.ListDevices(suite.objID)
+	suite.NoError(err)
+	suite.Len(devices, 1)
+	suite.Equal("MacBook", devices[0].Name())
+}
+
+func (suite *UserTrustedDeviceServiceTestSuite) TestRevokeDevice_NotFound() {
+	_, device := suite.remember()
+
+	err := suite.service.RevokeDevice("00000000-0000-0000-0000-000000000000", device.ID())
+	suite.ErrorIs(err, trusteddevice.ErrDeviceNotFound, "他のユーザーの端末は取り消せないこと")
+	err = suite.service.RevokeDevice(suite.objID, "unknown")
+	suite.ErrorIs(err, trusteddevice.ErrDeviceNotFound)
+}
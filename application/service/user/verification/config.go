@@ -0,0 +1,46 @@
+package verification
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// Policy は、メールアドレス未確認のユーザーに対する制限の方針
+type Policy string
+
+const (
+	// PolicyNone: 制限しない
+	PolicyNone Policy = "none"
+	// PolicyLogin: 未確認のユーザーはログインできない
+	PolicyLogin Policy = "login"
+	// PolicySensitive: 未確認のユーザーは重要な操作（アカウント削除など）を行えない
+	PolicySensitive Policy = "sensitive"
+)
+
+type Config struct {
+	VerifyURL      string        // メール内リンクの遷移先（token クエリが付与される）
+	TokenTTL       time.Duration // 確認リンクの有効期限
+	ResendInterval time.Duration // 再送の最小間隔
+	Policy         Policy
+	Locale         mailer.Locale       // メール文面の言語
+	EmailKeys      value.EmailKeyRules // 再送の間隔を判定するキー（正規化したメールアドレス）の作り方
+}
+
+func NewConfigFromEnv() *Config {
+	policy := Policy(utils.GetEnvDefault("EMAIL_VERIFICATION_POLICY", string(PolicyNone)))
+	switch policy {
+	case PolicyNone, PolicyLogin, PolicySensitive:
+	default:
+		policy = PolicyNone
+	}
+	return &Config{
+		VerifyURL:      utils.GetEnvDefault("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email"),
+		TokenTTL:       utils.GetEnvDuration("EMAIL_VERIFICATION_TOKEN_TTL", 24*time.Hour),
+		ResendInterval: utils.GetEnvDuration("EMAIL_VERIFICATION_RESEND_INTERVAL", time.Minute),
+		Policy:         policy,
+		Locale:         mailer.LocaleFromEnv(),
+	}
+}
@@ -0,0 +1,33 @@
+package verification
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// verificationEmailPublisher は、ユーザー登録イベントを受けて確認メールを送信する Publisher の実装
+type verificationEmailPublisher struct {
+	userRepository      repository.UserRepository
+	verificationService UserVerificationService
+}
+
+// NewVerificationEmailPublisher は、ユーザー登録時に確認メールを送信する Publisher を返します。
+func NewVerificationEmailPublisher(userRepository repository.UserRepository, verificationService UserVerificationService) event.Publisher {
+	return &verificationEmailPublisher{
+		userRepository:      userRepository,
+		verificationService: verificationService,
+	}
+}
+
+func (p *verificationEmailPublisher) Publish(e *event.Event) error {
+	if e.Type != event.TypeUserRegistered {
+		return nil
+	}
+	user, err := p.userRepository.GetUserByObjID(e.AggregateID)
+	if err != nil {
+		return errs.NewServiceError("failed to get user")
+	}
+	// 確認済みの場合は送信しないため、再配信されても重複して送られることはない
+	return p.verificationService.SendVerificationEmail(user)
+}
@@ -0,0 +1,101 @@
+package verification_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/verification"
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type VerificationEmailPublisherTestSuite struct {
+	suite.Suite
+	mockRepo  *mockUserRepository
+	outbox    *mailer.MemoryOutbox
+	publisher event.Publisher
+	testUser  *entity.User
+}
+
+func TestVerificationEmailPublisherTestSuite(t *testing.T) {
+	suite.Run(t, new(VerificationEmailPublisherTestSuite))
+}
+
+func (suite *VerificationEmailPublisherTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *VerificationEmailPublisherTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *VerificationEmailPublisherTestSuite) SetupTest() {
+	suite.mockRepo = new(mockUserRepository)
+	suite.outbox = mailer.NewMemoryOutbox()
+	service := verification.NewUserVerificationService(suite.mockRepo, newMemoryEmailVerificationRepository(), suite.outbox, utils.DefaultTokenSigner(), &verification.Config{
+		VerifyURL:      "https://app.example.com/verify-email",
+		TokenTTL:       time.Hour,
+		ResendInterval: time.Minute,
+		Locale:         mailer.LocaleJa,
+	})
+	suite.publisher = verification.NewVerificationEmailPublisher(suite.mockRepo, service)
+
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	testUser, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	suite.testUser = testUser
+}
+
+func (suite *VerificationEmailPublisherTestSuite) newEvent(eventType event.Type) *event.Event {
+	e, err := event.NewEvent(eventType, suite.testUser.ObjID().Value(), event.UserPayload{UID: suite.testUser.ObjID().Value()})
+	suite.Require().NoError(err)
+	return e
+}
+
+// ユーザー登録イベントで確認メールが送信されること
+func (suite *VerificationEmailPublisherTestSuite) TestPublish_UserRegistered() {
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+
+	err := suite.publisher.Publish(suite.newEvent(event.TypeUserRegistered))
+	suite.NoError(err)
+	suite.NotNil(suite.outbox.LastTo("test@example.com"))
+}
+
+// 確認済みのユーザーには送信しないこと（再配信時の重複送信防止）
+func (suite *VerificationEmailPublisherTestSuite) TestPublish_AlreadyVerified() {
+	verifiedAt, _ := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(suite.testUser.VerifyEmail(verifiedAt))
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+
+	err := suite.publisher.Publish(suite.newEvent(event.TypeUserRegistered))
+	suite.NoError(err)
+	suite.Empty(suite.outbox.Messages())
+}
+
+// ユーザーを取得できない場合はエラーを返し、再試行させること
+func (suite *VerificationEmailPublisherTestSuite) TestPublish_UserNotFound() {
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(nil, errs.NewInfraError("not found"))
+
+	err := suite.publisher.Publish(suite.newEvent(event.TypeUserRegistered))
+	suite.Error(err)
+	suite.Empty(suite.outbox.Messages())
+}
+
+// ユーザー登録以外のイベントは無視すること
+func (suite *VerificationEmailPublisherTestSuite) TestPublish_OtherEvent() {
+	err := suite.publisher.Publish(suite.newEvent(event.TypeUserUpdated))
+	suite.NoError(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByObjID")
+	suite.Empty(suite.outbox.Messages())
+}
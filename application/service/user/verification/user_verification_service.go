@@ -0,0 +1,146 @@
+package verification
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+var (
+	ErrInvalidVerificationToken = errs.NewServiceError("invalid verification token")
+	ErrResendThrottled          = errs.NewServiceError("verification email resend is throttled")
+)
+
+type UserVerificationService interface {
+	// SendVerificationEmail: 確認メールを送信
+	SendVerificationEmail(user *entity.User) error
+	// VerifyEmail: 確認トークンを検証し、メールアドレスを確認済みにする
+	VerifyEmail(token string) (*entity.User, error)
+	// ResendVerificationEmail: 確認メールを再送（一定間隔でスロットリングする）
+	ResendVerificationEmail(email string) error
+}
+
+// userVerificationService は UserVerificationService の実装
+type userVerificationService struct {
+	userRepository              repository.UserRepository
+	emailVerificationRepository repository.EmailVerificationRepository // メールアドレスごとの最終送信日時
+	mailer                      mailer.Mailer
+	tokens                      *utils.TokenSigner
+	config                      *Config
+}
+
+// NewUserVerificationService は UserVerificationService のインスタンスを作成
+func NewUserVerificationService(userRepository repository.UserRepository, emailVerificationRepository repository.EmailVerificationRepository, mailer mailer.Mailer, tokens *utils.TokenSigner, config *Config) UserVerificationService {
+	return &userVerificationService{
+		userRepository:              userRepository,
+		emailVerificationRepository: emailVerificationRepository,
+		mailer:                      mailer,
+		tokens:                      tokens,
+		config:                      config,
+	}
+}
+
+// SendVerificationEmail は署名付きの確認リンクを含むメールを送信
+func (s *userVerificationService) SendVerificationEmail(user *entity.User) error {
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	token, err := s.tokens.GenerateActionToken(utils.PurposeEmailVerification, user.ObjID().Value(), user.Email().Value(), s.config.TokenTTL)
+	if err != nil {
+		return errs.NewServiceError("failed to generate verification token")
+	}
+	link, err := utils.BuildTokenLink(s.config.VerifyURL, token)
+	if err != nil {
+		return errs.NewServiceError("failed to build verification link")
+	}
+
+	msg, err := mailer.Render(mailer.TemplateEmailVerification, s.config.Locale, user.Email().Value(), map[string]any{
+		"Username":  user.Username().Value(),
+		"Link":      link,
+		"ExpiresIn": s.config.TokenTTL,
+	})
+	if err != nil {
+		return errs.NewServiceError("failed to render verification email")
+	}
+	err = s.mailer.Send(msg)
+	if err != nil {
+		return errs.NewServiceError("failed to send verification email")
+	}
+
+	// 再送の間隔の判定に使う送信日時を記録する（記録に失敗しても送信済みのため成功とする）
+	if err := s.emailVerificationRepository.RecordSend(s.config.EmailKeys.Key(user.Email()), time.Now()); err != nil {
+		logger.Error(err.Error())
+	}
+	return nil
+}
+
+// VerifyEmail は確認トークンを検証し、メールアドレスを確認済みにする
+func (s *userVerificationService) VerifyEmail(token string) (*entity.User, error) {
+	claims, err := s.tokens.ValidateActionToken(utils.PurposeEmailVerification, token)
+	if err != nil {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	user, err := s.userRepository.GetUserByObjID(claims.ObjID)
+	if err != nil {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	// トークン発行後にメールアドレスが変更されている場合は無効
+	if user.Email().Value() != claims.Email {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	// 既に確認済みの場合は何もしない（リンクの再クリックを許容する）
+	if user.IsEmailVerified() {
+		return user, nil
+	}
+
+	verifiedAt, err := timeobj.NewTimeObj(time.Now())
+	if err != nil {
+		return nil, errs.NewServiceError("failed to create verified time")
+	}
+	if err := user.VerifyEmail(verifiedAt); err != nil {
+		return nil, errs.NewServiceError("failed to verify email")
+	}
+
+	updatedUser, err := s.userRepository.UpdateUser(user)
+	if err != nil {
+		return nil, errs.NewServiceError("failed to update user in repository")
+	}
+	return updatedUser, nil
+}
+
+// ResendVerificationEmail は確認メールを再送する
+// メールアドレスの存在有無を推測されないよう、未登録・確認済みの場合もエラーを返さない
+func (s *userVerificationService) ResendVerificationEmail(email string) error {
+	emailValue, err := value.NewUserEmail(email)
+	if err != nil {
+		logger.Info("verification email resend requested for invalid email")
+		return nil
+	}
+
+	// 未登録のメールアドレスも同じく記録し、応答から登録の有無を推測されないようにする
+	claimed, err := s.emailVerificationRepository.ClaimSend(s.config.EmailKeys.Key(emailValue), time.Now(), s.config.ResendInterval)
+	if err != nil {
+		return errs.NewServiceError("failed to record verification email resend")
+	}
+	if !claimed {
+		return ErrResendThrottled
+	}
+
+	user, err := s.userRepository.GetUserByEmail(email)
+	if err != nil {
+		logger.Info("verification email resend requested for unknown email")
+		return nil
+	}
+	return s.SendVerificationEmail(user)
+}
@@ -0,0 +1,274 @@
+package verification_test
+
+import (
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/verification"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モックリポジトリ ---
+
+type mockUserRepository struct {
+	mock.Mock
+}
+
+func (m *mockUserRepository) CreateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) GetUserByObjID(objID string) (*entity.User, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) UpdateUser(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	args := m.Called(filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *mockUserRepository) DeleteUser(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+// memoryEmailVerificationRepository は、送信日時をメモリに保持する EmailVerificationRepository
+type memoryEmailVerificationRepository struct {
+	sentAt map[string]time.Time
+}
+
+func newMemoryEmailVerificationRepository() *memoryEmailVerificationRepository {
+	return &memoryEmailVerificationRepository{sentAt: make(map[string]time.Time)}
+}
+
+func (r *memoryEmailVerificationRepository) RecordSend(emailKey string, sentAt time.Time) error {
+	r.sentAt[emailKey] = sentAt
+	return nil
+}
+
+func (r *memoryEmailVerificationRepository) ClaimSend(emailKey string, sentAt time.Time, interval time.Duration) (bool, error) {
+	if last, ok := r.sentAt[emailKey]; ok && sentAt.Sub(last) < interval {
+		return false, nil
+	}
+	r.sentAt[emailKey] = sentAt
+	return true, nil
+}
+
+// --- 送信に失敗する Mailer ---
+
+type failingMailer struct{}
+
+func (m *failingMailer) Send(msg *mailer.Message) error {
+	return errs.NewInfraError("smtp error")
+}
+
+// extractToken はメール本文のリンクから token クエリを取り出す
+func extractToken(body string) string {
+	link := regexp.MustCompile(`https?://\S+`).FindString(body)
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("token")
+}
+
+// --- テストスイート ---
+
+type UserVerificationServiceTestSuite struct {
+	suite.Suite
+	mockRepo *mockUserRepository
+	sendRepo *memoryEmailVerificationRepository
+	outbox   *mailer.MemoryOutbox
+	config   *verification.Config
+	service  verification.UserVerificationService
+	testUser *entity.User
+}
+
+func TestUserVerificationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserVerificationServiceTestSuite))
+}
+
+func (suite *UserVerificationServiceTestSuite) SetupSuite() {
+	err := os.Setenv("JWT_SECRET_KEY", "mysecret")
+	suite.Require().NoError(err, "環境変数の設定に失敗してはいけない")
+}
+
+func (suite *UserVerificationServiceTestSuite) TearDownSuite() {
+	err := os.Unsetenv("JWT_SECRET_KEY")
+	suite.Require().NoError(err, "環境変数の後片付けに失敗してはいけない")
+}
+
+func (suite *UserVerificationServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mockUserRepository)
+	suite.sendRepo = newMemoryEmailVerificationRepository()
+	suite.outbox = mailer.NewMemoryOutbox()
+	suite.config = &verification.Config{
+		VerifyURL:      "https://app.example.com/verify-email",
+		TokenTTL:       time.Hour,
+		ResendInterval: time.Minute,
+		Policy:         verification.PolicyNone,
+		Locale:         mailer.LocaleJa,
+	}
+	suite.service = verification.NewUserVerificationService(suite.mockRepo, suite.sendRepo, suite.outbox, utils.DefaultTokenSigner(), suite.config)
+
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	password := value.FromHashed("hashed")
+	testUser, err := entity.NewUser(email, password, username)
+	suite.Require().NoError(err)
+	suite.testUser = testUser
+}
+
+// 確認メールに署名付きリンクが含まれること
+func (suite *UserVerificationServiceTestSuite) TestSendVerificationEmail_Success() {
+	err := suite.service.SendVerificationEmail(suite.testUser)
+	suite.NoError(err)
+	suite.Require().Len(suite.outbox.Messages(), 1)
+
+	msg := suite.outbox.LastTo("test@example.com")
+	suite.Require().NotNil(msg)
+	suite.Equal("メールアドレスの確認", msg.Subject)
+	suite.Contains(msg.Body, "https://app.example.com/verify-email?token=")
+	suite.Contains(msg.HTMLBody, "https://app.example.com/verify-email?token=")
+
+	claims, err := utils.ValidateActionToken(utils.PurposeEmailVerification, extractToken(msg.Body))
+	suite.NoError(err, "リンクのトークンが検証できること")
+	suite.Equal(suite.testUser.ObjID().Value(), claims.ObjID)
+	suite.Equal("test@example.com", claims.Email)
+}
+
+// 送信に失敗した場合はエラーが返ること
+func (suite *UserVerificationServiceTestSuite) TestSendVerificationEmail_MailerError() {
+	service := verification.NewUserVerificationService(suite.mockRepo, suite.sendRepo, &failingMailer{}, utils.DefaultTokenSigner(), suite.config)
+	err := service.SendVerificationEmail(suite.testUser)
+	suite.Error(err)
+}
+
+// 確認トークンでメールアドレスが確認済みになること
+func (suite *UserVerificationServiceTestSuite) TestVerifyEmail_Success() {
+	token, err := utils.GenerateActionToken(utils.PurposeEmailVerification, suite.testUser.ObjID().Value(), "test@example.com", time.Hour)
+	suite.Require().NoError(err)
+
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+	suite.mockRepo.On("UpdateUser", mock.MatchedBy(func(u *entity.User) bool {
+		return u.IsEmailVerified()
+	})).Return(suite.testUser, nil)
+
+	user, err := suite.service.VerifyEmail(token)
+	suite.NoError(err)
+	suite.NotNil(user)
+	suite.True(user.IsEmailVerified())
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+// 既に確認済みの場合は更新せずに成功すること
+func (suite *UserVerificationServiceTestSuite) TestVerifyEmail_AlreadyVerified() {
+	token, err := utils.GenerateActionToken(utils.PurposeEmailVerification, suite.testUser.ObjID().Value(), "test@example.com", time.Hour)
+	suite.Require().NoError(err)
+
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything).Return(suite.testUser, nil).Once()
+
+	_, err = suite.service.VerifyEmail(token)
+	suite.NoError(err)
+	user, err := suite.service.VerifyEmail(token)
+	suite.NoError(err)
+	suite.True(user.IsEmailVerified())
+	suite.mockRepo.AssertNumberOfCalls(suite.T(), "UpdateUser", 1)
+}
+
+// 不正なトークンの場合はエラーが返ること
+func (suite *UserVerificationServiceTestSuite) TestVerifyEmail_InvalidToken() {
+	user, err := suite.service.VerifyEmail("invalid.token.value")
+	suite.ErrorIs(err, verification.ErrInvalidVerificationToken)
+	suite.Nil(user)
+}
+
+// トークン発行後にメールアドレスが変わっている場合はエラーが返ること
+func (suite *UserVerificationServiceTestSuite) TestVerifyEmail_EmailMismatch() {
+	token, err := utils.GenerateActionToken(utils.PurposeEmailVerification, suite.testUser.ObjID().Value(), "old@example.com", time.Hour)
+	suite.Require().NoError(err)
+
+	suite.mockRepo.On("GetUserByObjID", suite.testUser.ObjID().Value()).Return(suite.testUser, nil)
+
+	user, err := suite.service.VerifyEmail(token)
+	suite.ErrorIs(err, verification.ErrInvalidVerificationToken)
+	suite.Nil(user)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything)
+}
+
+// 再送は一定間隔内ではスロットリングされること
+func (suite *UserVerificationServiceTestSuite) TestResendVerificationEmail_Throttled() {
+	suite.mockRepo.On("GetUserByEmail", "test@example.com").Return(suite.testUser, nil)
+
+	err := suite.service.ResendVerificationEmail("test@example.com")
+	suite.NoError(err)
+	suite.Len(suite.outbox.Messages(), 1)
+
+	err = suite.service.ResendVerificationEmail("Test@Example.com")
+	suite.ErrorIs(err, verification.ErrResendThrottled)
+	suite.Len(suite.outbox.Messages(), 1, "スロットリング中は送信されないこと")
+
+	// 送信日時はリポジトリに保存し、別のインスタンスでもスロットリングされること
+	other := verification.NewUserVerificationService(suite.mockRepo, suite.sendRepo, suite.outbox, utils.DefaultTokenSigner(), suite.config)
+	err = other.ResendVerificationEmail("test@example.com")
+	suite.ErrorIs(err, verification.ErrResendThrottled)
+	suite.Len(suite.outbox.Messages(), 1)
+}
+
+// 登録時の確認メールの送信から一定間隔内の再送はスロットリングされること
+func (suite *UserVerificationServiceTestSuite) TestResendVerificationEmail_ThrottledAfterSend() {
+	suite.Require().NoError(suite.service.SendVerificationEmail(suite.testUser))
+
+	err := suite.service.ResendVerificationEmail("test@example.com")
+	suite.ErrorIs(err, verification.ErrResendThrottled)
+	suite.Len(suite.outbox.Messages(), 1)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByEmail", mock.Anything)
+}
+
+// 未登録のメールアドレスでもエラーを返さないこと
+func (suite *UserVerificationServiceTestSuite) TestResendVerificationEmail_UnknownEmail() {
+	suite.mockRepo.On("GetUserByEmail", "unknown@example.com").Return(nil, errs.NewInfraError("not found"))
+
+	err := suite.service.ResendVerificationEmail("unknown@example.com")
+	suite.NoError(err)
+	suite.Empty(suite.outbox.Messages())
+}
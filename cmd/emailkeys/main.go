@@ -0,0 +1,41 @@
+// emailkeys は、ユーザーのメールアドレスの検索キー（email_key）をアプリケーションと同じ正規化で作り直すコマンドです。
+//
+//	go run ./cmd/emailkeys
+//
+// マイグレーション 20261019143207 の適用後（SQL では IDN の Punycode 変換を再現できないため）と、
+// EMAIL_PROVIDER_RULES を切り替えた後に実行します。データベースの接続先と EMAIL_PROVIDER_RULES は
+// アプリケーションと同じ環境変数から読み込みます。正規化できないユーザーや、キーが重複するユーザーがある場合は
+// それらを一覧に出力して終了コード 1 で終了します（ほかのユーザーは更新されます）。
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+func main() {
+	if utils.GetEnvDefault("ENV", "development") == "development" {
+		_ = godotenv.Load(".env.development")
+	}
+
+	db, err := database.NewDBInstance(database.InstancePostgres)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	emailKeys := value.EmailKeyRules{ProviderRules: utils.GetEnvBool("EMAIL_PROVIDER_RULES", false)}
+	updated, err := repository.BackfillUserEmailKeys(db, emailKeys)
+	fmt.Printf("%d users updated\n", updated)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,47 @@
+// policycheck は、ポリシーのファイルを検証し、フィクスチャーを実行するコマンドです。
+//
+//	go run ./cmd/policycheck [-dir policies] [file ...]
+//
+// ファイルを指定した場合はそのファイルのみ、省略した場合は -dir のディレクトリ直下の *.yaml / *.yml を読み込みます。
+// 不正なポリシーがある場合、または期待と異なる判定になったフィクスチャーがある場合は終了コード 1 で終了します。
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/goda6565/nexus-user-auth/pkg/policy"
+)
+
+func main() {
+	dir := flag.String("dir", "policies", "ポリシーのファイルを置くディレクトリ")
+	flag.Parse()
+
+	var bundle *policy.Bundle
+	var err error
+	if flag.NArg() > 0 {
+		bundle, err = policy.LoadFiles(flag.Args()...)
+	} else {
+		bundle, err = policy.LoadDir(*dir)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	engine, err := policy.NewEngine(bundle.Policies)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	failures := engine.RunFixtures(bundle.Fixtures)
+	for _, failure := range failures {
+		fmt.Fprintln(os.Stderr, "FAIL", failure)
+	}
+	fmt.Printf("%d policies, %d fixtures, %d failures\n", len(bundle.Policies), len(bundle.Fixtures), len(failures))
+	if len(failures) > 0 {
+		os.Exit(1)
+	}
+}
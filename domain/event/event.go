@@ -0,0 +1,93 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// Type はドメインイベントの種類
+type Type string
+
+const (
+	// TypeAll: 購読時にすべての種類のイベントを対象とする場合に指定する
+	TypeAll Type = "*"
+
+	TypeUserRegistered Type = "user.registered"
+	TypeUserUpdated    Type = "user.updated"
+	TypeUserDeleted    Type = "user.deleted"
+
+	// TypeServiceAccountCreated: サービスアカウントを作成した（ペイロードは UserPayload）
+	TypeServiceAccountCreated Type = "service_account.created"
+
+	// TypeUserNewDeviceLogin: 最近ログインに成功していない端末・接続元からログインした
+	TypeUserNewDeviceLogin Type = "user.login.new_device"
+)
+
+// Event は、集約の変更とともに記録され、外部に配信されるドメインイベント
+type Event struct {
+	ID          string          `json:"id"`
+	TenantID    string          `json:"tenantId,omitempty"` // イベントが発生したテナント（記録時に設定される）
+	Type        Type            `json:"type"`
+	AggregateID string          `json:"aggregateId"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurredAt"`
+}
+
+// Publisher はイベントの配信先
+// 配信は少なくとも1回（at-least-once）行われるため、同じ ID のイベントを重複して受け取る可能性がある
+type Publisher interface {
+	// Publish: イベントを配信
+	Publish(event *Event) error
+}
+
+// UserPayload はユーザー関連イベントのペイロード
+type UserPayload struct {
+	UID      string `json:"uid"`
+	Kind     string `json:"kind,omitempty"` // アカウントの種類（user, service_account）
+	Email    string `json:"email,omitempty"`
+	Username string `json:"username,omitempty"`
+	Status   string `json:"status,omitempty"` // アカウントの状態（pending, active, suspended, deactivated）
+}
+
+// LoginPayload はログイン関連イベントのペイロード
+type LoginPayload struct {
+	UID          string    `json:"uid"`
+	LoginEventID string    `json:"loginEventId"`
+	IPAddress    string    `json:"ipAddress,omitempty"`
+	UserAgent    string    `json:"userAgent,omitempty"`
+	Device       string    `json:"device,omitempty"` // ブラウザと OS の種類（"Chrome on macOS" など）
+	OccurredAt   time.Time `json:"occurredAt"`
+}
+
+// NewEvent はペイロードを JSON にしてイベントを生成する
+func NewEvent(eventType Type, aggregateID string, payload any) (*Event, error) {
+	if eventType == "" || eventType == TypeAll {
+		return nil, errs.NewDomainError("イベントの種類が不正です")
+	}
+	if aggregateID == "" {
+		return nil, errs.NewDomainError("集約IDが指定されていません")
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errs.NewDomainError("イベントのペイロードを変換できません")
+	}
+	return &Event{
+		ID:          uuid.New().String(),
+		Type:        eventType,
+		AggregateID: aggregateID,
+		Payload:     data,
+		OccurredAt:  time.Now(),
+	}, nil
+}
+
+// DecodePayload はペイロードを v に読み込む
+func (e *Event) DecodePayload(v any) error {
+	if err := json.Unmarshal(e.Payload, v); err != nil {
+		return errs.NewDomainError("イベントのペイロードを読み込めません")
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEvent(t *testing.T) {
+	e, err := NewEvent(TypeUserRegistered, "uid-1", UserPayload{UID: "uid-1", Email: "test@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, TypeUserRegistered, e.Type)
+	assert.Equal(t, "uid-1", e.AggregateID)
+	assert.False(t, e.OccurredAt.IsZero())
+	_, err = uuid.Parse(e.ID)
+	assert.NoError(t, err, "ID は UUID であること")
+
+	var payload UserPayload
+	assert.NoError(t, e.DecodePayload(&payload))
+	assert.Equal(t, "test@example.com", payload.Email)
+}
+
+func TestNewEvent_Invalid(t *testing.T) {
+	_, err := NewEvent("", "uid-1", nil)
+	assert.Error(t, err, "種類が空の場合はエラー")
+
+	_, err = NewEvent(TypeAll, "uid-1", nil)
+	assert.Error(t, err, "TypeAll はイベントの種類として使えない")
+
+	_, err = NewEvent(TypeUserDeleted, "", nil)
+	assert.Error(t, err, "集約IDが空の場合はエラー")
+
+	_, err = NewEvent(TypeUserDeleted, "uid-1", make(chan int))
+	assert.Error(t, err, "JSON に変換できないペイロードはエラー")
+}
+
+func TestDecodePayload_Invalid(t *testing.T) {
+	e := &Event{Payload: []byte("not json")}
+	var payload UserPayload
+	assert.Error(t, e.DecodePayload(&payload))
+}
@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// InvitationStatus は組織への招待の状態
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"  // 回答待ち
+	InvitationAccepted InvitationStatus = "accepted" // 承諾済み（メンバーとして追加した）
+	InvitationDeclined InvitationStatus = "declined" // 辞退
+	InvitationRevoked  InvitationStatus = "revoked"  // 組織の管理者による取り消し
+)
+
+// Invitation は、メールアドレス宛ての組織への招待
+type Invitation struct {
+	id           string
+	orgObjID     *value.OrgObjID
+	email        *userValue.UserEmail
+	role         *value.MemberRole
+	inviterObjID *userValue.UserObjID
+	status       InvitationStatus
+	expiresAt    time.Time
+	createdAt    time.Time
+	respondedAt  *time.Time
+}
+
+func (ins *Invitation) ID() string {
+	return ins.id
+}
+
+func (ins *Invitation) OrgObjID() *value.OrgObjID {
+	return ins.orgObjID
+}
+
+// Email: 招待したメールアドレス（このアドレスのユーザーのみ承諾できる）
+func (ins *Invitation) Email() *userValue.UserEmail {
+	return ins.email
+}
+
+// Role: 承諾したときに与えるロール
+func (ins *Invitation) Role() *value.MemberRole {
+	return ins.role
+}
+
+func (ins *Invitation) InviterObjID() *userValue.UserObjID {
+	return ins.inviterObjID
+}
+
+func (ins *Invitation) Status() InvitationStatus {
+	return ins.status
+}
+
+func (ins *Invitation) ExpiresAt() time.Time {
+	return ins.expiresAt
+}
+
+func (ins *Invitation) CreatedAt() time.Time {
+	return ins.createdAt
+}
+
+func (ins *Invitation) RespondedAt() *time.Time {
+	return ins.respondedAt
+}
+
+// IsExpired: 回答期限を過ぎているかどうか
+func (ins *Invitation) IsExpired(now time.Time) bool {
+	return now.After(ins.expiresAt)
+}
+
+// Accept: 招待を承諾する
+func (ins *Invitation) Accept(now time.Time) error {
+	if err := ins.checkRespondable(now); err != nil {
+		return err
+	}
+	ins.status = InvitationAccepted
+	ins.respondedAt = &now
+	return nil
+}
+
+// Decline: 招待を辞退する
+func (ins *Invitation) Decline(now time.Time) error {
+	if err := ins.checkRespondable(now); err != nil {
+		return err
+	}
+	ins.status = InvitationDeclined
+	ins.respondedAt = &now
+	return nil
+}
+
+// Revoke: 回答待ちの招待を取り消す
+func (ins *Invitation) Revoke(now time.Time) error {
+	if ins.status != InvitationPending {
+		return errs.NewDomainError("回答待ちではない招待は取り消せません。")
+	}
+	ins.status = InvitationRevoked
+	ins.respondedAt = &now
+	return nil
+}
+
+func (ins *Invitation) checkRespondable(now time.Time) error {
+	if ins.status != InvitationPending {
+		return errs.NewDomainError("回答待ちではない招待には回答できません。")
+	}
+	if ins.IsExpired(now) {
+		return errs.NewDomainError("招待の回答期限が切れています。")
+	}
+	return nil
+}
+
+func NewInvitation(orgObjID *value.OrgObjID, email *userValue.UserEmail, role *value.MemberRole, inviterObjID *userValue.UserObjID, expiresAt time.Time) (*Invitation, error) {
+	if orgObjID == nil || email == nil || role == nil || inviterObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	return &Invitation{
+		id:           id.String(),
+		orgObjID:     orgObjID,
+		email:        email,
+		role:         role,
+		inviterObjID: inviterObjID,
+		status:       InvitationPending,
+		expiresAt:    expiresAt,
+		createdAt:    time.Now(),
+		respondedAt:  nil,
+	}, nil
+}
+
+func BuildInvitation(id string, orgObjID *value.OrgObjID, email *userValue.UserEmail, role *value.MemberRole, inviterObjID *userValue.UserObjID, status InvitationStatus, expiresAt time.Time, createdAt time.Time, respondedAt *time.Time) (*Invitation, error) {
+	if id == "" || orgObjID == nil || email == nil || role == nil || inviterObjID == nil {
+		return nil, errs.NewDomainError("招待の再構築に必要な値が不足しています。")
+	}
+	switch status {
+	case InvitationPending, InvitationAccepted, InvitationDeclined, InvitationRevoked:
+	default:
+		return nil, errs.NewDomainError("無効な招待の状態です。")
+	}
+	return &Invitation{
+		id:           id,
+		orgObjID:     orgObjID,
+		email:        email,
+		role:         role,
+		inviterObjID: inviterObjID,
+		status:       status,
+		expiresAt:    expiresAt,
+		createdAt:    createdAt,
+		respondedAt:  respondedAt,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+func dummyInvitation(t *testing.T, expiresAt time.Time) *Invitation {
+	t.Helper()
+	org, err := NewOrganization(dummyOrgName(t, "Acme"), dummyOrgSlug(t))
+	require.NoError(t, err)
+	email, err := userValue.NewUserEmail("invitee@example.com")
+	require.NoError(t, err)
+	invitation, err := NewInvitation(org.ObjID(), email, dummyMemberRole(t, value.MemberRoleMember), dummyUserObjID(t), expiresAt)
+	require.NoError(t, err)
+	return invitation
+}
+
+func TestNewInvitation(t *testing.T) {
+	invitation := dummyInvitation(t, time.Now().Add(time.Hour))
+	assert.NotEmpty(t, invitation.ID(), "IDが採番されていること")
+	assert.Equal(t, "invitee@example.com", invitation.Email().Value())
+	assert.Equal(t, value.MemberRoleMember, invitation.Role().Value())
+	assert.Equal(t, InvitationPending, invitation.Status(), "回答待ちの状態で生成されること")
+	assert.Nil(t, invitation.RespondedAt())
+
+	_, err := NewInvitation(nil, invitation.Email(), invitation.Role(), invitation.InviterObjID(), time.Now())
+	assert.Error(t, err)
+}
+
+func TestInvitationAccept(t *testing.T) {
+	invitation := dummyInvitation(t, time.Now().Add(time.Hour))
+	assert.NoError(t, invitation.Accept(time.Now()))
+	assert.Equal(t, InvitationAccepted, invitation.Status())
+	assert.NotNil(t, invitation.RespondedAt())
+
+	assert.Error(t, invitation.Accept(time.Now()), "回答済みの招待は承諾できないこと")
+	assert.Error(t, invitation.Decline(time.Now()))
+	assert.Error(t, invitation.Revoke(time.Now()), "承諾済みの招待は取り消せないこと")
+}
+
+func TestInvitationDecline(t *testing.T) {
+	invitation := dummyInvitation(t, time.Now().Add(time.Hour))
+	assert.NoError(t, invitation.Decline(time.Now()))
+	assert.Equal(t, InvitationDeclined, invitation.Status())
+	assert.Error(t, invitation.Accept(time.Now()))
+}
+
+func TestInvitationExpired(t *testing.T) {
+	invitation := dummyInvitation(t, time.Now().Add(-time.Minute))
+	assert.True(t, invitation.IsExpired(time.Now()))
+	assert.Error(t, invitation.Accept(time.Now()), "期限切れの招待は承諾できないこと")
+	assert.Error(t, invitation.Decline(time.Now()))
+	assert.NoError(t, invitation.Revoke(time.Now()), "期限切れでも回答待ちの招待は取り消せること")
+	assert.Equal(t, InvitationRevoked, invitation.Status())
+}
+
+func TestBuildInvitation(t *testing.T) {
+	source := dummyInvitation(t, time.Now().Add(time.Hour))
+	respondedAt := time.Now()
+	invitation, err := BuildInvitation(source.ID(), source.OrgObjID(), source.Email(), source.Role(), source.InviterObjID(), InvitationAccepted, source.ExpiresAt(), source.CreatedAt(), &respondedAt)
+	assert.NoError(t, err)
+	assert.Equal(t, InvitationAccepted, invitation.Status())
+
+	_, err = BuildInvitation(source.ID(), source.OrgObjID(), source.Email(), source.Role(), source.InviterObjID(), "expired", source.ExpiresAt(), source.CreatedAt(), nil)
+	assert.Error(t, err, "不正な状態はエラーになること")
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// Membership は、ユーザーの組織への所属と、組織でのロール
+type Membership struct {
+	orgObjID  *value.OrgObjID
+	userObjID *userValue.UserObjID
+	role      *value.MemberRole
+	joinedAt  time.Time
+}
+
+func (ins *Membership) OrgObjID() *value.OrgObjID {
+	return ins.orgObjID
+}
+
+func (ins *Membership) UserObjID() *userValue.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *Membership) Role() *value.MemberRole {
+	return ins.role
+}
+
+func (ins *Membership) JoinedAt() time.Time {
+	return ins.joinedAt
+}
+
+// ChangeRole: 組織でのロールを変更する
+func (ins *Membership) ChangeRole(role *value.MemberRole) error {
+	if role == nil {
+		return errs.NewDomainError("引数でnilが指定されました。")
+	}
+	ins.role = role
+	return nil
+}
+
+func NewMembership(orgObjID *value.OrgObjID, userObjID *userValue.UserObjID, role *value.MemberRole) (*Membership, error) {
+	if orgObjID == nil || userObjID == nil || role == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	return &Membership{
+		orgObjID:  orgObjID,
+		userObjID: userObjID,
+		role:      role,
+		joinedAt:  time.Now(),
+	}, nil
+}
+
+func BuildMembership(orgObjID *value.OrgObjID, userObjID *userValue.UserObjID, role *value.MemberRole, joinedAt time.Time) (*Membership, error) {
+	if orgObjID == nil || userObjID == nil || role == nil {
+		return nil, errs.NewDomainError("メンバーの再構築に必要な値が不足しています。")
+	}
+	return &Membership{
+		orgObjID:  orgObjID,
+		userObjID: userObjID,
+		role:      role,
+		joinedAt:  joinedAt,
+	}, nil
+}
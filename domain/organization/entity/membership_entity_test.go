@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+)
+
+func TestNewMembership(t *testing.T) {
+	org, err := NewOrganization(dummyOrgName(t, "Acme"), dummyOrgSlug(t))
+	require.NoError(t, err)
+
+	membership, err := NewMembership(org.ObjID(), dummyUserObjID(t), dummyMemberRole(t, value.MemberRoleOwner))
+	assert.NoError(t, err)
+	assert.Equal(t, org.ObjID(), membership.OrgObjID())
+	assert.True(t, membership.Role().IsOwner())
+	assert.False(t, membership.JoinedAt().IsZero())
+
+	_, err = NewMembership(org.ObjID(), nil, dummyMemberRole(t, value.MemberRoleMember))
+	assert.Error(t, err)
+}
+
+func TestMembershipChangeRole(t *testing.T) {
+	org, err := NewOrganization(dummyOrgName(t, "Acme"), dummyOrgSlug(t))
+	require.NoError(t, err)
+	membership, err := BuildMembership(org.ObjID(), dummyUserObjID(t), dummyMemberRole(t, value.MemberRoleMember), time.Now())
+	require.NoError(t, err)
+
+	assert.NoError(t, membership.ChangeRole(dummyMemberRole(t, value.MemberRoleAdmin)))
+	assert.Equal(t, value.MemberRoleAdmin, membership.Role().Value())
+	assert.Error(t, membership.ChangeRole(nil))
+}
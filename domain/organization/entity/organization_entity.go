@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// Organization は、ユーザーが所属する組織（B2B の顧客企業など）
+type Organization struct {
+	objID     *value.OrgObjID
+	name      *value.OrgName
+	slug      *value.OrgSlug
+	createdAt time.Time
+}
+
+func (ins *Organization) ObjID() *value.OrgObjID {
+	return ins.objID
+}
+
+func (ins *Organization) Name() *value.OrgName {
+	return ins.name
+}
+
+func (ins *Organization) Slug() *value.OrgSlug {
+	return ins.slug
+}
+
+func (ins *Organization) CreatedAt() time.Time {
+	return ins.createdAt
+}
+
+// Rename: 組織名を変更する（スラッグは変更しない）
+func (ins *Organization) Rename(name *value.OrgName) error {
+	if name == nil {
+		return errs.NewDomainError("引数でnilが指定されました。")
+	}
+	ins.name = name
+	return nil
+}
+
+func NewOrganization(name *value.OrgName, slug *value.OrgSlug) (*Organization, error) {
+	if name == nil || slug == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	objID, err := value.NewOrgObjID(id.String())
+	if err != nil {
+		return nil, err
+	}
+	return &Organization{
+		objID:     objID,
+		name:      name,
+		slug:      slug,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func BuildOrganization(objID *value.OrgObjID, name *value.OrgName, slug *value.OrgSlug, createdAt time.Time) (*Organization, error) {
+	if objID == nil || name == nil || slug == nil {
+		return nil, errs.NewDomainError("組織の再構築に必要な値が不足しています。")
+	}
+	return &Organization{
+		objID:     objID,
+		name:      name,
+		slug:      slug,
+		createdAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+func dummyOrgName(t *testing.T, s string) *value.OrgName {
+	t.Helper()
+	name, err := value.NewOrgName(s)
+	require.NoError(t, err)
+	return name
+}
+
+func dummyOrgSlug(t *testing.T) *value.OrgSlug {
+	t.Helper()
+	slug, err := value.NewOrgSlug("acme")
+	require.NoError(t, err)
+	return slug
+}
+
+func dummyMemberRole(t *testing.T, s string) *value.MemberRole {
+	t.Helper()
+	role, err := value.NewMemberRole(s)
+	require.NoError(t, err)
+	return role
+}
+
+func dummyUserObjID(t *testing.T) *userValue.UserObjID {
+	t.Helper()
+	objID, err := userValue.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	require.NoError(t, err)
+	return objID
+}
+
+func TestNewOrganization(t *testing.T) {
+	org, err := NewOrganization(dummyOrgName(t, "Acme"), dummyOrgSlug(t))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, org.ObjID().Value(), "IDが採番されていること")
+	assert.Equal(t, "Acme", org.Name().Value())
+	assert.Equal(t, "acme", org.Slug().Value())
+	assert.False(t, org.CreatedAt().IsZero())
+
+	_, err = NewOrganization(nil, dummyOrgSlug(t))
+	assert.Error(t, err)
+}
+
+func TestOrganizationRename(t *testing.T) {
+	org, err := NewOrganization(dummyOrgName(t, "Acme"), dummyOrgSlug(t))
+	require.NoError(t, err)
+
+	assert.NoError(t, org.Rename(dummyOrgName(t, "Acme Japan")))
+	assert.Equal(t, "Acme Japan", org.Name().Value())
+	assert.Equal(t, "acme", org.Slug().Value(), "スラッグは変わらないこと")
+	assert.Error(t, org.Rename(nil))
+}
+
+func TestBuildOrganization(t *testing.T) {
+	objID, err := value.NewOrgObjID("223e4567-e89b-12d3-a456-426614174000")
+	require.NoError(t, err)
+	createdAt := time.Now().Add(-time.Hour)
+
+	org, err := BuildOrganization(objID, dummyOrgName(t, "Acme"), dummyOrgSlug(t), createdAt)
+	assert.NoError(t, err)
+	assert.Equal(t, objID, org.ObjID())
+	assert.Equal(t, createdAt, org.CreatedAt())
+
+	_, err = BuildOrganization(nil, dummyOrgName(t, "Acme"), dummyOrgSlug(t), createdAt)
+	assert.Error(t, err)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+)
+
+type InvitationRepository interface {
+	// CreateInvitation: 招待を作成
+	CreateInvitation(invitation *entity.Invitation) (*entity.Invitation, error)
+
+	// FindInvitation: IDで招待を取得（存在しない場合は nil）
+	FindInvitation(id string) (*entity.Invitation, error)
+
+	// ListPendingInvitations: 組織の回答待ちの招待を作成日時の新しい順に取得
+	ListPendingInvitations(orgObjID string) ([]*entity.Invitation, error)
+
+	// UpdateInvitation: 招待の状態を更新
+	UpdateInvitation(invitation *entity.Invitation) (*entity.Invitation, error)
+
+	// AcceptInvitation: 回答待ちの招待を承諾済みにし、同じトランザクションでメンバーを追加する
+	// 招待がすでに回答済み（同時に承諾された場合を含む）のときは何もせず false を返す
+	AcceptInvitation(invitation *entity.Invitation, membership *entity.Membership) (bool, error)
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+)
+
+// UserOrganization は、ユーザーが所属する組織と、組織でのユーザーのメンバーシップ
+type UserOrganization struct {
+	Organization *entity.Organization
+	Membership   *entity.Membership
+}
+
+type OrganizationRepository interface {
+	// CreateOrganization: 組織と、作成したユーザー（オーナー）のメンバーシップを作成
+	CreateOrganization(organization *entity.Organization, owner *entity.Membership) (*entity.Organization, error)
+
+	// FindOrganization: IDで組織を取得（存在しない場合は nil）
+	FindOrganization(orgObjID string) (*entity.Organization, error)
+
+	// FindOrganizationBySlug: スラッグで組織を取得（存在しない場合は nil）
+	FindOrganizationBySlug(slug string) (*entity.Organization, error)
+
+	// UpdateOrganization: 組織名を更新
+	UpdateOrganization(organization *entity.Organization) (*entity.Organization, error)
+
+	// DeleteOrganization: 組織と、組織のメンバーシップ・招待を削除
+	DeleteOrganization(orgObjID string) error
+
+	// ListUserOrganizations: ユーザーが所属する組織を組織名の順に取得
+	ListUserOrganizations(userObjID string) ([]*UserOrganization, error)
+
+	// FindMembership: ユーザーの組織のメンバーシップを取得（所属していない場合は nil）
+	FindMembership(orgObjID string, userObjID string) (*entity.Membership, error)
+
+	// ListMemberships: 組織のメンバーシップを参加日時の順に取得
+	ListMemberships(orgObjID string) ([]*entity.Membership, error)
+
+	// CreateMembership: メンバーシップを作成
+	CreateMembership(membership *entity.Membership) (*entity.Membership, error)
+
+	// UpdateMembership: メンバーシップのロールを更新
+	UpdateMembership(membership *entity.Membership) (*entity.Membership, error)
+
+	// DeleteMembership: メンバーシップを削除
+	DeleteMembership(orgObjID string, userObjID string) error
+}
@@ -0,0 +1,42 @@
+package value
+
+import (
+	"fmt"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// 組織のメンバーのロール
+const (
+	MemberRoleOwner  = "owner"  // 組織の削除、オーナーの任命を含むすべての操作
+	MemberRoleAdmin  = "admin"  // 組織の変更、メンバーの招待・ロールの変更・削除
+	MemberRoleMember = "member" // 組織とメンバーの参照
+)
+
+// MemberRole は組織ごとのメンバーのロール
+type MemberRole struct {
+	value string
+}
+
+func (ins *MemberRole) Value() string {
+	return ins.value
+}
+
+// IsOwner: オーナーかどうか
+func (ins *MemberRole) IsOwner() bool {
+	return ins.value == MemberRoleOwner
+}
+
+// CanManage: 組織の変更とメンバーの管理ができるかどうか（オーナー・管理者）
+func (ins *MemberRole) CanManage() bool {
+	return ins.value == MemberRoleOwner || ins.value == MemberRoleAdmin
+}
+
+func NewMemberRole(value string) (*MemberRole, error) {
+	switch value {
+	case MemberRoleOwner, MemberRoleAdmin, MemberRoleMember:
+		return &MemberRole{value: value}, nil
+	default:
+		return nil, errs.NewDomainError(fmt.Sprintf("無効なメンバーのロール: %s", value))
+	}
+}
@@ -0,0 +1,30 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMemberRole(t *testing.T) {
+	owner, err := NewMemberRole(MemberRoleOwner)
+	assert.NoError(t, err)
+	assert.True(t, owner.IsOwner())
+	assert.True(t, owner.CanManage())
+
+	admin, err := NewMemberRole(MemberRoleAdmin)
+	assert.NoError(t, err)
+	assert.False(t, admin.IsOwner())
+	assert.True(t, admin.CanManage())
+
+	member, err := NewMemberRole(MemberRoleMember)
+	assert.NoError(t, err)
+	assert.False(t, member.IsOwner())
+	assert.False(t, member.CanManage(), "メンバーは組織を管理できないこと")
+}
+
+func TestNewMemberRole_Invalid(t *testing.T) {
+	role, err := NewMemberRole("guest")
+	assert.Error(t, err)
+	assert.Nil(t, role)
+}
@@ -0,0 +1,33 @@
+package value
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// OrgNameMaxLength は組織名の最大の文字数
+const OrgNameMaxLength = 100
+
+// OrgName は組織の表示名
+type OrgName struct {
+	value string
+}
+
+func (ins *OrgName) Value() string {
+	return ins.value
+}
+
+// NewOrgName は前後の空白を取り除いた組織名を作成する
+func NewOrgName(value string) (*OrgName, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, errs.NewDomainError("組織名が指定されていません。")
+	}
+	if utf8.RuneCountInString(value) > OrgNameMaxLength {
+		return nil, errs.NewDomainError(fmt.Sprintf("組織名は%d文字以内で指定してください。", OrgNameMaxLength))
+	}
+	return &OrgName{value: value}, nil
+}
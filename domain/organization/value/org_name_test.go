@@ -0,0 +1,25 @@
+package value
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrgName(t *testing.T) {
+	name, err := NewOrgName("  株式会社サンプル ")
+	assert.NoError(t, err)
+	assert.Equal(t, "株式会社サンプル", name.Value(), "前後の空白を取り除くこと")
+
+	_, err = NewOrgName(strings.Repeat("あ", OrgNameMaxLength))
+	assert.NoError(t, err)
+}
+
+func TestNewOrgName_Invalid(t *testing.T) {
+	for _, s := range []string{"", "   ", strings.Repeat("a", OrgNameMaxLength+1)} {
+		name, err := NewOrgName(s)
+		assert.Error(t, err)
+		assert.Nil(t, name)
+	}
+}
@@ -0,0 +1,34 @@
+package value
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// OrgObjID は組織の外部識別用の ID（UUID）
+type OrgObjID struct {
+	value string
+}
+
+func (ins *OrgObjID) Value() string {
+	return ins.value
+}
+
+func (ins *OrgObjID) Equals(value *OrgObjID) bool {
+	return ins.value == value.Value()
+}
+
+func NewOrgObjID(value string) (*OrgObjID, error) {
+	const LENGTH int = 36
+	const REGEXP string = "(^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$)"
+	if utf8.RuneCountInString(value) != LENGTH {
+		return nil, errs.NewDomainError(fmt.Sprintf("組織IDの文字数は%d文字でなければなりません。", LENGTH))
+	}
+	if !regexp.MustCompile(REGEXP).Match([]byte(value)) {
+		return nil, errs.NewDomainError("組織IDはUUID形式でなければなりません。")
+	}
+	return &OrgObjID{value: value}, nil
+}
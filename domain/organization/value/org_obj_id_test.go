@@ -0,0 +1,21 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrgObjID(t *testing.T) {
+	id, err := NewOrgObjID("123e4567-e89b-12d3-a456-426614174000")
+	assert.NoError(t, err)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", id.Value())
+
+	other, _ := NewOrgObjID("123e4567-e89b-12d3-a456-426614174000")
+	assert.True(t, id.Equals(other))
+
+	for _, s := range []string{"", "org-1", "123e4567-e89b-12d3-a456-42661417400z"} {
+		_, err := NewOrgObjID(s)
+		assert.Error(t, err, "UUID 形式でない ID はエラーになること: %q", s)
+	}
+}
@@ -0,0 +1,26 @@
+package value
+
+import (
+	"regexp"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// orgSlugPattern: 英小文字・数字で始まり英小文字・数字で終わる、英小文字・数字・"-" の 3〜50 文字
+var orgSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,48}[a-z0-9]$`)
+
+// OrgSlug は URL などで組織を識別する一意な短い名前
+type OrgSlug struct {
+	value string
+}
+
+func (ins *OrgSlug) Value() string {
+	return ins.value
+}
+
+func NewOrgSlug(value string) (*OrgSlug, error) {
+	if !orgSlugPattern.MatchString(value) {
+		return nil, errs.NewDomainError("組織のスラッグは英小文字・数字・\"-\" の3〜50文字で、英小文字か数字で始まり終わる必要があります。")
+	}
+	return &OrgSlug{value: value}, nil
+}
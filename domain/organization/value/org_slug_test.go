@@ -0,0 +1,24 @@
+package value
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrgSlug(t *testing.T) {
+	for _, s := range []string{"acme", "acme-inc", "123", strings.Repeat("a", 50)} {
+		slug, err := NewOrgSlug(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, s, slug.Value())
+	}
+}
+
+func TestNewOrgSlug_Invalid(t *testing.T) {
+	for _, s := range []string{"", "ab", "Acme", "-acme", "acme-", "acme inc", "acme_inc", strings.Repeat("a", 51)} {
+		slug, err := NewOrgSlug(s)
+		assert.Error(t, err, "不正なスラッグはエラーになること: %q", s)
+		assert.Nil(t, slug)
+	}
+}
@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// APIKeyPrefix は API キーの先頭に付ける文字列（アクセストークンと見分け、漏洩したキーを検出しやすくする）
+const APIKeyPrefix = "nxk_"
+
+// APIKeyNameMaxLength は API キーの名前の最大文字数
+const APIKeyNameMaxLength = 100
+
+// apiKeyDisplayLength は、API キーを見分けるために保存・表示する先頭の文字数（APIKeyPrefix を含む）
+const apiKeyDisplayLength = len(APIKeyPrefix) + 8
+
+// APIKey は、スクリプトや CI などがユーザーとして API を利用するためのキー
+// キーそのものは作成時にのみ返し、照合用のハッシュと見分けるための先頭の文字列のみを保持する。
+type APIKey struct {
+	id         string
+	userObjID  *value.UserObjID
+	name       string
+	prefix     string // キーの先頭の文字列（一覧で見分けるために表示する）
+	secretHash string
+	scopes     []*value.APIKeyScope
+	expiresAt  *time.Time // nil の場合は期限なし
+	createdAt  time.Time
+	lastUsedAt *time.Time
+}
+
+func (ins *APIKey) ID() string {
+	return ins.id
+}
+
+func (ins *APIKey) UserObjID() *value.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *APIKey) Name() string {
+	return ins.name
+}
+
+func (ins *APIKey) Prefix() string {
+	return ins.prefix
+}
+
+func (ins *APIKey) SecretHash() string {
+	return ins.secretHash
+}
+
+func (ins *APIKey) Scopes() []*value.APIKeyScope {
+	return ins.scopes
+}
+
+func (ins *APIKey) ExpiresAt() *time.Time {
+	return ins.expiresAt
+}
+
+func (ins *APIKey) CreatedAt() time.Time {
+	return ins.createdAt
+}
+
+func (ins *APIKey) LastUsedAt() *time.Time {
+	return ins.lastUsedAt
+}
+
+// ScopeNames: スコープの名前の一覧（名前順）
+func (ins *APIKey) ScopeNames() []string {
+	names := make([]string, 0, len(ins.scopes))
+	for _, scope := range ins.scopes {
+		names = append(names, scope.Value())
+	}
+	return names
+}
+
+// Grants: いずれかのスコープが scope を満たすかどうか
+func (ins *APIKey) Grants(scope string) bool {
+	return slices.ContainsFunc(ins.scopes, func(s *value.APIKeyScope) bool {
+		return s.Grants(scope)
+	})
+}
+
+// IsExpired: 有効期限を過ぎているかどうか
+func (ins *APIKey) IsExpired(now time.Time) bool {
+	return ins.expiresAt != nil && !now.Before(*ins.expiresAt)
+}
+
+// IsAPIKeySecret は、値が API キーの形式（APIKeyPrefix で始まる）かどうかを判定する
+func IsAPIKeySecret(secret string) bool {
+	return strings.HasPrefix(secret, APIKeyPrefix)
+}
+
+// HashAPIKeySecret は API キーの保存・照合用のハッシュを返す
+// キーは十分なエントロピーを持つランダム値のため、ソルトなしの SHA-256 で照合する。
+func HashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewAPIKey は、secret（APIKeyPrefix で始まるランダムな値）の API キーを作成する。名前は前後の空白を除く
+func NewAPIKey(userObjID *value.UserObjID, name string, secret string, scopes []*value.APIKeyScope, expiresAt *time.Time) (*APIKey, error) {
+	if userObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > APIKeyNameMaxLength {
+		return nil, errs.NewDomainError("API キーの名前は1文字以上100文字以下でなければなりません。")
+	}
+	if !IsAPIKeySecret(secret) || len(secret) <= apiKeyDisplayLength {
+		return nil, errs.NewDomainError("API キーの形式が正しくありません。")
+	}
+	if len(scopes) == 0 {
+		return nil, errs.NewDomainError("API キーには1つ以上のスコープが必要です。")
+	}
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, errs.NewDomainError("有効期限は現在より後でなければなりません。")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	return &APIKey{
+		id:         id.String(),
+		userObjID:  userObjID,
+		name:       name,
+		prefix:     secret[:apiKeyDisplayLength],
+		secretHash: HashAPIKeySecret(secret),
+		scopes:     normalizeAPIKeyScopes(scopes),
+		expiresAt:  expiresAt,
+		createdAt:  now,
+		lastUsedAt: nil,
+	}, nil
+}
+
+func BuildAPIKey(id string, userObjID *value.UserObjID, name string, prefix string, secretHash string, scopes []*value.APIKeyScope, expiresAt *time.Time, createdAt time.Time, lastUsedAt *time.Time) (*APIKey, error) {
+	if id == "" || userObjID == nil || secretHash == "" {
+		return nil, errs.NewDomainError("API キーの再構築に必要な値が不足しています。")
+	}
+	return &APIKey{
+		id:         id,
+		userObjID:  userObjID,
+		name:       name,
+		prefix:     prefix,
+		secretHash: secretHash,
+		scopes:     normalizeAPIKeyScopes(scopes),
+		expiresAt:  expiresAt,
+		createdAt:  createdAt,
+		lastUsedAt: lastUsedAt,
+	}, nil
+}
+
+// normalizeAPIKeyScopes はスコープを名前順に並べ、重複を除く
+func normalizeAPIKeyScopes(scopes []*value.APIKeyScope) []*value.APIKeyScope {
+	normalized := slices.Clone(scopes)
+	slices.SortFunc(normalized, func(a, b *value.APIKeyScope) int {
+		return strings.Compare(a.Value(), b.Value())
+	})
+	return slices.CompactFunc(normalized, func(a, b *value.APIKeyScope) bool {
+		return a.Value() == b.Value()
+	})
+}
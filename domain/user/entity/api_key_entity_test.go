@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+const dummyAPIKeySecret = "nxk_abcdefgh0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZab"
+
+// dummyAPIKeyScopes は指定した名前のスコープを返す
+func dummyAPIKeyScopes(t *testing.T, names ...string) []*value.APIKeyScope {
+	t.Helper()
+	scopes := make([]*value.APIKeyScope, 0, len(names))
+	for _, name := range names {
+		scope, err := value.NewAPIKeyScope(name)
+		assert.NoError(t, err)
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
+func TestNewAPIKey(t *testing.T) {
+	userObjID := dummyUserObjID(t)
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	k, err := NewAPIKey(userObjID, "  CI  ", dummyAPIKeySecret, dummyAPIKeyScopes(t, value.APIKeyScopeProfileWrite, value.APIKeyScopeProfileRead, value.APIKeyScopeProfileRead), &expiresAt)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, k.ID())
+	assert.Equal(t, userObjID, k.UserObjID())
+	assert.Equal(t, "CI", k.Name())
+	assert.Equal(t, "nxk_abcdefgh", k.Prefix(), "先頭の文字列のみを保持すること")
+	assert.Equal(t, HashAPIKeySecret(dummyAPIKeySecret), k.SecretHash())
+	assert.NotContains(t, k.SecretHash(), "abcdefgh")
+	assert.Equal(t, []string{value.APIKeyScopeProfileRead, value.APIKeyScopeProfileWrite}, k.ScopeNames(), "スコープは重複なく名前順に並べること")
+	assert.Nil(t, k.LastUsedAt())
+	assert.False(t, k.IsExpired(time.Now()))
+	assert.True(t, k.IsExpired(expiresAt))
+
+	// 有効期限を指定しない場合は期限切れにならない
+	k, err = NewAPIKey(userObjID, "CI", dummyAPIKeySecret, dummyAPIKeyScopes(t, value.APIKeyScopeAll), nil)
+	assert.NoError(t, err)
+	assert.False(t, k.IsExpired(time.Now().Add(100*365*24*time.Hour)))
+}
+
+func TestNewAPIKey_Invalid(t *testing.T) {
+	userObjID := dummyUserObjID(t)
+	scopes := dummyAPIKeyScopes(t, value.APIKeyScopeProfileRead)
+	past := time.Now().Add(-time.Minute)
+
+	_, err := NewAPIKey(nil, "CI", dummyAPIKeySecret, scopes, nil)
+	assert.Error(t, err)
+	_, err = NewAPIKey(userObjID, "   ", dummyAPIKeySecret, scopes, nil)
+	assert.Error(t, err, "名前は必須であること")
+	_, err = NewAPIKey(userObjID, strings.Repeat("あ", APIKeyNameMaxLength+1), dummyAPIKeySecret, scopes, nil)
+	assert.Error(t, err, "長すぎる名前はエラーになること")
+	_, err = NewAPIKey(userObjID, "CI", "abcdefgh0123456789", scopes, nil)
+	assert.Error(t, err, "接頭辞のないキーはエラーになること")
+	_, err = NewAPIKey(userObjID, "CI", "nxk_abc", scopes, nil)
+	assert.Error(t, err, "短すぎるキーはエラーになること")
+	_, err = NewAPIKey(userObjID, "CI", dummyAPIKeySecret, nil, nil)
+	assert.Error(t, err, "スコープは1つ以上必要であること")
+	_, err = NewAPIKey(userObjID, "CI", dummyAPIKeySecret, scopes, &past)
+	assert.Error(t, err, "過去の有効期限はエラーになること")
+}
+
+func TestAPIKey_Grants(t *testing.T) {
+	k, err := NewAPIKey(dummyUserObjID(t), "CI", dummyAPIKeySecret, dummyAPIKeyScopes(t, value.APIKeyScopeProfileRead, value.PermissionUsersRead), nil)
+	assert.NoError(t, err)
+	assert.True(t, k.Grants(value.APIKeyScopeProfileRead))
+	assert.True(t, k.Grants(value.PermissionUsersRead))
+	assert.False(t, k.Grants(value.APIKeyScopeProfileWrite))
+
+	all, err := NewAPIKey(dummyUserObjID(t), "CI", dummyAPIKeySecret, dummyAPIKeyScopes(t, value.APIKeyScopeAll), nil)
+	assert.NoError(t, err)
+	assert.True(t, all.Grants(value.APIKeyScopeOrganizationsWrite), "* はすべてのスコープを満たすこと")
+}
+
+func TestIsAPIKeySecret(t *testing.T) {
+	assert.True(t, IsAPIKeySecret(dummyAPIKeySecret))
+	assert.False(t, IsAPIKeySecret("eyJhbGciOiJIUzI1NiJ9.e30.sig"), "アクセストークンは API キーとして扱わないこと")
+	assert.False(t, IsAPIKeySecret(""))
+}
+
+func TestBuildAPIKey(t *testing.T) {
+	now := time.Now()
+	expired := now.Add(-time.Minute)
+	k, err := BuildAPIKey("id", dummyUserObjID(t), "CI", "nxk_abcdefgh", "hash", dummyAPIKeyScopes(t, value.APIKeyScopeProfileRead), &expired, now.Add(-time.Hour), &now)
+	assert.NoError(t, err)
+	assert.Equal(t, "id", k.ID())
+	assert.True(t, k.IsExpired(now))
+	assert.Equal(t, &now, k.LastUsedAt())
+
+	_, err = BuildAPIKey("", dummyUserObjID(t), "CI", "nxk_abcdefgh", "hash", nil, nil, now, nil)
+	assert.Error(t, err)
+	_, err = BuildAPIKey("id", nil, "CI", "nxk_abcdefgh", "hash", nil, nil, now, nil)
+	assert.Error(t, err)
+	_, err = BuildAPIKey("id", dummyUserObjID(t), "CI", "nxk_abcdefgh", "", nil, nil, now, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package entity
+
+import (
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// 監査ログに記録する管理者の操作
+const (
+	AuditActionUserList          = "user.list"           // ユーザーの一覧の取得
+	AuditActionUserView          = "user.view"           // ユーザーの取得
+	AuditActionUserCreate        = "user.create"         // ユーザーの作成
+	AuditActionUserRoles         = "user.roles"          // ロールの変更
+	AuditActionUserSuspend       = "user.suspend"        // アカウントの停止
+	AuditActionUserUnsuspend     = "user.unsuspend"      // アカウントの停止の解除
+	AuditActionUserLogout        = "user.logout"         // 強制ログアウト
+	AuditActionUserDelete        = "user.delete"         // ユーザーの削除
+	AuditActionRoleCreate        = "role.create"         // ロールの作成
+	AuditActionRoleUpdate        = "role.update"         // ロールの変更
+	AuditActionRoleDelete        = "role.delete"         // ロールの削除
+	AuditActionGroupCreate       = "group.create"        // グループの作成
+	AuditActionGroupUpdate       = "group.update"        // グループの変更
+	AuditActionGroupDelete       = "group.delete"        // グループの削除
+	AuditActionGroupMemberAdd    = "group.member.add"    // グループへのユーザー・グループの追加
+	AuditActionGroupMemberRemove = "group.member.remove" // グループからのユーザー・グループの削除
+
+	AuditActionServiceAccountCreate    = "service_account.create"     // サービスアカウントの作成
+	AuditActionServiceAccountDelete    = "service_account.delete"     // サービスアカウントの削除
+	AuditActionServiceAccountKeyCreate = "service_account.key.create" // サービスアカウントの API キーの発行
+	AuditActionServiceAccountKeyRevoke = "service_account.key.revoke" // サービスアカウントの API キーの取り消し
+)
+
+// AuditLog は、管理者による操作の記録
+type AuditLog struct {
+	id          string
+	actorObjID  *value.UserObjID
+	actorKind   *value.UserKind // 操作したアカウントの種類（人かサービスアカウントか）
+	action      string
+	targetObjID string            // 操作の対象のユーザーID（一覧の取得やロール・グループの操作など、対象のユーザーがない場合は空）
+	detail      map[string]string // 操作の内容（変更後のロール、停止の理由、検索条件、操作したロールなど）
+	occurredAt  time.Time
+}
+
+func (ins *AuditLog) ID() string {
+	return ins.id
+}
+
+// ActorObjID: 操作した管理者のユーザーID
+func (ins *AuditLog) ActorObjID() *value.UserObjID {
+	return ins.actorObjID
+}
+
+// ActorKind: 操作したアカウントの種類
+func (ins *AuditLog) ActorKind() *value.UserKind {
+	return ins.actorKind
+}
+
+func (ins *AuditLog) Action() string {
+	return ins.action
+}
+
+func (ins *AuditLog) TargetObjID() string {
+	return ins.targetObjID
+}
+
+func (ins *AuditLog) Detail() map[string]string {
+	return ins.detail
+}
+
+func (ins *AuditLog) OccurredAt() time.Time {
+	return ins.occurredAt
+}
+
+// NewAuditLog は管理者による操作の記録を作成する。detail の値が空の項目は記録しない
+// actorKind が nil の場合は、人が利用するアカウント（value.KindUser）による操作として記録する
+func NewAuditLog(actorObjID *value.UserObjID, actorKind *value.UserKind, action string, targetObjID string, detail map[string]string) (*AuditLog, error) {
+	if actorObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	actorKind, err := defaultActorKind(actorKind)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(action) == "" {
+		return nil, errs.NewDomainError("操作が指定されていません。")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	recorded := make(map[string]string, len(detail))
+	for key, val := range detail {
+		if val != "" {
+			recorded[key] = val
+		}
+	}
+	return &AuditLog{
+		id:          id.String(),
+		actorObjID:  actorObjID,
+		actorKind:   actorKind,
+		action:      action,
+		targetObjID: targetObjID,
+		detail:      recorded,
+		occurredAt:  time.Now(),
+	}, nil
+}
+
+func BuildAuditLog(id string, actorObjID *value.UserObjID, actorKind *value.UserKind, action string, targetObjID string, detail map[string]string, occurredAt time.Time) (*AuditLog, error) {
+	if id == "" || actorObjID == nil || action == "" {
+		return nil, errs.NewDomainError("監査ログの再構築に必要な値が不足しています。")
+	}
+	actorKind, err := defaultActorKind(actorKind)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLog{
+		id:          id,
+		actorObjID:  actorObjID,
+		actorKind:   actorKind,
+		action:      action,
+		targetObjID: targetObjID,
+		detail:      maps.Clone(detail),
+		occurredAt:  occurredAt,
+	}, nil
+}
+
+// defaultActorKind は、操作したアカウントの種類が指定されていない場合に value.KindUser を返す
+func defaultActorKind(kind *value.UserKind) (*value.UserKind, error) {
+	if kind != nil {
+		return kind, nil
+	}
+	return value.NewUserKind(value.KindUser)
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+func TestNewAuditLog(t *testing.T) {
+	actor := dummyUserObjID(t)
+
+	l, err := NewAuditLog(actor, nil, AuditActionUserSuspend, "target-id", map[string]string{"reason": "規約違反", "note": ""})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, l.ID())
+	assert.Equal(t, actor, l.ActorObjID())
+	assert.Equal(t, AuditActionUserSuspend, l.Action())
+	assert.Equal(t, "target-id", l.TargetObjID())
+	assert.Equal(t, map[string]string{"reason": "規約違反"}, l.Detail(), "空の項目は記録しないこと")
+	assert.WithinDuration(t, time.Now(), l.OccurredAt(), time.Second)
+	assert.Equal(t, value.KindUser, l.ActorKind().Value(), "種類を指定しない場合は人による操作として記録すること")
+
+	// サービスアカウントによる操作も記録できること
+	kind, err := value.NewUserKind(value.KindServiceAccount)
+	assert.NoError(t, err)
+	byServiceAccount, err := NewAuditLog(actor, kind, AuditActionUserList, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, value.KindServiceAccount, byServiceAccount.ActorKind().Value())
+
+	// 対象や内容がない操作も記録できること
+	list, err := NewAuditLog(actor, nil, AuditActionUserList, "", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, list.TargetObjID())
+	assert.Empty(t, list.Detail())
+
+	_, err = NewAuditLog(nil, nil, AuditActionUserList, "", nil)
+	assert.Error(t, err)
+	_, err = NewAuditLog(actor, nil, " ", "", nil)
+	assert.Error(t, err, "操作が必要")
+}
+
+func TestBuildAuditLog(t *testing.T) {
+	now := time.Now()
+	detail := map[string]string{"role": "admin"}
+	l, err := BuildAuditLog("id", dummyUserObjID(t), nil, AuditActionUserRoles, "target-id", detail, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "id", l.ID())
+	assert.Equal(t, detail, l.Detail())
+	assert.Equal(t, now, l.OccurredAt())
+
+	_, err = BuildAuditLog("", dummyUserObjID(t), nil, AuditActionUserRoles, "", nil, now)
+	assert.Error(t, err)
+	_, err = BuildAuditLog("id", nil, nil, AuditActionUserRoles, "", nil, now)
+	assert.Error(t, err)
+	_, err = BuildAuditLog("id", dummyUserObjID(t), nil, "", "", nil, now)
+	assert.Error(t, err)
+}
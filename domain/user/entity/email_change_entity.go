@@ -0,0 +1,138 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// EmailChangeStatus はメールアドレス変更リクエストの状態
+type EmailChangeStatus string
+
+const (
+	EmailChangePending   EmailChangeStatus = "pending"   // 新しいアドレスでの確認待ち
+	EmailChangeConfirmed EmailChangeStatus = "confirmed" // 確認済み（変更完了）
+	EmailChangeCancelled EmailChangeStatus = "cancelled" // 確認前に取り消し
+	EmailChangeReverted  EmailChangeStatus = "reverted"  // 変更後に元のアドレスへ戻した
+)
+
+// EmailChange は、メールアドレス変更リクエストを表すエンティティ
+// 新しいアドレスでの確認が完了するまで、ユーザーのメールアドレスは変更されない。
+type EmailChange struct {
+	id          string
+	userObjID   *value.UserObjID
+	oldEmail    *value.UserEmail
+	newEmail    *value.UserEmail
+	status      EmailChangeStatus
+	expiresAt   time.Time
+	completedAt *time.Time
+}
+
+func (ins *EmailChange) ID() string {
+	return ins.id
+}
+
+func (ins *EmailChange) UserObjID() *value.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *EmailChange) OldEmail() *value.UserEmail {
+	return ins.oldEmail
+}
+
+func (ins *EmailChange) NewEmail() *value.UserEmail {
+	return ins.newEmail
+}
+
+func (ins *EmailChange) Status() EmailChangeStatus {
+	return ins.status
+}
+
+func (ins *EmailChange) ExpiresAt() time.Time {
+	return ins.expiresAt
+}
+
+func (ins *EmailChange) CompletedAt() *time.Time {
+	return ins.completedAt
+}
+
+// IsExpired: 確認期限を過ぎているかどうか
+func (ins *EmailChange) IsExpired(now time.Time) bool {
+	return now.After(ins.expiresAt)
+}
+
+// Confirm: 新しいアドレスでの確認を完了する
+func (ins *EmailChange) Confirm(now time.Time) error {
+	if ins.status != EmailChangePending {
+		return errs.NewDomainError("確認待ちではないメールアドレス変更は確認できません。")
+	}
+	if ins.IsExpired(now) {
+		return errs.NewDomainError("メールアドレス変更の確認期限が切れています。")
+	}
+	ins.status = EmailChangeConfirmed
+	ins.completedAt = &now
+	return nil
+}
+
+// Cancel: 確認前のメールアドレス変更を取り消す
+func (ins *EmailChange) Cancel(now time.Time) error {
+	if ins.status != EmailChangePending {
+		return errs.NewDomainError("確認待ちではないメールアドレス変更は取り消せません。")
+	}
+	ins.status = EmailChangeCancelled
+	ins.completedAt = &now
+	return nil
+}
+
+// Revert: 完了したメールアドレス変更を元に戻す
+func (ins *EmailChange) Revert(now time.Time) error {
+	if ins.status != EmailChangeConfirmed {
+		return errs.NewDomainError("完了していないメールアドレス変更は元に戻せません。")
+	}
+	ins.status = EmailChangeReverted
+	ins.completedAt = &now
+	return nil
+}
+
+func NewEmailChange(user *User, newEmail *value.UserEmail, expiresAt time.Time) (*EmailChange, error) {
+	if user == nil || newEmail == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	if user.Email().Equals(newEmail) {
+		return nil, errs.NewDomainError("現在と同じメールアドレスには変更できません。")
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	return &EmailChange{
+		id:          id.String(),
+		userObjID:   user.ObjID(),
+		oldEmail:    user.Email(),
+		newEmail:    newEmail,
+		status:      EmailChangePending,
+		expiresAt:   expiresAt,
+		completedAt: nil,
+	}, nil
+}
+
+func BuildEmailChange(id string, userObjID *value.UserObjID, oldEmail *value.UserEmail, newEmail *value.UserEmail, status EmailChangeStatus, expiresAt time.Time, completedAt *time.Time) (*EmailChange, error) {
+	switch status {
+	case EmailChangePending, EmailChangeConfirmed, EmailChangeCancelled, EmailChangeReverted:
+	default:
+		return nil, errs.NewDomainError("無効なメールアドレス変更の状態です。")
+	}
+	return &EmailChange{
+		id:          id,
+		userObjID:   userObjID,
+		oldEmail:    oldEmail,
+		newEmail:    newEmail,
+		status:      status,
+		expiresAt:   expiresAt,
+		completedAt: completedAt,
+	}, nil
+}
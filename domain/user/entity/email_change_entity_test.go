@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func dummyEmailChange(t *testing.T, expiresAt time.Time) *EmailChange {
+	u, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+	ec, err := NewEmailChange(u, dummyUserEmail(), expiresAt)
+	assert.NoError(t, err)
+	return ec
+}
+
+func TestNewEmailChange(t *testing.T) {
+	u, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+	newEmail := dummyUserEmail()
+
+	ec, err := NewEmailChange(u, newEmail, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, ec.ID(), "IDが採番されていること")
+	assert.Equal(t, u.ObjID(), ec.UserObjID())
+	assert.Equal(t, u.Email(), ec.OldEmail(), "変更前のアドレスが記録されていること")
+	assert.Equal(t, newEmail, ec.NewEmail())
+	assert.Equal(t, EmailChangePending, ec.Status(), "確認待ちの状態で生成されること")
+
+	// 同じアドレスへの変更は作成できないこと
+	_, err = NewEmailChange(u, u.Email(), time.Now().Add(time.Hour))
+	assert.Error(t, err)
+}
+
+func TestEmailChangeConfirm(t *testing.T) {
+	ec := dummyEmailChange(t, time.Now().Add(time.Hour))
+	assert.NoError(t, ec.Confirm(time.Now()))
+	assert.Equal(t, EmailChangeConfirmed, ec.Status())
+	assert.NotNil(t, ec.CompletedAt())
+
+	// 確認済みのものは再度確認できないこと
+	assert.Error(t, ec.Confirm(time.Now()))
+}
+
+func TestEmailChangeConfirm_Expired(t *testing.T) {
+	ec := dummyEmailChange(t, time.Now().Add(-time.Minute))
+	assert.Error(t, ec.Confirm(time.Now()), "期限切れの場合はエラーが返ること")
+	assert.Equal(t, EmailChangePending, ec.Status())
+}
+
+func TestEmailChangeCancel(t *testing.T) {
+	ec := dummyEmailChange(t, time.Now().Add(time.Hour))
+	assert.NoError(t, ec.Cancel(time.Now()))
+	assert.Equal(t, EmailChangeCancelled, ec.Status())
+
+	// 取り消し後は確認も取り消しもできないこと
+	assert.Error(t, ec.Confirm(time.Now()))
+	assert.Error(t, ec.Cancel(time.Now()))
+}
+
+func TestEmailChangeRevert(t *testing.T) {
+	ec := dummyEmailChange(t, time.Now().Add(time.Hour))
+	assert.Error(t, ec.Revert(time.Now()), "確認前は元に戻せないこと")
+
+	assert.NoError(t, ec.Confirm(time.Now()))
+	assert.NoError(t, ec.Revert(time.Now()))
+	assert.Equal(t, EmailChangeReverted, ec.Status())
+	assert.Error(t, ec.Revert(time.Now()), "元に戻した後は再度戻せないこと")
+}
+
+func TestBuildEmailChange_InvalidStatus(t *testing.T) {
+	ec, err := BuildEmailChange("id", nil, nil, nil, EmailChangeStatus("unknown"), time.Now(), nil)
+	assert.Error(t, err)
+	assert.Nil(t, ec)
+}
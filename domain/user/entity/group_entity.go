@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// GroupDescriptionMaxLength は、グループの説明の最大文字数
+const GroupDescriptionMaxLength = 255
+
+// Group は、ユーザー（と入れ子のグループ）をまとめ、所属するユーザーにロールと権限を与える単位
+type Group struct {
+	objID       *value.GroupObjID
+	name        *value.GroupName
+	description string
+	roles       []*value.UserRole   // 重複なし・名前順
+	permissions []*value.Permission // 重複なし・名前順
+	createdAt   time.Time
+}
+
+func (ins *Group) ObjID() *value.GroupObjID {
+	return ins.objID
+}
+
+func (ins *Group) Name() *value.GroupName {
+	return ins.name
+}
+
+func (ins *Group) Description() string {
+	return ins.description
+}
+
+func (ins *Group) Roles() []*value.UserRole {
+	return ins.roles
+}
+
+func (ins *Group) Permissions() []*value.Permission {
+	return ins.permissions
+}
+
+func (ins *Group) CreatedAt() time.Time {
+	return ins.createdAt
+}
+
+// RoleNames: グループに与えたロールの名前の一覧
+func (ins *Group) RoleNames() []string {
+	names := make([]string, 0, len(ins.roles))
+	for _, role := range ins.roles {
+		names = append(names, role.Value())
+	}
+	return names
+}
+
+// PermissionNames: グループに直接与えた権限の名前の一覧
+func (ins *Group) PermissionNames() []string {
+	names := make([]string, 0, len(ins.permissions))
+	for _, permission := range ins.permissions {
+		names = append(names, permission.Value())
+	}
+	return names
+}
+
+// Update: 説明とロール・権限を置き換える
+func (ins *Group) Update(description string, roles []*value.UserRole, permissions []*value.Permission) error {
+	description, err := validateGroupDescription(description)
+	if err != nil {
+		return err
+	}
+	ins.description = description
+	ins.roles = normalizeGroupRoles(roles)
+	ins.permissions = normalizePermissions(permissions)
+	return nil
+}
+
+func NewGroup(name *value.GroupName, description string, roles []*value.UserRole, permissions []*value.Permission) (*Group, error) {
+	if name == nil {
+		return nil, errs.NewDomainError("グループ名が指定されていません。")
+	}
+	description, err := validateGroupDescription(description)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	objID, err := value.NewGroupObjID(id.String())
+	if err != nil {
+		return nil, err
+	}
+	return &Group{
+		objID:       objID,
+		name:        name,
+		description: description,
+		roles:       normalizeGroupRoles(roles),
+		permissions: normalizePermissions(permissions),
+		createdAt:   time.Now(),
+	}, nil
+}
+
+func BuildGroup(objID *value.GroupObjID, name *value.GroupName, description string, roles []*value.UserRole, permissions []*value.Permission, createdAt time.Time) (*Group, error) {
+	if objID == nil || name == nil {
+		return nil, errs.NewDomainError("グループの再構築に必要な値が不足しています。")
+	}
+	return &Group{
+		objID:       objID,
+		name:        name,
+		description: description,
+		roles:       normalizeGroupRoles(roles),
+		permissions: normalizePermissions(permissions),
+		createdAt:   createdAt,
+	}, nil
+}
+
+func validateGroupDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > GroupDescriptionMaxLength {
+		return "", errs.NewDomainError(fmt.Sprintf("説明は %d 文字以内で指定してください。", GroupDescriptionMaxLength))
+	}
+	return description, nil
+}
+
+// normalizeGroupRoles はロールの重複を取り除き、名前順に並べる
+func normalizeGroupRoles(roles []*value.UserRole) []*value.UserRole {
+	normalized := make([]*value.UserRole, 0, len(roles))
+	for _, role := range roles {
+		if role != nil {
+			normalized = append(normalized, role)
+		}
+	}
+	slices.SortFunc(normalized, func(a, b *value.UserRole) int {
+		return cmp.Compare(a.Value(), b.Value())
+	})
+	return slices.CompactFunc(normalized, func(a, b *value.UserRole) bool {
+		return a.Value() == b.Value()
+	})
+}
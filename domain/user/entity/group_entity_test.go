@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+func dummyRoles(t *testing.T, names ...string) []*value.UserRole {
+	t.Helper()
+	roles := make([]*value.UserRole, 0, len(names))
+	for _, name := range names {
+		role, err := value.NewUserRole(name)
+		require.NoError(t, err)
+		roles = append(roles, role)
+	}
+	return roles
+}
+
+func TestNewGroup(t *testing.T) {
+	name, _ := value.NewGroupName("support")
+	group, err := NewGroup(name, "  サポートチーム  ", dummyRoles(t, "support", "auditor", "support"), dummyPermissions(t, value.PermissionUsersRead, value.PermissionUsersRead))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, group.ObjID().Value())
+	assert.Equal(t, "support", group.Name().Value())
+	assert.Equal(t, "サポートチーム", group.Description())
+	assert.Equal(t, []string{"auditor", "support"}, group.RoleNames(), "重複を取り除いて名前順に並べること")
+	assert.Equal(t, []string{value.PermissionUsersRead}, group.PermissionNames())
+	assert.False(t, group.CreatedAt().IsZero())
+
+	// ロール・権限のないグループも作成できること
+	empty, err := NewGroup(name, "", nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, empty.RoleNames())
+	assert.Empty(t, empty.PermissionNames())
+}
+
+func TestNewGroup_Invalid(t *testing.T) {
+	_, err := NewGroup(nil, "", nil, nil)
+	assert.Error(t, err)
+
+	name, _ := value.NewGroupName("support")
+	_, err = NewGroup(name, strings.Repeat("あ", GroupDescriptionMaxLength+1), nil, nil)
+	assert.Error(t, err, "説明が長すぎる場合はエラー")
+}
+
+func TestGroupUpdate(t *testing.T) {
+	name, _ := value.NewGroupName("support")
+	group, err := NewGroup(name, "サポートチーム", dummyRoles(t, "support"), nil)
+	require.NoError(t, err)
+
+	assert.NoError(t, group.Update("サポート責任者", dummyRoles(t, "auditor"), dummyPermissions(t, value.PermissionGroupsRead)))
+	assert.Equal(t, "サポート責任者", group.Description())
+	assert.Equal(t, []string{"auditor"}, group.RoleNames(), "ロールを置き換えること")
+	assert.Equal(t, []string{value.PermissionGroupsRead}, group.PermissionNames(), "権限を置き換えること")
+
+	assert.Error(t, group.Update(strings.Repeat("あ", GroupDescriptionMaxLength+1), nil, nil))
+	assert.Equal(t, "サポート責任者", group.Description(), "失敗した場合は変更しないこと")
+	assert.Equal(t, []string{"auditor"}, group.RoleNames())
+}
+
+func TestBuildGroup(t *testing.T) {
+	objID, _ := value.NewGroupObjID("123e4567-e89b-12d3-a456-426614174000")
+	name, _ := value.NewGroupName("support")
+	createdAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	group, err := BuildGroup(objID, name, "サポートチーム", dummyRoles(t, "support"), dummyPermissions(t, value.PermissionUsersRead), createdAt)
+	assert.NoError(t, err)
+	assert.Equal(t, objID, group.ObjID())
+	assert.Equal(t, createdAt, group.CreatedAt())
+
+	_, err = BuildGroup(nil, name, "", nil, nil, createdAt)
+	assert.Error(t, err)
+	_, err = BuildGroup(objID, nil, "", nil, nil, createdAt)
+	assert.Error(t, err)
+}
@@ -0,0 +1,158 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// LoginEventResult はログインの結果
+type LoginEventResult string
+
+const (
+	LoginSucceeded LoginEventResult = "success"
+	LoginFailed    LoginEventResult = "failure"
+)
+
+// ログインに失敗した理由
+const (
+	LoginReasonInvalidCredentials = "invalid_credentials" // パスワードの誤り
+	LoginReasonAccountLocked      = "account_locked"      // 失敗が続いたためロック中
+	LoginReasonThrottled          = "throttled"           // 失敗が続いたため待機中
+	LoginReasonEmailNotVerified   = "email_not_verified"  // メールアドレス未確認
+	LoginReasonInvalidMFACode     = "invalid_mfa_code"    // 二要素目のコードの誤り
+	LoginReasonInvalidPasskey     = "invalid_passkey"     // パスキーの検証の失敗
+	LoginReasonAccountInactive    = "account_inactive"    // アカウントが有効でない（有効化待ち・停止・無効化）
+	LoginReasonServiceAccount     = "service_account"     // サービスアカウントによるログイン
+)
+
+// LoginEventUserAgentMaxLength は記録する User-Agent の最大文字数
+const LoginEventUserAgentMaxLength = 512
+
+// LoginEvent は、ユーザーのログインの試行の記録
+type LoginEvent struct {
+	id         string
+	userObjID  *value.UserObjID
+	result     LoginEventResult
+	reason     string   // 失敗した理由（成功の場合は空）
+	methods    []string // 使われた認証方法（amr 値）
+	ipAddress  string
+	userAgent  string
+	occurredAt time.Time
+	newDevice  bool // 最近ログインに成功していない端末・接続元からのログインかどうか
+}
+
+func (ins *LoginEvent) ID() string {
+	return ins.id
+}
+
+func (ins *LoginEvent) UserObjID() *value.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *LoginEvent) Result() LoginEventResult {
+	return ins.result
+}
+
+func (ins *LoginEvent) Reason() string {
+	return ins.reason
+}
+
+func (ins *LoginEvent) Methods() []string {
+	return ins.methods
+}
+
+func (ins *LoginEvent) IPAddress() string {
+	return ins.ipAddress
+}
+
+func (ins *LoginEvent) UserAgent() string {
+	return ins.userAgent
+}
+
+func (ins *LoginEvent) OccurredAt() time.Time {
+	return ins.occurredAt
+}
+
+// IsSucceeded: ログインに成功した記録かどうか
+func (ins *LoginEvent) IsSucceeded() bool {
+	return ins.result == LoginSucceeded
+}
+
+func (ins *LoginEvent) IsNewDevice() bool {
+	return ins.newDevice
+}
+
+// Fingerprint: ログインした端末と接続元の特徴
+func (ins *LoginEvent) Fingerprint() *value.LoginFingerprint {
+	return value.NewLoginFingerprint(ins.ipAddress, ins.userAgent)
+}
+
+// MarkNewDevice: 新しい端末・接続元からのログインとして記録する（成功したログインのみ）
+func (ins *LoginEvent) MarkNewDevice() error {
+	if !ins.IsSucceeded() {
+		return errs.NewDomainError("失敗したログインは新しい端末からのログインとして記録できません。")
+	}
+	ins.newDevice = true
+	return nil
+}
+
+// NewLoginEvent はログインの試行の記録を作成する。失敗の場合は理由が必要で、成功の場合は理由を指定できない
+// User-Agent は前後の空白を除き、長すぎる場合は切り詰める
+func NewLoginEvent(userObjID *value.UserObjID, result LoginEventResult, reason string, methods []string, ipAddress string, userAgent string) (*LoginEvent, error) {
+	if userObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	switch result {
+	case LoginSucceeded:
+		if reason != "" {
+			return nil, errs.NewDomainError("成功したログインには理由を指定できません。")
+		}
+	case LoginFailed:
+		if reason == "" {
+			return nil, errs.NewDomainError("失敗したログインには理由が必要です。")
+		}
+	default:
+		return nil, errs.NewDomainError("ログインの結果が不正です。")
+	}
+	userAgent = strings.TrimSpace(userAgent)
+	if utf8.RuneCountInString(userAgent) > LoginEventUserAgentMaxLength {
+		userAgent = string([]rune(userAgent)[:LoginEventUserAgentMaxLength])
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	return &LoginEvent{
+		id:         id.String(),
+		userObjID:  userObjID,
+		result:     result,
+		reason:     reason,
+		methods:    slices.Clone(methods),
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+		occurredAt: time.Now(),
+	}, nil
+}
+
+func BuildLoginEvent(id string, userObjID *value.UserObjID, result LoginEventResult, reason string, methods []string, ipAddress string, userAgent string, occurredAt time.Time, newDevice bool) (*LoginEvent, error) {
+	if id == "" || userObjID == nil {
+		return nil, errs.NewDomainError("ログインの記録の再構築に必要な値が不足しています。")
+	}
+	return &LoginEvent{
+		id:         id,
+		userObjID:  userObjID,
+		result:     result,
+		reason:     reason,
+		methods:    methods,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+		occurredAt: occurredAt,
+		newDevice:  newDevice,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLoginEvent(t *testing.T) {
+	userObjID := dummyUserObjID(t)
+
+	e, err := NewLoginEvent(userObjID, LoginSucceeded, "", []string{"pwd", "otp"}, "192.0.2.1", "  Mozilla/5.0 (Macintosh)  ")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, e.ID())
+	assert.Equal(t, userObjID, e.UserObjID())
+	assert.True(t, e.IsSucceeded())
+	assert.Empty(t, e.Reason())
+	assert.Equal(t, []string{"pwd", "otp"}, e.Methods())
+	assert.Equal(t, "192.0.2.1", e.IPAddress())
+	assert.Equal(t, "Mozilla/5.0 (Macintosh)", e.UserAgent())
+	assert.WithinDuration(t, time.Now(), e.OccurredAt(), time.Second)
+
+	failed, err := NewLoginEvent(userObjID, LoginFailed, LoginReasonInvalidCredentials, []string{"pwd"}, "192.0.2.1", "")
+	assert.NoError(t, err)
+	assert.False(t, failed.IsSucceeded())
+	assert.Equal(t, LoginReasonInvalidCredentials, failed.Reason())
+
+	// 長すぎる User-Agent は切り詰めること
+	long, err := NewLoginEvent(userObjID, LoginSucceeded, "", nil, "", strings.Repeat("a", LoginEventUserAgentMaxLength+10))
+	assert.NoError(t, err)
+	assert.Equal(t, LoginEventUserAgentMaxLength, utf8.RuneCountInString(long.UserAgent()))
+
+	_, err = NewLoginEvent(nil, LoginSucceeded, "", nil, "", "")
+	assert.Error(t, err)
+	_, err = NewLoginEvent(userObjID, LoginSucceeded, LoginReasonInvalidCredentials, nil, "", "")
+	assert.Error(t, err, "成功の場合は理由を指定できない")
+	_, err = NewLoginEvent(userObjID, LoginFailed, "", nil, "", "")
+	assert.Error(t, err, "失敗の場合は理由が必要")
+	_, err = NewLoginEvent(userObjID, "unknown", "", nil, "", "")
+	assert.Error(t, err)
+}
+
+func TestBuildLoginEvent(t *testing.T) {
+	now := time.Now()
+	e, err := BuildLoginEvent("id", dummyUserObjID(t), LoginFailed, LoginReasonAccountLocked, []string{"pwd"}, "192.0.2.1", "curl", now, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "id", e.ID())
+	assert.Equal(t, LoginReasonAccountLocked, e.Reason())
+	assert.Equal(t, now, e.OccurredAt())
+	assert.False(t, e.IsNewDevice())
+
+	_, err = BuildLoginEvent("", dummyUserObjID(t), LoginSucceeded, "", nil, "", "", now, false)
+	assert.Error(t, err)
+	_, err = BuildLoginEvent("id", nil, LoginSucceeded, "", nil, "", "", now, false)
+	assert.Error(t, err)
+}
+
+func TestLoginEvent_MarkNewDevice(t *testing.T) {
+	userObjID := dummyUserObjID(t)
+
+	e, err := NewLoginEvent(userObjID, LoginSucceeded, "", []string{"pwd"}, "192.0.2.1", "curl/8.4.0")
+	assert.NoError(t, err)
+	assert.False(t, e.IsNewDevice())
+	assert.NoError(t, e.MarkNewDevice())
+	assert.True(t, e.IsNewDevice())
+	assert.Equal(t, "curl", e.Fingerprint().UAFamily())
+	assert.Equal(t, "192.0.2.0/24", e.Fingerprint().Network())
+
+	failed, err := NewLoginEvent(userObjID, LoginFailed, LoginReasonInvalidCredentials, []string{"pwd"}, "192.0.2.1", "")
+	assert.NoError(t, err)
+	assert.Error(t, failed.MarkNewDevice(), "失敗したログインは新しい端末として記録できない")
+	assert.False(t, failed.IsNewDevice())
+}
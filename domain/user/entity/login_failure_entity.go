@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// ログイン失敗を数える単位
+const (
+	LoginFailureScopeAccount      = "account"       // アカウント（正規化したメールアドレス）ごと
+	LoginFailureScopeIP           = "ip"            // 接続元 IP アドレスごと
+	LoginFailureScopeMFAChallenge = "mfa_challenge" // 二要素認証のチャレンジトークン（のハッシュ）ごとの試行
+)
+
+// LoginFailure は、アカウントまたは接続元 IP アドレスごとのログイン失敗の記録
+// 複数のインスタンスで共有するため DB に保存し、失敗回数の加算は DB 上で行う。
+type LoginFailure struct {
+	scope        string
+	key          string
+	failures     int       // 直近の連続した失敗回数（ログイン成功・ロック解除で 0 に戻る）
+	lastFailedAt time.Time // 最後に失敗した日時
+	lockedUntil  *time.Time
+}
+
+func (ins *LoginFailure) Scope() string {
+	return ins.scope
+}
+
+func (ins *LoginFailure) Key() string {
+	return ins.key
+}
+
+func (ins *LoginFailure) Failures() int {
+	return ins.failures
+}
+
+func (ins *LoginFailure) LastFailedAt() time.Time {
+	return ins.lastFailedAt
+}
+
+func (ins *LoginFailure) LockedUntil() *time.Time {
+	return ins.lockedUntil
+}
+
+// IsLocked: ロック中かどうか
+func (ins *LoginFailure) IsLocked(now time.Time) bool {
+	return ins.lockedUntil != nil && now.Before(*ins.lockedUntil)
+}
+
+// BackoffUntil は、失敗回数が threshold 以上の場合に次の試行を待たせる日時を返す（待たせない場合はゼロ値）
+// 待ち時間は base から失敗のたびに倍になり、max を上限とする。
+func (ins *LoginFailure) BackoffUntil(threshold int, base time.Duration, max time.Duration) time.Time {
+	if threshold <= 0 || ins.failures < threshold || base <= 0 {
+		return time.Time{}
+	}
+	delay := base
+	for i := threshold; i < ins.failures && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return ins.lastFailedAt.Add(delay)
+}
+
+func BuildLoginFailure(scope string, key string, failures int, lastFailedAt time.Time, lockedUntil *time.Time) (*LoginFailure, error) {
+	if scope != LoginFailureScopeAccount && scope != LoginFailureScopeIP && scope != LoginFailureScopeMFAChallenge {
+		return nil, errs.NewDomainError("無効なログイン失敗の単位です。")
+	}
+	if key == "" {
+		return nil, errs.NewDomainError("ログイン失敗の記録に必要な値が不足しています。")
+	}
+	return &LoginFailure{
+		scope:        scope,
+		key:          key,
+		failures:     failures,
+		lastFailedAt: lastFailedAt,
+		lockedUntil:  lockedUntil,
+	}, nil
+}
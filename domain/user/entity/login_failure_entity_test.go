@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildLoginFailure(t *testing.T) {
+	now := time.Now()
+	f, err := BuildLoginFailure(LoginFailureScopeAccount, "test@example.com", 3, now, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, LoginFailureScopeAccount, f.Scope())
+	assert.Equal(t, "test@example.com", f.Key())
+	assert.Equal(t, 3, f.Failures())
+	assert.Equal(t, now, f.LastFailedAt())
+	assert.Nil(t, f.LockedUntil())
+
+	_, err = BuildLoginFailure(LoginFailureScopeMFAChallenge, "challenge", 1, now, nil)
+	assert.NoError(t, err)
+	_, err = BuildLoginFailure("unknown", "key", 1, now, nil)
+	assert.Error(t, err)
+	_, err = BuildLoginFailure(LoginFailureScopeIP, "", 1, now, nil)
+	assert.Error(t, err)
+}
+
+func TestLoginFailure_IsLocked(t *testing.T) {
+	now := time.Now()
+	until := now.Add(time.Minute)
+	f, err := BuildLoginFailure(LoginFailureScopeAccount, "key", 10, now, &until)
+	assert.NoError(t, err)
+	assert.True(t, f.IsLocked(now))
+	assert.False(t, f.IsLocked(until), "期限を過ぎたらロックは解除される")
+
+	f, err = BuildLoginFailure(LoginFailureScopeAccount, "key", 10, now, nil)
+	assert.NoError(t, err)
+	assert.False(t, f.IsLocked(now))
+}
+
+func TestLoginFailure_BackoffUntil(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		failures int
+		want     time.Duration // 0 の場合は待たせない
+	}{
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{5, 4 * time.Second},
+		{10, 10 * time.Second}, // 上限で頭打ち
+	}
+	for _, tc := range cases {
+		f, err := BuildLoginFailure(LoginFailureScopeAccount, "key", tc.failures, now, nil)
+		assert.NoError(t, err)
+		until := f.BackoffUntil(3, time.Second, 10*time.Second)
+		if tc.want == 0 {
+			assert.True(t, until.IsZero(), "failures=%d", tc.failures)
+			continue
+		}
+		assert.Equal(t, now.Add(tc.want), until, "failures=%d", tc.failures)
+	}
+
+	f, err := BuildLoginFailure(LoginFailureScopeAccount, "key", 100, now, nil)
+	assert.NoError(t, err)
+	assert.True(t, f.BackoffUntil(0, time.Second, time.Minute).IsZero(), "しきい値が 0 の場合は待たせない")
+}
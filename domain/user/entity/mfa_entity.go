@@ -0,0 +1,148 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// TOTPFactor は、ユーザーが認証アプリに登録した TOTP（RFC 6238）の共有シークレット
+// 最初のコードで確認が完了するまでは、ログイン時の二要素認証に使われない。
+type TOTPFactor struct {
+	userObjID    *value.UserObjID
+	secret       string // Base32 でエンコードした共有シークレット（保存時はリポジトリで暗号化する）
+	confirmedAt  *time.Time
+	lastUsedStep int64 // 最後に受け付けたコードのタイムステップ（同じコードの再利用を防ぐ）
+}
+
+func (ins *TOTPFactor) UserObjID() *value.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *TOTPFactor) Secret() string {
+	return ins.secret
+}
+
+func (ins *TOTPFactor) ConfirmedAt() *time.Time {
+	return ins.confirmedAt
+}
+
+func (ins *TOTPFactor) LastUsedStep() int64 {
+	return ins.lastUsedStep
+}
+
+// IsConfirmed: 登録の確認が完了しているかどうか
+func (ins *TOTPFactor) IsConfirmed() bool {
+	return ins.confirmedAt != nil
+}
+
+// Confirm: 最初のコードを受け付けて登録を完了する
+func (ins *TOTPFactor) Confirm(step int64, now time.Time) error {
+	if ins.IsConfirmed() {
+		return errs.NewDomainError("TOTP の登録はすでに完了しています。")
+	}
+	ins.confirmedAt = &now
+	ins.lastUsedStep = step
+	return nil
+}
+
+func NewTOTPFactor(userObjID *value.UserObjID, secret string) (*TOTPFactor, error) {
+	if userObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	if secret == "" {
+		return nil, errs.NewDomainError("TOTP のシークレットは必須です。")
+	}
+	return &TOTPFactor{
+		userObjID:    userObjID,
+		secret:       secret,
+		confirmedAt:  nil,
+		lastUsedStep: 0,
+	}, nil
+}
+
+func BuildTOTPFactor(userObjID *value.UserObjID, secret string, confirmedAt *time.Time, lastUsedStep int64) (*TOTPFactor, error) {
+	if userObjID == nil || secret == "" {
+		return nil, errs.NewDomainError("TOTP の再構築に必要な値が不足しています。")
+	}
+	return &TOTPFactor{
+		userObjID:    userObjID,
+		secret:       secret,
+		confirmedAt:  confirmedAt,
+		lastUsedStep: lastUsedStep,
+	}, nil
+}
+
+// RecoveryCode は、認証アプリを使えない場合に一度だけ使えるリカバリーコード
+// コード自体は保持せず、ハッシュのみを保持する。
+type RecoveryCode struct {
+	id        string
+	userObjID *value.UserObjID
+	codeHash  string
+	usedAt    *time.Time
+}
+
+func (ins *RecoveryCode) ID() string {
+	return ins.id
+}
+
+func (ins *RecoveryCode) UserObjID() *value.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *RecoveryCode) CodeHash() string {
+	return ins.codeHash
+}
+
+func (ins *RecoveryCode) UsedAt() *time.Time {
+	return ins.usedAt
+}
+
+// NormalizeRecoveryCode は入力されたリカバリーコードから区切りや空白を除き、小文字にそろえる
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// HashRecoveryCode はリカバリーコードの保存・照合用のハッシュを返す
+// コードは十分なエントロピーを持つランダム値のため、ソルトなしの SHA-256 で照合する。
+func HashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(NormalizeRecoveryCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewRecoveryCode(userObjID *value.UserObjID, code string) (*RecoveryCode, error) {
+	if userObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	if NormalizeRecoveryCode(code) == "" {
+		return nil, errs.NewDomainError("リカバリーコードは必須です。")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	return &RecoveryCode{
+		id:        id.String(),
+		userObjID: userObjID,
+		codeHash:  HashRecoveryCode(code),
+		usedAt:    nil,
+	}, nil
+}
+
+func BuildRecoveryCode(id string, userObjID *value.UserObjID, codeHash string, usedAt *time.Time) (*RecoveryCode, error) {
+	if userObjID == nil || codeHash == "" {
+		return nil, errs.NewDomainError("リカバリーコードの再構築に必要な値が不足しています。")
+	}
+	return &RecoveryCode{
+		id:        id,
+		userObjID: userObjID,
+		codeHash:  codeHash,
+		usedAt:    usedAt,
+	}, nil
+}
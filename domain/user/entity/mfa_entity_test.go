@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+func dummyUserObjID(t *testing.T) *value.UserObjID {
+	u, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+	return u.ObjID()
+}
+
+func TestNewTOTPFactor(t *testing.T) {
+	objID := dummyUserObjID(t)
+
+	f, err := NewTOTPFactor(objID, "JBSWY3DPEHPK3PXP")
+	assert.NoError(t, err)
+	assert.Equal(t, objID, f.UserObjID())
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", f.Secret())
+	assert.False(t, f.IsConfirmed(), "確認前の状態で生成されること")
+
+	_, err = NewTOTPFactor(nil, "JBSWY3DPEHPK3PXP")
+	assert.Error(t, err)
+	_, err = NewTOTPFactor(objID, "")
+	assert.Error(t, err)
+}
+
+func TestTOTPFactorConfirm(t *testing.T) {
+	f, err := NewTOTPFactor(dummyUserObjID(t), "JBSWY3DPEHPK3PXP")
+	assert.NoError(t, err)
+
+	assert.NoError(t, f.Confirm(100, time.Now()))
+	assert.True(t, f.IsConfirmed())
+	assert.Equal(t, int64(100), f.LastUsedStep(), "確認に使ったコードは再利用できないこと")
+
+	// 確認済みのものは再度確認できないこと
+	assert.Error(t, f.Confirm(101, time.Now()))
+}
+
+func TestNewRecoveryCode(t *testing.T) {
+	objID := dummyUserObjID(t)
+
+	rc, err := NewRecoveryCode(objID, "abcde-12345")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rc.ID())
+	assert.NotContains(t, rc.CodeHash(), "abcde", "コードそのものは保持しないこと")
+	assert.Nil(t, rc.UsedAt())
+
+	// 区切りや大文字小文字の違いは同じコードとして扱うこと
+	assert.Equal(t, rc.CodeHash(), HashRecoveryCode("ABCDE12345"))
+	assert.Equal(t, rc.CodeHash(), HashRecoveryCode(" abcde 12345 "))
+	assert.NotEqual(t, rc.CodeHash(), HashRecoveryCode("abcde-12346"))
+
+	_, err = NewRecoveryCode(objID, "--")
+	assert.Error(t, err)
+}
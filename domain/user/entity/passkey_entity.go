@@ -0,0 +1,228 @@
+package entity
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// PasskeyNameMaxLength はパスキーの表示名の最大文字数
+const PasskeyNameMaxLength = 100
+
+// PasskeyCredential は、ユーザーが登録した WebAuthn のクレデンシャル（パスキー）
+// 秘密鍵は認証器が保持し、サーバーは公開鍵と署名カウンターのみを保持する。
+type PasskeyCredential struct {
+	id              string
+	userObjID       *value.UserObjID
+	credentialID    []byte // 認証器が発行したクレデンシャルID
+	name            string
+	publicKey       []byte // COSE 形式の公開鍵
+	attestationType string
+	transports      []string
+	aaguid          []byte
+	signCount       uint32 // 署名カウンター（複製された認証器の検知に使う）
+	backupEligible  bool
+	backupState     bool
+	createdAt       time.Time
+	lastUsedAt      *time.Time
+}
+
+func (ins *PasskeyCredential) ID() string {
+	return ins.id
+}
+
+func (ins *PasskeyCredential) UserObjID() *value.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *PasskeyCredential) CredentialID() []byte {
+	return ins.credentialID
+}
+
+func (ins *PasskeyCredential) Name() string {
+	return ins.name
+}
+
+func (ins *PasskeyCredential) PublicKey() []byte {
+	return ins.publicKey
+}
+
+func (ins *PasskeyCredential) AttestationType() string {
+	return ins.attestationType
+}
+
+func (ins *PasskeyCredential) Transports() []string {
+	return ins.transports
+}
+
+func (ins *PasskeyCredential) AAGUID() []byte {
+	return ins.aaguid
+}
+
+func (ins *PasskeyCredential) SignCount() uint32 {
+	return ins.signCount
+}
+
+func (ins *PasskeyCredential) BackupEligible() bool {
+	return ins.backupEligible
+}
+
+func (ins *PasskeyCredential) BackupState() bool {
+	return ins.backupState
+}
+
+func (ins *PasskeyCredential) CreatedAt() time.Time {
+	return ins.createdAt
+}
+
+func (ins *PasskeyCredential) LastUsedAt() *time.Time {
+	return ins.lastUsedAt
+}
+
+// RecordUse: 認証に使われたことを記録する
+// 署名カウンターが増えていない場合は、認証器が複製された可能性があるため拒否する
+// （カウンターを実装しない認証器は常に 0 を返すため、双方が 0 の場合は許容する）。
+func (ins *PasskeyCredential) RecordUse(signCount uint32, backupState bool, now time.Time) error {
+	if (signCount != 0 || ins.signCount != 0) && signCount <= ins.signCount {
+		return errs.NewDomainError("パスキーの署名カウンターが不正です。認証器が複製された可能性があります。")
+	}
+	ins.signCount = signCount
+	ins.backupState = backupState
+	ins.lastUsedAt = &now
+	return nil
+}
+
+func NewPasskeyCredential(userObjID *value.UserObjID, credentialID []byte, name string, publicKey []byte, attestationType string, transports []string, aaguid []byte, signCount uint32, backupEligible bool, backupState bool) (*PasskeyCredential, error) {
+	if userObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	if len(credentialID) == 0 || len(publicKey) == 0 {
+		return nil, errs.NewDomainError("パスキーのクレデンシャルIDと公開鍵は必須です。")
+	}
+	if utf8.RuneCountInString(name) > PasskeyNameMaxLength {
+		return nil, errs.NewDomainError("パスキーの名前は100文字以内でなければなりません。")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	return &PasskeyCredential{
+		id:              id.String(),
+		userObjID:       userObjID,
+		credentialID:    credentialID,
+		name:            name,
+		publicKey:       publicKey,
+		attestationType: attestationType,
+		transports:      transports,
+		aaguid:          aaguid,
+		signCount:       signCount,
+		backupEligible:  backupEligible,
+		backupState:     backupState,
+		createdAt:       time.Now(),
+		lastUsedAt:      nil,
+	}, nil
+}
+
+func BuildPasskeyCredential(id string, userObjID *value.UserObjID, credentialID []byte, name string, publicKey []byte, attestationType string, transports []string, aaguid []byte, signCount uint32, backupEligible bool, backupState bool, createdAt time.Time, lastUsedAt *time.Time) (*PasskeyCredential, error) {
+	if userObjID == nil || len(credentialID) == 0 || len(publicKey) == 0 {
+		return nil, errs.NewDomainError("パスキーの再構築に必要な値が不足しています。")
+	}
+	return &PasskeyCredential{
+		id:              id,
+		userObjID:       userObjID,
+		credentialID:    credentialID,
+		name:            name,
+		publicKey:       publicKey,
+		attestationType: attestationType,
+		transports:      transports,
+		aaguid:          aaguid,
+		signCount:       signCount,
+		backupEligible:  backupEligible,
+		backupState:     backupState,
+		createdAt:       createdAt,
+		lastUsedAt:      lastUsedAt,
+	}, nil
+}
+
+// PasskeyCeremony は WebAuthn のセレモニー（登録・認証）の種類
+type PasskeyCeremony string
+
+const (
+	PasskeyCeremonyRegistration PasskeyCeremony = "registration" // パスキーの登録
+	PasskeyCeremonyLogin        PasskeyCeremony = "login"        // パスキーのみでのログイン
+	PasskeyCeremonyMFA          PasskeyCeremony = "mfa"          // 二要素目としての認証
+)
+
+// PasskeySession は、WebAuthn のセレモニーの開始から完了までの間に保持するチャレンジ等の状態
+// 一度だけ使用でき、完了時に削除する。
+type PasskeySession struct {
+	id        string
+	userObjID *value.UserObjID // パスキーのみでのログインでは、開始時点のユーザーは不明のため nil
+	ceremony  PasskeyCeremony
+	data      []byte // WebAuthn ライブラリのセッションデータ（JSON）
+	expiresAt time.Time
+}
+
+func (ins *PasskeySession) ID() string {
+	return ins.id
+}
+
+func (ins *PasskeySession) UserObjID() *value.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *PasskeySession) Ceremony() PasskeyCeremony {
+	return ins.ceremony
+}
+
+func (ins *PasskeySession) Data() []byte {
+	return ins.data
+}
+
+func (ins *PasskeySession) ExpiresAt() time.Time {
+	return ins.expiresAt
+}
+
+// IsExpired: 有効期限が切れているかどうか
+func (ins *PasskeySession) IsExpired(now time.Time) bool {
+	return !now.Before(ins.expiresAt)
+}
+
+func NewPasskeySession(userObjID *value.UserObjID, ceremony PasskeyCeremony, data []byte, ttl time.Duration) (*PasskeySession, error) {
+	if ceremony != PasskeyCeremonyLogin && userObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	if len(data) == 0 {
+		return nil, errs.NewDomainError("パスキーのセッションデータは必須です。")
+	}
+	if ttl <= 0 {
+		return nil, errs.NewDomainError("有効期限は正の値でなければなりません。")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	return &PasskeySession{
+		id:        id.String(),
+		userObjID: userObjID,
+		ceremony:  ceremony,
+		data:      data,
+		expiresAt: time.Now().Add(ttl),
+	}, nil
+}
+
+func BuildPasskeySession(id string, userObjID *value.UserObjID, ceremony PasskeyCeremony, data []byte, expiresAt time.Time) (*PasskeySession, error) {
+	if id == "" || len(data) == 0 {
+		return nil, errs.NewDomainError("パスキーのセッションの再構築に必要な値が不足しています。")
+	}
+	return &PasskeySession{
+		id:        id,
+		userObjID: userObjID,
+		ceremony:  ceremony,
+		data:      data,
+		expiresAt: expiresAt,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func dummyPasskeyCredential(t *testing.T, signCount uint32) *PasskeyCredential {
+	c, err := NewPasskeyCredential(dummyUserObjID(t), []byte("credential-id"), "MacBook", []byte("public-key"), "none", []string{"internal"}, make([]byte, 16), signCount, true, false)
+	assert.NoError(t, err)
+	return c
+}
+
+func TestNewPasskeyCredential(t *testing.T) {
+	objID := dummyUserObjID(t)
+
+	c, err := NewPasskeyCredential(objID, []byte("credential-id"), "MacBook", []byte("public-key"), "none", []string{"internal"}, nil, 0, true, false)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, c.ID())
+	assert.Equal(t, objID, c.UserObjID())
+	assert.Equal(t, []byte("credential-id"), c.CredentialID())
+	assert.Equal(t, "MacBook", c.Name())
+	assert.Nil(t, c.LastUsedAt())
+
+	_, err = NewPasskeyCredential(nil, []byte("credential-id"), "", []byte("public-key"), "none", nil, nil, 0, false, false)
+	assert.Error(t, err)
+	_, err = NewPasskeyCredential(objID, nil, "", []byte("public-key"), "none", nil, nil, 0, false, false)
+	assert.Error(t, err)
+	_, err = NewPasskeyCredential(objID, []byte("credential-id"), "", nil, "none", nil, nil, 0, false, false)
+	assert.Error(t, err)
+	_, err = NewPasskeyCredential(objID, []byte("credential-id"), strings.Repeat("あ", PasskeyNameMaxLength+1), []byte("public-key"), "none", nil, nil, 0, false, false)
+	assert.Error(t, err)
+}
+
+func TestPasskeyCredentialRecordUse(t *testing.T) {
+	c := dummyPasskeyCredential(t, 5)
+
+	now := time.Now()
+	assert.NoError(t, c.RecordUse(6, true, now))
+	assert.Equal(t, uint32(6), c.SignCount())
+	assert.True(t, c.BackupState())
+	assert.Equal(t, &now, c.LastUsedAt())
+
+	// カウンターが増えていない場合は複製の疑いとして拒否すること
+	assert.Error(t, c.RecordUse(6, true, now))
+	assert.Error(t, c.RecordUse(3, true, now))
+	assert.Error(t, c.RecordUse(0, true, now))
+	assert.Equal(t, uint32(6), c.SignCount(), "拒否した場合はカウンターを更新しないこと")
+}
+
+func TestPasskeyCredentialRecordUse_NoCounter(t *testing.T) {
+	// カウンターを実装しない認証器（常に 0）は許容すること
+	c := dummyPasskeyCredential(t, 0)
+
+	assert.NoError(t, c.RecordUse(0, false, time.Now()))
+	assert.NoError(t, c.RecordUse(0, false, time.Now()))
+	assert.NotNil(t, c.LastUsedAt())
+}
+
+func TestNewPasskeySession(t *testing.T) {
+	objID := dummyUserObjID(t)
+
+	s, err := NewPasskeySession(objID, PasskeyCeremonyRegistration, []byte(`{"challenge":"abc"}`), time.Minute)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, s.ID())
+	assert.Equal(t, PasskeyCeremonyRegistration, s.Ceremony())
+	assert.False(t, s.IsExpired(time.Now()))
+	assert.True(t, s.IsExpired(time.Now().Add(2*time.Minute)))
+
+	// パスキーのみでのログインは開始時点のユーザーが不明なため nil を許容すること
+	s, err = NewPasskeySession(nil, PasskeyCeremonyLogin, []byte(`{}`), time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, s.UserObjID())
+
+	_, err = NewPasskeySession(nil, PasskeyCeremonyMFA, []byte(`{}`), time.Minute)
+	assert.Error(t, err)
+	_, err = NewPasskeySession(objID, PasskeyCeremonyMFA, nil, time.Minute)
+	assert.Error(t, err)
+	_, err = NewPasskeySession(objID, PasskeyCeremonyMFA, []byte(`{}`), 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// PasswordlessChallenge は、パスワードレスログインのために送信したマジックリンク・ワンタイムコードを表すエンティティ
+// コード自体は保持せず、チャレンジIDと組み合わせたハッシュのみを保持する。
+type PasswordlessChallenge struct {
+	id         string
+	email      *value.UserEmail
+	codeHash   string
+	attempts   int
+	expiresAt  time.Time
+	consumedAt *time.Time
+	createdAt  time.Time
+}
+
+func (ins *PasswordlessChallenge) ID() string {
+	return ins.id
+}
+
+func (ins *PasswordlessChallenge) Email() *value.UserEmail {
+	return ins.email
+}
+
+func (ins *PasswordlessChallenge) CodeHash() string {
+	return ins.codeHash
+}
+
+func (ins *PasswordlessChallenge) Attempts() int {
+	return ins.attempts
+}
+
+func (ins *PasswordlessChallenge) ExpiresAt() time.Time {
+	return ins.expiresAt
+}
+
+func (ins *PasswordlessChallenge) ConsumedAt() *time.Time {
+	return ins.consumedAt
+}
+
+func (ins *PasswordlessChallenge) CreatedAt() time.Time {
+	return ins.createdAt
+}
+
+// IsExpired: 有効期限を過ぎているかどうか
+func (ins *PasswordlessChallenge) IsExpired(now time.Time) bool {
+	return now.After(ins.expiresAt)
+}
+
+// IsConsumed: ログインに使用済みかどうか
+func (ins *PasswordlessChallenge) IsConsumed() bool {
+	return ins.consumedAt != nil
+}
+
+// MatchesCode: 入力されたコードが一致するかどうか（タイミング攻撃を避けるため定数時間で比較する）
+func (ins *PasswordlessChallenge) MatchesCode(code string) bool {
+	hash := HashPasswordlessCode(ins.id, code)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(ins.codeHash)) == 1
+}
+
+// HashPasswordlessCode はワンタイムコードの保存・照合用のハッシュを返す
+// コードは桁数が少ないため、チャレンジIDと組み合わせて他のチャレンジのハッシュと照合できないようにする。
+func HashPasswordlessCode(challengeID string, code string) string {
+	sum := sha256.Sum256([]byte(challengeID + ":" + strings.TrimSpace(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewPasswordlessChallenge(email *value.UserEmail, code string, ttl time.Duration) (*PasswordlessChallenge, error) {
+	if email == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	if strings.TrimSpace(code) == "" {
+		return nil, errs.NewDomainError("ワンタイムコードは必須です。")
+	}
+	if ttl <= 0 {
+		return nil, errs.NewDomainError("有効期限は正の値でなければなりません。")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	now := time.Now()
+	return &PasswordlessChallenge{
+		id:         id.String(),
+		email:      email,
+		codeHash:   HashPasswordlessCode(id.String(), code),
+		attempts:   0,
+		expiresAt:  now.Add(ttl),
+		consumedAt: nil,
+		createdAt:  now,
+	}, nil
+}
+
+func BuildPasswordlessChallenge(id string, email *value.UserEmail, codeHash string, attempts int, expiresAt time.Time, consumedAt *time.Time, createdAt time.Time) (*PasswordlessChallenge, error) {
+	if email == nil || codeHash == "" {
+		return nil, errs.NewDomainError("パスワードレスログインのチャレンジの再構築に必要な値が不足しています。")
+	}
+	return &PasswordlessChallenge{
+		id:         id,
+		email:      email,
+		codeHash:   codeHash,
+		attempts:   attempts,
+		expiresAt:  expiresAt,
+		consumedAt: consumedAt,
+		createdAt:  createdAt,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPasswordlessChallenge(t *testing.T) {
+	email := dummyUserEmail()
+
+	c, err := NewPasswordlessChallenge(email, "123456", 10*time.Minute)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, c.ID())
+	assert.Equal(t, email, c.Email())
+	assert.NotEqual(t, "123456", c.CodeHash(), "コード自体は保持しないこと")
+	assert.Equal(t, 0, c.Attempts())
+	assert.False(t, c.IsConsumed())
+	assert.False(t, c.IsExpired(time.Now()))
+	assert.True(t, c.IsExpired(time.Now().Add(11*time.Minute)))
+
+	_, err = NewPasswordlessChallenge(nil, "123456", time.Minute)
+	assert.Error(t, err)
+	_, err = NewPasswordlessChallenge(email, " ", time.Minute)
+	assert.Error(t, err)
+	_, err = NewPasswordlessChallenge(email, "123456", 0)
+	assert.Error(t, err)
+}
+
+func TestPasswordlessChallengeMatchesCode(t *testing.T) {
+	c, err := NewPasswordlessChallenge(dummyUserEmail(), "123456", time.Minute)
+	assert.NoError(t, err)
+
+	assert.True(t, c.MatchesCode("123456"))
+	assert.True(t, c.MatchesCode(" 123456 "), "前後の空白は無視すること")
+	assert.False(t, c.MatchesCode("654321"))
+	assert.False(t, c.MatchesCode(""))
+
+	// 同じコードでもチャレンジが異なればハッシュは異なること
+	other, err := NewPasswordlessChallenge(dummyUserEmail(), "123456", time.Minute)
+	assert.NoError(t, err)
+	assert.NotEqual(t, c.CodeHash(), other.CodeHash())
+}
+
+func TestBuildPasswordlessChallenge(t *testing.T) {
+	now := time.Now()
+	c, err := BuildPasswordlessChallenge("id", dummyUserEmail(), HashPasswordlessCode("id", "123456"), 2, now.Add(time.Minute), &now, now)
+	assert.NoError(t, err)
+	assert.True(t, c.IsConsumed())
+	assert.Equal(t, 2, c.Attempts())
+	assert.True(t, c.MatchesCode("123456"))
+
+	_, err = BuildPasswordlessChallenge("id", nil, "hash", 0, now, nil, now)
+	assert.Error(t, err)
+	_, err = BuildPasswordlessChallenge("id", dummyUserEmail(), "", 0, now, nil, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// RoleDescriptionMaxLength は、ロールの説明の最大文字数
+const RoleDescriptionMaxLength = 255
+
+// ErrBuiltInRole は、組み込みのロール（admin・user）を変更・削除しようとした場合のエラー
+var ErrBuiltInRole = errs.NewDomainError("組み込みのロールは変更・削除できません。")
+
+// Role は、ユーザーに与える権限の組
+type Role struct {
+	name        *value.UserRole
+	description string
+	permissions []*value.Permission // 重複なし・名前順
+}
+
+func (ins *Role) Name() *value.UserRole {
+	return ins.name
+}
+
+func (ins *Role) Description() string {
+	return ins.description
+}
+
+func (ins *Role) Permissions() []*value.Permission {
+	return ins.permissions
+}
+
+// PermissionNames: 権限の名前の一覧
+func (ins *Role) PermissionNames() []string {
+	names := make([]string, 0, len(ins.permissions))
+	for _, permission := range ins.permissions {
+		names = append(names, permission.Value())
+	}
+	return names
+}
+
+// IsBuiltIn: 組み込みのロールかどうか
+func (ins *Role) IsBuiltIn() bool {
+	return ins.name.IsBuiltIn()
+}
+
+// Grants: ロールが permission を与えるかどうか
+func (ins *Role) Grants(permission string) bool {
+	return slices.ContainsFunc(ins.permissions, func(p *value.Permission) bool {
+		return p.Grants(permission)
+	})
+}
+
+// Update: 説明と権限を置き換える（組み込みのロールは変更できない）
+func (ins *Role) Update(description string, permissions []*value.Permission) error {
+	if ins.IsBuiltIn() {
+		return ErrBuiltInRole
+	}
+	description, err := validateRoleDescription(description)
+	if err != nil {
+		return err
+	}
+	ins.description = description
+	ins.permissions = normalizePermissions(permissions)
+	return nil
+}
+
+func NewRole(name *value.UserRole, description string, permissions []*value.Permission) (*Role, error) {
+	if name == nil {
+		return nil, errs.NewDomainError("ロール名が指定されていません。")
+	}
+	if name.IsBuiltIn() {
+		return nil, errs.NewDomainError(fmt.Sprintf("%s は組み込みのロールの名前です。", name.Value()))
+	}
+	description, err := validateRoleDescription(description)
+	if err != nil {
+		return nil, err
+	}
+	return &Role{
+		name:        name,
+		description: description,
+		permissions: normalizePermissions(permissions),
+	}, nil
+}
+
+func BuildRole(name *value.UserRole, description string, permissions []*value.Permission) (*Role, error) {
+	if name == nil {
+		return nil, errs.NewDomainError("ロールの再構築に必要な値が不足しています。")
+	}
+	return &Role{
+		name:        name,
+		description: description,
+		permissions: normalizePermissions(permissions),
+	}, nil
+}
+
+// BuiltInRoles は、組み込みのロール（admin はすべての権限を持ち、user は権限を持たない）を返す
+func BuiltInRoles() []*Role {
+	adminName, _ := value.NewUserRole(value.Admin)
+	userName, _ := value.NewUserRole(value.RegularUser)
+	all, _ := value.NewPermission(value.PermissionAll)
+	return []*Role{
+		{name: adminName, description: "すべての操作を許可する管理者", permissions: []*value.Permission{all}},
+		{name: userName, description: "一般ユーザー", permissions: []*value.Permission{}},
+	}
+}
+
+func validateRoleDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > RoleDescriptionMaxLength {
+		return "", errs.NewDomainError(fmt.Sprintf("説明は %d 文字以内で指定してください。", RoleDescriptionMaxLength))
+	}
+	return description, nil
+}
+
+// normalizePermissions は権限の重複を取り除き、名前順に並べる
+func normalizePermissions(permissions []*value.Permission) []*value.Permission {
+	normalized := make([]*value.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		if permission != nil {
+			normalized = append(normalized, permission)
+		}
+	}
+	slices.SortFunc(normalized, func(a, b *value.Permission) int {
+		return cmp.Compare(a.Value(), b.Value())
+	})
+	return slices.CompactFunc(normalized, func(a, b *value.Permission) bool {
+		return a.Value() == b.Value()
+	})
+}
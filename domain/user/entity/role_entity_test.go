@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+func dummyPermissions(t *testing.T, names ...string) []*value.Permission {
+	t.Helper()
+	permissions := make([]*value.Permission, 0, len(names))
+	for _, name := range names {
+		permission, err := value.NewPermission(name)
+		require.NoError(t, err)
+		permissions = append(permissions, permission)
+	}
+	return permissions
+}
+
+func TestNewRole(t *testing.T) {
+	name, _ := value.NewUserRole("support")
+	role, err := NewRole(name, "  サポート担当  ", dummyPermissions(t, value.PermissionUsersWrite, value.PermissionUsersRead, value.PermissionUsersRead))
+	assert.NoError(t, err)
+	assert.Equal(t, "support", role.Name().Value())
+	assert.Equal(t, "サポート担当", role.Description())
+	assert.Equal(t, []string{value.PermissionUsersRead, value.PermissionUsersWrite}, role.PermissionNames(), "重複を取り除いて名前順に並べること")
+	assert.False(t, role.IsBuiltIn())
+	assert.True(t, role.Grants(value.PermissionUsersRead))
+	assert.False(t, role.Grants(value.PermissionRolesWrite))
+
+	// 権限のないロールも作成できること
+	empty, err := NewRole(name, "", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, empty.PermissionNames())
+	assert.False(t, empty.Grants(value.PermissionUsersRead))
+}
+
+func TestNewRole_Invalid(t *testing.T) {
+	_, err := NewRole(nil, "", nil)
+	assert.Error(t, err)
+
+	admin, _ := value.NewUserRole(value.Admin)
+	_, err = NewRole(admin, "", nil)
+	assert.Error(t, err, "組み込みのロールの名前では作成できないこと")
+
+	name, _ := value.NewUserRole("support")
+	_, err = NewRole(name, strings.Repeat("あ", RoleDescriptionMaxLength+1), nil)
+	assert.Error(t, err, "説明が長すぎる場合はエラー")
+}
+
+func TestRoleUpdate(t *testing.T) {
+	name, _ := value.NewUserRole("support")
+	role, err := NewRole(name, "サポート担当", dummyPermissions(t, value.PermissionUsersRead))
+	require.NoError(t, err)
+
+	assert.NoError(t, role.Update("サポート責任者", dummyPermissions(t, value.PermissionUsersWrite)))
+	assert.Equal(t, "サポート責任者", role.Description())
+	assert.Equal(t, []string{value.PermissionUsersWrite}, role.PermissionNames(), "権限を置き換えること")
+
+	assert.Error(t, role.Update(strings.Repeat("あ", RoleDescriptionMaxLength+1), nil))
+	assert.Equal(t, "サポート責任者", role.Description(), "失敗した場合は変更しないこと")
+}
+
+func TestRoleUpdate_BuiltIn(t *testing.T) {
+	name, _ := value.NewUserRole(value.Admin)
+	role, err := BuildRole(name, "管理者", dummyPermissions(t, value.PermissionAll))
+	require.NoError(t, err)
+	assert.True(t, role.IsBuiltIn())
+	assert.True(t, role.Grants(value.PermissionRolesWrite), "* はすべての権限を与えること")
+
+	assert.ErrorIs(t, role.Update("", nil), ErrBuiltInRole)
+	assert.Equal(t, []string{value.PermissionAll}, role.PermissionNames())
+}
+
+func TestBuildRole_Invalid(t *testing.T) {
+	_, err := BuildRole(nil, "", nil)
+	assert.Error(t, err)
+}
+
+func TestBuiltInRoles(t *testing.T) {
+	roles := BuiltInRoles()
+	require.Len(t, roles, 2)
+	assert.Equal(t, value.Admin, roles[0].Name().Value())
+	assert.True(t, roles[0].IsBuiltIn())
+	assert.True(t, roles[0].Grants(value.PermissionRolesWrite), "admin はすべての権限を持つこと")
+	assert.Equal(t, value.RegularUser, roles[1].Name().Value())
+	assert.True(t, roles[1].IsBuiltIn())
+	assert.Empty(t, roles[1].PermissionNames(), "user は権限を持たないこと")
+}
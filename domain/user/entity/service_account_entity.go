@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// ServiceAccountDescriptionMaxLength は、サービスアカウントの説明の最大文字数
+const ServiceAccountDescriptionMaxLength = 255
+
+// サービスアカウントの所有者の種類
+const (
+	ServiceAccountOwnerAdmin        = "admin"        // 作成した管理者が所有する
+	ServiceAccountOwnerOrganization = "organization" // 組織が所有する（組織のメンバーとして登録する）
+)
+
+// ServiceAccount は、システムが API を利用するための人ではないアカウント
+// ユーザー（種類が value.KindServiceAccount）として保存し、パスワードではログインできない。
+// API キーまたはクライアント資格情報（サービスアカウントの ID と API キー）で取得したアクセストークンで認証する。
+type ServiceAccount struct {
+	user        *User
+	description string
+	ownerType   string
+	ownerID     string // 所有する管理者のユーザー ID または組織の ID
+	createdBy   *value.UserObjID
+}
+
+func (ins *ServiceAccount) User() *User {
+	return ins.user
+}
+
+func (ins *ServiceAccount) ObjID() *value.UserObjID {
+	return ins.user.ObjID()
+}
+
+func (ins *ServiceAccount) Description() string {
+	return ins.description
+}
+
+func (ins *ServiceAccount) OwnerType() string {
+	return ins.ownerType
+}
+
+func (ins *ServiceAccount) OwnerID() string {
+	return ins.ownerID
+}
+
+func (ins *ServiceAccount) CreatedBy() *value.UserObjID {
+	return ins.createdBy
+}
+
+// IsOwnedByOrganization: 組織が所有するかどうか
+func (ins *ServiceAccount) IsOwnedByOrganization() bool {
+	return ins.ownerType == ServiceAccountOwnerOrganization
+}
+
+// NewServiceAccount: サービスアカウントを作成する
+// orgObjID が空の場合は作成した管理者（createdBy）が、指定した場合はその組織が所有する
+func NewServiceAccount(username *value.UserUsername, description string, orgObjID string, createdBy *value.UserObjID) (*ServiceAccount, error) {
+	if username == nil {
+		return nil, errs.NewDomainError("名前が指定されていません。")
+	}
+	if createdBy == nil {
+		return nil, errs.NewDomainError("作成者が指定されていません。")
+	}
+	description, err := validateServiceAccountDescription(description)
+	if err != nil {
+		return nil, err
+	}
+	user, err := NewServiceAccountUser(username)
+	if err != nil {
+		return nil, err
+	}
+	ownerType, ownerID := ServiceAccountOwnerAdmin, createdBy.Value()
+	if orgObjID = strings.TrimSpace(orgObjID); orgObjID != "" {
+		ownerType, ownerID = ServiceAccountOwnerOrganization, orgObjID
+	}
+	return &ServiceAccount{
+		user:        user,
+		description: description,
+		ownerType:   ownerType,
+		ownerID:     ownerID,
+		createdBy:   createdBy,
+	}, nil
+}
+
+func BuildServiceAccount(user *User, description string, ownerType string, ownerID string, createdBy *value.UserObjID) (*ServiceAccount, error) {
+	if user == nil || createdBy == nil {
+		return nil, errs.NewDomainError("サービスアカウントの再構築に必要な値が不足しています。")
+	}
+	if !user.IsServiceAccount() {
+		return nil, errs.NewDomainError("サービスアカウントではないユーザーが指定されました。")
+	}
+	switch ownerType {
+	case ServiceAccountOwnerAdmin, ServiceAccountOwnerOrganization:
+	default:
+		return nil, errs.NewDomainError(fmt.Sprintf("無効な所有者の種類: %s", ownerType))
+	}
+	return &ServiceAccount{
+		user:        user,
+		description: description,
+		ownerType:   ownerType,
+		ownerID:     ownerID,
+		createdBy:   createdBy,
+	}, nil
+}
+
+func validateServiceAccountDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > ServiceAccountDescriptionMaxLength {
+		return "", errs.NewDomainError(fmt.Sprintf("説明は %d 文字以内で指定してください。", ServiceAccountDescriptionMaxLength))
+	}
+	return description, nil
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+func TestNewServiceAccount(t *testing.T) {
+	admin, _ := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	name, _ := value.NewUserUsername("ci-deployer")
+
+	account, err := NewServiceAccount(name, "  デプロイ用  ", "", admin)
+	assert.NoError(t, err)
+	assert.True(t, account.User().IsServiceAccount())
+	assert.Equal(t, account.User().ObjID(), account.ObjID())
+	assert.Equal(t, "デプロイ用", account.Description())
+	assert.Equal(t, ServiceAccountOwnerAdmin, account.OwnerType(), "組織を指定しない場合は作成した管理者が所有すること")
+	assert.Equal(t, admin.Value(), account.OwnerID())
+	assert.Equal(t, admin, account.CreatedBy())
+	assert.False(t, account.IsOwnedByOrganization())
+
+	owned, err := NewServiceAccount(name, "", "223e4567-e89b-12d3-a456-426614174000", admin)
+	assert.NoError(t, err)
+	assert.Equal(t, ServiceAccountOwnerOrganization, owned.OwnerType())
+	assert.Equal(t, "223e4567-e89b-12d3-a456-426614174000", owned.OwnerID())
+	assert.True(t, owned.IsOwnedByOrganization())
+}
+
+func TestNewServiceAccount_Invalid(t *testing.T) {
+	admin, _ := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	name, _ := value.NewUserUsername("ci-deployer")
+
+	_, err := NewServiceAccount(nil, "", "", admin)
+	assert.Error(t, err)
+	_, err = NewServiceAccount(name, "", "", nil)
+	assert.Error(t, err)
+	_, err = NewServiceAccount(name, strings.Repeat("あ", ServiceAccountDescriptionMaxLength+1), "", admin)
+	assert.Error(t, err, "説明が長すぎる場合はエラー")
+}
+
+func TestBuildServiceAccount(t *testing.T) {
+	admin, _ := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	name, _ := value.NewUserUsername("ci-deployer")
+	user, err := NewServiceAccountUser(name)
+	require.NoError(t, err)
+
+	account, err := BuildServiceAccount(user, "デプロイ用", ServiceAccountOwnerAdmin, admin.Value(), admin)
+	assert.NoError(t, err)
+	assert.Equal(t, user, account.User())
+
+	_, err = BuildServiceAccount(user, "", "team", admin.Value(), admin)
+	assert.Error(t, err, "不正な所有者の種類はエラー")
+	_, err = BuildServiceAccount(nil, "", ServiceAccountOwnerAdmin, admin.Value(), admin)
+	assert.Error(t, err)
+
+	human, err := NewUser(dummyUserEmail(), dummyUserPassword(), name)
+	require.NoError(t, err)
+	_, err = BuildServiceAccount(human, "", ServiceAccountOwnerAdmin, admin.Value(), admin)
+	assert.Error(t, err, "サービスアカウントではないユーザーはエラー")
+}
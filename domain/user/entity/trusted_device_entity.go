@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/google/uuid"
+)
+
+// TrustedDeviceNameMaxLength は信頼済み端末の表示名の最大文字数
+const TrustedDeviceNameMaxLength = 255
+
+// TrustedDevice は、二要素認証を省略してログインできる端末（ユーザーが「この端末を記憶する」を選んだ端末）
+// 端末には署名付きのトークンを発行し、サーバーはトークンと対応する記録を保持する。記録を削除するとトークンは無効になる。
+type TrustedDevice struct {
+	id         string
+	userObjID  *value.UserObjID
+	name       string // 登録時の User-Agent など、ユーザーが端末を見分けるための名前
+	expiresAt  time.Time
+	createdAt  time.Time
+	lastUsedAt *time.Time
+}
+
+func (ins *TrustedDevice) ID() string {
+	return ins.id
+}
+
+func (ins *TrustedDevice) UserObjID() *value.UserObjID {
+	return ins.userObjID
+}
+
+func (ins *TrustedDevice) Name() string {
+	return ins.name
+}
+
+func (ins *TrustedDevice) ExpiresAt() time.Time {
+	return ins.expiresAt
+}
+
+func (ins *TrustedDevice) CreatedAt() time.Time {
+	return ins.createdAt
+}
+
+func (ins *TrustedDevice) LastUsedAt() *time.Time {
+	return ins.lastUsedAt
+}
+
+// IsExpired: 有効期限を過ぎているかどうか
+func (ins *TrustedDevice) IsExpired(now time.Time) bool {
+	return !now.Before(ins.expiresAt)
+}
+
+// NewTrustedDevice は信頼済み端末を作成する。名前は前後の空白を除き、長すぎる場合は切り詰める
+func NewTrustedDevice(userObjID *value.UserObjID, name string, ttl time.Duration) (*TrustedDevice, error) {
+	if userObjID == nil {
+		return nil, errs.NewDomainError("引数でnilが指定されました。")
+	}
+	if ttl <= 0 {
+		return nil, errs.NewDomainError("有効期限は正の値でなければなりません。")
+	}
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > TrustedDeviceNameMaxLength {
+		name = string([]rune(name)[:TrustedDeviceNameMaxLength])
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	now := time.Now()
+	return &TrustedDevice{
+		id:         id.String(),
+		userObjID:  userObjID,
+		name:       name,
+		expiresAt:  now.Add(ttl),
+		createdAt:  now,
+		lastUsedAt: nil,
+	}, nil
+}
+
+func BuildTrustedDevice(id string, userObjID *value.UserObjID, name string, expiresAt time.Time, createdAt time.Time, lastUsedAt *time.Time) (*TrustedDevice, error) {
+	if id == "" || userObjID == nil {
+		return nil, errs.NewDomainError("信頼済み端末の再構築に必要な値が不足しています。")
+	}
+	return &TrustedDevice{
+		id:         id,
+		userObjID:  userObjID,
+		name:       name,
+		expiresAt:  expiresAt,
+		createdAt:  createdAt,
+		lastUsedAt: lastUsedAt,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrustedDevice(t *testing.T) {
+	userObjID := dummyUserObjID(t)
+
+	d, err := NewTrustedDevice(userObjID, "  Mozilla/5.0 (Macintosh)  ", 30*24*time.Hour)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, d.ID())
+	assert.Equal(t, userObjID, d.UserObjID())
+	assert.Equal(t, "Mozilla/5.0 (Macintosh)", d.Name())
+	assert.Nil(t, d.LastUsedAt())
+	assert.False(t, d.IsExpired(time.Now()))
+	assert.True(t, d.IsExpired(time.Now().Add(31*24*time.Hour)))
+
+	// 長すぎる名前は切り詰めること
+	long, err := NewTrustedDevice(userObjID, strings.Repeat("あ", TrustedDeviceNameMaxLength+10), time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, TrustedDeviceNameMaxLength, utf8.RuneCountInString(long.Name()))
+
+	_, err = NewTrustedDevice(nil, "name", time.Hour)
+	assert.Error(t, err)
+	_, err = NewTrustedDevice(userObjID, "name", 0)
+	assert.Error(t, err)
+}
+
+func TestBuildTrustedDevice(t *testing.T) {
+	now := time.Now()
+	d, err := BuildTrustedDevice("id", dummyUserObjID(t), "name", now.Add(-time.Minute), now.Add(-time.Hour), &now)
+	assert.NoError(t, err)
+	assert.Equal(t, "id", d.ID())
+	assert.True(t, d.IsExpired(now))
+	assert.Equal(t, &now, d.LastUsedAt())
+
+	_, err = BuildTrustedDevice("", dummyUserObjID(t), "name", now, now, nil)
+	assert.Error(t, err)
+	_, err = BuildTrustedDevice("id", nil, "name", now, now, nil)
+	assert.Error(t, err)
+}
@@ -1,27 +1,65 @@
 package entity
 
 import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	"github.com/goda6565/nexus-user-auth/domain/timeobj"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
 	"github.com/goda6565/nexus-user-auth/errs"
 	"github.com/google/uuid"
 )
 
+// アカウントの状態によって利用を拒否した理由（CheckActive が返す）
+var (
+	ErrAccountPending     = errs.NewDomainError("アカウントが有効化されていません。")
+	ErrAccountSuspended   = errs.NewDomainError("アカウントが停止されています。")
+	ErrAccountDeactivated = errs.NewDomainError("アカウントが無効化されています。")
+)
+
+// UserStatusReasonMaxLength は、状態を変更した理由の最大文字数
+const UserStatusReasonMaxLength = 255
+
+// ServiceAccountEmailDomain は、サービスアカウントに割り当てるメールアドレスのドメイン
+// サービスアカウントはメールを受け取らないため、配送されない予約済みのドメイン（.invalid）を使う
+const ServiceAccountEmailDomain = "service-account.invalid"
+
+// ErrServiceAccount は、サービスアカウントに許可されない操作（パスワードの設定など）を行おうとした場合のエラー
+var ErrServiceAccount = errs.NewDomainError("サービスアカウントではこの操作はできません。")
+
 type User struct {
 	objID           *value.UserObjID
+	kind            *value.UserKind
 	email           *value.UserEmail
 	password        *value.UserPassword
 	username        *value.UserUsername
 	avatarURL       *value.UserAvatarURL
 	emailVerifiedAt *timeobj.TimeObj
 	lastLoginAt     *timeobj.TimeObj
-	role            *value.UserRole
+	roles           []*value.UserRole // 重複なし・名前順
+	status          *value.UserStatus
+	statusReason    string
+	statusChangedAt *timeobj.TimeObj
+	createdAt       *timeobj.TimeObj
 }
 
 func (ins *User) ObjID() *value.UserObjID {
 	return ins.objID
 }
 
+func (ins *User) Kind() *value.UserKind {
+	return ins.kind
+}
+
+// IsServiceAccount: サービスアカウントかどうか
+func (ins *User) IsServiceAccount() bool {
+	return ins.kind.Value() == value.KindServiceAccount
+}
+
 func (ins *User) Email() *value.UserEmail {
 	return ins.email
 }
@@ -46,18 +84,186 @@ func (ins *User) LastLoginAt() *timeobj.TimeObj {
 	return ins.lastLoginAt
 }
 
-func (ins *User) Role() *value.UserRole {
-	return ins.role
+func (ins *User) Roles() []*value.UserRole {
+	return ins.roles
+}
+
+// RoleNames: ロールの名前の一覧
+func (ins *User) RoleNames() []string {
+	names := make([]string, 0, len(ins.roles))
+	for _, role := range ins.roles {
+		names = append(names, role.Value())
+	}
+	return names
+}
+
+// HasRole: 指定したロールを持つかどうか
+func (ins *User) HasRole(name string) bool {
+	return slices.ContainsFunc(ins.roles, func(role *value.UserRole) bool {
+		return role.Value() == name
+	})
+}
+
+func (ins *User) Status() *value.UserStatus {
+	return ins.status
+}
+
+// StatusReason: 状態を変更した理由（停止・無効化の理由。ない場合は空）
+func (ins *User) StatusReason() string {
+	return ins.statusReason
+}
+
+func (ins *User) StatusChangedAt() *timeobj.TimeObj {
+	return ins.statusChangedAt
+}
+
+func (ins *User) CreatedAt() *timeobj.TimeObj {
+	return ins.createdAt
+}
+
+// IsActive: アカウントが有効かどうか
+func (ins *User) IsActive() bool {
+	return ins.status.Value() == value.StatusActive
+}
+
+// CheckActive: アカウントが有効でない場合に、状態に応じたエラー（ErrAccountPending など）を返す
+func (ins *User) CheckActive() error {
+	switch ins.status.Value() {
+	case value.StatusActive:
+		return nil
+	case value.StatusPending:
+		return ErrAccountPending
+	case value.StatusSuspended:
+		return ErrAccountSuspended
+	default:
+		return ErrAccountDeactivated
+	}
+}
+
+// Activate: 有効化待ちのアカウントを有効にする
+func (ins *User) Activate(activatedAt *timeobj.TimeObj) error {
+	return ins.changeStatus(value.StatusActive, "", activatedAt, value.StatusPending)
+}
+
+// Suspend: アカウントを停止する（管理者による操作。理由は必須）
+func (ins *User) Suspend(reason string, suspendedAt *timeobj.TimeObj) error {
+	if strings.TrimSpace(reason) == "" {
+		return errs.NewDomainError("停止の理由が指定されていません。")
+	}
+	return ins.changeStatus(value.StatusSuspended, reason, suspendedAt, value.StatusPending, value.StatusActive)
+}
+
+// Unsuspend: 停止したアカウントを有効に戻す
+func (ins *User) Unsuspend(unsuspendedAt *timeobj.TimeObj) error {
+	return ins.changeStatus(value.StatusActive, "", unsuspendedAt, value.StatusSuspended)
+}
+
+// Deactivate: アカウントを無効にする（ユーザー自身による操作。理由は任意）
+func (ins *User) Deactivate(reason string, deactivatedAt *timeobj.TimeObj) error {
+	return ins.changeStatus(value.StatusDeactivated, reason, deactivatedAt, value.StatusActive)
+}
+
+// changeStatus: 現在の状態が from のいずれかの場合のみ、状態を to に変更する
+func (ins *User) changeStatus(to string, reason string, changedAt *timeobj.TimeObj, from ...string) error {
+	if changedAt == nil {
+		return errs.NewDomainError("変更日時が指定されていません。")
+	}
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > UserStatusReasonMaxLength {
+		return errs.NewDomainError(fmt.Sprintf("理由は %d 文字以内で指定してください。", UserStatusReasonMaxLength))
+	}
+	if !slices.Contains(from, ins.status.Value()) {
+		return errs.NewDomainError(fmt.Sprintf("アカウントの状態を %s から %s に変更することはできません。", ins.status.Value(), to))
+	}
+	status, err := value.NewUserStatus(to)
+	if err != nil {
+		return err
+	}
+	ins.status = status
+	ins.statusReason = reason
+	ins.statusChangedAt = changedAt
+	return nil
 }
 
 func (ins *User) ChangeUsername(newUsername *value.UserUsername) {
 	ins.username = newUsername
 }
 
+// ChangeAvatarURL: アバターURLを変更する（nil の場合はアバターを消す）
 func (ins *User) ChangeAvatarURL(newAvatarURL *value.UserAvatarURL) {
 	ins.avatarURL = newAvatarURL
 }
 
+// ChangeRoles: ロールを指定したロールに置き換える（1 つ以上必要）
+func (ins *User) ChangeRoles(newRoles []*value.UserRole) error {
+	roles := normalizeRoles(newRoles)
+	if len(roles) == 0 {
+		return errs.NewDomainError("ロールが指定されていません。")
+	}
+	ins.roles = roles
+	return nil
+}
+
+// ChangePassword: パスワードを変更する（ハッシュ化済みの値を受け取る）
+// サービスアカウントはパスワードを持たない
+func (ins *User) ChangePassword(newPassword *value.UserPassword) error {
+	if ins.IsServiceAccount() {
+		return ErrServiceAccount
+	}
+	if newPassword == nil || !newPassword.IsSet() {
+		return errs.NewDomainError("パスワードが指定されていません。")
+	}
+	ins.password = newPassword
+	return nil
+}
+
+// ClearPassword: パスワードを取り除く（以降はパスワードではログインできない）
+// 他人が登録したメールアドレスをパスワードレスログインで確認した場合に、登録した人のパスワードを使えなくする
+func (ins *User) ClearPassword() {
+	ins.password = value.NoPassword()
+}
+
+// ChangeEmail: メールアドレスを変更する
+// 新しいアドレスの確認状態は verifiedAt で指定する（nil の場合は未確認）
+// サービスアカウントのメールアドレスは変更できない
+func (ins *User) ChangeEmail(newEmail *value.UserEmail, verifiedAt *timeobj.TimeObj) error {
+	if ins.IsServiceAccount() {
+		return ErrServiceAccount
+	}
+	if newEmail == nil {
+		return errs.NewDomainError("メールアドレスが指定されていません。")
+	}
+	if ins.email != nil && ins.email.Equals(newEmail) {
+		return errs.NewDomainError("現在と同じメールアドレスには変更できません。")
+	}
+	ins.email = newEmail
+	ins.emailVerifiedAt = verifiedAt
+	return nil
+}
+
+// IsEmailVerified: メールアドレスが確認済みかどうか
+func (ins *User) IsEmailVerified() bool {
+	return ins.emailVerifiedAt != nil
+}
+
+// VerifyEmail: メールアドレスを確認済みにする
+// 有効化待ちのアカウントは、メールアドレスの確認とともに有効にする
+func (ins *User) VerifyEmail(verifiedAt *timeobj.TimeObj) error {
+	if verifiedAt == nil {
+		return errs.NewDomainError("確認日時が指定されていません。")
+	}
+	if ins.IsEmailVerified() {
+		return errs.NewDomainError("メールアドレスは既に確認済みです。")
+	}
+	if ins.status.Value() == value.StatusPending {
+		if err := ins.Activate(verifiedAt); err != nil {
+			return err
+		}
+	}
+	ins.emailVerifiedAt = verifiedAt
+	return nil
+}
+
 // 同一性の確認
 func (ins *User) Equals(obj *User) (bool, error) {
 	if obj == nil {
@@ -78,32 +284,131 @@ func NewUser(email *value.UserEmail, password *value.UserPassword, username *val
 		return nil, errs.NewDomainError(err.Error())
 	}
 
-	defaultRole, err := value.NewUserRole("user")
+	defaultRole, err := value.NewUserRole(value.RegularUser)
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	pending, err := value.NewUserStatus(value.StatusPending)
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	createdAt, err := timeobj.NewTimeObj(time.Now())
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	kind, err := value.NewUserKind(value.KindUser)
 	if err != nil {
 		return nil, errs.NewDomainError(err.Error())
 	}
 
 	return &User{
 		objID:           id,
+		kind:            kind,
 		email:           email,
 		password:        password,
 		username:        username,
 		avatarURL:       nil, // 未設定状態
 		emailVerifiedAt: nil, // 未検証状態
 		lastLoginAt:     nil, // 未ログイン状態
-		role:            defaultRole,
+		roles:           []*value.UserRole{defaultRole},
+		status:          pending, // メールアドレスの確認（または Activate）まで有効化待ち
+		statusChangedAt: createdAt,
+		createdAt:       createdAt,
+	}, nil
+}
+
+// NewServiceAccountUser: サービスアカウントのユーザーを作成する
+// パスワードは持たず（パスワードではログインできない）、メールアドレスは ServiceAccountEmailDomain の確認済みのアドレスになる
+// メールアドレスの確認が不要なため、作成時から有効になる
+func NewServiceAccountUser(username *value.UserUsername) (*User, error) {
+	uid, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	id, err := value.NewUserObjID(uid.String())
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	email, err := value.NewUserEmail(id.Value() + "@" + ServiceAccountEmailDomain)
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	kind, err := value.NewUserKind(value.KindServiceAccount)
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	defaultRole, err := value.NewUserRole(value.RegularUser)
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	active, err := value.NewUserStatus(value.StatusActive)
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+	createdAt, err := timeobj.NewTimeObj(time.Now())
+	if err != nil {
+		return nil, errs.NewDomainError(err.Error())
+	}
+
+	return &User{
+		objID:           id,
+		kind:            kind,
+		email:           email,
+		password:        value.NoPassword(),
+		username:        username,
+		emailVerifiedAt: createdAt,
+		roles:           []*value.UserRole{defaultRole},
+		status:          active,
+		statusChangedAt: createdAt,
+		createdAt:       createdAt,
 	}, nil
 }
 
-func BuildUser(objID *value.UserObjID, email *value.UserEmail, password *value.UserPassword, username *value.UserUsername, avatarURL *value.UserAvatarURL, emailVerifiedAt *timeobj.TimeObj, lastLoginAt *timeobj.TimeObj, role *value.UserRole) (*User, error) {
+// BuildUser の kind が nil の場合は、人が利用するアカウント（value.KindUser）として扱う
+func BuildUser(objID *value.UserObjID, email *value.UserEmail, password *value.UserPassword, username *value.UserUsername, avatarURL *value.UserAvatarURL, emailVerifiedAt *timeobj.TimeObj, lastLoginAt *timeobj.TimeObj, roles []*value.UserRole, status *value.UserStatus, statusReason string, statusChangedAt *timeobj.TimeObj, createdAt *timeobj.TimeObj, kind *value.UserKind) (*User, error) {
+	if kind == nil {
+		userKind, err := value.NewUserKind(value.KindUser)
+		if err != nil {
+			return nil, err
+		}
+		kind = userKind
+	}
 	return &User{
 		objID:           objID,
+		kind:            kind,
 		email:           email,
 		password:        password,
 		username:        username,
 		avatarURL:       avatarURL,
 		emailVerifiedAt: emailVerifiedAt,
 		lastLoginAt:     lastLoginAt,
-		role:            role,
+		roles:           normalizeRoles(roles),
+		status:          status,
+		statusReason:    statusReason,
+		statusChangedAt: statusChangedAt,
+		createdAt:       createdAt,
 	}, nil
 }
+
+// normalizeRoles はロールの重複を取り除き、名前順に並べる
+func normalizeRoles(roles []*value.UserRole) []*value.UserRole {
+	normalized := make([]*value.UserRole, 0, len(roles))
+	for _, role := range roles {
+		if role != nil {
+			normalized = append(normalized, role)
+		}
+	}
+	slices.SortFunc(normalized, func(a, b *value.UserRole) int {
+		return cmp.Compare(a.Value(), b.Value())
+	})
+	return slices.CompactFunc(normalized, func(a, b *value.UserRole) bool {
+		return a.Value() == b.Value()
+	})
+}
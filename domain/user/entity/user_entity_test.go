@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -55,6 +56,14 @@ func dummyUserRole() *value.UserRole {
 	return role
 }
 
+func dummyUserStatus(status string) *value.UserStatus {
+	s, err := value.NewUserStatus(status)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
 func dummyTimeObj() *timeobj.TimeObj {
 	// 現在時刻を元に TimeObj を生成する（エラー処理は panic で簡略化）
 	timeObj, err := timeobj.NewTimeObj(time.Now())
@@ -79,7 +88,33 @@ func TestNewUser(t *testing.T) {
 	assert.Equal(t, email, u.Email(), "Email が正しくセットされていること")
 	assert.Equal(t, password, u.Password(), "Password が正しくセットされていること")
 	assert.Equal(t, username, u.Username(), "Username が正しくセットされていること")
-	assert.Equal(t, role, u.Role(), "Role が正しくセットされていること")
+	assert.Equal(t, []*value.UserRole{role}, u.Roles(), "既定のロール（user）がセットされていること")
+	assert.Equal(t, value.StatusPending, u.Status().Value(), "生成直後は有効化待ちであること")
+	assert.NotNil(t, u.StatusChangedAt())
+	assert.Equal(t, u.StatusChangedAt(), u.CreatedAt(), "作成日時が設定されていること")
+	assert.ErrorIs(t, u.CheckActive(), ErrAccountPending)
+	assert.Equal(t, value.KindUser, u.Kind().Value(), "人が利用するアカウントであること")
+	assert.False(t, u.IsServiceAccount())
+}
+
+func TestNewServiceAccountUser(t *testing.T) {
+	username := dummyUserUsername()
+
+	u, err := NewServiceAccountUser(username)
+	assert.NoError(t, err)
+	assert.True(t, u.IsServiceAccount(), "サービスアカウントであること")
+	assert.Equal(t, value.KindServiceAccount, u.Kind().Value())
+	assert.Equal(t, username, u.Username())
+	assert.Equal(t, u.ObjID().Value()+"@"+ServiceAccountEmailDomain, u.Email().Value(), "予約済みのドメインのアドレスが割り当てられること")
+	assert.False(t, u.Password().IsSet(), "パスワードを持たないこと")
+	assert.True(t, u.IsEmailVerified())
+	assert.NoError(t, u.CheckActive(), "作成時から有効であること")
+	assert.Equal(t, []string{value.RegularUser}, u.RoleNames())
+
+	// パスワードとメールアドレスは変更できない
+	assert.ErrorIs(t, u.ChangePassword(value.FromHashed("hashed")), ErrServiceAccount)
+	assert.False(t, u.Password().IsSet())
+	assert.ErrorIs(t, u.ChangeEmail(dummyUserEmail(), nil), ErrServiceAccount)
 }
 
 func TestBuildUser(t *testing.T) {
@@ -96,10 +131,17 @@ func TestBuildUser(t *testing.T) {
 	u1, err := NewUser(email, password, username)
 	assert.NoError(t, err)
 
-	u2, err := BuildUser(u1.ObjID(), email, password, username, avatarURL, emailVerifiedAt, lastLoginAt, role)
+	u2, err := BuildUser(u1.ObjID(), email, password, username, avatarURL, emailVerifiedAt, lastLoginAt, []*value.UserRole{role}, dummyUserStatus(value.StatusActive), "", dummyTimeObj(), dummyTimeObj(), nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, u2)
 	assert.Equal(t, u1.ObjID(), u2.ObjID(), "ObjID が一致していること")
+	assert.Equal(t, value.KindUser, u2.Kind().Value(), "kind が nil の場合は人が利用するアカウントになること")
+
+	kind, err := value.NewUserKind(value.KindServiceAccount)
+	assert.NoError(t, err)
+	u3, err := BuildUser(u1.ObjID(), email, value.NoPassword(), username, nil, emailVerifiedAt, nil, []*value.UserRole{role}, dummyUserStatus(value.StatusActive), "", dummyTimeObj(), dummyTimeObj(), kind)
+	assert.NoError(t, err)
+	assert.True(t, u3.IsServiceAccount())
 }
 
 func TestUserEquals(t *testing.T) {
@@ -114,7 +156,7 @@ func TestUserEquals(t *testing.T) {
 
 	u1, err := NewUser(email, password, username)
 	assert.NoError(t, err)
-	u2, err := BuildUser(u1.ObjID(), email, password, username, avatarURL, emailVerifiedAt, lastLoginAt, role)
+	u2, err := BuildUser(u1.ObjID(), email, password, username, avatarURL, emailVerifiedAt, lastLoginAt, []*value.UserRole{role}, dummyUserStatus(value.StatusActive), "", dummyTimeObj(), dummyTimeObj(), nil)
 	assert.NoError(t, err)
 
 	// Equals で同一のオブジェクトと判断されること
@@ -127,3 +169,135 @@ func TestUserEquals(t *testing.T) {
 	assert.Error(t, err, "nil を引数にした場合はエラーが返ること")
 	assert.False(t, equal, "nil を引数にした場合 Equals は false を返す")
 }
+
+func TestUserVerifyEmail(t *testing.T) {
+	u, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+	assert.False(t, u.IsEmailVerified(), "生成直後は未確認であること")
+
+	verifiedAt := dummyTimeObj()
+	err = u.VerifyEmail(verifiedAt)
+	assert.NoError(t, err, "未確認のユーザーは確認済みにできること")
+	assert.True(t, u.IsEmailVerified(), "確認後は確認済みであること")
+	assert.Equal(t, verifiedAt, u.EmailVerifiedAt(), "確認日時がセットされていること")
+	assert.True(t, u.IsActive(), "有効化待ちのアカウントは確認とともに有効になること")
+	assert.Equal(t, verifiedAt, u.StatusChangedAt())
+
+	// 確認済みのユーザーを再度確認しようとするとエラーになること
+	err = u.VerifyEmail(dummyTimeObj())
+	assert.Error(t, err, "確認済みのユーザーを再度確認するとエラーが返ること")
+	assert.Equal(t, verifiedAt, u.EmailVerifiedAt(), "確認日時が変わらないこと")
+
+	// nil を指定した場合はエラーが返ること
+	u2, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+	assert.Error(t, u2.VerifyEmail(nil), "nil を指定した場合はエラーが返ること")
+	assert.False(t, u2.IsEmailVerified())
+}
+
+func TestUserChangeEmail(t *testing.T) {
+	u, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+	assert.NoError(t, u.VerifyEmail(dummyTimeObj()))
+
+	// 確認日時を指定して変更した場合、新しいアドレスが確認済みになること
+	newEmail := dummyUserEmail()
+	verifiedAt := dummyTimeObj()
+	err = u.ChangeEmail(newEmail, verifiedAt)
+	assert.NoError(t, err)
+	assert.Equal(t, newEmail, u.Email(), "メールアドレスが変更されていること")
+	assert.Equal(t, verifiedAt, u.EmailVerifiedAt(), "確認日時が新しいアドレスのものになること")
+
+	// 確認日時を指定せずに変更した場合、未確認になること
+	err = u.ChangeEmail(dummyUserEmail(), nil)
+	assert.NoError(t, err)
+	assert.False(t, u.IsEmailVerified(), "未確認の状態になること")
+
+	// 同じアドレスや nil は指定できないこと
+	assert.Error(t, u.ChangeEmail(u.Email(), nil), "同じメールアドレスの場合はエラーが返ること")
+	assert.Error(t, u.ChangeEmail(nil, nil), "nil の場合はエラーが返ること")
+}
+
+func TestUserChangeRoles(t *testing.T) {
+	u, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+
+	admin, err := value.NewUserRole(value.Admin)
+	assert.NoError(t, err)
+	support, err := value.NewUserRole("support")
+	assert.NoError(t, err)
+	assert.NoError(t, u.ChangeRoles([]*value.UserRole{support, admin, support}))
+	assert.Equal(t, []string{value.Admin, "support"}, u.RoleNames(), "重複を取り除いて名前順に置き換えること")
+	assert.True(t, u.HasRole(value.Admin))
+	assert.False(t, u.HasRole(value.RegularUser), "元のロールは取り除かれること")
+
+	assert.Error(t, u.ChangeRoles(nil), "ロールが空の場合はエラーが返ること")
+	assert.Error(t, u.ChangeRoles([]*value.UserRole{nil}), "nil のみの場合はエラーが返ること")
+	assert.Equal(t, []string{value.Admin, "support"}, u.RoleNames())
+}
+
+func TestUserChangePassword(t *testing.T) {
+	user, err := NewUser(dummyUserEmail(), value.NoPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+
+	newPassword, err := value.NewUserPassword("newpassword123")
+	assert.NoError(t, err)
+	assert.NoError(t, user.ChangePassword(newPassword))
+	assert.True(t, user.Password().Verify("newpassword123"))
+
+	assert.Error(t, user.ChangePassword(nil))
+	assert.Error(t, user.ChangePassword(value.NoPassword()), "パスワードを未設定に戻すことはできない")
+	assert.True(t, user.Password().Verify("newpassword123"))
+
+	user.ClearPassword()
+	assert.False(t, user.Password().IsSet(), "取り除いたパスワードは未設定になること")
+	assert.False(t, user.Password().Verify("newpassword123"))
+}
+
+func TestUserStatusTransitions(t *testing.T) {
+	u, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+
+	// 有効化待ち → 有効
+	assert.Error(t, u.Activate(nil), "変更日時が必要")
+	assert.Error(t, u.Deactivate("", dummyTimeObj()), "有効化待ちのアカウントは無効化できない")
+	assert.NoError(t, u.Activate(dummyTimeObj()))
+	assert.True(t, u.IsActive())
+	assert.NoError(t, u.CheckActive())
+	assert.Error(t, u.Activate(dummyTimeObj()), "有効なアカウントを再度有効化することはできない")
+
+	// 有効 → 停止 → 有効
+	assert.Error(t, u.Suspend("  ", dummyTimeObj()), "停止の理由が必要")
+	suspendedAt := dummyTimeObj()
+	assert.NoError(t, u.Suspend(" 規約違反 ", suspendedAt))
+	assert.Equal(t, value.StatusSuspended, u.Status().Value())
+	assert.Equal(t, "規約違反", u.StatusReason())
+	assert.Equal(t, suspendedAt, u.StatusChangedAt())
+	assert.ErrorIs(t, u.CheckActive(), ErrAccountSuspended)
+	assert.Error(t, u.Deactivate("", dummyTimeObj()), "停止中のアカウントは無効化できない")
+	assert.NoError(t, u.Unsuspend(dummyTimeObj()))
+	assert.True(t, u.IsActive())
+	assert.Empty(t, u.StatusReason(), "有効に戻すと理由は消えること")
+	assert.Error(t, u.Unsuspend(dummyTimeObj()), "停止していないアカウントは戻せない")
+
+	// 有効 → 無効化（戻せない）
+	assert.Error(t, u.Deactivate(strings.Repeat("あ", UserStatusReasonMaxLength+1), dummyTimeObj()), "理由が長すぎる")
+	assert.True(t, u.IsActive())
+	assert.NoError(t, u.Deactivate("使わなくなった", dummyTimeObj()))
+	assert.Equal(t, value.StatusDeactivated, u.Status().Value())
+	assert.Equal(t, "使わなくなった", u.StatusReason())
+	assert.ErrorIs(t, u.CheckActive(), ErrAccountDeactivated)
+	assert.Error(t, u.Activate(dummyTimeObj()))
+	assert.Error(t, u.Unsuspend(dummyTimeObj()))
+	assert.Error(t, u.Suspend("規約違反", dummyTimeObj()))
+}
+
+func TestUserSuspend_Pending(t *testing.T) {
+	u, err := NewUser(dummyUserEmail(), dummyUserPassword(), dummyUserUsername())
+	assert.NoError(t, err)
+
+	assert.NoError(t, u.Suspend("不正な登録", dummyTimeObj()), "有効化待ちのアカウントも停止できること")
+	// 停止中にメールアドレスを確認しても有効にならないこと
+	assert.NoError(t, u.VerifyEmail(dummyTimeObj()))
+	assert.Equal(t, value.StatusSuspended, u.Status().Value())
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type APIKeyRepository interface {
+	// CreateAPIKey: API キーを登録
+	CreateAPIKey(key *entity.APIKey) (*entity.APIKey, error)
+
+	// FindAPIKeyByHash: キーのハッシュで API キーを取得（存在しない場合は nil, nil を返す）
+	FindAPIKeyByHash(secretHash string) (*entity.APIKey, error)
+
+	// ListAPIKeys: ユーザーの API キーを作成順に取得（有効期限切れを含む）
+	ListAPIKeys(userObjID string) ([]*entity.APIKey, error)
+
+	// TouchAPIKey: API キーの最終使用日時を更新
+	TouchAPIKey(id string, usedAt time.Time) error
+
+	// DeleteAPIKey: ユーザーの API キーを削除（該当するキーがない場合は false を返す）
+	DeleteAPIKey(userObjID string, id string) (bool, error)
+}
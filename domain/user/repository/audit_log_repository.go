@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type AuditLogRepository interface {
+	// SaveAuditLog: 管理者による操作を記録
+	SaveAuditLog(log *entity.AuditLog) error
+}
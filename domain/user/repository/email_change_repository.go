@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type EmailChangeRepository interface {
+	// CreateEmailChange: メールアドレス変更リクエストを作成
+	CreateEmailChange(emailChange *entity.EmailChange) (*entity.EmailChange, error)
+
+	// GetEmailChangeByID: IDでメールアドレス変更リクエストを取得
+	GetEmailChangeByID(id string) (*entity.EmailChange, error)
+
+	// UpdateEmailChange: メールアドレス変更リクエストの状態を更新
+	UpdateEmailChange(emailChange *entity.EmailChange) (*entity.EmailChange, error)
+
+	// CancelPendingEmailChanges: 指定ユーザーの確認待ちのリクエストをすべて取り消す
+	CancelPendingEmailChanges(userObjID string) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"time"
+)
+
+type EmailVerificationRepository interface {
+	// RecordSend: メールアドレス（正規化したキー）に確認メールを送信した日時を記録
+	RecordSend(emailKey string, sentAt time.Time) error
+
+	// ClaimSend: 最後の送信から interval 以上経過している場合のみ送信日時を記録する（経過していない場合は false を返す）
+	// 判定と記録は 1 つの UPSERT で行い、複数のインスタンスからの同時リクエストでも 1 件のみ記録する
+	ClaimSend(emailKey string, sentAt time.Time, interval time.Duration) (bool, error)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+// GroupMembers は、グループに直接所属するユーザーと入れ子のグループ
+type GroupMembers struct {
+	UserObjIDs  []string // 所属した日時の順
+	GroupObjIDs []string // 追加した日時の順
+}
+
+type GroupRepository interface {
+	// ListGroups: すべてのグループを名前順に取得
+	ListGroups() ([]*entity.Group, error)
+
+	// FindGroup: IDでグループを取得（存在しない場合は nil）
+	FindGroup(groupObjID string) (*entity.Group, error)
+
+	// FindGroupByName: 名前でグループを取得（存在しない場合は nil）
+	FindGroupByName(name string) (*entity.Group, error)
+
+	// FindGroupsByIDs: 指定したIDのグループのうち、存在するものを名前順に取得
+	FindGroupsByIDs(groupObjIDs []string) ([]*entity.Group, error)
+
+	// CreateGroup: グループを作成
+	CreateGroup(group *entity.Group) (*entity.Group, error)
+
+	// UpdateGroup: グループの説明とロール・権限を更新
+	UpdateGroup(group *entity.Group) (*entity.Group, error)
+
+	// DeleteGroup: グループと、グループへの所属（ユーザー・入れ子のグループ、他のグループへの所属）を削除
+	DeleteGroup(groupObjID string) error
+
+	// ListMembers: グループに直接所属するユーザーと入れ子のグループを取得
+	ListMembers(groupObjID string) (*GroupMembers, error)
+
+	// AddUser: ユーザーをグループに所属させる（所属済みの場合は何もしない）
+	AddUser(groupObjID string, userObjID string) error
+
+	// RemoveUser: ユーザーをグループから外す（所属していない場合は何もしない）
+	RemoveUser(groupObjID string, userObjID string) error
+
+	// AddSubgroup: グループを親のグループに所属させる（所属済みの場合は何もしない）
+	AddSubgroup(parentObjID string, childObjID string) error
+
+	// RemoveSubgroup: グループを親のグループから外す（所属していない場合は何もしない）
+	RemoveSubgroup(parentObjID string, childObjID string) error
+
+	// FindUserGroupIDs: ユーザーが直接所属するグループのIDを取得
+	FindUserGroupIDs(userObjID string) ([]string, error)
+
+	// FindParentGroupIDs: 指定したグループのいずれかを直接含むグループのIDを取得
+	FindParentGroupIDs(groupObjIDs []string) ([]string, error)
+}
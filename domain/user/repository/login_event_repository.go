@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type LoginEventRepository interface {
+	// SaveLoginEvent: ログインの試行を記録（成功の場合はユーザーの最終ログイン日時も更新する）
+	// 新しい端末・接続元からのログインの場合は、同じトランザクションで通知のためのイベントを記録する
+	SaveLoginEvent(event *entity.LoginEvent) error
+
+	// ListSucceededLoginEvents: since 以降に成功したユーザーのログインの記録を新しい順に最大 limit 件取得
+	ListSucceededLoginEvents(userObjID string, since time.Time, limit int) ([]*entity.LoginEvent, error)
+
+	// ListLoginEvents: ユーザーのログインの記録を新しい順に offset 件目から最大 limit 件取得し、全体の件数とともに返す
+	ListLoginEvents(userObjID string, offset int, limit int) ([]*entity.LoginEvent, int64, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type LoginFailureRepository interface {
+	// FindFailure: ログイン失敗の記録を取得（存在しない場合は nil, nil を返す）
+	FindFailure(scope string, key string) (*entity.LoginFailure, error)
+
+	// RecordFailure: 失敗回数を 1 加算し、加算後の記録を返す
+	// 最後の失敗から window 以上経過している場合は 1 からやり直し、ロックも解除する
+	RecordFailure(scope string, key string, failedAt time.Time, window time.Duration) (*entity.LoginFailure, error)
+
+	// LockFailure: 指定した日時までロックする
+	LockFailure(scope string, key string, until time.Time) error
+
+	// ResetFailures: 失敗回数を 0 に戻し、ロックを解除する
+	ResetFailures(scope string, key string) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type MFARepository interface {
+	// SaveTOTPFactor: ユーザーの TOTP を保存（既存の登録は置き換える）
+	SaveTOTPFactor(factor *entity.TOTPFactor) (*entity.TOTPFactor, error)
+
+	// FindTOTPFactor: ユーザーの TOTP を取得（未登録の場合は nil, nil を返す）
+	FindTOTPFactor(userObjID string) (*entity.TOTPFactor, error)
+
+	// ConsumeTOTPStep: 受け付けたコードのタイムステップを記録（記録済み以前のステップの場合は false を返す）
+	ConsumeTOTPStep(userObjID string, step int64) (bool, error)
+
+	// ReplaceRecoveryCodes: ユーザーのリカバリーコードをすべて置き換える
+	ReplaceRecoveryCodes(userObjID string, codes []*entity.RecoveryCode) error
+
+	// UseRecoveryCode: 未使用のリカバリーコードを使用済みにする（該当するコードがない場合は false を返す）
+	UseRecoveryCode(userObjID string, codeHash string) (bool, error)
+}
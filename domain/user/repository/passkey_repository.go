@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type PasskeyRepository interface {
+	// CreateCredential: パスキーを登録
+	CreateCredential(credential *entity.PasskeyCredential) (*entity.PasskeyCredential, error)
+
+	// ListCredentials: ユーザーのパスキーを登録順に取得
+	ListCredentials(userObjID string) ([]*entity.PasskeyCredential, error)
+
+	// UpdateCredentialUsage: 認証に使われたパスキーの署名カウンター・最終使用日時を更新
+	// 保存済みのカウンター以下への更新（同時に使われた場合など）は false を返す。
+	UpdateCredentialUsage(credential *entity.PasskeyCredential) (bool, error)
+
+	// SaveSession: セレモニーの状態を保存
+	SaveSession(session *entity.PasskeySession) error
+
+	// ConsumeSession: セレモニーの状態を取得して削除（存在しない場合は nil, nil を返す）
+	ConsumeSession(id string) (*entity.PasskeySession, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+type PasswordlessRepository interface {
+	// CreateChallenge: パスワードレスログインのチャレンジを作成
+	CreateChallenge(challenge *entity.PasswordlessChallenge) (*entity.PasswordlessChallenge, error)
+
+	// FindChallenge: IDでチャレンジを取得（存在しない場合は nil, nil を返す）
+	FindChallenge(id string) (*entity.PasswordlessChallenge, error)
+
+	// FindLatestChallenge: メールアドレス（正規化したキーで同一視する）に対して最後に作成されたチャレンジを取得（存在しない場合は nil, nil を返す）
+	FindLatestChallenge(email *value.UserEmail) (*entity.PasswordlessChallenge, error)
+
+	// IncrementAttempts: 未使用のチャレンジのコード検証の試行回数を加算（上限に達している・使用済みの場合は false を返す）
+	IncrementAttempts(id string, maxAttempts int) (bool, error)
+
+	// ConsumeChallenge: 未使用のチャレンジを使用済みにする（使用済みの場合は false を返す）
+	ConsumeChallenge(id string) (bool, error)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type RoleRepository interface {
+	// ListRoles: すべてのロールを名前順に取得
+	ListRoles() ([]*entity.Role, error)
+
+	// FindRole: 指定した名前のロールを取得（存在しない場合は nil）
+	FindRole(name string) (*entity.Role, error)
+
+	// FindRolesByNames: 指定した名前のロールのうち、存在するものを名前順に取得
+	FindRolesByNames(names []string) ([]*entity.Role, error)
+
+	// CreateRole: ロールを作成
+	CreateRole(role *entity.Role) (*entity.Role, error)
+
+	// EnsureRoles: 指定したロールのうち存在しないものを作成（既存のロールは変更しない）
+	EnsureRoles(roles []*entity.Role) error
+
+	// UpdateRole: ロールの説明と権限を更新
+	UpdateRole(role *entity.Role) (*entity.Role, error)
+
+	// DeleteRole: ロールを削除し、ロールを持つユーザー・グループからも取り除く
+	DeleteRole(name string) error
+}
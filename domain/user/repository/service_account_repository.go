@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+// サービスアカウントの削除は UserRepository.DeleteUser で行う
+type ServiceAccountRepository interface {
+	// CreateServiceAccount: サービスアカウントをユーザーとともに作成
+	// 組織が所有する場合は、組織のメンバー（member）としても登録する
+	CreateServiceAccount(account *entity.ServiceAccount) (*entity.ServiceAccount, error)
+
+	// FindServiceAccount: IDでサービスアカウントを取得（存在しない場合は nil, nil を返す）
+	FindServiceAccount(objID string) (*entity.ServiceAccount, error)
+
+	// ListServiceAccounts: すべてのサービスアカウントを作成順に取得
+	ListServiceAccounts() ([]*entity.ServiceAccount, error)
+}
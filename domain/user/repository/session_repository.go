@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"time"
+)
+
+type SessionRepository interface {
+	// RevokeSessions: revokedAt より前に発行したユーザーのトークンをすべて無効にする（ユーザーが存在しない場合は false を返す）
+	RevokeSessions(userObjID string, revokedAt time.Time) (bool, error)
+
+	// GetSessionsRevokedAt: ユーザーのトークンを無効にした日時を取得（無効にしたことがない場合は nil を返す）
+	GetSessionsRevokedAt(userObjID string) (*time.Time, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+// TxRepositories は、ひとつのトランザクションの中で使うリポジトリ
+type TxRepositories struct {
+	Users           UserRepository
+	Sessions        SessionRepository
+	AuditLogs       AuditLogRepository
+	Roles           RoleRepository
+	Groups          GroupRepository
+	ServiceAccounts ServiceAccountRepository
+	APIKeys         APIKeyRepository
+	EmailChanges    EmailChangeRepository
+}
+
+type Transactor interface {
+	// Transaction: fn に渡したリポジトリでの操作をひとつのトランザクションで行う（fn がエラーを返した場合はすべて取り消す）
+	Transaction(fn func(repos *TxRepositories) error) error
+}
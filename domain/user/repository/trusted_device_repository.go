@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+type TrustedDeviceRepository interface {
+	// CreateDevice: 信頼済み端末を登録
+	CreateDevice(device *entity.TrustedDevice) (*entity.TrustedDevice, error)
+
+	// FindDevice: ID で信頼済み端末を取得（存在しない場合は nil, nil を返す）
+	FindDevice(id string) (*entity.TrustedDevice, error)
+
+	// ListDevices: ユーザーの信頼済み端末を登録順に取得（有効期限切れを含む）
+	ListDevices(userObjID string) ([]*entity.TrustedDevice, error)
+
+	// TouchDevice: 信頼済み端末の最終使用日時を更新
+	TouchDevice(id string, usedAt time.Time) error
+
+	// DeleteDevice: ユーザーの信頼済み端末を削除（該当する端末がない場合は false を返す）
+	DeleteDevice(userObjID string, id string) (bool, error)
+}
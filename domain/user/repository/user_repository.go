@@ -1,9 +1,27 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 )
 
+// UserListFilter は、ユーザーの一覧の絞り込み条件（空・nil の項目では絞り込まない）
+type UserListFilter struct {
+	Email       string      // メールアドレスの部分一致（大文字小文字を区別しない）
+	Role        string      // このロールを持つユーザー
+	Status      string      // アカウントの状態
+	CreatedFrom *time.Time  // この日時以降に作成したユーザー
+	CreatedTo   *time.Time  // この日時より前に作成したユーザー
+	After       *UserCursor // 指定したユーザーより後（古い方）のユーザー
+}
+
+// UserCursor は、ユーザーの一覧での位置（作成日時とユーザーID）
+type UserCursor struct {
+	CreatedAt time.Time
+	ObjID     string
+}
+
 type UserRepository interface {
 	// CreateUser: 新規ユーザーを作成
 	CreateUser(user *entity.User) (*entity.User, error)
@@ -14,6 +32,9 @@ type UserRepository interface {
 	// GetUserByObjID: 外部識別用のUUID (ObjID) でユーザーを取得
 	GetUserByObjID(objID string) (*entity.User, error)
 
+	// ListUsers: 条件に一致するユーザーを作成日時の新しい順に最大 limit 件取得
+	ListUsers(filter UserListFilter, limit int) ([]*entity.User, error)
+
 	// UpdateUser: ユーザー情報を更新
 	UpdateUser(user *entity.User) (*entity.User, error)
 
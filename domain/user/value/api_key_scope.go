@@ -0,0 +1,60 @@
+package value
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// API キーのスコープ（"リソース:操作" 形式）。API キーで利用できる操作を限定する
+// 管理者用の操作には、権限と同じ名前のスコープ（users:read など）を使う（ユーザー自身がその権限を持つ必要もある）
+const (
+	APIKeyScopeAll                = "*"                   // すべての操作
+	APIKeyScopeProfileRead        = "profile:read"        // 自分のプロフィール・権限・ログイン履歴の参照
+	APIKeyScopeProfileWrite       = "profile:write"       // 自分のプロフィールの変更
+	APIKeyScopeOrganizationsRead  = "organizations:read"  // 所属する組織とメンバーの参照
+	APIKeyScopeOrganizationsWrite = "organizations:write" // 組織の作成・変更・削除とメンバー・招待の管理
+	APIKeyScopeAuthzCheck         = "authz:check"         // 認可の判定
+)
+
+// knownAPIKeyScopes は、API キーに与えられるスコープの一覧
+var knownAPIKeyScopes = append([]string{
+	APIKeyScopeAll,
+	APIKeyScopeProfileRead,
+	APIKeyScopeProfileWrite,
+	APIKeyScopeOrganizationsRead,
+	APIKeyScopeOrganizationsWrite,
+	APIKeyScopeAuthzCheck,
+}, slices.DeleteFunc(KnownPermissions(), func(permission string) bool { return permission == PermissionAll })...)
+
+type APIKeyScope struct {
+	value string
+}
+
+func (s *APIKeyScope) Value() string {
+	return s.value
+}
+
+// Grants: このスコープが scope を満たすかどうか
+func (s *APIKeyScope) Grants(scope string) bool {
+	return s.value == APIKeyScopeAll || s.value == scope
+}
+
+// ScopesGrant: スコープの一覧が scope を満たすかどうか（API キー・サービスアカウントのトークンのスコープの判定に使う）
+func ScopesGrant(scopes []string, scope string) bool {
+	return slices.Contains(scopes, APIKeyScopeAll) || slices.Contains(scopes, scope)
+}
+
+// KnownAPIKeyScopes: API キーに与えられるスコープの一覧
+func KnownAPIKeyScopes() []string {
+	return slices.Clone(knownAPIKeyScopes)
+}
+
+func NewAPIKeyScope(value string) (*APIKeyScope, error) {
+	// 定義されているスコープかチェックする
+	if !slices.Contains(knownAPIKeyScopes, value) {
+		return nil, errs.NewDomainError(fmt.Sprintf("無効なスコープ: %s", value))
+	}
+	return &APIKeyScope{value: value}, nil
+}
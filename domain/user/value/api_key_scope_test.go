@@ -0,0 +1,44 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIKeyScope_Valid(t *testing.T) {
+	for _, name := range KnownAPIKeyScopes() {
+		scope, err := NewAPIKeyScope(name)
+		assert.NoError(t, err, "定義されているスコープは有効であること: %s", name)
+		assert.Equal(t, name, scope.Value())
+	}
+	for _, name := range []string{PermissionUsersRead, PermissionGroupsWrite} {
+		_, err := NewAPIKeyScope(name)
+		assert.NoError(t, err, "権限と同じ名前のスコープを使えること: %s", name)
+	}
+}
+
+func TestNewAPIKeyScope_Invalid(t *testing.T) {
+	for _, name := range []string{"", "profile", "profile:delete", "PROFILE:READ", "profile:*"} {
+		scope, err := NewAPIKeyScope(name)
+		assert.Error(t, err, "定義されていないスコープはエラーになること: %q", name)
+		assert.Nil(t, scope)
+	}
+}
+
+func TestAPIKeyScope_Grants(t *testing.T) {
+	read, _ := NewAPIKeyScope(APIKeyScopeProfileRead)
+	assert.True(t, read.Grants(APIKeyScopeProfileRead))
+	assert.False(t, read.Grants(APIKeyScopeProfileWrite), "別のスコープは満たさないこと")
+
+	all, _ := NewAPIKeyScope(APIKeyScopeAll)
+	assert.True(t, all.Grants(APIKeyScopeProfileWrite), "* はすべてのスコープを満たすこと")
+	assert.True(t, all.Grants(PermissionUsersWrite))
+}
+
+func TestScopesGrant(t *testing.T) {
+	assert.True(t, ScopesGrant([]string{APIKeyScopeProfileRead, PermissionUsersRead}, PermissionUsersRead))
+	assert.False(t, ScopesGrant([]string{APIKeyScopeProfileRead}, APIKeyScopeProfileWrite), "含まれないスコープは満たさないこと")
+	assert.True(t, ScopesGrant([]string{APIKeyScopeAll}, PermissionUsersWrite), "* はすべてのスコープを満たすこと")
+	assert.False(t, ScopesGrant(nil, APIKeyScopeProfileRead), "スコープがない場合は満たさないこと")
+}
@@ -0,0 +1,27 @@
+package value
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// グループ名は英小文字で始まる英小文字・数字・"-"・"_"（50 文字以内。トークンの groups クレームにそのまま含める）
+var groupNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}$`)
+
+// GroupName はテナント内で一意なグループの名前
+type GroupName struct {
+	value string
+}
+
+func (ins *GroupName) Value() string {
+	return ins.value
+}
+
+func NewGroupName(value string) (*GroupName, error) {
+	if !groupNamePattern.MatchString(value) {
+		return nil, errs.NewDomainError(fmt.Sprintf("無効なグループ名: %s", value))
+	}
+	return &GroupName{value: value}, nil
+}
@@ -0,0 +1,18 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGroupName(t *testing.T) {
+	name, err := NewGroupName("engineering_2-tokyo")
+	assert.NoError(t, err)
+	assert.Equal(t, "engineering_2-tokyo", name.Value())
+
+	for _, s := range []string{"", "Engineering", "2nd", "sales team", "groups:read", "a123456789012345678901234567890123456789012345678901"} {
+		_, err := NewGroupName(s)
+		assert.Error(t, err, "不正な名前のグループはエラーになること: %q", s)
+	}
+}
@@ -0,0 +1,34 @@
+package value
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// GroupObjID はグループの外部識別用の ID（UUID）
+type GroupObjID struct {
+	value string
+}
+
+func (ins *GroupObjID) Value() string {
+	return ins.value
+}
+
+func (ins *GroupObjID) Equals(value *GroupObjID) bool {
+	return ins.value == value.Value()
+}
+
+func NewGroupObjID(value string) (*GroupObjID, error) {
+	const LENGTH int = 36
+	const REGEXP string = "(^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$)"
+	if utf8.RuneCountInString(value) != LENGTH {
+		return nil, errs.NewDomainError(fmt.Sprintf("グループIDの文字数は%d文字でなければなりません。", LENGTH))
+	}
+	if !regexp.MustCompile(REGEXP).Match([]byte(value)) {
+		return nil, errs.NewDomainError("グループIDはUUID形式でなければなりません。")
+	}
+	return &GroupObjID{value: value}, nil
+}
@@ -0,0 +1,21 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGroupObjID(t *testing.T) {
+	id, err := NewGroupObjID("123e4567-e89b-12d3-a456-426614174000")
+	assert.NoError(t, err)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", id.Value())
+
+	other, _ := NewGroupObjID("123e4567-e89b-12d3-a456-426614174000")
+	assert.True(t, id.Equals(other))
+
+	for _, s := range []string{"", "short", "123e4567-e89b-12d3-a456-42661417400g"} {
+		_, err := NewGroupObjID(s)
+		assert.Error(t, err, s)
+	}
+}
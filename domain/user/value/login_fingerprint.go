@@ -0,0 +1,108 @@
+package value
+
+import (
+	"net"
+	"strings"
+)
+
+// ログインの接続元を同一視する IP アドレスのネットワークの大きさ（プレフィックス長）
+const (
+	loginFingerprintIPv4Prefix = 24
+	loginFingerprintIPv6Prefix = 48
+)
+
+// LoginFingerprint は、ログインした端末と接続元の特徴（User-Agent のブラウザと OS の種類、IP アドレスのネットワーク）
+// バージョンの更新や同じネットワーク内での IP アドレスの変更では変わらないよう、細かな違いは捨てて比較する
+type LoginFingerprint struct {
+	uaFamily string
+	network  string
+}
+
+// UAFamily は User-Agent のブラウザと OS の種類（"Chrome on macOS" など）を返す
+func (f *LoginFingerprint) UAFamily() string {
+	return f.uaFamily
+}
+
+// Network は IP アドレスのネットワーク（"192.0.2.0/24" など。IP アドレスが不明な場合は空）を返す
+func (f *LoginFingerprint) Network() string {
+	return f.network
+}
+
+// Equals は、ブラウザと OS の種類、IP アドレスのネットワークがともに一致する場合に同じ接続元とみなす
+func (f *LoginFingerprint) Equals(other *LoginFingerprint) bool {
+	return f.uaFamily == other.uaFamily && f.network == other.network
+}
+
+// NewLoginFingerprint は接続元の IP アドレスと User-Agent から LoginFingerprint を作成する
+func NewLoginFingerprint(ipAddress string, userAgent string) *LoginFingerprint {
+	return &LoginFingerprint{
+		uaFamily: userAgentFamily(userAgent),
+		network:  ipNetwork(ipAddress),
+	}
+}
+
+// userAgentFamily は User-Agent からブラウザと OS の種類を判定する
+// 判定できないブラウザは最初の製品名（"curl/8.0" の "curl" など）を使う
+func userAgentFamily(userAgent string) string {
+	userAgent = strings.TrimSpace(userAgent)
+	if userAgent == "" {
+		return "Unknown"
+	}
+	browser := userAgentBrowser(userAgent)
+	if os := userAgentOS(userAgent); os != "" {
+		return browser + " on " + os
+	}
+	return browser
+}
+
+func userAgentBrowser(userAgent string) string {
+	// 他のブラウザの名前を含む User-Agent が多いため、判定の順序に意味がある
+	switch {
+	case strings.Contains(userAgent, "Edg/"), strings.Contains(userAgent, "Edge/"), strings.Contains(userAgent, "EdgiOS/"), strings.Contains(userAgent, "EdgA/"):
+		return "Edge"
+	case strings.Contains(userAgent, "OPR/"), strings.Contains(userAgent, "Opera"):
+		return "Opera"
+	case strings.Contains(userAgent, "SamsungBrowser/"):
+		return "Samsung Internet"
+	case strings.Contains(userAgent, "Firefox/"), strings.Contains(userAgent, "FxiOS/"):
+		return "Firefox"
+	case strings.Contains(userAgent, "Chrome/"), strings.Contains(userAgent, "CriOS/"), strings.Contains(userAgent, "Chromium/"):
+		return "Chrome"
+	case strings.Contains(userAgent, "Safari/"):
+		return "Safari"
+	}
+	product, _, _ := strings.Cut(strings.Fields(userAgent)[0], "/")
+	return product
+}
+
+func userAgentOS(userAgent string) string {
+	switch {
+	case strings.Contains(userAgent, "iPhone"), strings.Contains(userAgent, "iPad"), strings.Contains(userAgent, "iPod"):
+		return "iOS"
+	case strings.Contains(userAgent, "Android"):
+		return "Android"
+	case strings.Contains(userAgent, "CrOS"):
+		return "ChromeOS"
+	case strings.Contains(userAgent, "Windows"):
+		return "Windows"
+	case strings.Contains(userAgent, "Macintosh"), strings.Contains(userAgent, "Mac OS X"):
+		return "macOS"
+	case strings.Contains(userAgent, "Linux"):
+		return "Linux"
+	}
+	return ""
+}
+
+// ipNetwork は IP アドレスが属するネットワーク（IPv4 は /24、IPv6 は /48）を返す
+func ipNetwork(ipAddress string) string {
+	ip := net.ParseIP(strings.TrimSpace(ipAddress))
+	if ip == nil {
+		return ""
+	}
+	if v4 := ip.To4(); v4 != nil {
+		mask := net.CIDRMask(loginFingerprintIPv4Prefix, 32)
+		return (&net.IPNet{IP: v4.Mask(mask), Mask: mask}).String()
+	}
+	mask := net.CIDRMask(loginFingerprintIPv6Prefix, 128)
+	return (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
+}
@@ -0,0 +1,40 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLoginFingerprint_UAFamily(t *testing.T) {
+	cases := map[string]string{
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0.0.0 Safari/537.36":                   "Chrome on macOS",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0.0.0 Safari/537.36 Edg/129.0.0.0":           "Edge on Windows",
+		"Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0":                                                                  "Firefox on Linux",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 18_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.0 Mobile/15E148 Safari/604.1": "Safari on iOS",
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0.0.0 Mobile Safari/537.36":                   "Chrome on Android",
+		"curl/8.4.0": "curl",
+		"   ":        "Unknown",
+	}
+	for userAgent, expected := range cases {
+		assert.Equal(t, expected, NewLoginFingerprint("", userAgent).UAFamily(), userAgent)
+	}
+}
+
+func TestNewLoginFingerprint_Network(t *testing.T) {
+	assert.Equal(t, "192.0.2.0/24", NewLoginFingerprint("192.0.2.123", "").Network())
+	assert.Equal(t, "2001:db8:1234::/48", NewLoginFingerprint("2001:db8:1234:5678::1", "").Network())
+	assert.Equal(t, "192.0.2.0/24", NewLoginFingerprint("::ffff:192.0.2.1", "").Network(), "IPv4 射影アドレスは IPv4 として扱うこと")
+	assert.Empty(t, NewLoginFingerprint("unknown", "").Network())
+}
+
+func TestLoginFingerprint_Equals(t *testing.T) {
+	chrome129 := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0.0.0 Safari/537.36"
+	chrome130 := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Safari/537.36"
+	firefox := "Mozilla/5.0 (Macintosh; Intel Mac OS X 14.0; rv:131.0) Gecko/20100101 Firefox/131.0"
+
+	base := NewLoginFingerprint("192.0.2.1", chrome129)
+	assert.True(t, base.Equals(NewLoginFingerprint("192.0.2.200", chrome130)), "バージョンや同じネットワーク内の IP アドレスの違いは同じ接続元とみなす")
+	assert.False(t, base.Equals(NewLoginFingerprint("198.51.100.1", chrome129)), "ネットワークが異なる場合は別の接続元")
+	assert.False(t, base.Equals(NewLoginFingerprint("192.0.2.1", firefox)), "ブラウザが異なる場合は別の接続元")
+}
@@ -0,0 +1,60 @@
+package value
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// 権限（"リソース:操作" 形式）
+const (
+	PermissionAll                  = "*"                      // すべての権限
+	PermissionUsersRead            = "users:read"             // ユーザーの参照
+	PermissionUsersWrite           = "users:write"            // ユーザーの作成・変更・削除
+	PermissionRolesRead            = "roles:read"             // ロールの参照
+	PermissionRolesWrite           = "roles:write"            // ロールの作成・変更・削除
+	PermissionGroupsRead           = "groups:read"            // グループの参照
+	PermissionGroupsWrite          = "groups:write"           // グループの作成・変更・削除とメンバーの変更
+	PermissionServiceAccountsRead  = "service-accounts:read"  // サービスアカウントの参照
+	PermissionServiceAccountsWrite = "service-accounts:write" // サービスアカウントの作成・削除と API キーの管理
+)
+
+// knownPermissions は、ロールに与えられる権限の一覧
+var knownPermissions = []string{
+	PermissionAll,
+	PermissionUsersRead,
+	PermissionUsersWrite,
+	PermissionRolesRead,
+	PermissionRolesWrite,
+	PermissionGroupsRead,
+	PermissionGroupsWrite,
+	PermissionServiceAccountsRead,
+	PermissionServiceAccountsWrite,
+}
+
+type Permission struct {
+	value string
+}
+
+func (p *Permission) Value() string {
+	return p.value
+}
+
+// Grants: この権限が permission を満たすかどうか
+func (p *Permission) Grants(permission string) bool {
+	return p.value == PermissionAll || p.value == permission
+}
+
+// KnownPermissions: ロールに与えられる権限の一覧
+func KnownPermissions() []string {
+	return slices.Clone(knownPermissions)
+}
+
+func NewPermission(value string) (*Permission, error) {
+	// 定義されている権限かチェックする
+	if !slices.Contains(knownPermissions, value) {
+		return nil, errs.NewDomainError(fmt.Sprintf("無効な権限: %s", value))
+	}
+	return &Permission{value: value}, nil
+}
@@ -0,0 +1,33 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPermission_Valid(t *testing.T) {
+	for _, name := range KnownPermissions() {
+		permission, err := NewPermission(name)
+		assert.NoError(t, err, "定義されている権限は有効であること: %s", name)
+		assert.Equal(t, name, permission.Value())
+	}
+}
+
+func TestNewPermission_Invalid(t *testing.T) {
+	for _, name := range []string{"", "users", "users:delete", "USERS:READ", "users:*"} {
+		permission, err := NewPermission(name)
+		assert.Error(t, err, "定義されていない権限はエラーになること: %q", name)
+		assert.Nil(t, permission)
+	}
+}
+
+func TestPermission_Grants(t *testing.T) {
+	read, _ := NewPermission(PermissionUsersRead)
+	assert.True(t, read.Grants(PermissionUsersRead))
+	assert.False(t, read.Grants(PermissionUsersWrite), "別の権限は満たさないこと")
+
+	all, _ := NewPermission(PermissionAll)
+	assert.True(t, all.Grants(PermissionUsersWrite), "* はすべての権限を満たすこと")
+	assert.True(t, all.Grants(PermissionRolesWrite))
+}
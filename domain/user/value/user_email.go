@@ -2,6 +2,9 @@ package value
 
 import (
 	"regexp"
+	"strings"
+
+	"golang.org/x/net/idna"
 
 	"github.com/goda6565/nexus-user-auth/errs"
 )
@@ -14,17 +17,64 @@ func (e *UserEmail) Value() string {
 	return e.value
 }
 
+// Equals は、正規化したキー（プロバイダー固有ルールを適用しない）が一致する場合に同じメールアドレスとみなす
+// 設定したルールで同一視する場合は EmailKeyRules.Equal を使う
 func (e *UserEmail) Equals(other *UserEmail) bool {
-	return e.value == other.value
+	return e.CanonicalKey() == other.CanonicalKey()
+}
+
+// CanonicalKey は、同一のメールボックスを指すアドレスを同一視するための検索キーを返す
+// ローカル部も小文字にする。プロバイダー固有ルールは適用しない（適用する場合は EmailKeyRules.Key を使う）
+func (e *UserEmail) CanonicalKey() string {
+	return EmailKeyRules{}.Key(e)
+}
+
+// EmailKeyRules は、メールアドレスの検索キーの作り方
+// ゼロ値はプロバイダー固有ルールを適用しない（CanonicalKey と同じ）
+type EmailKeyRules struct {
+	// ProviderRules: プロバイダー固有ルール（Gmail のドット無視など）を適用する
+	// 既存データの検索キーが変わるため、運用中に切り替える場合はキーの再計算が必要
+	ProviderRules bool
+}
+
+// Key は、ルールに従ってメールアドレスの検索キーを返す
+func (r EmailKeyRules) Key(e *UserEmail) string {
+	local, domain, _ := strings.Cut(e.value, "@")
+	local = strings.ToLower(local)
+	if r.ProviderRules {
+		if rule, ok := emailProviderRules[domain]; ok {
+			local, domain = rule.apply(local, domain)
+		}
+	}
+	return local + "@" + domain
+}
+
+// Equal は、ルールに従った検索キーが一致する（同じメールボックスを指す）かどうかを返す
+func (r EmailKeyRules) Equal(a *UserEmail, b *UserEmail) bool {
+	return r.Key(a) == r.Key(b)
 }
 
 // 空の場合や無効な形式の場合はエラーを返す。
+// 前後の空白を除き、ドメインは小文字化・IDN の場合は Punycode に変換して保持する。
 func NewUserEmail(value string) (*UserEmail, error) {
+	value = strings.TrimSpace(value)
+
 	// 空文字チェック
 	if value == "" {
 		return nil, errs.NewDomainError("メールアドレスは必須です。")
 	}
 
+	// ドメインの正規化
+	at := strings.LastIndex(value, "@")
+	if at < 0 {
+		return nil, errs.NewDomainError("メールアドレスの形式が正しくありません。")
+	}
+	domain, err := idna.Lookup.ToASCII(strings.ToLower(value[at+1:]))
+	if err != nil {
+		return nil, errs.NewDomainError("メールアドレスの形式が正しくありません。")
+	}
+	value = value[:at] + "@" + domain
+
 	// シンプルなメールアドレス形式の正規表現
 	const emailRegex = `^[a-zA-Z0-9.!#$%&'*+/=?^_{|}~-]+@[a-zA-Z0-9-]+\.[a-zA-Z0-9-.]+$`
 	matched, err := regexp.MatchString(emailRegex, value)
@@ -37,3 +87,31 @@ func NewUserEmail(value string) (*UserEmail, error) {
 
 	return &UserEmail{value: value}, nil
 }
+
+// emailProviderRule は、プロバイダー固有のアドレスの同一視ルール
+type emailProviderRule struct {
+	ignoreDots bool   // ローカル部のドットを無視する
+	plusTag    bool   // "+" 以降をタグとして無視する
+	domain     string // 同一視するドメイン（空の場合はそのまま）
+}
+
+func (r emailProviderRule) apply(local, domain string) (string, string) {
+	if r.plusTag {
+		local, _, _ = strings.Cut(local, "+")
+	}
+	if r.ignoreDots {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+	if r.domain != "" {
+		domain = r.domain
+	}
+	return local, domain
+}
+
+var emailProviderRules = map[string]emailProviderRule{
+	"gmail.com":      {ignoreDots: true, plusTag: true},
+	"googlemail.com": {ignoreDots: true, plusTag: true, domain: "gmail.com"},
+	"outlook.com":    {plusTag: true},
+	"hotmail.com":    {plusTag: true},
+	"icloud.com":     {plusTag: true},
+}
@@ -35,3 +35,60 @@ func TestUserEmail_Equals(t *testing.T) {
 	assert.NoError(t, err2)
 	assert.True(t, email1.Equals(email2), "同じメールアドレスの場合 Equals は true を返す")
 }
+
+func TestNewUserEmail_Normalize(t *testing.T) {
+	// 前後の空白を除き、ドメインは小文字化する（ローカル部の大文字小文字は保持）
+	email, err := NewUserEmail("  Foo.Bar@Example.COM ")
+	assert.NoError(t, err)
+	assert.Equal(t, "Foo.Bar@example.com", email.Value())
+
+	// IDN ドメインは Punycode に変換する
+	email, err = NewUserEmail("user@日本語.jp")
+	assert.NoError(t, err)
+	assert.Equal(t, "user@xn--wgv71a119e.jp", email.Value())
+}
+
+func TestUserEmail_CanonicalKey(t *testing.T) {
+	email, err := NewUserEmail("Foo@Example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo@example.com", email.CanonicalKey(), "ローカル部も小文字にすること")
+
+	other, err := NewUserEmail("foo@example.com")
+	assert.NoError(t, err)
+	assert.True(t, email.Equals(other), "大文字小文字の違いは同じアドレスとみなす")
+
+	// プロバイダー固有ルールは既定では無効
+	gmail, err := NewUserEmail("Foo.Bar+news@googlemail.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo.bar+news@googlemail.com", gmail.CanonicalKey())
+}
+
+func TestEmailKeyRules_ProviderRules(t *testing.T) {
+	rules := EmailKeyRules{ProviderRules: true}
+	cases := map[string]string{
+		"Foo.Bar+news@gmail.com":     "foobar@gmail.com",
+		"foo.bar@googlemail.com":     "foobar@gmail.com",
+		"foo.bar+tag@outlook.com":    "foo.bar@outlook.com",
+		"foo.bar+tag@example.com":    "foo.bar+tag@example.com",
+		"first.last+x@ICLOUD.com":    "first.last@icloud.com",
+		"first.last+x@hotmail.com":   "first.last@hotmail.com",
+		"first.last+x@sub.gmail.com": "first.last+x@sub.gmail.com",
+	}
+	for input, expected := range cases {
+		email, err := NewUserEmail(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, rules.Key(email), input)
+		assert.Equal(t, EmailKeyRules{}.Key(email), email.CanonicalKey(), "ゼロ値のルールは CanonicalKey と同じキーを返す")
+	}
+}
+
+func TestEmailKeyRules_Equal(t *testing.T) {
+	dotted, err := NewUserEmail("foo.bar@gmail.com")
+	assert.NoError(t, err)
+	plain, err := NewUserEmail("FooBar@gmail.com")
+	assert.NoError(t, err)
+
+	assert.True(t, EmailKeyRules{ProviderRules: true}.Equal(dotted, plain), "プロバイダー固有ルールで同じメールボックスを指すアドレスは同じとみなす")
+	assert.False(t, EmailKeyRules{}.Equal(dotted, plain), "ルールを適用しない場合は別のアドレスとみなす")
+	assert.Equal(t, dotted.Equals(plain), EmailKeyRules{}.Equal(dotted, plain), "ゼロ値のルールは Equals と同じ結果を返す")
+}
@@ -0,0 +1,31 @@
+package value
+
+import (
+	"fmt"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// アカウントの種類
+const (
+	KindUser           = "user"            // 人が利用するアカウント
+	KindServiceAccount = "service_account" // サービスアカウント（システムが API キーやクライアント資格情報で利用する）
+)
+
+type UserKind struct {
+	value string
+}
+
+func (k *UserKind) Value() string {
+	return k.value
+}
+
+func NewUserKind(value string) (*UserKind, error) {
+	// アカウントの種類の値が正しいかチェックする
+	switch value {
+	case KindUser, KindServiceAccount:
+		return &UserKind{value: value}, nil
+	default:
+		return nil, errs.NewDomainError(fmt.Sprintf("無効なアカウントの種類: %s", value))
+	}
+}
@@ -0,0 +1,21 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserKind_Valid(t *testing.T) {
+	for _, k := range []string{KindUser, KindServiceAccount} {
+		kind, err := NewUserKind(k)
+		assert.NoError(t, err, "%s は有効な種類であること", k)
+		assert.Equal(t, k, kind.Value())
+	}
+}
+
+func TestNewUserKind_Invalid(t *testing.T) {
+	kind, err := NewUserKind("robot")
+	assert.Error(t, err, "不正な種類はエラーになること")
+	assert.Nil(t, kind)
+}
@@ -52,8 +52,22 @@ func FromHashed(hashed string) *UserPassword {
 	return &UserPassword{hashed: hashed}
 }
 
+// NoPassword は、パスワードを設定していないユーザー（パスワードレスログインで登録したユーザーなど）の
+// UserPassword を返します。ハッシュ値を持たないため、どのパスワードでも認証できません。
+func NoPassword() *UserPassword {
+	return &UserPassword{hashed: ""}
+}
+
+// IsSet は、パスワードが設定されているかを判定します。
+func (p *UserPassword) IsSet() bool {
+	return p.hashed != ""
+}
+
 // Verify は、プレーンなパスワードと内部に保持しているハッシュ値を比較し、一致するかを判定します。
 func (p *UserPassword) Verify(plain string) bool {
+	if !p.IsSet() {
+		return false
+	}
 	err := utils.CheckPassword(p.hashed, plain)
 	return err == nil
 }
@@ -41,3 +41,12 @@ func TestNewUserPassword_MissingLetter(t *testing.T) {
 	assert.Error(t, err, "英字が含まれていないパスワードはエラーになること")
 	assert.Nil(t, pw, "UserPassword オブジェクトは生成されない")
 }
+
+func TestNoPassword(t *testing.T) {
+	pw := NoPassword()
+	assert.False(t, pw.IsSet(), "パスワード未設定として扱われること")
+	assert.False(t, pw.Verify(""), "空文字でも認証できないこと")
+	assert.False(t, pw.Verify("Abcd1234"))
+
+	assert.True(t, FromHashed("hashed").IsSet())
+}
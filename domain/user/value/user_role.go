@@ -2,15 +2,20 @@ package value
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/goda6565/nexus-user-auth/errs"
 )
 
+// 組み込みのロール（変更・削除できない）
 const (
 	Admin       = "admin"
 	RegularUser = "user"
 )
 
+// ロール名は英小文字で始まる英小文字・数字・"-"・"_"（50 文字以内）
+var userRolePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}$`)
+
 type UserRole struct {
 	value string
 }
@@ -19,12 +24,15 @@ func (r *UserRole) Value() string {
 	return r.value
 }
 
+// IsBuiltIn: 組み込みのロールかどうか
+func (r *UserRole) IsBuiltIn() bool {
+	return r.value == Admin || r.value == RegularUser
+}
+
 func NewUserRole(value string) (*UserRole, error) {
-	// ユーザーロールの値が正しいかチェックする
-	switch value {
-	case Admin, RegularUser:
-		return &UserRole{value: value}, nil
-	default:
+	// ユーザーロールの名前の形式をチェックする（ロールが存在するかはリポジトリで確認する）
+	if !userRolePattern.MatchString(value) {
 		return nil, errs.NewDomainError(fmt.Sprintf("無効なユーザーロール: %s", value))
 	}
+	return &UserRole{value: value}, nil
 }
@@ -11,15 +11,23 @@ func TestNewUserRole_Valid(t *testing.T) {
 	assert.NoError(t, err, "admin は有効なロールであること")
 	assert.NotNil(t, roleAdmin)
 	assert.Equal(t, Admin, roleAdmin.Value())
+	assert.True(t, roleAdmin.IsBuiltIn())
 
 	roleUser, err := NewUserRole(RegularUser)
 	assert.NoError(t, err, "user は有効なロールであること")
 	assert.NotNil(t, roleUser)
 	assert.Equal(t, RegularUser, roleUser.Value())
+	assert.True(t, roleUser.IsBuiltIn())
+
+	custom, err := NewUserRole("support-agent_2")
+	assert.NoError(t, err, "形式が正しければ任意の名前のロールを作成できること")
+	assert.False(t, custom.IsBuiltIn())
 }
 
 func TestNewUserRole_Invalid(t *testing.T) {
-	role, err := NewUserRole("unknown")
-	assert.Error(t, err, "不正なロールはエラーになること")
-	assert.Nil(t, role)
+	for _, name := range []string{"", "Admin", "1st", "support agent", "role:write", "a123456789012345678901234567890123456789012345678901"} {
+		role, err := NewUserRole(name)
+		assert.Error(t, err, "不正な名前のロールはエラーになること: %q", name)
+		assert.Nil(t, role)
+	}
 }
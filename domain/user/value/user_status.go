@@ -0,0 +1,33 @@
+package value
+
+import (
+	"fmt"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// アカウントの状態
+const (
+	StatusPending     = "pending"     // 有効化待ち（メールアドレスの確認前）
+	StatusActive      = "active"      // 有効
+	StatusSuspended   = "suspended"   // 管理者による停止
+	StatusDeactivated = "deactivated" // ユーザー自身による無効化
+)
+
+type UserStatus struct {
+	value string
+}
+
+func (s *UserStatus) Value() string {
+	return s.value
+}
+
+func NewUserStatus(value string) (*UserStatus, error) {
+	// アカウントの状態の値が正しいかチェックする
+	switch value {
+	case StatusPending, StatusActive, StatusSuspended, StatusDeactivated:
+		return &UserStatus{value: value}, nil
+	default:
+		return nil, errs.NewDomainError(fmt.Sprintf("無効なアカウントの状態: %s", value))
+	}
+}
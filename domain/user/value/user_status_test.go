@@ -0,0 +1,21 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserStatus_Valid(t *testing.T) {
+	for _, s := range []string{StatusPending, StatusActive, StatusSuspended, StatusDeactivated} {
+		status, err := NewUserStatus(s)
+		assert.NoError(t, err, "%s は有効な状態であること", s)
+		assert.Equal(t, s, status.Value())
+	}
+}
+
+func TestNewUserStatus_Invalid(t *testing.T) {
+	status, err := NewUserStatus("deleted")
+	assert.Error(t, err, "不正な状態はエラーになること")
+	assert.Nil(t, status)
+}
@@ -0,0 +1,72 @@
+package adapter
+
+import (
+	"encoding/json"
+
+	"gorm.io/gorm"
+
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// APIKeyAdapter は、API キーと永続化用モデル間の変換を行うためのインターフェースです。
+type APIKeyAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.APIKey) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.APIKey, error)
+}
+
+// apiKeyAdapterImpl は、APIKeyAdapter の実装です。
+type apiKeyAdapterImpl struct{}
+
+// NewAPIKeyAdapter は、APIKeyAdapter の実装を返します。
+func NewAPIKeyAdapter() APIKeyAdapter {
+	return &apiKeyAdapterImpl{}
+}
+
+func (a *apiKeyAdapterImpl) Convert(source *userEntity.APIKey) any {
+	// スコープは JSON の配列で保存する（文字列のスライスのため変換には失敗しない）
+	scopes, _ := json.Marshal(source.ScopeNames())
+	return &models.APIKey{
+		Model:      gorm.Model{CreatedAt: source.CreatedAt()},
+		ObjID:      source.ID(),
+		UserObjID:  source.UserObjID().Value(),
+		Name:       source.Name(),
+		Prefix:     source.Prefix(),
+		SecretHash: source.SecretHash(),
+		Scopes:     string(scopes),
+		ExpiresAt:  source.ExpiresAt(),
+		LastUsedAt: source.LastUsedAt(),
+	}
+}
+
+func (a *apiKeyAdapterImpl) ReBuild(source any) (*userEntity.APIKey, error) {
+	model, ok := source.(*models.APIKey)
+	if !ok {
+		return nil, errs.NewInfraError("*models.APIKey以外の値が指定されました。")
+	}
+
+	userObjID, err := value.NewUserObjID(model.UserObjID)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	if model.Scopes != "" {
+		if err := json.Unmarshal([]byte(model.Scopes), &names); err != nil {
+			return nil, errs.NewInfraError("API キーのスコープの再構築に失敗しました。")
+		}
+	}
+	scopes := make([]*value.APIKeyScope, 0, len(names))
+	for _, name := range names {
+		scope, err := value.NewAPIKeyScope(name)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+
+	return userEntity.BuildAPIKey(model.ObjID, userObjID, model.Name, model.Prefix, model.SecretHash, scopes, model.ExpiresAt, model.CreatedAt, model.LastUsedAt)
+}
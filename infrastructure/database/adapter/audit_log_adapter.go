@@ -0,0 +1,71 @@
+package adapter
+
+import (
+	"encoding/json"
+
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// AuditLogAdapter は、監査ログと永続化用モデル間の変換を行うためのインターフェースです。
+type AuditLogAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.AuditLog) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.AuditLog, error)
+}
+
+// auditLogAdapterImpl は、AuditLogAdapter の実装です。
+type auditLogAdapterImpl struct{}
+
+// NewAuditLogAdapter は、AuditLogAdapter の実装を返します。
+func NewAuditLogAdapter() AuditLogAdapter {
+	return &auditLogAdapterImpl{}
+}
+
+func (a *auditLogAdapterImpl) Convert(source *userEntity.AuditLog) any {
+	// 操作の内容は JSON で保存する（内容がない場合は空文字。文字列のマップのため変換には失敗しない）
+	detail := ""
+	if len(source.Detail()) > 0 {
+		encoded, _ := json.Marshal(source.Detail())
+		detail = string(encoded)
+	}
+	return &models.AuditLog{
+		ObjID:       source.ID(),
+		ActorObjID:  source.ActorObjID().Value(),
+		ActorKind:   source.ActorKind().Value(),
+		Action:      source.Action(),
+		TargetObjID: source.TargetObjID(),
+		Detail:      detail,
+		OccurredAt:  source.OccurredAt(),
+	}
+}
+
+func (a *auditLogAdapterImpl) ReBuild(source any) (*userEntity.AuditLog, error) {
+	model, ok := source.(*models.AuditLog)
+	if !ok {
+		return nil, errs.NewInfraError("*models.AuditLog以外の値が指定されました。")
+	}
+
+	actorObjID, err := value.NewUserObjID(model.ActorObjID)
+	if err != nil {
+		return nil, err
+	}
+	var actorKind *value.UserKind
+	if model.ActorKind != "" {
+		actorKind, err = value.NewUserKind(model.ActorKind)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var detail map[string]string
+	if model.Detail != "" {
+		if err := json.Unmarshal([]byte(model.Detail), &detail); err != nil {
+			return nil, errs.NewInfraError("監査ログの内容の再構築に失敗しました。")
+		}
+	}
+
+	return userEntity.BuildAuditLog(model.ObjID, actorObjID, actorKind, model.Action, model.TargetObjID, detail, model.OccurredAt)
+}
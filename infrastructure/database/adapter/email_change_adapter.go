@@ -0,0 +1,58 @@
+package adapter
+
+import (
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// EmailChangeAdapter は、メールアドレス変更リクエストと永続化用モデル間の変換を行うためのインターフェースです。
+type EmailChangeAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.EmailChange) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.EmailChange, error)
+}
+
+// emailChangeAdapterImpl は、EmailChangeAdapter の実装です。
+type emailChangeAdapterImpl struct{}
+
+// NewEmailChangeAdapter は、EmailChangeAdapter の実装を返します。
+func NewEmailChangeAdapter() EmailChangeAdapter {
+	return &emailChangeAdapterImpl{}
+}
+
+func (a *emailChangeAdapterImpl) Convert(source *userEntity.EmailChange) any {
+	return &models.EmailChange{
+		ObjID:       source.ID(),
+		UserObjID:   source.UserObjID().Value(),
+		OldEmail:    source.OldEmail().Value(),
+		NewEmail:    source.NewEmail().Value(),
+		Status:      string(source.Status()),
+		ExpiresAt:   source.ExpiresAt(),
+		CompletedAt: source.CompletedAt(),
+	}
+}
+
+func (a *emailChangeAdapterImpl) ReBuild(source any) (*userEntity.EmailChange, error) {
+	model, ok := source.(*models.EmailChange)
+	if !ok {
+		return nil, errs.NewInfraError("*models.EmailChange以外の値が指定されました。")
+	}
+
+	userObjID, err := value.NewUserObjID(model.UserObjID)
+	if err != nil {
+		return nil, err
+	}
+	oldEmail, err := value.NewUserEmail(model.OldEmail)
+	if err != nil {
+		return nil, err
+	}
+	newEmail, err := value.NewUserEmail(model.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return userEntity.BuildEmailChange(model.ObjID, userObjID, oldEmail, newEmail, userEntity.EmailChangeStatus(model.Status), model.ExpiresAt, model.CompletedAt)
+}
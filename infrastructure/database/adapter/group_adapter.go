@@ -0,0 +1,85 @@
+package adapter
+
+import (
+	"encoding/json"
+
+	"gorm.io/gorm"
+
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// GroupAdapter は、グループと永続化用モデル間の変換を行うためのインターフェースです。
+type GroupAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.Group) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.Group, error)
+}
+
+// groupAdapterImpl は、GroupAdapter の実装です。
+type groupAdapterImpl struct{}
+
+// NewGroupAdapter は、GroupAdapter の実装を返します。
+func NewGroupAdapter() GroupAdapter {
+	return &groupAdapterImpl{}
+}
+
+func (a *groupAdapterImpl) Convert(source *userEntity.Group) any {
+	// 権限は JSON の配列で保存する（文字列のスライスのため変換には失敗しない）
+	permissions, _ := json.Marshal(source.PermissionNames())
+	roles := make([]models.GroupRole, 0, len(source.Roles()))
+	for _, role := range source.Roles() {
+		roles = append(roles, models.GroupRole{GroupObjID: source.ObjID().Value(), RoleName: role.Value()})
+	}
+	return &models.Group{
+		Model:       gorm.Model{CreatedAt: source.CreatedAt()},
+		ObjID:       source.ObjID().Value(),
+		Name:        source.Name().Value(),
+		Description: source.Description(),
+		Permissions: string(permissions),
+		Roles:       roles,
+	}
+}
+
+func (a *groupAdapterImpl) ReBuild(source any) (*userEntity.Group, error) {
+	model, ok := source.(*models.Group)
+	if !ok {
+		return nil, errs.NewInfraError("*models.Group以外の値が指定されました。")
+	}
+
+	objID, err := value.NewGroupObjID(model.ObjID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := value.NewGroupName(model.Name)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]*value.UserRole, 0, len(model.Roles))
+	for _, groupRole := range model.Roles {
+		role, err := value.NewUserRole(groupRole.RoleName)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	var names []string
+	if model.Permissions != "" {
+		if err := json.Unmarshal([]byte(model.Permissions), &names); err != nil {
+			return nil, errs.NewInfraError("グループの権限の再構築に失敗しました。")
+		}
+	}
+	permissions := make([]*value.Permission, 0, len(names))
+	for _, name := range names {
+		permission, err := value.NewPermission(name)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return userEntity.BuildGroup(objID, name, model.Description, roles, permissions, model.CreatedAt)
+}
@@ -0,0 +1,67 @@
+package adapter
+
+import (
+	"gorm.io/gorm"
+
+	orgEntity "github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// InvitationAdapter は、組織への招待と永続化用モデル間の変換を行うためのインターフェースです。
+type InvitationAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *orgEntity.Invitation) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*orgEntity.Invitation, error)
+}
+
+// invitationAdapterImpl は、InvitationAdapter の実装です。
+type invitationAdapterImpl struct{}
+
+// NewInvitationAdapter は、InvitationAdapter の実装を返します。
+func NewInvitationAdapter() InvitationAdapter {
+	return &invitationAdapterImpl{}
+}
+
+func (a *invitationAdapterImpl) Convert(source *orgEntity.Invitation) any {
+	return &models.OrganizationInvitation{
+		Model:        gorm.Model{CreatedAt: source.CreatedAt()},
+		ObjID:        source.ID(),
+		OrgObjID:     source.OrgObjID().Value(),
+		Email:        source.Email().Value(),
+		Role:         source.Role().Value(),
+		InviterObjID: source.InviterObjID().Value(),
+		Status:       string(source.Status()),
+		ExpiresAt:    source.ExpiresAt(),
+		RespondedAt:  source.RespondedAt(),
+	}
+}
+
+func (a *invitationAdapterImpl) ReBuild(source any) (*orgEntity.Invitation, error) {
+	model, ok := source.(*models.OrganizationInvitation)
+	if !ok {
+		return nil, errs.NewInfraError("*models.OrganizationInvitation以外の値が指定されました。")
+	}
+
+	orgObjID, err := value.NewOrgObjID(model.OrgObjID)
+	if err != nil {
+		return nil, err
+	}
+	email, err := userValue.NewUserEmail(model.Email)
+	if err != nil {
+		return nil, err
+	}
+	role, err := value.NewMemberRole(model.Role)
+	if err != nil {
+		return nil, err
+	}
+	inviterObjID, err := userValue.NewUserObjID(model.InviterObjID)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgEntity.BuildInvitation(model.ObjID, orgObjID, email, role, inviterObjID, orgEntity.InvitationStatus(model.Status), model.ExpiresAt, model.CreatedAt, model.RespondedAt)
+}
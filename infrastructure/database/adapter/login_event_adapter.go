@@ -0,0 +1,54 @@
+package adapter
+
+import (
+	"strings"
+
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// LoginEventAdapter は、ログインの記録と永続化用モデル間の変換を行うためのインターフェースです。
+type LoginEventAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.LoginEvent) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.LoginEvent, error)
+}
+
+// loginEventAdapterImpl は、LoginEventAdapter の実装です。
+type loginEventAdapterImpl struct{}
+
+// NewLoginEventAdapter は、LoginEventAdapter の実装を返します。
+func NewLoginEventAdapter() LoginEventAdapter {
+	return &loginEventAdapterImpl{}
+}
+
+func (a *loginEventAdapterImpl) Convert(source *userEntity.LoginEvent) any {
+	return &models.LoginEvent{
+		ObjID:      source.ID(),
+		UserObjID:  source.UserObjID().Value(),
+		Result:     string(source.Result()),
+		Reason:     source.Reason(),
+		Methods:    strings.Join(source.Methods(), " "),
+		IPAddress:  source.IPAddress(),
+		UserAgent:  source.UserAgent(),
+		OccurredAt: source.OccurredAt(),
+		NewDevice:  source.IsNewDevice(),
+	}
+}
+
+func (a *loginEventAdapterImpl) ReBuild(source any) (*userEntity.LoginEvent, error) {
+	model, ok := source.(*models.LoginEvent)
+	if !ok {
+		return nil, errs.NewInfraError("*models.LoginEvent以外の値が指定されました。")
+	}
+
+	userObjID, err := value.NewUserObjID(model.UserObjID)
+	if err != nil {
+		return nil, err
+	}
+
+	return userEntity.BuildLoginEvent(model.ObjID, userObjID, userEntity.LoginEventResult(model.Result), model.Reason, strings.Fields(model.Methods), model.IPAddress, model.UserAgent, model.OccurredAt, model.NewDevice)
+}
@@ -0,0 +1,30 @@
+package adapter
+
+import (
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// LoginFailureAdapter は、ログイン失敗の記録と永続化用モデル間の変換を行うためのインターフェースです。
+type LoginFailureAdapter interface {
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.LoginFailure, error)
+}
+
+// loginFailureAdapterImpl は、LoginFailureAdapter の実装です。
+type loginFailureAdapterImpl struct{}
+
+// NewLoginFailureAdapter は、LoginFailureAdapter の実装を返します。
+func NewLoginFailureAdapter() LoginFailureAdapter {
+	return &loginFailureAdapterImpl{}
+}
+
+func (a *loginFailureAdapterImpl) ReBuild(source any) (*userEntity.LoginFailure, error) {
+	model, ok := source.(*models.LoginFailure)
+	if !ok {
+		return nil, errs.NewInfraError("*models.LoginFailure以外の値が指定されました。")
+	}
+
+	return userEntity.BuildLoginFailure(model.Scope, model.Identifier, model.Failures, model.LastFailedAt, model.LockedUntil)
+}
@@ -0,0 +1,58 @@
+package adapter
+
+import (
+	"gorm.io/gorm"
+
+	orgEntity "github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// MembershipAdapter は、組織のメンバーシップと永続化用モデル間の変換を行うためのインターフェースです。
+type MembershipAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *orgEntity.Membership) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*orgEntity.Membership, error)
+}
+
+// membershipAdapterImpl は、MembershipAdapter の実装です。
+type membershipAdapterImpl struct{}
+
+// NewMembershipAdapter は、MembershipAdapter の実装を返します。
+func NewMembershipAdapter() MembershipAdapter {
+	return &membershipAdapterImpl{}
+}
+
+func (a *membershipAdapterImpl) Convert(source *orgEntity.Membership) any {
+	return &models.OrganizationMember{
+		Model:     gorm.Model{CreatedAt: source.JoinedAt()},
+		OrgObjID:  source.OrgObjID().Value(),
+		UserObjID: source.UserObjID().Value(),
+		Role:      source.Role().Value(),
+	}
+}
+
+func (a *membershipAdapterImpl) ReBuild(source any) (*orgEntity.Membership, error) {
+	model, ok := source.(*models.OrganizationMember)
+	if !ok {
+		return nil, errs.NewInfraError("*models.OrganizationMember以外の値が指定されました。")
+	}
+
+	orgObjID, err := value.NewOrgObjID(model.OrgObjID)
+	if err != nil {
+		return nil, err
+	}
+	userObjID, err := userValue.NewUserObjID(model.UserObjID)
+	if err != nil {
+		return nil, err
+	}
+	role, err := value.NewMemberRole(model.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgEntity.BuildMembership(orgObjID, userObjID, role, model.CreatedAt)
+}
@@ -0,0 +1,87 @@
+package adapter
+
+import (
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// TOTPFactorAdapter は、TOTP と永続化用モデル間の変換を行うためのインターフェースです。
+// シークレットの暗号化・復号はリポジトリで行うため、ここでは平文のまま扱います。
+type TOTPFactorAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.TOTPFactor) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.TOTPFactor, error)
+}
+
+// totpFactorAdapterImpl は、TOTPFactorAdapter の実装です。
+type totpFactorAdapterImpl struct{}
+
+// NewTOTPFactorAdapter は、TOTPFactorAdapter の実装を返します。
+func NewTOTPFactorAdapter() TOTPFactorAdapter {
+	return &totpFactorAdapterImpl{}
+}
+
+func (a *totpFactorAdapterImpl) Convert(source *userEntity.TOTPFactor) any {
+	return &models.TOTPFactor{
+		UserObjID:    source.UserObjID().Value(),
+		Secret:       source.Secret(),
+		ConfirmedAt:  source.ConfirmedAt(),
+		LastUsedStep: source.LastUsedStep(),
+	}
+}
+
+func (a *totpFactorAdapterImpl) ReBuild(source any) (*userEntity.TOTPFactor, error) {
+	model, ok := source.(*models.TOTPFactor)
+	if !ok {
+		return nil, errs.NewInfraError("*models.TOTPFactor以外の値が指定されました。")
+	}
+
+	userObjID, err := value.NewUserObjID(model.UserObjID)
+	if err != nil {
+		return nil, err
+	}
+
+	return userEntity.BuildTOTPFactor(userObjID, model.Secret, model.ConfirmedAt, model.LastUsedStep)
+}
+
+// RecoveryCodeAdapter は、リカバリーコードと永続化用モデル間の変換を行うためのインターフェースです。
+type RecoveryCodeAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.RecoveryCode) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.RecoveryCode, error)
+}
+
+// recoveryCodeAdapterImpl は、RecoveryCodeAdapter の実装です。
+type recoveryCodeAdapterImpl struct{}
+
+// NewRecoveryCodeAdapter は、RecoveryCodeAdapter の実装を返します。
+func NewRecoveryCodeAdapter() RecoveryCodeAdapter {
+	return &recoveryCodeAdapterImpl{}
+}
+
+func (a *recoveryCodeAdapterImpl) Convert(source *userEntity.RecoveryCode) any {
+	return &models.RecoveryCode{
+		ObjID:     source.ID(),
+		UserObjID: source.UserObjID().Value(),
+		CodeHash:  source.CodeHash(),
+		UsedAt:    source.UsedAt(),
+	}
+}
+
+func (a *recoveryCodeAdapterImpl) ReBuild(source any) (*userEntity.RecoveryCode, error) {
+	model, ok := source.(*models.RecoveryCode)
+	if !ok {
+		return nil, errs.NewInfraError("*models.RecoveryCode以外の値が指定されました。")
+	}
+
+	userObjID, err := value.NewUserObjID(model.UserObjID)
+	if err != nil {
+		return nil, err
+	}
+
+	return userEntity.BuildRecoveryCode(model.ObjID, userObjID, model.CodeHash, model.UsedAt)
+}
@@ -0,0 +1,57 @@
+package adapter
+
+import (
+	"gorm.io/gorm"
+
+	orgEntity "github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// OrganizationAdapter は、組織と永続化用モデル間の変換を行うためのインターフェースです。
+type OrganizationAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *orgEntity.Organization) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*orgEntity.Organization, error)
+}
+
+// organizationAdapterImpl は、OrganizationAdapter の実装です。
+type organizationAdapterImpl struct{}
+
+// NewOrganizationAdapter は、OrganizationAdapter の実装を返します。
+func NewOrganizationAdapter() OrganizationAdapter {
+	return &organizationAdapterImpl{}
+}
+
+func (a *organizationAdapterImpl) Convert(source *orgEntity.Organization) any {
+	return &models.Organization{
+		Model: gorm.Model{CreatedAt: source.CreatedAt()},
+		ObjID: source.ObjID().Value(),
+		Name:  source.Name().Value(),
+		Slug:  source.Slug().Value(),
+	}
+}
+
+func (a *organizationAdapterImpl) ReBuild(source any) (*orgEntity.Organization, error) {
+	model, ok := source.(*models.Organization)
+	if !ok {
+		return nil, errs.NewInfraError("*models.Organization以外の値が指定されました。")
+	}
+
+	objID, err := value.NewOrgObjID(model.ObjID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := value.NewOrgName(model.Name)
+	if err != nil {
+		return nil, err
+	}
+	slug, err := value.NewOrgSlug(model.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgEntity.BuildOrganization(objID, name, slug, model.CreatedAt)
+}
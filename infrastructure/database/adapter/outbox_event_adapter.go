@@ -0,0 +1,53 @@
+package adapter
+
+import (
+	"encoding/json"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// OutboxEventAdapter は、ドメインイベントとアウトボックスの永続化用モデル間の変換を行うためのインターフェースです。
+type OutboxEventAdapter interface {
+	// Convert は、ドメインイベントから未配信状態の GORM モデルへ変換します。
+	Convert(source *event.Event) any
+	// ReBuild は、GORM モデルからドメインイベントへ再構築します。
+	ReBuild(source any) (*event.Event, error)
+}
+
+// outboxEventAdapterImpl は、OutboxEventAdapter の実装です。
+type outboxEventAdapterImpl struct{}
+
+// NewOutboxEventAdapter は、OutboxEventAdapter の実装を返します。
+func NewOutboxEventAdapter() OutboxEventAdapter {
+	return &outboxEventAdapterImpl{}
+}
+
+func (a *outboxEventAdapterImpl) Convert(source *event.Event) any {
+	return &models.OutboxEvent{
+		ObjID:         source.ID,
+		TenantID:      source.TenantID,
+		EventType:     string(source.Type),
+		AggregateID:   source.AggregateID,
+		Payload:       string(source.Payload),
+		Status:        models.OutboxStatusPending,
+		NextAttemptAt: source.OccurredAt,
+		OccurredAt:    source.OccurredAt,
+	}
+}
+
+func (a *outboxEventAdapterImpl) ReBuild(source any) (*event.Event, error) {
+	model, ok := source.(*models.OutboxEvent)
+	if !ok {
+		return nil, errs.NewInfraError("*models.OutboxEvent以外の値が指定されました。")
+	}
+	return &event.Event{
+		ID:          model.ObjID,
+		TenantID:    model.TenantID,
+		Type:        event.Type(model.EventType),
+		AggregateID: model.AggregateID,
+		Payload:     json.RawMessage(model.Payload),
+		OccurredAt:  model.OccurredAt,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package adapter
+
+import (
+	"strings"
+
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	"gorm.io/gorm"
+)
+
+// PasskeyCredentialAdapter は、パスキーと永続化用モデル間の変換を行うためのインターフェースです。
+type PasskeyCredentialAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.PasskeyCredential) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.PasskeyCredential, error)
+}
+
+// passkeyCredentialAdapterImpl は、PasskeyCredentialAdapter の実装です。
+type passkeyCredentialAdapterImpl struct{}
+
+// NewPasskeyCredentialAdapter は、PasskeyCredentialAdapter の実装を返します。
+func NewPasskeyCredentialAdapter() PasskeyCredentialAdapter {
+	return &passkeyCredentialAdapterImpl{}
+}
+
+func (a *passkeyCredentialAdapterImpl) Convert(source *userEntity.PasskeyCredential) any {
+	return &models.PasskeyCredential{
+		Model:           gorm.Model{CreatedAt: source.CreatedAt()},
+		ObjID:           source.ID(),
+		UserObjID:       source.UserObjID().Value(),
+		CredentialID:    source.CredentialID(),
+		Name:            source.Name(),
+		PublicKey:       source.PublicKey(),
+		AttestationType: source.AttestationType(),
+		Transports:      strings.Join(source.Transports(), ","),
+		AAGUID:          source.AAGUID(),
+		SignCount:       int64(source.SignCount()),
+		BackupEligible:  source.BackupEligible(),
+		BackupState:     source.BackupState(),
+		LastUsedAt:      source.LastUsedAt(),
+	}
+}
+
+func (a *passkeyCredentialAdapterImpl) ReBuild(source any) (*userEntity.PasskeyCredential, error) {
+	model, ok := source.(*models.PasskeyCredential)
+	if !ok {
+		return nil, errs.NewInfraError("*models.PasskeyCredential以外の値が指定されました。")
+	}
+
+	userObjID, err := value.NewUserObjID(model.UserObjID)
+	if err != nil {
+		return nil, err
+	}
+	var transports []string
+	if model.Transports != "" {
+		transports = strings.Split(model.Transports, ",")
+	}
+
+	return userEntity.BuildPasskeyCredential(model.ObjID, userObjID, model.CredentialID, model.Name, model.PublicKey, model.AttestationType, transports, model.AAGUID, uint32(model.SignCount), model.BackupEligible, model.BackupState, model.CreatedAt, model.LastUsedAt)
+}
+
+// PasskeySessionAdapter は、パスキーのセレモニーの状態と永続化用モデル間の変換を行うためのインターフェースです。
+type PasskeySessionAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.PasskeySession) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.PasskeySession, error)
+}
+
+// passkeySessionAdapterImpl は、PasskeySessionAdapter の実装です。
+type passkeySessionAdapterImpl struct{}
+
+// NewPasskeySessionAdapter は、PasskeySessionAdapter の実装を返します。
+func NewPasskeySessionAdapter() PasskeySessionAdapter {
+	return &passkeySessionAdapterImpl{}
+}
+
+func (a *passkeySessionAdapterImpl) Convert(source *userEntity.PasskeySession) any {
+	var userObjID *string
+	if source.UserObjID() != nil {
+		v := source.UserObjID().Value()
+		userObjID = &v
+	}
+	return &models.PasskeySession{
+		ObjID:     source.ID(),
+		UserObjID: userObjID,
+		Ceremony:  string(source.Ceremony()),
+		Data:      string(source.Data()),
+		ExpiresAt: source.ExpiresAt(),
+	}
+}
+
+func (a *passkeySessionAdapterImpl) ReBuild(source any) (*userEntity.PasskeySession, error) {
+	model, ok := source.(*models.PasskeySession)
+	if !ok {
+		return nil, errs.NewInfraError("*models.PasskeySession以外の値が指定されました。")
+	}
+
+	var userObjID *value.UserObjID
+	if model.UserObjID != nil {
+		v, err := value.NewUserObjID(*model.UserObjID)
+		if err != nil {
+			return nil, err
+		}
+		userObjID = v
+	}
+
+	return userEntity.BuildPasskeySession(model.ObjID, userObjID, userEntity.PasskeyCeremony(model.Ceremony), []byte(model.Data), model.ExpiresAt)
+}
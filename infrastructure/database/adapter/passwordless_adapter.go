@@ -0,0 +1,52 @@
+package adapter
+
+import (
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	"gorm.io/gorm"
+)
+
+// PasswordlessChallengeAdapter は、パスワードレスログインのチャレンジと永続化用モデル間の変換を行うためのインターフェースです。
+type PasswordlessChallengeAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.PasswordlessChallenge) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.PasswordlessChallenge, error)
+}
+
+// passwordlessChallengeAdapterImpl は、PasswordlessChallengeAdapter の実装です。
+type passwordlessChallengeAdapterImpl struct{}
+
+// NewPasswordlessChallengeAdapter は、PasswordlessChallengeAdapter の実装を返します。
+func NewPasswordlessChallengeAdapter() PasswordlessChallengeAdapter {
+	return &passwordlessChallengeAdapterImpl{}
+}
+
+func (a *passwordlessChallengeAdapterImpl) Convert(source *userEntity.PasswordlessChallenge) any {
+	return &models.PasswordlessChallenge{
+		Model:      gorm.Model{CreatedAt: source.CreatedAt()},
+		ObjID:      source.ID(),
+		Email:      source.Email().Value(),
+		EmailKey:   source.Email().CanonicalKey(),
+		CodeHash:   source.CodeHash(),
+		Attempts:   source.Attempts(),
+		ExpiresAt:  source.ExpiresAt(),
+		ConsumedAt: source.ConsumedAt(),
+	}
+}
+
+func (a *passwordlessChallengeAdapterImpl) ReBuild(source any) (*userEntity.PasswordlessChallenge, error) {
+	model, ok := source.(*models.PasswordlessChallenge)
+	if !ok {
+		return nil, errs.NewInfraError("*models.PasswordlessChallenge以外の値が指定されました。")
+	}
+
+	email, err := value.NewUserEmail(model.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return userEntity.BuildPasswordlessChallenge(model.ObjID, email, model.CodeHash, model.Attempts, model.ExpiresAt, model.ConsumedAt, model.CreatedAt)
+}
@@ -0,0 +1,64 @@
+package adapter
+
+import (
+	"encoding/json"
+
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// RoleAdapter は、ロールと永続化用モデル間の変換を行うためのインターフェースです。
+type RoleAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.Role) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.Role, error)
+}
+
+// roleAdapterImpl は、RoleAdapter の実装です。
+type roleAdapterImpl struct{}
+
+// NewRoleAdapter は、RoleAdapter の実装を返します。
+func NewRoleAdapter() RoleAdapter {
+	return &roleAdapterImpl{}
+}
+
+func (a *roleAdapterImpl) Convert(source *userEntity.Role) any {
+	// 権限は JSON の配列で保存する（文字列のスライスのため変換には失敗しない）
+	permissions, _ := json.Marshal(source.PermissionNames())
+	return &models.Role{
+		Name:        source.Name().Value(),
+		Description: source.Description(),
+		Permissions: string(permissions),
+	}
+}
+
+func (a *roleAdapterImpl) ReBuild(source any) (*userEntity.Role, error) {
+	model, ok := source.(*models.Role)
+	if !ok {
+		return nil, errs.NewInfraError("*models.Role以外の値が指定されました。")
+	}
+
+	name, err := value.NewUserRole(model.Name)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	if model.Permissions != "" {
+		if err := json.Unmarshal([]byte(model.Permissions), &names); err != nil {
+			return nil, errs.NewInfraError("ロールの権限の再構築に失敗しました。")
+		}
+	}
+	permissions := make([]*value.Permission, 0, len(names))
+	for _, name := range names {
+		permission, err := value.NewPermission(name)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return userEntity.BuildRole(name, model.Description, permissions)
+}
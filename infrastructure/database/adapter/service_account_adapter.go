@@ -0,0 +1,49 @@
+package adapter
+
+import (
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// ServiceAccountAdapter は、サービスアカウントと永続化用モデル間の変換を行うためのインターフェースです。
+// ユーザーとしての情報は UserAdapter で変換します。
+type ServiceAccountAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.ServiceAccount) any
+	// ReBuild は、GORM モデルと再構築済みのユーザーからドメインエンティティへ再構築します。
+	ReBuild(source any, user *userEntity.User) (*userEntity.ServiceAccount, error)
+}
+
+// serviceAccountAdapterImpl は、ServiceAccountAdapter の実装です。
+type serviceAccountAdapterImpl struct{}
+
+// NewServiceAccountAdapter は、ServiceAccountAdapter の実装を返します。
+func NewServiceAccountAdapter() ServiceAccountAdapter {
+	return &serviceAccountAdapterImpl{}
+}
+
+func (a *serviceAccountAdapterImpl) Convert(source *userEntity.ServiceAccount) any {
+	return &models.ServiceAccount{
+		UserObjID:   source.ObjID().Value(),
+		Description: source.Description(),
+		OwnerType:   source.OwnerType(),
+		OwnerID:     source.OwnerID(),
+		CreatedBy:   source.CreatedBy().Value(),
+	}
+}
+
+func (a *serviceAccountAdapterImpl) ReBuild(source any, user *userEntity.User) (*userEntity.ServiceAccount, error) {
+	model, ok := source.(*models.ServiceAccount)
+	if !ok {
+		return nil, errs.NewInfraError("*models.ServiceAccount以外の値が指定されました。")
+	}
+
+	createdBy, err := value.NewUserObjID(model.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return userEntity.BuildServiceAccount(user, model.Description, model.OwnerType, model.OwnerID, createdBy)
+}
@@ -0,0 +1,50 @@
+package adapter
+
+import (
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	"gorm.io/gorm"
+)
+
+// TrustedDeviceAdapter は、信頼済み端末と永続化用モデル間の変換を行うためのインターフェースです。
+type TrustedDeviceAdapter interface {
+	// Convert は、ドメインエンティティから GORM モデルへ変換します。
+	Convert(source *userEntity.TrustedDevice) any
+	// ReBuild は、GORM モデルからドメインエンティティへ再構築します。
+	ReBuild(source any) (*userEntity.TrustedDevice, error)
+}
+
+// trustedDeviceAdapterImpl は、TrustedDeviceAdapter の実装です。
+type trustedDeviceAdapterImpl struct{}
+
+// NewTrustedDeviceAdapter は、TrustedDeviceAdapter の実装を返します。
+func NewTrustedDeviceAdapter() TrustedDeviceAdapter {
+	return &trustedDeviceAdapterImpl{}
+}
+
+func (a *trustedDeviceAdapterImpl) Convert(source *userEntity.TrustedDevice) any {
+	return &models.TrustedDevice{
+		Model:      gorm.Model{CreatedAt: source.CreatedAt()},
+		ObjID:      source.ID(),
+		UserObjID:  source.UserObjID().Value(),
+		Name:       source.Name(),
+		ExpiresAt:  source.ExpiresAt(),
+		LastUsedAt: source.LastUsedAt(),
+	}
+}
+
+func (a *trustedDeviceAdapterImpl) ReBuild(source any) (*userEntity.TrustedDevice, error) {
+	model, ok := source.(*models.TrustedDevice)
+	if !ok {
+		return nil, errs.NewInfraError("*models.TrustedDevice以外の値が指定されました。")
+	}
+
+	userObjID, err := value.NewUserObjID(model.UserObjID)
+	if err != nil {
+		return nil, err
+	}
+
+	return userEntity.BuildTrustedDevice(model.ObjID, userObjID, model.Name, model.ExpiresAt, model.CreatedAt, model.LastUsedAt)
+}
@@ -3,6 +3,8 @@ package adapter
 import (
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/goda6565/nexus-user-auth/domain/timeobj"
 	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
@@ -27,7 +29,7 @@ func NewUserAdapter() UserAdapter {
 }
 
 func (a *userAdapterImpl) Convert(source *userEntity.User) any {
-	// EmailVerifiedAt, LastLoginAt は nil チェックを行い、存在すれば time.Time に変換
+	// EmailVerifiedAt, LastLoginAt, StatusChangedAt, CreatedAt は nil チェックを行い、存在すれば time.Time に変換
 	var emailVerifiedAt *time.Time
 	if source.EmailVerifiedAt() != nil {
 		t := source.EmailVerifiedAt().Value()
@@ -38,21 +40,41 @@ func (a *userAdapterImpl) Convert(source *userEntity.User) any {
 		t := source.LastLoginAt().Value()
 		lastLoginAt = &t
 	}
+	var statusChangedAt *time.Time
+	if source.StatusChangedAt() != nil {
+		t := source.StatusChangedAt().Value()
+		statusChangedAt = &t
+	}
+	var createdAt time.Time
+	if source.CreatedAt() != nil {
+		createdAt = source.CreatedAt().Value()
+	}
 	// AvatarURL が nil なら空文字とする
 	avatar := ""
 	if source.AvatarURL() != nil {
 		avatar = source.AvatarURL().Value()
 	}
 
+	roles := make([]models.UserRole, 0, len(source.Roles()))
+	for _, role := range source.Roles() {
+		roles = append(roles, models.UserRole{UserObjID: source.ObjID().Value(), RoleName: role.Value()})
+	}
+
 	return &models.User{
+		Model:           gorm.Model{CreatedAt: createdAt}, // ゼロ値の場合は GORM が作成日時を設定する
 		ObjID:           source.ObjID().Value(),
+		Kind:            source.Kind().Value(),
 		Email:           source.Email().Value(),
+		EmailKey:        source.Email().CanonicalKey(),
 		Password:        source.Password().Value(),
 		Username:        source.Username().Value(),
 		AvatarURL:       avatar,
 		EmailVerifiedAt: emailVerifiedAt,
 		LastLoginAt:     lastLoginAt,
-		Role:            source.Role().Value(),
+		Status:          source.Status().Value(),
+		StatusReason:    source.StatusReason(),
+		StatusChangedAt: statusChangedAt,
+		Roles:           roles,
 	}
 }
 
@@ -93,15 +115,44 @@ func (a *userAdapterImpl) ReBuild(source any) (*userEntity.User, error) {
 			return nil, err
 		}
 	}
-	role, err := value.NewUserRole(userModel.Role)
+	roles := make([]*value.UserRole, 0, len(userModel.Roles))
+	for _, userRole := range userModel.Roles {
+		role, err := value.NewUserRole(userRole.RoleName)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	status, err := value.NewUserStatus(userModel.Status)
 	if err != nil {
 		return nil, err
 	}
+	var statusChangedAt *timeobj.TimeObj
+	if userModel.StatusChangedAt != nil {
+		statusChangedAt, err = timeobj.NewTimeObj(*userModel.StatusChangedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var createdAt *timeobj.TimeObj
+	if !userModel.CreatedAt.IsZero() {
+		createdAt, err = timeobj.NewTimeObj(userModel.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
 	objID, err := value.NewUserObjID(userModel.ObjID)
 	if err != nil {
 		return nil, err
 	}
+	var kind *value.UserKind
+	if userModel.Kind != "" {
+		kind, err = value.NewUserKind(userModel.Kind)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// BuildUser は、既存データからドメインエンティティを再構築するためのファクトリ関数です。
-	return userEntity.BuildUser(objID, email, value.FromHashed(userModel.Password), username, avatarURL, emailVerifiedAt, lastLoginAt, role)
+	return userEntity.BuildUser(objID, email, value.FromHashed(userModel.Password), username, avatarURL, emailVerifiedAt, lastLoginAt, roles, status, userModel.StatusReason, statusChangedAt, createdAt, kind)
 }
@@ -40,12 +40,41 @@ func NewDBInstance(instance int) (db *gorm.DB, err error) {
 	default:
 		return nil, errInvalidDBInstance
 	}
-	return db, err
+	if err != nil {
+		return nil, err
+	}
+	if err := registerTenantScope(db); err != nil {
+		return nil, err
+	}
+	return db, nil
 }
 
 func NewGormModel() []interface{} {
 	// マイグレーション対象のモデルを返す
 	return []interface{}{
 		&models.User{},
+		&models.EmailChange{},
+		&models.EmailVerificationSend{},
+		&models.OutboxEvent{},
+		&models.TOTPFactor{},
+		&models.RecoveryCode{},
+		&models.PasskeyCredential{},
+		&models.PasskeySession{},
+		&models.PasswordlessChallenge{},
+		&models.TrustedDevice{},
+		&models.APIKey{},
+		&models.ServiceAccount{},
+		&models.LoginFailure{},
+		&models.LoginEvent{},
+		&models.AuditLog{},
+		&models.Role{},
+		&models.UserRole{},
+		&models.Organization{},
+		&models.OrganizationMember{},
+		&models.OrganizationInvitation{},
+		&models.Group{},
+		&models.GroupRole{},
+		&models.GroupMember{},
+		&models.GroupSubgroup{},
 	}
 }
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type APIKey struct {
+	gorm.Model
+	ObjID      string     `gorm:"type:uuid;uniqueIndex;not null"` // 外部識別用のUUID
+	TenantID   string     `gorm:"size:64;not null;default:'default'"`
+	UserObjID  string     `gorm:"type:uuid;index;not null"`
+	Name       string     `gorm:"size:100;not null"`
+	Prefix     string     `gorm:"size:20;not null"`                // キーの先頭の文字列（一覧で見分けるために表示する）
+	SecretHash string     `gorm:"size:64;uniqueIndex;not null"`    // キーの SHA-256（キーそのものは保存しない）
+	Scopes     string     `gorm:"type:text;not null;default:'[]'"` // スコープの一覧（JSON の配列）
+	ExpiresAt  *time.Time // nil の場合は期限なし
+	LastUsedAt *time.Time
+}
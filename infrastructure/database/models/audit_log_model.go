@@ -0,0 +1,18 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type AuditLog struct {
+	gorm.Model
+	ObjID       string    `gorm:"type:uuid;uniqueIndex;not null"`     // 外部識別用のUUID
+	TenantID    string    `gorm:"size:64;not null;default:'default'"` // 所属するテナント
+	ActorObjID  string    `gorm:"type:uuid;index;not null"`           // 操作した管理者
+	ActorKind   string    `gorm:"size:20;not null;default:'user'"`    // 操作したアカウントの種類（user / service_account）
+	Action      string    `gorm:"size:64;not null"`
+	TargetObjID string    `gorm:"size:36;index;not null;default:''"` // 操作の対象のユーザー（ない場合は空）
+	Detail      string    `gorm:"type:text;not null;default:''"`     // 操作の内容（JSON）
+	OccurredAt  time.Time `gorm:"index;not null"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type EmailChange struct {
+	gorm.Model
+	ObjID       string    `gorm:"type:uuid;uniqueIndex;not null"`     // 外部識別用のUUID
+	TenantID    string    `gorm:"size:64;not null;default:'default'"` // 所属するテナント
+	UserObjID   string    `gorm:"type:uuid;index;not null"`
+	OldEmail    string    `gorm:"size:255;not null"`
+	NewEmail    string    `gorm:"size:255;not null"`
+	Status      string    `gorm:"size:20;index;not null"`
+	ExpiresAt   time.Time `gorm:"not null"`
+	CompletedAt *time.Time
+}
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type EmailVerificationSend struct {
+	gorm.Model
+	TenantID string    `gorm:"size:64;not null;default:'default';uniqueIndex:idx_email_verification_sends_tenant_id_email_key,priority:1"`
+	EmailKey string    `gorm:"size:320;not null;uniqueIndex:idx_email_verification_sends_tenant_id_email_key,priority:2"` // 正規化したメールアドレス
+	SentAt   time.Time `gorm:"not null"`                                                                                  // 最後に確認メールを送信した日時
+}
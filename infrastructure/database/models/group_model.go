@@ -0,0 +1,36 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+type Group struct {
+	gorm.Model
+	ObjID       string      `gorm:"type:uuid;uniqueIndex;not null"`                                                      // 外部識別用のUUID
+	TenantID    string      `gorm:"size:64;not null;default:'default';uniqueIndex:idx_groups_tenant_id_name,priority:1"` // 所属するテナント（名前はテナントごとに一意）
+	Name        string      `gorm:"size:50;not null;uniqueIndex:idx_groups_tenant_id_name,priority:2"`
+	Description string      `gorm:"size:255;not null;default:''"`
+	Permissions string      `gorm:"type:text;not null;default:'[]'"` // グループに直接与えた権限の一覧（JSON の配列）
+	Roles       []GroupRole `gorm:"foreignKey:GroupObjID;references:ObjID"`
+}
+
+// GroupRole は、グループに与えたロール
+type GroupRole struct {
+	gorm.Model
+	GroupObjID string `gorm:"type:uuid;not null;uniqueIndex:idx_group_roles_group_obj_id_role_name"`
+	RoleName   string `gorm:"size:50;not null;uniqueIndex:idx_group_roles_group_obj_id_role_name;index"`
+}
+
+// GroupMember は、ユーザーのグループへの所属
+type GroupMember struct {
+	gorm.Model
+	GroupObjID string `gorm:"type:uuid;not null;uniqueIndex:idx_group_members_group_obj_id_user_obj_id"`
+	UserObjID  string `gorm:"type:uuid;not null;uniqueIndex:idx_group_members_group_obj_id_user_obj_id;index"`
+}
+
+// GroupSubgroup は、グループの他のグループへの所属（子のグループのメンバーは親のグループにも所属する）
+type GroupSubgroup struct {
+	gorm.Model
+	ParentObjID string `gorm:"type:uuid;not null;uniqueIndex:idx_group_subgroups_parent_obj_id_child_obj_id"`
+	ChildObjID  string `gorm:"type:uuid;not null;uniqueIndex:idx_group_subgroups_parent_obj_id_child_obj_id;index"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type LoginEvent struct {
+	gorm.Model
+	ObjID      string    `gorm:"type:uuid;uniqueIndex;not null"`     // 外部識別用のUUID
+	TenantID   string    `gorm:"size:64;not null;default:'default'"` // 所属するテナント
+	UserObjID  string    `gorm:"type:uuid;index:idx_login_events_user_occurred_at,priority:1;not null"`
+	Result     string    `gorm:"size:16;not null"`
+	Reason     string    `gorm:"size:64;not null;default:''"`
+	Methods    string    `gorm:"size:64;not null;default:''"` // amr 値を空白区切りで保存
+	IPAddress  string    `gorm:"size:45;not null;default:''"`
+	UserAgent  string    `gorm:"size:512;not null;default:''"`
+	OccurredAt time.Time `gorm:"index:idx_login_events_user_occurred_at,priority:2;not null"`
+	NewDevice  bool      `gorm:"not null;default:false"` // 最近ログインに成功していない端末・接続元からのログインか
+}
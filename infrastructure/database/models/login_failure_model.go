@@ -0,0 +1,16 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type LoginFailure struct {
+	gorm.Model
+	TenantID     string    `gorm:"size:64;not null;default:'default';uniqueIndex:idx_login_failures_tenant_id_scope_identifier,priority:1"`
+	Scope        string    `gorm:"size:16;not null;uniqueIndex:idx_login_failures_tenant_id_scope_identifier,priority:2"`
+	Identifier   string    `gorm:"size:320;not null;uniqueIndex:idx_login_failures_tenant_id_scope_identifier,priority:3"` // 正規化したメールアドレス、または IP アドレス
+	Failures     int       `gorm:"not null;default:0"`
+	LastFailedAt time.Time `gorm:"not null"`
+	LockedUntil  *time.Time
+}
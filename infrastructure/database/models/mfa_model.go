@@ -0,0 +1,24 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type TOTPFactor struct {
+	gorm.Model
+	TenantID     string `gorm:"size:64;not null;default:'default'"` // 所属するテナント
+	UserObjID    string `gorm:"type:uuid;uniqueIndex;not null"`
+	Secret       string `gorm:"size:255;not null"` // AES-GCM で暗号化したシークレット
+	ConfirmedAt  *time.Time
+	LastUsedStep int64 `gorm:"not null;default:0"`
+}
+
+type RecoveryCode struct {
+	gorm.Model
+	ObjID     string `gorm:"type:uuid;uniqueIndex;not null"`     // 外部識別用のUUID
+	TenantID  string `gorm:"size:64;not null;default:'default'"` // 所属するテナント
+	UserObjID string `gorm:"type:uuid;index;not null"`
+	CodeHash  string `gorm:"size:64;not null"`
+	UsedAt    *time.Time
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Organization struct {
+	gorm.Model
+	ObjID    string `gorm:"type:uuid;uniqueIndex;not null"`                                                             // 外部識別用のUUID
+	TenantID string `gorm:"size:64;not null;default:'default';uniqueIndex:idx_organizations_tenant_id_slug,priority:1"` // 所属するテナント（スラッグはテナントごとに一意）
+	Name     string `gorm:"size:100;not null"`
+	Slug     string `gorm:"size:50;not null;uniqueIndex:idx_organizations_tenant_id_slug,priority:2"`
+}
+
+// OrganizationMember は、ユーザーの組織への所属と組織でのロール
+type OrganizationMember struct {
+	gorm.Model
+	OrgObjID  string `gorm:"type:uuid;not null;uniqueIndex:idx_organization_members_org_obj_id_user_obj_id"`
+	UserObjID string `gorm:"type:uuid;not null;uniqueIndex:idx_organization_members_org_obj_id_user_obj_id;index"`
+	Role      string `gorm:"size:20;not null"`
+}
+
+// OrganizationInvitation は、メールアドレス宛ての組織への招待
+type OrganizationInvitation struct {
+	gorm.Model
+	ObjID        string    `gorm:"type:uuid;uniqueIndex;not null"` // 外部識別用のUUID
+	OrgObjID     string    `gorm:"type:uuid;index;not null"`
+	Email        string    `gorm:"size:255;not null"`
+	Role         string    `gorm:"size:20;not null"`
+	InviterObjID string    `gorm:"type:uuid;not null"`
+	Status       string    `gorm:"size:20;index;not null"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	RespondedAt  *time.Time
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// OutboxEvent は、集約の変更と同じトランザクションで記録され、リレーによって配信されるイベント
+type OutboxEvent struct {
+	gorm.Model
+	ObjID         string    `gorm:"type:uuid;uniqueIndex;not null"`     // イベントID
+	TenantID      string    `gorm:"size:64;not null;default:'default'"` // イベントが発生したテナント
+	EventType     string    `gorm:"size:100;index;not null"`
+	AggregateID   string    `gorm:"size:255;index;not null"`
+	Payload       string    `gorm:"type:text;not null"` // JSON
+	Status        string    `gorm:"size:20;index:idx_outbox_events_status_next_attempt_at,priority:1;not null"`
+	Attempts      int       `gorm:"not null;default:0"`
+	NextAttemptAt time.Time `gorm:"index:idx_outbox_events_status_next_attempt_at,priority:2;not null"`
+	LastError     string    `gorm:"type:text"`
+	OccurredAt    time.Time `gorm:"not null"`
+	DeliveredAt   *time.Time
+}
+
+const (
+	OutboxStatusPending   = "pending"   // 未配信（リトライ待ちを含む）
+	OutboxStatusDelivered = "delivered" // 配信済み
+	OutboxStatusFailed    = "failed"    // 最大試行回数を超えて配信を断念
+)
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type PasskeyCredential struct {
+	gorm.Model
+	ObjID           string `gorm:"type:uuid;uniqueIndex;not null"`     // 外部識別用のUUID
+	TenantID        string `gorm:"size:64;not null;default:'default'"` // 所属するテナント
+	UserObjID       string `gorm:"type:uuid;index;not null"`
+	CredentialID    []byte `gorm:"uniqueIndex;not null"`
+	Name            string `gorm:"size:100;not null;default:''"`
+	PublicKey       []byte `gorm:"not null"`
+	AttestationType string `gorm:"size:32;not null;default:''"`
+	Transports      string `gorm:"size:255;not null;default:''"` // カンマ区切り
+	AAGUID          []byte
+	SignCount       int64 `gorm:"not null;default:0"`
+	BackupEligible  bool  `gorm:"not null;default:false"`
+	BackupState     bool  `gorm:"not null;default:false"`
+	LastUsedAt      *time.Time
+}
+
+type PasskeySession struct {
+	gorm.Model
+	ObjID     string    `gorm:"type:uuid;uniqueIndex;not null"`     // 外部識別用のUUID
+	TenantID  string    `gorm:"size:64;not null;default:'default'"` // 所属するテナント
+	UserObjID *string   `gorm:"type:uuid;index"`
+	Ceremony  string    `gorm:"size:20;not null"`
+	Data      string    `gorm:"type:text;not null"` // WebAuthn ライブラリのセッションデータ（JSON）
+	ExpiresAt time.Time `gorm:"not null"`
+}
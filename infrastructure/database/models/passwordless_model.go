@@ -0,0 +1,18 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type PasswordlessChallenge struct {
+	gorm.Model
+	ObjID      string    `gorm:"type:uuid;uniqueIndex;not null"` // 外部識別用のUUID
+	TenantID   string    `gorm:"size:64;not null;default:'default'"`
+	Email      string    `gorm:"size:255;not null"`
+	EmailKey   string    `gorm:"size:255;index;not null"` // 正規化したメールアドレス（検索用）
+	CodeHash   string    `gorm:"size:64;not null"`
+	Attempts   int       `gorm:"not null;default:0"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	ConsumedAt *time.Time
+}
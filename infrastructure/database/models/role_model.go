@@ -0,0 +1,20 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+type Role struct {
+	gorm.Model
+	TenantID    string `gorm:"size:64;not null;default:'default';uniqueIndex:idx_roles_tenant_id_name,priority:1"` // ロールを定義したテナント
+	Name        string `gorm:"size:50;not null;uniqueIndex:idx_roles_tenant_id_name,priority:2"`
+	Description string `gorm:"size:255;not null;default:''"`
+	Permissions string `gorm:"type:text;not null;default:'[]'"` // 権限の一覧（JSON の配列）
+}
+
+// UserRole は、ユーザーが持つロール
+type UserRole struct {
+	gorm.Model
+	UserObjID string `gorm:"type:uuid;not null;uniqueIndex:idx_user_roles_user_obj_id_role_name"`
+	RoleName  string `gorm:"size:50;not null;uniqueIndex:idx_user_roles_user_obj_id_role_name;index"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+// ServiceAccount は、サービスアカウント（種類が service_account のユーザー）の所有者などの情報
+type ServiceAccount struct {
+	gorm.Model
+	UserObjID   string `gorm:"type:uuid;uniqueIndex;not null"`
+	TenantID    string `gorm:"size:64;not null;default:'default'"`
+	Description string `gorm:"size:255"`
+	OwnerType   string `gorm:"size:20;not null"`         // 所有者の種類（admin / organization）
+	OwnerID     string `gorm:"type:uuid;index;not null"` // 所有する管理者のユーザー ID または組織の ID
+	CreatedBy   string `gorm:"type:uuid;not null"`
+}
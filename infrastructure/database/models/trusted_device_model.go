@@ -0,0 +1,16 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type TrustedDevice struct {
+	gorm.Model
+	ObjID      string    `gorm:"type:uuid;uniqueIndex;not null"`     // 外部識別用のUUID
+	TenantID   string    `gorm:"size:64;not null;default:'default'"` // 所属するテナント
+	UserObjID  string    `gorm:"type:uuid;index;not null"`
+	Name       string    `gorm:"size:255;not null;default:''"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	LastUsedAt *time.Time
+}
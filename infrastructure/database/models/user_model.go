@@ -7,12 +7,19 @@ import (
 
 type User struct {
 	gorm.Model
-	ObjID           string `gorm:"type:uuid;uniqueIndex;not null"` // 外部識別用のUUID
-	Email           string `gorm:"size:255;uniqueIndex;not null"`
-	Password        string `gorm:"size:255;not null"`
-	Username        string `gorm:"size:255;not null"`
-	AvatarURL       string `gorm:"size:255"`
-	EmailVerifiedAt *time.Time
-	LastLoginAt     *time.Time
-	Role            string `gorm:"size:50;default:'user';not null"`
+	ObjID             string `gorm:"type:uuid;uniqueIndex;not null"`                                                                                                           // 外部識別用のUUID
+	TenantID          string `gorm:"size:64;not null;default:'default';uniqueIndex:idx_users_tenant_id_email,priority:1;uniqueIndex:idx_users_tenant_id_email_key,priority:1"` // 所属するテナント（メールアドレスはテナントごとに一意）
+	Kind              string `gorm:"size:20;not null;default:'user'"`                                                                                                          // アカウントの種類（user / service_account）
+	Email             string `gorm:"size:255;not null;uniqueIndex:idx_users_tenant_id_email,priority:2"`
+	EmailKey          string `gorm:"size:255;not null;uniqueIndex:idx_users_tenant_id_email_key,priority:2"` // 正規化したメールアドレス（検索・重複判定用）
+	Password          string `gorm:"size:255;not null"`
+	Username          string `gorm:"size:255;not null"`
+	AvatarURL         string `gorm:"size:255"`
+	EmailVerifiedAt   *time.Time
+	LastLoginAt       *time.Time
+	SessionsRevokedAt *time.Time // これより前に発行したトークンは無効
+	Status            string     `gorm:"size:20;default:'active';not null"` // アカウントの状態
+	StatusReason      string     `gorm:"size:255"`                          // 停止・無効化の理由
+	StatusChangedAt   *time.Time
+	Roles             []UserRole `gorm:"foreignKey:UserObjID;references:ObjID"`
 }
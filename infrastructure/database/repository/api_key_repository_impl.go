@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type APIKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) repository.APIKeyRepository {
+	return &APIKeyRepositoryImpl{db: db}
+}
+
+func (r *APIKeyRepositoryImpl) CreateAPIKey(key *entity.APIKey) (*entity.APIKey, error) {
+	tx := r.db.Create(adapter.NewAPIKeyAdapter().Convert(key))
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("API キーの登録に失敗しました: %w", tx.Error).Error())
+	}
+	return key, nil
+}
+
+func (r *APIKeyRepositoryImpl) FindAPIKeyByHash(secretHash string) (*entity.APIKey, error) {
+	var model models.APIKey
+	tx := r.db.Where("secret_hash = ?", secretHash).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		// ハッシュはキーを特定できる値のため、エラーメッセージに含めない
+		return nil, errs.NewInfraError(fmt.Errorf("API キーの取得に失敗しました: %w", tx.Error).Error())
+	}
+	return r.rebuild(&model)
+}
+
+func (r *APIKeyRepositoryImpl) ListAPIKeys(userObjID string) ([]*entity.APIKey, error) {
+	var records []models.APIKey
+	if err := r.db.Where("user_obj_id = ?", userObjID).Order("id").Find(&records).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)の API キーの取得に失敗しました: %w", userObjID, err).Error())
+	}
+
+	keys := make([]*entity.APIKey, 0, len(records))
+	for i := range records {
+		key, err := r.rebuild(&records[i])
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *APIKeyRepositoryImpl) TouchAPIKey(id string, usedAt time.Time) error {
+	tx := r.db.Model(&models.APIKey{}).Where("obj_id = ?", id).Update("last_used_at", usedAt)
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("API キー(%s)の最終使用日時の更新に失敗しました: %w", id, tx.Error).Error())
+	}
+	return nil
+}
+
+func (r *APIKeyRepositoryImpl) DeleteAPIKey(userObjID string, id string) (bool, error) {
+	// 他のユーザーのキーを削除できないよう、ユーザーIDも条件に含める
+	tx := r.db.Unscoped().Where("obj_id = ? AND user_obj_id = ?", id, userObjID).Delete(&models.APIKey{})
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("API キー(%s)の削除に失敗しました: %w", id, tx.Error).Error())
+	}
+	return tx.RowsAffected == 1, nil
+}
+
+func (r *APIKeyRepositoryImpl) rebuild(model *models.APIKey) (*entity.APIKey, error) {
+	key, err := adapter.NewAPIKeyAdapter().ReBuild(model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("API キーの再構築に失敗しました: %w", err).Error())
+	}
+	return key, nil
+}
@@ -0,0 +1,142 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type APIKeyRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	apiKeyRepo repository.APIKeyRepository
+}
+
+func TestAPIKeyRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyRepositoryImplTestSuite))
+}
+
+func (suite *APIKeyRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.apiKeyRepo = NewAPIKeyRepository(suite.DB)
+}
+
+// newUserObjID はテスト用のユーザーIDを生成する
+func (suite *APIKeyRepositoryImplTestSuite) newUserObjID() *value.UserObjID {
+	objID, err := value.NewUserObjID(uuid.NewString())
+	suite.Require().NoError(err)
+	return objID
+}
+
+// newKey は API キーを作成し、キーそのものとともに返す（登録はしない）
+func (suite *APIKeyRepositoryImplTestSuite) newKey(objID *value.UserObjID, name string, scopes ...string) (*entity.APIKey, string) {
+	scopeValues := make([]*value.APIKeyScope, 0, len(scopes))
+	for _, s := range scopes {
+		scope, err := value.NewAPIKeyScope(s)
+		suite.Require().NoError(err)
+		scopeValues = append(scopeValues, scope)
+	}
+	secret := entity.APIKeyPrefix + uuid.NewString()
+	expiresAt := time.Now().Add(24 * time.Hour)
+	key, err := entity.NewAPIKey(objID, name, secret, scopeValues, &expiresAt)
+	suite.Require().NoError(err)
+	return key, secret
+}
+
+func (suite *APIKeyRepositoryImplTestSuite) createKey(objID *value.UserObjID, name string, scopes ...string) (*entity.APIKey, string) {
+	key, secret := suite.newKey(objID, name, scopes...)
+	_, err := suite.apiKeyRepo.CreateAPIKey(key)
+	suite.Require().NoError(err, "API キーの登録に失敗してはいけない")
+	return key, secret
+}
+
+func (suite *APIKeyRepositoryImplTestSuite) TestCreateAndFindAPIKeyByHash() {
+	key, secret := suite.createKey(suite.newUserObjID(), "CI", value.APIKeyScopeProfileRead, value.PermissionUsersRead)
+
+	found, err := suite.apiKeyRepo.FindAPIKeyByHash(entity.HashAPIKeySecret(secret))
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal(key.ID(), found.ID())
+	suite.Equal(key.UserObjID().Value(), found.UserObjID().Value())
+	suite.Equal("CI", found.Name())
+	suite.Equal(key.Prefix(), found.Prefix())
+	suite.Equal([]string{value.APIKeyScopeProfileRead, value.PermissionUsersRead}, found.ScopeNames())
+	suite.Require().NotNil(found.ExpiresAt())
+	suite.WithinDuration(*key.ExpiresAt(), *found.ExpiresAt(), time.Second)
+	suite.Nil(found.LastUsedAt())
+
+	missing, err := suite.apiKeyRepo.FindAPIKeyByHash(entity.HashAPIKeySecret(entity.APIKeyPrefix + "missing-secret"))
+	suite.NoError(err)
+	suite.Nil(missing, "存在しない場合は nil を返すこと")
+}
+
+func (suite *APIKeyRepositoryImplTestSuite) TestListAPIKeys() {
+	objID := suite.newUserObjID()
+	suite.createKey(objID, "CI", value.APIKeyScopeAll)
+	suite.createKey(objID, "Backup", value.APIKeyScopeProfileRead)
+	suite.createKey(suite.newUserObjID(), "Other", value.APIKeyScopeAll)
+
+	keys, err := suite.apiKeyRepo.ListAPIKeys(objID.Value())
+	suite.NoError(err)
+	suite.Require().Len(keys, 2, "他のユーザーのキーを含まないこと")
+	suite.Equal("CI", keys[0].Name(), "作成順に取得されること")
+	suite.Equal("Backup", keys[1].Name())
+}
+
+func (suite *APIKeyRepositoryImplTestSuite) TestTouchAPIKey() {
+	key, secret := suite.createKey(suite.newUserObjID(), "CI", value.APIKeyScopeAll)
+	usedAt := time.Now()
+
+	suite.NoError(suite.apiKeyRepo.TouchAPIKey(key.ID(), usedAt))
+
+	found, err := suite.apiKeyRepo.FindAPIKeyByHash(entity.HashAPIKeySecret(secret))
+	suite.NoError(err)
+	suite.Require().NotNil(found.LastUsedAt())
+	suite.WithinDuration(usedAt, *found.LastUsedAt(), time.Second)
+}
+
+func (suite *APIKeyRepositoryImplTestSuite) TestDeleteAPIKey() {
+	objID := suite.newUserObjID()
+	key, secret := suite.createKey(objID, "CI", value.APIKeyScopeAll)
+
+	// 他のユーザーのキーは削除できないこと
+	deleted, err := suite.apiKeyRepo.DeleteAPIKey(suite.newUserObjID().Value(), key.ID())
+	suite.NoError(err)
+	suite.False(deleted)
+
+	deleted, err = suite.apiKeyRepo.DeleteAPIKey(objID.Value(), key.ID())
+	suite.NoError(err)
+	suite.True(deleted)
+	found, err := suite.apiKeyRepo.FindAPIKeyByHash(entity.HashAPIKeySecret(secret))
+	suite.NoError(err)
+	suite.Nil(found)
+
+	deleted, err = suite.apiKeyRepo.DeleteAPIKey(objID.Value(), key.ID())
+	suite.NoError(err)
+	suite.False(deleted, "削除済みの場合は false を返すこと")
+}
+
+// 他のテナントで作成したキーでは認証できないこと
+func (suite *APIKeyRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeRepo := NewAPIKeyRepository(database.WithTenant(suite.DB, "acme"))
+	globexRepo := NewAPIKeyRepository(database.WithTenant(suite.DB, "globex"))
+
+	key, secret := suite.newKey(suite.newUserObjID(), "CI", value.APIKeyScopeAll)
+	_, err := acmeRepo.CreateAPIKey(key)
+	suite.Require().NoError(err)
+
+	found, err := globexRepo.FindAPIKeyByHash(entity.HashAPIKeySecret(secret))
+	suite.NoError(err)
+	suite.Nil(found, "他のテナントのキーは参照できないこと")
+	found, err = acmeRepo.FindAPIKeyByHash(entity.HashAPIKeySecret(secret))
+	suite.NoError(err)
+	suite.NotNil(found)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+)
+
+type AuditLogRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) repository.AuditLogRepository {
+	return &AuditLogRepositoryImpl{db: db}
+}
+
+func (r *AuditLogRepositoryImpl) SaveAuditLog(log *entity.AuditLog) error {
+	if err := r.db.Create(adapter.NewAuditLogAdapter().Convert(log)).Error; err != nil {
+		return errs.NewInfraError(fmt.Errorf("監査ログ(%s)の記録に失敗しました: %w", log.Action(), err).Error())
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type AuditLogRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	auditLogRepo repository.AuditLogRepository
+}
+
+func TestAuditLogRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditLogRepositoryImplTestSuite))
+}
+
+func (suite *AuditLogRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.auditLogRepo = NewAuditLogRepository(suite.DB)
+}
+
+func (suite *AuditLogRepositoryImplTestSuite) TestSaveAuditLog() {
+	actor, err := value.NewUserObjID("11111111-1111-1111-1111-111111111111")
+	suite.Require().NoError(err)
+	log, err := entity.NewAuditLog(actor, nil, entity.AuditActionUserRoles, "22222222-2222-2222-2222-222222222222", map[string]string{"role": value.Admin, "previousRole": value.RegularUser})
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.auditLogRepo.SaveAuditLog(log), "監査ログの記録に失敗してはいけない")
+
+	var record models.AuditLog
+	suite.Require().NoError(suite.DB.Where("obj_id = ?", log.ID()).First(&record).Error)
+	saved, err := adapter.NewAuditLogAdapter().ReBuild(&record)
+	suite.Require().NoError(err)
+	suite.Equal(actor.Value(), saved.ActorObjID().Value())
+	suite.Equal(value.KindUser, saved.ActorKind().Value())
+	suite.Equal(entity.AuditActionUserRoles, saved.Action())
+	suite.Equal("22222222-2222-2222-2222-222222222222", saved.TargetObjID())
+	suite.Equal(log.Detail(), saved.Detail(), "操作の内容が保存されること")
+	suite.WithinDuration(log.OccurredAt(), saved.OccurredAt(), 0)
+}
+
+func (suite *AuditLogRepositoryImplTestSuite) TestSaveAuditLog_WithoutTarget() {
+	actor, err := value.NewUserObjID("11111111-1111-1111-1111-111111111111")
+	suite.Require().NoError(err)
+	log, err := entity.NewAuditLog(actor, nil, entity.AuditActionUserList, "", nil)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.auditLogRepo.SaveAuditLog(log))
+
+	var record models.AuditLog
+	suite.Require().NoError(suite.DB.Where("obj_id = ?", log.ID()).First(&record).Error)
+	suite.Empty(record.TargetObjID)
+	suite.Empty(record.Detail, "内容がない場合は空文字で保存すること")
+}
+
+func (suite *AuditLogRepositoryImplTestSuite) TestSaveAuditLog_ServiceAccount() {
+	actor, err := value.NewUserObjID("11111111-1111-1111-1111-111111111111")
+	suite.Require().NoError(err)
+	kind, err := value.NewUserKind(value.KindServiceAccount)
+	suite.Require().NoError(err)
+	log, err := entity.NewAuditLog(actor, kind, entity.AuditActionUserList, "", nil)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.auditLogRepo.SaveAuditLog(log))
+
+	var record models.AuditLog
+	suite.Require().NoError(suite.DB.Where("obj_id = ?", log.ID()).First(&record).Error)
+	suite.Equal(value.KindServiceAccount, record.ActorKind, "サービスアカウントによる操作であることが保存されること")
+}
+
+// 監査ログは記録したテナントに属すること
+func (suite *AuditLogRepositoryImplTestSuite) TestSaveAuditLog_Tenant() {
+	actor, err := value.NewUserObjID("11111111-1111-1111-1111-111111111111")
+	suite.Require().NoError(err)
+	log, err := entity.NewAuditLog(actor, nil, entity.AuditActionUserView, "", nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(NewAuditLogRepository(database.WithTenant(suite.DB, "acme")).SaveAuditLog(log))
+
+	var record models.AuditLog
+	suite.Require().NoError(suite.DB.Where("obj_id = ?", log.ID()).First(&record).Error)
+	suite.Equal("acme", record.TenantID)
+	err = database.WithTenant(suite.DB, "globex").Where("obj_id = ?", log.ID()).First(&models.AuditLog{}).Error
+	suite.Error(err, "他のテナントからは参照できないこと")
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type EmailChangeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewEmailChangeRepository(db *gorm.DB) repository.EmailChangeRepository {
+	return &EmailChangeRepositoryImpl{db: db}
+}
+
+func (r *EmailChangeRepositoryImpl) CreateEmailChange(emailChange *entity.EmailChange) (*entity.EmailChange, error) {
+	tx := r.db.Create(adapter.NewEmailChangeAdapter().Convert(emailChange))
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メールアドレス変更リクエストの作成に失敗しました: %w", tx.Error).Error())
+	}
+	return emailChange, nil
+}
+
+func (r *EmailChangeRepositoryImpl) GetEmailChangeByID(id string) (*entity.EmailChange, error) {
+	var model models.EmailChange
+	tx := r.db.Where("obj_id = ?", id).First(&model)
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ID(%s)でのメールアドレス変更リクエスト取得に失敗しました: %w", id, tx.Error).Error())
+	}
+	emailChange, err := adapter.NewEmailChangeAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メールアドレス変更リクエストの再構築に失敗しました: %w", err).Error())
+	}
+	return emailChange, nil
+}
+
+func (r *EmailChangeRepositoryImpl) UpdateEmailChange(emailChange *entity.EmailChange) (*entity.EmailChange, error) {
+	var model models.EmailChange
+	tx := r.db.Where("obj_id = ?", emailChange.ID()).First(&model)
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メールアドレス変更リクエスト更新のための既存レコード取得に失敗しました: %w", tx.Error).Error())
+	}
+
+	converted, ok := adapter.NewEmailChangeAdapter().Convert(emailChange).(*models.EmailChange)
+	if !ok {
+		return nil, errs.NewInfraError("変換されたモデルが *models.EmailChange ではありません。")
+	}
+
+	// 状態のみ更新する（アドレスや期限は作成後に変わらない）
+	model.Status = converted.Status
+	model.CompletedAt = converted.CompletedAt
+
+	tx = r.db.Save(&model)
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メールアドレス変更リクエストの更新に失敗しました: %w", tx.Error).Error())
+	}
+
+	updated, err := adapter.NewEmailChangeAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メールアドレス変更リクエストの再構築に失敗しました: %w", err).Error())
+	}
+	return updated, nil
+}
+
+func (r *EmailChangeRepositoryImpl) CancelPendingEmailChanges(userObjID string) error {
+	tx := r.db.Model(&models.EmailChange{}).
+		Where("user_obj_id = ? AND status = ?", userObjID, string(entity.EmailChangePending)).
+		Updates(map[string]any{
+			"status":       string(entity.EmailChangeCancelled),
+			"completed_at": time.Now(),
+		})
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("ユーザー(%s)の確認待ちメールアドレス変更の取り消しに失敗しました: %w", userObjID, tx.Error).Error())
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type EmailChangeRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	emailChangeRepo repository.EmailChangeRepository
+}
+
+func TestEmailChangeRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(EmailChangeRepositoryImplTestSuite))
+}
+
+func (suite *EmailChangeRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.emailChangeRepo = NewEmailChangeRepository(suite.DB)
+}
+
+// newEmailChange はテスト用のメールアドレス変更リクエストを生成する
+func (suite *EmailChangeRepositoryImplTestSuite) newEmailChange(oldEmail, newEmail string) *entity.EmailChange {
+	email, err := value.NewUserEmail(oldEmail)
+	suite.NoError(err)
+	username, err := value.NewUserUsername("changeuser")
+	suite.NoError(err)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+
+	next, err := value.NewUserEmail(newEmail)
+	suite.NoError(err)
+	emailChange, err := entity.NewEmailChange(user, next, time.Now().Add(time.Hour))
+	suite.NoError(err)
+	return emailChange
+}
+
+func (suite *EmailChangeRepositoryImplTestSuite) TestCreateAndGetEmailChange() {
+	emailChange := suite.newEmailChange("old@example.com", "new@example.com")
+
+	_, err := suite.emailChangeRepo.CreateEmailChange(emailChange)
+	suite.NoError(err, "メールアドレス変更リクエストの作成に失敗してはいけない")
+
+	found, err := suite.emailChangeRepo.GetEmailChangeByID(emailChange.ID())
+	suite.NoError(err)
+	suite.Equal(emailChange.ID(), found.ID())
+	suite.Equal(emailChange.UserObjID().Value(), found.UserObjID().Value())
+	suite.Equal("old@example.com", found.OldEmail().Value())
+	suite.Equal("new@example.com", found.NewEmail().Value())
+	suite.Equal(entity.EmailChangePending, found.Status())
+}
+
+func (suite *EmailChangeRepositoryImplTestSuite) TestUpdateEmailChange() {
+	emailChange := suite.newEmailChange("update-old@example.com", "update-new@example.com")
+	_, err := suite.emailChangeRepo.CreateEmailChange(emailChange)
+	suite.NoError(err)
+
+	suite.NoError(emailChange.Confirm(time.Now()))
+	updated, err := suite.emailChangeRepo.UpdateEmailChange(emailChange)
+	suite.NoError(err)
+	suite.Equal(entity.EmailChangeConfirmed, updated.Status())
+	suite.NotNil(updated.CompletedAt())
+}
+
+func (suite *EmailChangeRepositoryImplTestSuite) TestCancelPendingEmailChanges() {
+	first := suite.newEmailChange("cancel@example.com", "cancel-new1@example.com")
+	_, err := suite.emailChangeRepo.CreateEmailChange(first)
+	suite.NoError(err)
+
+	// 同一ユーザーの別リクエスト（ユーザーIDを揃える）
+	next, err := value.NewUserEmail("cancel-new2@example.com")
+	suite.NoError(err)
+	second, err := entity.BuildEmailChange("9b2a8f3e-1d7c-4b6a-9e2f-5c3d1a0b7e4f", first.UserObjID(), first.OldEmail(), next, entity.EmailChangePending, time.Now().Add(time.Hour), nil)
+	suite.NoError(err)
+	_, err = suite.emailChangeRepo.CreateEmailChange(second)
+	suite.NoError(err)
+
+	err = suite.emailChangeRepo.CancelPendingEmailChanges(first.UserObjID().Value())
+	suite.NoError(err)
+
+	for _, id := range []string{first.ID(), second.ID()} {
+		found, err := suite.emailChangeRepo.GetEmailChangeByID(id)
+		suite.NoError(err)
+		suite.Equal(entity.EmailChangeCancelled, found.Status(), "確認待ちのリクエストが取り消されていること")
+	}
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type EmailVerificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewEmailVerificationRepository(db *gorm.DB) repository.EmailVerificationRepository {
+	return &EmailVerificationRepositoryImpl{db: db}
+}
+
+func (r *EmailVerificationRepositoryImpl) RecordSend(emailKey string, sentAt time.Time) error {
+	tx := r.db.Clauses(r.onConflict(sentAt, nil)).Create(&models.EmailVerificationSend{
+		EmailKey: emailKey,
+		SentAt:   sentAt,
+	})
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("確認メールの送信日時(%s)の記録に失敗しました: %w", emailKey, tx.Error).Error())
+	}
+	return nil
+}
+
+func (r *EmailVerificationRepositoryImpl) ClaimSend(emailKey string, sentAt time.Time, interval time.Duration) (bool, error) {
+	// 最後の送信から interval 経過していない場合は更新されず、影響行数が 0 になる
+	where := clause.Where{Exprs: []clause.Expression{
+		clause.Expr{SQL: "email_verification_sends.sent_at <= ?", Vars: []any{sentAt.Add(-interval)}},
+	}}
+	tx := r.db.Clauses(r.onConflict(sentAt, &where)).Create(&models.EmailVerificationSend{
+		EmailKey: emailKey,
+		SentAt:   sentAt,
+	})
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("確認メールの送信日時(%s)の記録に失敗しました: %w", emailKey, tx.Error).Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// onConflict は、記録済みのメールアドレスの送信日時を更新する UPSERT の句を返す（where を指定した場合は一致する行のみ更新する）
+func (r *EmailVerificationRepositoryImpl) onConflict(sentAt time.Time, where *clause.Where) clause.OnConflict {
+	conflict := clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "email_key"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"sent_at":    sentAt,
+			"updated_at": sentAt,
+		}),
+	}
+	if where != nil {
+		conflict.Where = *where
+	}
+	return conflict
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type EmailVerificationRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	emailVerificationRepo repository.EmailVerificationRepository
+}
+
+func TestEmailVerificationRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(EmailVerificationRepositoryImplTestSuite))
+}
+
+func (suite *EmailVerificationRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.emailVerificationRepo = NewEmailVerificationRepository(suite.DB)
+}
+
+func (suite *EmailVerificationRepositoryImplTestSuite) TestClaimSend() {
+	now := time.Now()
+	claimed, err := suite.emailVerificationRepo.ClaimSend("claim@example.com", now, time.Minute)
+	suite.Require().NoError(err)
+	suite.True(claimed, "初回の送信は記録できること")
+
+	claimed, err = suite.emailVerificationRepo.ClaimSend("claim@example.com", now.Add(30*time.Second), time.Minute)
+	suite.Require().NoError(err)
+	suite.False(claimed, "間隔内の送信は記録できないこと")
+
+	claimed, err = suite.emailVerificationRepo.ClaimSend("other@example.com", now.Add(30*time.Second), time.Minute)
+	suite.Require().NoError(err)
+	suite.True(claimed, "異なるメールアドレスとは区別すること")
+
+	claimed, err = suite.emailVerificationRepo.ClaimSend("claim@example.com", now.Add(time.Minute), time.Minute)
+	suite.Require().NoError(err)
+	suite.True(claimed, "間隔が経過した後は記録できること")
+}
+
+func (suite *EmailVerificationRepositoryImplTestSuite) TestRecordSend() {
+	now := time.Now()
+	suite.Require().NoError(suite.emailVerificationRepo.RecordSend("record@example.com", now))
+
+	claimed, err := suite.emailVerificationRepo.ClaimSend("record@example.com", now.Add(time.Second), time.Minute)
+	suite.Require().NoError(err)
+	suite.False(claimed, "記録した送信日時から間隔内は記録できないこと")
+
+	suite.Require().NoError(suite.emailVerificationRepo.RecordSend("record@example.com", now.Add(time.Second)), "記録済みでも上書きできること")
+}
+
+func (suite *EmailVerificationRepositoryImplTestSuite) TestClaimSend_Tenant() {
+	now := time.Now()
+	tenantA := NewEmailVerificationRepository(database.WithTenant(suite.DB, "tenant-a"))
+	tenantB := NewEmailVerificationRepository(database.WithTenant(suite.DB, "tenant-b"))
+
+	claimed, err := tenantA.ClaimSend("tenant@example.com", now, time.Minute)
+	suite.Require().NoError(err)
+	suite.True(claimed)
+
+	claimed, err = tenantB.ClaimSend("tenant@example.com", now, time.Minute)
+	suite.Require().NoError(err)
+	suite.True(claimed, "テナントごとに区別すること")
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type GroupRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewGroupRepository(db *gorm.DB) repository.GroupRepository {
+	return &GroupRepositoryImpl{db: db}
+}
+
+func (r *GroupRepositoryImpl) ListGroups() ([]*entity.Group, error) {
+	var modelGroups []models.Group
+	if err := r.db.Preload("Roles").Order("name").Find(&modelGroups).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("グループの一覧の取得に失敗しました: %w", err).Error())
+	}
+	return rebuildGroups(modelGroups)
+}
+
+func (r *GroupRepositoryImpl) FindGroup(groupObjID string) (*entity.Group, error) {
+	return r.findGroup("obj_id = ?", groupObjID)
+}
+
+func (r *GroupRepositoryImpl) FindGroupByName(name string) (*entity.Group, error) {
+	return r.findGroup("name = ?", name)
+}
+
+func (r *GroupRepositoryImpl) FindGroupsByIDs(groupObjIDs []string) ([]*entity.Group, error) {
+	if len(groupObjIDs) == 0 {
+		return []*entity.Group{}, nil
+	}
+	var modelGroups []models.Group
+	if err := r.db.Preload("Roles").Where("obj_id IN ?", groupObjIDs).Order("name").Find(&modelGroups).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("グループの取得に失敗しました: %w", err).Error())
+	}
+	return rebuildGroups(modelGroups)
+}
+
+func (r *GroupRepositoryImpl) CreateGroup(group *entity.Group) (*entity.Group, error) {
+	if err := r.db.Create(adapter.NewGroupAdapter().Convert(group)).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("グループ(%s)の作成に失敗しました: %w", group.Name().Value(), err).Error())
+	}
+	return group, nil
+}
+
+func (r *GroupRepositoryImpl) UpdateGroup(group *entity.Group) (*entity.Group, error) {
+	converted, ok := adapter.NewGroupAdapter().Convert(group).(*models.Group)
+	if !ok {
+		return nil, errs.NewInfraError("変換されたモデルが *models.Group ではありません。")
+	}
+	// 説明・権限の更新とロールの置き換えを同じトランザクションで行う
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Group{}).Where("obj_id = ?", converted.ObjID).Updates(map[string]any{
+			"description": converted.Description,
+			"permissions": converted.Permissions,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("更新するグループ(%s)が見つかりません", converted.ObjID)
+		}
+		if err := tx.Unscoped().Where("group_obj_id = ?", converted.ObjID).Delete(&models.GroupRole{}).Error; err != nil {
+			return err
+		}
+		if len(converted.Roles) == 0 {
+			return nil
+		}
+		return tx.Create(&converted.Roles).Error
+	})
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("グループ(%s)の更新に失敗しました: %w", converted.ObjID, err).Error())
+	}
+	return group, nil
+}
+
+func (r *GroupRepositoryImpl) DeleteGroup(groupObjID string) error {
+	// ロール・所属とグループを同じトランザクションで削除する（同じ名前で作り直せるよう物理削除する）
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("group_obj_id = ?", groupObjID).Delete(&models.GroupRole{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("group_obj_id = ?", groupObjID).Delete(&models.GroupMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("parent_obj_id = ? OR child_obj_id = ?", groupObjID, groupObjID).Delete(&models.GroupSubgroup{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("obj_id = ?", groupObjID).Delete(&models.Group{}).Error
+	})
+	if err != nil {
+		return errs.NewInfraError(fmt.Errorf("グループ(%s)の削除に失敗しました: %w", groupObjID, err).Error())
+	}
+	return nil
+}
+
+func (r *GroupRepositoryImpl) ListMembers(groupObjID string) (*repository.GroupMembers, error) {
+	var modelMembers []models.GroupMember
+	if err := r.db.Where("group_obj_id = ?", groupObjID).Order("created_at").Order("id").Find(&modelMembers).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("グループ(%s)のメンバーの取得に失敗しました: %w", groupObjID, err).Error())
+	}
+	var modelSubgroups []models.GroupSubgroup
+	if err := r.db.Where("parent_obj_id = ?", groupObjID).Order("created_at").Order("id").Find(&modelSubgroups).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("グループ(%s)の入れ子のグループの取得に失敗しました: %w", groupObjID, err).Error())
+	}
+
+	members := &repository.GroupMembers{
+		UserObjIDs:  make([]string, 0, len(modelMembers)),
+		GroupObjIDs: make([]string, 0, len(modelSubgroups)),
+	}
+	for _, member := range modelMembers {
+		members.UserObjIDs = append(members.UserObjIDs, member.UserObjID)
+	}
+	for _, subgroup := range modelSubgroups {
+		members.GroupObjIDs = append(members.GroupObjIDs, subgroup.ChildObjID)
+	}
+	return members, nil
+}
+
+func (r *GroupRepositoryImpl) AddUser(groupObjID string, userObjID string) error {
+	tx := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "group_obj_id"}, {Name: "user_obj_id"}},
+		DoNothing: true,
+	}).Create(&models.GroupMember{GroupObjID: groupObjID, UserObjID: userObjID})
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("グループ(%s)へのユーザー(%s)の追加に失敗しました: %w", groupObjID, userObjID, tx.Error).Error())
+	}
+	return nil
+}
+
+func (r *GroupRepositoryImpl) RemoveUser(groupObjID string, userObjID string) error {
+	// 同じユーザーを追加し直せるよう物理削除する
+	tx := r.db.Unscoped().Where("group_obj_id = ? AND user_obj_id = ?", groupObjID, userObjID).Delete(&models.GroupMember{})
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("グループ(%s)からのユーザー(%s)の削除に失敗しました: %w", groupObjID, userObjID, tx.Error).Error())
+	}
+	return nil
+}
+
+func (r *GroupRepositoryImpl) AddSubgroup(parentObjID string, childObjID string) error {
+	tx := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "parent_obj_id"}, {Name: "child_obj_id"}},
+		DoNothing: true,
+	}).Create(&models.GroupSubgroup{ParentObjID: parentObjID, ChildObjID: childObjID})
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("グループ(%s)への入れ子のグループ(%s)の追加に失敗しました: %w", parentObjID, childObjID, tx.Error).Error())
+	}
+	return nil
+}
+
+func (r *GroupRepositoryImpl) RemoveSubgroup(parentObjID string, childObjID string) error {
+	tx := r.db.Unscoped().Where("parent_obj_id = ? AND child_obj_id = ?", parentObjID, childObjID).Delete(&models.GroupSubgroup{})
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("グループ(%s)からの入れ子のグループ(%s)の削除に失敗しました: %w", parentObjID, childObjID, tx.Error).Error())
+	}
+	return nil
+}
+
+func (r *GroupRepositoryImpl) FindUserGroupIDs(userObjID string) ([]string, error) {
+	groupObjIDs := []string{}
+	if err := r.db.Model(&models.GroupMember{}).Where("user_obj_id = ?", userObjID).Pluck("group_obj_id", &groupObjIDs).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)のグループの取得に失敗しました: %w", userObjID, err).Error())
+	}
+	return groupObjIDs, nil
+}
+
+func (r *GroupRepositoryImpl) FindParentGroupIDs(groupObjIDs []string) ([]string, error) {
+	parentObjIDs := []string{}
+	if len(groupObjIDs) == 0 {
+		return parentObjIDs, nil
+	}
+	if err := r.db.Model(&models.GroupSubgroup{}).Where("child_obj_id IN ?", groupObjIDs).Distinct().Pluck("parent_obj_id", &parentObjIDs).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("親のグループの取得に失敗しました: %w", err).Error())
+	}
+	return parentObjIDs, nil
+}
+
+// findGroup は条件に一致するグループを取得する（存在しない場合は nil）
+func (r *GroupRepositoryImpl) findGroup(query string, arg string) (*entity.Group, error) {
+	var model models.Group
+	tx := r.db.Preload("Roles").Where(query, arg).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("グループ(%s)の取得に失敗しました: %w", arg, tx.Error).Error())
+	}
+	group, err := adapter.NewGroupAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("グループの再構築に失敗しました: %w", err).Error())
+	}
+	return group, nil
+}
+
+// rebuildGroups は永続化用モデルからグループを再構築する
+func rebuildGroups(modelGroups []models.Group) ([]*entity.Group, error) {
+	groups := make([]*entity.Group, 0, len(modelGroups))
+	for i := range modelGroups {
+		group, err := adapter.NewGroupAdapter().ReBuild(&modelGroups[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("グループの再構築に失敗しました: %w", err).Error())
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
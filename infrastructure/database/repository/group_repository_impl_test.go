@@ -0,0 +1,241 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type GroupRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	groupRepo repository.GroupRepository
+}
+
+func TestGroupRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupRepositoryImplTestSuite))
+}
+
+func (suite *GroupRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.groupRepo = NewGroupRepository(suite.DB)
+}
+
+// newGroup は指定したロール・権限を持つグループを作成する
+func (suite *GroupRepositoryImplTestSuite) newGroup(name string, roles []string, permissions ...string) *entity.Group {
+	groupName, err := value.NewGroupName(name)
+	suite.Require().NoError(err)
+	roleValues := make([]*value.UserRole, 0, len(roles))
+	for _, role := range roles {
+		r, err := value.NewUserRole(role)
+		suite.Require().NoError(err)
+		roleValues = append(roleValues, r)
+	}
+	permissionValues := make([]*value.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		p, err := value.NewPermission(permission)
+		suite.Require().NoError(err)
+		permissionValues = append(permissionValues, p)
+	}
+	group, err := entity.NewGroup(groupName, "説明", roleValues, permissionValues)
+	suite.Require().NoError(err)
+	return group
+}
+
+// createGroup はグループを作成して保存する
+func (suite *GroupRepositoryImplTestSuite) createGroup(name string, roles []string, permissions ...string) *entity.Group {
+	group, err := suite.groupRepo.CreateGroup(suite.newGroup(name, roles, permissions...))
+	suite.Require().NoError(err)
+	return group
+}
+
+func groupNames(groups []*entity.Group) []string {
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, group.Name().Value())
+	}
+	return names
+}
+
+func (suite *GroupRepositoryImplTestSuite) TestCreateAndFindGroup() {
+	created := suite.createGroup("support", []string{"support", value.RegularUser}, value.PermissionUsersRead)
+
+	found, err := suite.groupRepo.FindGroup(created.ObjID().Value())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal("support", found.Name().Value())
+	suite.Equal("説明", found.Description())
+	suite.Equal([]string{"support", value.RegularUser}, found.RoleNames())
+	suite.Equal([]string{value.PermissionUsersRead}, found.PermissionNames())
+
+	byName, err := suite.groupRepo.FindGroupByName("support")
+	suite.NoError(err)
+	suite.Require().NotNil(byName)
+	suite.Equal(created.ObjID().Value(), byName.ObjID().Value())
+
+	missing, err := suite.groupRepo.FindGroup(uuid.NewString())
+	suite.NoError(err)
+	suite.Nil(missing, "存在しない場合は nil")
+	missing, err = suite.groupRepo.FindGroupByName("missing")
+	suite.NoError(err)
+	suite.Nil(missing)
+
+	_, err = suite.groupRepo.CreateGroup(suite.newGroup("support", nil))
+	suite.Error(err, "同じ名前のグループは作成できないこと")
+}
+
+func (suite *GroupRepositoryImplTestSuite) TestListAndFindGroupsByIDs() {
+	groupRepo := NewGroupRepository(database.WithTenant(suite.DB, "list"))
+	zeta, err := groupRepo.CreateGroup(suite.newGroup("zeta", nil))
+	suite.Require().NoError(err)
+	alpha, err := groupRepo.CreateGroup(suite.newGroup("alpha", []string{value.Admin}))
+	suite.Require().NoError(err)
+	_, err = groupRepo.CreateGroup(suite.newGroup("beta", nil))
+	suite.Require().NoError(err)
+
+	groups, err := groupRepo.ListGroups()
+	suite.NoError(err)
+	suite.Equal([]string{"alpha", "beta", "zeta"}, groupNames(groups), "名前順に取得すること")
+	suite.Equal([]string{value.Admin}, groups[0].RoleNames())
+
+	found, err := groupRepo.FindGroupsByIDs([]string{zeta.ObjID().Value(), alpha.ObjID().Value(), uuid.NewString()})
+	suite.NoError(err)
+	suite.Equal([]string{"alpha", "zeta"}, groupNames(found), "存在するグループのみを名前順に取得すること")
+
+	empty, err := groupRepo.FindGroupsByIDs(nil)
+	suite.NoError(err)
+	suite.Empty(empty)
+}
+
+func (suite *GroupRepositoryImplTestSuite) TestUpdateGroup() {
+	group := suite.createGroup("update-target", []string{"support"}, value.PermissionUsersRead)
+
+	newRole, err := value.NewUserRole("auditor")
+	suite.Require().NoError(err)
+	write, err := value.NewPermission(value.PermissionUsersWrite)
+	suite.Require().NoError(err)
+	suite.Require().NoError(group.Update("新しい説明", []*value.UserRole{newRole}, []*value.Permission{write}))
+	_, err = suite.groupRepo.UpdateGroup(group)
+	suite.NoError(err)
+
+	found, err := suite.groupRepo.FindGroup(group.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal("新しい説明", found.Description())
+	suite.Equal([]string{"auditor"}, found.RoleNames(), "ロールを置き換えること")
+	suite.Equal([]string{value.PermissionUsersWrite}, found.PermissionNames())
+
+	// ロールをすべて外せること
+	suite.Require().NoError(found.Update("", nil, nil))
+	_, err = suite.groupRepo.UpdateGroup(found)
+	suite.NoError(err)
+	found, err = suite.groupRepo.FindGroup(group.ObjID().Value())
+	suite.NoError(err)
+	suite.Empty(found.RoleNames())
+
+	_, err = suite.groupRepo.UpdateGroup(suite.newGroup("not-saved", nil))
+	suite.Error(err, "保存されていないグループは更新できないこと")
+}
+
+func (suite *GroupRepositoryImplTestSuite) TestMembers() {
+	parent := suite.createGroup("members-parent", nil)
+	child := suite.createGroup("members-child", nil)
+	userObjID := uuid.NewString()
+	otherObjID := uuid.NewString()
+
+	suite.NoError(suite.groupRepo.AddUser(child.ObjID().Value(), userObjID))
+	suite.NoError(suite.groupRepo.AddUser(child.ObjID().Value(), userObjID), "所属済みの場合は何もしないこと")
+	suite.NoError(suite.groupRepo.AddUser(child.ObjID().Value(), otherObjID))
+	suite.NoError(suite.groupRepo.AddSubgroup(parent.ObjID().Value(), child.ObjID().Value()))
+	suite.NoError(suite.groupRepo.AddSubgroup(parent.ObjID().Value(), child.ObjID().Value()), "所属済みの場合は何もしないこと")
+
+	members, err := suite.groupRepo.ListMembers(child.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{userObjID, otherObjID}, members.UserObjIDs)
+	suite.Empty(members.GroupObjIDs)
+	members, err = suite.groupRepo.ListMembers(parent.ObjID().Value())
+	suite.NoError(err)
+	suite.Empty(members.UserObjIDs)
+	suite.Equal([]string{child.ObjID().Value()}, members.GroupObjIDs)
+
+	groupIDs, err := suite.groupRepo.FindUserGroupIDs(userObjID)
+	suite.NoError(err)
+	suite.Equal([]string{child.ObjID().Value()}, groupIDs)
+	parentIDs, err := suite.groupRepo.FindParentGroupIDs(groupIDs)
+	suite.NoError(err)
+	suite.Equal([]string{parent.ObjID().Value()}, parentIDs)
+	parentIDs, err = suite.groupRepo.FindParentGroupIDs(nil)
+	suite.NoError(err)
+	suite.Empty(parentIDs)
+
+	suite.NoError(suite.groupRepo.RemoveUser(child.ObjID().Value(), userObjID))
+	suite.NoError(suite.groupRepo.RemoveSubgroup(parent.ObjID().Value(), child.ObjID().Value()))
+	groupIDs, err = suite.groupRepo.FindUserGroupIDs(userObjID)
+	suite.NoError(err)
+	suite.Empty(groupIDs)
+	parentIDs, err = suite.groupRepo.FindParentGroupIDs([]string{child.ObjID().Value()})
+	suite.NoError(err)
+	suite.Empty(parentIDs)
+
+	// 外したユーザーを追加し直せること
+	suite.NoError(suite.groupRepo.AddUser(child.ObjID().Value(), userObjID))
+	groupIDs, err = suite.groupRepo.FindUserGroupIDs(userObjID)
+	suite.NoError(err)
+	suite.Equal([]string{child.ObjID().Value()}, groupIDs)
+}
+
+func (suite *GroupRepositoryImplTestSuite) TestDeleteGroup() {
+	parent := suite.createGroup("delete-parent", nil)
+	target := suite.createGroup("delete-target", []string{"support"})
+	child := suite.createGroup("delete-child", nil)
+	userObjID := uuid.NewString()
+	suite.Require().NoError(suite.groupRepo.AddUser(target.ObjID().Value(), userObjID))
+	suite.Require().NoError(suite.groupRepo.AddSubgroup(parent.ObjID().Value(), target.ObjID().Value()))
+	suite.Require().NoError(suite.groupRepo.AddSubgroup(target.ObjID().Value(), child.ObjID().Value()))
+
+	suite.NoError(suite.groupRepo.DeleteGroup(target.ObjID().Value()))
+
+	found, err := suite.groupRepo.FindGroup(target.ObjID().Value())
+	suite.NoError(err)
+	suite.Nil(found)
+	groupIDs, err := suite.groupRepo.FindUserGroupIDs(userObjID)
+	suite.NoError(err)
+	suite.Empty(groupIDs, "ユーザーの所属も削除すること")
+	members, err := suite.groupRepo.ListMembers(parent.ObjID().Value())
+	suite.NoError(err)
+	suite.Empty(members.GroupObjIDs, "親のグループへの所属も削除すること")
+	parentIDs, err := suite.groupRepo.FindParentGroupIDs([]string{child.ObjID().Value()})
+	suite.NoError(err)
+	suite.Empty(parentIDs, "入れ子のグループの所属も削除すること")
+
+	// 削除したグループと同じ名前で作り直せること
+	_, err = suite.groupRepo.CreateGroup(suite.newGroup("delete-target", nil))
+	suite.NoError(err)
+}
+
+// グループはテナントごとに定義され、他のテナントのグループは参照できないこと
+func (suite *GroupRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeRepo := NewGroupRepository(database.WithTenant(suite.DB, "acme"))
+	globexRepo := NewGroupRepository(database.WithTenant(suite.DB, "globex"))
+
+	acmeGroup, err := acmeRepo.CreateGroup(suite.newGroup("engineering", nil))
+	suite.Require().NoError(err)
+	_, err = globexRepo.CreateGroup(suite.newGroup("engineering", nil))
+	suite.NoError(err, "他のテナントと同じ名前のグループを作成できること")
+
+	found, err := globexRepo.FindGroup(acmeGroup.ObjID().Value())
+	suite.NoError(err)
+	suite.Nil(found, "他のテナントのグループは参照できないこと")
+	groups, err := globexRepo.FindGroupsByIDs([]string{acmeGroup.ObjID().Value()})
+	suite.NoError(err)
+	suite.Empty(groups)
+	byName, err := acmeRepo.FindGroupByName("engineering")
+	suite.NoError(err)
+	suite.Equal(acmeGroup.ObjID().Value(), byName.ObjID().Value())
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type InvitationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewInvitationRepository(db *gorm.DB) repository.InvitationRepository {
+	return &InvitationRepositoryImpl{db: db}
+}
+
+func (r *InvitationRepositoryImpl) CreateInvitation(invitation *entity.Invitation) (*entity.Invitation, error) {
+	if err := r.db.Create(adapter.NewInvitationAdapter().Convert(invitation)).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織(%s)への招待の作成に失敗しました: %w", invitation.OrgObjID().Value(), err).Error())
+	}
+	return invitation, nil
+}
+
+func (r *InvitationRepositoryImpl) FindInvitation(id string) (*entity.Invitation, error) {
+	var model models.OrganizationInvitation
+	tx := r.db.Where("obj_id = ?", id).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("招待(%s)の取得に失敗しました: %w", id, tx.Error).Error())
+	}
+	invitation, err := adapter.NewInvitationAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("招待の再構築に失敗しました: %w", err).Error())
+	}
+	return invitation, nil
+}
+
+func (r *InvitationRepositoryImpl) ListPendingInvitations(orgObjID string) ([]*entity.Invitation, error) {
+	var modelInvitations []models.OrganizationInvitation
+	tx := r.db.Where("org_obj_id = ? AND status = ?", orgObjID, string(entity.InvitationPending)).
+		Order("created_at DESC").Order("id DESC").
+		Find(&modelInvitations)
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織(%s)の招待の取得に失敗しました: %w", orgObjID, tx.Error).Error())
+	}
+	invitations := make([]*entity.Invitation, 0, len(modelInvitations))
+	for i := range modelInvitations {
+		invitation, err := adapter.NewInvitationAdapter().ReBuild(&modelInvitations[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("招待の再構築に失敗しました: %w", err).Error())
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations, nil
+}
+
+func (r *InvitationRepositoryImpl) UpdateInvitation(invitation *entity.Invitation) (*entity.Invitation, error) {
+	// 状態のみ更新する（宛先やロール、期限は作成後に変わらない）
+	tx := r.db.Model(&models.OrganizationInvitation{}).Where("obj_id = ?", invitation.ID()).Updates(map[string]any{
+		"status":       string(invitation.Status()),
+		"responded_at": invitation.RespondedAt(),
+	})
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("招待(%s)の更新に失敗しました: %w", invitation.ID(), tx.Error).Error())
+	}
+	if tx.RowsAffected == 0 {
+		return nil, errs.NewInfraError(fmt.Sprintf("更新する招待(%s)が見つかりません。", invitation.ID()))
+	}
+	return invitation, nil
+}
+
+func (r *InvitationRepositoryImpl) AcceptInvitation(invitation *entity.Invitation, membership *entity.Membership) (bool, error) {
+	accepted := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 回答待ちの場合のみ更新し、同時に承諾されたときに二重にメンバーを追加しない
+		result := tx.Model(&models.OrganizationInvitation{}).
+			Where("obj_id = ? AND status = ?", invitation.ID(), string(entity.InvitationPending)).
+			Updates(map[string]any{
+				"status":       string(invitation.Status()),
+				"responded_at": invitation.RespondedAt(),
+			})
+		if result.Error != nil {
+			return errs.NewInfraError(fmt.Errorf("招待(%s)の更新に失敗しました: %w", invitation.ID(), result.Error).Error())
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if err := tx.Create(adapter.NewMembershipAdapter().Convert(membership)).Error; err != nil {
+			return errs.NewInfraError(fmt.Errorf("組織(%s)のメンバーシップの作成に失敗しました: %w", membership.OrgObjID().Value(), err).Error())
+		}
+		accepted = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return accepted, nil
+}
@@ -0,0 +1,159 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type InvitationRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	invitationRepo repository.InvitationRepository
+	orgRepo        repository.OrganizationRepository
+}
+
+func TestInvitationRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(InvitationRepositoryImplTestSuite))
+}
+
+func (suite *InvitationRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.invitationRepo = NewInvitationRepository(suite.DB)
+	suite.orgRepo = NewOrganizationRepository(suite.DB)
+}
+
+// newInvitation はテスト用の招待を生成する
+func (suite *InvitationRepositoryImplTestSuite) newInvitation(orgObjID *value.OrgObjID, address string) *entity.Invitation {
+	email, err := userValue.NewUserEmail(address)
+	suite.Require().NoError(err)
+	role, err := value.NewMemberRole(value.MemberRoleAdmin)
+	suite.Require().NoError(err)
+	inviter, err := userValue.NewUserObjID(uuid.NewString())
+	suite.Require().NoError(err)
+	invitation, err := entity.NewInvitation(orgObjID, email, role, inviter, time.Now().Add(time.Hour))
+	suite.Require().NoError(err)
+	return invitation
+}
+
+// newOrgObjID はテスト用の組織IDを生成する
+func (suite *InvitationRepositoryImplTestSuite) newOrgObjID() *value.OrgObjID {
+	objID, err := value.NewOrgObjID(uuid.NewString())
+	suite.Require().NoError(err)
+	return objID
+}
+
+func (suite *InvitationRepositoryImplTestSuite) TestCreateAndFindInvitation() {
+	invitation := suite.newInvitation(suite.newOrgObjID(), "invitee@example.com")
+	_, err := suite.invitationRepo.CreateInvitation(invitation)
+	suite.Require().NoError(err)
+
+	found, err := suite.invitationRepo.FindInvitation(invitation.ID())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal(invitation.OrgObjID().Value(), found.OrgObjID().Value())
+	suite.Equal("invitee@example.com", found.Email().Value())
+	suite.Equal(value.MemberRoleAdmin, found.Role().Value())
+	suite.Equal(invitation.InviterObjID().Value(), found.InviterObjID().Value())
+	suite.Equal(entity.InvitationPending, found.Status())
+	suite.Nil(found.RespondedAt())
+
+	// 存在しない招待は nil を返すこと
+	missing, err := suite.invitationRepo.FindInvitation(uuid.NewString())
+	suite.NoError(err)
+	suite.Nil(missing)
+}
+
+func (suite *InvitationRepositoryImplTestSuite) TestUpdateAndListPendingInvitations() {
+	orgObjID := suite.newOrgObjID()
+	accepted := suite.newInvitation(orgObjID, "accepted@example.com")
+	pending := suite.newInvitation(orgObjID, "pending@example.com")
+	for _, invitation := range []*entity.Invitation{accepted, pending, suite.newInvitation(suite.newOrgObjID(), "other@example.com")} {
+		_, err := suite.invitationRepo.CreateInvitation(invitation)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(accepted.Accept(time.Now()))
+	_, err := suite.invitationRepo.UpdateInvitation(accepted)
+	suite.NoError(err)
+
+	found, err := suite.invitationRepo.FindInvitation(accepted.ID())
+	suite.NoError(err)
+	suite.Equal(entity.InvitationAccepted, found.Status())
+	suite.NotNil(found.RespondedAt())
+
+	invitations, err := suite.invitationRepo.ListPendingInvitations(orgObjID.Value())
+	suite.NoError(err)
+	suite.Require().Len(invitations, 1, "組織の回答待ちの招待のみを返すこと")
+	suite.Equal(pending.ID(), invitations[0].ID())
+
+	// 存在しない招待は更新できないこと
+	_, err = suite.invitationRepo.UpdateInvitation(suite.newInvitation(orgObjID, "missing@example.com"))
+	suite.Error(err)
+}
+
+// newMembership は招待されたロールでのテスト用メンバーシップを生成する
+func (suite *InvitationRepositoryImplTestSuite) newMembership(invitation *entity.Invitation, userObjID *userValue.UserObjID) *entity.Membership {
+	membership, err := entity.NewMembership(invitation.OrgObjID(), userObjID, invitation.Role())
+	suite.Require().NoError(err)
+	return membership
+}
+
+// 承諾すると招待の状態とメンバーの追加が同時に反映され、二度目の承諾は何もしないこと
+func (suite *InvitationRepositoryImplTestSuite) TestAcceptInvitation() {
+	invitation := suite.newInvitation(suite.newOrgObjID(), "invitee@example.com")
+	_, err := suite.invitationRepo.CreateInvitation(invitation)
+	suite.Require().NoError(err)
+	userObjID, err := userValue.NewUserObjID(uuid.NewString())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(invitation.Accept(time.Now()))
+	accepted, err := suite.invitationRepo.AcceptInvitation(invitation, suite.newMembership(invitation, userObjID))
+	suite.NoError(err)
+	suite.True(accepted)
+
+	found, err := suite.invitationRepo.FindInvitation(invitation.ID())
+	suite.NoError(err)
+	suite.Equal(entity.InvitationAccepted, found.Status())
+	membership, err := suite.orgRepo.FindMembership(invitation.OrgObjID().Value(), userObjID.Value())
+	suite.NoError(err)
+	suite.NotNil(membership)
+
+	// 同時に承諾された場合を想定し、別のユーザーで再度承諾してもメンバーは追加されないこと
+	otherObjID, err := userValue.NewUserObjID(uuid.NewString())
+	suite.Require().NoError(err)
+	accepted, err = suite.invitationRepo.AcceptInvitation(invitation, suite.newMembership(invitation, otherObjID))
+	suite.NoError(err)
+	suite.False(accepted)
+	membership, err = suite.orgRepo.FindMembership(invitation.OrgObjID().Value(), otherObjID.Value())
+	suite.NoError(err)
+	suite.Nil(membership)
+}
+
+// メンバーの追加に失敗した場合は招待の状態も更新されないこと
+func (suite *InvitationRepositoryImplTestSuite) TestAcceptInvitation_RollsBack() {
+	invitation := suite.newInvitation(suite.newOrgObjID(), "invitee@example.com")
+	_, err := suite.invitationRepo.CreateInvitation(invitation)
+	suite.Require().NoError(err)
+	userObjID, err := userValue.NewUserObjID(uuid.NewString())
+	suite.Require().NoError(err)
+	_, err = suite.orgRepo.CreateMembership(suite.newMembership(invitation, userObjID))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(invitation.Accept(time.Now()))
+	accepted, err := suite.invitationRepo.AcceptInvitation(invitation, suite.newMembership(invitation, userObjID))
+	suite.Error(err, "同じユーザーのメンバーシップは重複して作成できない")
+	suite.False(accepted)
+
+	found, err := suite.invitationRepo.FindInvitation(invitation.ID())
+	suite.NoError(err)
+	suite.Equal(entity.InvitationPending, found.Status(), "招待は回答待ちのままであること")
+}
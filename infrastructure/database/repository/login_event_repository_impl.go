@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type LoginEventRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLoginEventRepository(db *gorm.DB) repository.LoginEventRepository {
+	return &LoginEventRepositoryImpl{db: db}
+}
+
+func (r *LoginEventRepositoryImpl) SaveLoginEvent(loginEvent *entity.LoginEvent) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(adapter.NewLoginEventAdapter().Convert(loginEvent)).Error; err != nil {
+			return err
+		}
+		if !loginEvent.IsSucceeded() {
+			return nil
+		}
+		// ユーザー全体を保存し直さないよう、最終ログイン日時のみを更新する（ユーザー更新のイベントも記録しない）
+		if err := tx.Model(&models.User{}).Where("obj_id = ?", loginEvent.UserObjID().Value()).Update("last_login_at", loginEvent.OccurredAt()).Error; err != nil {
+			return err
+		}
+		if !loginEvent.IsNewDevice() {
+			return nil
+		}
+		return appendOutboxEvent(tx, event.TypeUserNewDeviceLogin, loginEvent.UserObjID().Value(), event.LoginPayload{
+			UID:          loginEvent.UserObjID().Value(),
+			LoginEventID: loginEvent.ID(),
+			IPAddress:    loginEvent.IPAddress(),
+			UserAgent:    loginEvent.UserAgent(),
+			Device:       loginEvent.Fingerprint().UAFamily(),
+			OccurredAt:   loginEvent.OccurredAt(),
+		})
+	})
+	if err != nil {
+		return errs.NewInfraError(fmt.Errorf("ユーザー(%s)のログインの記録に失敗しました: %w", loginEvent.UserObjID().Value(), err).Error())
+	}
+	return nil
+}
+
+func (r *LoginEventRepositoryImpl) ListSucceededLoginEvents(userObjID string, since time.Time, limit int) ([]*entity.LoginEvent, error) {
+	var records []models.LoginEvent
+	tx := r.db.Where("user_obj_id = ? AND result = ? AND occurred_at >= ?", userObjID, string(entity.LoginSucceeded), since).
+		Order("occurred_at DESC, id DESC").Limit(limit).Find(&records)
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)の成功したログインの記録の取得に失敗しました: %w", userObjID, tx.Error).Error())
+	}
+	return r.rebuild(records)
+}
+
+func (r *LoginEventRepositoryImpl) ListLoginEvents(userObjID string, offset int, limit int) ([]*entity.LoginEvent, int64, error) {
+	query := r.db.Model(&models.LoginEvent{}).Where("user_obj_id = ?", userObjID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errs.NewInfraError(fmt.Errorf("ユーザー(%s)のログインの記録の件数の取得に失敗しました: %w", userObjID, err).Error())
+	}
+
+	var records []models.LoginEvent
+	if err := query.Order("occurred_at DESC, id DESC").Offset(offset).Limit(limit).Find(&records).Error; err != nil {
+		return nil, 0, errs.NewInfraError(fmt.Errorf("ユーザー(%s)のログインの記録の取得に失敗しました: %w", userObjID, err).Error())
+	}
+
+	events, err := r.rebuild(records)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+// rebuild は永続化用モデルからログインの記録を再構築する
+func (r *LoginEventRepositoryImpl) rebuild(records []models.LoginEvent) ([]*entity.LoginEvent, error) {
+	events := make([]*entity.LoginEvent, 0, len(records))
+	for i := range records {
+		loginEvent, err := adapter.NewLoginEventAdapter().ReBuild(&records[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("ログインの記録の再構築に失敗しました: %w", err).Error())
+		}
+		events = append(events, loginEvent)
+	}
+	return events, nil
+}
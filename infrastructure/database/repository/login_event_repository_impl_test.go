@@ -0,0 +1,160 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type LoginEventRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	loginEventRepo repository.LoginEventRepository
+	userRepo       repository.UserRepository
+}
+
+func TestLoginEventRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(LoginEventRepositoryImplTestSuite))
+}
+
+func (suite *LoginEventRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.loginEventRepo = NewLoginEventRepository(suite.DB)
+	suite.userRepo = NewUserRepository(suite.DB, value.EmailKeyRules{})
+}
+
+// createUser はテスト用のユーザーを登録する
+func (suite *LoginEventRepositoryImplTestSuite) createUser(address string) *entity.User {
+	email, err := value.NewUserEmail(address)
+	suite.NoError(err)
+	username, err := value.NewUserUsername("loginuser")
+	suite.NoError(err)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	created, err := suite.userRepo.CreateUser(user)
+	suite.Require().NoError(err)
+	return created
+}
+
+func (suite *LoginEventRepositoryImplTestSuite) saveEvent(objID *value.UserObjID, result entity.LoginEventResult, reason string) *entity.LoginEvent {
+	loginEvent, err := entity.NewLoginEvent(objID, result, reason, []string{"pwd"}, "192.0.2.1", "Mozilla/5.0")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.loginEventRepo.SaveLoginEvent(loginEvent), "ログインの記録に失敗してはいけない")
+	return loginEvent
+}
+
+func (suite *LoginEventRepositoryImplTestSuite) TestSaveLoginEvent_UpdatesLastLoginAt() {
+	user := suite.createUser("lastlogin@example.com")
+
+	// 失敗では最終ログイン日時を更新しない
+	suite.saveEvent(user.ObjID(), entity.LoginFailed, entity.LoginReasonInvalidCredentials)
+	found, err := suite.userRepo.GetUserByObjID(user.ObjID().Value())
+	suite.Require().NoError(err)
+	suite.Nil(found.LastLoginAt())
+
+	loginEvent := suite.saveEvent(user.ObjID(), entity.LoginSucceeded, "")
+	found, err = suite.userRepo.GetUserByObjID(user.ObjID().Value())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(found.LastLoginAt())
+	suite.WithinDuration(loginEvent.OccurredAt(), found.LastLoginAt().Value(), time.Second)
+}
+
+func (suite *LoginEventRepositoryImplTestSuite) TestListLoginEvents() {
+	user := suite.createUser("history@example.com")
+	first := suite.saveEvent(user.ObjID(), entity.LoginFailed, entity.LoginReasonInvalidCredentials)
+	second := suite.saveEvent(user.ObjID(), entity.LoginSucceeded, "")
+	third := suite.saveEvent(user.ObjID(), entity.LoginSucceeded, "")
+	suite.saveEvent(suite.createUser("other-history@example.com").ObjID(), entity.LoginSucceeded, "")
+
+	events, total, err := suite.loginEventRepo.ListLoginEvents(user.ObjID().Value(), 0, 2)
+	suite.Require().NoError(err)
+	suite.Equal(int64(3), total, "他のユーザーの記録を含まないこと")
+	suite.Require().Len(events, 2)
+	suite.Equal(third.ID(), events[0].ID(), "新しい順に取得されること")
+	suite.Equal(second.ID(), events[1].ID())
+
+	events, _, err = suite.loginEventRepo.ListLoginEvents(user.ObjID().Value(), 2, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal(first.ID(), events[0].ID())
+	suite.Equal(entity.LoginFailed, events[0].Result())
+	suite.Equal(entity.LoginReasonInvalidCredentials, events[0].Reason())
+	suite.Equal([]string{"pwd"}, events[0].Methods())
+	suite.Equal("192.0.2.1", events[0].IPAddress())
+	suite.Equal("Mozilla/5.0", events[0].UserAgent())
+}
+
+func (suite *LoginEventRepositoryImplTestSuite) TestSaveLoginEvent_NewDeviceAppendsOutboxEvent() {
+	user := suite.createUser("newdevice@example.com")
+	suite.saveEvent(user.ObjID(), entity.LoginSucceeded, "")
+
+	loginEvent, err := entity.NewLoginEvent(user.ObjID(), entity.LoginSucceeded, "", []string{"pwd"}, "198.51.100.7", "curl/8.4.0")
+	suite.Require().NoError(err)
+	suite.Require().NoError(loginEvent.MarkNewDevice())
+	suite.Require().NoError(suite.loginEventRepo.SaveLoginEvent(loginEvent))
+
+	// 新しい端末からのログインのみ通知のためのイベントを記録すること
+	var events []models.OutboxEvent
+	suite.NoError(suite.DB.Where("aggregate_id = ? AND event_type = ?", user.ObjID().Value(), string(event.TypeUserNewDeviceLogin)).Find(&events).Error)
+	suite.Require().Len(events, 1)
+	suite.Contains(events[0].Payload, loginEvent.ID())
+	suite.Contains(events[0].Payload, "198.51.100.7")
+
+	saved, _, err := suite.loginEventRepo.ListLoginEvents(user.ObjID().Value(), 0, 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(saved, 1)
+	suite.True(saved[0].IsNewDevice())
+}
+
+func (suite *LoginEventRepositoryImplTestSuite) TestListSucceededLoginEvents() {
+	user := suite.createUser("succeeded@example.com")
+	suite.saveEvent(user.ObjID(), entity.LoginFailed, entity.LoginReasonInvalidCredentials)
+	first := suite.saveEvent(user.ObjID(), entity.LoginSucceeded, "")
+	second := suite.saveEvent(user.ObjID(), entity.LoginSucceeded, "")
+
+	events, err := suite.loginEventRepo.ListSucceededLoginEvents(user.ObjID().Value(), time.Now().Add(-time.Hour), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 2, "失敗した記録を含まないこと")
+	suite.Equal(second.ID(), events[0].ID())
+	suite.Equal(first.ID(), events[1].ID())
+
+	events, err = suite.loginEventRepo.ListSucceededLoginEvents(user.ObjID().Value(), time.Now().Add(-time.Hour), 1)
+	suite.Require().NoError(err)
+	suite.Len(events, 1)
+
+	events, err = suite.loginEventRepo.ListSucceededLoginEvents(user.ObjID().Value(), time.Now().Add(time.Hour), 10)
+	suite.Require().NoError(err)
+	suite.Empty(events, "since より前の記録を含まないこと")
+}
+
+// ログイン履歴は記録したテナントでのみ取得できること
+func (suite *LoginEventRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeRepo := NewLoginEventRepository(database.WithTenant(suite.DB, "acme"))
+	globexRepo := NewLoginEventRepository(database.WithTenant(suite.DB, "globex"))
+	user := suite.createUser("tenant.login@example.com")
+	loginEvent, err := entity.NewLoginEvent(user.ObjID(), entity.LoginSucceeded, "", []string{"pwd"}, "192.0.2.1", "Mozilla/5.0")
+	suite.Require().NoError(err)
+	suite.Require().NoError(acmeRepo.SaveLoginEvent(loginEvent))
+
+	events, total, err := globexRepo.ListLoginEvents(user.ObjID().Value(), 0, 10)
+	suite.NoError(err)
+	suite.Empty(events)
+	suite.Zero(total)
+	events, err = globexRepo.ListSucceededLoginEvents(user.ObjID().Value(), time.Now().Add(-time.Hour), 10)
+	suite.NoError(err)
+	suite.Empty(events)
+
+	events, total, err = acmeRepo.ListLoginEvents(user.ObjID().Value(), 0, 10)
+	suite.NoError(err)
+	suite.Len(events, 1)
+	suite.Equal(int64(1), total)
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type LoginFailureRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLoginFailureRepository(db *gorm.DB) repository.LoginFailureRepository {
+	return &LoginFailureRepositoryImpl{db: db}
+}
+
+func (r *LoginFailureRepositoryImpl) FindFailure(scope string, key string) (*entity.LoginFailure, error) {
+	var model models.LoginFailure
+	tx := r.db.Where("scope = ? AND identifier = ?", scope, key).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ログイン失敗の記録(%s:%s)の取得に失敗しました: %w", scope, key, tx.Error).Error())
+	}
+	failure, err := adapter.NewLoginFailureAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ログイン失敗の記録の再構築に失敗しました: %w", err).Error())
+	}
+	return failure, nil
+}
+
+func (r *LoginFailureRepositoryImpl) RecordFailure(scope string, key string, failedAt time.Time, window time.Duration) (*entity.LoginFailure, error) {
+	// 加算は 1 つの UPSERT で行い、複数のインスタンスからの同時リクエストでも失敗回数を取りこぼさない
+	staleBefore := failedAt.Add(-window)
+	tx := r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "scope"}, {Name: "identifier"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"failures":       gorm.Expr("CASE WHEN login_failures.last_failed_at < ? THEN 1 ELSE login_failures.failures + 1 END", staleBefore),
+			"locked_until":   gorm.Expr("CASE WHEN login_failures.last_failed_at < ? THEN NULL ELSE login_failures.locked_until END", staleBefore),
+			"last_failed_at": failedAt,
+			"updated_at":     failedAt,
+		}),
+	}).Create(&models.LoginFailure{
+		Scope:        scope,
+		Identifier:   key,
+		Failures:     1,
+		LastFailedAt: failedAt,
+	})
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ログイン失敗(%s:%s)の記録に失敗しました: %w", scope, key, tx.Error).Error())
+	}
+
+	failure, err := r.FindFailure(scope, key)
+	if err != nil {
+		return nil, err
+	}
+	if failure == nil {
+		return nil, errs.NewInfraError(fmt.Sprintf("記録したログイン失敗(%s:%s)が見つかりません", scope, key))
+	}
+	return failure, nil
+}
+
+func (r *LoginFailureRepositoryImpl) LockFailure(scope string, key string, until time.Time) error {
+	tx := r.db.Model(&models.LoginFailure{}).
+		Where("scope = ? AND identifier = ?", scope, key).
+		Update("locked_until", until)
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("ログイン失敗の記録(%s:%s)のロックに失敗しました: %w", scope, key, tx.Error).Error())
+	}
+	return nil
+}
+
+func (r *LoginFailureRepositoryImpl) ResetFailures(scope string, key string) error {
+	tx := r.db.Model(&models.LoginFailure{}).
+		Where("scope = ? AND identifier = ?", scope, key).
+		Updates(map[string]any{"failures": 0, "locked_until": nil})
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("ログイン失敗の記録(%s:%s)のリセットに失敗しました: %w", scope, key, tx.Error).Error())
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type LoginFailureRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	loginFailureRepo repository.LoginFailureRepository
+}
+
+func TestLoginFailureRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(LoginFailureRepositoryImplTestSuite))
+}
+
+func (suite *LoginFailureRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.loginFailureRepo = NewLoginFailureRepository(suite.DB)
+}
+
+func (suite *LoginFailureRepositoryImplTestSuite) TestRecordFailure() {
+	now := time.Now()
+	for i := 1; i <= 3; i++ {
+		failure, err := suite.loginFailureRepo.RecordFailure(entity.LoginFailureScopeAccount, "record@example.com", now, time.Hour)
+		suite.Require().NoError(err)
+		suite.Equal(i, failure.Failures(), "失敗のたびに加算されること")
+	}
+
+	// 単位が異なる記録とは区別すること
+	failure, err := suite.loginFailureRepo.RecordFailure(entity.LoginFailureScopeIP, "record@example.com", now, time.Hour)
+	suite.Require().NoError(err)
+	suite.Equal(1, failure.Failures())
+
+	found, err := suite.loginFailureRepo.FindFailure(entity.LoginFailureScopeAccount, "record@example.com")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal(3, found.Failures())
+
+	found, err = suite.loginFailureRepo.FindFailure(entity.LoginFailureScopeAccount, "unknown@example.com")
+	suite.NoError(err, "存在しない場合はエラーにしないこと")
+	suite.Nil(found)
+}
+
+func (suite *LoginFailureRepositoryImplTestSuite) TestRecordFailure_WindowExpired() {
+	key := "window@example.com"
+	old := time.Now().Add(-2 * time.Hour)
+	_, err := suite.loginFailureRepo.RecordFailure(entity.LoginFailureScopeAccount, key, old, time.Hour)
+	suite.Require().NoError(err)
+	_, err = suite.loginFailureRepo.RecordFailure(entity.LoginFailureScopeAccount, key, old, time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.loginFailureRepo.LockFailure(entity.LoginFailureScopeAccount, key, old.Add(time.Minute)))
+
+	// 最後の失敗から window 以上経過している場合は 1 からやり直し、ロックも解除されること
+	failure, err := suite.loginFailureRepo.RecordFailure(entity.LoginFailureScopeAccount, key, time.Now(), time.Hour)
+	suite.Require().NoError(err)
+	suite.Equal(1, failure.Failures())
+	suite.Nil(failure.LockedUntil())
+}
+
+func (suite *LoginFailureRepositoryImplTestSuite) TestLockAndResetFailures() {
+	key := "lock@example.com"
+	now := time.Now()
+	_, err := suite.loginFailureRepo.RecordFailure(entity.LoginFailureScopeAccount, key, now, time.Hour)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.loginFailureRepo.LockFailure(entity.LoginFailureScopeAccount, key, now.Add(time.Hour)))
+	found, err := suite.loginFailureRepo.FindFailure(entity.LoginFailureScopeAccount, key)
+	suite.Require().NoError(err)
+	suite.True(found.IsLocked(now))
+
+	// ロック中に失敗を記録してもロックは維持されること
+	found, err = suite.loginFailureRepo.RecordFailure(entity.LoginFailureScopeAccount, key, now, time.Hour)
+	suite.Require().NoError(err)
+	suite.True(found.IsLocked(now))
+
+	suite.Require().NoError(suite.loginFailureRepo.ResetFailures(entity.LoginFailureScopeAccount, key))
+	found, err = suite.loginFailureRepo.FindFailure(entity.LoginFailureScopeAccount, key)
+	suite.Require().NoError(err)
+	suite.Equal(0, found.Failures())
+	suite.False(found.IsLocked(now))
+
+	// 記録がない場合のリセットはエラーにしないこと
+	suite.NoError(suite.loginFailureRepo.ResetFailures(entity.LoginFailureScopeAccount, "none@example.com"))
+}
+
+// 同じメールアドレスでもテナントが異なれば、失敗回数とロックは別に数えること
+func (suite *LoginFailureRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeRepo := NewLoginFailureRepository(database.WithTenant(suite.DB, "acme"))
+	globexRepo := NewLoginFailureRepository(database.WithTenant(suite.DB, "globex"))
+	key := "tenant@example.com"
+	now := time.Now()
+
+	for i := 1; i <= 2; i++ {
+		failure, err := acmeRepo.RecordFailure(entity.LoginFailureScopeAccount, key, now, time.Hour)
+		suite.Require().NoError(err)
+		suite.Equal(i, failure.Failures())
+	}
+	suite.Require().NoError(acmeRepo.LockFailure(entity.LoginFailureScopeAccount, key, now.Add(time.Hour)))
+
+	found, err := globexRepo.FindFailure(entity.LoginFailureScopeAccount, key)
+	suite.NoError(err)
+	suite.Nil(found, "他のテナントの失敗は見えないこと")
+	failure, err := globexRepo.RecordFailure(entity.LoginFailureScopeAccount, key, now, time.Hour)
+	suite.Require().NoError(err)
+	suite.Equal(1, failure.Failures())
+	suite.False(failure.IsLocked(now), "他のテナントのロックは影響しないこと")
+
+	suite.NoError(globexRepo.ResetFailures(entity.LoginFailureScopeAccount, key))
+	found, err = acmeRepo.FindFailure(entity.LoginFailureScopeAccount, key)
+	suite.NoError(err)
+	suite.Require().NotNil(found, "他のテナントの失敗はリセットしないこと")
+	suite.Equal(2, found.Failures())
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type MFARepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMFARepository(db *gorm.DB) repository.MFARepository {
+	return &MFARepositoryImpl{db: db}
+}
+
+func (r *MFARepositoryImpl) SaveTOTPFactor(factor *entity.TOTPFactor) (*entity.TOTPFactor, error) {
+	model, ok := adapter.NewTOTPFactorAdapter().Convert(factor).(*models.TOTPFactor)
+	if !ok {
+		return nil, errs.NewInfraError("変換されたモデルが *models.TOTPFactor ではありません。")
+	}
+
+	// シークレットは平文で保存しない（別ユーザーのレコードへの付け替えを防ぐため、ユーザーIDを関連データにする）
+	encrypted, err := utils.EncryptString(model.Secret, model.UserObjID)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("TOTP シークレットの暗号化に失敗しました: %w", err).Error())
+	}
+	model.Secret = encrypted
+
+	tx := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_obj_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret", "confirmed_at", "last_used_step", "updated_at"}),
+	}).Create(model)
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("TOTP の保存に失敗しました: %w", tx.Error).Error())
+	}
+	return factor, nil
+}
+
+func (r *MFARepositoryImpl) FindTOTPFactor(userObjID string) (*entity.TOTPFactor, error) {
+	var model models.TOTPFactor
+	tx := r.db.Where("user_obj_id = ?", userObjID).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)の TOTP 取得に失敗しました: %w", userObjID, tx.Error).Error())
+	}
+
+	secret, err := utils.DecryptString(model.Secret, model.UserObjID)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("TOTP シークレットの復号に失敗しました: %w", err).Error())
+	}
+	model.Secret = secret
+
+	factor, err := adapter.NewTOTPFactorAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("TOTP の再構築に失敗しました: %w", err).Error())
+	}
+	return factor, nil
+}
+
+func (r *MFARepositoryImpl) ConsumeTOTPStep(userObjID string, step int64) (bool, error) {
+	// 条件付き更新にすることで、同じコードによる同時リクエストも一方のみ成功させる
+	tx := r.db.Model(&models.TOTPFactor{}).
+		Where("user_obj_id = ? AND confirmed_at IS NOT NULL AND last_used_step < ?", userObjID, step).
+		Update("last_used_step", step)
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("ユーザー(%s)の TOTP 使用記録に失敗しました: %w", userObjID, tx.Error).Error())
+	}
+	return tx.RowsAffected == 1, nil
+}
+
+func (r *MFARepositoryImpl) ReplaceRecoveryCodes(userObjID string, codes []*entity.RecoveryCode) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("user_obj_id = ?", userObjID).Delete(&models.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		for _, code := range codes {
+			if err := tx.Create(adapter.NewRecoveryCodeAdapter().Convert(code)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.NewInfraError(fmt.Errorf("ユーザー(%s)のリカバリーコードの保存に失敗しました: %w", userObjID, err).Error())
+	}
+	return nil
+}
+
+func (r *MFARepositoryImpl) UseRecoveryCode(userObjID string, codeHash string) (bool, error) {
+	tx := r.db.Model(&models.RecoveryCode{}).
+		Where("user_obj_id = ? AND code_hash = ? AND used_at IS NULL", userObjID, codeHash).
+		Update("used_at", time.Now())
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("ユーザー(%s)のリカバリーコードの使用に失敗しました: %w", userObjID, tx.Error).Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
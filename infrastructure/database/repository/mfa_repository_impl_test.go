@@ -0,0 +1,156 @@
+package repository_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type MFARepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	mfaRepo repository.MFARepository
+}
+
+func TestMFARepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(MFARepositoryImplTestSuite))
+}
+
+func (suite *MFARepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.Require().NoError(os.Setenv("DATA_ENCRYPTION_KEY", "test-encryption-key"))
+	suite.mfaRepo = NewMFARepository(suite.DB)
+}
+
+func (suite *MFARepositoryImplTestSuite) TearDownSuite() {
+	suite.Require().NoError(os.Unsetenv("DATA_ENCRYPTION_KEY"))
+	suite.DBSQLiteSuite.TearDownSuite()
+}
+
+// newUserObjID はテスト用のユーザーIDを生成する
+func (suite *MFARepositoryImplTestSuite) newUserObjID() *value.UserObjID {
+	email, err := value.NewUserEmail("mfa@example.com")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("mfauser")
+	suite.NoError(err)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	return user.ObjID()
+}
+
+func (suite *MFARepositoryImplTestSuite) TestSaveAndFindTOTPFactor() {
+	objID := suite.newUserObjID()
+	factor, err := entity.NewTOTPFactor(objID, "JBSWY3DPEHPK3PXP")
+	suite.NoError(err)
+
+	_, err = suite.mfaRepo.SaveTOTPFactor(factor)
+	suite.NoError(err, "TOTP の保存に失敗してはいけない")
+
+	// シークレットは暗号化して保存されていること
+	var model models.TOTPFactor
+	suite.NoError(suite.DB.Where("user_obj_id = ?", objID.Value()).First(&model).Error)
+	suite.NotContains(model.Secret, "JBSWY3DPEHPK3PXP")
+
+	found, err := suite.mfaRepo.FindTOTPFactor(objID.Value())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal("JBSWY3DPEHPK3PXP", found.Secret(), "復号したシークレットが取得できること")
+	suite.False(found.IsConfirmed())
+
+	// 再登録で置き換えられること
+	suite.NoError(found.Confirm(10, time.Now()))
+	_, err = suite.mfaRepo.SaveTOTPFactor(found)
+	suite.NoError(err)
+	found, err = suite.mfaRepo.FindTOTPFactor(objID.Value())
+	suite.NoError(err)
+	suite.True(found.IsConfirmed())
+	suite.Equal(int64(10), found.LastUsedStep())
+}
+
+func (suite *MFARepositoryImplTestSuite) TestFindTOTPFactor_NotFound() {
+	found, err := suite.mfaRepo.FindTOTPFactor(suite.newUserObjID().Value())
+	suite.NoError(err, "未登録の場合はエラーにならないこと")
+	suite.Nil(found)
+}
+
+func (suite *MFARepositoryImplTestSuite) TestFindTOTPFactor_Tampered() {
+	objID := suite.newUserObjID()
+	other := suite.newUserObjID()
+	factor, _ := entity.NewTOTPFactor(objID, "JBSWY3DPEHPK3PXP")
+	_, err := suite.mfaRepo.SaveTOTPFactor(factor)
+	suite.NoError(err)
+
+	// 暗号文を別ユーザーのレコードに付け替えても復号できないこと
+	var model models.TOTPFactor
+	suite.NoError(suite.DB.Where("user_obj_id = ?", objID.Value()).First(&model).Error)
+	suite.NoError(suite.DB.Create(&models.TOTPFactor{UserObjID: other.Value(), Secret: model.Secret}).Error)
+
+	_, err = suite.mfaRepo.FindTOTPFactor(other.Value())
+	suite.Error(err)
+}
+
+func (suite *MFARepositoryImplTestSuite) TestConsumeTOTPStep() {
+	objID := suite.newUserObjID()
+	factor, _ := entity.NewTOTPFactor(objID, "JBSWY3DPEHPK3PXP")
+	_, err := suite.mfaRepo.SaveTOTPFactor(factor)
+	suite.NoError(err)
+
+	// 確認前の TOTP では使用できないこと
+	ok, err := suite.mfaRepo.ConsumeTOTPStep(objID.Value(), 100)
+	suite.NoError(err)
+	suite.False(ok)
+
+	suite.NoError(factor.Confirm(100, time.Now()))
+	_, err = suite.mfaRepo.SaveTOTPFactor(factor)
+	suite.NoError(err)
+
+	ok, err = suite.mfaRepo.ConsumeTOTPStep(objID.Value(), 100)
+	suite.NoError(err)
+	suite.False(ok, "確認に使ったステップは再利用できないこと")
+
+	ok, err = suite.mfaRepo.ConsumeTOTPStep(objID.Value(), 101)
+	suite.NoError(err)
+	suite.True(ok)
+
+	ok, err = suite.mfaRepo.ConsumeTOTPStep(objID.Value(), 101)
+	suite.NoError(err)
+	suite.False(ok, "同じステップは一度しか使えないこと")
+}
+
+func (suite *MFARepositoryImplTestSuite) TestRecoveryCodes() {
+	objID := suite.newUserObjID()
+	first, _ := entity.NewRecoveryCode(objID, "aaaaa-11111")
+	second, _ := entity.NewRecoveryCode(objID, "bbbbb-22222")
+	suite.NoError(suite.mfaRepo.ReplaceRecoveryCodes(objID.Value(), []*entity.RecoveryCode{first, second}))
+
+	ok, err := suite.mfaRepo.UseRecoveryCode(objID.Value(), entity.HashRecoveryCode("aaaaa-11111"))
+	suite.NoError(err)
+	suite.True(ok)
+
+	ok, err = suite.mfaRepo.UseRecoveryCode(objID.Value(), entity.HashRecoveryCode("aaaaa-11111"))
+	suite.NoError(err)
+	suite.False(ok, "使用済みのコードは再利用できないこと")
+
+	// 別ユーザーのコードとしては使えないこと
+	ok, err = suite.mfaRepo.UseRecoveryCode(suite.newUserObjID().Value(), entity.HashRecoveryCode("bbbbb-22222"))
+	suite.NoError(err)
+	suite.False(ok)
+
+	// 置き換えると以前のコードは使えなくなること
+	third, _ := entity.NewRecoveryCode(objID, "ccccc-33333")
+	suite.NoError(suite.mfaRepo.ReplaceRecoveryCodes(objID.Value(), []*entity.RecoveryCode{third}))
+	ok, err = suite.mfaRepo.UseRecoveryCode(objID.Value(), entity.HashRecoveryCode("bbbbb-22222"))
+	suite.NoError(err)
+	suite.False(ok)
+	ok, err = suite.mfaRepo.UseRecoveryCode(objID.Value(), entity.HashRecoveryCode("ccccc-33333"))
+	suite.NoError(err)
+	suite.True(ok)
+}
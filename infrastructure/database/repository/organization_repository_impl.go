@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type OrganizationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOrganizationRepository(db *gorm.DB) repository.OrganizationRepository {
+	return &OrganizationRepositoryImpl{db: db}
+}
+
+func (r *OrganizationRepositoryImpl) CreateOrganization(organization *entity.Organization, owner *entity.Membership) (*entity.Organization, error) {
+	// 組織とオーナーのメンバーシップを同じトランザクションで作成する（オーナーのいない組織を作らない）
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(adapter.NewOrganizationAdapter().Convert(organization)).Error; err != nil {
+			return err
+		}
+		return tx.Create(adapter.NewMembershipAdapter().Convert(owner)).Error
+	})
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織(%s)の作成に失敗しました: %w", organization.Slug().Value(), err).Error())
+	}
+	return organization, nil
+}
+
+func (r *OrganizationRepositoryImpl) FindOrganization(orgObjID string) (*entity.Organization, error) {
+	return r.findOrganization("obj_id = ?", orgObjID)
+}
+
+func (r *OrganizationRepositoryImpl) FindOrganizationBySlug(slug string) (*entity.Organization, error) {
+	return r.findOrganization("slug = ?", slug)
+}
+
+func (r *OrganizationRepositoryImpl) UpdateOrganization(organization *entity.Organization) (*entity.Organization, error) {
+	converted, ok := adapter.NewOrganizationAdapter().Convert(organization).(*models.Organization)
+	if !ok {
+		return nil, errs.NewInfraError("変換されたモデルが *models.Organization ではありません。")
+	}
+	tx := r.db.Model(&models.Organization{}).Where("obj_id = ?", converted.ObjID).Update("name", converted.Name)
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織(%s)の更新に失敗しました: %w", converted.ObjID, tx.Error).Error())
+	}
+	if tx.RowsAffected == 0 {
+		return nil, errs.NewInfraError(fmt.Sprintf("更新する組織(%s)が見つかりません。", converted.ObjID))
+	}
+	return organization, nil
+}
+
+func (r *OrganizationRepositoryImpl) DeleteOrganization(orgObjID string) error {
+	// メンバーシップ・招待・組織を同じトランザクションで削除する（同じスラッグで作り直せるよう物理削除する）
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("org_obj_id = ?", orgObjID).Delete(&models.OrganizationInvitation{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("org_obj_id = ?", orgObjID).Delete(&models.OrganizationMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("obj_id = ?", orgObjID).Delete(&models.Organization{}).Error
+	})
+	if err != nil {
+		return errs.NewInfraError(fmt.Errorf("組織(%s)の削除に失敗しました: %w", orgObjID, err).Error())
+	}
+	return nil
+}
+
+func (r *OrganizationRepositoryImpl) ListUserOrganizations(userObjID string) ([]*repository.UserOrganization, error) {
+	var modelMembers []models.OrganizationMember
+	if err := r.db.Where("user_obj_id = ?", userObjID).Find(&modelMembers).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)のメンバーシップの取得に失敗しました: %w", userObjID, err).Error())
+	}
+	if len(modelMembers) == 0 {
+		return []*repository.UserOrganization{}, nil
+	}
+
+	memberships := make(map[string]*entity.Membership, len(modelMembers))
+	orgObjIDs := make([]string, 0, len(modelMembers))
+	for i := range modelMembers {
+		membership, err := adapter.NewMembershipAdapter().ReBuild(&modelMembers[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("メンバーシップの再構築に失敗しました: %w", err).Error())
+		}
+		memberships[modelMembers[i].OrgObjID] = membership
+		orgObjIDs = append(orgObjIDs, modelMembers[i].OrgObjID)
+	}
+
+	var modelOrgs []models.Organization
+	if err := r.db.Where("obj_id IN ?", orgObjIDs).Order("name").Order("slug").Find(&modelOrgs).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)の組織の取得に失敗しました: %w", userObjID, err).Error())
+	}
+	result := make([]*repository.UserOrganization, 0, len(modelOrgs))
+	for i := range modelOrgs {
+		organization, err := adapter.NewOrganizationAdapter().ReBuild(&modelOrgs[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("組織の再構築に失敗しました: %w", err).Error())
+		}
+		result = append(result, &repository.UserOrganization{
+			Organization: organization,
+			Membership:   memberships[modelOrgs[i].ObjID],
+		})
+	}
+	return result, nil
+}
+
+func (r *OrganizationRepositoryImpl) FindMembership(orgObjID string, userObjID string) (*entity.Membership, error) {
+	var model models.OrganizationMember
+	tx := r.db.Where("org_obj_id = ? AND user_obj_id = ?", orgObjID, userObjID).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織(%s)のユーザー(%s)のメンバーシップの取得に失敗しました: %w", orgObjID, userObjID, tx.Error).Error())
+	}
+	membership, err := adapter.NewMembershipAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メンバーシップの再構築に失敗しました: %w", err).Error())
+	}
+	return membership, nil
+}
+
+func (r *OrganizationRepositoryImpl) ListMemberships(orgObjID string) ([]*entity.Membership, error) {
+	var modelMembers []models.OrganizationMember
+	if err := r.db.Where("org_obj_id = ?", orgObjID).Order("created_at").Order("id").Find(&modelMembers).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織(%s)のメンバーシップの取得に失敗しました: %w", orgObjID, err).Error())
+	}
+	memberships := make([]*entity.Membership, 0, len(modelMembers))
+	for i := range modelMembers {
+		membership, err := adapter.NewMembershipAdapter().ReBuild(&modelMembers[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("メンバーシップの再構築に失敗しました: %w", err).Error())
+		}
+		memberships = append(memberships, membership)
+	}
+	return memberships, nil
+}
+
+func (r *OrganizationRepositoryImpl) CreateMembership(membership *entity.Membership) (*entity.Membership, error) {
+	if err := r.db.Create(adapter.NewMembershipAdapter().Convert(membership)).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織(%s)のメンバーシップの作成に失敗しました: %w", membership.OrgObjID().Value(), err).Error())
+	}
+	return membership, nil
+}
+
+func (r *OrganizationRepositoryImpl) UpdateMembership(membership *entity.Membership) (*entity.Membership, error) {
+	tx := r.db.Model(&models.OrganizationMember{}).
+		Where("org_obj_id = ? AND user_obj_id = ?", membership.OrgObjID().Value(), membership.UserObjID().Value()).
+		Update("role", membership.Role().Value())
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メンバーシップの更新に失敗しました: %w", tx.Error).Error())
+	}
+	if tx.RowsAffected == 0 {
+		return nil, errs.NewInfraError(fmt.Sprintf("更新するメンバーシップ(%s/%s)が見つかりません。", membership.OrgObjID().Value(), membership.UserObjID().Value()))
+	}
+	return membership, nil
+}
+
+func (r *OrganizationRepositoryImpl) DeleteMembership(orgObjID string, userObjID string) error {
+	// 再招待で同じユーザーを追加できるよう物理削除する
+	tx := r.db.Unscoped().Where("org_obj_id = ? AND user_obj_id = ?", orgObjID, userObjID).Delete(&models.OrganizationMember{})
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("組織(%s)のユーザー(%s)のメンバーシップの削除に失敗しました: %w", orgObjID, userObjID, tx.Error).Error())
+	}
+	return nil
+}
+
+// findOrganization は条件に一致する組織を取得する（存在しない場合は nil）
+func (r *OrganizationRepositoryImpl) findOrganization(query string, arg string) (*entity.Organization, error) {
+	var model models.Organization
+	tx := r.db.Where(query, arg).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織(%s)の取得に失敗しました: %w", arg, tx.Error).Error())
+	}
+	organization, err := adapter.NewOrganizationAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("組織の再構築に失敗しました: %w", err).Error())
+	}
+	return organization, nil
+}
@@ -0,0 +1,200 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type OrganizationRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	orgRepo        repository.OrganizationRepository
+	invitationRepo repository.InvitationRepository
+}
+
+func TestOrganizationRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationRepositoryImplTestSuite))
+}
+
+func (suite *OrganizationRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.orgRepo = NewOrganizationRepository(suite.DB)
+	suite.invitationRepo = NewInvitationRepository(suite.DB)
+}
+
+// newUserObjID はテスト用のユーザーIDを生成する
+func (suite *OrganizationRepositoryImplTestSuite) newUserObjID() *userValue.UserObjID {
+	objID, err := userValue.NewUserObjID(uuid.NewString())
+	suite.Require().NoError(err)
+	return objID
+}
+
+// newMembership はテスト用のメンバーシップを生成する
+func (suite *OrganizationRepositoryImplTestSuite) newMembership(org *entity.Organization, userObjID *userValue.UserObjID, role string) *entity.Membership {
+	memberRole, err := value.NewMemberRole(role)
+	suite.Require().NoError(err)
+	membership, err := entity.NewMembership(org.ObjID(), userObjID, memberRole)
+	suite.Require().NoError(err)
+	return membership
+}
+
+// createOrganization はオーナーのメンバーシップとともに組織を作成する
+func (suite *OrganizationRepositoryImplTestSuite) createOrganization(name, slug string, owner *userValue.UserObjID) *entity.Organization {
+	orgName, err := value.NewOrgName(name)
+	suite.Require().NoError(err)
+	orgSlug, err := value.NewOrgSlug(slug)
+	suite.Require().NoError(err)
+	org, err := entity.NewOrganization(orgName, orgSlug)
+	suite.Require().NoError(err)
+
+	_, err = suite.orgRepo.CreateOrganization(org, suite.newMembership(org, owner, value.MemberRoleOwner))
+	suite.Require().NoError(err)
+	return org
+}
+
+func (suite *OrganizationRepositoryImplTestSuite) TestCreateAndFindOrganization() {
+	owner := suite.newUserObjID()
+	org := suite.createOrganization("Acme", "acme-find", owner)
+
+	found, err := suite.orgRepo.FindOrganization(org.ObjID().Value())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal("Acme", found.Name().Value())
+	suite.Equal("acme-find", found.Slug().Value())
+
+	bySlug, err := suite.orgRepo.FindOrganizationBySlug("acme-find")
+	suite.NoError(err)
+	suite.Require().NotNil(bySlug)
+	suite.Equal(org.ObjID().Value(), bySlug.ObjID().Value())
+
+	// 作成したユーザーはオーナーとして所属すること
+	membership, err := suite.orgRepo.FindMembership(org.ObjID().Value(), owner.Value())
+	suite.NoError(err)
+	suite.Require().NotNil(membership)
+	suite.True(membership.Role().IsOwner())
+
+	// 同じスラッグの組織は作成できないこと
+	orgName, _ := value.NewOrgName("Other")
+	orgSlug, _ := value.NewOrgSlug("acme-find")
+	duplicated, err := entity.NewOrganization(orgName, orgSlug)
+	suite.Require().NoError(err)
+	_, err = suite.orgRepo.CreateOrganization(duplicated, suite.newMembership(duplicated, owner, value.MemberRoleOwner))
+	suite.Error(err)
+
+	// 存在しない組織は nil を返すこと
+	missing, err := suite.orgRepo.FindOrganization(uuid.NewString())
+	suite.NoError(err)
+	suite.Nil(missing)
+	missing, err = suite.orgRepo.FindOrganizationBySlug("missing")
+	suite.NoError(err)
+	suite.Nil(missing)
+}
+
+func (suite *OrganizationRepositoryImplTestSuite) TestUpdateOrganization() {
+	org := suite.createOrganization("Before", "acme-update", suite.newUserObjID())
+
+	name, err := value.NewOrgName("After")
+	suite.Require().NoError(err)
+	suite.Require().NoError(org.Rename(name))
+	_, err = suite.orgRepo.UpdateOrganization(org)
+	suite.NoError(err)
+
+	found, err := suite.orgRepo.FindOrganization(org.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal("After", found.Name().Value())
+}
+
+func (suite *OrganizationRepositoryImplTestSuite) TestMemberships() {
+	owner := suite.newUserObjID()
+	member := suite.newUserObjID()
+	org := suite.createOrganization("Members", "acme-members", owner)
+
+	_, err := suite.orgRepo.CreateMembership(suite.newMembership(org, member, value.MemberRoleMember))
+	suite.Require().NoError(err)
+
+	// 同じユーザーを二重に追加できないこと
+	_, err = suite.orgRepo.CreateMembership(suite.newMembership(org, member, value.MemberRoleAdmin))
+	suite.Error(err)
+
+	memberships, err := suite.orgRepo.ListMemberships(org.ObjID().Value())
+	suite.NoError(err)
+	suite.Require().Len(memberships, 2)
+	suite.Equal(owner.Value(), memberships[0].UserObjID().Value(), "参加日時の順に返すこと")
+
+	admin, err := value.NewMemberRole(value.MemberRoleAdmin)
+	suite.Require().NoError(err)
+	suite.Require().NoError(memberships[1].ChangeRole(admin))
+	_, err = suite.orgRepo.UpdateMembership(memberships[1])
+	suite.NoError(err)
+	found, err := suite.orgRepo.FindMembership(org.ObjID().Value(), member.Value())
+	suite.NoError(err)
+	suite.Equal(value.MemberRoleAdmin, found.Role().Value())
+
+	suite.NoError(suite.orgRepo.DeleteMembership(org.ObjID().Value(), member.Value()))
+	found, err = suite.orgRepo.FindMembership(org.ObjID().Value(), member.Value())
+	suite.NoError(err)
+	suite.Nil(found)
+
+	// 削除したユーザーは再び追加できること
+	_, err = suite.orgRepo.CreateMembership(suite.newMembership(org, member, value.MemberRoleMember))
+	suite.NoError(err)
+}
+
+func (suite *OrganizationRepositoryImplTestSuite) TestListUserOrganizations() {
+	user := suite.newUserObjID()
+	suite.createOrganization("Zeta", "list-zeta", user)
+	beta := suite.createOrganization("Beta", "list-beta", suite.newUserObjID())
+	_, err := suite.orgRepo.CreateMembership(suite.newMembership(beta, user, value.MemberRoleMember))
+	suite.Require().NoError(err)
+	suite.createOrganization("Other", "list-other", suite.newUserObjID())
+
+	organizations, err := suite.orgRepo.ListUserOrganizations(user.Value())
+	suite.NoError(err)
+	suite.Require().Len(organizations, 2, "所属する組織のみを返すこと")
+	suite.Equal("Beta", organizations[0].Organization.Name().Value(), "組織名の順に返すこと")
+	suite.Equal(value.MemberRoleMember, organizations[0].Membership.Role().Value())
+	suite.Equal("Zeta", organizations[1].Organization.Name().Value())
+	suite.True(organizations[1].Membership.Role().IsOwner())
+
+	organizations, err = suite.orgRepo.ListUserOrganizations(uuid.NewString())
+	suite.NoError(err)
+	suite.Empty(organizations)
+}
+
+func (suite *OrganizationRepositoryImplTestSuite) TestDeleteOrganization() {
+	owner := suite.newUserObjID()
+	org := suite.createOrganization("Delete", "acme-delete", owner)
+
+	email, err := userValue.NewUserEmail("invitee@example.com")
+	suite.Require().NoError(err)
+	role, err := value.NewMemberRole(value.MemberRoleMember)
+	suite.Require().NoError(err)
+	invitation, err := entity.NewInvitation(org.ObjID(), email, role, owner, org.CreatedAt().Add(time.Hour))
+	suite.Require().NoError(err)
+	_, err = suite.invitationRepo.CreateInvitation(invitation)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.orgRepo.DeleteOrganization(org.ObjID().Value()))
+
+	found, err := suite.orgRepo.FindOrganization(org.ObjID().Value())
+	suite.NoError(err)
+	suite.Nil(found)
+	membership, err := suite.orgRepo.FindMembership(org.ObjID().Value(), owner.Value())
+	suite.NoError(err)
+	suite.Nil(membership, "メンバーシップも削除されること")
+	foundInvitation, err := suite.invitationRepo.FindInvitation(invitation.ID())
+	suite.NoError(err)
+	suite.Nil(foundInvitation, "招待も削除されること")
+
+	// 同じスラッグで作り直せること
+	suite.createOrganization("Delete", "acme-delete", owner)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+)
+
+// appendOutboxEvent は、呼び出し元のトランザクション内でアウトボックスにイベントを記録する
+// 集約の変更と同時にコミットされるため、変更があったにもかかわらずイベントが失われることはない
+func appendOutboxEvent(tx *gorm.DB, eventType event.Type, aggregateID string, payload any) error {
+	e, err := event.NewEvent(eventType, aggregateID, payload)
+	if err != nil {
+		return err
+	}
+	return tx.Create(adapter.NewOutboxEventAdapter().Convert(e)).Error
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type PasskeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPasskeyRepository(db *gorm.DB) repository.PasskeyRepository {
+	return &PasskeyRepositoryImpl{db: db}
+}
+
+func (r *PasskeyRepositoryImpl) CreateCredential(credential *entity.PasskeyCredential) (*entity.PasskeyCredential, error) {
+	model, ok := adapter.NewPasskeyCredentialAdapter().Convert(credential).(*models.PasskeyCredential)
+	if !ok {
+		return nil, errs.NewInfraError("変換されたモデルが *models.PasskeyCredential ではありません。")
+	}
+	if err := r.db.Create(model).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("パスキーの登録に失敗しました: %w", err).Error())
+	}
+	return credential, nil
+}
+
+func (r *PasskeyRepositoryImpl) ListCredentials(userObjID string) ([]*entity.PasskeyCredential, error) {
+	var records []models.PasskeyCredential
+	if err := r.db.Where("user_obj_id = ?", userObjID).Order("id").Find(&records).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)のパスキー取得に失敗しました: %w", userObjID, err).Error())
+	}
+
+	credentials := make([]*entity.PasskeyCredential, 0, len(records))
+	for i := range records {
+		credential, err := adapter.NewPasskeyCredentialAdapter().ReBuild(&records[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("パスキーの再構築に失敗しました: %w", err).Error())
+		}
+		credentials = append(credentials, credential)
+	}
+	return credentials, nil
+}
+
+func (r *PasskeyRepositoryImpl) UpdateCredentialUsage(credential *entity.PasskeyCredential) (bool, error) {
+	// 条件付き更新にすることで、同じカウンターの署名による同時リクエストも一方のみ成功させる
+	// （カウンターを実装しない認証器は常に 0 のため、その場合は条件を付けない）
+	tx := r.db.Model(&models.PasskeyCredential{}).Where("obj_id = ?", credential.ID())
+	if credential.SignCount() != 0 {
+		tx = tx.Where("sign_count < ?", int64(credential.SignCount()))
+	}
+	tx = tx.Updates(map[string]any{
+		"sign_count":   int64(credential.SignCount()),
+		"backup_state": credential.BackupState(),
+		"last_used_at": credential.LastUsedAt(),
+	})
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("パスキー(%s)の使用記録に失敗しました: %w", credential.ID(), tx.Error).Error())
+	}
+	return tx.RowsAffected == 1, nil
+}
+
+func (r *PasskeyRepositoryImpl) SaveSession(session *entity.PasskeySession) error {
+	model, ok := adapter.NewPasskeySessionAdapter().Convert(session).(*models.PasskeySession)
+	if !ok {
+		return errs.NewInfraError("変換されたモデルが *models.PasskeySession ではありません。")
+	}
+	if err := r.db.Create(model).Error; err != nil {
+		return errs.NewInfraError(fmt.Errorf("パスキーのセッションの保存に失敗しました: %w", err).Error())
+	}
+	return nil
+}
+
+func (r *PasskeyRepositoryImpl) ConsumeSession(id string) (*entity.PasskeySession, error) {
+	var model models.PasskeySession
+	tx := r.db.Where("obj_id = ?", id).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("パスキーのセッション(%s)の取得に失敗しました: %w", id, tx.Error).Error())
+	}
+
+	// 削除できたリクエストのみが使用できる（同じセッションによる同時リクエストを防ぐ）
+	tx = r.db.Unscoped().Where("obj_id = ?", id).Delete(&models.PasskeySession{})
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("パスキーのセッション(%s)の削除に失敗しました: %w", id, tx.Error).Error())
+	}
+	if tx.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	session, err := adapter.NewPasskeySessionAdapter().ReBuild(&model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("パスキーのセッションの再構築に失敗しました: %w", err).Error())
+	}
+	return session, nil
+}
@@ -0,0 +1,159 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type PasskeyRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	passkeyRepo repository.PasskeyRepository
+}
+
+func TestPasskeyRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(PasskeyRepositoryImplTestSuite))
+}
+
+func (suite *PasskeyRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.passkeyRepo = NewPasskeyRepository(suite.DB)
+}
+
+// newUserObjID はテスト用のユーザーIDを生成する
+func (suite *PasskeyRepositoryImplTestSuite) newUserObjID() *value.UserObjID {
+	email, err := value.NewUserEmail("passkey@example.com")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("passkeyuser")
+	suite.NoError(err)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	return user.ObjID()
+}
+
+func (suite *PasskeyRepositoryImplTestSuite) newCredential(objID *value.UserObjID, credentialID string, signCount uint32) *entity.PasskeyCredential {
+	credential, err := entity.NewPasskeyCredential(objID, []byte(credentialID), "MacBook", []byte("public-key"), "none", []string{"internal", "hybrid"}, make([]byte, 16), signCount, true, false)
+	suite.NoError(err)
+	return credential
+}
+
+func (suite *PasskeyRepositoryImplTestSuite) TestCreateAndListCredentials() {
+	objID := suite.newUserObjID()
+	_, err := suite.passkeyRepo.CreateCredential(suite.newCredential(objID, "credential-1", 0))
+	suite.NoError(err, "パスキーの登録に失敗してはいけない")
+	_, err = suite.passkeyRepo.CreateCredential(suite.newCredential(objID, "credential-2", 0))
+	suite.NoError(err)
+
+	credentials, err := suite.passkeyRepo.ListCredentials(objID.Value())
+	suite.NoError(err)
+	suite.Require().Len(credentials, 2)
+	suite.Equal([]byte("credential-1"), credentials[0].CredentialID(), "登録順に取得されること")
+	suite.Equal([]string{"internal", "hybrid"}, credentials[0].Transports())
+	suite.Equal("MacBook", credentials[0].Name())
+	suite.True(credentials[0].BackupEligible())
+
+	// 他のユーザーのパスキーは含まれないこと
+	credentials, err = suite.passkeyRepo.ListCredentials(suite.newUserObjID().Value())
+	suite.NoError(err)
+	suite.Empty(credentials)
+}
+
+func (suite *PasskeyRepositoryImplTestSuite) TestCreateCredential_DuplicateCredentialID() {
+	_, err := suite.passkeyRepo.CreateCredential(suite.newCredential(suite.newUserObjID(), "duplicate", 0))
+	suite.NoError(err)
+
+	// 同じクレデンシャルIDは別のユーザーでも登録できないこと
+	_, err = suite.passkeyRepo.CreateCredential(suite.newCredential(suite.newUserObjID(), "duplicate", 0))
+	suite.Error(err)
+}
+
+func (suite *PasskeyRepositoryImplTestSuite) TestUpdateCredentialUsage() {
+	objID := suite.newUserObjID()
+	credential, err := suite.passkeyRepo.CreateCredential(suite.newCredential(objID, "usage", 1))
+	suite.NoError(err)
+
+	suite.NoError(credential.RecordUse(2, true, time.Now()))
+	updated, err := suite.passkeyRepo.UpdateCredentialUsage(credential)
+	suite.NoError(err)
+	suite.True(updated)
+
+	credentials, err := suite.passkeyRepo.ListCredentials(objID.Value())
+	suite.NoError(err)
+	suite.Require().Len(credentials, 1)
+	suite.Equal(uint32(2), credentials[0].SignCount())
+	suite.True(credentials[0].BackupState())
+	suite.NotNil(credentials[0].LastUsedAt())
+
+	// 保存済みのカウンター以下の更新は反映されないこと（同時に使われた場合）
+	updated, err = suite.passkeyRepo.UpdateCredentialUsage(credential)
+	suite.NoError(err)
+	suite.False(updated)
+}
+
+func (suite *PasskeyRepositoryImplTestSuite) TestConsumeSession() {
+	objID := suite.newUserObjID()
+	session, err := entity.NewPasskeySession(objID, entity.PasskeyCeremonyRegistration, []byte(`{"challenge":"abc"}`), time.Minute)
+	suite.NoError(err)
+	suite.NoError(suite.passkeyRepo.SaveSession(session))
+
+	found, err := suite.passkeyRepo.ConsumeSession(session.ID())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal(objID.Value(), found.UserObjID().Value())
+	suite.Equal(entity.PasskeyCeremonyRegistration, found.Ceremony())
+	suite.JSONEq(`{"challenge":"abc"}`, string(found.Data()))
+
+	// 一度しか使えないこと
+	found, err = suite.passkeyRepo.ConsumeSession(session.ID())
+	suite.NoError(err)
+	suite.Nil(found)
+}
+
+func (suite *PasskeyRepositoryImplTestSuite) TestConsumeSession_WithoutUser() {
+	session, err := entity.NewPasskeySession(nil, entity.PasskeyCeremonyLogin, []byte(`{}`), time.Minute)
+	suite.NoError(err)
+	suite.NoError(suite.passkeyRepo.SaveSession(session))
+
+	found, err := suite.passkeyRepo.ConsumeSession(session.ID())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Nil(found.UserObjID())
+}
+
+// 他のテナントのパスキーとセッションは取得・更新できないこと
+func (suite *PasskeyRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeRepo := NewPasskeyRepository(database.WithTenant(suite.DB, "acme"))
+	globexRepo := NewPasskeyRepository(database.WithTenant(suite.DB, "globex"))
+	objID := suite.newUserObjID()
+	credential, err := acmeRepo.CreateCredential(suite.newCredential(objID, "tenant-credential", 1))
+	suite.Require().NoError(err)
+
+	credentials, err := globexRepo.ListCredentials(objID.Value())
+	suite.NoError(err)
+	suite.Empty(credentials)
+	suite.NoError(credential.RecordUse(2, false, time.Now()))
+	updated, err := globexRepo.UpdateCredentialUsage(credential)
+	suite.NoError(err)
+	suite.False(updated, "他のテナントのパスキーは更新しないこと")
+	credentials, err = acmeRepo.ListCredentials(objID.Value())
+	suite.NoError(err)
+	suite.Len(credentials, 1)
+
+	session, err := entity.NewPasskeySession(objID, entity.PasskeyCeremonyLogin, []byte(`{}`), time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acmeRepo.SaveSession(session))
+	found, err := globexRepo.ConsumeSession(session.ID())
+	suite.NoError(err)
+	suite.Nil(found, "他のテナントのセッションは使えないこと")
+	found, err = acmeRepo.ConsumeSession(session.ID())
+	suite.NoError(err)
+	suite.NotNil(found)
+}
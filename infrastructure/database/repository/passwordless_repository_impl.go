@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type PasswordlessRepositoryImpl struct {
+	db        *gorm.DB
+	emailKeys value.EmailKeyRules // 検索用のメールアドレスのキーの作り方
+}
+
+func NewPasswordlessRepository(db *gorm.DB, emailKeys value.EmailKeyRules) repository.PasswordlessRepository {
+	return &PasswordlessRepositoryImpl{db: db, emailKeys: emailKeys}
+}
+
+func (r *PasswordlessRepositoryImpl) CreateChallenge(challenge *entity.PasswordlessChallenge) (*entity.PasswordlessChallenge, error) {
+	model, ok := adapter.NewPasswordlessChallengeAdapter().Convert(challenge).(*models.PasswordlessChallenge)
+	if !ok {
+		return nil, errs.NewInfraError("変換されたモデルが *models.PasswordlessChallenge ではありません。")
+	}
+	model.EmailKey = r.emailKeys.Key(challenge.Email())
+	tx := r.db.Create(model)
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("パスワードレスログインのチャレンジの作成に失敗しました: %w", tx.Error).Error())
+	}
+	return challenge, nil
+}
+
+func (r *PasswordlessRepositoryImpl) FindChallenge(id string) (*entity.PasswordlessChallenge, error) {
+	var model models.PasswordlessChallenge
+	tx := r.db.Where("obj_id = ?", id).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ID(%s)でのパスワードレスログインのチャレンジ取得に失敗しました: %w", id, tx.Error).Error())
+	}
+	return r.rebuild(&model)
+}
+
+func (r *PasswordlessRepositoryImpl) FindLatestChallenge(email *value.UserEmail) (*entity.PasswordlessChallenge, error) {
+	emailKey := r.emailKeys.Key(email)
+	var model models.PasswordlessChallenge
+	tx := r.db.Where("email_key = ?", emailKey).Order("id DESC").First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メールアドレス(%s)のパスワードレスログインのチャレンジ取得に失敗しました: %w", emailKey, tx.Error).Error())
+	}
+	return r.rebuild(&model)
+}
+
+func (r *PasswordlessRepositoryImpl) IncrementAttempts(id string, maxAttempts int) (bool, error) {
+	// 条件付き更新にすることで、同時リクエストでも上限を超えて試行させない
+	tx := r.db.Model(&models.PasswordlessChallenge{}).
+		Where("obj_id = ? AND consumed_at IS NULL AND attempts < ?", id, maxAttempts).
+		Update("attempts", gorm.Expr("attempts + 1"))
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("パスワードレスログインのチャレンジ(%s)の試行回数の更新に失敗しました: %w", id, tx.Error).Error())
+	}
+	return tx.RowsAffected == 1, nil
+}
+
+func (r *PasswordlessRepositoryImpl) ConsumeChallenge(id string) (bool, error) {
+	// 条件付き更新にすることで、同じコード・リンクによる同時リクエストも一方のみ成功させる
+	tx := r.db.Model(&models.PasswordlessChallenge{}).
+		Where("obj_id = ? AND consumed_at IS NULL", id).
+		Update("consumed_at", time.Now())
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("パスワードレスログインのチャレンジ(%s)の使用に失敗しました: %w", id, tx.Error).Error())
+	}
+	return tx.RowsAffected == 1, nil
+}
+
+func (r *PasswordlessRepositoryImpl) rebuild(model *models.PasswordlessChallenge) (*entity.PasswordlessChallenge, error) {
+	challenge, err := adapter.NewPasswordlessChallengeAdapter().ReBuild(model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("パスワードレスログインのチャレンジの再構築に失敗しました: %w", err).Error())
+	}
+	return challenge, nil
+}
@@ -0,0 +1,132 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type PasswordlessRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	passwordlessRepo repository.PasswordlessRepository
+}
+
+func TestPasswordlessRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordlessRepositoryImplTestSuite))
+}
+
+func (suite *PasswordlessRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.passwordlessRepo = NewPasswordlessRepository(suite.DB, value.EmailKeyRules{})
+}
+
+func (suite *PasswordlessRepositoryImplTestSuite) email(email string) *value.UserEmail {
+	emailValue, err := value.NewUserEmail(email)
+	suite.Require().NoError(err)
+	return emailValue
+}
+
+func (suite *PasswordlessRepositoryImplTestSuite) newChallenge(email string) *entity.PasswordlessChallenge {
+	challenge, err := entity.NewPasswordlessChallenge(suite.email(email), "123456", 10*time.Minute)
+	suite.NoError(err)
+	return challenge
+}
+
+func (suite *PasswordlessRepositoryImplTestSuite) TestCreateAndFindChallenge() {
+	challenge := suite.newChallenge("find@example.com")
+	_, err := suite.passwordlessRepo.CreateChallenge(challenge)
+	suite.NoError(err, "チャレンジの作成に失敗してはいけない")
+
+	found, err := suite.passwordlessRepo.FindChallenge(challenge.ID())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal("find@example.com", found.Email().Value())
+	suite.True(found.MatchesCode("123456"))
+	suite.False(found.IsConsumed())
+
+	found, err = suite.passwordlessRepo.FindChallenge("00000000-0000-0000-0000-000000000000")
+	suite.NoError(err, "存在しない場合はエラーにしないこと")
+	suite.Nil(found)
+}
+
+func (suite *PasswordlessRepositoryImplTestSuite) TestFindLatestChallenge() {
+	_, err := suite.passwordlessRepo.CreateChallenge(suite.newChallenge("latest@example.com"))
+	suite.NoError(err)
+	latest := suite.newChallenge("Latest@Example.com")
+	_, err = suite.passwordlessRepo.CreateChallenge(latest)
+	suite.NoError(err)
+
+	found, err := suite.passwordlessRepo.FindLatestChallenge(suite.email("latest@example.com"))
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal(latest.ID(), found.ID(), "正規化したキーで最後に作成されたチャレンジを取得すること")
+
+	found, err = suite.passwordlessRepo.FindLatestChallenge(suite.email("unknown@example.com"))
+	suite.NoError(err)
+	suite.Nil(found)
+}
+
+// プロバイダー固有ルールを適用する場合は、同じメールボックスを指すアドレスで検索できること
+func (suite *PasswordlessRepositoryImplTestSuite) TestFindLatestChallenge_ProviderRules() {
+	repo := NewPasswordlessRepository(suite.DB, value.EmailKeyRules{ProviderRules: true})
+	challenge := suite.newChallenge("provider.rules@gmail.com")
+	_, err := repo.CreateChallenge(challenge)
+	suite.Require().NoError(err)
+
+	found, err := repo.FindLatestChallenge(suite.email("ProviderRules+login@googlemail.com"))
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal(challenge.ID(), found.ID())
+
+	found, err = suite.passwordlessRepo.FindLatestChallenge(suite.email("ProviderRules+login@googlemail.com"))
+	suite.NoError(err)
+	suite.Nil(found, "ルールを適用しない場合は別のアドレスとみなすこと")
+}
+
+func (suite *PasswordlessRepositoryImplTestSuite) TestIncrementAttempts() {
+	challenge := suite.newChallenge("attempts@example.com")
+	_, err := suite.passwordlessRepo.CreateChallenge(challenge)
+	suite.NoError(err)
+
+	for i := 0; i < 3; i++ {
+		ok, err := suite.passwordlessRepo.IncrementAttempts(challenge.ID(), 3)
+		suite.NoError(err)
+		suite.True(ok)
+	}
+	ok, err := suite.passwordlessRepo.IncrementAttempts(challenge.ID(), 3)
+	suite.NoError(err)
+	suite.False(ok, "上限に達した後は試行できないこと")
+
+	found, err := suite.passwordlessRepo.FindChallenge(challenge.ID())
+	suite.NoError(err)
+	suite.Equal(3, found.Attempts())
+}
+
+func (suite *PasswordlessRepositoryImplTestSuite) TestConsumeChallenge() {
+	challenge := suite.newChallenge("consume@example.com")
+	_, err := suite.passwordlessRepo.CreateChallenge(challenge)
+	suite.NoError(err)
+
+	ok, err := suite.passwordlessRepo.ConsumeChallenge(challenge.ID())
+	suite.NoError(err)
+	suite.True(ok)
+
+	ok, err = suite.passwordlessRepo.ConsumeChallenge(challenge.ID())
+	suite.NoError(err)
+	suite.False(ok, "使用済みのチャレンジは再度使用できないこと")
+
+	ok, err = suite.passwordlessRepo.IncrementAttempts(challenge.ID(), 5)
+	suite.NoError(err)
+	suite.False(ok, "使用済みのチャレンジではコードを試行できないこと")
+
+	found, err := suite.passwordlessRepo.FindChallenge(challenge.ID())
+	suite.NoError(err)
+	suite.True(found.IsConsumed())
+}
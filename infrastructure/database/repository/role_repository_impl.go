@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type RoleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) repository.RoleRepository {
+	return &RoleRepositoryImpl{db: db}
+}
+
+func (r *RoleRepositoryImpl) ListRoles() ([]*entity.Role, error) {
+	var modelRoles []models.Role
+	if err := r.db.Order("name").Find(&modelRoles).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ロールの一覧の取得に失敗しました: %w", err).Error())
+	}
+	return rebuildRoles(modelRoles)
+}
+
+func (r *RoleRepositoryImpl) FindRole(name string) (*entity.Role, error) {
+	var modelRole models.Role
+	tx := r.db.Where("name = ?", name).First(&modelRole)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ロール(%s)の取得に失敗しました: %w", name, tx.Error).Error())
+	}
+	role, err := adapter.NewRoleAdapter().ReBuild(&modelRole)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ロールの再構築に失敗しました: %w", err).Error())
+	}
+	return role, nil
+}
+
+func (r *RoleRepositoryImpl) FindRolesByNames(names []string) ([]*entity.Role, error) {
+	if len(names) == 0 {
+		return []*entity.Role{}, nil
+	}
+	var modelRoles []models.Role
+	if err := r.db.Where("name IN ?", names).Order("name").Find(&modelRoles).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ロールの取得に失敗しました: %w", err).Error())
+	}
+	return rebuildRoles(modelRoles)
+}
+
+func (r *RoleRepositoryImpl) CreateRole(role *entity.Role) (*entity.Role, error) {
+	if err := r.db.Create(adapter.NewRoleAdapter().Convert(role)).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ロール(%s)の作成に失敗しました: %w", role.Name().Value(), err).Error())
+	}
+	return role, nil
+}
+
+func (r *RoleRepositoryImpl) EnsureRoles(roles []*entity.Role) error {
+	for _, role := range roles {
+		tx := r.db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "name"}},
+			DoNothing: true,
+		}).Create(adapter.NewRoleAdapter().Convert(role))
+		if tx.Error != nil {
+			return errs.NewInfraError(fmt.Errorf("ロール(%s)の作成に失敗しました: %w", role.Name().Value(), tx.Error).Error())
+		}
+	}
+	return nil
+}
+
+func (r *RoleRepositoryImpl) UpdateRole(role *entity.Role) (*entity.Role, error) {
+	converted, ok := adapter.NewRoleAdapter().Convert(role).(*models.Role)
+	if !ok {
+		return nil, errs.NewInfraError("変換されたモデルが *models.Role ではありません。")
+	}
+	tx := r.db.Model(&models.Role{}).Where("name = ?", converted.Name).Updates(map[string]any{
+		"description": converted.Description,
+		"permissions": converted.Permissions,
+	})
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ロール(%s)の更新に失敗しました: %w", converted.Name, tx.Error).Error())
+	}
+	if tx.RowsAffected == 0 {
+		return nil, errs.NewInfraError(fmt.Sprintf("更新するロール(%s)が見つかりません。", converted.Name))
+	}
+	return role, nil
+}
+
+func (r *RoleRepositoryImpl) DeleteRole(name string) error {
+	// ロールを持つユーザー・グループからの取り除きとロールの削除を同じトランザクションで行う（同じ名前で作り直せるよう物理削除する）
+	// 他のテナントの同じ名前のロールは別のロールのため、取り除くのはこのテナントのユーザー・グループのみ
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		users := tx.Unscoped().Model(&models.User{}).Select("obj_id")
+		if err := tx.Unscoped().Where("role_name = ? AND user_obj_id IN (?)", name, users).Delete(&models.UserRole{}).Error; err != nil {
+			return err
+		}
+		groups := tx.Unscoped().Model(&models.Group{}).Select("obj_id")
+		if err := tx.Unscoped().Where("role_name = ? AND group_obj_id IN (?)", name, groups).Delete(&models.GroupRole{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("name = ?", name).Delete(&models.Role{}).Error
+	})
+	if err != nil {
+		return errs.NewInfraError(fmt.Errorf("ロール(%s)の削除に失敗しました: %w", name, err).Error())
+	}
+	return nil
+}
+
+// rebuildRoles は永続化用モデルからロールを再構築する
+func rebuildRoles(modelRoles []models.Role) ([]*entity.Role, error) {
+	roles := make([]*entity.Role, 0, len(modelRoles))
+	for i := range modelRoles {
+		role, err := adapter.NewRoleAdapter().ReBuild(&modelRoles[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("ロールの再構築に失敗しました: %w", err).Error())
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
@@ -0,0 +1,230 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type RoleRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	roleRepo repository.RoleRepository
+	userRepo repository.UserRepository
+}
+
+func TestRoleRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(RoleRepositoryImplTestSuite))
+}
+
+func (suite *RoleRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.roleRepo = NewRoleRepository(suite.DB)
+	suite.userRepo = NewUserRepository(suite.DB, value.EmailKeyRules{})
+}
+
+// newRole は指定した権限を持つロールを作成する
+func (suite *RoleRepositoryImplTestSuite) newRole(name string, permissions ...string) *entity.Role {
+	roleName, err := value.NewUserRole(name)
+	suite.Require().NoError(err)
+	values := make([]*value.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		p, err := value.NewPermission(permission)
+		suite.Require().NoError(err)
+		values = append(values, p)
+	}
+	role, err := entity.NewRole(roleName, name+" の説明", values)
+	suite.Require().NoError(err)
+	return role
+}
+
+func (suite *RoleRepositoryImplTestSuite) TestCreateAndFindRole() {
+	_, err := suite.roleRepo.CreateRole(suite.newRole("auditor", value.PermissionUsersRead, value.PermissionRolesRead))
+	suite.Require().NoError(err)
+
+	found, err := suite.roleRepo.FindRole("auditor")
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal("auditor の説明", found.Description())
+	suite.Equal([]string{value.PermissionRolesRead, value.PermissionUsersRead}, found.PermissionNames(), "権限が保存されること")
+
+	// 同じ名前のロールは作成できないこと
+	_, err = suite.roleRepo.CreateRole(suite.newRole("auditor"))
+	suite.Error(err)
+
+	// 存在しないロールは nil を返すこと
+	missing, err := suite.roleRepo.FindRole("missing")
+	suite.NoError(err)
+	suite.Nil(missing)
+}
+
+func (suite *RoleRepositoryImplTestSuite) TestListAndFindRolesByNames() {
+	for _, name := range []string{"list-b", "list-a", "list-c"} {
+		_, err := suite.roleRepo.CreateRole(suite.newRole(name))
+		suite.Require().NoError(err)
+	}
+
+	roles, err := suite.roleRepo.FindRolesByNames([]string{"list-c", "list-a", "missing"})
+	suite.NoError(err)
+	suite.Equal([]string{"list-a", "list-c"}, roleNames(roles), "存在するロールのみを名前順に返すこと")
+
+	roles, err = suite.roleRepo.FindRolesByNames(nil)
+	suite.NoError(err)
+	suite.Empty(roles)
+
+	roles, err = suite.roleRepo.ListRoles()
+	suite.NoError(err)
+	names := roleNames(roles)
+	suite.Subset(names, []string{"list-a", "list-b", "list-c"})
+	suite.IsNonDecreasing(names, "名前順に返すこと")
+}
+
+func (suite *RoleRepositoryImplTestSuite) TestUpdateRole() {
+	role, err := suite.roleRepo.CreateRole(suite.newRole("editor", value.PermissionUsersRead))
+	suite.Require().NoError(err)
+
+	permission, err := value.NewPermission(value.PermissionUsersWrite)
+	suite.Require().NoError(err)
+	suite.Require().NoError(role.Update("編集者", []*value.Permission{permission}))
+	_, err = suite.roleRepo.UpdateRole(role)
+	suite.NoError(err)
+
+	found, err := suite.roleRepo.FindRole("editor")
+	suite.NoError(err)
+	suite.Equal("編集者", found.Description())
+	suite.Equal([]string{value.PermissionUsersWrite}, found.PermissionNames())
+
+	// 存在しないロールは更新できないこと
+	_, err = suite.roleRepo.UpdateRole(suite.newRole("not-saved"))
+	suite.Error(err)
+}
+
+func (suite *RoleRepositoryImplTestSuite) TestDeleteRole() {
+	_, err := suite.roleRepo.CreateRole(suite.newRole("temporary", value.PermissionUsersRead))
+	suite.Require().NoError(err)
+
+	// ロールを持つユーザーを作成する
+	email, err := value.NewUserEmail("role-member@example.com")
+	suite.Require().NoError(err)
+	username, err := value.NewUserUsername("rolemember")
+	suite.Require().NoError(err)
+	user, err := entity.NewUser(email, value.NoPassword(), username)
+	suite.Require().NoError(err)
+	temporary, err := value.NewUserRole("temporary")
+	suite.Require().NoError(err)
+	regular, err := value.NewUserRole(value.RegularUser)
+	suite.Require().NoError(err)
+	suite.Require().NoError(user.ChangeRoles([]*value.UserRole{temporary, regular}))
+	_, err = suite.userRepo.CreateUser(user)
+	suite.Require().NoError(err)
+	// ロールを与えたグループを作成する
+	groupRepo := NewGroupRepository(suite.DB)
+	groupName, err := value.NewGroupName("temporary-members")
+	suite.Require().NoError(err)
+	group, err := entity.NewGroup(groupName, "", []*value.UserRole{temporary, regular}, nil)
+	suite.Require().NoError(err)
+	_, err = groupRepo.CreateGroup(group)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.roleRepo.DeleteRole("temporary"))
+
+	found, err := suite.roleRepo.FindRole("temporary")
+	suite.NoError(err)
+	suite.Nil(found, "ロールが削除されること")
+	member, err := suite.userRepo.GetUserByObjID(user.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{value.RegularUser}, member.RoleNames(), "ユーザーからもロールが取り除かれること")
+	var count int64
+	suite.Require().NoError(suite.DB.Unscoped().Model(&models.UserRole{}).Where("role_name = ?", "temporary").Count(&count).Error)
+	suite.Zero(count)
+	foundGroup, err := groupRepo.FindGroup(group.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{value.RegularUser}, foundGroup.RoleNames(), "グループからもロールが取り除かれること")
+
+	// 削除したロールと同じ名前で作り直せること
+	_, err = suite.roleRepo.CreateRole(suite.newRole("temporary"))
+	suite.NoError(err)
+}
+
+// 存在しないロールのみ作成し、既存のロールは変更しないこと
+func (suite *RoleRepositoryImplTestSuite) TestEnsureRoles() {
+	roleRepo := NewRoleRepository(database.WithTenant(suite.DB, "ensure"))
+	_, err := roleRepo.CreateRole(suite.newRole("support", value.PermissionUsersRead))
+	suite.Require().NoError(err)
+
+	suite.NoError(roleRepo.EnsureRoles(append(entity.BuiltInRoles(), suite.newRole("support"))))
+	suite.NoError(roleRepo.EnsureRoles(entity.BuiltInRoles()), "繰り返し実行できること")
+
+	roles, err := roleRepo.ListRoles()
+	suite.NoError(err)
+	suite.Equal([]string{value.Admin, "support", value.RegularUser}, roleNames(roles))
+	admin, err := roleRepo.FindRole(value.Admin)
+	suite.NoError(err)
+	suite.Equal([]string{value.PermissionAll}, admin.PermissionNames())
+	support, err := roleRepo.FindRole("support")
+	suite.NoError(err)
+	suite.Equal([]string{value.PermissionUsersRead}, support.PermissionNames(), "既存のロールは変更しないこと")
+}
+
+// ロールはテナントごとに定義され、削除は他のテナントのロールとユーザーに影響しないこと
+func (suite *RoleRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeDB := database.WithTenant(suite.DB, "acme")
+	globexDB := database.WithTenant(suite.DB, "globex")
+	acmeRoles, globexRoles := NewRoleRepository(acmeDB), NewRoleRepository(globexDB)
+
+	_, err := acmeRoles.CreateRole(suite.newRole("operator", value.PermissionUsersRead))
+	suite.Require().NoError(err)
+	_, err = globexRoles.CreateRole(suite.newRole("operator", value.PermissionUsersWrite))
+	suite.Require().NoError(err, "同じ名前のロールを別のテナントで作成できること")
+
+	found, err := acmeRoles.FindRole("operator")
+	suite.NoError(err)
+	suite.Equal([]string{value.PermissionUsersRead}, found.PermissionNames())
+	roles, err := acmeRoles.ListRoles()
+	suite.NoError(err)
+	suite.Equal([]string{"operator"}, roleNames(roles))
+
+	// それぞれのテナントでロールを持つユーザーを作成する
+	operator, err := value.NewUserRole("operator")
+	suite.Require().NoError(err)
+	newMember := func(userRepo repository.UserRepository) *entity.User {
+		email, err := value.NewUserEmail("operator@example.com")
+		suite.Require().NoError(err)
+		username, err := value.NewUserUsername("operator")
+		suite.Require().NoError(err)
+		user, err := entity.NewUser(email, value.NoPassword(), username)
+		suite.Require().NoError(err)
+		suite.Require().NoError(user.ChangeRoles([]*value.UserRole{operator}))
+		_, err = userRepo.CreateUser(user)
+		suite.Require().NoError(err)
+		return user
+	}
+	newMember(NewUserRepository(acmeDB, value.EmailKeyRules{}))
+	globexUserRepo := NewUserRepository(globexDB, value.EmailKeyRules{})
+	globexMember := newMember(globexUserRepo)
+
+	suite.NoError(acmeRoles.DeleteRole("operator"))
+
+	found, err = globexRoles.FindRole("operator")
+	suite.NoError(err)
+	suite.NotNil(found, "他のテナントのロールは削除しないこと")
+	member, err := globexUserRepo.GetUserByObjID(globexMember.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{"operator"}, member.RoleNames(), "他のテナントのユーザーからはロールを取り除かないこと")
+}
+
+// roleNames はロールの名前の一覧を返す
+func roleNames(roles []*entity.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name().Value())
+	}
+	return names
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	orgValue "github.com/goda6565/nexus-user-auth/domain/organization/value"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type ServiceAccountRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewServiceAccountRepository(db *gorm.DB) repository.ServiceAccountRepository {
+	return &ServiceAccountRepositoryImpl{db: db}
+}
+
+func (r *ServiceAccountRepositoryImpl) CreateServiceAccount(account *entity.ServiceAccount) (*entity.ServiceAccount, error) {
+	// ユーザー・サービスアカウント・組織への所属の作成とイベントの記録を同じトランザクションで行う
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(adapter.NewUserAdapter().Convert(account.User())).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(adapter.NewServiceAccountAdapter().Convert(account)).Error; err != nil {
+			return err
+		}
+		if account.IsOwnedByOrganization() {
+			member := &models.OrganizationMember{OrgObjID: account.OwnerID(), UserObjID: account.ObjID().Value(), Role: orgValue.MemberRoleMember}
+			if err := tx.Create(member).Error; err != nil {
+				return err
+			}
+		}
+		return appendOutboxEvent(tx, event.TypeServiceAccountCreated, account.ObjID().Value(), userPayload(account.User()))
+	})
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("サービスアカウントの作成に失敗しました: %w", err).Error())
+	}
+	return account, nil
+}
+
+func (r *ServiceAccountRepositoryImpl) FindServiceAccount(objID string) (*entity.ServiceAccount, error) {
+	var record models.ServiceAccount
+	tx := r.db.Where("user_obj_id = ?", objID).First(&record)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("サービスアカウント(%s)の取得に失敗しました: %w", objID, tx.Error).Error())
+	}
+	accounts, err := r.rebuild([]models.ServiceAccount{record})
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+	return accounts[0], nil
+}
+
+func (r *ServiceAccountRepositoryImpl) ListServiceAccounts() ([]*entity.ServiceAccount, error) {
+	var records []models.ServiceAccount
+	if err := r.db.Order("id").Find(&records).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("サービスアカウントの一覧の取得に失敗しました: %w", err).Error())
+	}
+	return r.rebuild(records)
+}
+
+// rebuild は、サービスアカウントのユーザーをまとめて取得し、ドメインエンティティを再構築する
+// ユーザーが存在しない（削除済みの）サービスアカウントは結果に含めない
+func (r *ServiceAccountRepositoryImpl) rebuild(records []models.ServiceAccount) ([]*entity.ServiceAccount, error) {
+	if len(records) == 0 {
+		return []*entity.ServiceAccount{}, nil
+	}
+	objIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		objIDs = append(objIDs, record.UserObjID)
+	}
+	var users []models.User
+	if err := r.db.Preload("Roles").Where("obj_id IN ?", objIDs).Find(&users).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("サービスアカウントのユーザーの取得に失敗しました: %w", err).Error())
+	}
+	usersByID := make(map[string]*models.User, len(users))
+	for i := range users {
+		usersByID[users[i].ObjID] = &users[i]
+	}
+
+	accounts := make([]*entity.ServiceAccount, 0, len(records))
+	for i := range records {
+		userModel, ok := usersByID[records[i].UserObjID]
+		if !ok {
+			continue
+		}
+		user, err := adapter.NewUserAdapter().ReBuild(userModel)
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("ユーザーエンティティの再構築に失敗しました: %w", err).Error())
+		}
+		account, err := adapter.NewServiceAccountAdapter().ReBuild(&records[i], user)
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("サービスアカウントの再構築に失敗しました: %w", err).Error())
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
@@ -0,0 +1,140 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	orgRepository "github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	orgValue "github.com/goda6565/nexus-user-auth/domain/organization/value"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type ServiceAccountRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	serviceAccountRepo repository.ServiceAccountRepository
+	userRepo           repository.UserRepository
+	orgRepo            orgRepository.OrganizationRepository
+}
+
+func TestServiceAccountRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceAccountRepositoryImplTestSuite))
+}
+
+func (suite *ServiceAccountRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.serviceAccountRepo = NewServiceAccountRepository(suite.DB)
+	suite.userRepo = NewUserRepository(suite.DB, value.EmailKeyRules{})
+	suite.orgRepo = NewOrganizationRepository(suite.DB)
+}
+
+// newServiceAccount はサービスアカウントを作成する（登録はしない）
+func (suite *ServiceAccountRepositoryImplTestSuite) newServiceAccount(name string, orgObjID string) *entity.ServiceAccount {
+	username, err := value.NewUserUsername(name)
+	suite.Require().NoError(err)
+	admin, err := value.NewUserObjID(uuid.NewString())
+	suite.Require().NoError(err)
+	account, err := entity.NewServiceAccount(username, "デプロイ用", orgObjID, admin)
+	suite.Require().NoError(err)
+	return account
+}
+
+func (suite *ServiceAccountRepositoryImplTestSuite) TestCreateAndFindServiceAccount() {
+	account := suite.newServiceAccount("ci-deployer", "")
+	_, err := suite.serviceAccountRepo.CreateServiceAccount(account)
+	suite.Require().NoError(err)
+
+	found, err := suite.serviceAccountRepo.FindServiceAccount(account.ObjID().Value())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal("ci-deployer", found.User().Username().Value())
+	suite.True(found.User().IsServiceAccount(), "種類が保存されること")
+	suite.False(found.User().Password().IsSet())
+	suite.Equal("デプロイ用", found.Description())
+	suite.Equal(entity.ServiceAccountOwnerAdmin, found.OwnerType())
+	suite.Equal(account.CreatedBy().Value(), found.OwnerID())
+	suite.Equal(account.CreatedBy().Value(), found.CreatedBy().Value())
+
+	// ユーザーとしても取得できること
+	user, err := suite.userRepo.GetUserByObjID(account.ObjID().Value())
+	suite.NoError(err)
+	suite.True(user.IsServiceAccount())
+
+	// ユーザー登録ではなくサービスアカウント作成のイベントを記録すること
+	var outbox models.OutboxEvent
+	suite.NoError(suite.DB.Where("aggregate_id = ?", account.ObjID().Value()).First(&outbox).Error)
+	suite.Equal(string(event.TypeServiceAccountCreated), outbox.EventType)
+
+	missing, err := suite.serviceAccountRepo.FindServiceAccount(uuid.NewString())
+	suite.NoError(err)
+	suite.Nil(missing, "存在しない場合は nil を返すこと")
+}
+
+func (suite *ServiceAccountRepositoryImplTestSuite) TestCreateServiceAccount_OwnedByOrganization() {
+	orgObjID := uuid.NewString()
+	account := suite.newServiceAccount("org-bot", orgObjID)
+	_, err := suite.serviceAccountRepo.CreateServiceAccount(account)
+	suite.Require().NoError(err)
+
+	membership, err := suite.orgRepo.FindMembership(orgObjID, account.ObjID().Value())
+	suite.NoError(err)
+	suite.Require().NotNil(membership, "組織のメンバーとして登録されること")
+	suite.Equal(orgValue.MemberRoleMember, membership.Role().Value())
+}
+
+func (suite *ServiceAccountRepositoryImplTestSuite) TestListAndDeleteServiceAccounts() {
+	first := suite.newServiceAccount("list-first", "")
+	second := suite.newServiceAccount("list-second", "")
+	for _, account := range []*entity.ServiceAccount{first, second} {
+		_, err := suite.serviceAccountRepo.CreateServiceAccount(account)
+		suite.Require().NoError(err)
+	}
+
+	ids := func() []string {
+		accounts, err := suite.serviceAccountRepo.ListServiceAccounts()
+		suite.Require().NoError(err)
+		ids := make([]string, 0, len(accounts))
+		for _, account := range accounts {
+			ids = append(ids, account.ObjID().Value())
+		}
+		return ids
+	}
+	listed := ids()
+	suite.Contains(listed, first.ObjID().Value())
+	suite.Contains(listed, second.ObjID().Value())
+
+	// ユーザーの削除とともにサービスアカウントも削除されること
+	suite.Require().NoError(suite.userRepo.DeleteUser(first.ObjID().Value()))
+	suite.NotContains(ids(), first.ObjID().Value())
+	found, err := suite.serviceAccountRepo.FindServiceAccount(first.ObjID().Value())
+	suite.NoError(err)
+	suite.Nil(found)
+	var count int64
+	suite.NoError(suite.DB.Model(&models.ServiceAccount{}).Where("user_obj_id = ?", first.ObjID().Value()).Count(&count).Error)
+	suite.Zero(count)
+}
+
+// 他のテナントのサービスアカウントは参照できないこと
+func (suite *ServiceAccountRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeRepo := NewServiceAccountRepository(database.WithTenant(suite.DB, "acme"))
+	globexRepo := NewServiceAccountRepository(database.WithTenant(suite.DB, "globex"))
+
+	account := suite.newServiceAccount("tenant-bot", "")
+	_, err := acmeRepo.CreateServiceAccount(account)
+	suite.Require().NoError(err)
+
+	found, err := globexRepo.FindServiceAccount(account.ObjID().Value())
+	suite.NoError(err)
+	suite.Nil(found)
+	found, err = acmeRepo.FindServiceAccount(account.ObjID().Value())
+	suite.NoError(err)
+	suite.NotNil(found)
+}
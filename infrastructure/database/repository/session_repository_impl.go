@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type SessionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) repository.SessionRepository {
+	return &SessionRepositoryImpl{db: db}
+}
+
+func (r *SessionRepositoryImpl) RevokeSessions(userObjID string, revokedAt time.Time) (bool, error) {
+	// ユーザー全体を保存し直さないよう、無効にした日時のみを更新する
+	// トークンの発行日時（秒単位）と比較するため、秒未満を切り捨てて保存する（直後に発行したトークンを無効にしない）
+	tx := r.db.Model(&models.User{}).Where("obj_id = ?", userObjID).Update("sessions_revoked_at", revokedAt.Truncate(time.Second))
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("ユーザー(%s)のトークンの無効化に失敗しました: %w", userObjID, tx.Error).Error())
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *SessionRepositoryImpl) GetSessionsRevokedAt(userObjID string) (*time.Time, error) {
+	var modelUser models.User
+	tx := r.db.Select("sessions_revoked_at").Where("obj_id = ?", userObjID).First(&modelUser)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)のトークンを無効にした日時の取得に失敗しました: %w", userObjID, tx.Error).Error())
+	}
+	return modelUser.SessionsRevokedAt, nil
+}
@@ -0,0 +1,102 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type SessionRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	sessionRepo repository.SessionRepository
+	userRepo    repository.UserRepository
+}
+
+func TestSessionRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(SessionRepositoryImplTestSuite))
+}
+
+func (suite *SessionRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.sessionRepo = NewSessionRepository(suite.DB)
+	suite.userRepo = NewUserRepository(suite.DB, value.EmailKeyRules{})
+}
+
+func (suite *SessionRepositoryImplTestSuite) TestRevokeSessions() {
+	email, err := value.NewUserEmail("sessions@example.com")
+	suite.Require().NoError(err)
+	username, err := value.NewUserUsername("sessionuser")
+	suite.Require().NoError(err)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	created, err := suite.userRepo.CreateUser(user)
+	suite.Require().NoError(err)
+	objID := created.ObjID().Value()
+
+	revokedAt, err := suite.sessionRepo.GetSessionsRevokedAt(objID)
+	suite.NoError(err)
+	suite.Nil(revokedAt, "無効にしたことがない場合は nil")
+
+	now := time.Now()
+	found, err := suite.sessionRepo.RevokeSessions(objID, now)
+	suite.NoError(err)
+	suite.True(found)
+	revokedAt, err = suite.sessionRepo.GetSessionsRevokedAt(objID)
+	suite.NoError(err)
+	suite.Require().NotNil(revokedAt)
+	suite.WithinDuration(now, *revokedAt, time.Second)
+
+	// ユーザーの更新で無効にした日時が失われないこと
+	_, err = suite.userRepo.UpdateUser(created)
+	suite.Require().NoError(err)
+	revokedAt, err = suite.sessionRepo.GetSessionsRevokedAt(objID)
+	suite.NoError(err)
+	suite.NotNil(revokedAt)
+}
+
+func (suite *SessionRepositoryImplTestSuite) TestRevokeSessions_UnknownUser() {
+	found, err := suite.sessionRepo.RevokeSessions("123e4567-e89b-12d3-a456-426614174999", time.Now())
+	suite.NoError(err)
+	suite.False(found)
+
+	revokedAt, err := suite.sessionRepo.GetSessionsRevokedAt("123e4567-e89b-12d3-a456-426614174999")
+	suite.NoError(err)
+	suite.Nil(revokedAt)
+}
+
+// 他のテナントのユーザーのトークンは無効にできないこと（無効にした日時はテナントごとのユーザーに保存する）
+func (suite *SessionRepositoryImplTestSuite) TestTenantIsolation() {
+	email, err := value.NewUserEmail("tenant.sessions@example.com")
+	suite.Require().NoError(err)
+	username, err := value.NewUserUsername("sessionuser")
+	suite.Require().NoError(err)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	_, err = NewUserRepository(database.WithTenant(suite.DB, "acme"), value.EmailKeyRules{}).CreateUser(user)
+	suite.Require().NoError(err)
+	objID := user.ObjID().Value()
+
+	acmeRepo := NewSessionRepository(database.WithTenant(suite.DB, "acme"))
+	globexRepo := NewSessionRepository(database.WithTenant(suite.DB, "globex"))
+	found, err := globexRepo.RevokeSessions(objID, time.Now())
+	suite.NoError(err)
+	suite.False(found)
+	revokedAt, err := acmeRepo.GetSessionsRevokedAt(objID)
+	suite.NoError(err)
+	suite.Nil(revokedAt, "他のテナントからは無効にされないこと")
+
+	found, err = acmeRepo.RevokeSessions(objID, time.Now())
+	suite.NoError(err)
+	suite.True(found)
+	revokedAt, err = globexRepo.GetSessionsRevokedAt(objID)
+	suite.NoError(err)
+	suite.Nil(revokedAt, "他のテナントのユーザーの日時は取得できないこと")
+}
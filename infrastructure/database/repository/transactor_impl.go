@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+)
+
+type TransactorImpl struct {
+	db        *gorm.DB
+	emailKeys value.EmailKeyRules
+}
+
+func NewTransactor(db *gorm.DB, emailKeys value.EmailKeyRules) repository.Transactor {
+	return &TransactorImpl{db: db, emailKeys: emailKeys}
+}
+
+// Transaction は、同じトランザクションの接続を使うリポジトリを fn に渡す
+// リポジトリの中で開くトランザクションは、このトランザクションの中のセーブポイントになる
+func (t *TransactorImpl) Transaction(fn func(repos *repository.TxRepositories) error) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&repository.TxRepositories{
+			Users:           NewUserRepository(tx, t.emailKeys),
+			Sessions:        NewSessionRepository(tx),
+			AuditLogs:       NewAuditLogRepository(tx),
+			Roles:           NewRoleRepository(tx),
+			Groups:          NewGroupRepository(tx),
+			ServiceAccounts: NewServiceAccountRepository(tx),
+			APIKeys:         NewAPIKeyRepository(tx),
+			EmailChanges:    NewEmailChangeRepository(tx),
+		})
+	})
+}
@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type TransactorImplTestSuite struct {
+	tester.DBSQLiteSuite
+	transactor repository.Transactor
+}
+
+func TestTransactorImplTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactorImplTestSuite))
+}
+
+func (suite *TransactorImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.transactor = NewTransactor(suite.DB, value.EmailKeyRules{})
+}
+
+func (suite *TransactorImplTestSuite) newUserAndAuditLog(address string) (*entity.User, *entity.AuditLog) {
+	email, err := value.NewUserEmail(address)
+	suite.Require().NoError(err)
+	username, err := value.NewUserUsername("transactor")
+	suite.Require().NoError(err)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	actor, err := value.NewUserObjID("11111111-1111-1111-1111-111111111111")
+	suite.Require().NoError(err)
+	log, err := entity.NewAuditLog(actor, nil, entity.AuditActionUserCreate, user.ObjID().Value(), nil)
+	suite.Require().NoError(err)
+	return user, log
+}
+
+// 操作と監査ログがまとめて保存されること
+func (suite *TransactorImplTestSuite) TestTransaction_Commit() {
+	user, log := suite.newUserAndAuditLog("commit@example.com")
+
+	err := suite.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if _, err := repos.Users.CreateUser(user); err != nil {
+			return err
+		}
+		return repos.AuditLogs.SaveAuditLog(log)
+	})
+	suite.Require().NoError(err)
+
+	var count int64
+	suite.NoError(suite.DB.Model(&models.User{}).Where("obj_id = ?", user.ObjID().Value()).Count(&count).Error)
+	suite.Equal(int64(1), count)
+	suite.NoError(suite.DB.Model(&models.AuditLog{}).Where("obj_id = ?", log.ID()).Count(&count).Error)
+	suite.Equal(int64(1), count)
+}
+
+// fn がエラーを返した場合は、リポジトリでの操作（イベントの記録を含む）がすべて取り消されること
+func (suite *TransactorImplTestSuite) TestTransaction_Rollback() {
+	user, _ := suite.newUserAndAuditLog("rollback@example.com")
+	failure := errors.New("audit log failed")
+
+	err := suite.transactor.Transaction(func(repos *repository.TxRepositories) error {
+		if _, err := repos.Users.CreateUser(user); err != nil {
+			return err
+		}
+		return failure
+	})
+	suite.ErrorIs(err, failure)
+
+	var count int64
+	suite.NoError(suite.DB.Model(&models.User{}).Where("obj_id = ?", user.ObjID().Value()).Count(&count).Error)
+	suite.Zero(count)
+	suite.NoError(suite.DB.Model(&models.OutboxEvent{}).Where("aggregate_id = ?", user.ObjID().Value()).Count(&count).Error)
+	suite.Zero(count)
+}
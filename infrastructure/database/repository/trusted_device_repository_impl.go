@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+type TrustedDeviceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTrustedDeviceRepository(db *gorm.DB) repository.TrustedDeviceRepository {
+	return &TrustedDeviceRepositoryImpl{db: db}
+}
+
+func (r *TrustedDeviceRepositoryImpl) CreateDevice(device *entity.TrustedDevice) (*entity.TrustedDevice, error) {
+	tx := r.db.Create(adapter.NewTrustedDeviceAdapter().Convert(device))
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("信頼済み端末の登録に失敗しました: %w", tx.Error).Error())
+	}
+	return device, nil
+}
+
+func (r *TrustedDeviceRepositoryImpl) FindDevice(id string) (*entity.TrustedDevice, error) {
+	var model models.TrustedDevice
+	tx := r.db.Where("obj_id = ?", id).First(&model)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ID(%s)での信頼済み端末の取得に失敗しました: %w", id, tx.Error).Error())
+	}
+	return r.rebuild(&model)
+}
+
+func (r *TrustedDeviceRepositoryImpl) ListDevices(userObjID string) ([]*entity.TrustedDevice, error) {
+	var records []models.TrustedDevice
+	if err := r.db.Where("user_obj_id = ?", userObjID).Order("id").Find(&records).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー(%s)の信頼済み端末の取得に失敗しました: %w", userObjID, err).Error())
+	}
+
+	devices := make([]*entity.TrustedDevice, 0, len(records))
+	for i := range records {
+		device, err := r.rebuild(&records[i])
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+func (r *TrustedDeviceRepositoryImpl) TouchDevice(id string, usedAt time.Time) error {
+	tx := r.db.Model(&models.TrustedDevice{}).Where("obj_id = ?", id).Update("last_used_at", usedAt)
+	if tx.Error != nil {
+		return errs.NewInfraError(fmt.Errorf("信頼済み端末(%s)の最終使用日時の更新に失敗しました: %w", id, tx.Error).Error())
+	}
+	return nil
+}
+
+func (r *TrustedDeviceRepositoryImpl) DeleteDevice(userObjID string, id string) (bool, error) {
+	// 他のユーザーの端末を削除できないよう、ユーザーIDも条件に含める
+	tx := r.db.Unscoped().Where("obj_id = ? AND user_obj_id = ?", id, userObjID).Delete(&models.TrustedDevice{})
+	if tx.Error != nil {
+		return false, errs.NewInfraError(fmt.Errorf("信頼済み端末(%s)の削除に失敗しました: %w", id, tx.Error).Error())
+	}
+	return tx.RowsAffected == 1, nil
+}
+
+func (r *TrustedDeviceRepositoryImpl) rebuild(model *models.TrustedDevice) (*entity.TrustedDevice, error) {
+	device, err := adapter.NewTrustedDeviceAdapter().ReBuild(model)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("信頼済み端末の再構築に失敗しました: %w", err).Error())
+	}
+	return device, nil
+}
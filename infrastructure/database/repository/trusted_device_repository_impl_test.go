@@ -0,0 +1,137 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type TrustedDeviceRepositoryImplTestSuite struct {
+	tester.DBSQLiteSuite
+	trustedDeviceRepo repository.TrustedDeviceRepository
+}
+
+func TestTrustedDeviceRepositoryImplTestSuite(t *testing.T) {
+	suite.Run(t, new(TrustedDeviceRepositoryImplTestSuite))
+}
+
+func (suite *TrustedDeviceRepositoryImplTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.trustedDeviceRepo = NewTrustedDeviceRepository(suite.DB)
+}
+
+// newUserObjID はテスト用のユーザーIDを生成する
+func (suite *TrustedDeviceRepositoryImplTestSuite) newUserObjID() *value.UserObjID {
+	email, err := value.NewUserEmail("device@example.com")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("deviceuser")
+	suite.NoError(err)
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	return user.ObjID()
+}
+
+func (suite *TrustedDeviceRepositoryImplTestSuite) newDevice(objID *value.UserObjID, name string) *entity.TrustedDevice {
+	device, err := entity.NewTrustedDevice(objID, name, 30*24*time.Hour)
+	suite.NoError(err)
+	_, err = suite.trustedDeviceRepo.CreateDevice(device)
+	suite.NoError(err, "信頼済み端末の登録に失敗してはいけない")
+	return device
+}
+
+func (suite *TrustedDeviceRepositoryImplTestSuite) TestCreateAndFindDevice() {
+	device := suite.newDevice(suite.newUserObjID(), "MacBook")
+
+	found, err := suite.trustedDeviceRepo.FindDevice(device.ID())
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal(device.UserObjID().Value(), found.UserObjID().Value())
+	suite.Equal("MacBook", found.Name())
+	suite.WithinDuration(device.ExpiresAt(), found.ExpiresAt(), time.Second)
+	suite.Nil(found.LastUsedAt())
+
+	missing, err := suite.trustedDeviceRepo.FindDevice("00000000-0000-0000-0000-000000000000")
+	suite.NoError(err)
+	suite.Nil(missing, "存在しない場合は nil を返すこと")
+}
+
+func (suite *TrustedDeviceRepositoryImplTestSuite) TestListDevices() {
+	objID := suite.newUserObjID()
+	suite.newDevice(objID, "MacBook")
+	suite.newDevice(objID, "iPhone")
+	suite.newDevice(suite.newUserObjID(), "Other")
+
+	devices, err := suite.trustedDeviceRepo.ListDevices(objID.Value())
+	suite.NoError(err)
+	suite.Require().Len(devices, 2, "他のユーザーの端末を含まないこと")
+	suite.Equal("MacBook", devices[0].Name(), "登録順に取得されること")
+	suite.Equal("iPhone", devices[1].Name())
+}
+
+func (suite *TrustedDeviceRepositoryImplTestSuite) TestTouchDevice() {
+	device := suite.newDevice(suite.newUserObjID(), "MacBook")
+	usedAt := time.Now()
+
+	suite.NoError(suite.trustedDeviceRepo.TouchDevice(device.ID(), usedAt))
+
+	found, err := suite.trustedDeviceRepo.FindDevice(device.ID())
+	suite.NoError(err)
+	suite.Require().NotNil(found.LastUsedAt())
+	suite.WithinDuration(usedAt, *found.LastUsedAt(), time.Second)
+}
+
+func (suite *TrustedDeviceRepositoryImplTestSuite) TestDeleteDevice() {
+	objID := suite.newUserObjID()
+	device := suite.newDevice(objID, "MacBook")
+
+	// 他のユーザーの端末は削除できないこと
+	deleted, err := suite.trustedDeviceRepo.DeleteDevice(suite.newUserObjID().Value(), device.ID())
+	suite.NoError(err)
+	suite.False(deleted)
+
+	deleted, err = suite.trustedDeviceRepo.DeleteDevice(objID.Value(), device.ID())
+	suite.NoError(err)
+	suite.True(deleted)
+	found, err := suite.trustedDeviceRepo.FindDevice(device.ID())
+	suite.NoError(err)
+	suite.Nil(found)
+
+	deleted, err = suite.trustedDeviceRepo.DeleteDevice(objID.Value(), device.ID())
+	suite.NoError(err)
+	suite.False(deleted, "削除済みの場合は false を返すこと")
+}
+
+// 他のテナントの信頼済み端末は取得・更新・削除できないこと
+func (suite *TrustedDeviceRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeRepo := NewTrustedDeviceRepository(database.WithTenant(suite.DB, "acme"))
+	globexRepo := NewTrustedDeviceRepository(database.WithTenant(suite.DB, "globex"))
+	objID := suite.newUserObjID()
+	device, err := entity.NewTrustedDevice(objID, "MacBook", 30*24*time.Hour)
+	suite.Require().NoError(err)
+	_, err = acmeRepo.CreateDevice(device)
+	suite.Require().NoError(err)
+
+	found, err := globexRepo.FindDevice(device.ID())
+	suite.NoError(err)
+	suite.Nil(found)
+	devices, err := globexRepo.ListDevices(objID.Value())
+	suite.NoError(err)
+	suite.Empty(devices)
+	suite.NoError(globexRepo.TouchDevice(device.ID(), time.Now()))
+	deleted, err := globexRepo.DeleteDevice(objID.Value(), device.ID())
+	suite.NoError(err)
+	suite.False(deleted)
+
+	found, err = acmeRepo.FindDevice(device.ID())
+	suite.NoError(err)
+	suite.Require().NotNil(found, "他のテナントからは削除されないこと")
+	suite.Nil(found.LastUsedAt(), "他のテナントからは更新されないこと")
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+)
+
+// emailKeyBackfillBatchSize は、BackfillUserEmailKeys で一度に読み込むユーザーの数
+const emailKeyBackfillBatchSize = 500
+
+// BackfillUserEmailKeys は、すべてのテナントのユーザーのメールアドレスと検索キー（email_key）を
+// アプリケーションと同じ正規化（ドメインの小文字化・IDN の Punycode 変換・emailKeys のルール）で作り直し、更新した件数を返す
+// SQL のマイグレーションでは IDN の変換やプロバイダー固有ルールを再現できないため、マイグレーションの適用後や
+// EMAIL_PROVIDER_RULES を切り替えた後に実行する。正規化できないアドレスや、作り直したキーが他のユーザーと重複する場合は
+// そのユーザーを更新せずに続け、最後にまとめてエラーを返す
+func BackfillUserEmailKeys(db *gorm.DB, emailKeys value.EmailKeyRules) (int, error) {
+	updated := 0
+	var failures []error
+	var users []models.User
+	result := db.Unscoped().Select("id", "tenant_id", "obj_id", "email", "email_key").
+		FindInBatches(&users, emailKeyBackfillBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, user := range users {
+				emailValue, err := value.NewUserEmail(user.Email)
+				if err != nil {
+					failures = append(failures, fmt.Errorf("%s <%s>: %w", user.ObjID, user.Email, err))
+					continue
+				}
+				email, key := emailValue.Value(), emailKeys.Key(emailValue)
+				if email == user.Email && key == user.EmailKey {
+					continue
+				}
+				err = db.Unscoped().Model(&models.User{}).Where("id = ?", user.ID).
+					UpdateColumns(map[string]any{"email": email, "email_key": key}).Error
+				if err != nil {
+					failures = append(failures, fmt.Errorf("%s <%s> -> %s: %w", user.ObjID, user.Email, key, err))
+					continue
+				}
+				updated++
+			}
+			return nil
+		})
+	if result.Error != nil {
+		return updated, errs.NewInfraError(fmt.Errorf("ユーザーの読み込みに失敗しました: %w", result.Error).Error())
+	}
+	if len(failures) > 0 {
+		return updated, errs.NewInfraError(fmt.Errorf("%d 件のユーザーのメールアドレスを正規化できませんでした:\n%w", len(failures), errors.Join(failures...)).Error())
+	}
+	return updated, nil
+}
@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+type UserEmailKeyBackfillTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestUserEmailKeyBackfillTestSuite(t *testing.T) {
+	suite.Run(t, new(UserEmailKeyBackfillTestSuite))
+}
+
+func (suite *UserEmailKeyBackfillTestSuite) SetupTest() {
+	suite.Require().NoError(suite.DB.Unscoped().Where("1 = 1").Delete(&models.User{}).Error)
+}
+
+// insertUser は、SQL のマイグレーション（lower(trim(email))）で作成されたキーを持つユーザーを直接登録する
+func (suite *UserEmailKeyBackfillTestSuite) insertUser(tenantID string, email string, emailKey string) *models.User {
+	user := &models.User{
+		ObjID:    uuid.NewString(),
+		TenantID: tenantID,
+		Email:    email,
+		EmailKey: emailKey,
+		Password: "hashed",
+		Username: "backfill",
+	}
+	suite.Require().NoError(suite.DB.Create(user).Error)
+	return user
+}
+
+func (suite *UserEmailKeyBackfillTestSuite) reload(user *models.User) *models.User {
+	var found models.User
+	suite.Require().NoError(suite.DB.Where("id = ?", user.ID).First(&found).Error)
+	return &found
+}
+
+// IDN のドメインを Punycode に変換し、アプリケーションの検索キーと一致させること
+func (suite *UserEmailKeyBackfillTestSuite) TestBackfill_IDN() {
+	idn := suite.insertUser("default", "Foo@Bücher.example", "foo@bücher.example")
+	ascii := suite.insertUser("default", "Bar@example.com", "bar@example.com")
+
+	updated, err := BackfillUserEmailKeys(suite.DB, value.EmailKeyRules{})
+	suite.Require().NoError(err)
+	suite.Equal(1, updated, "正規化済みのユーザーは更新しないこと")
+
+	found := suite.reload(idn)
+	suite.Equal("Foo@xn--bcher-kva.example", found.Email)
+	suite.Equal("foo@xn--bcher-kva.example", found.EmailKey)
+	suite.Equal("bar@example.com", suite.reload(ascii).EmailKey)
+
+	// 作り直したキーでアプリケーションから検索できること
+	user, err := NewUserRepository(suite.DB, value.EmailKeyRules{}).GetUserByEmail("FOO@bücher.example")
+	suite.Require().NoError(err)
+	suite.Equal(idn.ObjID, user.ObjID().Value())
+
+	updated, err = BackfillUserEmailKeys(suite.DB, value.EmailKeyRules{})
+	suite.NoError(err)
+	suite.Equal(0, updated, "繰り返し実行しても変わらないこと")
+}
+
+// プロバイダー固有ルールを適用し、重複するユーザーは更新せずにエラーを返すこと
+func (suite *UserEmailKeyBackfillTestSuite) TestBackfill_ProviderRulesCollision() {
+	first := suite.insertUser("default", "first.last@gmail.com", "first.last@gmail.com")
+	second := suite.insertUser("default", "firstlast+news@gmail.com", "firstlast+news@gmail.com")
+	other := suite.insertUser("acme", "first.last@gmail.com", "first.last@gmail.com")
+
+	updated, err := BackfillUserEmailKeys(suite.DB, value.EmailKeyRules{ProviderRules: true})
+	suite.Error(err)
+	suite.Contains(err.Error(), second.ObjID)
+	suite.Equal(2, updated)
+
+	suite.Equal("firstlast@gmail.com", suite.reload(first).EmailKey)
+	suite.Equal("firstlast+news@gmail.com", suite.reload(second).EmailKey, "重複するユーザーは更新しないこと")
+	suite.Equal("firstlast@gmail.com", suite.reload(other).EmailKey, "テナントが異なる場合は重複とみなさないこと")
+}
@@ -2,35 +2,66 @@ package repository
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 
+	"github.com/goda6565/nexus-user-auth/domain/event"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
 	"github.com/goda6565/nexus-user-auth/errs"
 	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
 	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
 )
 
 type UserRepositoryImpl struct {
-	db *gorm.DB
+	db        *gorm.DB
+	emailKeys value.EmailKeyRules // 検索・重複判定用のメールアドレスのキーの作り方
 }
 
-func NewUserRepository(db *gorm.DB) repository.UserRepository {
-	return &UserRepositoryImpl{db: db}
+func NewUserRepository(db *gorm.DB, emailKeys value.EmailKeyRules) repository.UserRepository {
+	return &UserRepositoryImpl{db: db, emailKeys: emailKeys}
+}
+
+// convert は、ドメインエンティティを永続化用モデルに変換し、メールアドレスのキーを設定したルールで作り直す
+func (r *UserRepositoryImpl) convert(user *entity.User) (*models.User, bool) {
+	converted, ok := adapter.NewUserAdapter().Convert(user).(*models.User)
+	if !ok {
+		return nil, false
+	}
+	converted.EmailKey = r.emailKeys.Key(user.Email())
+	return converted, true
 }
 
 func (r *UserRepositoryImpl) CreateUser(user *entity.User) (*entity.User, error) {
-	tx := r.db.Create(adapter.NewUserAdapter().Convert(user))
-	if tx.Error != nil {
-		return nil, errs.NewInfraError(fmt.Errorf("ユーザー作成に失敗しました: %w", tx.Error).Error())
+	converted, ok := r.convert(user)
+	if !ok {
+		return nil, errs.NewInfraError("変換されたモデルが *models.User ではありません。")
+	}
+	// ユーザーの作成とイベントの記録を同じトランザクションで行う
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(converted).Error; err != nil {
+			return err
+		}
+		return appendOutboxEvent(tx, event.TypeUserRegistered, user.ObjID().Value(), userPayload(user))
+	})
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー作成に失敗しました: %w", err).Error())
 	}
 	return user, nil
 }
 
 func (r *UserRepositoryImpl) GetUserByEmail(email string) (*entity.User, error) {
+	// 入力を正規化し、正規化済みのキーで検索する（大文字小文字などの表記揺れを同一視する）
+	emailValue, err := value.NewUserEmail(email)
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("メールアドレス(%s)の形式が正しくありません: %w", email, err).Error())
+	}
 	var modelUser models.User
-	tx := r.db.Where("email = ?", email).First(&modelUser)
+	tx := r.db.Preload("Roles").Where("email_key = ?", r.emailKeys.Key(emailValue)).First(&modelUser)
 	if tx.Error != nil {
 		return nil, errs.NewInfraError(fmt.Errorf("メールアドレス(%s)でのユーザー取得に失敗しました: %w", email, tx.Error).Error())
 	}
@@ -43,7 +74,7 @@ func (r *UserRepositoryImpl) GetUserByEmail(email string) (*entity.User, error)
 
 func (r *UserRepositoryImpl) GetUserByObjID(objID string) (*entity.User, error) {
 	var modelUser models.User
-	tx := r.db.Where("obj_id = ?", objID).First(&modelUser)
+	tx := r.db.Preload("Roles").Where("obj_id = ?", objID).First(&modelUser)
 	if tx.Error != nil {
 		return nil, errs.NewInfraError(fmt.Errorf("オブジェクトID(%s)でのユーザー取得に失敗しました: %w", objID, tx.Error).Error())
 	}
@@ -54,35 +85,98 @@ func (r *UserRepositoryImpl) GetUserByObjID(objID string) (*entity.User, error)
 	return user, nil
 }
 
+func (r *UserRepositoryImpl) ListUsers(filter repository.UserListFilter, limit int) ([]*entity.User, error) {
+	query := r.db.Model(&models.User{}).Preload("Roles")
+	if filter.Email != "" {
+		query = query.Where("LOWER(email) LIKE ? ESCAPE '\\'", "%"+likeEscaper.Replace(strings.ToLower(filter.Email))+"%")
+	}
+	if filter.Role != "" {
+		query = query.Where("obj_id IN (?)", r.db.Model(&models.UserRole{}).Select("user_obj_id").Where("role_name = ?", filter.Role))
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at < ?", *filter.CreatedTo)
+	}
+	if filter.After != nil {
+		// 作成日時が同じユーザーはユーザーIDの順に並べる
+		query = query.Where("created_at < ? OR (created_at = ? AND obj_id < ?)", filter.After.CreatedAt, filter.After.CreatedAt, filter.After.ObjID)
+	}
+
+	var modelUsers []models.User
+	if err := query.Order("created_at DESC, obj_id DESC").Limit(limit).Find(&modelUsers).Error; err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザーの一覧の取得に失敗しました: %w", err).Error())
+	}
+	users := make([]*entity.User, 0, len(modelUsers))
+	for i := range modelUsers {
+		user, err := adapter.NewUserAdapter().ReBuild(&modelUsers[i])
+		if err != nil {
+			return nil, errs.NewInfraError(fmt.Errorf("ユーザーエンティティの再構築に失敗しました: %w", err).Error())
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
 func (r *UserRepositoryImpl) UpdateUser(user *entity.User) (*entity.User, error) {
 	var modelUser models.User
-	tx := r.db.Where("obj_id = ?", user.ObjID().Value()).First(&modelUser)
+	tx := r.db.Preload("Roles").Where("obj_id = ?", user.ObjID().Value()).First(&modelUser)
 	if tx.Error != nil {
 		return nil, errs.NewInfraError(fmt.Errorf("ユーザー更新のための既存レコード取得に失敗しました: %w", tx.Error).Error())
 	}
 
 	// ドメインエンティティを永続化用モデルに変換
-	converted, ok := adapter.NewUserAdapter().Convert(user).(*models.User)
+	converted, ok := r.convert(user)
 	if !ok {
 		return nil, errs.NewInfraError("変換されたモデルが *models.User ではありません。")
 	}
 
 	// 既存の modelUser のフィールドを更新
+	before := modelUser
 	modelUser.Email = converted.Email
+	modelUser.EmailKey = converted.EmailKey
 	modelUser.Password = converted.Password
 	modelUser.Username = converted.Username
 	modelUser.AvatarURL = converted.AvatarURL
 	modelUser.EmailVerifiedAt = converted.EmailVerifiedAt
 	modelUser.LastLoginAt = converted.LastLoginAt
-	modelUser.Role = converted.Role
+	modelUser.Status = converted.Status
+	modelUser.StatusReason = converted.StatusReason
+	modelUser.StatusChangedAt = converted.StatusChangedAt
 
-	// 更新処理を実行
-	tx = r.db.Save(&modelUser)
-	if tx.Error != nil {
-		return nil, errs.NewInfraError(fmt.Errorf("ユーザー更新に失敗しました: %w", tx.Error).Error())
+	rolesChanged := !slices.Equal(userRoleNames(modelUser.Roles), userRoleNames(converted.Roles))
+	// 保存する値が変わらない場合はイベントを記録しない
+	changed := rolesChanged || userFieldsChanged(&before, &modelUser)
+
+	// 更新処理とイベントの記録を同じトランザクションで実行
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Roles").Save(&modelUser).Error; err != nil {
+			return err
+		}
+		if rolesChanged {
+			// ロールは変更された場合のみ置き換える
+			if err := tx.Unscoped().Where("user_obj_id = ?", modelUser.ObjID).Delete(&models.UserRole{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Create(&converted.Roles).Error; err != nil {
+				return err
+			}
+		}
+		if !changed {
+			return nil
+		}
+		return appendOutboxEvent(tx, event.TypeUserUpdated, user.ObjID().Value(), userPayload(user))
+	})
+	if err != nil {
+		return nil, errs.NewInfraError(fmt.Errorf("ユーザー更新に失敗しました: %w", err).Error())
 	}
 
 	// 更新後の永続化用モデルからドメインエンティティに再構築
+	modelUser.Roles = converted.Roles
 	updatedUser, err := adapter.NewUserAdapter().ReBuild(&modelUser)
 	if err != nil {
 		return nil, errs.NewInfraError(fmt.Errorf("ユーザーエンティティの再構築に失敗しました: %w", err).Error())
@@ -91,9 +185,83 @@ func (r *UserRepositoryImpl) UpdateUser(user *entity.User) (*entity.User, error)
 }
 
 func (r *UserRepositoryImpl) DeleteUser(objID string) error {
-	tx := r.db.Where("obj_id = ?", objID).Delete(&entity.User{})
-	if tx.Error != nil {
-		return errs.NewInfraError(fmt.Errorf("オブジェクトID(%s)のユーザー削除に失敗しました: %w", objID, tx.Error).Error())
+	// 削除処理とイベントの記録を同じトランザクションで実行（同じメールアドレスで登録し直せるよう物理削除する）
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("obj_id = ?", objID).Delete(&models.User{}).Error; err != nil {
+			return err
+		}
+		// 削除したユーザーはグループからも外す
+		if err := tx.Unscoped().Where("user_obj_id = ?", objID).Delete(&models.GroupMember{}).Error; err != nil {
+			return err
+		}
+		// 削除したユーザーの API キーは使えないようにする
+		if err := tx.Unscoped().Where("user_obj_id = ?", objID).Delete(&models.APIKey{}).Error; err != nil {
+			return err
+		}
+		// サービスアカウントの場合は、所有者などの情報も削除する
+		if err := tx.Unscoped().Where("user_obj_id = ?", objID).Delete(&models.ServiceAccount{}).Error; err != nil {
+			return err
+		}
+		// 組織からも外す（削除したユーザーがオーナーとして数えられ続けないようにする）
+		if err := tx.Unscoped().Where("user_obj_id = ?", objID).Delete(&models.OrganizationMember{}).Error; err != nil {
+			return err
+		}
+		// 二要素認証・パスキー・信頼済み端末・メールアドレスの変更の記録は、ユーザーがいなくなると使われないため削除する
+		for _, model := range []any{&models.TOTPFactor{}, &models.RecoveryCode{}, &models.PasskeyCredential{}, &models.PasskeySession{}, &models.TrustedDevice{}, &models.EmailChange{}} {
+			if err := tx.Unscoped().Where("user_obj_id = ?", objID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		return appendOutboxEvent(tx, event.TypeUserDeleted, objID, event.UserPayload{UID: objID})
+	})
+	if err != nil {
+		return errs.NewInfraError(fmt.Errorf("オブジェクトID(%s)のユーザー削除に失敗しました: %w", objID, err).Error())
 	}
 	return nil
 }
+
+// likeEscaper は LIKE のパターンで特別な意味を持つ文字をエスケープする
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// userFieldsChanged は、ユーザーの更新で保存するフィールドが変わったかどうかを返す
+func userFieldsChanged(before, after *models.User) bool {
+	return before.Email != after.Email ||
+		before.EmailKey != after.EmailKey ||
+		before.Password != after.Password ||
+		before.Username != after.Username ||
+		before.AvatarURL != after.AvatarURL ||
+		!sameTime(before.EmailVerifiedAt, after.EmailVerifiedAt) ||
+		!sameTime(before.LastLoginAt, after.LastLoginAt) ||
+		before.Status != after.Status ||
+		before.StatusReason != after.StatusReason ||
+		!sameTime(before.StatusChangedAt, after.StatusChangedAt)
+}
+
+// sameTime は、2 つの日時がどちらも未設定か、同じ時刻であるかを返す
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// userRoleNames はユーザーのロールの名前を名前順に返す
+func userRoleNames(roles []models.UserRole) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.RoleName)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// userPayload はユーザー関連イベントのペイロードを作成する
+func userPayload(user *entity.User) event.UserPayload {
+	return event.UserPayload{
+		UID:      user.ObjID().Value(),
+		Kind:     user.Kind().Value(),
+		Email:    user.Email().Value(),
+		Username: user.Username().Value(),
+		Status:   user.Status().Value(),
+	}
+}
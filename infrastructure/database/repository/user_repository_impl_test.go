@@ -1,13 +1,21 @@
 package repository_test
 
 import (
+	"slices"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/domain/user/repository"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
 	. "github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
 	"github.com/goda6565/nexus-user-auth/pkg/tester"
 	"github.com/goda6565/nexus-user-auth/pkg/utils"
@@ -24,7 +32,7 @@ func TestUserRepositoryImplTestSuite(t *testing.T) {
 
 func (suite *UserRepositoryImplTestSuite) SetupSuite() {
 	suite.DBSQLiteSuite.SetupSuite()
-	suite.userRepo = NewUserRepository(suite.DB)
+	suite.userRepo = NewUserRepository(suite.DB, value.EmailKeyRules{})
 }
 
 func (suite *UserRepositoryImplTestSuite) TestCreateUser() {
@@ -128,7 +136,12 @@ func (suite *UserRepositoryImplTestSuite) TestUpdateUser() {
 		createdUser.AvatarURL(),
 		createdUser.EmailVerifiedAt(),
 		createdUser.LastLoginAt(),
-		createdUser.Role(),
+		createdUser.Roles(),
+		createdUser.Status(),
+		createdUser.StatusReason(),
+		createdUser.StatusChangedAt(),
+		createdUser.CreatedAt(),
+		createdUser.Kind(),
 	)
 	suite.NoError(err)
 
@@ -141,6 +154,180 @@ func (suite *UserRepositoryImplTestSuite) TestUpdateUser() {
 	suite.NoError(utils.CheckPassword(resultUser.Password().Value(), "Password123!"), "更新後のユーザーのパスワードが一致すること")
 }
 
+func (suite *UserRepositoryImplTestSuite) TestUpdateUser_Status() {
+	email, err := value.NewUserEmail("status@example.com")
+	suite.NoError(err)
+	password, err := value.NewUserPassword("Password123!")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("statususer")
+	suite.NoError(err)
+	userEntity, err := entity.NewUser(email, password, username)
+	suite.NoError(err)
+	createdUser, err := suite.userRepo.CreateUser(userEntity)
+	suite.NoError(err)
+	suite.Equal(value.StatusPending, createdUser.Status().Value(), "作成直後は有効化待ちであること")
+
+	suspendedAt, err := timeobj.NewTimeObj(time.Now())
+	suite.NoError(err)
+	suite.NoError(createdUser.Suspend("規約違反", suspendedAt))
+	_, err = suite.userRepo.UpdateUser(createdUser)
+	suite.NoError(err)
+
+	found, err := suite.userRepo.GetUserByObjID(createdUser.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal(value.StatusSuspended, found.Status().Value(), "状態が保存されること")
+	suite.Equal("規約違反", found.StatusReason(), "理由が保存されること")
+	suite.Require().NotNil(found.StatusChangedAt())
+	suite.WithinDuration(suspendedAt.Value(), found.StatusChangedAt().Value(), time.Second, "変更日時が保存されること")
+}
+
+func (suite *UserRepositoryImplTestSuite) TestUpdateUser_Roles() {
+	email, err := value.NewUserEmail("roles@example.com")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("rolesuser")
+	suite.NoError(err)
+	userEntity, err := entity.NewUser(email, value.NoPassword(), username)
+	suite.NoError(err)
+	createdUser, err := suite.userRepo.CreateUser(userEntity)
+	suite.NoError(err)
+
+	found, err := suite.userRepo.GetUserByObjID(createdUser.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{value.RegularUser}, found.RoleNames(), "作成時のロールが保存されること")
+
+	// 複数のロールに置き換えられること
+	admin, err := value.NewUserRole(value.Admin)
+	suite.NoError(err)
+	support, err := value.NewUserRole("support")
+	suite.NoError(err)
+	suite.NoError(found.ChangeRoles([]*value.UserRole{support, admin}))
+	updated, err := suite.userRepo.UpdateUser(found)
+	suite.NoError(err)
+	suite.Equal([]string{value.Admin, "support"}, updated.RoleNames())
+
+	found, err = suite.userRepo.GetUserByEmail("roles@example.com")
+	suite.NoError(err)
+	suite.Equal([]string{value.Admin, "support"}, found.RoleNames(), "置き換えたロールが保存されること")
+
+	// ロール以外の更新ではロールが変わらないこと
+	suite.NoError(found.ChangeRoles([]*value.UserRole{support}))
+	_, err = suite.userRepo.UpdateUser(found)
+	suite.NoError(err)
+	renamed, err := value.NewUserUsername("renamed")
+	suite.NoError(err)
+	found.ChangeUsername(renamed)
+	_, err = suite.userRepo.UpdateUser(found)
+	suite.NoError(err)
+	found, err = suite.userRepo.GetUserByObjID(createdUser.ObjID().Value())
+	suite.NoError(err)
+	suite.Equal([]string{"support"}, found.RoleNames())
+
+	// 一覧はいずれかのロールで絞り込めること
+	users, err := suite.userRepo.ListUsers(repository.UserListFilter{Role: "support", Email: "roles@"}, 10)
+	suite.NoError(err)
+	suite.Equal([]string{createdUser.ObjID().Value()}, objIDs(users))
+	suite.Equal([]string{"support"}, users[0].RoleNames(), "一覧でもロールを取得すること")
+	users, err = suite.userRepo.ListUsers(repository.UserListFilter{Role: value.Admin, Email: "roles@"}, 10)
+	suite.NoError(err)
+	suite.Empty(users, "取り除いたロールでは一致しないこと")
+}
+
+// createListedUser は作成日時と状態を指定してユーザーを登録する
+func (suite *UserRepositoryImplTestSuite) createListedUser(address string, role string, status string, createdAt time.Time) *entity.User {
+	email, err := value.NewUserEmail(address)
+	suite.Require().NoError(err)
+	username, err := value.NewUserUsername("listuser")
+	suite.Require().NoError(err)
+	roleValue, err := value.NewUserRole(role)
+	suite.Require().NoError(err)
+	statusValue, err := value.NewUserStatus(status)
+	suite.Require().NoError(err)
+	created, err := timeobj.NewTimeObj(createdAt)
+	suite.Require().NoError(err)
+	objID, err := value.NewUserObjID(uuid.NewString())
+	suite.Require().NoError(err)
+	user, err := entity.BuildUser(objID, email, value.FromHashed("hashed"), username, nil, nil, nil, []*value.UserRole{roleValue}, statusValue, "", created, created, nil)
+	suite.Require().NoError(err)
+	_, err = suite.userRepo.CreateUser(user)
+	suite.Require().NoError(err)
+	return user
+}
+
+// objIDs はユーザーIDの一覧を返す
+func objIDs(users []*entity.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ObjID().Value())
+	}
+	return ids
+}
+
+func (suite *UserRepositoryImplTestSuite) TestListUsers() {
+	base := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldest := suite.createListedUser("List-Oldest@example.com", value.RegularUser, value.StatusActive, base)
+	admin := suite.createListedUser("list-admin@example.com", value.Admin, value.StatusActive, base.Add(time.Hour))
+	suspended := suite.createListedUser("list-suspended@example.com", value.RegularUser, value.StatusSuspended, base.Add(2*time.Hour))
+	newest := suite.createListedUser("list-newest@example.com", value.RegularUser, value.StatusActive, base.Add(3*time.Hour))
+	from, until := base, base.Add(4*time.Hour)
+
+	// 作成日時の新しい順に取得すること（大文字小文字を区別せずにメールアドレスの部分一致で絞り込む）
+	users, err := suite.userRepo.ListUsers(repository.UserListFilter{Email: "LIST-", CreatedFrom: &from, CreatedTo: &until}, 10)
+	suite.NoError(err)
+	suite.Equal([]string{newest.ObjID().Value(), suspended.ObjID().Value(), admin.ObjID().Value(), oldest.ObjID().Value()}, objIDs(users))
+	suite.Equal(base.Add(3*time.Hour), users[0].CreatedAt().Value().UTC(), "作成日時が保存されること")
+
+	// ロール・状態・作成日時で絞り込めること
+	users, err = suite.userRepo.ListUsers(repository.UserListFilter{Role: value.Admin, CreatedFrom: &from, CreatedTo: &until}, 10)
+	suite.NoError(err)
+	suite.Equal([]string{admin.ObjID().Value()}, objIDs(users))
+	users, err = suite.userRepo.ListUsers(repository.UserListFilter{Status: value.StatusSuspended, CreatedFrom: &from, CreatedTo: &until}, 10)
+	suite.NoError(err)
+	suite.Equal([]string{suspended.ObjID().Value()}, objIDs(users))
+	to := base.Add(2 * time.Hour)
+	users, err = suite.userRepo.ListUsers(repository.UserListFilter{CreatedFrom: &from, CreatedTo: &to}, 10)
+	suite.NoError(err)
+	suite.Equal([]string{admin.ObjID().Value(), oldest.ObjID().Value()}, objIDs(users), "終了日時ちょうどに作成したユーザーは含めないこと")
+
+	// 前のページの最後のユーザーより後から取得すること
+	users, err = suite.userRepo.ListUsers(repository.UserListFilter{CreatedFrom: &from, CreatedTo: &until}, 2)
+	suite.NoError(err)
+	suite.Equal([]string{newest.ObjID().Value(), suspended.ObjID().Value()}, objIDs(users))
+	last := users[len(users)-1]
+	users, err = suite.userRepo.ListUsers(repository.UserListFilter{CreatedFrom: &from, CreatedTo: &until, After: &repository.UserCursor{CreatedAt: last.CreatedAt().Value(), ObjID: last.ObjID().Value()}}, 2)
+	suite.NoError(err)
+	suite.Equal([]string{admin.ObjID().Value(), oldest.ObjID().Value()}, objIDs(users))
+}
+
+func (suite *UserRepositoryImplTestSuite) TestListUsers_SameCreatedAt() {
+	createdAt := time.Date(2002, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := suite.createListedUser("same-a@example.com", value.RegularUser, value.StatusActive, createdAt)
+	second := suite.createListedUser("same-b@example.com", value.RegularUser, value.StatusActive, createdAt)
+	from, until := createdAt, createdAt.Add(time.Second)
+	expected := []string{first.ObjID().Value(), second.ObjID().Value()}
+	slices.Sort(expected)
+	slices.Reverse(expected)
+
+	// 作成日時が同じユーザーもページをまたいで漏れなく取得できること
+	page1, err := suite.userRepo.ListUsers(repository.UserListFilter{CreatedFrom: &from, CreatedTo: &until}, 1)
+	suite.NoError(err)
+	suite.Equal(expected[:1], objIDs(page1))
+	page2, err := suite.userRepo.ListUsers(repository.UserListFilter{CreatedFrom: &from, CreatedTo: &until, After: &repository.UserCursor{CreatedAt: page1[0].CreatedAt().Value(), ObjID: page1[0].ObjID().Value()}}, 1)
+	suite.NoError(err)
+	suite.Equal(expected[1:], objIDs(page2))
+}
+
+func (suite *UserRepositoryImplTestSuite) TestListUsers_EscapesEmailPattern() {
+	underscore := suite.createListedUser("under_score@example.com", value.RegularUser, value.StatusActive, time.Now())
+	suite.createListedUser("underxscore@example.com", value.RegularUser, value.StatusActive, time.Now())
+
+	users, err := suite.userRepo.ListUsers(repository.UserListFilter{Email: "under_"}, 10)
+	suite.NoError(err)
+	suite.Equal([]string{underscore.ObjID().Value()}, objIDs(users), "_ はワイルドカードとして扱わないこと")
+	users, err = suite.userRepo.ListUsers(repository.UserListFilter{Email: "under%"}, 10)
+	suite.NoError(err)
+	suite.Empty(users, "% はワイルドカードとして扱わないこと")
+}
+
 func (suite *UserRepositoryImplTestSuite) TestDeleteUser() {
 	// ユーザー作成
 	email, err := value.NewUserEmail("delete@example.com")
@@ -155,6 +342,16 @@ func (suite *UserRepositoryImplTestSuite) TestDeleteUser() {
 
 	createdUser, err := suite.userRepo.CreateUser(userEntity)
 	suite.NoError(err)
+	suite.NoError(suite.DB.Create(&models.GroupMember{GroupObjID: "22222222-2222-2222-2222-222222222222", UserObjID: createdUser.ObjID().Value()}).Error)
+	suite.NoError(suite.DB.Create(&models.APIKey{ObjID: "33333333-3333-3333-3333-333333333333", UserObjID: createdUser.ObjID().Value(), Name: "CI", Prefix: "nxk_abcdefgh", SecretHash: "hash"}).Error)
+	userObjID := createdUser.ObjID().Value()
+	suite.NoError(suite.DB.Create(&models.OrganizationMember{OrgObjID: "44444444-4444-4444-4444-444444444444", UserObjID: userObjID, Role: "owner"}).Error)
+	suite.NoError(suite.DB.Create(&models.TOTPFactor{UserObjID: userObjID, Secret: "secret"}).Error)
+	suite.NoError(suite.DB.Create(&models.RecoveryCode{ObjID: "55555555-5555-5555-5555-555555555555", UserObjID: userObjID, CodeHash: "hash"}).Error)
+	suite.NoError(suite.DB.Create(&models.PasskeyCredential{ObjID: "66666666-6666-6666-6666-666666666666", UserObjID: userObjID, CredentialID: []byte("credential"), PublicKey: []byte("key")}).Error)
+	suite.NoError(suite.DB.Create(&models.PasskeySession{ObjID: "77777777-7777-7777-7777-777777777777", UserObjID: &userObjID, Ceremony: "registration", Data: "{}", ExpiresAt: time.Now().Add(time.Minute)}).Error)
+	suite.NoError(suite.DB.Create(&models.TrustedDevice{ObjID: "88888888-8888-8888-8888-888888888888", UserObjID: userObjID, ExpiresAt: time.Now().Add(time.Hour)}).Error)
+	suite.NoError(suite.DB.Create(&models.EmailChange{ObjID: "99999999-9999-9999-9999-999999999999", UserObjID: userObjID, OldEmail: "delete@example.com", NewEmail: "new@example.com", Status: "pending", ExpiresAt: time.Now().Add(time.Hour)}).Error)
 
 	// ユーザー削除
 	err = suite.userRepo.DeleteUser(createdUser.ObjID().Value())
@@ -164,4 +361,224 @@ func (suite *UserRepositoryImplTestSuite) TestDeleteUser() {
 	deletedUser, err := suite.userRepo.GetUserByObjID(createdUser.ObjID().Value())
 	suite.Error(err, "削除されたユーザーは取得できないはず")
 	suite.Nil(deletedUser, "削除されたユーザーはnilであるはず")
+
+	var memberCount int64
+	suite.NoError(suite.DB.Unscoped().Model(&models.GroupMember{}).Where("user_obj_id = ?", createdUser.ObjID().Value()).Count(&memberCount).Error)
+	suite.Zero(memberCount, "削除されたユーザーはグループからも外れるはず")
+
+	var apiKeyCount int64
+	suite.NoError(suite.DB.Unscoped().Model(&models.APIKey{}).Where("user_obj_id = ?", createdUser.ObjID().Value()).Count(&apiKeyCount).Error)
+	suite.Zero(apiKeyCount, "削除されたユーザーの API キーも削除されるはず")
+
+	// 組織のメンバー・二要素認証・パスキー・信頼済み端末・メールアドレスの変更の記録も残らないこと
+	for _, model := range []any{&models.OrganizationMember{}, &models.TOTPFactor{}, &models.RecoveryCode{}, &models.PasskeyCredential{}, &models.PasskeySession{}, &models.TrustedDevice{}, &models.EmailChange{}} {
+		var count int64
+		suite.NoError(suite.DB.Unscoped().Model(model).Where("user_obj_id = ?", userObjID).Count(&count).Error)
+		suite.Zero(count, "%T が削除されるはず", model)
+	}
+}
+
+// ユーザーの作成・更新・削除と同時にアウトボックスにイベントが記録されること
+func (suite *UserRepositoryImplTestSuite) TestUserChangesAppendOutboxEvents() {
+	email, err := value.NewUserEmail("outbox@example.com")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("outboxuser")
+	suite.NoError(err)
+	userEntity, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	objID := userEntity.ObjID().Value()
+
+	_, err = suite.userRepo.CreateUser(userEntity)
+	suite.NoError(err)
+	renamed, err := value.NewUserUsername("renamed")
+	suite.NoError(err)
+	userEntity.ChangeUsername(renamed)
+	_, err = suite.userRepo.UpdateUser(userEntity)
+	suite.NoError(err)
+	suite.NoError(suite.userRepo.DeleteUser(objID))
+
+	var events []models.OutboxEvent
+	suite.NoError(suite.DB.Where("aggregate_id = ?", objID).Order("id").Find(&events).Error)
+	suite.Require().Len(events, 3)
+	suite.Equal(string(event.TypeUserRegistered), events[0].EventType)
+	suite.Equal(string(event.TypeUserUpdated), events[1].EventType)
+	suite.Equal(string(event.TypeUserDeleted), events[2].EventType)
+	suite.Equal(models.OutboxStatusPending, events[0].Status)
+	suite.Contains(events[0].Payload, "outbox@example.com")
+}
+
+// 保存する値が変わらない更新ではイベントを記録しないこと
+func (suite *UserRepositoryImplTestSuite) TestUpdateUser_UnchangedSkipsOutboxEvent() {
+	email, err := value.NewUserEmail("unchanged@example.com")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("unchanged")
+	suite.NoError(err)
+	userEntity, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	_, err = suite.userRepo.CreateUser(userEntity)
+	suite.NoError(err)
+
+	found, err := suite.userRepo.GetUserByObjID(userEntity.ObjID().Value())
+	suite.Require().NoError(err)
+	_, err = suite.userRepo.UpdateUser(found)
+	suite.NoError(err)
+
+	var count int64
+	suite.NoError(suite.DB.Model(&models.OutboxEvent{}).
+		Where("aggregate_id = ? AND event_type = ?", userEntity.ObjID().Value(), string(event.TypeUserUpdated)).Count(&count).Error)
+	suite.Zero(count)
+}
+
+// ユーザーの作成に失敗した場合はイベントも記録されないこと
+func (suite *UserRepositoryImplTestSuite) TestCreateUser_RollbackOutboxEvent() {
+	email, err := value.NewUserEmail("duplicate@example.com")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("duplicate")
+	suite.NoError(err)
+	first, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	second, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+
+	_, err = suite.userRepo.CreateUser(first)
+	suite.NoError(err)
+	_, err = suite.userRepo.CreateUser(second)
+	suite.Error(err, "メールアドレスが重複するユーザーは作成できない")
+
+	var count int64
+	suite.NoError(suite.DB.Model(&models.OutboxEvent{}).Where("aggregate_id = ?", second.ObjID().Value()).Count(&count).Error)
+	suite.Equal(int64(0), count)
+}
+
+// 大文字小文字や前後の空白の違いがあっても同じユーザーを取得できること
+func (suite *UserRepositoryImplTestSuite) TestGetUserByEmail_CaseInsensitive() {
+	email, err := value.NewUserEmail("Mixed.Case@Example.com")
+	suite.NoError(err)
+	username, err := value.NewUserUsername("mixedcase")
+	suite.NoError(err)
+	userEntity, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	_, err = suite.userRepo.CreateUser(userEntity)
+	suite.NoError(err)
+
+	found, err := suite.userRepo.GetUserByEmail(" mixed.case@EXAMPLE.COM ")
+	suite.NoError(err)
+	suite.Equal(userEntity.ObjID().Value(), found.ObjID().Value())
+	suite.Equal("Mixed.Case@example.com", found.Email().Value(), "入力された表記で保持されること")
+
+	_, err = suite.userRepo.GetUserByEmail("not-an-email")
+	suite.Error(err)
+}
+
+// 正規化すると同じになるメールアドレスでは別のユーザーを作成できないこと
+func (suite *UserRepositoryImplTestSuite) TestCreateUser_DuplicateNormalizedEmail() {
+	username, err := value.NewUserUsername("dupcase")
+	suite.NoError(err)
+
+	first, err := value.NewUserEmail("dup.case@example.com")
+	suite.NoError(err)
+	firstUser, err := entity.NewUser(first, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	_, err = suite.userRepo.CreateUser(firstUser)
+	suite.NoError(err)
+
+	second, err := value.NewUserEmail("DUP.CASE@example.com")
+	suite.NoError(err)
+	secondUser, err := entity.NewUser(second, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	_, err = suite.userRepo.CreateUser(secondUser)
+	suite.Error(err, "大文字小文字だけが異なるメールアドレスは重複とみなす")
+}
+
+// プロバイダー固有ルールを適用する場合は、同じメールボックスを指すアドレスで取得でき、重複とみなすこと
+func (suite *UserRepositoryImplTestSuite) TestProviderRules() {
+	repo := NewUserRepository(suite.DB, value.EmailKeyRules{ProviderRules: true})
+	username, err := value.NewUserUsername("provider")
+	suite.NoError(err)
+
+	email, err := value.NewUserEmail("provider.rules@gmail.com")
+	suite.NoError(err)
+	userEntity, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	_, err = repo.CreateUser(userEntity)
+	suite.Require().NoError(err)
+
+	found, err := repo.GetUserByEmail("ProviderRules+news@googlemail.com")
+	suite.Require().NoError(err)
+	suite.Equal(userEntity.ObjID().Value(), found.ObjID().Value())
+
+	_, err = suite.userRepo.GetUserByEmail("ProviderRules+news@googlemail.com")
+	suite.Error(err, "ルールを適用しない場合は別のアドレスとみなす")
+
+	other, err := value.NewUserEmail("providerrules@gmail.com")
+	suite.NoError(err)
+	otherUser, err := entity.NewUser(other, value.FromHashed("hashed"), username)
+	suite.NoError(err)
+	_, err = repo.CreateUser(otherUser)
+	suite.Error(err, "ドットだけが異なる Gmail のアドレスは重複とみなす")
+}
+
+// テナントごとのリポジトリは他のテナントのユーザーを取得・更新・削除できないこと
+func (suite *UserRepositoryImplTestSuite) TestTenantIsolation() {
+	acmeRepo := NewUserRepository(database.WithTenant(suite.DB, "acme"), value.EmailKeyRules{})
+	globexRepo := NewUserRepository(database.WithTenant(suite.DB, "globex"), value.EmailKeyRules{})
+	newUser := func(name string) *entity.User {
+		email, err := value.NewUserEmail("tenant.user@example.com")
+		suite.Require().NoError(err)
+		username, err := value.NewUserUsername(name)
+		suite.Require().NoError(err)
+		user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+		suite.Require().NoError(err)
+		return user
+	}
+
+	// 同じメールアドレスでもテナントが異なれば作成できる（同じテナントでは作成できない）
+	acmeUser := newUser("acme")
+	_, err := acmeRepo.CreateUser(acmeUser)
+	suite.Require().NoError(err)
+	globexUser := newUser("globex")
+	_, err = globexRepo.CreateUser(globexUser)
+	suite.Require().NoError(err)
+	_, err = acmeRepo.CreateUser(newUser("acme2"))
+	suite.Error(err, "同じテナントでは同じメールアドレスのユーザーを作成できないこと")
+
+	var stored models.User
+	suite.Require().NoError(suite.DB.Where("obj_id = ?", acmeUser.ObjID().Value()).First(&stored).Error)
+	suite.Equal("acme", stored.TenantID, "作成したユーザーはテナントに属すること")
+
+	// メールアドレスではそのテナントのユーザーのみ取得できる
+	found, err := acmeRepo.GetUserByEmail("tenant.user@example.com")
+	suite.Require().NoError(err)
+	suite.Equal(acmeUser.ObjID().Value(), found.ObjID().Value())
+	found, err = globexRepo.GetUserByEmail("tenant.user@example.com")
+	suite.Require().NoError(err)
+	suite.Equal(globexUser.ObjID().Value(), found.ObjID().Value())
+	_, err = NewUserRepository(database.WithTenant(suite.DB, "initech"), value.EmailKeyRules{}).GetUserByEmail("tenant.user@example.com")
+	suite.Error(err)
+
+	// 他のテナントのユーザーはIDを指定しても取得できない
+	_, err = acmeRepo.GetUserByObjID(globexUser.ObjID().Value())
+	suite.Error(err)
+
+	// 一覧にはそのテナントのユーザーのみを含む
+	users, err := acmeRepo.ListUsers(repository.UserListFilter{}, 100)
+	suite.NoError(err)
+	suite.Require().Len(users, 1)
+	suite.Equal(acmeUser.ObjID().Value(), users[0].ObjID().Value())
+
+	// 他のテナントのユーザーは更新・削除できない
+	username, err := value.NewUserUsername("hijacked")
+	suite.Require().NoError(err)
+	globexUser.ChangeUsername(username)
+	_, err = acmeRepo.UpdateUser(globexUser)
+	suite.Error(err)
+	suite.NoError(acmeRepo.DeleteUser(globexUser.ObjID().Value()))
+	found, err = globexRepo.GetUserByObjID(globexUser.ObjID().Value())
+	suite.Require().NoError(err, "他のテナントのリポジトリからは削除されないこと")
+	suite.Equal("globex", found.Username().Value())
+
+	// 記録したイベントはユーザーのテナントに属する
+	var outboxEvent models.OutboxEvent
+	suite.Require().NoError(suite.DB.Where("aggregate_id = ?", globexUser.ObjID().Value()).First(&outboxEvent).Error)
+	suite.Equal("globex", outboxEvent.TenantID)
 }
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/goda6565/nexus-user-auth/pkg/tenant"
+)
+
+// tenantField は、テナントごとにデータを分けるモデルが持つフィールドの名前
+// このフィールドを持たないモデル（ユーザーのロール・グループのメンバーなど）は、テナントで絞り込んだ親のデータを通してのみ参照する
+const tenantField = "TenantID"
+
+// WithTenant は、操作の対象をテナントのデータに限定した接続を返します。
+// TenantID フィールドを持つモデルの検索・更新・削除はテナントのデータに絞り込まれ、作成したデータはテナントに属します。
+func WithTenant(db *gorm.DB, tenantID string) *gorm.DB {
+	return db.WithContext(tenant.NewContext(db.Statement.Context, tenantID))
+}
+
+// registerTenantScope は、WithTenant で指定したテナントにデータを限定するコールバックを登録する
+// テナントを指定しない接続（テナントをまたいで処理するアウトボックスのリレーなど）では絞り込まない
+func registerTenantScope(db *gorm.DB) error {
+	callbacks := []struct {
+		register func(name string, fn func(*gorm.DB)) error
+		fn       func(*gorm.DB)
+	}{
+		{db.Callback().Create().Before("gorm:create").Register, assignTenant},
+		{db.Callback().Query().Before("gorm:query").Register, scopeTenant},
+		{db.Callback().Update().Before("gorm:update").Register, scopeTenant},
+		{db.Callback().Delete().Before("gorm:delete").Register, scopeTenant},
+		{db.Callback().Row().Before("gorm:row").Register, scopeTenant},
+	}
+	for _, callback := range callbacks {
+		if err := callback.register("tenant:scope", callback.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tenantOf は、接続に指定されたテナントと、モデルのテナントのフィールドを返す
+func tenantOf(db *gorm.DB) (string, *schema.Field, bool) {
+	tenantID, ok := tenant.FromContext(db.Statement.Context)
+	if !ok || db.Statement.Schema == nil {
+		return "", nil, false
+	}
+	field := db.Statement.Schema.LookUpField(tenantField)
+	return tenantID, field, field != nil
+}
+
+// scopeTenant は、検索・更新・削除の条件にテナントを加える
+func scopeTenant(db *gorm.DB) {
+	tenantID, field, ok := tenantOf(db)
+	if !ok {
+		return
+	}
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: tenantID},
+	}})
+}
+
+// assignTenant は、作成するデータをテナントに属させる
+func assignTenant(db *gorm.DB) {
+	tenantID, field, ok := tenantOf(db)
+	if !ok {
+		return
+	}
+	ctx := db.Statement.Context
+	switch value := db.Statement.ReflectValue; value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := field.Set(ctx, reflect.Indirect(value.Index(i)), tenantID); err != nil {
+				db.AddError(err)
+				return
+			}
+		}
+	case reflect.Struct:
+		if err := field.Set(ctx, value, tenantID); err != nil {
+			db.AddError(err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	PollInterval time.Duration // 未配信イベントを確認する間隔
+	BatchSize    int           // 1回に処理するイベントの最大数
+	MaxAttempts  int           // 配信を断念するまでの最大試行回数
+	Backoff      time.Duration // 初回リトライまでの待機時間（以降は倍々に伸ばす）
+	MaxBackoff   time.Duration // 待機時間の上限
+	ClaimTimeout time.Duration // 取得したイベントをほかのリレーが取得しない時間（配信にかかる時間より長くする）
+	WebhookURL   string        // 設定されている場合、すべてのイベントを POST で通知する
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		PollInterval: utils.GetEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+		BatchSize:    utils.GetEnvInt("OUTBOX_BATCH_SIZE", 100),
+		MaxAttempts:  utils.GetEnvInt("OUTBOX_MAX_ATTEMPTS", 10),
+		Backoff:      utils.GetEnvDuration("OUTBOX_BACKOFF", 5*time.Second),
+		MaxBackoff:   utils.GetEnvDuration("OUTBOX_MAX_BACKOFF", time.Hour),
+		ClaimTimeout: utils.GetEnvDuration("OUTBOX_CLAIM_TIMEOUT", 5*time.Minute),
+		WebhookURL:   utils.GetEnvDefault("OUTBOX_WEBHOOK_URL", ""),
+	}
+}
@@ -0,0 +1,194 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+// Relay は、アウトボックスに記録された未配信イベントを登録された Publisher に配信する
+// 配信に成功したイベントは配信済みとし、失敗したイベントはバックオフを挟んで再試行する
+type Relay struct {
+	db     *gorm.DB
+	config *Config
+	now    func() time.Time
+
+	mu         sync.RWMutex
+	publishers map[event.Type][]event.Publisher
+}
+
+// NewRelay は Relay のインスタンスを作成
+func NewRelay(db *gorm.DB, config *Config) *Relay {
+	return &Relay{
+		db:         db,
+		config:     config,
+		now:        time.Now,
+		publishers: make(map[event.Type][]event.Publisher),
+	}
+}
+
+// Register は eventType のイベントの配信先を登録する（event.TypeAll の場合はすべてのイベント）
+func (r *Relay) Register(eventType event.Type, publisher event.Publisher) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.publishers[eventType] = append(r.publishers[eventType], publisher)
+}
+
+// Run は ctx がキャンセルされるまで、一定間隔で未配信イベントを配信する
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		// 1バッチ分を処理しきった場合は、待たずに次のバッチを処理する
+		n, err := r.ProcessBatch()
+		if err != nil {
+			logger.Error("failed to process outbox events", "error", err.Error())
+		}
+		if err == nil && n >= r.config.BatchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch は配信時刻を迎えた未配信イベントを最大 BatchSize 件配信し、処理した件数を返す
+// 配信先への送信はトランザクションの外で行い、送信を待つ間に行ロックやデータベースの接続を保持しない
+func (r *Relay) ProcessBatch() (int, error) {
+	entries, err := r.claim()
+	if err != nil {
+		return 0, errs.NewInfraError(fmt.Errorf("アウトボックスのイベントの取得に失敗しました: %w", err).Error())
+	}
+
+	processed := 0
+	var errList []error
+	for i := range entries {
+		r.deliver(&entries[i])
+		if err := r.complete(&entries[i]); err != nil {
+			// 保存できなかったイベントは、取得の期限が過ぎた後に再び配信される
+			errList = append(errList, fmt.Errorf("%s: %w", entries[i].ObjID, err))
+			continue
+		}
+		processed++
+	}
+	if len(errList) > 0 {
+		return processed, errs.NewInfraError(fmt.Errorf("アウトボックスの配信結果の保存に失敗しました: %w", errors.Join(errList...)).Error())
+	}
+	return processed, nil
+}
+
+// claim は配信時刻を迎えた未配信イベントを最大 BatchSize 件取得し、ClaimTimeout の間はほかのリレーが取得しないよう
+// 配信時刻を先送りしてコミットする（配信中にプロセスが停止した場合は、期限が過ぎた後に再び配信される）
+func (r *Relay) claim() ([]models.OutboxEvent, error) {
+	var entries []models.OutboxEvent
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := r.now()
+		query := tx.Where("status = ? AND next_attempt_at <= ?", models.OutboxStatusPending, now).
+			Order("id").
+			Limit(r.config.BatchSize)
+		// 複数インスタンスで動かしても同じイベントを同時に取得しないよう行ロックを取る
+		if tx.Dialector.Name() == "postgres" {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+		if err := query.Find(&entries).Error; err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, 0, len(entries))
+		for _, entry := range entries {
+			ids = append(ids, entry.ID)
+		}
+		return tx.Model(&models.OutboxEvent{}).Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(r.config.ClaimTimeout)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// complete は、配信の結果（deliver で更新した entry の状態）を保存する
+func (r *Relay) complete(entry *models.OutboxEvent) error {
+	return r.db.Model(&models.OutboxEvent{}).Where("id = ?", entry.ID).Updates(map[string]any{
+		"status":          entry.Status,
+		"attempts":        entry.Attempts,
+		"next_attempt_at": entry.NextAttemptAt,
+		"last_error":      entry.LastError,
+		"delivered_at":    entry.DeliveredAt,
+	}).Error
+}
+
+// deliver はイベントを配信し、結果に応じて entry の状態を更新する
+func (r *Relay) deliver(entry *models.OutboxEvent) {
+	e, err := adapter.NewOutboxEventAdapter().ReBuild(entry)
+	if err == nil {
+		err = r.publish(e)
+	}
+
+	now := r.now()
+	entry.Attempts++
+	if err == nil {
+		entry.Status = models.OutboxStatusDelivered
+		entry.DeliveredAt = &now
+		entry.LastError = ""
+		return
+	}
+
+	entry.LastError = err.Error()
+	if entry.Attempts >= r.config.MaxAttempts {
+		entry.Status = models.OutboxStatusFailed
+		logger.Error("outbox event delivery gave up", "id", entry.ObjID, "type", entry.EventType, "attempts", entry.Attempts, "error", err.Error())
+		return
+	}
+	entry.NextAttemptAt = now.Add(r.backoff(entry.Attempts))
+	logger.Warn("outbox event delivery failed, retrying", "id", entry.ObjID, "type", entry.EventType, "attempt", entry.Attempts, "error", err.Error())
+}
+
+// publish は登録されたすべての配信先に配信する
+// いずれかが失敗した場合はイベント全体を再試行するため、成功済みの配信先にも再度配信される
+func (r *Relay) publish(e *event.Event) error {
+	r.mu.RLock()
+	publishers := append(append([]event.Publisher(nil), r.publishers[e.Type]...), r.publishers[event.TypeAll]...)
+	r.mu.RUnlock()
+
+	var errList []error
+	for _, publisher := range publishers {
+		if err := publisher.Publish(e); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// backoff は attempts 回目の失敗後に待つ時間を返す
+func (r *Relay) backoff(attempts int) time.Duration {
+	d := r.config.Backoff
+	for i := 1; i < attempts && d < r.config.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > r.config.MaxBackoff {
+		d = r.config.MaxBackoff
+	}
+	return d
+}
@@ -0,0 +1,272 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/adapter"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database/models"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/outbox"
+	"github.com/goda6565/nexus-user-auth/pkg/tester"
+)
+
+// recordingPublisher は受け取ったイベントを記録し、failures 回だけ失敗する
+type recordingPublisher struct {
+	mu       sync.Mutex
+	failures int
+	events   []*event.Event
+}
+
+func (p *recordingPublisher) Publish(e *event.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("publish failed")
+	}
+	p.events = append(p.events, e)
+	return nil
+}
+
+func (p *recordingPublisher) received() []*event.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*event.Event(nil), p.events...)
+}
+
+type RelayTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
+
+func (suite *RelayTestSuite) SetupTest() {
+	suite.NoError(suite.DB.Unscoped().Where("1 = 1").Delete(&models.OutboxEvent{}).Error)
+}
+
+func newTestConfig() *Config {
+	return &Config{PollInterval: 10 * time.Millisecond, BatchSize: 10, MaxAttempts: 3, Backoff: 0, MaxBackoff: 0, ClaimTimeout: time.Minute}
+}
+
+// appendEvent はアウトボックスにイベントを記録する
+func (suite *RelayTestSuite) appendEvent(eventType event.Type, aggregateID string) *event.Event {
+	e, err := event.NewEvent(eventType, aggregateID, event.UserPayload{UID: aggregateID})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.DB.Create(adapter.NewOutboxEventAdapter().Convert(e)).Error)
+	return e
+}
+
+func (suite *RelayTestSuite) findEntry(id string) *models.OutboxEvent {
+	var entry models.OutboxEvent
+	suite.Require().NoError(suite.DB.Where("obj_id = ?", id).First(&entry).Error)
+	return &entry
+}
+
+// 登録された種類のイベントが配信され、配信済みになること
+func (suite *RelayTestSuite) TestProcessBatch_Delivers() {
+	relay := NewRelay(suite.DB, newTestConfig())
+	registered := &recordingPublisher{}
+	all := &recordingPublisher{}
+	relay.Register(event.TypeUserRegistered, registered)
+	relay.Register(event.TypeAll, all)
+
+	e1 := suite.appendEvent(event.TypeUserRegistered, "uid-1")
+	e2 := suite.appendEvent(event.TypeUserDeleted, "uid-2")
+
+	n, err := relay.ProcessBatch()
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	suite.Require().Len(registered.received(), 1)
+	suite.Equal(e1.ID, registered.received()[0].ID)
+	suite.Equal(e1.Payload, registered.received()[0].Payload)
+	suite.Len(all.received(), 2, "TypeAll の配信先にはすべてのイベントが届くこと")
+
+	for _, id := range []string{e1.ID, e2.ID} {
+		entry := suite.findEntry(id)
+		suite.Equal(models.OutboxStatusDelivered, entry.Status)
+		suite.NotNil(entry.DeliveredAt)
+		suite.Equal(1, entry.Attempts)
+	}
+
+	// 配信済みのイベントは再度配信されないこと
+	n, err = relay.ProcessBatch()
+	suite.NoError(err)
+	suite.Equal(0, n)
+}
+
+// 配信に失敗したイベントは再試行され、成功すると配信済みになること
+func (suite *RelayTestSuite) TestProcessBatch_RetriesFailed() {
+	relay := NewRelay(suite.DB, newTestConfig())
+	publisher := &recordingPublisher{failures: 1}
+	relay.Register(event.TypeUserRegistered, publisher)
+	e := suite.appendEvent(event.TypeUserRegistered, "uid-1")
+
+	_, err := relay.ProcessBatch()
+	suite.NoError(err)
+	entry := suite.findEntry(e.ID)
+	suite.Equal(models.OutboxStatusPending, entry.Status)
+	suite.Equal(1, entry.Attempts)
+	suite.Equal("publish failed", entry.LastError)
+
+	_, err = relay.ProcessBatch()
+	suite.NoError(err)
+	entry = suite.findEntry(e.ID)
+	suite.Equal(models.OutboxStatusDelivered, entry.Status)
+	suite.Equal(2, entry.Attempts)
+	suite.Len(publisher.received(), 1)
+}
+
+// publishFunc は関数を Publisher として扱う
+type publishFunc func(e *event.Event) error
+
+func (f publishFunc) Publish(e *event.Event) error { return f(e) }
+
+// 配信はトランザクションの外で行われ、配信中のイベントはほかのリレーに取得されないこと
+func (suite *RelayTestSuite) TestProcessBatch_ClaimsBeforePublishing() {
+	relay := NewRelay(suite.DB, newTestConfig())
+	other := NewRelay(suite.DB, newTestConfig())
+	other.Register(event.TypeAll, &recordingPublisher{})
+
+	var concurrent []int
+	relay.Register(event.TypeUserRegistered, publishFunc(func(e *event.Event) error {
+		// 配信中に別のリレーが動いても、取得済みのイベントは処理されないこと
+		n, err := other.ProcessBatch()
+		suite.NoError(err)
+		concurrent = append(concurrent, n)
+		return nil
+	}))
+	e := suite.appendEvent(event.TypeUserRegistered, "uid-1")
+
+	n, err := relay.ProcessBatch()
+	suite.NoError(err)
+	suite.Equal(1, n)
+	suite.Equal([]int{0}, concurrent)
+	suite.Equal(models.OutboxStatusDelivered, suite.findEntry(e.ID).Status)
+}
+
+// 取得の期限が過ぎても結果が保存されていないイベントは、ほかのリレーが再び配信すること
+func (suite *RelayTestSuite) TestProcessBatch_ReclaimsExpired() {
+	config := newTestConfig()
+	config.ClaimTimeout = 20 * time.Millisecond
+	relay := NewRelay(suite.DB, config)
+	other := NewRelay(suite.DB, config)
+	publisher := &recordingPublisher{}
+	other.Register(event.TypeUserRegistered, publisher)
+
+	relay.Register(event.TypeUserRegistered, publishFunc(func(e *event.Event) error {
+		// 配信が期限を超えて停滞している間に、別のリレーが動く
+		time.Sleep(50 * time.Millisecond)
+		n, err := other.ProcessBatch()
+		suite.NoError(err)
+		suite.Equal(1, n)
+		return nil
+	}))
+	suite.appendEvent(event.TypeUserRegistered, "uid-1")
+
+	_, err := relay.ProcessBatch()
+	suite.NoError(err)
+	suite.Len(publisher.received(), 1)
+}
+
+// 失敗したイベントはバックオフの間は再試行されないこと
+func (suite *RelayTestSuite) TestProcessBatch_Backoff() {
+	config := newTestConfig()
+	config.Backoff = time.Hour
+	config.MaxBackoff = time.Hour
+	relay := NewRelay(suite.DB, config)
+	relay.Register(event.TypeUserRegistered, &recordingPublisher{failures: 1})
+	e := suite.appendEvent(event.TypeUserRegistered, "uid-1")
+
+	_, err := relay.ProcessBatch()
+	suite.NoError(err)
+	entry := suite.findEntry(e.ID)
+	suite.True(entry.NextAttemptAt.After(time.Now().Add(50*time.Minute)), "次回の試行がバックオフ分先になること")
+
+	n, err := relay.ProcessBatch()
+	suite.NoError(err)
+	suite.Equal(0, n, "バックオフ中は処理されないこと")
+}
+
+// 最大試行回数に達したイベントは配信を断念すること
+func (suite *RelayTestSuite) TestProcessBatch_GivesUp() {
+	relay := NewRelay(suite.DB, newTestConfig())
+	publisher := &recordingPublisher{failures: 100}
+	relay.Register(event.TypeUserRegistered, publisher)
+	e := suite.appendEvent(event.TypeUserRegistered, "uid-1")
+
+	for i := 0; i < 5; i++ {
+		_, err := relay.ProcessBatch()
+		suite.NoError(err)
+	}
+	entry := suite.findEntry(e.ID)
+	suite.Equal(models.OutboxStatusFailed, entry.Status)
+	suite.Equal(3, entry.Attempts)
+	suite.Equal(97, publisher.failures, "断念後は配信しないこと")
+}
+
+// Run がバックグラウンドでイベントを配信し、コンテキストのキャンセルで停止すること
+func (suite *RelayTestSuite) TestRun() {
+	relay := NewRelay(suite.DB, newTestConfig())
+	publisher := &recordingPublisher{}
+	relay.Register(event.TypeUserRegistered, publisher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		relay.Run(ctx)
+	}()
+
+	suite.appendEvent(event.TypeUserRegistered, "uid-1")
+	suite.Eventually(func() bool { return len(publisher.received()) == 1 }, time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		suite.Fail("Run が停止しなかった")
+	}
+}
+
+// テナントごとに登録した配信先には、そのテナントで発生したイベントのみが配信されること
+func (suite *RelayTestSuite) TestProcessBatch_TenantPublishers() {
+	appendTenantEvent := func(tenantID string, aggregateID string) *event.Event {
+		e, err := event.NewEvent(event.TypeUserRegistered, aggregateID, event.UserPayload{UID: aggregateID})
+		suite.Require().NoError(err)
+		suite.Require().NoError(database.WithTenant(suite.DB, tenantID).Create(adapter.NewOutboxEventAdapter().Convert(e)).Error)
+		return e
+	}
+	acmeEvent := appendTenantEvent("acme", "uid-acme")
+	globexEvent := appendTenantEvent("globex", "uid-globex")
+
+	relay := NewRelay(suite.DB, newTestConfig())
+	acme := &recordingPublisher{}
+	globex := &recordingPublisher{}
+	all := &recordingPublisher{}
+	relay.Register(event.TypeUserRegistered, NewTenantPublisher("acme", acme))
+	relay.Register(event.TypeUserRegistered, NewTenantPublisher("globex", globex))
+	relay.Register(event.TypeAll, all)
+
+	n, err := relay.ProcessBatch()
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	suite.Require().Len(acme.received(), 1)
+	suite.Equal(acmeEvent.ID, acme.received()[0].ID)
+	suite.Equal("acme", acme.received()[0].TenantID)
+	suite.Require().Len(globex.received(), 1)
+	suite.Equal(globexEvent.ID, globex.received()[0].ID)
+	suite.Len(all.received(), 2, "テナントを区別しない配信先にはすべてのイベントが配信されること")
+	suite.Equal(models.OutboxStatusDelivered, suite.findEntry(acmeEvent.ID).Status)
+	suite.Equal(models.OutboxStatusDelivered, suite.findEntry(globexEvent.ID).Status)
+}
@@ -0,0 +1,25 @@
+package outbox
+
+import (
+	"github.com/goda6565/nexus-user-auth/domain/event"
+)
+
+// tenantPublisher は、指定したテナントで発生したイベントのみを配信先に渡す
+type tenantPublisher struct {
+	tenantID  string
+	publisher event.Publisher
+}
+
+// NewTenantPublisher は、tenantID のテナントで発生したイベントのみを publisher に配信する Publisher を返す
+// テナントのデータのみを扱う配信先（確認メールの送信など）は、テナントごとにこの Publisher で包んで登録する
+func NewTenantPublisher(tenantID string, publisher event.Publisher) event.Publisher {
+	return &tenantPublisher{tenantID: tenantID, publisher: publisher}
+}
+
+// Publish は、他のテナントのイベントであれば何もせずに配信済みとする
+func (p *tenantPublisher) Publish(e *event.Event) error {
+	if e.TenantID != p.tenantID {
+		return nil
+	}
+	return p.publisher.Publish(e)
+}
@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// webhookPublisher は、イベントを JSON で指定の URL に POST する Publisher の実装
+type webhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookPublisher は、イベントを Webhook で通知する Publisher を返します。
+func NewWebhookPublisher(url string) event.Publisher {
+	return &webhookPublisher{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *webhookPublisher) Publish(e *event.Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return errs.NewInfraError(fmt.Sprintf("イベントの変換に失敗しました: %v", err))
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return errs.NewInfraError(fmt.Sprintf("Webhook リクエストの作成に失敗しました: %v", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// 受信側で重複を除外できるようイベントIDを付与する
+	req.Header.Set("X-Event-Id", e.ID)
+	req.Header.Set("X-Event-Type", string(e.Type))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errs.NewInfraError(fmt.Sprintf("Webhook の送信に失敗しました: %v", err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errs.NewInfraError(fmt.Sprintf("Webhook がステータス %d を返しました", resp.StatusCode))
+	}
+	return nil
+}
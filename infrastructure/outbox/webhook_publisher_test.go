@@ -0,0 +1,46 @@
+package outbox_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	. "github.com/goda6565/nexus-user-auth/infrastructure/outbox"
+)
+
+func TestWebhookPublisher_Publish(t *testing.T) {
+	var received event.Event
+	var eventID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		eventID = r.Header.Get("X-Event-Id")
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	e, err := event.NewEvent(event.TypeUserRegistered, "uid-1", event.UserPayload{UID: "uid-1"})
+	assert.NoError(t, err)
+
+	err = NewWebhookPublisher(server.URL).Publish(e)
+	assert.NoError(t, err)
+	assert.Equal(t, e.ID, eventID)
+	assert.Equal(t, e.ID, received.ID)
+	assert.Equal(t, event.TypeUserRegistered, received.Type)
+	assert.JSONEq(t, string(e.Payload), string(received.Payload))
+}
+
+func TestWebhookPublisher_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	e, err := event.NewEvent(event.TypeUserDeleted, "uid-1", nil)
+	assert.NoError(t, err)
+	assert.Error(t, NewWebhookPublisher(server.URL).Publish(e), "2xx 以外はエラーとして再試行させること")
+}
@@ -10,6 +10,8 @@ type Config struct {
 	Host             string
 	Port             string
 	CorsAllowOrigins []string
+	// TrustedProxies は X-Forwarded-For を信頼するプロキシの IP アドレス・CIDR（空の場合はどのプロキシも信頼しない）
+	TrustedProxies []string
 }
 
 func NewConfigWeb() *Config {
@@ -17,5 +19,17 @@ func NewConfigWeb() *Config {
 		Host:             utils.GetEnvDefault("WEB_HOST", "localhost"),
 		Port:             utils.GetEnvDefault("WEB_PORT", "8080"),
 		CorsAllowOrigins: strings.Split(utils.GetEnvDefault("CORS_ALLOW_ORIGINS", "http://localhost:3000"), ","),
+		TrustedProxies:   splitList(utils.GetEnvDefault("TRUSTED_PROXIES", "")),
 	}
 }
+
+// splitList はカンマ区切りの値を空白を除いて分割する（空の要素は除く）
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"gorm.io/gorm"
+
+	"github.com/goda6565/nexus-user-auth/infrastructure/outbox"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
 )
 
 const (
@@ -16,7 +19,7 @@ type Server interface {
 	Shutdown(ctx context.Context) error
 }
 
-func NewServer(db *gorm.DB) (Server, error) {
+func NewServer(db *gorm.DB, relay *outbox.Relay, mailSender mailer.Mailer) (Server, error) {
 	config := NewConfigWeb()
-	return NewGinServer(config.Host, config.Port, config.CorsAllowOrigins, db)
+	return NewGinServer(config.Host, config.Port, config.CorsAllowOrigins, config.TrustedProxies, db, relay, mailSender)
 }
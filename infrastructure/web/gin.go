@@ -7,8 +7,10 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/goda6565/nexus-user-auth/infrastructure/outbox"
 	"github.com/goda6565/nexus-user-auth/interface/router"
 	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
 )
 
 type GinWebServer struct {
@@ -23,9 +25,9 @@ func (g *GinWebServer) Shutdown(ctx context.Context) error {
 	return g.server.Shutdown(ctx)
 }
 
-func NewGinServer(host, port string, corsAllowOrigins []string, db *gorm.DB) (Server, error) {
+func NewGinServer(host, port string, corsAllowOrigins, trustedProxies []string, db *gorm.DB, relay *outbox.Relay, mailSender mailer.Mailer) (Server, error) {
 	// Gin ルーターの初期化
-	router, err := router.NewGinRouter(db, corsAllowOrigins)
+	router, err := router.NewGinRouter(db, corsAllowOrigins, trustedProxies, relay, mailSender)
 	if err != nil {
 		logger.Error(err.Error(), "host", host, "port", port)
 		return nil, err
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+// アカウントが有効でない場合のエラーの種類（ErrorResponse の error）
+const (
+	ErrorCodeAccountPending     = "account_pending"
+	ErrorCodeAccountSuspended   = "account_suspended"
+	ErrorCodeAccountDeactivated = "account_deactivated"
+	ErrorCodeServiceAccount     = "service_account" // サービスアカウントではログインできない
+)
+
+// AccountStatusErrorResponse は、アカウントの状態によって利用を拒否したエラー（entity.User.CheckActive が返すエラー）を
+// 403 のレスポンスに変換します。それ以外のエラーの場合は false を返します。
+func AccountStatusErrorResponse(err error) (gen.ErrorResponse, bool) {
+	var code, message string
+	switch {
+	case errors.Is(err, entity.ErrAccountPending):
+		code, message = ErrorCodeAccountPending, "account is pending activation"
+	case errors.Is(err, entity.ErrAccountSuspended):
+		code, message = ErrorCodeAccountSuspended, "account is suspended"
+	case errors.Is(err, entity.ErrAccountDeactivated):
+		code, message = ErrorCodeAccountDeactivated, "account is deactivated"
+	case errors.Is(err, entity.ErrServiceAccount):
+		code, message = ErrorCodeServiceAccount, "service accounts cannot log in"
+	default:
+		return gen.ErrorResponse{}, false
+	}
+	return gen.ErrorResponse{Message: message, Code: http.StatusForbidden, Error: &code}, true
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+)
+
+func TestAccountStatusErrorResponse(t *testing.T) {
+	cases := map[error]string{
+		entity.ErrAccountPending:                                ErrorCodeAccountPending,
+		entity.ErrAccountSuspended:                              ErrorCodeAccountSuspended,
+		fmt.Errorf("wrapped: %w", entity.ErrAccountDeactivated): ErrorCodeAccountDeactivated,
+		entity.ErrServiceAccount:                                ErrorCodeServiceAccount,
+	}
+	for err, code := range cases {
+		resp, ok := AccountStatusErrorResponse(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+		if assert.NotNil(t, resp.Error) {
+			assert.Equal(t, code, *resp.Error)
+		}
+	}
+
+	_, ok := AccountStatusErrorResponse(errors.New("unexpected"))
+	assert.False(t, ok, "アカウントの状態以外のエラーは変換しないこと")
+	_, ok = AccountStatusErrorResponse(nil)
+	assert.False(t, ok)
+}
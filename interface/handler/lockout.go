@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+// RespondLockout は、失敗が続いているために試行を拒否したエラー（*lockout.LockoutError）の場合に、
+// 再試行できるまでの秒数を Retry-After ヘッダーで返します（ロック中は 423、それ以外は 429）。
+// それ以外のエラーの場合は何も返さず false を返します。
+func RespondLockout(c *gin.Context, err error) bool {
+	var lockoutErr *lockout.LockoutError
+	if !errors.As(err, &lockoutErr) {
+		return false
+	}
+	status := http.StatusTooManyRequests
+	if errors.Is(err, lockout.ErrAccountLocked) {
+		status = http.StatusLocked
+	}
+	c.Header("Retry-After", retryAfterSeconds(lockoutErr.RetryAfter))
+	c.JSON(status, gen.ErrorResponse{Message: err.Error(), Code: status})
+	return true
+}
+
+// retryAfterSeconds は Retry-After ヘッダーの値（1 以上の秒数、切り上げ）を返す
+func retryAfterSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Max(1, math.Ceil(d.Seconds()))))
+}
@@ -0,0 +1,373 @@
+package organization
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/organization"
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type OrganizationHandler struct {
+	organizationService organization.OrganizationService
+	invitationService   organization.InvitationService
+}
+
+func NewOrganizationHandler(organizationService organization.OrganizationService, invitationService organization.InvitationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationService: organizationService,
+		invitationService:   invitationService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーのIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// requireUID は認証済みユーザーのIDを取得し、取得できない場合は 400 を返す
+func requireUID(c *gin.Context) (string, bool) {
+	objID, ok := getValidatedUID(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return "", false
+	}
+	return objID, true
+}
+
+// ListOrganizations: 所属する組織の一覧
+func (h *OrganizationHandler) ListOrganizations(c *gin.Context) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	organizations, err := h.organizationService.ListOrganizations(objID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := gen.OrganizationListResponse{Organizations: make([]gen.Organization, 0, len(organizations))}
+	for _, o := range organizations {
+		response.Organizations = append(response.Organizations, toOrganizationResponse(o))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateOrganization: 組織の作成
+func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.CreateOrganizationJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	created, err := h.organizationService.CreateOrganization(objID, req.Name, req.Slug)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toOrganizationResponse(created))
+}
+
+// GetOrganization: 組織の取得
+func (h *OrganizationHandler) GetOrganization(c *gin.Context, orgId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	found, err := h.organizationService.GetOrganization(objID, orgId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOrganizationResponse(found))
+}
+
+// UpdateOrganization: 組織名の変更
+func (h *OrganizationHandler) UpdateOrganization(c *gin.Context, orgId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.UpdateOrganizationJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	updated, err := h.organizationService.UpdateOrganization(objID, orgId, req.Name)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOrganizationResponse(updated))
+}
+
+// DeleteOrganization: 組織の削除
+func (h *OrganizationHandler) DeleteOrganization(c *gin.Context, orgId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.organizationService.DeleteOrganization(objID, orgId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListOrganizationMembers: 組織のメンバーの一覧
+func (h *OrganizationHandler) ListOrganizationMembers(c *gin.Context, orgId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	members, err := h.organizationService.ListMembers(objID, orgId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := gen.OrganizationMemberListResponse{Members: make([]gen.OrganizationMember, 0, len(members))}
+	for _, m := range members {
+		response.Members = append(response.Members, toMemberResponse(m))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateOrganizationMember: メンバーのロールの変更
+func (h *OrganizationHandler) UpdateOrganizationMember(c *gin.Context, orgId string, userId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.UpdateOrganizationMemberJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	member, err := h.organizationService.ChangeMemberRole(objID, orgId, userId, string(req.Role))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toMemberResponse(member))
+}
+
+// RemoveOrganizationMember: メンバーの削除・組織からの脱退
+func (h *OrganizationHandler) RemoveOrganizationMember(c *gin.Context, orgId string, userId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.organizationService.RemoveMember(objID, orgId, userId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListOrganizationInvitations: 組織の回答待ちの招待の一覧
+func (h *OrganizationHandler) ListOrganizationInvitations(c *gin.Context, orgId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	invitations, err := h.invitationService.ListInvitations(objID, orgId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := gen.OrganizationInvitationListResponse{Invitations: make([]gen.OrganizationInvitation, 0, len(invitations))}
+	for _, i := range invitations {
+		response.Invitations = append(response.Invitations, toInvitationResponse(i))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateOrganizationInvitation: メールアドレスの組織への招待
+func (h *OrganizationHandler) CreateOrganizationInvitation(c *gin.Context, orgId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.CreateOrganizationInvitationJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	invitation, err := h.invitationService.Invite(objID, orgId, req.Email, string(req.Role))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toInvitationResponse(invitation))
+}
+
+// RevokeOrganizationInvitation: 招待の取り消し
+func (h *OrganizationHandler) RevokeOrganizationInvitation(c *gin.Context, orgId string, invitationId string) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.invitationService.RevokeInvitation(objID, orgId, invitationId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AcceptInvitation: 組織への招待の承諾
+func (h *OrganizationHandler) AcceptInvitation(c *gin.Context) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.AcceptInvitationJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	joined, err := h.invitationService.AcceptInvitation(objID, req.Token)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOrganizationResponse(joined))
+}
+
+// DeclineInvitation: 組織への招待の辞退
+func (h *OrganizationHandler) DeclineInvitation(c *gin.Context) {
+	var req gen.DeclineInvitationJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	if err := h.invitationService.DeclineInvitation(req.Token); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.MessageResponse{Message: "招待を辞退しました"})
+}
+
+// SwitchOrganization: 組織の切り替え
+func (h *OrganizationHandler) SwitchOrganization(c *gin.Context) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.SwitchOrganizationJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	orgID := ""
+	if req.OrgId != nil {
+		orgID = *req.OrgId
+	}
+	// 認証日時・方法は元のトークンから引き継ぐ（切り替えで認証が新しくなったことにはしない）
+	auth, _ := c.Value("validated_auth").(utils.AuthContext)
+
+	accessToken, refreshToken, err := h.organizationService.SwitchOrganization(objID, orgID, auth)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.LoginResponse{
+		AccessToken:  &accessToken,
+		RefreshToken: &refreshToken,
+	})
+}
+
+// respondError はサービスのエラーを HTTP ステータスに対応づけて返す
+func respondError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, organization.ErrOrganizationNotFound), errors.Is(err, organization.ErrMemberNotFound), errors.Is(err, organization.ErrInvitationNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, organization.ErrInvalidOrganization), errors.Is(err, organization.ErrInvalidMemberRole), errors.Is(err, organization.ErrInvalidEmail), errors.Is(err, organization.ErrInvalidInvitationToken):
+		status = http.StatusBadRequest
+	case errors.Is(err, organization.ErrForbidden), errors.Is(err, organization.ErrInvitationEmailMismatch), errors.Is(err, organization.ErrEmailNotVerified):
+		status = http.StatusForbidden
+	case errors.Is(err, organization.ErrSlugAlreadyInUse), errors.Is(err, organization.ErrLastOwner), errors.Is(err, organization.ErrAlreadyMember):
+		status = http.StatusConflict
+	}
+	c.JSON(status, gen.ErrorResponse{Message: err.Error(), Code: status})
+}
+
+func toOrganizationResponse(o *repository.UserOrganization) gen.Organization {
+	return gen.Organization{
+		Id:        o.Organization.ObjID().Value(),
+		Name:      o.Organization.Name().Value(),
+		Slug:      o.Organization.Slug().Value(),
+		Role:      gen.MemberRole(o.Membership.Role().Value()),
+		CreatedAt: o.Organization.CreatedAt(),
+	}
+}
+
+func toMemberResponse(m *organization.Member) gen.OrganizationMember {
+	return gen.OrganizationMember{
+		Uid:      m.User.ObjID().Value(),
+		Email:    m.User.Email().Value(),
+		Username: m.User.Username().Value(),
+		Role:     gen.MemberRole(m.Membership.Role().Value()),
+		JoinedAt: m.Membership.JoinedAt(),
+	}
+}
+
+func toInvitationResponse(i *entity.Invitation) gen.OrganizationInvitation {
+	return gen.OrganizationInvitation{
+		Id:        i.ID(),
+		Email:     i.Email().Value(),
+		Role:      gen.MemberRole(i.Role().Value()),
+		Status:    gen.OrganizationInvitationStatus(i.Status()),
+		ExpiresAt: i.ExpiresAt(),
+		CreatedAt: i.CreatedAt(),
+	}
+}
@@ -0,0 +1,501 @@
+package organization_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/organization"
+	"github.com/goda6565/nexus-user-auth/domain/organization/entity"
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/domain/organization/value"
+	userEntity "github.com/goda6565/nexus-user-auth/domain/user/entity"
+	userValue "github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/organization"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モックの OrganizationService ---
+type mockOrganizationService struct {
+	mock.Mock
+}
+
+func (m *mockOrganizationService) CreateOrganization(userObjID string, name string, slug string) (*repository.UserOrganization, error) {
+	args := m.Called(userObjID, name, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.UserOrganization), args.Error(1)
+}
+
+func (m *mockOrganizationService) ListOrganizations(userObjID string) ([]*repository.UserOrganization, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.UserOrganization), args.Error(1)
+}
+
+func (m *mockOrganizationService) GetOrganization(userObjID string, orgObjID string) (*repository.UserOrganization, error) {
+	args := m.Called(userObjID, orgObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.UserOrganization), args.Error(1)
+}
+
+func (m *mockOrganizationService) UpdateOrganization(userObjID string, orgObjID string, name string) (*repository.UserOrganization, error) {
+	args := m.Called(userObjID, orgObjID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.UserOrganization), args.Error(1)
+}
+
+func (m *mockOrganizationService) DeleteOrganization(userObjID string, orgObjID string) error {
+	args := m.Called(userObjID, orgObjID)
+	return args.Error(0)
+}
+
+func (m *mockOrganizationService) ListMembers(userObjID string, orgObjID string) ([]*organization.Member, error) {
+	args := m.Called(userObjID, orgObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*organization.Member), args.Error(1)
+}
+
+func (m *mockOrganizationService) ChangeMemberRole(userObjID string, orgObjID string, memberObjID string, role string) (*organization.Member, error) {
+	args := m.Called(userObjID, orgObjID, memberObjID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*organization.Member), args.Error(1)
+}
+
+func (m *mockOrganizationService) RemoveMember(userObjID string, orgObjID string, memberObjID string) error {
+	args := m.Called(userObjID, orgObjID, memberObjID)
+	return args.Error(0)
+}
+
+func (m *mockOrganizationService) SwitchOrganization(userObjID string, orgObjID string, auth utils.AuthContext) (string, string, error) {
+	args := m.Called(userObjID, orgObjID, auth)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+// --- モックの InvitationService ---
+type mockInvitationService struct {
+	mock.Mock
+}
+
+func (m *mockInvitationService) Invite(userObjID string, orgObjID string, email string, role string) (*entity.Invitation, error) {
+	args := m.Called(userObjID, orgObjID, email, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Invitation), args.Error(1)
+}
+
+func (m *mockInvitationService) ListInvitations(userObjID string, orgObjID string) ([]*entity.Invitation, error) {
+	args := m.Called(userObjID, orgObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Invitation), args.Error(1)
+}
+
+func (m *mockInvitationService) RevokeInvitation(userObjID string, orgObjID string, invitationID string) error {
+	args := m.Called(userObjID, orgObjID, invitationID)
+	return args.Error(0)
+}
+
+func (m *mockInvitationService) AcceptInvitation(userObjID string, token string) (*repository.UserOrganization, error) {
+	args := m.Called(userObjID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.UserOrganization), args.Error(1)
+}
+
+func (m *mockInvitationService) DeclineInvitation(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+
+const userObjID = "11111111-1111-1111-1111-111111111111"
+
+type OrganizationHandlerTestSuite struct {
+	suite.Suite
+	orgService        *mockOrganizationService
+	invitationService *mockInvitationService
+	handler           *OrganizationHandler
+	testOrg           *repository.UserOrganization
+	testInvitation    *entity.Invitation
+	testMember        *organization.Member
+}
+
+func TestOrganizationHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationHandlerTestSuite))
+}
+
+func (suite *OrganizationHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.orgService = new(mockOrganizationService)
+	suite.invitationService = new(mockInvitationService)
+	suite.handler = NewOrganizationHandler(suite.orgService, suite.invitationService)
+
+	name, _ := value.NewOrgName("Acme")
+	slug, _ := value.NewOrgSlug("acme")
+	org, err := entity.NewOrganization(name, slug)
+	suite.Require().NoError(err)
+	ownerObjID, _ := userValue.NewUserObjID(userObjID)
+	ownerRole, _ := value.NewMemberRole(value.MemberRoleOwner)
+	membership, err := entity.NewMembership(org.ObjID(), ownerObjID, ownerRole)
+	suite.Require().NoError(err)
+	suite.testOrg = &repository.UserOrganization{Organization: org, Membership: membership}
+
+	email, _ := userValue.NewUserEmail("invitee@example.com")
+	memberRole, _ := value.NewMemberRole(value.MemberRoleMember)
+	invitation, err := entity.NewInvitation(org.ObjID(), email, memberRole, ownerObjID, time.Now().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.testInvitation = invitation
+
+	ownerEmail, _ := userValue.NewUserEmail("owner@example.com")
+	username, _ := userValue.NewUserUsername("owner")
+	user, err := userEntity.NewUser(ownerEmail, userValue.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	suite.testMember = &organization.Member{Membership: membership, User: user}
+}
+
+func (suite *OrganizationHandlerTestSuite) newContext(method string, body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Set("validated_uid", userObjID)
+	return c, w
+}
+
+// ----- 組織のテスト -----
+
+func (suite *OrganizationHandlerTestSuite) TestListOrganizations() {
+	suite.orgService.On("ListOrganizations", userObjID).Return([]*repository.UserOrganization{suite.testOrg}, nil)
+	c, w := suite.newContext(http.MethodGet, nil)
+
+	suite.handler.ListOrganizations(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.OrganizationListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.Organizations, 1)
+	suite.Equal(suite.testOrg.Organization.ObjID().Value(), response.Organizations[0].Id)
+	suite.Equal("acme", response.Organizations[0].Slug)
+	suite.Equal(gen.MemberRole("owner"), response.Organizations[0].Role)
+}
+
+func (suite *OrganizationHandlerTestSuite) TestCreateOrganization() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusCreated},
+		{organization.ErrInvalidOrganization, http.StatusBadRequest},
+		{organization.ErrSlugAlreadyInUse, http.StatusConflict},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.orgService.On("CreateOrganization", userObjID, "Acme", "acme").Return(suite.testOrg, nil)
+		} else {
+			suite.orgService.On("CreateOrganization", userObjID, "Acme", "acme").Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.CreateOrganizationJSONRequestBody{Name: "Acme", Slug: "acme"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, body)
+
+		suite.handler.CreateOrganization(c)
+
+		suite.Equal(tc.status, w.Code)
+		suite.orgService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *OrganizationHandlerTestSuite) TestCreateOrganization_InvalidJSON() {
+	c, w := suite.newContext(http.MethodPost, []byte("invalid json"))
+
+	suite.handler.CreateOrganization(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.orgService.AssertNotCalled(suite.T(), "CreateOrganization", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrganizationHandlerTestSuite) TestGetOrganization() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{organization.ErrOrganizationNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.orgService.On("GetOrganization", userObjID, "org-1").Return(suite.testOrg, nil)
+		} else {
+			suite.orgService.On("GetOrganization", userObjID, "org-1").Return(nil, tc.err)
+		}
+		c, w := suite.newContext(http.MethodGet, nil)
+
+		suite.handler.GetOrganization(c, "org-1")
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *OrganizationHandlerTestSuite) TestUpdateOrganization() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{organization.ErrForbidden, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.orgService.On("UpdateOrganization", userObjID, "org-1", "Renamed").Return(suite.testOrg, nil)
+		} else {
+			suite.orgService.On("UpdateOrganization", userObjID, "org-1", "Renamed").Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.UpdateOrganizationJSONRequestBody{Name: "Renamed"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPut, body)
+
+		suite.handler.UpdateOrganization(c, "org-1")
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *OrganizationHandlerTestSuite) TestDeleteOrganization() {
+	suite.orgService.On("DeleteOrganization", userObjID, "org-1").Return(nil)
+	c, w := suite.newContext(http.MethodDelete, nil)
+
+	suite.handler.DeleteOrganization(c, "org-1")
+	c.Writer.WriteHeaderNow()
+
+	suite.Equal(http.StatusNoContent, w.Code)
+}
+
+// ----- メンバーのテスト -----
+
+func (suite *OrganizationHandlerTestSuite) TestListOrganizationMembers() {
+	suite.orgService.On("ListMembers", userObjID, "org-1").Return([]*organization.Member{suite.testMember}, nil)
+	c, w := suite.newContext(http.MethodGet, nil)
+
+	suite.handler.ListOrganizationMembers(c, "org-1")
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.OrganizationMemberListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.Members, 1)
+	suite.Equal("owner@example.com", response.Members[0].Email)
+	suite.Equal(gen.MemberRole("owner"), response.Members[0].Role)
+}
+
+func (suite *OrganizationHandlerTestSuite) TestUpdateOrganizationMember() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{organization.ErrInvalidMemberRole, http.StatusBadRequest},
+		{organization.ErrForbidden, http.StatusForbidden},
+		{organization.ErrMemberNotFound, http.StatusNotFound},
+		{organization.ErrLastOwner, http.StatusConflict},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.orgService.On("ChangeMemberRole", userObjID, "org-1", "user-2", "admin").Return(suite.testMember, nil)
+		} else {
+			suite.orgService.On("ChangeMemberRole", userObjID, "org-1", "user-2", "admin").Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.UpdateOrganizationMemberJSONRequestBody{Role: gen.MemberRole("admin")})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPut, body)
+
+		suite.handler.UpdateOrganizationMember(c, "org-1", "user-2")
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *OrganizationHandlerTestSuite) TestRemoveOrganizationMember() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{organization.ErrLastOwner, http.StatusConflict},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.orgService.On("RemoveMember", userObjID, "org-1", "user-2").Return(tc.err)
+		c, w := suite.newContext(http.MethodDelete, nil)
+
+		suite.handler.RemoveOrganizationMember(c, "org-1", "user-2")
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+// ----- 招待のテスト -----
+
+func (suite *OrganizationHandlerTestSuite) TestCreateOrganizationInvitation() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusCreated},
+		{organization.ErrInvalidEmail, http.StatusBadRequest},
+		{organization.ErrForbidden, http.StatusForbidden},
+		{organization.ErrAlreadyMember, http.StatusConflict},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.invitationService.On("Invite", userObjID, "org-1", "invitee@example.com", "member").Return(suite.testInvitation, nil)
+		} else {
+			suite.invitationService.On("Invite", userObjID, "org-1", "invitee@example.com", "member").Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.CreateOrganizationInvitationJSONRequestBody{Email: "invitee@example.com", Role: gen.MemberRole("member")})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, body)
+
+		suite.handler.CreateOrganizationInvitation(c, "org-1")
+
+		suite.Equal(tc.status, w.Code)
+		if tc.err == nil {
+			var response gen.OrganizationInvitation
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			suite.Equal(suite.testInvitation.ID(), response.Id)
+			suite.Equal(gen.OrganizationInvitationStatus("pending"), response.Status)
+		}
+	}
+}
+
+func (suite *OrganizationHandlerTestSuite) TestListAndRevokeOrganizationInvitations() {
+	suite.invitationService.On("ListInvitations", userObjID, "org-1").Return([]*entity.Invitation{suite.testInvitation}, nil)
+	c, w := suite.newContext(http.MethodGet, nil)
+	suite.handler.ListOrganizationInvitations(c, "org-1")
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.OrganizationInvitationListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Len(response.Invitations, 1)
+
+	suite.invitationService.On("RevokeInvitation", userObjID, "org-1", "inv-1").Return(nil)
+	suite.invitationService.On("RevokeInvitation", userObjID, "org-1", "missing").Return(organization.ErrInvitationNotFound)
+	c, w = suite.newContext(http.MethodDelete, nil)
+	suite.handler.RevokeOrganizationInvitation(c, "org-1", "inv-1")
+	c.Writer.WriteHeaderNow()
+	suite.Equal(http.StatusNoContent, w.Code)
+
+	c, w = suite.newContext(http.MethodDelete, nil)
+	suite.handler.RevokeOrganizationInvitation(c, "org-1", "missing")
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *OrganizationHandlerTestSuite) TestAcceptInvitation() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{organization.ErrInvalidInvitationToken, http.StatusBadRequest},
+		{organization.ErrInvitationEmailMismatch, http.StatusForbidden},
+		{organization.ErrEmailNotVerified, http.StatusForbidden},
+		{organization.ErrAlreadyMember, http.StatusConflict},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.invitationService.On("AcceptInvitation", userObjID, "token").Return(suite.testOrg, nil)
+		} else {
+			suite.invitationService.On("AcceptInvitation", userObjID, "token").Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.AcceptInvitationJSONRequestBody{Token: "token"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, body)
+
+		suite.handler.AcceptInvitation(c)
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+// 辞退はログインせずに行えること
+func (suite *OrganizationHandlerTestSuite) TestDeclineInvitation() {
+	suite.invitationService.On("DeclineInvitation", "token").Return(nil)
+	suite.invitationService.On("DeclineInvitation", "used").Return(organization.ErrInvalidInvitationToken)
+
+	body, _ := json.Marshal(gen.DeclineInvitationJSONRequestBody{Token: "token"})
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	suite.handler.DeclineInvitation(c)
+	suite.Equal(http.StatusOK, w.Code)
+
+	body, _ = json.Marshal(gen.DeclineInvitationJSONRequestBody{Token: "used"})
+	c, w = suite.newContext(http.MethodPost, body)
+	suite.handler.DeclineInvitation(c)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// ----- SwitchOrganization のテスト -----
+
+// 元のトークンの認証の情報を引き継いで切り替えること
+func (suite *OrganizationHandlerTestSuite) TestSwitchOrganization() {
+	auth := utils.AuthContext{AuthTime: time.Now().Add(-time.Minute), AMR: []string{utils.AMRPassword}}
+	suite.orgService.On("SwitchOrganization", userObjID, "org-1", auth).Return("access", "refresh", nil)
+	orgID := "org-1"
+	body, err := json.Marshal(gen.SwitchOrganizationJSONRequestBody{OrgId: &orgID})
+	suite.Require().NoError(err)
+	c, w := suite.newContext(http.MethodPost, body)
+	c.Set("validated_auth", auth)
+
+	suite.handler.SwitchOrganization(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Equal("access", *response.AccessToken)
+	suite.Equal("refresh", *response.RefreshToken)
+}
+
+func (suite *OrganizationHandlerTestSuite) TestSwitchOrganization_NotMember() {
+	suite.orgService.On("SwitchOrganization", userObjID, "org-2", utils.AuthContext{}).Return("", "", organization.ErrOrganizationNotFound)
+	orgID := "org-2"
+	body, _ := json.Marshal(gen.SwitchOrganizationJSONRequestBody{OrgId: &orgID})
+	c, w := suite.newContext(http.MethodPost, body)
+
+	suite.handler.SwitchOrganization(c)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,282 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/admin"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserAdminHandler struct {
+	userAdminService admin.UserAdminService
+}
+
+func NewUserAdminHandler(userAdminService admin.UserAdminService) *UserAdminHandler {
+	return &UserAdminHandler{
+		userAdminService: userAdminService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザー（操作する管理者）のIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// ListAdminUsers: ユーザーの一覧
+func (h *UserAdminHandler) ListAdminUsers(c *gin.Context, params gen.ListAdminUsersParams) {
+	actorObjID, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	query := admin.UserQuery{
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+	}
+	if params.Email != nil {
+		query.Email = *params.Email
+	}
+	if params.Role != nil {
+		query.Role = *params.Role
+	}
+	if params.Status != nil {
+		query.Status = string(*params.Status)
+	}
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+
+	page, err := h.userAdminService.ListUsers(actorObjID, query)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	users := make([]gen.AdminUser, 0, len(page.Users))
+	for _, user := range page.Users {
+		users = append(users, toAdminUserResponse(user))
+	}
+	response := gen.AdminUserListResponse{Users: users}
+	if page.NextCursor != "" {
+		response.NextCursor = &page.NextCursor
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateAdminUser: 一時的なパスワードを設定したユーザーの作成
+func (h *UserAdminHandler) CreateAdminUser(c *gin.Context) {
+	actorObjID, ok := requireActor(c)
+	if !ok {
+		return
+	}
+	var req gen.CreateAdminUserJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	var roles []string
+	if req.Roles != nil {
+		roles = *req.Roles
+	}
+
+	created, err := h.userAdminService.CreateUser(actorObjID, req.Email, req.Username, roles)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gen.AdminUserCreatedResponse{
+		User:              toAdminUserResponse(created.User),
+		TemporaryPassword: created.TemporaryPassword,
+	})
+}
+
+// GetAdminUser: ユーザーの取得
+func (h *UserAdminHandler) GetAdminUser(c *gin.Context, userId string) {
+	actorObjID, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.userAdminService.GetUser(actorObjID, userId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAdminUserResponse(user))
+}
+
+// UpdateAdminUserRoles: ロールの変更
+func (h *UserAdminHandler) UpdateAdminUserRoles(c *gin.Context, userId string) {
+	actorObjID, ok := requireActor(c)
+	if !ok {
+		return
+	}
+	var req gen.UpdateAdminUserRolesJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	user, err := h.userAdminService.UpdateRoles(actorObjID, userId, req.Roles)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAdminUserResponse(user))
+}
+
+// SuspendAdminUser: アカウントの停止
+func (h *UserAdminHandler) SuspendAdminUser(c *gin.Context, userId string) {
+	actorObjID, ok := requireActor(c)
+	if !ok {
+		return
+	}
+	var req gen.SuspendAdminUserJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	user, err := h.userAdminService.SuspendUser(actorObjID, userId, req.Reason)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAdminUserResponse(user))
+}
+
+// UnsuspendAdminUser: アカウントの停止の解除
+func (h *UserAdminHandler) UnsuspendAdminUser(c *gin.Context, userId string) {
+	actorObjID, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.userAdminService.UnsuspendUser(actorObjID, userId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAdminUserResponse(user))
+}
+
+// LogoutAdminUser: 強制ログアウト
+func (h *UserAdminHandler) LogoutAdminUser(c *gin.Context, userId string) {
+	actorObjID, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userAdminService.LogoutUser(actorObjID, userId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteAdminUser: ユーザーの削除
+func (h *UserAdminHandler) DeleteAdminUser(c *gin.Context, userId string) {
+	actorObjID, ok := requireActor(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userAdminService.DeleteUser(actorObjID, userId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// requireActor は操作する管理者のIDを取得する（取得できない場合は 400 を返す）
+func requireActor(c *gin.Context) (string, bool) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return "", false
+	}
+	return objID, true
+}
+
+// respondError はサービスのエラーに対応するステータスコードでエラーを返す
+func respondError(c *gin.Context, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, admin.ErrUserNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, admin.ErrInvalidUserQuery), errors.Is(err, admin.ErrInvalidCursor),
+		errors.Is(err, admin.ErrInvalidUser), errors.Is(err, admin.ErrInvalidRole),
+		errors.Is(err, admin.ErrInvalidSuspendReason), errors.Is(err, admin.ErrCannotManageSelf),
+		errors.Is(err, registration.ErrEmailDomainNotAllowed):
+		code = http.StatusBadRequest
+	case errors.Is(err, admin.ErrCannotGrantRole), errors.Is(err, admin.ErrCannotManageUser):
+		code = http.StatusForbidden
+	case errors.Is(err, admin.ErrEmailAlreadyInUse), errors.Is(err, admin.ErrInvalidStatusChange):
+		code = http.StatusConflict
+	}
+	c.JSON(code, gen.ErrorResponse{Message: err.Error(), Code: code})
+}
+
+func toAdminUserResponse(user *entity.User) gen.AdminUser {
+	kind := gen.AdminUserKind(user.Kind().Value())
+	response := gen.AdminUser{
+		Uid:      user.ObjID().Value(),
+		Kind:     &kind,
+		Email:    user.Email().Value(),
+		Username: user.Username().Value(),
+		Roles:    user.RoleNames(),
+		Status:   gen.AdminUserStatus(user.Status().Value()),
+	}
+	if user.AvatarURL() != nil {
+		avatarURL := user.AvatarURL().Value()
+		response.AvatarURL = &avatarURL
+	}
+	if reason := user.StatusReason(); reason != "" {
+		response.StatusReason = &reason
+	}
+	if user.StatusChangedAt() != nil {
+		changedAt := user.StatusChangedAt().Value()
+		response.StatusChangedAt = &changedAt
+	}
+	if user.EmailVerifiedAt() != nil {
+		verifiedAt := user.EmailVerifiedAt().Value()
+		response.EmailVerifiedAt = &verifiedAt
+	}
+	if user.LastLoginAt() != nil {
+		lastLoginAt := user.LastLoginAt().Value()
+		response.LastLoginAt = &lastLoginAt
+	}
+	if user.CreatedAt() != nil {
+		createdAt := user.CreatedAt().Value()
+		response.CreatedAt = &createdAt
+	}
+	return response
+}
@@ -0,0 +1,422 @@
+package admin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/admin"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/admin"
+)
+
+// --- モックの UserAdminService ---
+type mockUserAdminService struct {
+	mock.Mock
+}
+
+func (m *mockUserAdminService) ListUsers(actorObjID string, query admin.UserQuery) (*admin.UserPage, error) {
+	args := m.Called(actorObjID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*admin.UserPage), args.Error(1)
+}
+
+func (m *mockUserAdminService) GetUser(actorObjID string, objID string) (*entity.User, error) {
+	args := m.Called(actorObjID, objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserAdminService) CreateUser(actorObjID string, email string, username string, roles []string) (*admin.CreatedUser, error) {
+	args := m.Called(actorObjID, email, username, roles)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*admin.CreatedUser), args.Error(1)
+}
+
+func (m *mockUserAdminService) UpdateRoles(actorObjID string, objID string, roles []string) (*entity.User, error) {
+	args := m.Called(actorObjID, objID, roles)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserAdminService) SuspendUser(actorObjID string, objID string, reason string) (*entity.User, error) {
+	args := m.Called(actorObjID, objID, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserAdminService) UnsuspendUser(actorObjID string, objID string) (*entity.User, error) {
+	args := m.Called(actorObjID, objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserAdminService) LogoutUser(actorObjID string, objID string) error {
+	args := m.Called(actorObjID, objID)
+	return args.Error(0)
+}
+
+func (m *mockUserAdminService) DeleteUser(actorObjID string, objID string) error {
+	args := m.Called(actorObjID, objID)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+type UserAdminHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserAdminHandler
+	mockService *mockUserAdminService
+	testUser    *entity.User
+}
+
+const actorObjID = "admin-1"
+
+func TestUserAdminHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserAdminHandlerTestSuite))
+}
+
+func (suite *UserAdminHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserAdminService)
+	suite.handler = NewUserAdminHandler(suite.mockService)
+
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	password, _ := value.NewUserPassword("password123")
+	testUser, err := entity.NewUser(email, password, username)
+	suite.Require().NoError(err)
+	suite.testUser = testUser
+}
+
+func (suite *UserAdminHandlerTestSuite) newContext(method string, target string, body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Set("validated_uid", actorObjID)
+	return c, w
+}
+
+// ----- ListAdminUsers のテスト -----
+
+func (suite *UserAdminHandlerTestSuite) TestListAdminUsers() {
+	email := "example"
+	role := "admin"
+	cursor := "next-page"
+	limit := 10
+	query := admin.UserQuery{Email: email, Role: "admin", Cursor: cursor, Limit: limit}
+	suite.mockService.On("ListUsers", actorObjID, query).Return(&admin.UserPage{
+		Users:      []*entity.User{suite.testUser},
+		NextCursor: "cursor-2",
+	}, nil)
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+
+	suite.handler.ListAdminUsers(c, gen.ListAdminUsersParams{Email: &email, Role: &role, Cursor: &cursor, Limit: &limit})
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.AdminUserListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.Users, 1)
+	suite.Equal(suite.testUser.ObjID().Value(), response.Users[0].Uid)
+	suite.Equal("test@example.com", response.Users[0].Email)
+	suite.Equal(gen.AdminUserStatus(suite.testUser.Status().Value()), response.Users[0].Status)
+	suite.Require().NotNil(response.NextCursor)
+	suite.Equal("cursor-2", *response.NextCursor)
+}
+
+// 最後のページでは nextCursor を返さないこと
+func (suite *UserAdminHandlerTestSuite) TestListAdminUsers_LastPage() {
+	suite.mockService.On("ListUsers", actorObjID, admin.UserQuery{}).Return(&admin.UserPage{Users: []*entity.User{}}, nil)
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+
+	suite.handler.ListAdminUsers(c, gen.ListAdminUsersParams{})
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.AdminUserListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Empty(response.Users)
+	suite.Nil(response.NextCursor)
+}
+
+func (suite *UserAdminHandlerTestSuite) TestListAdminUsers_Error() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{admin.ErrInvalidCursor, http.StatusBadRequest},
+		{admin.ErrInvalidUserQuery, http.StatusBadRequest},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("ListUsers", actorObjID, mock.Anything).Return(nil, tc.err)
+		c, w := suite.newContext(http.MethodGet, "/", nil)
+
+		suite.handler.ListAdminUsers(c, gen.ListAdminUsersParams{})
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+// 認証済みユーザーのIDが無い場合は 400 を返すこと
+func (suite *UserAdminHandlerTestSuite) TestListAdminUsers_NoActor() {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	suite.handler.ListAdminUsers(c, gen.ListAdminUsersParams{})
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "ListUsers", mock.Anything, mock.Anything)
+}
+
+// ----- CreateAdminUser のテスト -----
+
+func (suite *UserAdminHandlerTestSuite) TestCreateAdminUser() {
+	suite.mockService.On("CreateUser", actorObjID, "test@example.com", "testuser", []string{"admin", "support"}).Return(&admin.CreatedUser{
+		User:              suite.testUser,
+		TemporaryPassword: "temporary-pass",
+	}, nil)
+	roles := []string{"admin", "support"}
+	body, err := json.Marshal(gen.CreateAdminUserJSONRequestBody{Email: "test@example.com", Username: "testuser", Roles: &roles})
+	suite.Require().NoError(err)
+	c, w := suite.newContext(http.MethodPost, "/", body)
+
+	suite.handler.CreateAdminUser(c)
+
+	suite.Equal(http.StatusCreated, w.Code)
+	var response gen.AdminUserCreatedResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Equal("temporary-pass", response.TemporaryPassword)
+	suite.Equal(suite.testUser.ObjID().Value(), response.User.Uid)
+}
+
+func (suite *UserAdminHandlerTestSuite) TestCreateAdminUser_Error() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{admin.ErrInvalidUser, http.StatusBadRequest},
+		{admin.ErrInvalidRole, http.StatusBadRequest},
+		{registration.ErrEmailDomainNotAllowed, http.StatusBadRequest},
+		{admin.ErrCannotGrantRole, http.StatusForbidden},
+		{admin.ErrEmailAlreadyInUse, http.StatusConflict},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("CreateUser", actorObjID, "test@example.com", "testuser", []string(nil)).Return(nil, tc.err)
+		body, err := json.Marshal(gen.CreateAdminUserJSONRequestBody{Email: "test@example.com", Username: "testuser"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, "/", body)
+
+		suite.handler.CreateAdminUser(c)
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *UserAdminHandlerTestSuite) TestCreateAdminUser_InvalidJSON() {
+	c, w := suite.newContext(http.MethodPost, "/", []byte("invalid json"))
+
+	suite.handler.CreateAdminUser(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "CreateUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ----- GetAdminUser のテスト -----
+
+func (suite *UserAdminHandlerTestSuite) TestGetAdminUser() {
+	cases := []struct {
+		user   *entity.User
+		err    error
+		status int
+	}{
+		{suite.testUser, nil, http.StatusOK},
+		{nil, admin.ErrUserNotFound, http.StatusNotFound},
+		{nil, errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.user != nil {
+			suite.mockService.On("GetUser", actorObjID, "user-1").Return(tc.user, nil)
+		} else {
+			suite.mockService.On("GetUser", actorObjID, "user-1").Return(nil, tc.err)
+		}
+		c, w := suite.newContext(http.MethodGet, "/", nil)
+
+		suite.handler.GetAdminUser(c, "user-1")
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+// ----- UpdateAdminUserRoles のテスト -----
+
+func (suite *UserAdminHandlerTestSuite) TestUpdateAdminUserRoles() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{admin.ErrCannotManageSelf, http.StatusBadRequest},
+		{admin.ErrUserNotFound, http.StatusNotFound},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("UpdateRoles", actorObjID, "user-1", []string{"admin"}).Return(suite.testUser, nil)
+		} else {
+			suite.mockService.On("UpdateRoles", actorObjID, "user-1", []string{"admin"}).Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.UpdateAdminUserRolesJSONRequestBody{Roles: []string{"admin"}})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPut, "/", body)
+
+		suite.handler.UpdateAdminUserRoles(c, "user-1")
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+// ----- SuspendAdminUser / UnsuspendAdminUser のテスト -----
+
+func (suite *UserAdminHandlerTestSuite) TestSuspendAdminUser() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{admin.ErrInvalidSuspendReason, http.StatusBadRequest},
+		{admin.ErrInvalidStatusChange, http.StatusConflict},
+		{admin.ErrCannotManageUser, http.StatusForbidden},
+		{admin.ErrUserNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("SuspendUser", actorObjID, "user-1", "spam").Return(suite.testUser, nil)
+		} else {
+			suite.mockService.On("SuspendUser", actorObjID, "user-1", "spam").Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.SuspendAdminUserJSONRequestBody{Reason: "spam"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, "/", body)
+
+		suite.handler.SuspendAdminUser(c, "user-1")
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserAdminHandlerTestSuite) TestSuspendAdminUser_InvalidJSON() {
+	c, w := suite.newContext(http.MethodPost, "/", []byte("invalid json"))
+
+	suite.handler.SuspendAdminUser(c, "user-1")
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "SuspendUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UserAdminHandlerTestSuite) TestUnsuspendAdminUser() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{admin.ErrInvalidStatusChange, http.StatusConflict},
+		{admin.ErrCannotManageUser, http.StatusForbidden},
+		{admin.ErrUserNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("UnsuspendUser", actorObjID, "user-1").Return(suite.testUser, nil)
+		} else {
+			suite.mockService.On("UnsuspendUser", actorObjID, "user-1").Return(nil, tc.err)
+		}
+		c, w := suite.newContext(http.MethodPost, "/", nil)
+
+		suite.handler.UnsuspendAdminUser(c, "user-1")
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+// ----- LogoutAdminUser / DeleteAdminUser のテスト -----
+
+func (suite *UserAdminHandlerTestSuite) TestLogoutAdminUser() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{admin.ErrUserNotFound, http.StatusNotFound},
+		{admin.ErrCannotManageUser, http.StatusForbidden},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("LogoutUser", actorObjID, "user-1").Return(tc.err)
+		c, w := suite.newContext(http.MethodPost, "/", nil)
+
+		suite.handler.LogoutAdminUser(c, "user-1")
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserAdminHandlerTestSuite) TestDeleteAdminUser() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{admin.ErrCannotManageSelf, http.StatusBadRequest},
+		{admin.ErrCannotManageUser, http.StatusForbidden},
+		{admin.ErrUserNotFound, http.StatusNotFound},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("DeleteUser", actorObjID, "user-1").Return(tc.err)
+		c, w := suite.newContext(http.MethodDelete, "/", nil)
+
+		suite.handler.DeleteAdminUser(c, "user-1")
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
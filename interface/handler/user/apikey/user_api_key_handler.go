@@ -0,0 +1,135 @@
+package apikey
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/apikey"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserAPIKeyHandler struct {
+	userAPIKeyService apikey.UserAPIKeyService
+}
+
+func NewUserAPIKeyHandler(userAPIKeyService apikey.UserAPIKeyService) *UserAPIKeyHandler {
+	return &UserAPIKeyHandler{
+		userAPIKeyService: userAPIKeyService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// ListAPIKeys: API キーの一覧
+func (h *UserAPIKeyHandler) ListAPIKeys(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	keys, err := h.userAPIKeyService.ListAPIKeys(objID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	apiKeys := make([]gen.APIKey, 0, len(keys))
+	for _, key := range keys {
+		apiKeys = append(apiKeys, toAPIKeyResponse(key))
+	}
+	c.JSON(http.StatusOK, gen.APIKeyListResponse{ApiKeys: apiKeys})
+}
+
+// CreateAPIKey: API キーの作成（キーそのものはこのレスポンスでのみ返す）
+func (h *UserAPIKeyHandler) CreateAPIKey(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	var req gen.CreateAPIKeyJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	secret, key, err := h.userAPIKeyService.CreateAPIKey(objID, req.Name, req.Scopes, req.ExpiresAt)
+	switch {
+	case errors.Is(err, apikey.ErrInvalidAPIKey):
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	case errors.Is(err, apikey.ErrTooManyAPIKeys):
+		c.JSON(http.StatusConflict, gen.ErrorResponse{Message: err.Error(), Code: http.StatusConflict})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	// キーそのものをキャッシュに残さない
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, gen.APIKeyCreatedResponse{
+		ApiKey: toAPIKeyResponse(key),
+		Secret: secret,
+	})
+}
+
+// RevokeAPIKey: API キーの取り消し
+func (h *UserAPIKeyHandler) RevokeAPIKey(c *gin.Context, keyId string) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	err := h.userAPIKeyService.RevokeAPIKey(objID, keyId)
+	if errors.Is(err, apikey.ErrAPIKeyNotFound) {
+		c.JSON(http.StatusNotFound, gen.ErrorResponse{Message: err.Error(), Code: http.StatusNotFound})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// toAPIKeyResponse は API キーを API のレスポンスに変換する（キーのハッシュは返さない）
+func toAPIKeyResponse(key *entity.APIKey) gen.APIKey {
+	return gen.APIKey{
+		Id:         key.ID(),
+		Name:       key.Name(),
+		Prefix:     key.Prefix(),
+		Scopes:     key.ScopeNames(),
+		CreatedAt:  key.CreatedAt(),
+		ExpiresAt:  key.ExpiresAt(),
+		LastUsedAt: key.LastUsedAt(),
+	}
+}
@@ -0,0 +1,233 @@
+package apikey_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/apikey"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/apikey"
+)
+
+const testSecret = "nxk_abcdefgh0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZab"
+
+// --- モックの UserAPIKeyService ---
+type mockUserAPIKeyService struct {
+	mock.Mock
+}
+
+func (m *mockUserAPIKeyService) CreateAPIKey(objID string, name string, scopes []string, expiresAt *time.Time) (string, *entity.APIKey, error) {
+	args := m.Called(objID, name, scopes, expiresAt)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*entity.APIKey), args.Error(2)
+}
+
+func (m *mockUserAPIKeyService) ListAPIKeys(objID string) ([]*entity.APIKey, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.APIKey), args.Error(1)
+}
+
+func (m *mockUserAPIKeyService) RevokeAPIKey(objID string, keyID string) error {
+	args := m.Called(objID, keyID)
+	return args.Error(0)
+}
+
+func (m *mockUserAPIKeyService) Authenticate(secret string) (*entity.APIKey, error) {
+	args := m.Called(secret)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.APIKey), args.Error(1)
+}
+
+func (m *mockUserAPIKeyService) WithRepository(apiKeyRepository repository.APIKeyRepository) apikey.UserAPIKeyService {
+	return m
+}
+
+// --- テストスイート ---
+type UserAPIKeyHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserAPIKeyHandler
+	mockService *mockUserAPIKeyService
+}
+
+func TestUserAPIKeyHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserAPIKeyHandlerTestSuite))
+}
+
+func (suite *UserAPIKeyHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserAPIKeyService)
+	suite.handler = NewUserAPIKeyHandler(suite.mockService)
+}
+
+func (suite *UserAPIKeyHandlerTestSuite) newContext(method string, uid string, body string) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	if uid != "" {
+		c.Set("validated_uid", uid)
+	}
+	return c, w
+}
+
+// newAPIKey は profile:read スコープの API キーを生成する
+func (suite *UserAPIKeyHandlerTestSuite) newAPIKey() *entity.APIKey {
+	userObjID, err := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	suite.Require().NoError(err)
+	scope, err := value.NewAPIKeyScope(value.APIKeyScopeProfileRead)
+	suite.Require().NoError(err)
+	key, err := entity.NewAPIKey(userObjID, "CI", testSecret, []*value.APIKeyScope{scope}, nil)
+	suite.Require().NoError(err)
+	return key
+}
+
+// ----- ListAPIKeys のテスト -----
+
+func (suite *UserAPIKeyHandlerTestSuite) TestListAPIKeys() {
+	key := suite.newAPIKey()
+	suite.mockService.On("ListAPIKeys", "uid-1").Return([]*entity.APIKey{key}, nil)
+	c, w := suite.newContext(http.MethodGet, "uid-1", "")
+
+	suite.handler.ListAPIKeys(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.APIKeyListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().Len(resp.ApiKeys, 1)
+	suite.Equal(key.ID(), resp.ApiKeys[0].Id)
+	suite.Equal("nxk_abcdefgh", resp.ApiKeys[0].Prefix)
+	suite.Equal([]string{value.APIKeyScopeProfileRead}, resp.ApiKeys[0].Scopes)
+	suite.NotContains(w.Body.String(), key.SecretHash(), "キーのハッシュを返さないこと")
+}
+
+func (suite *UserAPIKeyHandlerTestSuite) TestListAPIKeys_Empty() {
+	suite.mockService.On("ListAPIKeys", "uid-1").Return(nil, nil)
+	c, w := suite.newContext(http.MethodGet, "uid-1", "")
+
+	suite.handler.ListAPIKeys(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`{"apiKeys":[]}`, w.Body.String())
+}
+
+func (suite *UserAPIKeyHandlerTestSuite) TestListAPIKeys_NoUID() {
+	c, w := suite.newContext(http.MethodGet, "", "")
+
+	suite.handler.ListAPIKeys(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// ----- CreateAPIKey のテスト -----
+
+func (suite *UserAPIKeyHandlerTestSuite) TestCreateAPIKey() {
+	key := suite.newAPIKey()
+	suite.mockService.On("CreateAPIKey", "uid-1", "CI", []string{"profile:read"}, (*time.Time)(nil)).Return(testSecret, key, nil)
+	c, w := suite.newContext(http.MethodPost, "uid-1", `{"name":"CI","scopes":["profile:read"]}`)
+
+	suite.handler.CreateAPIKey(c)
+
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Equal("no-store", w.Header().Get("Cache-Control"))
+	var resp gen.APIKeyCreatedResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal(testSecret, resp.Secret, "キーそのものを返すこと")
+	suite.Equal(key.ID(), resp.ApiKey.Id)
+}
+
+func (suite *UserAPIKeyHandlerTestSuite) TestCreateAPIKey_ExpiresAt() {
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockService.On("CreateAPIKey", "uid-1", "CI", []string{"*"}, mock.MatchedBy(func(t *time.Time) bool {
+		return t != nil && t.Equal(expiresAt)
+	})).Return(testSecret, suite.newAPIKey(), nil)
+	c, w := suite.newContext(http.MethodPost, "uid-1", `{"name":"CI","scopes":["*"],"expiresAt":"2030-01-02T03:04:05Z"}`)
+
+	suite.handler.CreateAPIKey(c)
+
+	suite.Equal(http.StatusCreated, w.Code)
+}
+
+func (suite *UserAPIKeyHandlerTestSuite) TestCreateAPIKey_Errors() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{apikey.ErrInvalidAPIKey, http.StatusBadRequest},
+		{apikey.ErrTooManyAPIKeys, http.StatusConflict},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("CreateAPIKey", "uid-1", "CI", []string{"profile:read"}, (*time.Time)(nil)).Return("", nil, tc.err)
+		c, w := suite.newContext(http.MethodPost, "uid-1", `{"name":"CI","scopes":["profile:read"]}`)
+
+		suite.handler.CreateAPIKey(c)
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *UserAPIKeyHandlerTestSuite) TestCreateAPIKey_BadRequest() {
+	c, w := suite.newContext(http.MethodPost, "uid-1", `{"name":`)
+
+	suite.handler.CreateAPIKey(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "CreateAPIKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	c, w = suite.newContext(http.MethodPost, "", `{"name":"CI","scopes":["profile:read"]}`)
+	suite.handler.CreateAPIKey(c)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// ----- RevokeAPIKey のテスト -----
+
+func (suite *UserAPIKeyHandlerTestSuite) TestRevokeAPIKey() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{apikey.ErrAPIKeyNotFound, http.StatusNotFound},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("RevokeAPIKey", "uid-1", "key-1").Return(tc.err)
+		c, w := suite.newContext(http.MethodDelete, "uid-1", "")
+
+		suite.handler.RevokeAPIKey(c, "key-1")
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *UserAPIKeyHandlerTestSuite) TestRevokeAPIKey_NoUID() {
+	c, w := suite.newContext(http.MethodDelete, "", "")
+
+	suite.handler.RevokeAPIKey(c, "key-1")
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "RevokeAPIKey", mock.Anything, mock.Anything)
+}
@@ -1,12 +1,16 @@
 package authentication
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/goda6565/nexus-user-auth/application/service/user/authentication"
 	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/interface/handler"
+	loginhistoryHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/loginhistory"
+	trusteddeviceHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/trusteddevice"
 )
 
 type UserAuthenticationHandler struct {
@@ -27,15 +31,38 @@ func (h *UserAuthenticationHandler) UserLogin(c *gin.Context) {
 		return
 	}
 
-	accessToken, refreshToken, err := h.userAuthenticationService.UserLogin(req.Email, req.Password)
+	client := loginhistoryHandler.ClientFromRequest(c)
+	result, err := h.userAuthenticationService.UserLogin(req.Email, req.Password, trusteddeviceHandler.TokenFromRequest(c, req.TrustedDeviceToken), client)
+	// 失敗が続いている場合は、再試行できるまでの秒数を Retry-After で返す（ロック中は 423、それ以外は 429）
+	if handler.RespondLockout(c, err) {
+		return
+	}
+	if errors.Is(err, authentication.ErrEmailNotVerified) {
+		c.JSON(http.StatusForbidden, gen.ErrorResponse{Message: err.Error(), Code: http.StatusForbidden})
+		return
+	}
+	if resp, ok := handler.AccountStatusErrorResponse(err); ok {
+		c.JSON(resp.Code, resp)
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
 		return
 	}
 
+	// 二要素認証が有効な場合はチャレンジトークンのみ返す（トークンは /auth/mfa/verify または /auth/mfa/passkey/finish で発行する）
+	if result.MFARequired {
+		c.JSON(http.StatusOK, gen.LoginResponse{
+			MfaRequired: true,
+			MfaToken:    &result.MFAToken,
+			MfaMethods:  &result.MFAMethods,
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gen.LoginResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  &result.AccessToken,
+		RefreshToken: &result.RefreshToken,
 	})
 }
 
@@ -48,6 +75,10 @@ func (h *UserAuthenticationHandler) UserTokenRefresh(c *gin.Context) {
 	}
 
 	accessToken, err := h.userAuthenticationService.UserTokenRefresh(req.RefreshToken)
+	if resp, ok := handler.AccountStatusErrorResponse(err); ok {
+		c.JSON(resp.Code, resp)
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
 		return
@@ -7,12 +7,18 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/goda6565/nexus-user-auth/application/service/user/authentication"
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/interface/handler"
 	. "github.com/goda6565/nexus-user-auth/interface/handler/user/authentication"
 )
 
@@ -21,9 +27,20 @@ type mockUserAuthenticationService struct {
 	mock.Mock
 }
 
-func (m *mockUserAuthenticationService) UserLogin(email, password string) (string, string, error) {
-	args := m.Called(email, password)
-	return args.String(0), args.String(1), args.Error(2)
+func (m *mockUserAuthenticationService) UserLogin(email, password, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	args := m.Called(email, password, deviceToken, client)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authentication.LoginResult), args.Error(1)
+}
+
+func (m *mockUserAuthenticationService) CompleteLogin(user *entity.User, method string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	args := m.Called(user, method, deviceToken, client)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authentication.LoginResult), args.Error(1)
 }
 
 func (m *mockUserAuthenticationService) UserTokenRefresh(refreshToken string) (string, error) {
@@ -58,8 +75,8 @@ func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_Success() {
 	accessToken := "access_token_value"
 	refreshToken := "refresh_token_value"
 	suite.mockService.
-		On("UserLogin", reqBody.Email, reqBody.Password).
-		Return(accessToken, refreshToken, nil)
+		On("UserLogin", reqBody.Email, reqBody.Password, "", mock.Anything).
+		Return(&authentication.LoginResult{AccessToken: accessToken, RefreshToken: refreshToken}, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(bodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -73,8 +90,72 @@ func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_Success() {
 	var resp gen.LoginResponse
 	err = json.Unmarshal(w.Body.Bytes(), &resp)
 	suite.Require().NoError(err)
-	suite.Equal(accessToken, resp.AccessToken)
-	suite.Equal(refreshToken, resp.RefreshToken)
+	suite.Require().NotNil(resp.AccessToken)
+	suite.Require().NotNil(resp.RefreshToken)
+	suite.Equal(accessToken, *resp.AccessToken)
+	suite.Equal(refreshToken, *resp.RefreshToken)
+	suite.False(resp.MfaRequired)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 二要素認証が有効: トークンの代わりにチャレンジトークンが返ること
+func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_MFARequired() {
+	reqBody := gen.UserLoginRequestBody{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+	bodyBytes, err := json.Marshal(reqBody)
+	suite.Require().NoError(err)
+
+	suite.mockService.
+		On("UserLogin", reqBody.Email, reqBody.Password, "", mock.Anything).
+		Return(&authentication.LoginResult{MFARequired: true, MFAToken: "mfa_token_value", MFAMethods: []string{"totp", "passkey"}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	suite.handler.UserLogin(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+	suite.Require().NoError(err)
+	suite.True(resp.MfaRequired)
+	suite.Require().NotNil(resp.MfaToken)
+	suite.Equal("mfa_token_value", *resp.MfaToken)
+	suite.Require().NotNil(resp.MfaMethods)
+	suite.Equal([]string{"totp", "passkey"}, *resp.MfaMethods)
+	suite.Nil(resp.AccessToken, "二要素目の検証前にトークンを返さないこと")
+	suite.Nil(resp.RefreshToken)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 信頼済み端末: Cookie のトークンをサービスに渡すこと
+func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_TrustedDeviceCookie() {
+	reqBody := gen.UserLoginRequestBody{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+	bodyBytes, err := json.Marshal(reqBody)
+	suite.Require().NoError(err)
+
+	suite.mockService.
+		On("UserLogin", reqBody.Email, reqBody.Password, "device-token", mock.Anything).
+		Return(&authentication.LoginResult{AccessToken: "access", RefreshToken: "refresh"}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	req.AddCookie(&http.Cookie{Name: "trusted_device", Value: "device-token"})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	suite.handler.UserLogin(c)
+
+	suite.Equal(http.StatusOK, w.Code)
 	suite.mockService.AssertExpectations(suite.T())
 }
 
@@ -107,8 +188,8 @@ func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_ServiceError() {
 
 	serviceErr := errors.New("login failed")
 	suite.mockService.
-		On("UserLogin", reqBody.Email, reqBody.Password).
-		Return("", "", serviceErr)
+		On("UserLogin", reqBody.Email, reqBody.Password, "", mock.Anything).
+		Return(nil, serviceErr)
 
 	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(bodyBytes))
 	req.Header.Set("Content-Type", "application/json")
@@ -127,6 +208,117 @@ func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_ServiceError() {
 	suite.mockService.AssertExpectations(suite.T())
 }
 
+// メールアドレス未確認: 403 が返ること
+func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_EmailNotVerified() {
+	reqBody := gen.UserLoginRequestBody{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+	bodyBytes, err := json.Marshal(reqBody)
+	suite.Require().NoError(err)
+
+	suite.mockService.
+		On("UserLogin", reqBody.Email, reqBody.Password, "", mock.Anything).
+		Return(nil, authentication.ErrEmailNotVerified)
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	suite.handler.UserLogin(c)
+
+	suite.Equal(http.StatusForbidden, w.Code)
+	var errResp gen.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &errResp)
+	suite.Require().NoError(err)
+	suite.Equal(http.StatusForbidden, errResp.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 有効でないアカウントの場合: 403 と状態ごとのエラーコードを返すこと
+func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_InactiveAccount() {
+	cases := []struct {
+		err  error
+		code string
+	}{
+		{entity.ErrAccountPending, handler.ErrorCodeAccountPending},
+		{entity.ErrAccountSuspended, handler.ErrorCodeAccountSuspended},
+		{entity.ErrAccountDeactivated, handler.ErrorCodeAccountDeactivated},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		reqBody := gen.UserLoginRequestBody{
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+		bodyBytes, err := json.Marshal(reqBody)
+		suite.Require().NoError(err)
+		suite.mockService.
+			On("UserLogin", reqBody.Email, reqBody.Password, "", mock.Anything).
+			Return(nil, tc.err)
+
+		req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+
+		suite.handler.UserLogin(c)
+
+		suite.Equal(http.StatusForbidden, w.Code)
+		var errResp gen.ErrorResponse
+		err = json.Unmarshal(w.Body.Bytes(), &errResp)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(errResp.Error)
+		suite.Equal(tc.code, *errResp.Error)
+	}
+}
+
+// 失敗が続いている場合: ロック中は 423、それ以外は 429 と Retry-After を返すこと
+func (suite *UserAuthenticationHandlerTestSuite) TestUserLogin_Lockout() {
+	cases := []struct {
+		err        error
+		status     int
+		retryAfter string
+	}{
+		{&lockout.LockoutError{Err: lockout.ErrAccountLocked, RetryAfter: 30 * time.Minute}, http.StatusLocked, "1800"},
+		{&lockout.LockoutError{Err: lockout.ErrLoginThrottled, RetryAfter: 1500 * time.Millisecond}, http.StatusTooManyRequests, "2"},
+		{&lockout.LockoutError{Err: lockout.ErrLoginThrottled, RetryAfter: 0}, http.StatusTooManyRequests, "1"},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		reqBody := gen.UserLoginRequestBody{
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+		bodyBytes, err := json.Marshal(reqBody)
+		suite.Require().NoError(err)
+
+		suite.mockService.
+			On("UserLogin", reqBody.Email, reqBody.Password, "", loginhistory.ClientInfo{IP: "192.0.2.1", UserAgent: "Mozilla/5.0"}).
+			Return(nil, tc.err)
+
+		req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.RemoteAddr = "192.0.2.1:12345"
+		req.Header.Set("User-Agent", "Mozilla/5.0")
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+
+		suite.handler.UserLogin(c)
+
+		suite.Equal(tc.status, w.Code)
+		suite.Equal(tc.retryAfter, w.Header().Get("Retry-After"))
+		var errResp gen.ErrorResponse
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+		suite.Equal(tc.status, errResp.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
 // ----- UserTokenRefresh のテスト -----
 
 // 正常系: 正しいJSONを渡し、トークンリフレッシュに成功する場合
@@ -206,6 +398,33 @@ func (suite *UserAuthenticationHandlerTestSuite) TestUserTokenRefresh_ServiceErr
 	suite.mockService.AssertExpectations(suite.T())
 }
 
+// 停止されたアカウントのリフレッシュ: 403 と account_suspended を返すこと
+func (suite *UserAuthenticationHandlerTestSuite) TestUserTokenRefresh_Suspended() {
+	reqBody := gen.TokenRefreshRequestBody{
+		RefreshToken: "old_refresh_token",
+	}
+	bodyBytes, err := json.Marshal(reqBody)
+	suite.Require().NoError(err)
+	suite.mockService.
+		On("UserTokenRefresh", reqBody.RefreshToken).
+		Return("", entity.ErrAccountSuspended)
+
+	req := httptest.NewRequest(http.MethodPost, "/token/refresh", bytes.NewBuffer(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	suite.handler.UserTokenRefresh(c)
+
+	suite.Equal(http.StatusForbidden, w.Code)
+	var errResp gen.ErrorResponse
+	err = json.Unmarshal(w.Body.Bytes(), &errResp)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(errResp.Error)
+	suite.Equal(handler.ErrorCodeAccountSuspended, *errResp.Error)
+}
+
 func TestUserAuthenticationHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(UserAuthenticationHandlerTestSuite))
 }
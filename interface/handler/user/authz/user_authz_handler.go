@@ -0,0 +1,109 @@
+package authz
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/authz"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/policy"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type UserAuthzHandler struct {
+	userAuthzService authz.UserAuthzService
+}
+
+func NewUserAuthzHandler(userAuthzService authz.UserAuthzService) *UserAuthzHandler {
+	return &UserAuthzHandler{
+		userAuthzService: userAuthzService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーのIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// CheckAuthz: 認可の判定
+func (h *UserAuthzHandler) CheckAuthz(c *gin.Context) {
+	objID, ok := getValidatedUID(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	var req gen.CheckAuthzJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	request := authz.CheckRequest{Action: req.Action, Token: tokenFromContext(c)}
+	if req.Resource != nil {
+		request.Resource.Type = req.Resource.Type
+		if req.Resource.Id != nil {
+			request.Resource.ID = *req.Resource.Id
+		}
+		if req.Resource.Attributes != nil {
+			request.Resource.Attributes = *req.Resource.Attributes
+		}
+	}
+	if req.Context != nil {
+		request.Context = *req.Context
+	}
+
+	decision, err := h.userAuthzService.Check(objID, request)
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, authz.ErrInvalidCheck):
+			code = http.StatusBadRequest
+		case errors.Is(err, authz.ErrUserNotFound):
+			code = http.StatusUnauthorized
+		}
+		c.JSON(code, gen.ErrorResponse{Message: err.Error(), Code: code})
+		return
+	}
+
+	c.JSON(http.StatusOK, toDecisionResponse(decision))
+}
+
+// tokenFromContext は、AuthMiddleware が Context にセットしたアクセストークンの情報を取得する
+func tokenFromContext(c *gin.Context) authz.Token {
+	var token authz.Token
+	if auth, ok := c.Value("validated_auth").(utils.AuthContext); ok {
+		token.Auth = auth
+	}
+	if issuedAt, ok := c.Value("validated_issued_at").(time.Time); ok {
+		token.IssuedAt = issuedAt
+	}
+	token.OrgID = c.GetString("validated_org_id")
+	return token
+}
+
+func toDecisionResponse(decision *policy.Decision) gen.AuthzDecisionResponse {
+	response := gen.AuthzDecisionResponse{
+		Allowed: decision.Allowed,
+		Reason:  decision.Reason,
+	}
+	if decision.Policy != "" {
+		response.Policy = &decision.Policy
+	}
+	return response
+}
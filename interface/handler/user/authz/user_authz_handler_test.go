@@ -0,0 +1,143 @@
+package authz_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/authz"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/authz"
+	"github.com/goda6565/nexus-user-auth/pkg/policy"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// --- モックの UserAuthzService ---
+type mockUserAuthzService struct {
+	mock.Mock
+}
+
+func (m *mockUserAuthzService) Check(userObjID string, request authz.CheckRequest) (*policy.Decision, error) {
+	args := m.Called(userObjID, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*policy.Decision), args.Error(1)
+}
+
+// --- テストスイート ---
+
+type UserAuthzHandlerTestSuite struct {
+	suite.Suite
+	mockService *mockUserAuthzService
+	handler     *UserAuthzHandler
+}
+
+func TestUserAuthzHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserAuthzHandlerTestSuite))
+}
+
+func (suite *UserAuthzHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserAuthzService)
+	suite.handler = NewUserAuthzHandler(suite.mockService)
+}
+
+func (suite *UserAuthzHandlerTestSuite) newContext(body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/authz/check", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Set("validated_uid", "user-1")
+	return c, w
+}
+
+func (suite *UserAuthzHandlerTestSuite) TestCheckAuthz() {
+	issuedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	auth := utils.AuthContext{AuthTime: issuedAt, AMR: []string{utils.AMRPassword}}
+	expected := authz.CheckRequest{
+		Action:   "documents:read",
+		Resource: policy.Resource{Type: "document", ID: "d-1", Attributes: map[string]any{"owner": "user-1"}},
+		Context:  map[string]any{"ip": "10.0.0.1"},
+		Token:    authz.Token{IssuedAt: issuedAt, Auth: auth},
+	}
+	suite.mockService.On("Check", "user-1", expected).Return(&policy.Decision{Allowed: true, Policy: "owner", Reason: "allowed by policy"}, nil)
+
+	id := "d-1"
+	attributes := map[string]any{"owner": "user-1"}
+	context := map[string]any{"ip": "10.0.0.1"}
+	body, err := json.Marshal(gen.CheckAuthzJSONRequestBody{
+		Action:   "documents:read",
+		Resource: &gen.AuthzResource{Type: "document", Id: &id, Attributes: &attributes},
+		Context:  &context,
+	})
+	suite.Require().NoError(err)
+	c, w := suite.newContext(body)
+	c.Set("validated_auth", auth)
+	c.Set("validated_issued_at", issuedAt)
+
+	suite.handler.CheckAuthz(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.AuthzDecisionResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.True(response.Allowed)
+	suite.Require().NotNil(response.Policy)
+	suite.Equal("owner", *response.Policy)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *UserAuthzHandlerTestSuite) TestCheckAuthz_Denied() {
+	suite.mockService.On("Check", "user-1", authz.CheckRequest{Action: "users:write"}).Return(&policy.Decision{Allowed: false, Reason: "no policy allows the action"}, nil)
+	body, err := json.Marshal(gen.CheckAuthzJSONRequestBody{Action: "users:write"})
+	suite.Require().NoError(err)
+	c, w := suite.newContext(body)
+
+	suite.handler.CheckAuthz(c)
+
+	suite.Equal(http.StatusOK, w.Code, "拒否した場合も判定の結果を 200 で返すこと")
+	var response gen.AuthzDecisionResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.False(response.Allowed)
+	suite.Nil(response.Policy)
+}
+
+func (suite *UserAuthzHandlerTestSuite) TestCheckAuthz_Error() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{authz.ErrInvalidCheck, http.StatusBadRequest},
+		{authz.ErrUserNotFound, http.StatusUnauthorized},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("Check", "user-1", mock.Anything).Return(nil, tc.err)
+		body, err := json.Marshal(gen.CheckAuthzJSONRequestBody{Action: "users:read"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(body)
+
+		suite.handler.CheckAuthz(c)
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *UserAuthzHandlerTestSuite) TestCheckAuthz_InvalidJSON() {
+	c, w := suite.newContext([]byte("invalid json"))
+
+	suite.handler.CheckAuthz(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "Check", mock.Anything, mock.Anything)
+}
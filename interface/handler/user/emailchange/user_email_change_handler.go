@@ -0,0 +1,129 @@
+package emailchange
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/emailchange"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserEmailChangeHandler struct {
+	userEmailChangeService emailchange.UserEmailChangeService
+}
+
+func NewUserEmailChangeHandler(userEmailChangeService emailchange.UserEmailChangeService) *UserEmailChangeHandler {
+	return &UserEmailChangeHandler{
+		userEmailChangeService: userEmailChangeService,
+	}
+}
+
+func userToEmailChangeResponse(user *entity.User) *gen.EmailChangeResponse {
+	var emailVerifiedAt *time.Time
+	if user.EmailVerifiedAt() != nil {
+		t := user.EmailVerifiedAt().Value()
+		emailVerifiedAt = &t
+	}
+	return &gen.EmailChangeResponse{
+		Uid:             user.ObjID().Value(),
+		Email:           user.Email().Value(),
+		EmailVerifiedAt: emailVerifiedAt,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// errorStatus: サービスのエラーをHTTPステータスに変換
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, emailchange.ErrInvalidEmailChangeToken), errors.Is(err, emailchange.ErrSameEmail), errors.Is(err, emailchange.ErrInvalidEmail),
+		errors.Is(err, registration.ErrEmailDomainNotAllowed):
+		return http.StatusBadRequest
+	case errors.Is(err, emailchange.ErrEmailAlreadyInUse), errors.Is(err, emailchange.ErrEmailChangeConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// RequestEmailChange: メールアドレス変更のリクエスト
+func (h *UserEmailChangeHandler) RequestEmailChange(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req gen.EmailChangeRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	if err := h.userEmailChangeService.RequestEmailChange(objID, req.NewEmail); err != nil {
+		status := errorStatus(err)
+		c.JSON(status, gen.ErrorResponse{Message: err.Error(), Code: status})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gen.MessageResponse{
+		Message: "確認メールを新しいメールアドレスに送信しました",
+	})
+}
+
+// ConfirmEmailChange: メールアドレス変更の確認
+func (h *UserEmailChangeHandler) ConfirmEmailChange(c *gin.Context) {
+	var req gen.EmailChangeTokenRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	user, err := h.userEmailChangeService.ConfirmEmailChange(req.Token)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, gen.ErrorResponse{Message: err.Error(), Code: status})
+		return
+	}
+
+	c.JSON(http.StatusOK, userToEmailChangeResponse(user))
+}
+
+// UndoEmailChange: メールアドレス変更の取り消し
+func (h *UserEmailChangeHandler) UndoEmailChange(c *gin.Context) {
+	var req gen.EmailChangeTokenRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	user, err := h.userEmailChangeService.UndoEmailChange(req.Token)
+	if err != nil {
+		status := errorStatus(err)
+		c.JSON(status, gen.ErrorResponse{Message: err.Error(), Code: status})
+		return
+	}
+
+	c.JSON(http.StatusOK, userToEmailChangeResponse(user))
+}
@@ -0,0 +1,183 @@
+package emailchange_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/emailchange"
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/emailchange"
+)
+
+// --- モックの UserEmailChangeService ---
+type mockUserEmailChangeService struct {
+	mock.Mock
+}
+
+func (m *mockUserEmailChangeService) RequestEmailChange(objID string, newEmail string) error {
+	args := m.Called(objID, newEmail)
+	return args.Error(0)
+}
+
+func (m *mockUserEmailChangeService) ConfirmEmailChange(token string) (*entity.User, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserEmailChangeService) UndoEmailChange(token string) (*entity.User, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+// --- テストスイート ---
+type UserEmailChangeHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserEmailChangeHandler
+	mockService *mockUserEmailChangeService
+}
+
+func TestUserEmailChangeHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserEmailChangeHandlerTestSuite))
+}
+
+func (suite *UserEmailChangeHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserEmailChangeService)
+	suite.handler = NewUserEmailChangeHandler(suite.mockService)
+}
+
+func (suite *UserEmailChangeHandlerTestSuite) newContext(body any) (*gin.Context, *httptest.ResponseRecorder) {
+	bodyBytes, err := json.Marshal(body)
+	suite.Require().NoError(err)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+func createFakeUser(emailStr string) *entity.User {
+	email, _ := value.NewUserEmail(emailStr)
+	username, _ := value.NewUserUsername("username")
+	fakeUser, _ := entity.NewUser(email, value.FromHashed("hashed"), username)
+	return fakeUser
+}
+
+// ----- RequestEmailChange のテスト -----
+
+// 正常系: 202 が返ること
+func (suite *UserEmailChangeHandlerTestSuite) TestRequestEmailChange_Success() {
+	suite.mockService.On("RequestEmailChange", "123", "new@example.com").Return(nil)
+
+	c, w := suite.newContext(gen.EmailChangeRequestBody{NewEmail: "new@example.com"})
+	c.Set("validated_uid", "123")
+	suite.handler.RequestEmailChange(c)
+
+	suite.Equal(http.StatusAccepted, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 認証情報がない場合: 400 が返ること
+func (suite *UserEmailChangeHandlerTestSuite) TestRequestEmailChange_NoUID() {
+	c, w := suite.newContext(gen.EmailChangeRequestBody{NewEmail: "new@example.com"})
+	suite.handler.RequestEmailChange(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "RequestEmailChange", mock.Anything, mock.Anything)
+}
+
+// 使用済みのアドレス: 409 が返ること
+func (suite *UserEmailChangeHandlerTestSuite) TestRequestEmailChange_EmailInUse() {
+	suite.mockService.On("RequestEmailChange", "123", "new@example.com").Return(emailchange.ErrEmailAlreadyInUse)
+
+	c, w := suite.newContext(gen.EmailChangeRequestBody{NewEmail: "new@example.com"})
+	c.Set("validated_uid", "123")
+	suite.handler.RequestEmailChange(c)
+
+	suite.Equal(http.StatusConflict, w.Code)
+	var errResp gen.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	suite.Equal(http.StatusConflict, errResp.Code)
+}
+
+// 登録を許可しないドメイン: 400 が返ること
+func (suite *UserEmailChangeHandlerTestSuite) TestRequestEmailChange_DomainNotAllowed() {
+	suite.mockService.On("RequestEmailChange", "123", "new@example.com").Return(registration.ErrEmailDomainNotAllowed)
+
+	c, w := suite.newContext(gen.EmailChangeRequestBody{NewEmail: "new@example.com"})
+	c.Set("validated_uid", "123")
+	suite.handler.RequestEmailChange(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// ----- ConfirmEmailChange のテスト -----
+
+// 正常系: 変更後のアドレスが返ること
+func (suite *UserEmailChangeHandlerTestSuite) TestConfirmEmailChange_Success() {
+	fakeUser := createFakeUser("new@example.com")
+	suite.mockService.On("ConfirmEmailChange", "token").Return(fakeUser, nil)
+
+	c, w := suite.newContext(gen.EmailChangeTokenRequestBody{Token: "token"})
+	suite.handler.ConfirmEmailChange(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.EmailChangeResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal(fakeUser.ObjID().Value(), resp.Uid)
+	suite.Equal("new@example.com", resp.Email)
+}
+
+// 不正なトークン: 400 が返ること
+func (suite *UserEmailChangeHandlerTestSuite) TestConfirmEmailChange_InvalidToken() {
+	suite.mockService.On("ConfirmEmailChange", "token").Return(nil, emailchange.ErrInvalidEmailChangeToken)
+
+	c, w := suite.newContext(gen.EmailChangeTokenRequestBody{Token: "token"})
+	suite.handler.ConfirmEmailChange(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// ----- UndoEmailChange のテスト -----
+
+// 正常系: 元のアドレスが返ること
+func (suite *UserEmailChangeHandlerTestSuite) TestUndoEmailChange_Success() {
+	fakeUser := createFakeUser("old@example.com")
+	suite.mockService.On("UndoEmailChange", "token").Return(fakeUser, nil)
+
+	c, w := suite.newContext(gen.EmailChangeTokenRequestBody{Token: "token"})
+	suite.handler.UndoEmailChange(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.EmailChangeResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal("old@example.com", resp.Email)
+}
+
+// サービスエラー: 500 が返ること
+func (suite *UserEmailChangeHandlerTestSuite) TestUndoEmailChange_ServiceError() {
+	suite.mockService.On("UndoEmailChange", "token").Return(nil, errors.New("update failed"))
+
+	c, w := suite.newContext(gen.EmailChangeTokenRequestBody{Token: "token"})
+	suite.handler.UndoEmailChange(c)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
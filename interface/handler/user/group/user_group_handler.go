@@ -0,0 +1,240 @@
+package group
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/group"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserGroupHandler struct {
+	userGroupService group.UserGroupService
+}
+
+func NewUserGroupHandler(userGroupService group.UserGroupService) *UserGroupHandler {
+	return &UserGroupHandler{
+		userGroupService: userGroupService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーのIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// requireUID は認証済みユーザーのIDを取得し、取得できない場合は 400 を返す
+func requireUID(c *gin.Context) (string, bool) {
+	objID, ok := getValidatedUID(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return "", false
+	}
+	return objID, true
+}
+
+// ListAdminGroups: グループの一覧
+func (h *UserGroupHandler) ListAdminGroups(c *gin.Context) {
+	groups, err := h.userGroupService.ListGroups()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := gen.GroupListResponse{Groups: make([]gen.Group, 0, len(groups))}
+	for _, g := range groups {
+		response.Groups = append(response.Groups, toGroupResponse(g))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateAdminGroup: グループの作成
+func (h *UserGroupHandler) CreateAdminGroup(c *gin.Context) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.CreateAdminGroupJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	roles := []string{}
+	if req.Roles != nil {
+		roles = *req.Roles
+	}
+	permissions := []string{}
+	if req.Permissions != nil {
+		permissions = *req.Permissions
+	}
+
+	created, err := h.userGroupService.CreateGroup(actorObjID, req.Name, description, roles, permissions)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toGroupResponse(created))
+}
+
+// GetAdminGroup: グループと、グループに直接所属するユーザー・入れ子のグループの取得
+func (h *UserGroupHandler) GetAdminGroup(c *gin.Context, groupId string) {
+	found, members, err := h.userGroupService.GetGroup(groupId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.GroupDetailResponse{
+		Group:       toGroupResponse(found),
+		UserIds:     members.UserObjIDs,
+		SubgroupIds: members.GroupObjIDs,
+	})
+}
+
+// UpdateAdminGroup: グループの説明とロール・権限の変更
+func (h *UserGroupHandler) UpdateAdminGroup(c *gin.Context, groupId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.UpdateAdminGroupJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+
+	updated, err := h.userGroupService.UpdateGroup(actorObjID, groupId, description, req.Roles, req.Permissions)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toGroupResponse(updated))
+}
+
+// DeleteAdminGroup: グループの削除
+func (h *UserGroupHandler) DeleteAdminGroup(c *gin.Context, groupId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userGroupService.DeleteGroup(actorObjID, groupId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AddAdminGroupUser: ユーザーをグループに追加
+func (h *UserGroupHandler) AddAdminGroupUser(c *gin.Context, groupId string, userId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userGroupService.AddUser(actorObjID, groupId, userId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RemoveAdminGroupUser: ユーザーをグループから削除
+func (h *UserGroupHandler) RemoveAdminGroupUser(c *gin.Context, groupId string, userId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userGroupService.RemoveUser(actorObjID, groupId, userId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AddAdminSubgroup: 入れ子のグループを追加
+func (h *UserGroupHandler) AddAdminSubgroup(c *gin.Context, groupId string, subgroupId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userGroupService.AddSubgroup(actorObjID, groupId, subgroupId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RemoveAdminSubgroup: 入れ子のグループを削除
+func (h *UserGroupHandler) RemoveAdminSubgroup(c *gin.Context, groupId string, subgroupId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userGroupService.RemoveSubgroup(actorObjID, groupId, subgroupId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondError はサービスのエラーに対応するステータスコードでエラーを返す
+func respondError(c *gin.Context, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, group.ErrGroupNotFound), errors.Is(err, group.ErrSubgroupNotFound), errors.Is(err, group.ErrUserNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, group.ErrInvalidGroup), errors.Is(err, group.ErrRoleNotFound):
+		code = http.StatusBadRequest
+	case errors.Is(err, group.ErrCannotGrant):
+		code = http.StatusForbidden
+	case errors.Is(err, group.ErrGroupAlreadyExists), errors.Is(err, group.ErrCircularMembership):
+		code = http.StatusConflict
+	}
+	c.JSON(code, gen.ErrorResponse{Message: err.Error(), Code: code})
+}
+
+func toGroupResponse(g *entity.Group) gen.Group {
+	return gen.Group{
+		Id:          g.ObjID().Value(),
+		Name:        g.Name().Value(),
+		Description: g.Description(),
+		Roles:       g.RoleNames(),
+		Permissions: g.PermissionNames(),
+		CreatedAt:   g.CreatedAt(),
+	}
+}
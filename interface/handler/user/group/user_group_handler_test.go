@@ -0,0 +1,357 @@
+package group_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/group"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/group"
+)
+
+// --- モックの UserGroupService ---
+type mockUserGroupService struct {
+	mock.Mock
+}
+
+func (m *mockUserGroupService) ListGroups() ([]*entity.Group, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Group), args.Error(1)
+}
+
+func (m *mockUserGroupService) GetGroup(groupObjID string) (*entity.Group, *repository.GroupMembers, error) {
+	args := m.Called(groupObjID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*entity.Group), args.Get(1).(*repository.GroupMembers), args.Error(2)
+}
+
+func (m *mockUserGroupService) CreateGroup(actorObjID string, name string, description string, roles []string, permissions []string) (*entity.Group, error) {
+	args := m.Called(actorObjID, name, description, roles, permissions)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Group), args.Error(1)
+}
+
+func (m *mockUserGroupService) UpdateGroup(actorObjID string, groupObjID string, description string, roles []string, permissions []string) (*entity.Group, error) {
+	args := m.Called(actorObjID, groupObjID, description, roles, permissions)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Group), args.Error(1)
+}
+
+func (m *mockUserGroupService) DeleteGroup(actorObjID string, groupObjID string) error {
+	args := m.Called(actorObjID, groupObjID)
+	return args.Error(0)
+}
+
+func (m *mockUserGroupService) AddUser(actorObjID string, groupObjID string, userObjID string) error {
+	args := m.Called(actorObjID, groupObjID, userObjID)
+	return args.Error(0)
+}
+
+func (m *mockUserGroupService) RemoveUser(actorObjID string, groupObjID string, userObjID string) error {
+	args := m.Called(actorObjID, groupObjID, userObjID)
+	return args.Error(0)
+}
+
+func (m *mockUserGroupService) AddSubgroup(actorObjID string, groupObjID string, subgroupObjID string) error {
+	args := m.Called(actorObjID, groupObjID, subgroupObjID)
+	return args.Error(0)
+}
+
+func (m *mockUserGroupService) RemoveSubgroup(actorObjID string, groupObjID string, subgroupObjID string) error {
+	args := m.Called(actorObjID, groupObjID, subgroupObjID)
+	return args.Error(0)
+}
+
+func (m *mockUserGroupService) ResolveUserGroups(userObjID string) (*group.UserGroups, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*group.UserGroups), args.Error(1)
+}
+
+func (m *mockUserGroupService) GroupNames(userObjID string) ([]string, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockUserGroupService) InvalidateCache() {
+	m.Called()
+}
+
+// --- テストスイート ---
+
+const (
+	actorObjID    = "admin-1"
+	groupObjID    = "22222222-2222-2222-2222-222222222222"
+	subgroupObjID = "33333333-3333-3333-3333-333333333333"
+	memberObjID   = "44444444-4444-4444-4444-444444444444"
+)
+
+type UserGroupHandlerTestSuite struct {
+	suite.Suite
+	mockService *mockUserGroupService
+	handler     *UserGroupHandler
+	testGroup   *entity.Group
+}
+
+func TestUserGroupHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserGroupHandlerTestSuite))
+}
+
+func (suite *UserGroupHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserGroupService)
+	suite.handler = NewUserGroupHandler(suite.mockService)
+
+	name, _ := value.NewGroupName("engineering")
+	role, _ := value.NewUserRole("support")
+	permission, _ := value.NewPermission(value.PermissionUsersRead)
+	testGroup, err := entity.NewGroup(name, "開発チーム", []*value.UserRole{role}, []*value.Permission{permission})
+	suite.Require().NoError(err)
+	suite.testGroup = testGroup
+}
+
+func (suite *UserGroupHandlerTestSuite) newContext(method string, target string, body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Set("validated_uid", actorObjID)
+	return c, w
+}
+
+// ----- ListAdminGroups / GetAdminGroup のテスト -----
+
+func (suite *UserGroupHandlerTestSuite) TestListAdminGroups() {
+	suite.mockService.On("ListGroups").Return([]*entity.Group{suite.testGroup}, nil)
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+
+	suite.handler.ListAdminGroups(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.GroupListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.Groups, 1)
+	suite.Equal(suite.testGroup.ObjID().Value(), response.Groups[0].Id)
+	suite.Equal("engineering", response.Groups[0].Name)
+	suite.Equal("開発チーム", response.Groups[0].Description)
+	suite.Equal([]string{"support"}, response.Groups[0].Roles)
+	suite.Equal([]string{"users:read"}, response.Groups[0].Permissions)
+}
+
+func (suite *UserGroupHandlerTestSuite) TestGetAdminGroup() {
+	suite.mockService.On("GetGroup", groupObjID).Return(suite.testGroup, &repository.GroupMembers{
+		UserObjIDs:  []string{memberObjID},
+		GroupObjIDs: []string{subgroupObjID},
+	}, nil)
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+
+	suite.handler.GetAdminGroup(c, groupObjID)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.GroupDetailResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Equal("engineering", response.Group.Name)
+	suite.Equal([]string{memberObjID}, response.UserIds)
+	suite.Equal([]string{subgroupObjID}, response.SubgroupIds)
+}
+
+func (suite *UserGroupHandlerTestSuite) TestGetAdminGroup_Error() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{group.ErrGroupNotFound, http.StatusNotFound},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("GetGroup", groupObjID).Return(nil, nil, tc.err)
+		c, w := suite.newContext(http.MethodGet, "/", nil)
+
+		suite.handler.GetAdminGroup(c, groupObjID)
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+// ----- CreateAdminGroup のテスト -----
+
+func (suite *UserGroupHandlerTestSuite) TestCreateAdminGroup() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusCreated},
+		{group.ErrInvalidGroup, http.StatusBadRequest},
+		{group.ErrRoleNotFound, http.StatusBadRequest},
+		{group.ErrGroupAlreadyExists, http.StatusConflict},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("CreateGroup", actorObjID, "engineering", "開発チーム", []string{"support"}, []string{}).Return(suite.testGroup, nil)
+		} else {
+			suite.mockService.On("CreateGroup", actorObjID, "engineering", "開発チーム", []string{"support"}, []string{}).Return(nil, tc.err)
+		}
+		description := "開発チーム"
+		roles := []string{"support"}
+		body, err := json.Marshal(gen.CreateAdminGroupJSONRequestBody{Name: "engineering", Description: &description, Roles: &roles})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, "/", body)
+
+		suite.handler.CreateAdminGroup(c)
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserGroupHandlerTestSuite) TestCreateAdminGroup_InvalidJSON() {
+	c, w := suite.newContext(http.MethodPost, "/", []byte("invalid json"))
+
+	suite.handler.CreateAdminGroup(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "CreateGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ----- UpdateAdminGroup / DeleteAdminGroup のテスト -----
+
+func (suite *UserGroupHandlerTestSuite) TestUpdateAdminGroup() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{group.ErrGroupNotFound, http.StatusNotFound},
+		{group.ErrInvalidGroup, http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("UpdateGroup", actorObjID, groupObjID, "", []string{}, []string{"users:write"}).Return(suite.testGroup, nil)
+		} else {
+			suite.mockService.On("UpdateGroup", actorObjID, groupObjID, "", []string{}, []string{"users:write"}).Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.UpdateAdminGroupJSONRequestBody{Roles: []string{}, Permissions: []string{"users:write"}})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPut, "/", body)
+
+		suite.handler.UpdateAdminGroup(c, groupObjID)
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserGroupHandlerTestSuite) TestDeleteAdminGroup() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{group.ErrGroupNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("DeleteGroup", actorObjID, groupObjID).Return(tc.err)
+		c, w := suite.newContext(http.MethodDelete, "/", nil)
+
+		suite.handler.DeleteAdminGroup(c, groupObjID)
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+// ----- 所属の変更のテスト -----
+
+func (suite *UserGroupHandlerTestSuite) TestAddAndRemoveAdminGroupUser() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{group.ErrUserNotFound, http.StatusNotFound},
+		{group.ErrGroupNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("AddUser", actorObjID, groupObjID, memberObjID).Return(tc.err)
+		suite.mockService.On("RemoveUser", actorObjID, groupObjID, memberObjID).Return(tc.err)
+
+		c, w := suite.newContext(http.MethodPut, "/", nil)
+		suite.handler.AddAdminGroupUser(c, groupObjID, memberObjID)
+		c.Writer.WriteHeaderNow()
+		suite.Equal(tc.status, w.Code)
+
+		c, w = suite.newContext(http.MethodDelete, "/", nil)
+		suite.handler.RemoveAdminGroupUser(c, groupObjID, memberObjID)
+		c.Writer.WriteHeaderNow()
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *UserGroupHandlerTestSuite) TestAddAndRemoveAdminSubgroup() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{group.ErrSubgroupNotFound, http.StatusNotFound},
+		{group.ErrCircularMembership, http.StatusConflict},
+		{group.ErrCannotGrant, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("AddSubgroup", actorObjID, groupObjID, subgroupObjID).Return(tc.err)
+		suite.mockService.On("RemoveSubgroup", actorObjID, groupObjID, subgroupObjID).Return(nil)
+
+		c, w := suite.newContext(http.MethodPut, "/", nil)
+		suite.handler.AddAdminSubgroup(c, groupObjID, subgroupObjID)
+		c.Writer.WriteHeaderNow()
+		suite.Equal(tc.status, w.Code)
+
+		c, w = suite.newContext(http.MethodDelete, "/", nil)
+		suite.handler.RemoveAdminSubgroup(c, groupObjID, subgroupObjID)
+		c.Writer.WriteHeaderNow()
+		suite.Equal(http.StatusNoContent, w.Code)
+	}
+}
+
+func (suite *UserGroupHandlerTestSuite) TestAddAdminGroupUser_NoUID() {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/", nil)
+
+	suite.handler.AddAdminGroupUser(c, groupObjID, memberObjID)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "AddUser", mock.Anything, mock.Anything, mock.Anything)
+}
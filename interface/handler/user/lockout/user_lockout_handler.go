@@ -0,0 +1,57 @@
+package lockout
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserLockoutHandler struct {
+	userLockoutService lockout.UserLockoutService
+}
+
+func NewUserLockoutHandler(userLockoutService lockout.UserLockoutService) *UserLockoutHandler {
+	return &UserLockoutHandler{
+		userLockoutService: userLockoutService,
+	}
+}
+
+// UnlockAccount: メールのリンクによるアカウントのロック解除
+func (h *UserLockoutHandler) UnlockAccount(c *gin.Context) {
+	var req gen.AccountUnlockRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	err := h.userLockoutService.Unlock(req.Token)
+	if errors.Is(err, lockout.ErrInvalidUnlockToken) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AdminUnlockUser: 管理者によるアカウントのロック解除
+func (h *UserLockoutHandler) AdminUnlockUser(c *gin.Context, userId string) {
+	err := h.userLockoutService.AdminUnlock(userId)
+	if errors.Is(err, lockout.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, gen.ErrorResponse{Message: err.Error(), Code: http.StatusNotFound})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,132 @@
+package lockout_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/lockout"
+)
+
+// --- モックの UserLockoutService ---
+type mockUserLockoutService struct {
+	mock.Mock
+}
+
+func (m *mockUserLockoutService) Check(email string, ip string) error {
+	args := m.Called(email, ip)
+	return args.Error(0)
+}
+
+func (m *mockUserLockoutService) RecordFailure(email string, ip string) error {
+	args := m.Called(email, ip)
+	return args.Error(0)
+}
+
+func (m *mockUserLockoutService) RecordSuccess(email string) {
+	m.Called(email)
+}
+
+func (m *mockUserLockoutService) Unlock(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *mockUserLockoutService) AdminUnlock(objID string) error {
+	args := m.Called(objID)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+type UserLockoutHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserLockoutHandler
+	mockService *mockUserLockoutService
+}
+
+func TestUserLockoutHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserLockoutHandlerTestSuite))
+}
+
+func (suite *UserLockoutHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserLockoutService)
+	suite.handler = NewUserLockoutHandler(suite.mockService)
+}
+
+func (suite *UserLockoutHandlerTestSuite) newContext(body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+// ----- UnlockAccount のテスト -----
+
+func (suite *UserLockoutHandlerTestSuite) TestUnlockAccount() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{lockout.ErrInvalidUnlockToken, http.StatusBadRequest},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("Unlock", "unlock-token").Return(tc.err)
+		body, err := json.Marshal(gen.AccountUnlockRequestBody{Token: "unlock-token"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(body)
+
+		suite.handler.UnlockAccount(c)
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserLockoutHandlerTestSuite) TestUnlockAccount_InvalidJSON() {
+	c, w := suite.newContext([]byte("invalid json"))
+
+	suite.handler.UnlockAccount(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "Unlock", mock.Anything)
+}
+
+// ----- AdminUnlockUser のテスト -----
+
+func (suite *UserLockoutHandlerTestSuite) TestAdminUnlockUser() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{lockout.ErrUserNotFound, http.StatusNotFound},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("AdminUnlock", "user-1").Return(tc.err)
+		c, w := suite.newContext(nil)
+
+		suite.handler.AdminUnlockUser(c, "user-1")
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
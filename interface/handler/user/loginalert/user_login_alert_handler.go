@@ -0,0 +1,42 @@
+package loginalert
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginalert"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserLoginAlertHandler struct {
+	userLoginAlertService loginalert.UserLoginAlertService
+}
+
+func NewUserLoginAlertHandler(userLoginAlertService loginalert.UserLoginAlertService) *UserLoginAlertHandler {
+	return &UserLoginAlertHandler{
+		userLoginAlertService: userLoginAlertService,
+	}
+}
+
+// SecureAccount: 新しい端末からのログインの通知メールのリンクによるセッションの無効化
+func (h *UserLoginAlertHandler) SecureAccount(c *gin.Context) {
+	var req gen.SecureAccountRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	resetToken, err := h.userLoginAlertService.SecureAccount(req.Token)
+	if errors.Is(err, loginalert.ErrInvalidSecureToken) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.AccountSecuredResponse{PasswordResetToken: resetToken})
+}
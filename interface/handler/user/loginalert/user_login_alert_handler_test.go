@@ -0,0 +1,108 @@
+package loginalert_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginalert"
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/loginalert"
+)
+
+// --- モックの UserLoginAlertService ---
+type mockUserLoginAlertService struct {
+	mock.Mock
+}
+
+func (m *mockUserLoginAlertService) NotifyNewDevice(login *event.LoginPayload) error {
+	args := m.Called(login)
+	return args.Error(0)
+}
+
+func (m *mockUserLoginAlertService) SecureAccount(token string) (string, error) {
+	args := m.Called(token)
+	return args.String(0), args.Error(1)
+}
+
+// --- テストスイート ---
+type UserLoginAlertHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserLoginAlertHandler
+	mockService *mockUserLoginAlertService
+}
+
+func TestUserLoginAlertHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserLoginAlertHandlerTestSuite))
+}
+
+func (suite *UserLoginAlertHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserLoginAlertService)
+	suite.handler = NewUserLoginAlertHandler(suite.mockService)
+}
+
+func (suite *UserLoginAlertHandlerTestSuite) newContext(body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+// ----- SecureAccount のテスト -----
+
+func (suite *UserLoginAlertHandlerTestSuite) TestSecureAccount_Success() {
+	suite.mockService.On("SecureAccount", "secure-token").Return("reset-token", nil)
+	body, err := json.Marshal(gen.SecureAccountRequestBody{Token: "secure-token"})
+	suite.Require().NoError(err)
+	c, w := suite.newContext(body)
+
+	suite.handler.SecureAccount(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.AccountSecuredResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal("reset-token", resp.PasswordResetToken)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *UserLoginAlertHandlerTestSuite) TestSecureAccount_Error() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{loginalert.ErrInvalidSecureToken, http.StatusBadRequest},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("SecureAccount", "secure-token").Return("", tc.err)
+		body, err := json.Marshal(gen.SecureAccountRequestBody{Token: "secure-token"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(body)
+
+		suite.handler.SecureAccount(c)
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserLoginAlertHandlerTestSuite) TestSecureAccount_InvalidJSON() {
+	c, w := suite.newContext([]byte("invalid json"))
+
+	suite.handler.SecureAccount(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "SecureAccount", mock.Anything)
+}
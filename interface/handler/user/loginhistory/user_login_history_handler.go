@@ -0,0 +1,102 @@
+package loginhistory
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserLoginHistoryHandler struct {
+	userLoginHistoryService loginhistory.UserLoginHistoryService
+}
+
+func NewUserLoginHistoryHandler(userLoginHistoryService loginhistory.UserLoginHistoryService) *UserLoginHistoryHandler {
+	return &UserLoginHistoryHandler{
+		userLoginHistoryService: userLoginHistoryService,
+	}
+}
+
+// ClientFromRequest: ログイン履歴に記録するクライアントの情報をリクエストから取得する
+func ClientFromRequest(c *gin.Context) loginhistory.ClientInfo {
+	return loginhistory.ClientInfo{IP: c.ClientIP(), UserAgent: c.Request.UserAgent()}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// GetLoginHistory: ログイン履歴の取得
+func (h *UserLoginHistoryHandler) GetLoginHistory(c *gin.Context, params gen.GetLoginHistoryParams) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	page, perPage := 1, 0
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.PerPage != nil {
+		perPage = *params.PerPage
+	}
+
+	result, err := h.userLoginHistoryService.ListLoginHistory(objID, page, perPage)
+	if errors.Is(err, loginhistory.ErrInvalidPage) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	history := make([]gen.LoginEvent, 0, len(result.Events))
+	for _, event := range result.Events {
+		history = append(history, toLoginEventResponse(event))
+	}
+	c.JSON(http.StatusOK, gen.LoginHistoryResponse{
+		LoginHistory: history,
+		Page:         result.Page,
+		PerPage:      result.PerPage,
+		Total:        result.Total,
+	})
+}
+
+func toLoginEventResponse(event *entity.LoginEvent) gen.LoginEvent {
+	response := gen.LoginEvent{
+		Id:         event.ID(),
+		Result:     gen.LoginEventResult(event.Result()),
+		Methods:    event.Methods(),
+		IpAddress:  event.IPAddress(),
+		UserAgent:  event.UserAgent(),
+		NewDevice:  event.IsNewDevice(),
+		OccurredAt: event.OccurredAt(),
+	}
+	if response.Methods == nil {
+		response.Methods = []string{}
+	}
+	if reason := event.Reason(); reason != "" {
+		response.Reason = &reason
+	}
+	return response
+}
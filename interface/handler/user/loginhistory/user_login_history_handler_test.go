@@ -0,0 +1,167 @@
+package loginhistory_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/loginhistory"
+)
+
+// --- モックの UserLoginHistoryService ---
+type mockUserLoginHistoryService struct {
+	mock.Mock
+}
+
+func (m *mockUserLoginHistoryService) RecordSuccess(objID string, methods []string, client loginhistory.ClientInfo) {
+	m.Called(objID, methods, client)
+}
+
+func (m *mockUserLoginHistoryService) RecordFailure(objID string, methods []string, reason string, client loginhistory.ClientInfo) {
+	m.Called(objID, methods, reason, client)
+}
+
+func (m *mockUserLoginHistoryService) ListLoginHistory(objID string, page int, perPage int) (*loginhistory.LoginHistoryPage, error) {
+	args := m.Called(objID, page, perPage)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*loginhistory.LoginHistoryPage), args.Error(1)
+}
+
+// --- テストスイート ---
+type UserLoginHistoryHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserLoginHistoryHandler
+	mockService *mockUserLoginHistoryService
+}
+
+func TestUserLoginHistoryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserLoginHistoryHandlerTestSuite))
+}
+
+func (suite *UserLoginHistoryHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserLoginHistoryService)
+	suite.handler = NewUserLoginHistoryHandler(suite.mockService)
+}
+
+func (suite *UserLoginHistoryHandlerTestSuite) newContext(uid string) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	if uid != "" {
+		c.Set("validated_uid", uid)
+	}
+	return c, w
+}
+
+// ----- ClientFromRequest のテスト -----
+
+func (suite *UserLoginHistoryHandlerTestSuite) TestClientFromRequest() {
+	c, _ := suite.newContext("")
+	c.Request.Header.Set("User-Agent", "Mozilla/5.0")
+
+	client := ClientFromRequest(c)
+
+	suite.Equal(loginhistory.ClientInfo{IP: "192.0.2.1", UserAgent: "Mozilla/5.0"}, client)
+}
+
+// ----- GetLoginHistory のテスト -----
+
+func (suite *UserLoginHistoryHandlerTestSuite) TestGetLoginHistory_Success() {
+	userObjID, err := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	suite.Require().NoError(err)
+	succeeded, err := entity.NewLoginEvent(userObjID, entity.LoginSucceeded, "", []string{"pwd", "otp", "mfa"}, "192.0.2.1", "Mozilla/5.0")
+	suite.Require().NoError(err)
+	suite.Require().NoError(succeeded.MarkNewDevice())
+	failed, err := entity.NewLoginEvent(userObjID, entity.LoginFailed, entity.LoginReasonInvalidCredentials, nil, "192.0.2.2", "")
+	suite.Require().NoError(err)
+	// page を省略した場合は 1 ページ目、perPage を省略した場合は既定の件数（0）で取得すること
+	suite.mockService.On("ListLoginHistory", "uid-1", 1, 0).Return(&loginhistory.LoginHistoryPage{
+		Events:  []*entity.LoginEvent{succeeded, failed},
+		Page:    1,
+		PerPage: 20,
+		Total:   2,
+	}, nil)
+	c, w := suite.newContext("uid-1")
+
+	suite.handler.GetLoginHistory(c, gen.GetLoginHistoryParams{})
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginHistoryResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal(1, resp.Page)
+	suite.Equal(20, resp.PerPage)
+	suite.Equal(int64(2), resp.Total)
+	suite.Require().Len(resp.LoginHistory, 2)
+	suite.Equal(succeeded.ID(), resp.LoginHistory[0].Id)
+	suite.Equal(gen.LoginEventResult("success"), resp.LoginHistory[0].Result)
+	suite.Nil(resp.LoginHistory[0].Reason)
+	suite.Equal([]string{"pwd", "otp", "mfa"}, resp.LoginHistory[0].Methods)
+	suite.Equal("Mozilla/5.0", resp.LoginHistory[0].UserAgent)
+	suite.True(resp.LoginHistory[0].NewDevice)
+	suite.Equal(gen.LoginEventResult("failure"), resp.LoginHistory[1].Result)
+	suite.False(resp.LoginHistory[1].NewDevice)
+	suite.Require().NotNil(resp.LoginHistory[1].Reason)
+	suite.Equal(entity.LoginReasonInvalidCredentials, *resp.LoginHistory[1].Reason)
+	// 認証方法がない場合も null ではなく空の配列を返すこと
+	suite.NotNil(resp.LoginHistory[1].Methods)
+	suite.Empty(resp.LoginHistory[1].Methods)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *UserLoginHistoryHandlerTestSuite) TestGetLoginHistory_WithParams() {
+	page, perPage := 3, 5
+	suite.mockService.On("ListLoginHistory", "uid-1", 3, 5).Return(&loginhistory.LoginHistoryPage{Page: 3, PerPage: 5, Total: 11}, nil)
+	c, w := suite.newContext("uid-1")
+
+	suite.handler.GetLoginHistory(c, gen.GetLoginHistoryParams{Page: &page, PerPage: &perPage})
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginHistoryResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.NotNil(resp.LoginHistory)
+	suite.Empty(resp.LoginHistory)
+	suite.Equal(int64(11), resp.Total)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *UserLoginHistoryHandlerTestSuite) TestGetLoginHistory_InvalidPage() {
+	perPage := 1000
+	suite.mockService.On("ListLoginHistory", "uid-1", 1, 1000).Return(nil, loginhistory.ErrInvalidPage)
+	c, w := suite.newContext("uid-1")
+
+	suite.handler.GetLoginHistory(c, gen.GetLoginHistoryParams{PerPage: &perPage})
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *UserLoginHistoryHandlerTestSuite) TestGetLoginHistory_MissingUID() {
+	c, w := suite.newContext("")
+
+	suite.handler.GetLoginHistory(c, gen.GetLoginHistoryParams{})
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "ListLoginHistory", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UserLoginHistoryHandlerTestSuite) TestGetLoginHistory_ServiceError() {
+	suite.mockService.On("ListLoginHistory", "uid-1", 1, 0).Return(nil, errors.New("db error"))
+	c, w := suite.newContext("uid-1")
+
+	suite.handler.GetLoginHistory(c, gen.GetLoginHistoryParams{})
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,143 @@
+package mfa
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/mfa"
+	"github.com/goda6565/nexus-user-auth/application/service/user/trusteddevice"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/interface/handler"
+	loginhistoryHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/loginhistory"
+	trusteddeviceHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/trusteddevice"
+)
+
+type UserMFAHandler struct {
+	userMFAService           mfa.UserMFAService
+	userTrustedDeviceService trusteddevice.UserTrustedDeviceService
+}
+
+func NewUserMFAHandler(userMFAService mfa.UserMFAService, userTrustedDeviceService trusteddevice.UserTrustedDeviceService) *UserMFAHandler {
+	return &UserMFAHandler{
+		userMFAService:           userMFAService,
+		userTrustedDeviceService: userTrustedDeviceService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// BeginTOTPEnrollment: TOTP 二要素認証の登録開始
+func (h *UserMFAHandler) BeginTOTPEnrollment(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	enrollment, err := h.userMFAService.BeginTOTPEnrollment(objID)
+	if errors.Is(err, mfa.ErrMFAAlreadyEnabled) {
+		c.JSON(http.StatusConflict, gen.ErrorResponse{Message: err.Error(), Code: http.StatusConflict})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.TOTPEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.URI,
+		QrCode:     enrollment.QRCode,
+	})
+}
+
+// ConfirmTOTPEnrollment: TOTP 二要素認証の登録確認
+func (h *UserMFAHandler) ConfirmTOTPEnrollment(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req gen.MFACodeRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	recoveryCodes, err := h.userMFAService.ConfirmTOTPEnrollment(objID, req.Code)
+	if errors.Is(err, mfa.ErrInvalidMFACode) || errors.Is(err, mfa.ErrMFANotEnrolled) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if errors.Is(err, mfa.ErrMFAAlreadyEnabled) {
+		c.JSON(http.StatusConflict, gen.ErrorResponse{Message: err.Error(), Code: http.StatusConflict})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.RecoveryCodesResponse{
+		RecoveryCodes: recoveryCodes,
+	})
+}
+
+// VerifyMFA: 二要素認証の検証（ログインの2段階目）
+func (h *UserMFAHandler) VerifyMFA(c *gin.Context) {
+	var req gen.MFAVerifyRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	accessToken, refreshToken, err := h.userMFAService.VerifyMFA(req.MfaToken, req.Code, loginhistoryHandler.ClientFromRequest(c))
+	// 失敗が続いている場合は、再試行できるまでの秒数を Retry-After で返す（ロック中は 423、それ以外は 429）
+	if handler.RespondLockout(c, err) {
+		return
+	}
+	if errors.Is(err, mfa.ErrInvalidMFAToken) || errors.Is(err, mfa.ErrInvalidMFACode) || errors.Is(err, mfa.ErrTooManyAttempts) {
+		c.JSON(http.StatusUnauthorized, gen.ErrorResponse{Message: err.Error(), Code: http.StatusUnauthorized})
+		return
+	}
+	if resp, ok := handler.AccountStatusErrorResponse(err); ok {
+		c.JSON(resp.Code, resp)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	resp := gen.LoginResponse{
+		AccessToken:  &accessToken,
+		RefreshToken: &refreshToken,
+	}
+	// 端末を記憶する場合は、以降のログインで二要素認証を省略するためのトークンを発行する
+	if req.RememberDevice != nil && *req.RememberDevice {
+		resp.TrustedDeviceToken = trusteddeviceHandler.Remember(c, h.userTrustedDeviceService, accessToken)
+	}
+	c.JSON(http.StatusOK, resp)
+}
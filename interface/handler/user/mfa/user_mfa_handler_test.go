@@ -0,0 +1,292 @@
+package mfa_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/mfa"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/mfa"
+	trusteddeviceHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/trusteddevice"
+)
+
+// --- モックの UserMFAService ---
+type mockUserMFAService struct {
+	mock.Mock
+}
+
+func (m *mockUserMFAService) BeginTOTPEnrollment(objID string) (*mfa.TOTPEnrollment, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mfa.TOTPEnrollment), args.Error(1)
+}
+
+func (m *mockUserMFAService) ConfirmTOTPEnrollment(objID string, code string) ([]string, error) {
+	args := m.Called(objID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockUserMFAService) VerifyMFA(mfaToken string, code string, client loginhistory.ClientInfo) (string, string, error) {
+	args := m.Called(mfaToken, code, client)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *mockUserMFAService) VerifyCode(objID string, code string) error {
+	args := m.Called(objID, code)
+	return args.Error(0)
+}
+
+// --- モックの UserTrustedDeviceService ---
+type mockUserTrustedDeviceService struct {
+	mock.Mock
+}
+
+func (m *mockUserTrustedDeviceService) Remember(accessToken string, name string) (string, *entity.TrustedDevice, error) {
+	args := m.Called(accessToken, name)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*entity.TrustedDevice), args.Error(2)
+}
+
+func (m *mockUserTrustedDeviceService) IsTrusted(objID string, token string) bool {
+	args := m.Called(objID, token)
+	return args.Bool(0)
+}
+
+func (m *mockUserTrustedDeviceService) ListDevices(objID string) ([]*entity.TrustedDevice, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TrustedDevice), args.Error(1)
+}
+
+func (m *mockUserTrustedDeviceService) RevokeDevice(objID string, deviceID string) error {
+	args := m.Called(objID, deviceID)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+type UserMFAHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserMFAHandler
+	mockService *mockUserMFAService
+	mockDevices *mockUserTrustedDeviceService
+}
+
+func TestUserMFAHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserMFAHandlerTestSuite))
+}
+
+func (suite *UserMFAHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserMFAService)
+	suite.mockDevices = new(mockUserTrustedDeviceService)
+	suite.handler = NewUserMFAHandler(suite.mockService, suite.mockDevices)
+}
+
+func (suite *UserMFAHandlerTestSuite) newContext(body any, uid string) (*gin.Context, *httptest.ResponseRecorder) {
+	var buf bytes.Buffer
+	if body != nil {
+		suite.Require().NoError(json.NewEncoder(&buf).Encode(body))
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", &buf)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	if uid != "" {
+		c.Set("validated_uid", uid)
+	}
+	return c, w
+}
+
+// ----- BeginTOTPEnrollment のテスト -----
+
+func (suite *UserMFAHandlerTestSuite) TestBeginTOTPEnrollment_Success() {
+	suite.mockService.On("BeginTOTPEnrollment", "uid-1").Return(&mfa.TOTPEnrollment{
+		Secret: "JBSWY3DPEHPK3PXP",
+		URI:    "otpauth://totp/Nexus:user@example.com?secret=JBSWY3DPEHPK3PXP",
+		QRCode: "data:image/png;base64,AAAA",
+	}, nil)
+	c, w := suite.newContext(nil, "uid-1")
+
+	suite.handler.BeginTOTPEnrollment(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.TOTPEnrollmentResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal("JBSWY3DPEHPK3PXP", resp.Secret)
+	suite.Contains(resp.OtpauthUri, "otpauth://totp/")
+	suite.Equal("data:image/png;base64,AAAA", resp.QrCode)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *UserMFAHandlerTestSuite) TestBeginTOTPEnrollment_NoUID() {
+	c, w := suite.newContext(nil, "")
+
+	suite.handler.BeginTOTPEnrollment(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "BeginTOTPEnrollment", mock.Anything)
+}
+
+func (suite *UserMFAHandlerTestSuite) TestBeginTOTPEnrollment_AlreadyEnabled() {
+	suite.mockService.On("BeginTOTPEnrollment", "uid-1").Return(nil, mfa.ErrMFAAlreadyEnabled)
+	c, w := suite.newContext(nil, "uid-1")
+
+	suite.handler.BeginTOTPEnrollment(c)
+
+	suite.Equal(http.StatusConflict, w.Code)
+}
+
+// ----- ConfirmTOTPEnrollment のテスト -----
+
+func (suite *UserMFAHandlerTestSuite) TestConfirmTOTPEnrollment_Success() {
+	suite.mockService.On("ConfirmTOTPEnrollment", "uid-1", "123456").Return([]string{"aaaaa-bbbbb", "ccccc-ddddd"}, nil)
+	c, w := suite.newContext(gen.MFACodeRequestBody{Code: "123456"}, "uid-1")
+
+	suite.handler.ConfirmTOTPEnrollment(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.RecoveryCodesResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal([]string{"aaaaa-bbbbb", "ccccc-ddddd"}, resp.RecoveryCodes)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *UserMFAHandlerTestSuite) TestConfirmTOTPEnrollment_Errors() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{mfa.ErrInvalidMFACode, http.StatusBadRequest},
+		{mfa.ErrMFANotEnrolled, http.StatusBadRequest},
+		{mfa.ErrMFAAlreadyEnabled, http.StatusConflict},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("ConfirmTOTPEnrollment", "uid-1", "123456").Return(nil, tc.err)
+		c, w := suite.newContext(gen.MFACodeRequestBody{Code: "123456"}, "uid-1")
+
+		suite.handler.ConfirmTOTPEnrollment(c)
+
+		suite.Equal(tc.status, w.Code, tc.err.Error())
+	}
+}
+
+// ----- VerifyMFA のテスト -----
+
+func (suite *UserMFAHandlerTestSuite) TestVerifyMFA_Success() {
+	// 接続元の情報をログイン履歴のために渡すこと
+	suite.mockService.On("VerifyMFA", "mfa-token", "123456", loginhistory.ClientInfo{IP: "192.0.2.1"}).Return("access", "refresh", nil)
+	c, w := suite.newContext(gen.MFAVerifyRequestBody{MfaToken: "mfa-token", Code: "123456"}, "")
+
+	suite.handler.VerifyMFA(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().NotNil(resp.AccessToken)
+	suite.Equal("access", *resp.AccessToken)
+	suite.Require().NotNil(resp.RefreshToken)
+	suite.Equal("refresh", *resp.RefreshToken)
+	suite.False(resp.MfaRequired)
+	suite.Nil(resp.TrustedDeviceToken)
+	suite.mockDevices.AssertNotCalled(suite.T(), "Remember", mock.Anything, mock.Anything)
+}
+
+// 端末を記憶する場合: 信頼済み端末のトークンをレスポンスと Cookie で返すこと
+func (suite *UserMFAHandlerTestSuite) TestVerifyMFA_RememberDevice() {
+	userObjID, err := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	suite.Require().NoError(err)
+	device, err := entity.NewTrustedDevice(userObjID, "test-agent", 24*time.Hour)
+	suite.Require().NoError(err)
+	suite.mockService.On("VerifyMFA", "mfa-token", "123456", mock.Anything).Return("access", "refresh", nil)
+	suite.mockDevices.On("Remember", "access", "test-agent").Return("device-token", device, nil)
+	remember := true
+	c, w := suite.newContext(gen.MFAVerifyRequestBody{MfaToken: "mfa-token", Code: "123456", RememberDevice: &remember}, "")
+	c.Request.Header.Set("User-Agent", "test-agent")
+
+	suite.handler.VerifyMFA(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().NotNil(resp.TrustedDeviceToken)
+	suite.Equal("device-token", *resp.TrustedDeviceToken)
+	suite.Contains(w.Header().Get("Set-Cookie"), trusteddeviceHandler.CookieName+"=device-token")
+	suite.Contains(w.Header().Get("Set-Cookie"), "HttpOnly")
+}
+
+// 端末を記憶できない場合: ログイン自体は成功し、信頼済み端末のトークンを返さないこと
+func (suite *UserMFAHandlerTestSuite) TestVerifyMFA_RememberDeviceFailed() {
+	suite.mockService.On("VerifyMFA", "mfa-token", "123456", mock.Anything).Return("access", "refresh", nil)
+	suite.mockDevices.On("Remember", "access", mock.Anything).Return("", nil, errors.New("unexpected"))
+	remember := true
+	c, w := suite.newContext(gen.MFAVerifyRequestBody{MfaToken: "mfa-token", Code: "123456", RememberDevice: &remember}, "")
+
+	suite.handler.VerifyMFA(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().NotNil(resp.AccessToken)
+	suite.Nil(resp.TrustedDeviceToken)
+	suite.Empty(w.Header().Get("Set-Cookie"))
+}
+
+func (suite *UserMFAHandlerTestSuite) TestVerifyMFA_Unauthorized() {
+	for _, err := range []error{mfa.ErrInvalidMFAToken, mfa.ErrInvalidMFACode, mfa.ErrTooManyAttempts} {
+		suite.SetupTest()
+		suite.mockService.On("VerifyMFA", "mfa-token", "000000", mock.Anything).Return("", "", err)
+		c, w := suite.newContext(gen.MFAVerifyRequestBody{MfaToken: "mfa-token", Code: "000000"}, "")
+
+		suite.handler.VerifyMFA(c)
+
+		suite.Equal(http.StatusUnauthorized, w.Code, err.Error())
+	}
+}
+
+// 失敗が続いている場合: ロック中は 423 と Retry-After を返すこと
+func (suite *UserMFAHandlerTestSuite) TestVerifyMFA_Locked() {
+	suite.mockService.On("VerifyMFA", "mfa-token", "000000", mock.Anything).Return("", "", &lockout.LockoutError{Err: lockout.ErrAccountLocked, RetryAfter: time.Minute})
+	c, w := suite.newContext(gen.MFAVerifyRequestBody{MfaToken: "mfa-token", Code: "000000"}, "")
+
+	suite.handler.VerifyMFA(c)
+
+	suite.Equal(http.StatusLocked, w.Code)
+	suite.Equal("60", w.Header().Get("Retry-After"))
+}
+
+func (suite *UserMFAHandlerTestSuite) TestVerifyMFA_InvalidJSON() {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString("invalid json"))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	suite.handler.VerifyMFA(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,262 @@
+package passkey
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/passkey"
+	"github.com/goda6565/nexus-user-auth/application/service/user/trusteddevice"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/interface/handler"
+	loginhistoryHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/loginhistory"
+	trusteddeviceHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/trusteddevice"
+)
+
+type UserPasskeyHandler struct {
+	userPasskeyService       passkey.UserPasskeyService
+	userTrustedDeviceService trusteddevice.UserTrustedDeviceService
+}
+
+func NewUserPasskeyHandler(userPasskeyService passkey.UserPasskeyService, userTrustedDeviceService trusteddevice.UserTrustedDeviceService) *UserPasskeyHandler {
+	return &UserPasskeyHandler{
+		userPasskeyService:       userPasskeyService,
+		userTrustedDeviceService: userTrustedDeviceService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// ListPasskeys: 登録済みのパスキー一覧
+func (h *UserPasskeyHandler) ListPasskeys(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	credentials, err := h.userPasskeyService.ListPasskeys(objID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	passkeys := make([]gen.Passkey, 0, len(credentials))
+	for _, credential := range credentials {
+		passkeys = append(passkeys, toPasskeyResponse(credential))
+	}
+	c.JSON(http.StatusOK, gen.PasskeyListResponse{Passkeys: passkeys})
+}
+
+// BeginPasskeyRegistration: パスキーの登録開始
+func (h *UserPasskeyHandler) BeginPasskeyRegistration(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	ceremony, err := h.userPasskeyService.BeginRegistration(objID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.PasskeyOptionsResponse{
+		SessionId: ceremony.SessionID,
+		Options:   ceremony.Options,
+	})
+}
+
+// FinishPasskeyRegistration: パスキーの登録完了
+func (h *UserPasskeyHandler) FinishPasskeyRegistration(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req gen.PasskeyRegisterFinishRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	credential, err := json.Marshal(req.Credential)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	var name string
+	if req.Name != nil {
+		name = *req.Name
+	}
+
+	created, err := h.userPasskeyService.FinishRegistration(objID, req.SessionId, name, credential)
+	if errors.Is(err, passkey.ErrInvalidPasskeySession) || errors.Is(err, passkey.ErrInvalidPasskey) || errors.Is(err, passkey.ErrInvalidPasskeyName) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toPasskeyResponse(created))
+}
+
+// BeginPasskeyLogin: パスキーでのログイン開始
+func (h *UserPasskeyHandler) BeginPasskeyLogin(c *gin.Context) {
+	ceremony, err := h.userPasskeyService.BeginLogin()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.PasskeyOptionsResponse{
+		SessionId: ceremony.SessionID,
+		Options:   ceremony.Options,
+	})
+}
+
+// FinishPasskeyLogin: パスキーでのログイン完了
+func (h *UserPasskeyHandler) FinishPasskeyLogin(c *gin.Context) {
+	var req gen.PasskeyFinishRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	credential, err := json.Marshal(req.Credential)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	accessToken, refreshToken, err := h.userPasskeyService.FinishLogin(req.SessionId, credential, loginhistoryHandler.ClientFromRequest(c))
+	if errors.Is(err, passkey.ErrInvalidPasskeySession) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if errors.Is(err, passkey.ErrInvalidPasskey) {
+		c.JSON(http.StatusUnauthorized, gen.ErrorResponse{Message: err.Error(), Code: http.StatusUnauthorized})
+		return
+	}
+	if resp, ok := handler.AccountStatusErrorResponse(err); ok {
+		c.JSON(resp.Code, resp)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.LoginResponse{
+		AccessToken:  &accessToken,
+		RefreshToken: &refreshToken,
+	})
+}
+
+// BeginPasskeyMFA: 二要素認証（パスキー）の開始
+func (h *UserPasskeyHandler) BeginPasskeyMFA(c *gin.Context) {
+	var req gen.PasskeyMFABeginRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	ceremony, err := h.userPasskeyService.BeginMFA(req.MfaToken)
+	if errors.Is(err, passkey.ErrInvalidMFAToken) {
+		c.JSON(http.StatusUnauthorized, gen.ErrorResponse{Message: err.Error(), Code: http.StatusUnauthorized})
+		return
+	}
+	if errors.Is(err, passkey.ErrNoPasskeys) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.PasskeyOptionsResponse{
+		SessionId: ceremony.SessionID,
+		Options:   ceremony.Options,
+	})
+}
+
+// FinishPasskeyMFA: 二要素認証（パスキー）の検証
+func (h *UserPasskeyHandler) FinishPasskeyMFA(c *gin.Context) {
+	var req gen.PasskeyMFAFinishRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	credential, err := json.Marshal(req.Credential)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	accessToken, refreshToken, err := h.userPasskeyService.FinishMFA(req.MfaToken, req.SessionId, credential, loginhistoryHandler.ClientFromRequest(c))
+	if errors.Is(err, passkey.ErrInvalidPasskeySession) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if errors.Is(err, passkey.ErrInvalidMFAToken) || errors.Is(err, passkey.ErrInvalidPasskey) {
+		c.JSON(http.StatusUnauthorized, gen.ErrorResponse{Message: err.Error(), Code: http.StatusUnauthorized})
+		return
+	}
+	if resp, ok := handler.AccountStatusErrorResponse(err); ok {
+		c.JSON(resp.Code, resp)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	resp := gen.LoginResponse{
+		AccessToken:  &accessToken,
+		RefreshToken: &refreshToken,
+	}
+	// 端末を記憶する場合は、以降のログインで二要素認証を省略するためのトークンを発行する
+	if req.RememberDevice != nil && *req.RememberDevice {
+		resp.TrustedDeviceToken = trusteddeviceHandler.Remember(c, h.userTrustedDeviceService, accessToken)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// toPasskeyResponse はパスキーを API のレスポンスに変換する（公開鍵などの内部情報は返さない）
+func toPasskeyResponse(credential *entity.PasskeyCredential) gen.Passkey {
+	return gen.Passkey{
+		Id:             credential.ID(),
+		Name:           credential.Name(),
+		BackupEligible: credential.BackupEligible(),
+		CreatedAt:      credential.CreatedAt(),
+		LastUsedAt:     credential.LastUsedAt(),
+	}
+}
@@ -0,0 +1,396 @@
+package passkey_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/passkey"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/passkey"
+)
+
+// --- モックの UserPasskeyService ---
+type mockUserPasskeyService struct {
+	mock.Mock
+}
+
+func (m *mockUserPasskeyService) BeginRegistration(objID string) (*passkey.Ceremony, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*passkey.Ceremony), args.Error(1)
+}
+
+func (m *mockUserPasskeyService) FinishRegistration(objID string, sessionID string, name string, credential []byte) (*entity.PasskeyCredential, error) {
+	args := m.Called(objID, sessionID, name, credential)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PasskeyCredential), args.Error(1)
+}
+
+func (m *mockUserPasskeyService) ListPasskeys(objID string) ([]*entity.PasskeyCredential, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.PasskeyCredential), args.Error(1)
+}
+
+func (m *mockUserPasskeyService) BeginLogin() (*passkey.Ceremony, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*passkey.Ceremony), args.Error(1)
+}
+
+func (m *mockUserPasskeyService) FinishLogin(sessionID string, credential []byte, client loginhistory.ClientInfo) (string, string, error) {
+	args := m.Called(sessionID, credential, client)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *mockUserPasskeyService) BeginMFA(mfaToken string) (*passkey.Ceremony, error) {
+	args := m.Called(mfaToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*passkey.Ceremony), args.Error(1)
+}
+
+func (m *mockUserPasskeyService) FinishMFA(mfaToken string, sessionID string, credential []byte, client loginhistory.ClientInfo) (string, string, error) {
+	args := m.Called(mfaToken, sessionID, credential, client)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+// --- モックの UserTrustedDeviceService ---
+type mockUserTrustedDeviceService struct {
+	mock.Mock
+}
+
+func (m *mockUserTrustedDeviceService) Remember(accessToken string, name string) (string, *entity.TrustedDevice, error) {
+	args := m.Called(accessToken, name)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*entity.TrustedDevice), args.Error(2)
+}
+
+func (m *mockUserTrustedDeviceService) IsTrusted(objID string, token string) bool {
+	args := m.Called(objID, token)
+	return args.Bool(0)
+}
+
+func (m *mockUserTrustedDeviceService) ListDevices(objID string) ([]*entity.TrustedDevice, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TrustedDevice), args.Error(1)
+}
+
+func (m *mockUserTrustedDeviceService) RevokeDevice(objID string, deviceID string) error {
+	args := m.Called(objID, deviceID)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+type UserPasskeyHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserPasskeyHandler
+	mockService *mockUserPasskeyService
+	mockDevices *mockUserTrustedDeviceService
+}
+
+func TestUserPasskeyHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPasskeyHandlerTestSuite))
+}
+
+func (suite *UserPasskeyHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserPasskeyService)
+	suite.mockDevices = new(mockUserTrustedDeviceService)
+	suite.handler = NewUserPasskeyHandler(suite.mockService, suite.mockDevices)
+}
+
+func (suite *UserPasskeyHandlerTestSuite) newContext(body any, uid string) (*gin.Context, *httptest.ResponseRecorder) {
+	var buf bytes.Buffer
+	if body != nil {
+		suite.Require().NoError(json.NewEncoder(&buf).Encode(body))
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", &buf)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	if uid != "" {
+		c.Set("validated_uid", uid)
+	}
+	return c, w
+}
+
+func (suite *UserPasskeyHandlerTestSuite) newPasskey() *entity.PasskeyCredential {
+	objID, err := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	suite.Require().NoError(err)
+	credential, err := entity.NewPasskeyCredential(objID, []byte("credential-id"), "MacBook", []byte("public-key"), "none", nil, nil, 0, true, false)
+	suite.Require().NoError(err)
+	return credential
+}
+
+var (
+	testCeremony   = &passkey.Ceremony{SessionID: "session-1", Options: json.RawMessage(`{"publicKey":{"challenge":"abc"}}`)}
+	testCredential = gen.PasskeyCredential{"id": "cred", "type": "public-key"}
+)
+
+// ----- 登録のテスト -----
+
+func (suite *UserPasskeyHandlerTestSuite) TestBeginPasskeyRegistration_Success() {
+	suite.mockService.On("BeginRegistration", "uid-1").Return(testCeremony, nil)
+	c, w := suite.newContext(nil, "uid-1")
+
+	suite.handler.BeginPasskeyRegistration(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.PasskeyOptionsResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal("session-1", resp.SessionId)
+	suite.JSONEq(`{"publicKey":{"challenge":"abc"}}`, string(resp.Options), "オプションをそのまま返すこと")
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestBeginPasskeyRegistration_NoUID() {
+	c, w := suite.newContext(nil, "")
+
+	suite.handler.BeginPasskeyRegistration(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "BeginRegistration", mock.Anything)
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestFinishPasskeyRegistration_Success() {
+	created := suite.newPasskey()
+	suite.mockService.On("FinishRegistration", "uid-1", "session-1", "MacBook", mock.MatchedBy(func(credential []byte) bool {
+		var decoded map[string]any
+		return json.Unmarshal(credential, &decoded) == nil && decoded["id"] == "cred"
+	})).Return(created, nil)
+	name := "MacBook"
+	c, w := suite.newContext(gen.PasskeyRegisterFinishRequestBody{SessionId: "session-1", Name: &name, Credential: testCredential}, "uid-1")
+
+	suite.handler.FinishPasskeyRegistration(c)
+
+	suite.Equal(http.StatusCreated, w.Code)
+	var resp gen.Passkey
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal(created.ID(), resp.Id)
+	suite.Equal("MacBook", resp.Name)
+	suite.True(resp.BackupEligible)
+	suite.NotContains(w.Body.String(), "publicKey", "公開鍵などの内部情報は返さないこと")
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestFinishPasskeyRegistration_Errors() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{passkey.ErrInvalidPasskeySession, http.StatusBadRequest},
+		{passkey.ErrInvalidPasskey, http.StatusBadRequest},
+		{passkey.ErrInvalidPasskeyName, http.StatusBadRequest},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("FinishRegistration", "uid-1", "session-1", "", mock.Anything).Return(nil, tc.err)
+		c, w := suite.newContext(gen.PasskeyRegisterFinishRequestBody{SessionId: "session-1", Credential: testCredential}, "uid-1")
+
+		suite.handler.FinishPasskeyRegistration(c)
+
+		suite.Equal(tc.status, w.Code, tc.err.Error())
+	}
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestListPasskeys() {
+	suite.mockService.On("ListPasskeys", "uid-1").Return([]*entity.PasskeyCredential{suite.newPasskey()}, nil)
+	c, w := suite.newContext(nil, "uid-1")
+
+	suite.handler.ListPasskeys(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.PasskeyListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().Len(resp.Passkeys, 1)
+	suite.Equal("MacBook", resp.Passkeys[0].Name)
+	suite.Nil(resp.Passkeys[0].LastUsedAt)
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestListPasskeys_Empty() {
+	suite.mockService.On("ListPasskeys", "uid-1").Return([]*entity.PasskeyCredential{}, nil)
+	c, w := suite.newContext(nil, "uid-1")
+
+	suite.handler.ListPasskeys(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`{"passkeys":[]}`, w.Body.String(), "未登録の場合は空の配列を返すこと")
+}
+
+// ----- パスキーでのログインのテスト -----
+
+func (suite *UserPasskeyHandlerTestSuite) TestBeginPasskeyLogin() {
+	suite.mockService.On("BeginLogin").Return(testCeremony, nil)
+	c, w := suite.newContext(nil, "")
+
+	suite.handler.BeginPasskeyLogin(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.PasskeyOptionsResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal("session-1", resp.SessionId)
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestFinishPasskeyLogin_Success() {
+	suite.mockService.On("FinishLogin", "session-1", mock.Anything, mock.Anything).Return("access", "refresh", nil)
+	c, w := suite.newContext(gen.PasskeyFinishRequestBody{SessionId: "session-1", Credential: testCredential}, "")
+
+	suite.handler.FinishPasskeyLogin(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().NotNil(resp.AccessToken)
+	suite.Equal("access", *resp.AccessToken)
+	suite.Require().NotNil(resp.RefreshToken)
+	suite.Equal("refresh", *resp.RefreshToken)
+	suite.False(resp.MfaRequired)
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestFinishPasskeyLogin_Errors() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{passkey.ErrInvalidPasskeySession, http.StatusBadRequest},
+		{passkey.ErrInvalidPasskey, http.StatusUnauthorized},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("FinishLogin", "session-1", mock.Anything, mock.Anything).Return("", "", tc.err)
+		c, w := suite.newContext(gen.PasskeyFinishRequestBody{SessionId: "session-1", Credential: testCredential}, "")
+
+		suite.handler.FinishPasskeyLogin(c)
+
+		suite.Equal(tc.status, w.Code, tc.err.Error())
+	}
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestFinishPasskeyLogin_InvalidJSON() {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString("invalid json"))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	suite.handler.FinishPasskeyLogin(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// ----- 二要素認証（パスキー）のテスト -----
+
+func (suite *UserPasskeyHandlerTestSuite) TestBeginPasskeyMFA_Errors() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{passkey.ErrInvalidMFAToken, http.StatusUnauthorized},
+		{passkey.ErrNoPasskeys, http.StatusBadRequest},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("BeginMFA", "mfa-token").Return(nil, tc.err)
+		c, w := suite.newContext(gen.PasskeyMFABeginRequestBody{MfaToken: "mfa-token"}, "")
+
+		suite.handler.BeginPasskeyMFA(c)
+
+		suite.Equal(tc.status, w.Code, tc.err.Error())
+	}
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestBeginPasskeyMFA_Success() {
+	suite.mockService.On("BeginMFA", "mfa-token").Return(testCeremony, nil)
+	c, w := suite.newContext(gen.PasskeyMFABeginRequestBody{MfaToken: "mfa-token"}, "")
+
+	suite.handler.BeginPasskeyMFA(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestFinishPasskeyMFA_Success() {
+	suite.mockService.On("FinishMFA", "mfa-token", "session-1", mock.Anything, mock.Anything).Return("access", "refresh", nil)
+	c, w := suite.newContext(gen.PasskeyMFAFinishRequestBody{MfaToken: "mfa-token", SessionId: "session-1", Credential: testCredential}, "")
+
+	suite.handler.FinishPasskeyMFA(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().NotNil(resp.AccessToken)
+	suite.Equal("access", *resp.AccessToken)
+	suite.Nil(resp.TrustedDeviceToken)
+}
+
+// 端末を記憶する場合: 信頼済み端末のトークンをレスポンスと Cookie で返すこと
+func (suite *UserPasskeyHandlerTestSuite) TestFinishPasskeyMFA_RememberDevice() {
+	userObjID, err := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	suite.Require().NoError(err)
+	device, err := entity.NewTrustedDevice(userObjID, "test-agent", 24*time.Hour)
+	suite.Require().NoError(err)
+	suite.mockService.On("FinishMFA", "mfa-token", "session-1", mock.Anything, mock.Anything).Return("access", "refresh", nil)
+	suite.mockDevices.On("Remember", "access", "test-agent").Return("device-token", device, nil)
+	remember := true
+	c, w := suite.newContext(gen.PasskeyMFAFinishRequestBody{MfaToken: "mfa-token", SessionId: "session-1", Credential: testCredential, RememberDevice: &remember}, "")
+	c.Request.Header.Set("User-Agent", "test-agent")
+
+	suite.handler.FinishPasskeyMFA(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().NotNil(resp.TrustedDeviceToken)
+	suite.Equal("device-token", *resp.TrustedDeviceToken)
+	suite.Contains(w.Header().Get("Set-Cookie"), "trusted_device=device-token")
+}
+
+func (suite *UserPasskeyHandlerTestSuite) TestFinishPasskeyMFA_Errors() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{passkey.ErrInvalidPasskeySession, http.StatusBadRequest},
+		{passkey.ErrInvalidMFAToken, http.StatusUnauthorized},
+		{passkey.ErrInvalidPasskey, http.StatusUnauthorized},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("FinishMFA", "mfa-token", "session-1", mock.Anything, mock.Anything).Return("", "", tc.err)
+		c, w := suite.newContext(gen.PasskeyMFAFinishRequestBody{MfaToken: "mfa-token", SessionId: "session-1", Credential: testCredential}, "")
+
+		suite.handler.FinishPasskeyMFA(c)
+
+		suite.Equal(tc.status, w.Code, tc.err.Error())
+	}
+}
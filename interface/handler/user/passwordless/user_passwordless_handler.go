@@ -0,0 +1,107 @@
+package passwordless
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/authentication"
+	"github.com/goda6565/nexus-user-auth/application/service/user/passwordless"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/interface/handler"
+	loginhistoryHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/loginhistory"
+	trusteddeviceHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/trusteddevice"
+)
+
+type UserPasswordlessHandler struct {
+	userPasswordlessService passwordless.UserPasswordlessService
+}
+
+func NewUserPasswordlessHandler(userPasswordlessService passwordless.UserPasswordlessService) *UserPasswordlessHandler {
+	return &UserPasswordlessHandler{
+		userPasswordlessService: userPasswordlessService,
+	}
+}
+
+// StartPasswordless: マジックリンク・ワンタイムコードの送信
+func (h *UserPasswordlessHandler) StartPasswordless(c *gin.Context) {
+	var req gen.PasswordlessStartRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	err := h.userPasswordlessService.Start(req.Email)
+	if errors.Is(err, passwordless.ErrInvalidEmail) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if errors.Is(err, passwordless.ErrStartThrottled) {
+		c.JSON(http.StatusTooManyRequests, gen.ErrorResponse{Message: err.Error(), Code: http.StatusTooManyRequests})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	// 登録の有無に関わらず同じレスポンスを返す
+	c.JSON(http.StatusAccepted, gen.MessageResponse{
+		Message: "ログイン用のメールを送信しました",
+	})
+}
+
+// VerifyPasswordless: マジックリンクのトークン、またはメールアドレスとワンタイムコードでログイン
+func (h *UserPasswordlessHandler) VerifyPasswordless(c *gin.Context) {
+	var req gen.PasswordlessVerifyRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	var result *authentication.LoginResult
+	var err error
+	deviceToken := trusteddeviceHandler.TokenFromRequest(c, req.TrustedDeviceToken)
+	client := loginhistoryHandler.ClientFromRequest(c)
+	switch {
+	case req.Token != nil && *req.Token != "":
+		result, err = h.userPasswordlessService.VerifyLink(*req.Token, deviceToken, client)
+	case req.Email != nil && req.Code != nil:
+		result, err = h.userPasswordlessService.VerifyCode(*req.Email, *req.Code, deviceToken, client)
+	default:
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: "token or email and code are required", Code: http.StatusBadRequest})
+		return
+	}
+	if errors.Is(err, passwordless.ErrInvalidCode) {
+		c.JSON(http.StatusUnauthorized, gen.ErrorResponse{Message: err.Error(), Code: http.StatusUnauthorized})
+		return
+	}
+	if errors.Is(err, passwordless.ErrTooManyAttempts) {
+		c.JSON(http.StatusTooManyRequests, gen.ErrorResponse{Message: err.Error(), Code: http.StatusTooManyRequests})
+		return
+	}
+	if resp, ok := handler.AccountStatusErrorResponse(err); ok {
+		c.JSON(resp.Code, resp)
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	// 二要素認証が有効な場合はチャレンジトークンのみ返す（UserLogin と同じ）
+	if result.MFARequired {
+		c.JSON(http.StatusOK, gen.LoginResponse{
+			MfaRequired: true,
+			MfaToken:    &result.MFAToken,
+			MfaMethods:  &result.MFAMethods,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.LoginResponse{
+		AccessToken:  &result.AccessToken,
+		RefreshToken: &result.RefreshToken,
+	})
+}
@@ -0,0 +1,205 @@
+package passwordless_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/authentication"
+	"github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	"github.com/goda6565/nexus-user-auth/application/service/user/passwordless"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/passwordless"
+)
+
+// --- モックの UserPasswordlessService ---
+type mockUserPasswordlessService struct {
+	mock.Mock
+}
+
+func (m *mockUserPasswordlessService) Start(email string) error {
+	args := m.Called(email)
+	return args.Error(0)
+}
+
+func (m *mockUserPasswordlessService) VerifyLink(token string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	args := m.Called(token, deviceToken, client)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authentication.LoginResult), args.Error(1)
+}
+
+func (m *mockUserPasswordlessService) VerifyCode(email string, code string, deviceToken string, client loginhistory.ClientInfo) (*authentication.LoginResult, error) {
+	args := m.Called(email, code, deviceToken, client)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authentication.LoginResult), args.Error(1)
+}
+
+// --- テストスイート ---
+type UserPasswordlessHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserPasswordlessHandler
+	mockService *mockUserPasswordlessService
+}
+
+func TestUserPasswordlessHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPasswordlessHandlerTestSuite))
+}
+
+func (suite *UserPasswordlessHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserPasswordlessService)
+	suite.handler = NewUserPasswordlessHandler(suite.mockService)
+}
+
+func (suite *UserPasswordlessHandlerTestSuite) newContext(body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/auth/passwordless", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// ----- StartPasswordless のテスト -----
+
+// 正常系: 202 が返ること
+func (suite *UserPasswordlessHandlerTestSuite) TestStartPasswordless_Success() {
+	suite.mockService.On("Start", "test@example.com").Return(nil)
+
+	body, _ := json.Marshal(gen.PasswordlessStartRequestBody{Email: "test@example.com"})
+	c, w := suite.newContext(body)
+	suite.handler.StartPasswordless(c)
+
+	suite.Equal(http.StatusAccepted, w.Code)
+	var resp gen.MessageResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.NotEmpty(resp.Message)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 無効なメールアドレス: 400 が返ること
+func (suite *UserPasswordlessHandlerTestSuite) TestStartPasswordless_InvalidEmail() {
+	suite.mockService.On("Start", "invalid").Return(passwordless.ErrInvalidEmail)
+
+	body, _ := json.Marshal(gen.PasswordlessStartRequestBody{Email: "invalid"})
+	c, w := suite.newContext(body)
+	suite.handler.StartPasswordless(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// スロットリング: 429 が返ること
+func (suite *UserPasswordlessHandlerTestSuite) TestStartPasswordless_Throttled() {
+	suite.mockService.On("Start", "test@example.com").Return(passwordless.ErrStartThrottled)
+
+	body, _ := json.Marshal(gen.PasswordlessStartRequestBody{Email: "test@example.com"})
+	c, w := suite.newContext(body)
+	suite.handler.StartPasswordless(c)
+
+	suite.Equal(http.StatusTooManyRequests, w.Code)
+	var errResp gen.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	suite.Equal(http.StatusTooManyRequests, errResp.Code)
+}
+
+// サービスエラー: 500 が返ること
+func (suite *UserPasswordlessHandlerTestSuite) TestStartPasswordless_ServiceError() {
+	suite.mockService.On("Start", "test@example.com").Return(errors.New("send failed"))
+
+	body, _ := json.Marshal(gen.PasswordlessStartRequestBody{Email: "test@example.com"})
+	c, w := suite.newContext(body)
+	suite.handler.StartPasswordless(c)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
+
+// ----- VerifyPasswordless のテスト -----
+
+// マジックリンクのトークンでログインできること
+func (suite *UserPasswordlessHandlerTestSuite) TestVerifyPasswordless_Link() {
+	suite.mockService.On("VerifyLink", "link-token", "", mock.Anything).Return(&authentication.LoginResult{AccessToken: "access", RefreshToken: "refresh"}, nil)
+
+	body, _ := json.Marshal(gen.PasswordlessVerifyRequestBody{Token: strPtr("link-token")})
+	c, w := suite.newContext(body)
+	suite.handler.VerifyPasswordless(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal("access", *resp.AccessToken)
+	suite.Equal("refresh", *resp.RefreshToken)
+	suite.False(resp.MfaRequired)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// コードでログインし、二要素認証が有効な場合はチャレンジトークンが返ること
+func (suite *UserPasswordlessHandlerTestSuite) TestVerifyPasswordless_CodeMFARequired() {
+	suite.mockService.On("VerifyCode", "test@example.com", "123456", "", mock.Anything).Return(&authentication.LoginResult{MFARequired: true, MFAToken: "mfa-token", MFAMethods: []string{authentication.MFAMethodTOTP}}, nil)
+
+	body, _ := json.Marshal(gen.PasswordlessVerifyRequestBody{Email: strPtr("test@example.com"), Code: strPtr("123456")})
+	c, w := suite.newContext(body)
+	suite.handler.VerifyPasswordless(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.True(resp.MfaRequired)
+	suite.Equal("mfa-token", *resp.MfaToken)
+	suite.Nil(resp.AccessToken)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 無効なコード: 401 が返ること
+func (suite *UserPasswordlessHandlerTestSuite) TestVerifyPasswordless_InvalidCode() {
+	suite.mockService.On("VerifyCode", "test@example.com", "000000", "", mock.Anything).Return(nil, passwordless.ErrInvalidCode)
+
+	body, _ := json.Marshal(gen.PasswordlessVerifyRequestBody{Email: strPtr("test@example.com"), Code: strPtr("000000")})
+	c, w := suite.newContext(body)
+	suite.handler.VerifyPasswordless(c)
+
+	suite.Equal(http.StatusUnauthorized, w.Code)
+}
+
+// 試行回数の上限: 429 が返ること
+func (suite *UserPasswordlessHandlerTestSuite) TestVerifyPasswordless_TooManyAttempts() {
+	suite.mockService.On("VerifyCode", "test@example.com", "123456", "", mock.Anything).Return(nil, passwordless.ErrTooManyAttempts)
+
+	body, _ := json.Marshal(gen.PasswordlessVerifyRequestBody{Email: strPtr("test@example.com"), Code: strPtr("123456")})
+	c, w := suite.newContext(body)
+	suite.handler.VerifyPasswordless(c)
+
+	suite.Equal(http.StatusTooManyRequests, w.Code)
+}
+
+// トークンもコードも指定されていない場合: 400 が返ること
+func (suite *UserPasswordlessHandlerTestSuite) TestVerifyPasswordless_MissingParams() {
+	body, _ := json.Marshal(gen.PasswordlessVerifyRequestBody{Email: strPtr("test@example.com")})
+	c, w := suite.newContext(body)
+	suite.handler.VerifyPasswordless(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "VerifyCode", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// バインドエラー: 不正なJSONの場合
+func (suite *UserPasswordlessHandlerTestSuite) TestVerifyPasswordless_InvalidJSON() {
+	c, w := suite.newContext([]byte("invalid json"))
+	suite.handler.VerifyPasswordless(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
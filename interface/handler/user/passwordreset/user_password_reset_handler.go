@@ -0,0 +1,42 @@
+package passwordreset
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/passwordreset"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserPasswordResetHandler struct {
+	userPasswordResetService passwordreset.UserPasswordResetService
+}
+
+func NewUserPasswordResetHandler(userPasswordResetService passwordreset.UserPasswordResetService) *UserPasswordResetHandler {
+	return &UserPasswordResetHandler{
+		userPasswordResetService: userPasswordResetService,
+	}
+}
+
+// ResetPassword: パスワード再設定用のトークンによるパスワードの変更
+func (h *UserPasswordResetHandler) ResetPassword(c *gin.Context) {
+	var req gen.PasswordResetRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	err := h.userPasswordResetService.ResetPassword(req.Token, req.Password)
+	if errors.Is(err, passwordreset.ErrInvalidResetToken) || errors.Is(err, passwordreset.ErrInvalidPassword) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,96 @@
+package passwordreset_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/passwordreset"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/passwordreset"
+)
+
+// --- モックの UserPasswordResetService ---
+type mockUserPasswordResetService struct {
+	mock.Mock
+}
+
+func (m *mockUserPasswordResetService) IssueResetToken(user *entity.User) (string, error) {
+	args := m.Called(user)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockUserPasswordResetService) ResetPassword(token string, newPassword string) error {
+	args := m.Called(token, newPassword)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+type UserPasswordResetHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserPasswordResetHandler
+	mockService *mockUserPasswordResetService
+}
+
+func TestUserPasswordResetHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPasswordResetHandlerTestSuite))
+}
+
+func (suite *UserPasswordResetHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserPasswordResetService)
+	suite.handler = NewUserPasswordResetHandler(suite.mockService)
+}
+
+func (suite *UserPasswordResetHandlerTestSuite) newContext(body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+// ----- ResetPassword のテスト -----
+
+func (suite *UserPasswordResetHandlerTestSuite) TestResetPassword() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{passwordreset.ErrInvalidResetToken, http.StatusBadRequest},
+		{passwordreset.ErrInvalidPassword, http.StatusBadRequest},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("ResetPassword", "reset-token", "newpassword456").Return(tc.err)
+		body, err := json.Marshal(gen.PasswordResetRequestBody{Token: "reset-token", Password: "newpassword456"})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(body)
+
+		suite.handler.ResetPassword(c)
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserPasswordResetHandlerTestSuite) TestResetPassword_InvalidJSON() {
+	c, w := suite.newContext([]byte("invalid json"))
+
+	suite.handler.ResetPassword(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "ResetPassword", mock.Anything, mock.Anything)
+}
@@ -1,7 +1,13 @@
 package profile
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/goda6565/nexus-user-auth/application/service/user/profile"
@@ -9,6 +15,9 @@ import (
 	"github.com/goda6565/nexus-user-auth/interface/gen"
 )
 
+// mergePatchContentType は JSON Merge Patch（RFC 7396）のメディアタイプ
+const mergePatchContentType = "application/merge-patch+json"
+
 type UserProfileHandler struct {
 	userProfileService profile.UserProfileService
 }
@@ -26,18 +35,27 @@ func userToUserProfileResponse(user *entity.User) *gen.ProfileResponse {
 		avatar = user.AvatarURL().Value()
 	}
 
+	// メールアドレス確認日時は確認済みの場合のみ返す
+	var emailVerifiedAt *time.Time
+	if user.EmailVerifiedAt() != nil {
+		t := user.EmailVerifiedAt().Value()
+		emailVerifiedAt = &t
+	}
+
 	if avatar == "" {
 		return &gen.ProfileResponse{
-			Uid:      user.ObjID().Value(),
-			Email:    user.Email().Value(),
-			Username: user.Username().Value(),
+			Uid:             user.ObjID().Value(),
+			Email:           user.Email().Value(),
+			Username:        user.Username().Value(),
+			EmailVerifiedAt: emailVerifiedAt,
 		}
 	}
 	return &gen.ProfileResponse{
-		Uid:       user.ObjID().Value(),
-		AvatarURL: &avatar,
-		Email:     user.Email().Value(),
-		Username:  user.Username().Value(),
+		Uid:             user.ObjID().Value(),
+		AvatarURL:       &avatar,
+		Email:           user.Email().Value(),
+		Username:        user.Username().Value(),
+		EmailVerifiedAt: emailVerifiedAt,
 	}
 }
 
@@ -106,7 +124,13 @@ func (h *UserProfileHandler) UpdateUserProfile(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userProfileService.UserUpdate(objID, req.Username, *req.AvatarURL)
+	// アバターURLは省略できる（空文字と同じく変更しない）
+	var avatarURL string
+	if req.AvatarURL != nil {
+		avatarURL = *req.AvatarURL
+	}
+
+	user, err := h.userProfileService.UserUpdate(objID, req.Username, avatarURL)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{
 			Message: err.Error(),
@@ -118,6 +142,139 @@ func (h *UserProfileHandler) UpdateUserProfile(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// PatchUserProfile: ユーザー情報の部分更新（JSON Merge Patch）
+// 含めないフィールドは変更せず、null を指定したフィールドは値を消す
+func (h *UserProfileHandler) PatchUserProfile(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(c.ContentType()); err != nil || mediaType != mergePatchContentType {
+		c.JSON(http.StatusUnsupportedMediaType, gen.ErrorResponse{
+			Message: "Content-Type は " + mergePatchContentType + " でなければなりません",
+			Code:    http.StatusUnsupportedMediaType,
+		})
+		return
+	}
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	patch, err := parseProfilePatch(body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	user, changed, err := h.userProfileService.UserPatch(objID, *patch)
+	if errors.Is(err, profile.ErrInvalidUsername) || errors.Is(err, profile.ErrInvalidAvatarURL) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gen.ProfilePatchResponse{
+		Profile:       *userToUserProfileResponse(user),
+		ChangedFields: changed,
+	})
+}
+
+// parseProfilePatch は JSON Merge Patch のドキュメントを、フィールドの有無と null を区別して読み取る
+// 変更できないフィールドや文字列以外の値を含む場合はエラーを返す
+func parseProfilePatch(body []byte) (*profile.ProfilePatch, error) {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(body, &document); err != nil || document == nil {
+		return nil, errors.New("リクエストは JSON オブジェクトでなければなりません")
+	}
+
+	var patch profile.ProfilePatch
+	for name, raw := range document {
+		patchValue, err := parsePatchValue(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s は文字列または null でなければなりません", name)
+		}
+		switch name {
+		case profile.FieldUsername:
+			patch.Username = patchValue
+		case profile.FieldAvatarURL:
+			patch.AvatarURL = patchValue
+		default:
+			return nil, fmt.Errorf("%s は変更できないフィールドです", name)
+		}
+	}
+	return &patch, nil
+}
+
+// parsePatchValue は、フィールドの値（文字列または null）を読み取る
+func parsePatchValue(raw json.RawMessage) (*profile.PatchValue, error) {
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return &profile.PatchValue{Null: true}, nil
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return &profile.PatchValue{Value: value}, nil
+}
+
+// DeactivateUserProfile: ユーザー自身によるアカウントの無効化（理由の指定は任意）
+func (h *UserProfileHandler) DeactivateUserProfile(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req gen.AccountDeactivateRequestBody
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+				Message: err.Error(),
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+	}
+	var reason string
+	if req.Reason != nil {
+		reason = *req.Reason
+	}
+
+	err := h.userProfileService.UserDeactivate(objID, reason)
+	if errors.Is(err, profile.ErrInvalidDeactivationReason) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
 // DeleteUserProfile: ユーザー削除
 func (h *UserProfileHandler) DeleteUserProfile(c *gin.Context) {
 	objID, exists := getValidatedUID(c)
@@ -6,12 +6,14 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/goda6565/nexus-user-auth/application/service/user/profile"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
 	"github.com/goda6565/nexus-user-auth/interface/gen"
@@ -39,11 +41,24 @@ func (m *mockUserProfileService) UserUpdate(id, avatarURL, username string) (*en
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
+func (m *mockUserProfileService) UserPatch(id string, patch profile.ProfilePatch) (*entity.User, []string, error) {
+	args := m.Called(id, patch)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*entity.User), args.Get(1).([]string), args.Error(2)
+}
+
 func (m *mockUserProfileService) UserDelete(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
+func (m *mockUserProfileService) UserDeactivate(id string, reason string) error {
+	args := m.Called(id, reason)
+	return args.Error(0)
+}
+
 // --- ユーティリティ関数 ---
 // テスト用のユーザーを生成します。
 // AvatarURL は値が存在する場合、ChangeAvatarURL 経由で設定します。
@@ -252,6 +267,120 @@ func (suite *UserProfileHandlerTestSuite) TestUpdateUserProfile_Success() {
 	suite.mockService.AssertExpectations(suite.T())
 }
 
+// 正常系: アバターURLを省略した場合は変更しない（空文字として渡す）
+func (suite *UserProfileHandlerTestSuite) TestUpdateUserProfile_WithoutAvatarURL() {
+	fakeUser := createFakeUser("test@example.com", "newusername", "")
+	userID := fakeUser.ObjID().Value()
+	req := httptest.NewRequest(http.MethodPut, "/profile", strings.NewReader(`{"username":"newusername"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Set("validated_uid", userID)
+	c.Request = req
+	suite.mockService.On("UserUpdate", userID, "newusername", "").Return(fakeUser, nil)
+
+	suite.handler.UpdateUserProfile(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// ----- PatchUserProfile のテスト -----
+
+// newPatchContext は JSON Merge Patch のリクエストの Context を作成する
+func (suite *UserProfileHandlerTestSuite) newPatchContext(userID string, contentType string, body string) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPatch, "/profile", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Set("validated_uid", userID)
+	c.Request = req
+	return c, w
+}
+
+// 正常系: 含めないフィールドは nil、null は Null として渡し、変わったフィールドを返す
+func (suite *UserProfileHandlerTestSuite) TestPatchUserProfile() {
+	fakeUser := createFakeUser("test@example.com", "renamed", "")
+	userID := fakeUser.ObjID().Value()
+	cases := []struct {
+		body    string
+		patch   profile.ProfilePatch
+		changed []string
+	}{
+		{`{"avatarURL":null}`, profile.ProfilePatch{AvatarURL: &profile.PatchValue{Null: true}}, []string{"avatarURL"}},
+		{`{"username":"renamed"}`, profile.ProfilePatch{Username: &profile.PatchValue{Value: "renamed"}}, []string{"username"}},
+		{`{"username":"renamed","avatarURL":"https://example.com/a.png"}`, profile.ProfilePatch{
+			Username:  &profile.PatchValue{Value: "renamed"},
+			AvatarURL: &profile.PatchValue{Value: "https://example.com/a.png"},
+		}, []string{"username", "avatarURL"}},
+		{`{}`, profile.ProfilePatch{}, []string{}},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("UserPatch", userID, tc.patch).Return(fakeUser, tc.changed, nil)
+		c, w := suite.newPatchContext(userID, "application/merge-patch+json; charset=utf-8", tc.body)
+
+		suite.handler.PatchUserProfile(c)
+
+		suite.Equal(http.StatusOK, w.Code, tc.body)
+		var resp gen.ProfilePatchResponse
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+		suite.Equal(tc.changed, resp.ChangedFields, tc.body)
+		suite.Equal("renamed", resp.Profile.Username)
+		suite.Nil(resp.Profile.AvatarURL)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+// エラー系: JSON Merge Patch として読み取れないリクエストはサービスを呼ばない
+func (suite *UserProfileHandlerTestSuite) TestPatchUserProfile_InvalidRequest() {
+	cases := []struct {
+		contentType string
+		body        string
+		status      int
+	}{
+		{"application/json", `{"username":"renamed"}`, http.StatusUnsupportedMediaType},
+		{"application/merge-patch+json", "invalid json", http.StatusBadRequest},
+		{"application/merge-patch+json", `null`, http.StatusBadRequest},
+		{"application/merge-patch+json", `["username"]`, http.StatusBadRequest},
+		{"application/merge-patch+json", `{"email":"new@example.com"}`, http.StatusBadRequest},
+		{"application/merge-patch+json", `{"username":123}`, http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		c, w := suite.newPatchContext("123", tc.contentType, tc.body)
+
+		suite.handler.PatchUserProfile(c)
+
+		suite.Equal(tc.status, w.Code, tc.body)
+		suite.mockService.AssertNotCalled(suite.T(), "UserPatch", mock.Anything, mock.Anything)
+	}
+}
+
+// エラー系: 値オブジェクトで検証できない値は 400、それ以外のエラーは 500
+func (suite *UserProfileHandlerTestSuite) TestPatchUserProfile_ServiceError() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{profile.ErrInvalidUsername, http.StatusBadRequest},
+		{profile.ErrInvalidAvatarURL, http.StatusBadRequest},
+		{errors.New("update failed"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("UserPatch", "123", mock.Anything).Return(nil, nil, tc.err)
+		c, w := suite.newPatchContext("123", "application/merge-patch+json", `{"username":null}`)
+
+		suite.handler.PatchUserProfile(c)
+
+		suite.Equal(tc.status, w.Code)
+		var errResp gen.ErrorResponse
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+		suite.Equal(tc.err.Error(), errResp.Message)
+	}
+}
+
 // ----- DeleteUserProfile のテスト -----
 
 // エラー系: サービス側でエラー発生
@@ -298,6 +427,49 @@ func (suite *UserProfileHandlerTestSuite) TestDeleteUserProfile_Success() {
 	suite.mockService.AssertExpectations(suite.T())
 }
 
+// ----- DeactivateUserProfile のテスト -----
+
+func (suite *UserProfileHandlerTestSuite) TestDeactivateUserProfile() {
+	cases := []struct {
+		body   string
+		reason string
+		err    error
+		status int
+	}{
+		{`{"reason":"使わなくなった"}`, "使わなくなった", nil, http.StatusNoContent},
+		{"", "", nil, http.StatusNoContent},
+		{`{"reason":"long"}`, "long", profile.ErrInvalidDeactivationReason, http.StatusBadRequest},
+		{`{}`, "", errors.New("update failed"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("UserDeactivate", "123", tc.reason).Return(tc.err)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Set("validated_uid", "123")
+
+		suite.handler.DeactivateUserProfile(c)
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code, tc.body)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserProfileHandlerTestSuite) TestDeactivateUserProfile_InvalidJSON() {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("invalid json"))
+	c.Set("validated_uid", "123")
+
+	suite.handler.DeactivateUserProfile(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "UserDeactivate", mock.Anything, mock.Anything)
+}
+
 // --- ヘルパー関数 ---
 func ptr(s string) *string {
 	return &s
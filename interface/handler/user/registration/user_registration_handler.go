@@ -1,6 +1,7 @@
 package registration
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -37,6 +38,10 @@ func (h *UserRegistrationHandler) UserRegister(c *gin.Context) {
 	}
 
 	user, err := h.userRegistrationService.UserRegister(req.Email, req.Password, req.Username)
+	if errors.Is(err, registration.ErrEmailDomainNotAllowed) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
 		return
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/goda6565/nexus-user-auth/application/service/user/registration"
 	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/domain/user/value"
 	"github.com/goda6565/nexus-user-auth/interface/gen"
@@ -145,6 +146,33 @@ func (suite *UserRegistrationHandlerTestSuite) TestUserRegisterServiceError() {
 	suite.mockService.AssertExpectations(suite.T())
 }
 
+// テスト: 登録を許可しないドメインの場合は 400
+func (suite *UserRegistrationHandlerTestSuite) TestUserRegisterDomainNotAllowed() {
+	reqBody := gen.UserRegisterRequestBody{
+		Email:    "test@mailinator.com",
+		Password: "password",
+		Username: "username",
+	}
+	bodyBytes, err := json.Marshal(reqBody)
+	suite.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/register", bytes.NewBuffer(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	suite.mockService.
+		On("UserRegister", reqBody.Email, reqBody.Password, reqBody.Username).
+		Return(nil, registration.ErrEmailDomainNotAllowed)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	suite.handler.UserRegister(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
 func TestUserRegistrationHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(UserRegistrationHandlerTestSuite))
 }
@@ -0,0 +1,186 @@
+package role
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/role"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserRoleHandler struct {
+	userRoleService role.UserRoleService
+}
+
+func NewUserRoleHandler(userRoleService role.UserRoleService) *UserRoleHandler {
+	return &UserRoleHandler{
+		userRoleService: userRoleService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーのIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// requireUID は認証済みユーザーのIDを取得し、取得できない場合は 400 を返す
+func requireUID(c *gin.Context) (string, bool) {
+	objID, ok := getValidatedUID(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return "", false
+	}
+	return objID, true
+}
+
+// ListAdminRoles: ロールの一覧
+func (h *UserRoleHandler) ListAdminRoles(c *gin.Context) {
+	roles, err := h.userRoleService.ListRoles()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := gen.RoleListResponse{Roles: make([]gen.Role, 0, len(roles))}
+	for _, r := range roles {
+		response.Roles = append(response.Roles, toRoleResponse(r))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateAdminRole: ロールの作成
+func (h *UserRoleHandler) CreateAdminRole(c *gin.Context) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.CreateAdminRoleJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+
+	created, err := h.userRoleService.CreateRole(actorObjID, req.Name, description, req.Permissions)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toRoleResponse(created))
+}
+
+// GetAdminRole: ロールの取得
+func (h *UserRoleHandler) GetAdminRole(c *gin.Context, roleName string) {
+	found, err := h.userRoleService.GetRole(roleName)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toRoleResponse(found))
+}
+
+// UpdateAdminRole: ロールの説明と権限の変更
+func (h *UserRoleHandler) UpdateAdminRole(c *gin.Context, roleName string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.UpdateAdminRoleJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+
+	updated, err := h.userRoleService.UpdateRole(actorObjID, roleName, description, req.Permissions)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toRoleResponse(updated))
+}
+
+// DeleteAdminRole: ロールの削除
+func (h *UserRoleHandler) DeleteAdminRole(c *gin.Context, roleName string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userRoleService.DeleteRole(actorObjID, roleName); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetProfilePermissions: ログイン中のユーザーのロールと権限
+func (h *UserRoleHandler) GetProfilePermissions(c *gin.Context) {
+	objID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+
+	permissions, err := h.userRoleService.GetPermissions(objID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.ProfilePermissionsResponse{
+		Roles:       permissions.Roles,
+		Groups:      permissions.Groups,
+		Permissions: permissions.Permissions,
+	})
+}
+
+// respondError はサービスのエラーに対応するステータスコードでエラーを返す
+func respondError(c *gin.Context, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, role.ErrRoleNotFound), errors.Is(err, role.ErrUserNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, role.ErrInvalidRole):
+		code = http.StatusBadRequest
+	case errors.Is(err, role.ErrCannotGrant):
+		code = http.StatusForbidden
+	case errors.Is(err, role.ErrRoleAlreadyExists), errors.Is(err, role.ErrBuiltInRole):
+		code = http.StatusConflict
+	}
+	c.JSON(code, gen.ErrorResponse{Message: err.Error(), Code: code})
+}
+
+func toRoleResponse(r *entity.Role) gen.Role {
+	return gen.Role{
+		Name:        r.Name().Value(),
+		Description: r.Description(),
+		Permissions: r.PermissionNames(),
+		BuiltIn:     r.IsBuiltIn(),
+	}
+}
@@ -0,0 +1,281 @@
+package role_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/role"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/role"
+)
+
+// --- モックの UserRoleService ---
+type mockUserRoleService struct {
+	mock.Mock
+}
+
+func (m *mockUserRoleService) ListRoles() ([]*entity.Role, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Role), args.Error(1)
+}
+
+func (m *mockUserRoleService) GetRole(name string) (*entity.Role, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockUserRoleService) CreateRole(actorObjID string, name string, description string, permissions []string) (*entity.Role, error) {
+	args := m.Called(actorObjID, name, description, permissions)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockUserRoleService) UpdateRole(actorObjID string, name string, description string, permissions []string) (*entity.Role, error) {
+	args := m.Called(actorObjID, name, description, permissions)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *mockUserRoleService) DeleteRole(actorObjID string, name string) error {
+	args := m.Called(actorObjID, name)
+	return args.Error(0)
+}
+
+func (m *mockUserRoleService) GetPermissions(userObjID string) (*role.UserPermissions, error) {
+	args := m.Called(userObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*role.UserPermissions), args.Error(1)
+}
+
+func (m *mockUserRoleService) HasPermission(userObjID string, permission string) (bool, error) {
+	args := m.Called(userObjID, permission)
+	return args.Bool(0), args.Error(1)
+}
+
+// --- テストスイート ---
+
+const actorObjID = "admin-1"
+
+type UserRoleHandlerTestSuite struct {
+	suite.Suite
+	mockService *mockUserRoleService
+	handler     *UserRoleHandler
+	testRole    *entity.Role
+}
+
+func TestUserRoleHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserRoleHandlerTestSuite))
+}
+
+func (suite *UserRoleHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserRoleService)
+	suite.handler = NewUserRoleHandler(suite.mockService)
+
+	name, _ := value.NewUserRole("support")
+	permission, _ := value.NewPermission(value.PermissionUsersRead)
+	testRole, err := entity.NewRole(name, "サポート担当", []*value.Permission{permission})
+	suite.Require().NoError(err)
+	suite.testRole = testRole
+}
+
+func (suite *UserRoleHandlerTestSuite) newContext(method string, target string, body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Set("validated_uid", actorObjID)
+	return c, w
+}
+
+// ----- ListAdminRoles / GetAdminRole のテスト -----
+
+func (suite *UserRoleHandlerTestSuite) TestListAdminRoles() {
+	suite.mockService.On("ListRoles").Return([]*entity.Role{suite.testRole}, nil)
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+
+	suite.handler.ListAdminRoles(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.RoleListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.Roles, 1)
+	suite.Equal(gen.Role{Name: "support", Description: "サポート担当", Permissions: []string{"users:read"}, BuiltIn: false}, response.Roles[0])
+}
+
+func (suite *UserRoleHandlerTestSuite) TestGetAdminRole() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{role.ErrRoleNotFound, http.StatusNotFound},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("GetRole", "support").Return(suite.testRole, nil)
+		} else {
+			suite.mockService.On("GetRole", "support").Return(nil, tc.err)
+		}
+		c, w := suite.newContext(http.MethodGet, "/", nil)
+
+		suite.handler.GetAdminRole(c, "support")
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+// ----- CreateAdminRole のテスト -----
+
+func (suite *UserRoleHandlerTestSuite) TestCreateAdminRole() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusCreated},
+		{role.ErrInvalidRole, http.StatusBadRequest},
+		{role.ErrRoleAlreadyExists, http.StatusConflict},
+		{role.ErrCannotGrant, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("CreateRole", actorObjID, "support", "サポート担当", []string{"users:read"}).Return(suite.testRole, nil)
+		} else {
+			suite.mockService.On("CreateRole", actorObjID, "support", "サポート担当", []string{"users:read"}).Return(nil, tc.err)
+		}
+		description := "サポート担当"
+		body, err := json.Marshal(gen.CreateAdminRoleJSONRequestBody{Name: "support", Description: &description, Permissions: []string{"users:read"}})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, "/", body)
+
+		suite.handler.CreateAdminRole(c)
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserRoleHandlerTestSuite) TestCreateAdminRole_InvalidJSON() {
+	c, w := suite.newContext(http.MethodPost, "/", []byte("invalid json"))
+
+	suite.handler.CreateAdminRole(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "CreateRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ----- UpdateAdminRole / DeleteAdminRole のテスト -----
+
+func (suite *UserRoleHandlerTestSuite) TestUpdateAdminRole() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusOK},
+		{role.ErrBuiltInRole, http.StatusConflict},
+		{role.ErrRoleNotFound, http.StatusNotFound},
+		{role.ErrCannotGrant, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("UpdateRole", actorObjID, "support", "", []string{"users:write"}).Return(suite.testRole, nil)
+		} else {
+			suite.mockService.On("UpdateRole", actorObjID, "support", "", []string{"users:write"}).Return(nil, tc.err)
+		}
+		body, err := json.Marshal(gen.UpdateAdminRoleJSONRequestBody{Permissions: []string{"users:write"}})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPut, "/", body)
+
+		suite.handler.UpdateAdminRole(c, "support")
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *UserRoleHandlerTestSuite) TestDeleteAdminRole() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{role.ErrBuiltInRole, http.StatusConflict},
+		{role.ErrRoleNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("DeleteRole", actorObjID, "support").Return(tc.err)
+		c, w := suite.newContext(http.MethodDelete, "/", nil)
+
+		suite.handler.DeleteAdminRole(c, "support")
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+// ----- GetProfilePermissions のテスト -----
+
+func (suite *UserRoleHandlerTestSuite) TestGetProfilePermissions() {
+	suite.mockService.On("GetPermissions", actorObjID).Return(&role.UserPermissions{
+		Roles:       []string{"support", "user"},
+		Groups:      []string{"engineering"},
+		Permissions: []string{"users:read"},
+	}, nil)
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+
+	suite.handler.GetProfilePermissions(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.ProfilePermissionsResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Equal([]string{"support", "user"}, response.Roles)
+	suite.Equal([]string{"engineering"}, response.Groups)
+	suite.Equal([]string{"users:read"}, response.Permissions)
+}
+
+func (suite *UserRoleHandlerTestSuite) TestGetProfilePermissions_Error() {
+	suite.mockService.On("GetPermissions", actorObjID).Return(nil, role.ErrUserNotFound)
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+
+	suite.handler.GetProfilePermissions(c)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *UserRoleHandlerTestSuite) TestGetProfilePermissions_NoUID() {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	suite.handler.GetProfilePermissions(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
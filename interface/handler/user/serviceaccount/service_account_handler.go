@@ -0,0 +1,277 @@
+package serviceaccount
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/apikey"
+	"github.com/goda6565/nexus-user-auth/application/service/user/serviceaccount"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+// grantTypeClientCredentials はクライアント資格情報の grant_type（RFC 6749 4.4.2）
+const grantTypeClientCredentials = "client_credentials"
+
+type ServiceAccountHandler struct {
+	serviceAccountService serviceaccount.ServiceAccountService
+}
+
+func NewServiceAccountHandler(serviceAccountService serviceaccount.ServiceAccountService) *ServiceAccountHandler {
+	return &ServiceAccountHandler{
+		serviceAccountService: serviceAccountService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーのIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// requireUID は認証済みユーザーのIDを取得し、取得できない場合は 400 を返す
+func requireUID(c *gin.Context) (string, bool) {
+	objID, ok := getValidatedUID(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return "", false
+	}
+	return objID, true
+}
+
+// ListAdminServiceAccounts: サービスアカウントの一覧
+func (h *ServiceAccountHandler) ListAdminServiceAccounts(c *gin.Context) {
+	accounts, err := h.serviceAccountService.ListServiceAccounts()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := gen.ServiceAccountListResponse{ServiceAccounts: make([]gen.ServiceAccount, 0, len(accounts))}
+	for _, account := range accounts {
+		response.ServiceAccounts = append(response.ServiceAccounts, toServiceAccountResponse(account))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateAdminServiceAccount: サービスアカウントの作成
+func (h *ServiceAccountHandler) CreateAdminServiceAccount(c *gin.Context) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.CreateAdminServiceAccountJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	orgObjID := ""
+	if req.OrganizationId != nil {
+		orgObjID = *req.OrganizationId
+	}
+
+	account, err := h.serviceAccountService.CreateServiceAccount(actorObjID, req.Name, description, orgObjID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, toServiceAccountResponse(account))
+}
+
+// GetAdminServiceAccount: サービスアカウントの取得
+func (h *ServiceAccountHandler) GetAdminServiceAccount(c *gin.Context, serviceAccountId string) {
+	account, err := h.serviceAccountService.GetServiceAccount(serviceAccountId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toServiceAccountResponse(account))
+}
+
+// DeleteAdminServiceAccount: サービスアカウントの削除
+func (h *ServiceAccountHandler) DeleteAdminServiceAccount(c *gin.Context, serviceAccountId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	if err := h.serviceAccountService.DeleteServiceAccount(actorObjID, serviceAccountId); err != nil {
+		respondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListAdminServiceAccountAPIKeys: サービスアカウントの API キーの一覧
+func (h *ServiceAccountHandler) ListAdminServiceAccountAPIKeys(c *gin.Context, serviceAccountId string) {
+	keys, err := h.serviceAccountService.ListAPIKeys(serviceAccountId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	apiKeys := make([]gen.APIKey, 0, len(keys))
+	for _, key := range keys {
+		apiKeys = append(apiKeys, toAPIKeyResponse(key))
+	}
+	c.JSON(http.StatusOK, gen.APIKeyListResponse{ApiKeys: apiKeys})
+}
+
+// CreateAdminServiceAccountAPIKey: サービスアカウントの API キーの作成（キーそのものはこのレスポンスでのみ返す）
+func (h *ServiceAccountHandler) CreateAdminServiceAccountAPIKey(c *gin.Context, serviceAccountId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	var req gen.CreateAdminServiceAccountAPIKeyJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	secret, key, err := h.serviceAccountService.CreateAPIKey(actorObjID, serviceAccountId, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// キーそのものをキャッシュに残さない
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, gen.APIKeyCreatedResponse{
+		ApiKey: toAPIKeyResponse(key),
+		Secret: secret,
+	})
+}
+
+// RevokeAdminServiceAccountAPIKey: サービスアカウントの API キーの取り消し
+func (h *ServiceAccountHandler) RevokeAdminServiceAccountAPIKey(c *gin.Context, serviceAccountId string, keyId string) {
+	actorObjID, ok := requireUID(c)
+	if !ok {
+		return
+	}
+	if err := h.serviceAccountService.RevokeAPIKey(actorObjID, serviceAccountId, keyId); err != nil {
+		respondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// IssueClientCredentialsToken: クライアント資格情報でのアクセストークンの発行（RFC 6749 4.4）
+// 資格情報はフォームの client_id / client_secret か HTTP Basic 認証で受け取る（両方の指定は認めない）
+func (h *ServiceAccountHandler) IssueClientCredentialsToken(c *gin.Context) {
+	// トークンをキャッシュに残さない（RFC 6749 5.1）
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+
+	grantType := c.PostForm("grant_type")
+	if grantType == "" {
+		respondOAuthError(c, http.StatusBadRequest, "invalid_request", "grant_type is required")
+		return
+	}
+	if grantType != grantTypeClientCredentials {
+		respondOAuthError(c, http.StatusBadRequest, "unsupported_grant_type", "only client_credentials is supported")
+		return
+	}
+
+	clientID, clientSecret, basic := c.Request.BasicAuth()
+	if basic && (c.PostForm("client_id") != "" || c.PostForm("client_secret") != "") {
+		respondOAuthError(c, http.StatusBadRequest, "invalid_request", "client credentials must be sent in only one way")
+		return
+	}
+	if !basic {
+		clientID = c.PostForm("client_id")
+		clientSecret = c.PostForm("client_secret")
+	}
+
+	issued, err := h.serviceAccountService.IssueToken(clientID, clientSecret, c.PostForm("scope"))
+	switch {
+	case errors.Is(err, serviceaccount.ErrInvalidClient):
+		if basic {
+			c.Header("WWW-Authenticate", `Basic realm="token"`)
+		}
+		respondOAuthError(c, http.StatusUnauthorized, "invalid_client", err.Error())
+		return
+	case errors.Is(err, serviceaccount.ErrInvalidScope):
+		respondOAuthError(c, http.StatusBadRequest, "invalid_scope", err.Error())
+		return
+	case err != nil:
+		respondOAuthError(c, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.ClientCredentialsTokenResponse{
+		AccessToken: issued.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(issued.ExpiresIn.Seconds()),
+		Scope:       strings.Join(issued.Scopes, " "),
+	})
+}
+
+// respondOAuthError は RFC 6749 5.2 の形式でエラーを返す
+func respondOAuthError(c *gin.Context, code int, errorCode string, description string) {
+	c.JSON(code, gen.OAuthErrorResponse{Error: errorCode, ErrorDescription: &description})
+}
+
+func respondError(c *gin.Context, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, serviceaccount.ErrServiceAccountNotFound), errors.Is(err, serviceaccount.ErrOrganizationNotFound), errors.Is(err, apikey.ErrAPIKeyNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, serviceaccount.ErrInvalidServiceAccount), errors.Is(err, apikey.ErrInvalidAPIKey):
+		code = http.StatusBadRequest
+	case errors.Is(err, apikey.ErrTooManyAPIKeys):
+		code = http.StatusConflict
+	}
+	c.JSON(code, gen.ErrorResponse{Message: err.Error(), Code: code})
+}
+
+func toServiceAccountResponse(account *entity.ServiceAccount) gen.ServiceAccount {
+	user := account.User()
+	response := gen.ServiceAccount{
+		Id:        account.ObjID().Value(),
+		Name:      user.Username().Value(),
+		OwnerType: gen.ServiceAccountOwnerType(account.OwnerType()),
+		OwnerId:   account.OwnerID(),
+		Status:    gen.ServiceAccountStatus(user.Status().Value()),
+		Roles:     user.RoleNames(),
+	}
+	if description := account.Description(); description != "" {
+		response.Description = &description
+	}
+	if user.CreatedAt() != nil {
+		createdAt := user.CreatedAt().Value()
+		response.CreatedAt = &createdAt
+	}
+	return response
+}
+
+// toAPIKeyResponse は API キーを API のレスポンスに変換する（キーのハッシュは返さない）
+func toAPIKeyResponse(key *entity.APIKey) gen.APIKey {
+	return gen.APIKey{
+		Id:         key.ID(),
+		Name:       key.Name(),
+		Prefix:     key.Prefix(),
+		Scopes:     key.ScopeNames(),
+		CreatedAt:  key.CreatedAt(),
+		ExpiresAt:  key.ExpiresAt(),
+		LastUsedAt: key.LastUsedAt(),
+	}
+}
@@ -0,0 +1,366 @@
+package serviceaccount_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/apikey"
+	"github.com/goda6565/nexus-user-auth/application/service/user/serviceaccount"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/serviceaccount"
+)
+
+// --- モックの ServiceAccountService ---
+type mockServiceAccountService struct {
+	mock.Mock
+}
+
+func (m *mockServiceAccountService) ListServiceAccounts() ([]*entity.ServiceAccount, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ServiceAccount), args.Error(1)
+}
+
+func (m *mockServiceAccountService) GetServiceAccount(objID string) (*entity.ServiceAccount, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ServiceAccount), args.Error(1)
+}
+
+func (m *mockServiceAccountService) CreateServiceAccount(actorObjID string, name string, description string, orgObjID string) (*entity.ServiceAccount, error) {
+	args := m.Called(actorObjID, name, description, orgObjID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ServiceAccount), args.Error(1)
+}
+
+func (m *mockServiceAccountService) DeleteServiceAccount(actorObjID string, objID string) error {
+	args := m.Called(actorObjID, objID)
+	return args.Error(0)
+}
+
+func (m *mockServiceAccountService) ListAPIKeys(objID string) ([]*entity.APIKey, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.APIKey), args.Error(1)
+}
+
+func (m *mockServiceAccountService) CreateAPIKey(actorObjID string, objID string, name string, scopes []string, expiresAt *time.Time) (string, *entity.APIKey, error) {
+	args := m.Called(actorObjID, objID, name, scopes, expiresAt)
+	if args.Get(1) == nil {
+		return "", nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*entity.APIKey), args.Error(2)
+}
+
+func (m *mockServiceAccountService) RevokeAPIKey(actorObjID string, objID string, keyID string) error {
+	args := m.Called(actorObjID, objID, keyID)
+	return args.Error(0)
+}
+
+func (m *mockServiceAccountService) IssueToken(clientID string, clientSecret string, scope string) (*serviceaccount.IssuedToken, error) {
+	args := m.Called(clientID, clientSecret, scope)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*serviceaccount.IssuedToken), args.Error(1)
+}
+
+// --- テストスイート ---
+
+const (
+	actorObjID = "11111111-1111-1111-1111-111111111111"
+	orgObjID   = "33333333-3333-3333-3333-333333333333"
+	secret     = "nxk_abcdefgh0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZab"
+)
+
+type ServiceAccountHandlerTestSuite struct {
+	suite.Suite
+	mockService *mockServiceAccountService
+	handler     *ServiceAccountHandler
+	account     *entity.ServiceAccount
+	key         *entity.APIKey
+}
+
+func TestServiceAccountHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceAccountHandlerTestSuite))
+}
+
+func (suite *ServiceAccountHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockServiceAccountService)
+	suite.handler = NewServiceAccountHandler(suite.mockService)
+
+	username, _ := value.NewUserUsername("ci-deployer")
+	actor, _ := value.NewUserObjID(actorObjID)
+	account, err := entity.NewServiceAccount(username, "デプロイ用", orgObjID, actor)
+	suite.Require().NoError(err)
+	suite.account = account
+	scope, _ := value.NewAPIKeyScope(value.PermissionUsersRead)
+	key, err := entity.NewAPIKey(account.ObjID(), "deploy", secret, []*value.APIKeyScope{scope}, nil)
+	suite.Require().NoError(err)
+	suite.key = key
+}
+
+func (suite *ServiceAccountHandlerTestSuite) newContext(method string, target string, body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Set("validated_uid", actorObjID)
+	return c, w
+}
+
+// newTokenContext はフォーム形式のトークンリクエストの Context を作成する
+func (suite *ServiceAccountHandlerTestSuite) newTokenContext(form url.Values) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/auth/token", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+// ----- ListAdminServiceAccounts / GetAdminServiceAccount のテスト -----
+
+func (suite *ServiceAccountHandlerTestSuite) TestListAdminServiceAccounts() {
+	suite.mockService.On("ListServiceAccounts").Return([]*entity.ServiceAccount{suite.account}, nil)
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+
+	suite.handler.ListAdminServiceAccounts(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.ServiceAccountListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.ServiceAccounts, 1)
+	got := response.ServiceAccounts[0]
+	suite.Equal(suite.account.ObjID().Value(), got.Id)
+	suite.Equal("ci-deployer", got.Name)
+	suite.Equal("デプロイ用", *got.Description)
+	suite.Equal(gen.ServiceAccountOwnerTypeOrganization, got.OwnerType)
+	suite.Equal(orgObjID, got.OwnerId)
+	suite.Equal(gen.ServiceAccountStatusActive, got.Status)
+	suite.Equal([]string{"user"}, got.Roles)
+}
+
+func (suite *ServiceAccountHandlerTestSuite) TestGetAdminServiceAccount_Error() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{serviceaccount.ErrServiceAccountNotFound, http.StatusNotFound},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("GetServiceAccount", "missing").Return(nil, tc.err)
+		c, w := suite.newContext(http.MethodGet, "/", nil)
+
+		suite.handler.GetAdminServiceAccount(c, "missing")
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+// ----- CreateAdminServiceAccount / DeleteAdminServiceAccount のテスト -----
+
+func (suite *ServiceAccountHandlerTestSuite) TestCreateAdminServiceAccount() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusCreated},
+		{serviceaccount.ErrInvalidServiceAccount, http.StatusBadRequest},
+		{serviceaccount.ErrOrganizationNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("CreateServiceAccount", actorObjID, "ci-deployer", "デプロイ用", orgObjID).Return(suite.account, nil)
+		} else {
+			suite.mockService.On("CreateServiceAccount", actorObjID, "ci-deployer", "デプロイ用", orgObjID).Return(nil, tc.err)
+		}
+		description := "デプロイ用"
+		organizationID := orgObjID
+		body, err := json.Marshal(gen.CreateAdminServiceAccountJSONRequestBody{Name: "ci-deployer", Description: &description, OrganizationId: &organizationID})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, "/", body)
+
+		suite.handler.CreateAdminServiceAccount(c)
+
+		suite.Equal(tc.status, w.Code)
+		suite.mockService.AssertExpectations(suite.T())
+	}
+}
+
+func (suite *ServiceAccountHandlerTestSuite) TestDeleteAdminServiceAccount() {
+	objID := suite.account.ObjID().Value()
+	suite.mockService.On("DeleteServiceAccount", actorObjID, objID).Return(nil)
+	c, w := suite.newContext(http.MethodDelete, "/", nil)
+
+	suite.handler.DeleteAdminServiceAccount(c, objID)
+	c.Writer.WriteHeaderNow()
+
+	suite.Equal(http.StatusNoContent, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// ----- API キーのテスト -----
+
+func (suite *ServiceAccountHandlerTestSuite) TestCreateAdminServiceAccountAPIKey() {
+	objID := suite.account.ObjID().Value()
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusCreated},
+		{apikey.ErrInvalidAPIKey, http.StatusBadRequest},
+		{apikey.ErrTooManyAPIKeys, http.StatusConflict},
+		{serviceaccount.ErrServiceAccountNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err == nil {
+			suite.mockService.On("CreateAPIKey", actorObjID, objID, "deploy", []string{"users:read"}, (*time.Time)(nil)).Return(secret, suite.key, nil)
+		} else {
+			suite.mockService.On("CreateAPIKey", actorObjID, objID, "deploy", []string{"users:read"}, (*time.Time)(nil)).Return("", nil, tc.err)
+		}
+		body, err := json.Marshal(gen.CreateAdminServiceAccountAPIKeyJSONRequestBody{Name: "deploy", Scopes: []string{"users:read"}})
+		suite.Require().NoError(err)
+		c, w := suite.newContext(http.MethodPost, "/", body)
+
+		suite.handler.CreateAdminServiceAccountAPIKey(c, objID)
+
+		suite.Equal(tc.status, w.Code)
+		if tc.err == nil {
+			suite.Equal("no-store", w.Header().Get("Cache-Control"))
+			var response gen.APIKeyCreatedResponse
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			suite.Equal(secret, response.Secret)
+			suite.Equal(suite.key.ID(), response.ApiKey.Id)
+		}
+	}
+}
+
+func (suite *ServiceAccountHandlerTestSuite) TestListAndRevokeAdminServiceAccountAPIKeys() {
+	objID := suite.account.ObjID().Value()
+	suite.mockService.On("ListAPIKeys", objID).Return([]*entity.APIKey{suite.key}, nil)
+	suite.mockService.On("RevokeAPIKey", actorObjID, objID, "missing").Return(apikey.ErrAPIKeyNotFound)
+
+	c, w := suite.newContext(http.MethodGet, "/", nil)
+	suite.handler.ListAdminServiceAccountAPIKeys(c, objID)
+	suite.Equal(http.StatusOK, w.Code)
+	var response gen.APIKeyListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.ApiKeys, 1)
+	suite.Equal([]string{"users:read"}, response.ApiKeys[0].Scopes)
+
+	c, w = suite.newContext(http.MethodDelete, "/", nil)
+	suite.handler.RevokeAdminServiceAccountAPIKey(c, objID, "missing")
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+// ----- IssueClientCredentialsToken のテスト -----
+
+func (suite *ServiceAccountHandlerTestSuite) TestIssueClientCredentialsToken() {
+	objID := suite.account.ObjID().Value()
+	suite.mockService.On("IssueToken", objID, secret, "users:read").Return(&serviceaccount.IssuedToken{
+		AccessToken: "access-token",
+		ExpiresIn:   time.Hour,
+		Scopes:      []string{"users:read"},
+	}, nil)
+	c, w := suite.newTokenContext(url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {objID},
+		"client_secret": {secret},
+		"scope":         {"users:read"},
+	})
+
+	suite.handler.IssueClientCredentialsToken(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("no-store", w.Header().Get("Cache-Control"))
+	var response gen.ClientCredentialsTokenResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Equal("access-token", response.AccessToken)
+	suite.Equal("Bearer", response.TokenType)
+	suite.Equal(3600, response.ExpiresIn)
+	suite.Equal("users:read", response.Scope)
+}
+
+// HTTP Basic 認証で資格情報を受け取れること
+func (suite *ServiceAccountHandlerTestSuite) TestIssueClientCredentialsToken_BasicAuth() {
+	objID := suite.account.ObjID().Value()
+	suite.mockService.On("IssueToken", objID, secret, "").Return(nil, serviceaccount.ErrInvalidClient)
+	c, w := suite.newTokenContext(url.Values{"grant_type": {"client_credentials"}})
+	c.Request.SetBasicAuth(objID, secret)
+
+	suite.handler.IssueClientCredentialsToken(c)
+
+	suite.Equal(http.StatusUnauthorized, w.Code)
+	suite.Contains(w.Header().Get("WWW-Authenticate"), "Basic")
+	var response gen.OAuthErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Equal("invalid_client", response.Error)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *ServiceAccountHandlerTestSuite) TestIssueClientCredentialsToken_Error() {
+	objID := suite.account.ObjID().Value()
+	cases := []struct {
+		name      string
+		form      url.Values
+		basicAuth bool
+		err       error
+		status    int
+		errorCode string
+	}{
+		{"grant_type の指定なし", url.Values{"client_id": {objID}, "client_secret": {secret}}, false, nil, http.StatusBadRequest, "invalid_request"},
+		{"client_credentials 以外", url.Values{"grant_type": {"password"}}, false, nil, http.StatusBadRequest, "unsupported_grant_type"},
+		{"資格情報の二重指定", url.Values{"grant_type": {"client_credentials"}, "client_secret": {secret}}, true, nil, http.StatusBadRequest, "invalid_request"},
+		{"資格情報の誤り", url.Values{"grant_type": {"client_credentials"}, "client_id": {objID}, "client_secret": {secret}}, false, serviceaccount.ErrInvalidClient, http.StatusUnauthorized, "invalid_client"},
+		{"許可されていないスコープ", url.Values{"grant_type": {"client_credentials"}, "client_id": {objID}, "client_secret": {secret}}, false, serviceaccount.ErrInvalidScope, http.StatusBadRequest, "invalid_scope"},
+		{"予期しないエラー", url.Values{"grant_type": {"client_credentials"}, "client_id": {objID}, "client_secret": {secret}}, false, errors.New("unexpected"), http.StatusInternalServerError, "server_error"},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		if tc.err != nil {
+			suite.mockService.On("IssueToken", objID, secret, "").Return(nil, tc.err)
+		}
+		c, w := suite.newTokenContext(tc.form)
+		if tc.basicAuth {
+			c.Request.SetBasicAuth(objID, secret)
+		}
+
+		suite.handler.IssueClientCredentialsToken(c)
+
+		suite.Equal(tc.status, w.Code, tc.name)
+		var response gen.OAuthErrorResponse
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		suite.Equal(tc.errorCode, response.Error, tc.name)
+		if tc.err == nil {
+			suite.mockService.AssertNotCalled(suite.T(), "IssueToken", mock.Anything, mock.Anything, mock.Anything)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package stepup
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/stepup"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/interface/handler"
+)
+
+type UserStepUpHandler struct {
+	userStepUpService stepup.UserStepUpService
+}
+
+func NewUserStepUpHandler(userStepUpService stepup.UserStepUpService) *UserStepUpHandler {
+	return &UserStepUpHandler{
+		userStepUpService: userStepUpService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// Reauthenticate: 再認証（ステップアップ認証）
+func (h *UserStepUpHandler) Reauthenticate(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var req gen.ReauthenticateRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	var password, code string
+	if req.Password != nil {
+		password = *req.Password
+	}
+	if req.Code != nil {
+		code = *req.Code
+	}
+
+	// 組織に切り替えたトークンの場合は、再認証後も同じ組織のトークンを発行する
+	accessToken, refreshToken, err := h.userStepUpService.Reauthenticate(objID, c.GetString("validated_org_id"), password, code)
+	// 失敗が続いている場合は、再試行できるまでの秒数を Retry-After で返す（ロック中は 423、それ以外は 429）
+	if handler.RespondLockout(c, err) {
+		return
+	}
+	if errors.Is(err, stepup.ErrPasswordRequired) || errors.Is(err, stepup.ErrCodeRequired) || errors.Is(err, stepup.ErrReauthUnavailable) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if errors.Is(err, stepup.ErrInvalidPassword) || errors.Is(err, stepup.ErrInvalidCode) {
+		c.JSON(http.StatusUnauthorized, gen.ErrorResponse{Message: err.Error(), Code: http.StatusUnauthorized})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.LoginResponse{
+		AccessToken:  &accessToken,
+		RefreshToken: &refreshToken,
+	})
+}
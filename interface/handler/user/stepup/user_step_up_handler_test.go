@@ -0,0 +1,178 @@
+package stepup_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	"github.com/goda6565/nexus-user-auth/application/service/user/stepup"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/stepup"
+)
+
+// --- モックの UserStepUpService ---
+type mockUserStepUpService struct {
+	mock.Mock
+}
+
+func (m *mockUserStepUpService) Reauthenticate(objID string, orgID string, password string, code string) (string, string, error) {
+	args := m.Called(objID, orgID, password, code)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+// --- テストスイート ---
+type UserStepUpHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserStepUpHandler
+	mockService *mockUserStepUpService
+}
+
+func TestUserStepUpHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserStepUpHandlerTestSuite))
+}
+
+func (suite *UserStepUpHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserStepUpService)
+	suite.handler = NewUserStepUpHandler(suite.mockService)
+}
+
+func (suite *UserStepUpHandlerTestSuite) newContext(body []byte, uid string) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/auth/reauthenticate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	if uid != "" {
+		c.Set("validated_uid", uid)
+	}
+	return c, w
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// 正常系: 新しいトークンが返ること
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_Success() {
+	suite.mockService.On("Reauthenticate", "uid-1", "", "password123", "123456").Return("access", "refresh", nil)
+
+	body, _ := json.Marshal(gen.ReauthenticateRequestBody{Password: strPtr("password123"), Code: strPtr("123456")})
+	c, w := suite.newContext(body, "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.LoginResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal("access", *resp.AccessToken)
+	suite.Equal("refresh", *resp.RefreshToken)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 省略した項目は空文字としてサービスに渡すこと
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_PasswordOnly() {
+	suite.mockService.On("Reauthenticate", "uid-1", "", "password123", "").Return("access", "refresh", nil)
+
+	body, _ := json.Marshal(gen.ReauthenticateRequestBody{Password: strPtr("password123")})
+	c, w := suite.newContext(body, "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 必要な要素が不足している場合: 400 が返ること
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_CodeRequired() {
+	suite.mockService.On("Reauthenticate", "uid-1", "", "password123", "").Return("", "", stepup.ErrCodeRequired)
+
+	body, _ := json.Marshal(gen.ReauthenticateRequestBody{Password: strPtr("password123")})
+	c, w := suite.newContext(body, "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// 再認証できないユーザー: 400 が返ること
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_Unavailable() {
+	suite.mockService.On("Reauthenticate", "uid-1", "", "", "").Return("", "", stepup.ErrReauthUnavailable)
+
+	c, w := suite.newContext([]byte(`{}`), "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// 誤ったパスワード: 401 が返ること
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_InvalidPassword() {
+	suite.mockService.On("Reauthenticate", "uid-1", "", "wrong", "").Return("", "", stepup.ErrInvalidPassword)
+
+	body, _ := json.Marshal(gen.ReauthenticateRequestBody{Password: strPtr("wrong")})
+	c, w := suite.newContext(body, "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusUnauthorized, w.Code)
+	var errResp gen.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	suite.Equal(http.StatusUnauthorized, errResp.Code)
+}
+
+// 誤ったコード: 401 が返ること
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_InvalidCode() {
+	suite.mockService.On("Reauthenticate", "uid-1", "", "", "000000").Return("", "", stepup.ErrInvalidCode)
+
+	body, _ := json.Marshal(gen.ReauthenticateRequestBody{Code: strPtr("000000")})
+	c, w := suite.newContext(body, "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusUnauthorized, w.Code)
+}
+
+// コードの誤りが続いている場合: 429 と Retry-After が返ること
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_Throttled() {
+	suite.mockService.On("Reauthenticate", "uid-1", "", "", "000000").Return("", "", &lockout.LockoutError{Err: lockout.ErrLoginThrottled, RetryAfter: 2 * time.Second})
+
+	body, _ := json.Marshal(gen.ReauthenticateRequestBody{Code: strPtr("000000")})
+	c, w := suite.newContext(body, "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusTooManyRequests, w.Code)
+	suite.Equal("2", w.Header().Get("Retry-After"))
+}
+
+// サービスエラー: 500 が返ること
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_ServiceError() {
+	suite.mockService.On("Reauthenticate", "uid-1", "", "password123", "").Return("", "", errors.New("db error"))
+
+	body, _ := json.Marshal(gen.ReauthenticateRequestBody{Password: strPtr("password123")})
+	c, w := suite.newContext(body, "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
+
+// ユーザーIDが取得できない場合: 400 が返ること
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_NoUID() {
+	body, _ := json.Marshal(gen.ReauthenticateRequestBody{Password: strPtr("password123")})
+	c, w := suite.newContext(body, "")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "Reauthenticate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// バインドエラー: 不正なJSONの場合
+func (suite *UserStepUpHandlerTestSuite) TestReauthenticate_InvalidJSON() {
+	c, w := suite.newContext([]byte("invalid json"), "uid-1")
+	suite.handler.Reauthenticate(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,131 @@
+package trusteddevice
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/trusteddevice"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+// CookieName は信頼済み端末のトークンを保持する Cookie の名前
+const CookieName = "trusted_device"
+
+// cookiePath はログイン系のエンドポイントにのみ Cookie を送信させるためのパス
+const cookiePath = "/api/v1/auth"
+
+type UserTrustedDeviceHandler struct {
+	userTrustedDeviceService trusteddevice.UserTrustedDeviceService
+}
+
+func NewUserTrustedDeviceHandler(userTrustedDeviceService trusteddevice.UserTrustedDeviceService) *UserTrustedDeviceHandler {
+	return &UserTrustedDeviceHandler{
+		userTrustedDeviceService: userTrustedDeviceService,
+	}
+}
+
+// getValidatedUID: Gin の Context から認証済みユーザーIDを取得するヘルパー関数
+func getValidatedUID(c *gin.Context) (string, bool) {
+	objID, exists := c.Get("validated_uid")
+	if !exists {
+		return "", false
+	}
+
+	objIDStr, ok := objID.(string)
+	if !ok || objIDStr == "" {
+		return "", false
+	}
+
+	return objIDStr, true
+}
+
+// ListTrustedDevices: 信頼済み端末の一覧
+func (h *UserTrustedDeviceHandler) ListTrustedDevices(c *gin.Context) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	devices, err := h.userTrustedDeviceService.ListDevices(objID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	trustedDevices := make([]gen.TrustedDevice, 0, len(devices))
+	for _, device := range devices {
+		trustedDevices = append(trustedDevices, toTrustedDeviceResponse(device))
+	}
+	c.JSON(http.StatusOK, gen.TrustedDeviceListResponse{TrustedDevices: trustedDevices})
+}
+
+// RevokeTrustedDevice: 信頼済み端末の取り消し
+func (h *UserTrustedDeviceHandler) RevokeTrustedDevice(c *gin.Context, deviceId string) {
+	objID, exists := getValidatedUID(c)
+	if !exists {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{
+			Message: "ユーザーIDが取得できません",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	err := h.userTrustedDeviceService.RevokeDevice(objID, deviceId)
+	if errors.Is(err, trusteddevice.ErrDeviceNotFound) {
+		c.JSON(http.StatusNotFound, gen.ErrorResponse{Message: err.Error(), Code: http.StatusNotFound})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// TokenFromRequest は、リクエストボディ（bodyToken）または Cookie から信頼済み端末のトークンを取得する
+// ログイン系のハンドラーから利用する。どちらにもない場合は空文字を返す。
+func TokenFromRequest(c *gin.Context, bodyToken *string) string {
+	if bodyToken != nil && *bodyToken != "" {
+		return *bodyToken
+	}
+	token, err := c.Cookie(CookieName)
+	if err != nil {
+		return ""
+	}
+	return token
+}
+
+// Remember は、二要素認証の検証で発行したアクセストークンの端末を記憶し、トークンを Cookie に設定して返す
+// 端末を記憶できなくてもログイン自体は成功しているため、失敗した場合はログに記録して nil を返す。
+func Remember(c *gin.Context, service trusteddevice.UserTrustedDeviceService, accessToken string) *string {
+	token, device, err := service.Remember(accessToken, c.Request.UserAgent())
+	if err != nil {
+		logger.Warn("failed to remember trusted device", "error", err.Error())
+		return nil
+	}
+	maxAge := int(time.Until(device.ExpiresAt()).Seconds())
+	c.SetSameSite(http.SameSiteStrictMode)
+	c.SetCookie(CookieName, token, maxAge, cookiePath, "", true, true)
+	return &token
+}
+
+// toTrustedDeviceResponse は信頼済み端末を API のレスポンスに変換する
+func toTrustedDeviceResponse(device *entity.TrustedDevice) gen.TrustedDevice {
+	return gen.TrustedDevice{
+		Id:         device.ID(),
+		Name:       device.Name(),
+		CreatedAt:  device.CreatedAt(),
+		ExpiresAt:  device.ExpiresAt(),
+		LastUsedAt: device.LastUsedAt(),
+	}
+}
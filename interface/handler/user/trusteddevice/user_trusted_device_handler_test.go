@@ -0,0 +1,166 @@
+package trusteddevice_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/trusteddevice"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/trusteddevice"
+)
+
+// --- モックの UserTrustedDeviceService ---
+type mockUserTrustedDeviceService struct {
+	mock.Mock
+}
+
+func (m *mockUserTrustedDeviceService) Remember(accessToken string, name string) (string, *entity.TrustedDevice, error) {
+	args := m.Called(accessToken, name)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*entity.TrustedDevice), args.Error(2)
+}
+
+func (m *mockUserTrustedDeviceService) IsTrusted(objID string, token string) bool {
+	args := m.Called(objID, token)
+	return args.Bool(0)
+}
+
+func (m *mockUserTrustedDeviceService) ListDevices(objID string) ([]*entity.TrustedDevice, error) {
+	args := m.Called(objID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TrustedDevice), args.Error(1)
+}
+
+func (m *mockUserTrustedDeviceService) RevokeDevice(objID string, deviceID string) error {
+	args := m.Called(objID, deviceID)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+type UserTrustedDeviceHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserTrustedDeviceHandler
+	mockService *mockUserTrustedDeviceService
+}
+
+func TestUserTrustedDeviceHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserTrustedDeviceHandlerTestSuite))
+}
+
+func (suite *UserTrustedDeviceHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserTrustedDeviceService)
+	suite.handler = NewUserTrustedDeviceHandler(suite.mockService)
+}
+
+func (suite *UserTrustedDeviceHandlerTestSuite) newContext(method string, uid string) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	if uid != "" {
+		c.Set("validated_uid", uid)
+	}
+	return c, w
+}
+
+// ----- ListTrustedDevices のテスト -----
+
+func (suite *UserTrustedDeviceHandlerTestSuite) TestListTrustedDevices() {
+	userObjID, err := value.NewUserObjID("123e4567-e89b-12d3-a456-426614174000")
+	suite.Require().NoError(err)
+	device, err := entity.NewTrustedDevice(userObjID, "Mozilla/5.0", 24*time.Hour)
+	suite.Require().NoError(err)
+	suite.mockService.On("ListDevices", "uid-1").Return([]*entity.TrustedDevice{device}, nil)
+	c, w := suite.newContext(http.MethodGet, "uid-1")
+
+	suite.handler.ListTrustedDevices(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.TrustedDeviceListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Require().Len(resp.TrustedDevices, 1)
+	suite.Equal(device.ID(), resp.TrustedDevices[0].Id)
+	suite.Equal("Mozilla/5.0", resp.TrustedDevices[0].Name)
+	suite.Nil(resp.TrustedDevices[0].LastUsedAt)
+}
+
+func (suite *UserTrustedDeviceHandlerTestSuite) TestListTrustedDevices_Empty() {
+	suite.mockService.On("ListDevices", "uid-1").Return(nil, nil)
+	c, w := suite.newContext(http.MethodGet, "uid-1")
+
+	suite.handler.ListTrustedDevices(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`{"trustedDevices":[]}`, w.Body.String())
+}
+
+func (suite *UserTrustedDeviceHandlerTestSuite) TestListTrustedDevices_NoUID() {
+	c, w := suite.newContext(http.MethodGet, "")
+
+	suite.handler.ListTrustedDevices(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// ----- RevokeTrustedDevice のテスト -----
+
+func (suite *UserTrustedDeviceHandlerTestSuite) TestRevokeTrustedDevice() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{nil, http.StatusNoContent},
+		{trusteddevice.ErrDeviceNotFound, http.StatusNotFound},
+		{errors.New("unexpected"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		suite.SetupTest()
+		suite.mockService.On("RevokeDevice", "uid-1", "device-1").Return(tc.err)
+		c, w := suite.newContext(http.MethodDelete, "uid-1")
+
+		suite.handler.RevokeTrustedDevice(c, "device-1")
+		c.Writer.WriteHeaderNow()
+
+		suite.Equal(tc.status, w.Code)
+	}
+}
+
+func (suite *UserTrustedDeviceHandlerTestSuite) TestRevokeTrustedDevice_NoUID() {
+	c, w := suite.newContext(http.MethodDelete, "")
+
+	suite.handler.RevokeTrustedDevice(c, "device-1")
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "RevokeDevice", mock.Anything, mock.Anything)
+}
+
+// ----- TokenFromRequest のテスト -----
+
+func (suite *UserTrustedDeviceHandlerTestSuite) TestTokenFromRequest() {
+	c, _ := suite.newContext(http.MethodPost, "")
+	suite.Equal("", TokenFromRequest(c, nil), "どちらにもない場合は空文字")
+
+	c.Request.AddCookie(&http.Cookie{Name: CookieName, Value: "cookie-token"})
+	suite.Equal("cookie-token", TokenFromRequest(c, nil))
+
+	empty := ""
+	suite.Equal("cookie-token", TokenFromRequest(c, &empty), "ボディが空の場合は Cookie を使う")
+
+	body := "body-token"
+	suite.Equal("body-token", TokenFromRequest(c, &body), "ボディの指定を優先する")
+}
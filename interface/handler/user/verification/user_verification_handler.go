@@ -0,0 +1,69 @@
+package verification
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/verification"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+type UserVerificationHandler struct {
+	userVerificationService verification.UserVerificationService
+}
+
+func NewUserVerificationHandler(userVerificationService verification.UserVerificationService) *UserVerificationHandler {
+	return &UserVerificationHandler{
+		userVerificationService: userVerificationService,
+	}
+}
+
+// VerifyEmail: メールアドレスの確認
+func (h *UserVerificationHandler) VerifyEmail(c *gin.Context) {
+	var req gen.EmailVerifyRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	user, err := h.userVerificationService.VerifyEmail(req.Token)
+	if errors.Is(err, verification.ErrInvalidVerificationToken) {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.EmailVerifyResponse{
+		Uid:             user.ObjID().Value(),
+		Email:           user.Email().Value(),
+		EmailVerifiedAt: user.EmailVerifiedAt().Value(),
+	})
+}
+
+// ResendVerificationEmail: 確認メールの再送
+func (h *UserVerificationHandler) ResendVerificationEmail(c *gin.Context) {
+	var req gen.EmailVerifyResendRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gen.ErrorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	err := h.userVerificationService.ResendVerificationEmail(req.Email)
+	if errors.Is(err, verification.ErrResendThrottled) {
+		c.JSON(http.StatusTooManyRequests, gen.ErrorResponse{Message: err.Error(), Code: http.StatusTooManyRequests})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.ErrorResponse{Message: err.Error(), Code: http.StatusInternalServerError})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gen.MessageResponse{
+		Message: "確認メールを送信しました",
+	})
+}
@@ -0,0 +1,164 @@
+package verification_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/verification"
+	"github.com/goda6565/nexus-user-auth/domain/timeobj"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	. "github.com/goda6565/nexus-user-auth/interface/handler/user/verification"
+)
+
+// --- モックの UserVerificationService ---
+type mockUserVerificationService struct {
+	mock.Mock
+}
+
+func (m *mockUserVerificationService) SendVerificationEmail(user *entity.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *mockUserVerificationService) VerifyEmail(token string) (*entity.User, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *mockUserVerificationService) ResendVerificationEmail(email string) error {
+	args := m.Called(email)
+	return args.Error(0)
+}
+
+// --- テストスイート ---
+type UserVerificationHandlerTestSuite struct {
+	suite.Suite
+	handler     *UserVerificationHandler
+	mockService *mockUserVerificationService
+}
+
+func TestUserVerificationHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserVerificationHandlerTestSuite))
+}
+
+func (suite *UserVerificationHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(mockUserVerificationService)
+	suite.handler = NewUserVerificationHandler(suite.mockService)
+}
+
+func (suite *UserVerificationHandlerTestSuite) newContext(body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/auth/email/verify", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+// ----- VerifyEmail のテスト -----
+
+// 正常系: トークンが有効な場合
+func (suite *UserVerificationHandlerTestSuite) TestVerifyEmail_Success() {
+	email, _ := value.NewUserEmail("test@example.com")
+	username, _ := value.NewUserUsername("testuser")
+	user, err := entity.NewUser(email, value.FromHashed("hashed"), username)
+	suite.Require().NoError(err)
+	verifiedAt, _ := timeobj.NewTimeObj(time.Now())
+	suite.Require().NoError(user.VerifyEmail(verifiedAt))
+
+	suite.mockService.On("VerifyEmail", "valid-token").Return(user, nil)
+
+	body, _ := json.Marshal(gen.EmailVerifyRequestBody{Token: "valid-token"})
+	c, w := suite.newContext(body)
+	suite.handler.VerifyEmail(c)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp gen.EmailVerifyResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal(user.ObjID().Value(), resp.Uid)
+	suite.Equal("test@example.com", resp.Email)
+	suite.False(resp.EmailVerifiedAt.IsZero())
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// 不正なトークン: 400 が返ること
+func (suite *UserVerificationHandlerTestSuite) TestVerifyEmail_InvalidToken() {
+	suite.mockService.On("VerifyEmail", "invalid-token").Return(nil, verification.ErrInvalidVerificationToken)
+
+	body, _ := json.Marshal(gen.EmailVerifyRequestBody{Token: "invalid-token"})
+	c, w := suite.newContext(body)
+	suite.handler.VerifyEmail(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	var errResp gen.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	suite.Equal(http.StatusBadRequest, errResp.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// サービスエラー: 500 が返ること
+func (suite *UserVerificationHandlerTestSuite) TestVerifyEmail_ServiceError() {
+	suite.mockService.On("VerifyEmail", "token").Return(nil, errors.New("update failed"))
+
+	body, _ := json.Marshal(gen.EmailVerifyRequestBody{Token: "token"})
+	c, w := suite.newContext(body)
+	suite.handler.VerifyEmail(c)
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// バインドエラー: 不正なJSONの場合
+func (suite *UserVerificationHandlerTestSuite) TestVerifyEmail_InvalidJSON() {
+	c, w := suite.newContext([]byte("invalid json"))
+	suite.handler.VerifyEmail(c)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// ----- ResendVerificationEmail のテスト -----
+
+// 正常系: 202 が返ること
+func (suite *UserVerificationHandlerTestSuite) TestResendVerificationEmail_Success() {
+	suite.mockService.On("ResendVerificationEmail", "test@example.com").Return(nil)
+
+	body, _ := json.Marshal(gen.EmailVerifyResendRequestBody{Email: "test@example.com"})
+	c, w := suite.newContext(body)
+	suite.handler.ResendVerificationEmail(c)
+
+	suite.Equal(http.StatusAccepted, w.Code)
+	var resp gen.MessageResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.NotEmpty(resp.Message)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// スロットリング: 429 が返ること
+func (suite *UserVerificationHandlerTestSuite) TestResendVerificationEmail_Throttled() {
+	suite.mockService.On("ResendVerificationEmail", "test@example.com").Return(verification.ErrResendThrottled)
+
+	body, _ := json.Marshal(gen.EmailVerifyResendRequestBody{Email: "test@example.com"})
+	c, w := suite.newContext(body)
+	suite.handler.ResendVerificationEmail(c)
+
+	suite.Equal(http.StatusTooManyRequests, w.Code)
+	var errResp gen.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	suite.Equal(http.StatusTooManyRequests, errResp.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
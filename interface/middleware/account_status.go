@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/interface/handler"
+)
+
+// AccountStatusMiddleware は、有効でないアカウント（有効化待ち・停止・無効化）のユーザーのリクエストを拒否します。
+// 状態はトークンではなく保存されたユーザーから判定するため、停止などは発行済みのトークンにも即座に反映されます。
+// AuthMiddleware が検証したリクエストのみを対象とするため、AuthMiddleware の後に適用してください。
+func AccountStatusMiddleware(userRepository repository.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		objID := c.GetString("validated_uid")
+		if objID == "" {
+			c.Next()
+			return
+		}
+
+		user, err := userRepository.GetUserByObjID(objID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+				Message: "Invalid token",
+				Code:    http.StatusUnauthorized,
+			})
+			return
+		}
+		if resp, ok := handler.AccountStatusErrorResponse(user.CheckActive()); ok {
+			c.AbortWithStatusJSON(resp.Code, resp)
+			return
+		}
+
+		c.Next()
+	}
+}
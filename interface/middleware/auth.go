@@ -3,24 +3,40 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
 	"github.com/goda6565/nexus-user-auth/interface/gen"
 	"github.com/goda6565/nexus-user-auth/interface/keys"
 	"github.com/goda6565/nexus-user-auth/pkg/utils"
 )
 
-func AuthMiddleware(path string) gin.HandlerFunc {
+// APIKeyAuthenticator は、キーそのものから有効な API キーを取得します。
+type APIKeyAuthenticator interface {
+	Authenticate(secret string) (*entity.APIKey, error)
+}
+
+// AuthMiddleware は、指定されたパスでアクセストークンを tokens の鍵で検証します（他のテナントのトークンは受け付けない）。
+// パスは完全一致で判定し、末尾が "/*" のパスはその配下（パスパラメーターを含むパス）に一致します。
+// API キー（X-API-Key ヘッダー、または APIKeyPrefix で始まる Bearer の値）は apiKeys で検証し、キーの所有者のリクエストとして扱います。
+func AuthMiddleware(tokens *utils.TokenSigner, apiKeys APIKeyAuthenticator, paths ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 
-		// 指定されたパスと完全一致しなければ認証処理をスキップ
-		if c.Request.URL.Path != path {
+		// 指定されたいずれのパスとも一致しなければ認証処理をスキップ
+		if !matchPath(paths, c.Request.URL.Path) {
 			c.Next()
 			return
 		}
 
+		// API キーの検証（X-API-Key ヘッダーを優先する）
+		if secret := c.Request.Header.Get("X-API-Key"); secret != "" {
+			authenticateAPIKey(c, apiKeys, secret)
+			return
+		}
+
 		// Authorization ヘッダーの取得
 		authHeader := c.Request.Header.Get("Authorization")
 
@@ -35,8 +51,14 @@ func AuthMiddleware(path string) gin.HandlerFunc {
 			return
 		}
 
+		// Bearer の値が API キーの場合
+		if entity.IsAPIKeySecret(authHeader) {
+			authenticateAPIKey(c, apiKeys, authHeader)
+			return
+		}
+
 		// トークン検証
-		claims, err := utils.ValidateToken(authHeader)
+		claims, err := tokens.ValidateToken(authHeader)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
 				Message: "Invalid token",
@@ -45,8 +67,16 @@ func AuthMiddleware(path string) gin.HandlerFunc {
 			return
 		}
 
-		// Gin の Context にユーザーIDをセット
+		// Gin の Context にユーザーIDと認証の情報（StepUpMiddleware で使う）、発行日時（SessionMiddleware で使う）をセット
 		c.Set("validated_uid", claims.ObjID)
+		c.Set("validated_auth", claims.AuthContext())
+		c.Set("validated_issued_at", claims.IssuedAt)
+		// 組織に切り替えたトークンの場合は組織ID（OrganizationMembershipMiddleware・組織のハンドラーで使う）
+		c.Set("validated_org_id", claims.OrgID)
+		// サービスアカウントのトークンの場合はスコープ（ScopeMiddleware で使う）
+		if claims.IsServiceAccount() {
+			c.Set("validated_scopes", claims.Scopes)
+		}
 
 		// リクエストのコンテキストも更新
 		newCtx := context.WithValue(c.Request.Context(), keys.ValidatedUIDKey, claims.ObjID)
@@ -55,3 +85,51 @@ func AuthMiddleware(path string) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// authenticateAPIKey は API キーを検証し、キーの所有者のリクエストとして Context をセットします。
+// API キーは認証の日時・方法を持たないため、直近の認証を求める操作（StepUpMiddleware）には使えません。
+// 発行日時にはキーの作成日時をセットするため、ユーザーがトークンを無効にするとそれより前に作成したキーも使えなくなります。
+func authenticateAPIKey(c *gin.Context, apiKeys APIKeyAuthenticator, secret string) {
+	if apiKeys == nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+			Message: "Invalid API key",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+	key, err := apiKeys.Authenticate(secret)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+			Message: "Invalid API key",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	objID := key.UserObjID().Value()
+	c.Set("validated_uid", objID)
+	c.Set("validated_auth", utils.AuthContext{})
+	c.Set("validated_issued_at", key.CreatedAt())
+	c.Set("validated_org_id", "")
+	// API キー（RateLimitMiddleware で使う）とスコープ（ScopeMiddleware で使う）
+	c.Set("validated_api_key", key)
+	c.Set("validated_scopes", key.ScopeNames())
+
+	newCtx := context.WithValue(c.Request.Context(), keys.ValidatedUIDKey, objID)
+	c.Request = c.Request.WithContext(newCtx)
+
+	c.Next()
+}
+
+// matchPath は、path が patterns のいずれかに一致するかを判定します。
+func matchPath(patterns []string, path string) bool {
+	if slices.Contains(patterns, path) {
+		return true
+	}
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(path, prefix+"/") && len(path) > len(prefix)+1 {
+			return true
+		}
+	}
+	return false
+}
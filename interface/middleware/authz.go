@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/application/service/user/authz"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/policy"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// AuthzMiddleware は、routes のうち最初に一致したルートの操作をポリシーで判定し、許可されないユーザーを拒否します。
+// 対象の ID は最後のパスパラメーター（パスパラメーターがない場合は認証済みユーザー自身）、属性はパスパラメーターです。
+// context には method・path・ip・userAgent を渡します。AuthMiddleware の後に適用してください。
+func AuthzMiddleware(userAuthzService authz.UserAuthzService, routes ...policy.Route) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route, ok := matchedRoute(routes, c.Request.Method, c.Request.URL.Path)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		objID := c.GetString("validated_uid")
+		if objID == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+				Message: "Invalid token",
+				Code:    http.StatusUnauthorized,
+			})
+			return
+		}
+
+		resource := policy.Resource{Type: route.ResourceType, ID: objID, Attributes: make(map[string]any, len(c.Params))}
+		for _, param := range c.Params {
+			resource.ID = param.Value
+			resource.Attributes[param.Key] = param.Value
+		}
+		token := authz.Token{}
+		if auth, ok := c.Value("validated_auth").(utils.AuthContext); ok {
+			token.Auth = auth
+		}
+		if issuedAt, ok := c.Value("validated_issued_at").(time.Time); ok {
+			token.IssuedAt = issuedAt
+		}
+		token.OrgID = c.GetString("validated_org_id")
+
+		decision, err := userAuthzService.Check(objID, authz.CheckRequest{
+			Action:   route.Action,
+			Resource: resource,
+			Context: map[string]any{
+				"method":    c.Request.Method,
+				"path":      c.Request.URL.Path,
+				"ip":        c.ClientIP(),
+				"userAgent": c.Request.UserAgent(),
+			},
+			Token: token,
+		})
+		if errors.Is(err, authz.ErrUserNotFound) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+				Message: "Invalid token",
+				Code:    http.StatusUnauthorized,
+			})
+			return
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gen.ErrorResponse{
+				Message: "Failed to evaluate policies",
+				Code:    http.StatusInternalServerError,
+			})
+			return
+		}
+		if !decision.Allowed {
+			c.AbortWithStatusJSON(http.StatusForbidden, gen.ErrorResponse{
+				Message: fmt.Sprintf("Action %q is not allowed: %s", route.Action, decision.Reason),
+				Code:    http.StatusForbidden,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// matchedRoute は、リクエストに最初に一致したルートを返します。
+func matchedRoute(routes []policy.Route, method string, path string) (policy.Route, bool) {
+	for _, route := range routes {
+		if route.Matches(method, path) {
+			return route, true
+		}
+	}
+	return policy.Route{}, false
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+// EmailVerifiedMiddleware は、指定されたルート（"METHOD /path" 形式。パスの末尾の "/*" はその下のすべてのパス）で
+// メールアドレス未確認のユーザーを拒否します。AuthMiddleware の後に適用してください。
+func EmailVerifiedMiddleware(userRepository repository.UserRepository, routes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !slices.ContainsFunc(routes, func(route string) bool {
+			return matchRoute(route, c.Request.Method, c.Request.URL.Path)
+		}) {
+			c.Next()
+			return
+		}
+
+		objID := c.GetString("validated_uid")
+		if objID == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+				Message: "Invalid token",
+				Code:    http.StatusUnauthorized,
+			})
+			return
+		}
+
+		user, err := userRepository.GetUserByObjID(objID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+				Message: "Invalid token",
+				Code:    http.StatusUnauthorized,
+			})
+			return
+		}
+		if !user.IsEmailVerified() {
+			c.AbortWithStatusJSON(http.StatusForbidden, gen.ErrorResponse{
+				Message: "Email address is not verified",
+				Code:    http.StatusForbidden,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
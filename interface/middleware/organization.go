@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/domain/organization/repository"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+// errorCodeOrganizationMembershipRevoked は、切り替えた組織から外されたトークンを拒否した場合のエラーの種類（ErrorResponse の error）
+const errorCodeOrganizationMembershipRevoked = "organization_membership_revoked"
+
+// OrganizationMembershipMiddleware は、組織に切り替えたトークン（org_id クレームを含む）のうち、
+// その組織から外された（組織が削除された場合を含む）ユーザーのリクエストを拒否します。
+// 指定されたルート（"METHOD /path" 形式、組織の切り替えなど）は対象外です。AuthMiddleware の後に適用してください。
+func OrganizationMembershipMiddleware(organizationRepository repository.OrganizationRepository, exemptRoutes ...string) gin.HandlerFunc {
+	exempts := make(map[string]struct{}, len(exemptRoutes))
+	for _, route := range exemptRoutes {
+		exempts[route] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		objID := c.GetString("validated_uid")
+		orgID := c.GetString("validated_org_id")
+		if objID == "" || orgID == "" {
+			c.Next()
+			return
+		}
+		if _, ok := exempts[c.Request.Method+" "+c.Request.URL.Path]; ok {
+			c.Next()
+			return
+		}
+
+		membership, err := organizationRepository.FindMembership(orgID, objID)
+		if err != nil {
+			logger.Error("failed to get organization membership", "error", err.Error())
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gen.ErrorResponse{
+				Message: "Failed to validate organization membership",
+				Code:    http.StatusInternalServerError,
+			})
+			return
+		}
+		if membership == nil {
+			code := errorCodeOrganizationMembershipRevoked
+			c.AbortWithStatusJSON(http.StatusForbidden, gen.ErrorResponse{
+				Message: "You are no longer a member of the organization",
+				Code:    http.StatusForbidden,
+				Error:   &code,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+// PermissionChecker は、ユーザーが権限を持つかどうかを判定します。
+type PermissionChecker interface {
+	HasPermission(userObjID string, permission string) (bool, error)
+}
+
+// PermissionRule は、ルートと、そのルートの利用に必要な権限の組です。
+// Route は "METHOD /path" 形式で、METHOD には任意のメソッドを表す "*"、path には AuthMiddleware と同じ形式（末尾の "/*"）を使えます。
+type PermissionRule struct {
+	Route      string
+	Permission string
+}
+
+// PermissionMiddleware は、rules のうち最初に一致したルールの権限を持たないユーザーを拒否します。
+// 権限はトークンではなく保存されたロールから判定するため、変更は即座に反映されます。AuthMiddleware の後に適用してください。
+func PermissionMiddleware(checker PermissionChecker, rules ...PermissionRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		permission, ok := requiredPermission(rules, c.Request.Method, c.Request.URL.Path)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		objID := c.GetString("validated_uid")
+		if objID == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+				Message: "Invalid token",
+				Code:    http.StatusUnauthorized,
+			})
+			return
+		}
+
+		allowed, err := checker.HasPermission(objID, permission)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gen.ErrorResponse{
+				Message: "Failed to check permission",
+				Code:    http.StatusInternalServerError,
+			})
+			return
+		}
+		if !allowed {
+			c.AbortWithStatusJSON(http.StatusForbidden, gen.ErrorResponse{
+				Message: fmt.Sprintf("Permission %q is required", permission),
+				Code:    http.StatusForbidden,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// requiredPermission は、リクエストに最初に一致したルールの権限を返します。
+func requiredPermission(rules []PermissionRule, method string, path string) (string, bool) {
+	for _, rule := range rules {
+		if matchRoute(rule.Route, method, path) {
+			return rule.Permission, true
+		}
+	}
+	return "", false
+}
+
+// matchRoute は、リクエストが "METHOD /path" 形式のルートに一致するかを判定します。
+func matchRoute(route string, method string, path string) bool {
+	routeMethod, routePath, ok := strings.Cut(route, " ")
+	if !ok || (routeMethod != "*" && routeMethod != method) {
+		return false
+	}
+	return matchPath([]string{routePath}, path)
+}
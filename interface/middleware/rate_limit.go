@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/ratelimit"
+)
+
+// rateLimitContextKey は、先に適用したレート制限で最も残りの少なかった結果を保持するコンテキストのキー
+const rateLimitContextKey = "rate_limit_result"
+
+// RateLimitMiddleware は、リクエストに一致するすべての規則でリクエスト数を数え、いずれかの制限を超えた場合は 429 を返します。
+// 最も残りの少ない規則の使用量を RateLimit-Limit / RateLimit-Remaining / RateLimit-Reset / RateLimit-Policy ヘッダーで返します
+// （認証の前後など複数回適用した場合も、すべての段階を通じて最も残りの少ない規則の使用量を返します）。
+// ユーザーごとの規則を使う場合は AuthMiddleware の後に適用してください。
+// 保存先に接続できない場合、failOpen が true ならその規則を飛ばしてリクエストを許可し、false なら 503 を返します。
+func RateLimitMiddleware(store ratelimit.Store, failOpen bool, rules ...ratelimit.Rule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var tightest *ratelimit.Result
+		var tightestRule ratelimit.Rule
+		for _, rule := range rules {
+			if !rule.Matches(c.Request.Method, c.Request.URL.Path) {
+				continue
+			}
+
+			result, err := store.Take(rule.Route()+":"+rateLimitKey(c, rule.Key), rule.Limit, rule.Window)
+			if err != nil {
+				logger.Warn("failed to take rate limit", "route", rule.Route(), "fail_open", failOpen, "error", err.Error())
+				if failOpen {
+					continue
+				}
+				c.AbortWithStatusJSON(http.StatusServiceUnavailable, gen.ErrorResponse{
+					Message: "Rate limit is temporarily unavailable",
+					Code:    http.StatusServiceUnavailable,
+				})
+				return
+			}
+			if tightest == nil || moreRestrictive(result, tightest) {
+				tightest, tightestRule = result, rule
+			}
+		}
+		if tightest == nil {
+			c.Next()
+			return
+		}
+
+		// 先の段階の結果の方が厳しい場合は、ヘッダーをその結果のままにする
+		if previous, ok := c.Value(rateLimitContextKey).(*ratelimit.Result); ok && !moreRestrictive(tightest, previous) {
+			c.Next()
+			return
+		}
+		c.Set(rateLimitContextKey, tightest)
+
+		reset := strconv.Itoa(ceilSeconds(tightest.Reset))
+		c.Header("RateLimit-Limit", strconv.Itoa(tightest.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(tightest.Remaining))
+		c.Header("RateLimit-Reset", reset)
+		c.Header("RateLimit-Policy", tightestRule.Policy())
+
+		if !tightest.Allowed {
+			c.Header("Retry-After", reset)
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gen.ErrorResponse{
+				Message: "Too many requests",
+				Code:    http.StatusTooManyRequests,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// rateLimitKey はリクエストを数える単位のキーを返す（ユーザー・API キーがない場合は IP アドレス）
+func rateLimitKey(c *gin.Context, keyType ratelimit.KeyType) string {
+	switch keyType {
+	case ratelimit.KeyUser:
+		if objID := c.GetString("validated_uid"); objID != "" {
+			return "user:" + objID
+		}
+	case ratelimit.KeyAPIKey:
+		// AuthMiddleware が検証した API キーはキーのIDで数える（X-API-Key・Bearer のどちらで渡しても同じキーとして扱う）
+		if key, ok := c.Value("validated_api_key").(*entity.APIKey); ok {
+			return "apikey:" + key.ID()
+		}
+		// API キーそのものを保存先に残さないようにハッシュ化する
+		if apiKey := c.GetHeader("X-API-Key"); apiKey != "" {
+			sum := sha256.Sum256([]byte(apiKey))
+			return "apikey:" + hex.EncodeToString(sum[:])
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// moreRestrictive は a が b より厳しい（拒否されている、または残りが少ない）かを判定する
+func moreRestrictive(a *ratelimit.Result, b *ratelimit.Result) bool {
+	if a.Allowed != b.Allowed {
+		return !a.Allowed
+	}
+	if a.Remaining != b.Remaining {
+		return a.Remaining < b.Remaining
+	}
+	return a.Reset > b.Reset
+}
+
+// ceilSeconds は d を秒に切り上げる（1 以上）
+func ceilSeconds(d time.Duration) int {
+	return max(1, int(math.Ceil(d.Seconds())))
+}
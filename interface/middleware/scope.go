@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+)
+
+// ScopeRule は、ルートと、API キー・サービスアカウントのトークンでそのルートを利用するのに必要なスコープの組です。
+// Route は PermissionRule と同じ形式です。
+type ScopeRule struct {
+	Route string
+	Scope string
+}
+
+// ScopeMiddleware は、スコープを持つ資格情報（API キー・サービスアカウントのトークン）で認証したリクエストのうち、
+// rules のうち最初に一致したルールのスコープを持たないものを拒否します。
+// いずれのルールにも一致しないルート（キーの管理、再認証など）はこれらの資格情報では利用できません。
+// ユーザーのアクセストークンで認証したリクエストは対象外です。AuthMiddleware の後に適用してください。
+func ScopeMiddleware(rules ...ScopeRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scopes, ok := c.Value("validated_scopes").([]string)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		scope, found := requiredScope(rules, c.Request.Method, c.Request.URL.Path)
+		if !found {
+			c.AbortWithStatusJSON(http.StatusForbidden, gen.ErrorResponse{
+				Message: "API keys and service account tokens cannot be used for this operation",
+				Code:    http.StatusForbidden,
+			})
+			return
+		}
+		if !value.ScopesGrant(scopes, scope) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gen.ErrorResponse{
+				Message: fmt.Sprintf("Scope %q is required", scope),
+				Code:    http.StatusForbidden,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// requiredScope は、リクエストに最初に一致したルールのスコープを返します。
+func requiredScope(rules []ScopeRule, method string, path string) (string, bool) {
+	for _, rule := range rules {
+		if matchRoute(rule.Route, method, path) {
+			return rule.Scope, true
+		}
+	}
+	return "", false
+}
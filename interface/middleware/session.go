@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/domain/user/repository"
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+// SessionMiddleware は、ユーザーがトークンを無効にした日時（不審なログインの通知からの操作、パスワードの再設定など）より前に
+// 発行されたアクセストークンを拒否します。AuthMiddleware が検証したリクエストのみを対象とするため、AuthMiddleware の後に適用してください。
+func SessionMiddleware(sessionRepository repository.SessionRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		objID := c.GetString("validated_uid")
+		if objID == "" {
+			c.Next()
+			return
+		}
+
+		revokedAt, err := sessionRepository.GetSessionsRevokedAt(objID)
+		if err != nil {
+			logger.Error("failed to get sessions revoked at", "error", err.Error())
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gen.ErrorResponse{
+				Message: "Failed to validate session",
+				Code:    http.StatusInternalServerError,
+			})
+			return
+		}
+		// 発行日時を持たない古いトークンは、無効にした日時より前に発行されたものとして扱う
+		issuedAt, _ := c.Get("validated_issued_at")
+		if issued, _ := issuedAt.(time.Time); revokedAt != nil && issued.Before(*revokedAt) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+				Message: "Invalid token",
+				Code:    http.StatusUnauthorized,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// StepUpMiddleware は、指定されたルート（"METHOD /path" 形式。パスの末尾の "/*" はその下のすべてのパス）で、maxAge 以内に acr 以上の強度で
+// 認証していないユーザーを拒否します。AuthMiddleware の後に適用してください。
+// 拒否する場合は RFC 9470 の insufficient_user_authentication と、再認証の方法を返します。
+func StepUpMiddleware(maxAge time.Duration, acr string, reauthURL string, routes ...string) gin.HandlerFunc {
+	maxAgeSeconds := int(maxAge.Seconds())
+
+	return func(c *gin.Context) {
+		if !slices.ContainsFunc(routes, func(route string) bool {
+			return matchRoute(route, c.Request.Method, c.Request.URL.Path)
+		}) {
+			c.Next()
+			return
+		}
+
+		value, exists := c.Get("validated_auth")
+		auth, ok := value.(utils.AuthContext)
+		if !exists || !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gen.ErrorResponse{
+				Message: "Invalid token",
+				Code:    http.StatusUnauthorized,
+			})
+			return
+		}
+
+		var message string
+		switch {
+		case auth.AuthTime.IsZero() || time.Since(auth.AuthTime) > maxAge:
+			message = "A more recent authentication is required"
+		case !utils.ACRSatisfies(auth.ACR(), acr):
+			message = "A stronger authentication is required"
+		default:
+			c.Next()
+			return
+		}
+
+		c.Header("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_user_authentication", error_description=%q, max_age=%d, acr_values=%q`, message, maxAgeSeconds, acr))
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gen.StepUpRequiredResponse{
+			Message: message,
+			Code:    http.StatusUnauthorized,
+			StepUp: &gen.StepUpChallenge{
+				MaxAge:            maxAgeSeconds,
+				AcrValues:         acr,
+				ReauthenticateUrl: reauthURL,
+			},
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goda6565/nexus-user-auth/interface/gen"
+	"github.com/goda6565/nexus-user-auth/pkg/tenant"
+)
+
+// TenantMiddleware は、リクエストのテナント（ヘッダー・ホスト名から決める）を Gin の Context とリクエストのコンテキストにセットします。
+// テナントが決まらないリクエストは 404 で拒否します。
+func TenantMiddleware(resolver *tenant.Resolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		t, ok := resolver.Resolve(c.Request)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusNotFound, gen.ErrorResponse{
+				Message: "Tenant not found",
+				Code:    http.StatusNotFound,
+			})
+			return
+		}
+
+		c.Set("tenant_id", t.ID)
+		c.Request = c.Request.WithContext(tenant.NewContext(c.Request.Context(), t.ID))
+
+		c.Next()
+	}
+}
@@ -3,6 +3,7 @@ package router
 import (
 	"context"
 	"encoding/json"
+	"net"
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -14,17 +15,62 @@ import (
 	"github.com/swaggo/swag"
 	"gorm.io/gorm"
 
+	organizationService "github.com/goda6565/nexus-user-auth/application/service/organization"
+	adminService "github.com/goda6565/nexus-user-auth/application/service/user/admin"
+	apikeyService "github.com/goda6565/nexus-user-auth/application/service/user/apikey"
 	authenticationService "github.com/goda6565/nexus-user-auth/application/service/user/authentication"
+	authzService "github.com/goda6565/nexus-user-auth/application/service/user/authz"
+	emailchangeService "github.com/goda6565/nexus-user-auth/application/service/user/emailchange"
+	groupService "github.com/goda6565/nexus-user-auth/application/service/user/group"
+	lockoutService "github.com/goda6565/nexus-user-auth/application/service/user/lockout"
+	loginalertService "github.com/goda6565/nexus-user-auth/application/service/user/loginalert"
+	loginhistoryService "github.com/goda6565/nexus-user-auth/application/service/user/loginhistory"
+	mfaService "github.com/goda6565/nexus-user-auth/application/service/user/mfa"
+	passkeyService "github.com/goda6565/nexus-user-auth/application/service/user/passkey"
+	passwordlessService "github.com/goda6565/nexus-user-auth/application/service/user/passwordless"
+	passwordresetService "github.com/goda6565/nexus-user-auth/application/service/user/passwordreset"
 	profileService "github.com/goda6565/nexus-user-auth/application/service/user/profile"
 	registrationService "github.com/goda6565/nexus-user-auth/application/service/user/registration"
+	roleService "github.com/goda6565/nexus-user-auth/application/service/user/role"
+	serviceaccountService "github.com/goda6565/nexus-user-auth/application/service/user/serviceaccount"
+	stepupService "github.com/goda6565/nexus-user-auth/application/service/user/stepup"
+	trusteddeviceService "github.com/goda6565/nexus-user-auth/application/service/user/trusteddevice"
+	verificationService "github.com/goda6565/nexus-user-auth/application/service/user/verification"
+	"github.com/goda6565/nexus-user-auth/domain/event"
+	"github.com/goda6565/nexus-user-auth/domain/user/entity"
+	"github.com/goda6565/nexus-user-auth/domain/user/value"
+	"github.com/goda6565/nexus-user-auth/infrastructure/database"
 	"github.com/goda6565/nexus-user-auth/infrastructure/database/repository"
+	"github.com/goda6565/nexus-user-auth/infrastructure/outbox"
 	"github.com/goda6565/nexus-user-auth/interface/gen"
 	"github.com/goda6565/nexus-user-auth/interface/handler"
+	organizationHandler "github.com/goda6565/nexus-user-auth/interface/handler/organization"
+	adminHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/admin"
+	apikeyHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/apikey"
 	authenticationHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/authentication"
+	authzHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/authz"
+	emailchangeHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/emailchange"
+	groupHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/group"
+	lockoutHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/lockout"
+	loginalertHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/loginalert"
+	loginhistoryHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/loginhistory"
+	mfaHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/mfa"
+	passkeyHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/passkey"
+	passwordlessHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/passwordless"
+	passwordresetHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/passwordreset"
 	profileHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/profile"
 	registrationHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/registration"
+	roleHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/role"
+	serviceaccountHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/serviceaccount"
+	stepupHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/stepup"
+	trusteddeviceHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/trusteddevice"
+	verificationHandler "github.com/goda6565/nexus-user-auth/interface/handler/user/verification"
 	"github.com/goda6565/nexus-user-auth/interface/middleware"
 	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
+	"github.com/goda6565/nexus-user-auth/pkg/policy"
+	"github.com/goda6565/nexus-user-auth/pkg/ratelimit"
+	"github.com/goda6565/nexus-user-auth/pkg/tenant"
 	"github.com/goda6565/nexus-user-auth/pkg/utils"
 )
 
@@ -32,6 +78,24 @@ type ServerInterfaceImpl struct {
 	*registrationHandler.UserRegistrationHandler
 	*authenticationHandler.UserAuthenticationHandler
 	*profileHandler.UserProfileHandler
+	*verificationHandler.UserVerificationHandler
+	*emailchangeHandler.UserEmailChangeHandler
+	*mfaHandler.UserMFAHandler
+	*passkeyHandler.UserPasskeyHandler
+	*passwordlessHandler.UserPasswordlessHandler
+	*stepupHandler.UserStepUpHandler
+	*trusteddeviceHandler.UserTrustedDeviceHandler
+	*apikeyHandler.UserAPIKeyHandler
+	*lockoutHandler.UserLockoutHandler
+	*loginhistoryHandler.UserLoginHistoryHandler
+	*loginalertHandler.UserLoginAlertHandler
+	*passwordresetHandler.UserPasswordResetHandler
+	*adminHandler.UserAdminHandler
+	*roleHandler.UserRoleHandler
+	*groupHandler.UserGroupHandler
+	*serviceaccountHandler.ServiceAccountHandler
+	*authzHandler.UserAuthzHandler
+	*organizationHandler.OrganizationHandler
 }
 
 // swagger設定
@@ -40,6 +104,8 @@ func setUpSwagger(router *gin.Engine) (*openapi3.T, error) {
 	if err != nil {
 		return nil, err
 	}
+	// JSON Merge Patch（PATCH /profile）のリクエストも JSON としてスキーマを検証する
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
 
 	env := utils.GetEnvDefault("ENV", "development")
 	if env == "development" {
@@ -54,8 +120,26 @@ func setUpSwagger(router *gin.Engine) (*openapi3.T, error) {
 	return swagger, nil
 }
 
-func NewGinRouter(db *gorm.DB, corsAllowOrigins []string) (*gin.Engine, error) {
+// sharedDependencies は、すべてのテナントで共有する依存関係（テナントのデータを持たないもの）
+type sharedDependencies struct {
+	swagger        *openapi3.T
+	rateLimitStore ratelimit.Store
+	rateLimitRules []ratelimit.Rule
+	rateLimitOpen  bool // 保存先に接続できない場合にリクエストを許可する
+	policyEngine   *policy.Engine
+	authzRoutes    []policy.Route
+	mailSender     mailer.Mailer
+	relay          *outbox.Relay
+	emailKeys      value.EmailKeyRules // メールアドレスの検索キーの作り方
+}
+
+func NewGinRouter(db *gorm.DB, corsAllowOrigins, trustedProxies []string, relay *outbox.Relay, mailSender mailer.Mailer) (*gin.Engine, error) {
 	router := gin.New()
+	// ClientIP が X-Forwarded-For を参照するのは信頼するプロキシからの接続に限る（既定ではどのプロキシも信頼しない）
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
 
 	router.Use(middleware.CorsMiddleware(corsAllowOrigins))
 	swagger, err := setUpSwagger(router)
@@ -68,15 +152,209 @@ func NewGinRouter(db *gorm.DB, corsAllowOrigins []string) (*gin.Engine, error) {
 	router.Use(middleware.RecoveryWithZap())
 	router.GET("/health", handler.Health)
 
+	shared := &sharedDependencies{swagger: swagger, relay: relay}
+
+	// レート制限（保存先はすべてのテナントで共有する）
+	rateLimitConfig := ratelimit.NewConfigFromEnv()
+	if rateLimitConfig.Enabled {
+		shared.rateLimitRules, err = ratelimit.ParseRules(rateLimitConfig.Rules)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		shared.rateLimitStore, err = ratelimit.NewStore(rateLimitConfig)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		shared.rateLimitOpen = rateLimitConfig.FailOpen
+	}
+
+	// メールアドレスの同一視にプロバイダー固有ルール（Gmail のドット無視など）を適用するか
+	shared.emailKeys = value.EmailKeyRules{ProviderRules: utils.GetEnvBool("EMAIL_PROVIDER_RULES", false)}
+
+	// ポリシー（CEL）による認可。AUTHZ_ROUTES に指定したルートはポリシーで許可された操作のみ利用できる
+	authzConfig := authzService.NewConfigFromEnv()
+	policyBundle, err := policy.LoadDir(authzConfig.PolicyDir)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	shared.policyEngine, err = policy.NewEngine(policyBundle.Policies)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	if len(policyBundle.Policies) == 0 {
+		logger.Warn("no authorization policies are loaded; every action is denied", "dir", authzConfig.PolicyDir)
+	}
+	shared.authzRoutes, err = policy.ParseRoutes(authzConfig.Routes)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	// メール送信（MAIL_DRIVER で送信方式を切り替える。停止は main で行う）
+	shared.mailSender = mailSender
+
+	// すべてのテナントのイベントを Webhook に配信する（テナントはイベントの tenantId で区別する）
+	if webhookURL := outbox.NewConfigFromEnv().WebhookURL; webhookURL != "" {
+		relay.Register(event.TypeAll, outbox.NewWebhookPublisher(webhookURL))
+	}
+
+	// テナントごとに、テナントのデータのみを扱うハンドラーを作成する
+	resolver, err := tenant.NewResolver(tenant.NewConfigFromEnv())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+	tenantRouters := make(map[string]*gin.Engine, len(resolver.Tenants()))
+	for _, t := range resolver.Tenants() {
+		tenantRouter, err := newTenantRouter(database.WithTenant(db, t.ID), t, shared)
+		if err != nil {
+			logger.Error(err.Error(), "tenant", t.ID)
+			return nil, err
+		}
+		tenantRouters[t.ID] = tenantRouter
+	}
+
+	// リクエストのテナントを決め、そのテナントのハンドラーに渡す
+	router.Any("/api/*path", middleware.TenantMiddleware(resolver), func(c *gin.Context) {
+		tenantRouters[c.GetString("tenant_id")].ServeHTTP(c.Writer, c.Request)
+	})
+	return router, nil
+}
+
+// newTenantRouter は、テナントのデータ（db はテナントに限定した接続）とトークンの鍵を使う /api のルーターを作成する
+func newTenantRouter(db *gorm.DB, t *tenant.Tenant, shared *sharedDependencies) (*gin.Engine, error) {
+	router := gin.New()
+
 	apiGroup := router.Group("/api")
 	{
 		apiGroup.Use(middleware.TimeoutMiddleware(10 * time.Second))
 		v1 := apiGroup.Group("/v1")
 
-		v1.Use(middleware.AuthMiddleware("/api/v1/profile"))
+		// IP アドレスごとのレート制限は、認証に失敗したリクエストも数えられるよう AuthMiddleware の前に適用する
+		rateLimitByIP, rateLimitByIdentity := ratelimit.SplitRules(shared.rateLimitRules)
+		if shared.rateLimitStore != nil {
+			v1.Use(middleware.RateLimitMiddleware(shared.rateLimitStore, shared.rateLimitOpen, rateLimitByIP...))
+		}
+
+		// API キー（スクリプトや CI からの利用）はキーの所有者のリクエストとして扱う
+		apiKeyRepositoryImpl := repository.NewAPIKeyRepository(db)
+		userAPIKeyService := apikeyService.NewUserAPIKeyService(apiKeyRepositoryImpl, apikeyService.NewConfigFromEnv())
+		v1.Use(middleware.AuthMiddleware(t.Tokens, userAPIKeyService, "/api/v1/profile", "/api/v1/profile/email", "/api/v1/profile/mfa/totp", "/api/v1/profile/mfa/totp/confirm", "/api/v1/profile/passkeys", "/api/v1/profile/passkeys/register/begin", "/api/v1/profile/passkeys/register/finish", "/api/v1/auth/reauthenticate", "/api/v1/profile/trusted-devices", "/api/v1/profile/trusted-devices/*", "/api/v1/profile/api-keys", "/api/v1/profile/api-keys/*", "/api/v1/profile/login-history", "/api/v1/profile/deactivate", "/api/v1/profile/permissions", "/api/v1/authz/check", "/api/v1/admin/*", "/api/v1/auth/switch-organization", "/api/v1/organizations", "/api/v1/organizations/*", "/api/v1/invitations/accept"))
+
+		// ユーザー・API キーごとのレート制限（認証済みのリクエストはユーザーごとに数えられるよう AuthMiddleware の後に適用する）
+		if shared.rateLimitStore != nil {
+			v1.Use(middleware.RateLimitMiddleware(shared.rateLimitStore, shared.rateLimitOpen, rateLimitByIdentity...))
+		}
+
+		userRepositoryImpl := repository.NewUserRepository(db, shared.emailKeys)
+		// 無効にしたセッションで発行済みのトークンを拒否する
+		sessionRepositoryImpl := repository.NewSessionRepository(db)
+		v1.Use(middleware.SessionMiddleware(sessionRepositoryImpl))
+		// 有効でないアカウント（有効化待ち・停止・無効化）のユーザーのリクエストを拒否する
+		v1.Use(middleware.AccountStatusMiddleware(userRepositoryImpl))
+		// 組織を選択したトークンは、その組織から外されたユーザーのリクエストを拒否する（別の組織への切り替えは許可する）
+		organizationRepositoryImpl := repository.NewOrganizationRepository(db)
+		v1.Use(middleware.OrganizationMembershipMiddleware(organizationRepositoryImpl, "POST /api/v1/auth/switch-organization"))
+		// 管理者用のエンドポイントは、ロールから必要な権限を与えられたユーザーのみ利用できる
+		roleRepositoryImpl := repository.NewRoleRepository(db)
+		// 組み込みのロール（admin・user）はテナントごとに用意する
+		if err := roleRepositoryImpl.EnsureRoles(entity.BuiltInRoles()); err != nil {
+			return nil, err
+		}
+		auditLogRepositoryImpl := repository.NewAuditLogRepository(db)
+		// 管理者による変更は、監査ログの記録と同じトランザクションで行う
+		transactor := repository.NewTransactor(db, shared.emailKeys)
+		// グループのメンバーには、グループ（入れ子のグループを含む）に与えたロール・権限も与える
+		groupConfig := groupService.NewConfigFromEnv()
+		groupRepositoryImpl := repository.NewGroupRepository(db)
+		userGroupService := groupService.NewUserGroupService(userRepositoryImpl, groupRepositoryImpl, roleRepositoryImpl, transactor, groupConfig)
+		userRoleService := roleService.NewUserRoleService(userRepositoryImpl, roleRepositoryImpl, transactor, userGroupService)
+		// API キーとサービスアカウントのトークンは、スコープで許可されたルートのみ利用できる（一致するルールのないルートは利用できない）
+		v1.Use(middleware.ScopeMiddleware(
+			middleware.ScopeRule{Route: "GET /api/v1/profile", Scope: value.APIKeyScopeProfileRead},
+			middleware.ScopeRule{Route: "GET /api/v1/profile/permissions", Scope: value.APIKeyScopeProfileRead},
+			middleware.ScopeRule{Route: "GET /api/v1/profile/login-history", Scope: value.APIKeyScopeProfileRead},
+			middleware.ScopeRule{Route: "PUT /api/v1/profile", Scope: value.APIKeyScopeProfileWrite},
+			middleware.ScopeRule{Route: "PATCH /api/v1/profile", Scope: value.APIKeyScopeProfileWrite},
+			middleware.ScopeRule{Route: "GET /api/v1/organizations", Scope: value.APIKeyScopeOrganizationsRead},
+			middleware.ScopeRule{Route: "GET /api/v1/organizations/*", Scope: value.APIKeyScopeOrganizationsRead},
+			middleware.ScopeRule{Route: "* /api/v1/organizations", Scope: value.APIKeyScopeOrganizationsWrite},
+			middleware.ScopeRule{Route: "* /api/v1/organizations/*", Scope: value.APIKeyScopeOrganizationsWrite},
+			middleware.ScopeRule{Route: "POST /api/v1/authz/check", Scope: value.APIKeyScopeAuthzCheck},
+			// 管理者用のエンドポイントは、必要な権限と同じ名前のスコープを求める（ユーザーの権限も別に判定する）
+			middleware.ScopeRule{Route: "GET /api/v1/admin/users", Scope: value.PermissionUsersRead},
+			middleware.ScopeRule{Route: "GET /api/v1/admin/users/*", Scope: value.PermissionUsersRead},
+			middleware.ScopeRule{Route: "* /api/v1/admin/users", Scope: value.PermissionUsersWrite},
+			middleware.ScopeRule{Route: "* /api/v1/admin/users/*", Scope: value.PermissionUsersWrite},
+			middleware.ScopeRule{Route: "GET /api/v1/admin/roles", Scope: value.PermissionRolesRead},
+			middleware.ScopeRule{Route: "GET /api/v1/admin/roles/*", Scope: value.PermissionRolesRead},
+			middleware.ScopeRule{Route: "* /api/v1/admin/roles", Scope: value.PermissionRolesWrite},
+			middleware.ScopeRule{Route: "* /api/v1/admin/roles/*", Scope: value.PermissionRolesWrite},
+			middleware.ScopeRule{Route: "GET /api/v1/admin/groups", Scope: value.PermissionGroupsRead},
+			middleware.ScopeRule{Route: "GET /api/v1/admin/groups/*", Scope: value.PermissionGroupsRead},
+			middleware.ScopeRule{Route: "* /api/v1/admin/groups", Scope: value.PermissionGroupsWrite},
+			middleware.ScopeRule{Route: "* /api/v1/admin/groups/*", Scope: value.PermissionGroupsWrite},
+			middleware.ScopeRule{Route: "GET /api/v1/admin/service-accounts", Scope: value.PermissionServiceAccountsRead},
+			middleware.ScopeRule{Route: "GET /api/v1/admin/service-accounts/*", Scope: value.PermissionServiceAccountsRead},
+			middleware.ScopeRule{Route: "* /api/v1/admin/service-accounts", Scope: value.PermissionServiceAccountsWrite},
+			middleware.ScopeRule{Route: "* /api/v1/admin/service-accounts/*", Scope: value.PermissionServiceAccountsWrite},
+			middleware.ScopeRule{Route: "* /api/v1/admin/*", Scope: value.APIKeyScopeAll},
+		))
+		v1.Use(middleware.PermissionMiddleware(userRoleService,
+			middleware.PermissionRule{Route: "GET /api/v1/admin/users", Permission: value.PermissionUsersRead},
+			middleware.PermissionRule{Route: "GET /api/v1/admin/users/*", Permission: value.PermissionUsersRead},
+			middleware.PermissionRule{Route: "* /api/v1/admin/users", Permission: value.PermissionUsersWrite},
+			middleware.PermissionRule{Route: "* /api/v1/admin/users/*", Permission: value.PermissionUsersWrite},
+			middleware.PermissionRule{Route: "GET /api/v1/admin/roles", Permission: value.PermissionRolesRead},
+			middleware.PermissionRule{Route: "GET /api/v1/admin/roles/*", Permission: value.PermissionRolesRead},
+			middleware.PermissionRule{Route: "* /api/v1/admin/roles", Permission: value.PermissionRolesWrite},
+			middleware.PermissionRule{Route: "* /api/v1/admin/roles/*", Permission: value.PermissionRolesWrite},
+			middleware.PermissionRule{Route: "GET /api/v1/admin/groups", Permission: value.PermissionGroupsRead},
+			middleware.PermissionRule{Route: "GET /api/v1/admin/groups/*", Permission: value.PermissionGroupsRead},
+			middleware.PermissionRule{Route: "* /api/v1/admin/groups", Permission: value.PermissionGroupsWrite},
+			middleware.PermissionRule{Route: "* /api/v1/admin/groups/*", Permission: value.PermissionGroupsWrite},
+			middleware.PermissionRule{Route: "GET /api/v1/admin/service-accounts", Permission: value.PermissionServiceAccountsRead},
+			middleware.PermissionRule{Route: "GET /api/v1/admin/service-accounts/*", Permission: value.PermissionServiceAccountsRead},
+			middleware.PermissionRule{Route: "* /api/v1/admin/service-accounts", Permission: value.PermissionServiceAccountsWrite},
+			middleware.PermissionRule{Route: "* /api/v1/admin/service-accounts/*", Permission: value.PermissionServiceAccountsWrite},
+			// 上記以外の管理者用のエンドポイントはすべての権限を持つユーザーのみ利用できる
+			middleware.PermissionRule{Route: "* /api/v1/admin/*", Permission: value.PermissionAll},
+		))
+		userAuthzService := authzService.NewUserAuthzService(userRepositoryImpl, userRoleService, shared.policyEngine)
+		// ポリシー（CEL）による認可。AUTHZ_ROUTES に指定したルートはポリシーで許可された操作のみ利用できる
+		if len(shared.authzRoutes) > 0 {
+			v1.Use(middleware.AuthzMiddleware(userAuthzService, shared.authzRoutes...))
+		}
+		verificationConfig := verificationService.NewConfigFromEnv()
+		verificationConfig.EmailKeys = shared.emailKeys
+		// 重要な操作（メールアドレスの確認と、直近の十分な強度の認証を求める）
+		sensitiveRoutes := []string{
+			"DELETE /api/v1/profile",
+			"POST /api/v1/profile/deactivate",
+			"POST /api/v1/profile/email",
+			"POST /api/v1/profile/mfa/totp",
+			"POST /api/v1/profile/mfa/totp/confirm",
+			"POST /api/v1/profile/passkeys/register/begin",
+			"POST /api/v1/profile/passkeys/register/finish",
+			"POST /api/v1/profile/api-keys",
+			// サービスアカウントの API キーの作成
+			"POST /api/v1/admin/service-accounts/*",
+		}
+		if verificationConfig.Policy == verificationService.PolicySensitive {
+			// メールアドレス未確認のユーザーには重要な操作を許可しない
+			v1.Use(middleware.EmailVerifiedMiddleware(userRepositoryImpl, sensitiveRoutes...))
+		}
+
+		// 重要な操作には直近の十分な強度の認証を求める（満たさない場合は再認証を案内する）
+		stepupConfig := stepupService.NewConfigFromEnv()
+		v1.Use(middleware.StepUpMiddleware(stepupConfig.MaxAge, stepupConfig.RequiredACR, stepupConfig.ReauthURL, sensitiveRoutes...))
 
 		// OapiRequestValidator は v1 グループに適用（認証は後述の動的ミドルウェアで行う）
-		v1.Use(ginMiddleware.OapiRequestValidatorWithOptions(swagger, &ginMiddleware.Options{
+		v1.Use(ginMiddleware.OapiRequestValidatorWithOptions(shared.swagger, &ginMiddleware.Options{
 			Options: openapi3filter.Options{
 				AuthenticationFunc: func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 					// ここでは何もせず nil を返す
@@ -85,19 +363,119 @@ func NewGinRouter(db *gorm.DB, corsAllowOrigins []string) (*gin.Engine, error) {
 			},
 		}))
 
+		mailSender := shared.mailSender
+		// GROUP_TOKEN_CLAIMS=true の場合は、アクセストークンに所属するグループ（groups クレーム）を含める
+		tokens := t.Tokens
+		if groupConfig.TokenClaims {
+			tokens = tokens.WithGroups(userGroupService.GroupNames)
+		}
+
 		// すべてのハンドラーをひとつにまとめる
-		userRepositoryImpl := repository.NewUserRepository(db)
-		userRegistrationService := registrationService.NewUserRegistrationService(userRepositoryImpl)
+		emailVerificationRepositoryImpl := repository.NewEmailVerificationRepository(db)
+		userVerificationService := verificationService.NewUserVerificationService(userRepositoryImpl, emailVerificationRepositoryImpl, mailSender, tokens, verificationConfig)
+		userVerificationHandler := verificationHandler.NewUserVerificationHandler(userVerificationService)
+		registrationConfig := registrationService.NewConfigFromEnv()
+		registrationConfig.RequireVerifiedEmail = verificationConfig.Policy == verificationService.PolicyLogin
+		registrationDomainPolicy, err := registrationService.NewDomainPolicy(registrationConfig, net.DefaultResolver)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		userRegistrationService := registrationService.NewUserRegistrationService(userRepositoryImpl, registrationDomainPolicy, registrationConfig)
 		userRegistrationHandler := registrationHandler.NewUserRegistrationHandler(userRegistrationService)
-		userAuthenticationService := authenticationService.NewUserAuthenticationService(userRepositoryImpl)
+		mfaRepositoryImpl := repository.NewMFARepository(db)
+		mfaConfig := mfaService.NewConfigFromEnv()
+		passkeyRepositoryImpl := repository.NewPasskeyRepository(db)
+		trustedDeviceRepositoryImpl := repository.NewTrustedDeviceRepository(db)
+		userTrustedDeviceService := trusteddeviceService.NewUserTrustedDeviceService(userRepositoryImpl, trustedDeviceRepositoryImpl, tokens, trusteddeviceService.NewConfigFromEnv())
+		userTrustedDeviceHandler := trusteddeviceHandler.NewUserTrustedDeviceHandler(userTrustedDeviceService)
+		userAPIKeyHandler := apikeyHandler.NewUserAPIKeyHandler(userAPIKeyService)
+		loginFailureRepositoryImpl := repository.NewLoginFailureRepository(db)
+		lockoutConfig := lockoutService.NewConfigFromEnv()
+		lockoutConfig.EmailKeys = shared.emailKeys
+		userLockoutService := lockoutService.NewUserLockoutService(userRepositoryImpl, loginFailureRepositoryImpl, mailSender, tokens, lockoutConfig)
+		userLockoutHandler := lockoutHandler.NewUserLockoutHandler(userLockoutService)
+		loginEventRepositoryImpl := repository.NewLoginEventRepository(db)
+		userLoginHistoryService := loginhistoryService.NewUserLoginHistoryService(loginEventRepositoryImpl, loginhistoryService.NewConfigFromEnv())
+		userLoginHistoryHandler := loginhistoryHandler.NewUserLoginHistoryHandler(userLoginHistoryService)
+		userAuthenticationService := authenticationService.NewUserAuthenticationService(userRepositoryImpl, mfaRepositoryImpl, passkeyRepositoryImpl, sessionRepositoryImpl, userTrustedDeviceService, userLockoutService, userLoginHistoryService, tokens, &authenticationService.Config{
+			RequireVerifiedEmail: verificationConfig.Policy == verificationService.PolicyLogin,
+			MFAChallengeTTL:      mfaConfig.ChallengeTTL,
+		})
 		userAuthenticationHandler := authenticationHandler.NewUserAuthenticationHandler(userAuthenticationService)
 		userProfileService := profileService.NewUserProfileService(userRepositoryImpl)
 		userProfileHandler := profileHandler.NewUserProfileHandler(userProfileService)
+		emailChangeRepositoryImpl := repository.NewEmailChangeRepository(db)
+		emailChangeConfig := emailchangeService.NewConfigFromEnv()
+		emailChangeConfig.EmailKeys = shared.emailKeys
+		userEmailChangeService := emailchangeService.NewUserEmailChangeService(userRepositoryImpl, emailChangeRepositoryImpl, repository.NewTransactor(db, shared.emailKeys), registrationDomainPolicy, mailSender, tokens, emailChangeConfig)
+		userEmailChangeHandler := emailchangeHandler.NewUserEmailChangeHandler(userEmailChangeService)
+		userMFAService := mfaService.NewUserMFAService(userRepositoryImpl, mfaRepositoryImpl, loginFailureRepositoryImpl, userLockoutService, userLoginHistoryService, tokens, mfaConfig)
+		userMFAHandler := mfaHandler.NewUserMFAHandler(userMFAService, userTrustedDeviceService)
+		userPasskeyService, err := passkeyService.NewUserPasskeyService(userRepositoryImpl, passkeyRepositoryImpl, userLockoutService, userLoginHistoryService, tokens, passkeyService.NewConfigFromEnv())
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		userPasskeyHandler := passkeyHandler.NewUserPasskeyHandler(userPasskeyService, userTrustedDeviceService)
+		passwordlessRepositoryImpl := repository.NewPasswordlessRepository(db, shared.emailKeys)
+		userPasswordlessService := passwordlessService.NewUserPasswordlessService(userRepositoryImpl, passwordlessRepositoryImpl, sessionRepositoryImpl, userAuthenticationService, registrationDomainPolicy, mailSender, tokens, passwordlessService.NewConfigFromEnv())
+		userPasswordlessHandler := passwordlessHandler.NewUserPasswordlessHandler(userPasswordlessService)
+		userStepUpService := stepupService.NewUserStepUpService(userRepositoryImpl, mfaRepositoryImpl, userMFAService, userLockoutService, tokens)
+		userStepUpHandler := stepupHandler.NewUserStepUpHandler(userStepUpService)
+		userPasswordResetService := passwordresetService.NewUserPasswordResetService(userRepositoryImpl, sessionRepositoryImpl, tokens, passwordresetService.NewConfigFromEnv())
+		userPasswordResetHandler := passwordresetHandler.NewUserPasswordResetHandler(userPasswordResetService)
+		loginAlertConfig := loginalertService.NewConfigFromEnv()
+		loginAlertNotifier, err := loginalertService.NewNotifier(loginAlertConfig, mailSender)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		userLoginAlertService := loginalertService.NewUserLoginAlertService(userRepositoryImpl, sessionRepositoryImpl, trustedDeviceRepositoryImpl, userPasswordResetService, loginAlertNotifier, tokens, loginAlertConfig)
+		userLoginAlertHandler := loginalertHandler.NewUserLoginAlertHandler(userLoginAlertService)
+		userAdminService := adminService.NewUserAdminService(userRepositoryImpl, roleRepositoryImpl, auditLogRepositoryImpl, transactor, userRoleService, registrationDomainPolicy, adminService.NewConfigFromEnv())
+		userAdminHandler := adminHandler.NewUserAdminHandler(userAdminService)
+		userRoleHandler := roleHandler.NewUserRoleHandler(userRoleService)
+		userGroupHandler := groupHandler.NewUserGroupHandler(userGroupService)
+		// サービスアカウント（人に紐付かない自動化用のアカウント）は API キーかクライアント資格情報でのみ利用できる
+		serviceAccountRepositoryImpl := repository.NewServiceAccountRepository(db)
+		serviceAccountSvc := serviceaccountService.NewServiceAccountService(userRepositoryImpl, serviceAccountRepositoryImpl, organizationRepositoryImpl, transactor, userAPIKeyService, tokens, serviceaccountService.NewConfigFromEnv())
+		serviceAccountHandler := serviceaccountHandler.NewServiceAccountHandler(serviceAccountSvc)
+		userAuthzHandler := authzHandler.NewUserAuthzHandler(userAuthzService)
+		invitationRepositoryImpl := repository.NewInvitationRepository(db)
+		organizationSvc := organizationService.NewOrganizationService(organizationRepositoryImpl, userRepositoryImpl, tokens)
+		invitationSvc := organizationService.NewInvitationService(organizationRepositoryImpl, invitationRepositoryImpl, userRepositoryImpl, mailSender, tokens, organizationService.NewConfigFromEnv())
+		orgHandler := organizationHandler.NewOrganizationHandler(organizationSvc, invitationSvc)
 
 		serverInterface := &ServerInterfaceImpl{
 			UserRegistrationHandler:   userRegistrationHandler,
 			UserAuthenticationHandler: userAuthenticationHandler,
 			UserProfileHandler:        userProfileHandler,
+			UserVerificationHandler:   userVerificationHandler,
+			UserEmailChangeHandler:    userEmailChangeHandler,
+			UserMFAHandler:            userMFAHandler,
+			UserPasskeyHandler:        userPasskeyHandler,
+			UserPasswordlessHandler:   userPasswordlessHandler,
+			UserStepUpHandler:         userStepUpHandler,
+			UserTrustedDeviceHandler:  userTrustedDeviceHandler,
+			UserAPIKeyHandler:         userAPIKeyHandler,
+			UserLockoutHandler:        userLockoutHandler,
+			UserLoginHistoryHandler:   userLoginHistoryHandler,
+			UserLoginAlertHandler:     userLoginAlertHandler,
+			UserPasswordResetHandler:  userPasswordResetHandler,
+			UserAdminHandler:          userAdminHandler,
+			UserRoleHandler:           userRoleHandler,
+			UserGroupHandler:          userGroupHandler,
+			ServiceAccountHandler:     serviceAccountHandler,
+			UserAuthzHandler:          userAuthzHandler,
+			OrganizationHandler:       orgHandler,
+		}
+
+		// アウトボックスのイベント配信先を登録する（テナントのデータを使う配信先はこのテナントのイベントのみを扱う）
+		shared.relay.Register(event.TypeUserRegistered, outbox.NewTenantPublisher(t.ID, verificationService.NewVerificationEmailPublisher(userRepositoryImpl, userVerificationService)))
+		if loginAlertNotifier != nil {
+			// LOGIN_ALERT_NOTIFIER=none の場合は新しい端末からのログインを通知しない
+			shared.relay.Register(event.TypeUserNewDeviceLogin, outbox.NewTenantPublisher(t.ID, loginalertService.NewLoginAlertPublisher(userLoginAlertService)))
 		}
 
 		// v1 グループにハンドラーを登録する
@@ -13,8 +13,10 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/goda6565/nexus-user-auth/infrastructure/database"
+	"github.com/goda6565/nexus-user-auth/infrastructure/outbox"
 	"github.com/goda6565/nexus-user-auth/infrastructure/web"
 	"github.com/goda6565/nexus-user-auth/pkg/logger"
+	"github.com/goda6565/nexus-user-auth/pkg/mailer"
 	"github.com/goda6565/nexus-user-auth/pkg/utils"
 )
 
@@ -34,11 +36,28 @@ func main() {
 		return
 	}
 
-	server, err := web.NewServer(db)
+	// メール送信（MAIL_DRIVER で送信方式を切り替える）。SMTP の場合は配送キューを停止時に閉じる
+	mailSender, err := mailer.NewMailer(mailer.NewConfigFromEnv())
 	if err != nil {
 		logger.Fatal(err.Error())
 		return
 	}
+
+	// アウトボックスのイベント配信先はルーターの初期化時に登録される
+	relay := outbox.NewRelay(db, outbox.NewConfigFromEnv())
+	server, err := web.NewServer(db, relay, mailSender)
+	if err != nil {
+		logger.Fatal(err.Error())
+		return
+	}
+
+	// アウトボックスのリレーをバックグラウンドで起動する
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		relay.Run(relayCtx)
+	}()
 	// サーバーを非同期で起動する。
 	// ※ server.Start() はブロックする処理のため、ゴルーチン内で実行し、
 	//    メインゴルーチンが終了シグナル待ちなどの処理を実行できるようにする。
@@ -72,6 +91,15 @@ func main() {
 		logger.Fatal(err.Error())
 	}
 
+	// リクエストの処理が終わった後にリレーを停止する（未配信のイベントは次回起動時に配信される）
+	stopRelay()
+	<-relayDone
+
+	// リレーが配信したメールを含め、配送キューに残ったメールを期限まで配送する
+	if err := mailer.Shutdown(ctx, mailSender); err != nil {
+		logger.Error("failed to drain mail queue", "error", err.Error())
+	}
+
 	// シャットダウン処理が完了するまで待機する。
 	// ctx.Done() が閉じられると、シャットダウンが完了したと判断できる。
 	<-ctx.Done()
@@ -0,0 +1,23 @@
+-- Create "email_changes" table
+CREATE TABLE "public"."email_changes" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "user_obj_id" uuid NOT NULL,
+  "old_email" character varying(255) NOT NULL,
+  "new_email" character varying(255) NOT NULL,
+  "status" character varying(20) NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "completed_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_email_changes_deleted_at" to table: "email_changes"
+CREATE INDEX "idx_email_changes_deleted_at" ON "public"."email_changes" ("deleted_at");
+-- Create index "idx_email_changes_obj_id" to table: "email_changes"
+CREATE UNIQUE INDEX "idx_email_changes_obj_id" ON "public"."email_changes" ("obj_id");
+-- Create index "idx_email_changes_status" to table: "email_changes"
+CREATE INDEX "idx_email_changes_status" ON "public"."email_changes" ("status");
+-- Create index "idx_email_changes_user_obj_id" to table: "email_changes"
+CREATE INDEX "idx_email_changes_user_obj_id" ON "public"."email_changes" ("user_obj_id");
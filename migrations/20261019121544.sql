@@ -0,0 +1,28 @@
+-- Create "outbox_events" table
+CREATE TABLE "public"."outbox_events" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "event_type" character varying(100) NOT NULL,
+  "aggregate_id" character varying(255) NOT NULL,
+  "payload" text NOT NULL,
+  "status" character varying(20) NOT NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL,
+  "last_error" text NULL,
+  "occurred_at" timestamptz NOT NULL,
+  "delivered_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_outbox_events_aggregate_id" to table: "outbox_events"
+CREATE INDEX "idx_outbox_events_aggregate_id" ON "public"."outbox_events" ("aggregate_id");
+-- Create index "idx_outbox_events_deleted_at" to table: "outbox_events"
+CREATE INDEX "idx_outbox_events_deleted_at" ON "public"."outbox_events" ("deleted_at");
+-- Create index "idx_outbox_events_event_type" to table: "outbox_events"
+CREATE INDEX "idx_outbox_events_event_type" ON "public"."outbox_events" ("event_type");
+-- Create index "idx_outbox_events_obj_id" to table: "outbox_events"
+CREATE UNIQUE INDEX "idx_outbox_events_obj_id" ON "public"."outbox_events" ("obj_id");
+-- Create index "idx_outbox_events_status_next_attempt_at" to table: "outbox_events"
+CREATE INDEX "idx_outbox_events_status_next_attempt_at" ON "public"."outbox_events" ("status", "next_attempt_at");
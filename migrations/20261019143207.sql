@@ -0,0 +1,24 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "email_key" character varying(255) NULL;
+-- Backfill "email_key" with the normalized email (provider-specific rules are disabled by default)
+UPDATE "public"."users" SET "email_key" = lower(trim("email"));
+-- Report accounts whose normalized emails collide; they must be merged or renamed before the unique index can be created
+DO $$
+DECLARE
+  collisions text;
+BEGIN
+  SELECT string_agg(format('%s: %s', c."email_key", c."accounts"), E'\n' ORDER BY c."email_key") INTO collisions
+  FROM (
+    SELECT "email_key", string_agg(format('%s <%s>', "obj_id", "email"), ', ' ORDER BY "id") AS "accounts"
+    FROM "public"."users"
+    GROUP BY "email_key"
+    HAVING count(*) > 1
+  ) AS c;
+  IF collisions IS NOT NULL THEN
+    RAISE EXCEPTION 'users with colliding normalized emails found:%', E'\n' || collisions;
+  END IF;
+END $$;
+-- Modify "users" table
+ALTER TABLE "public"."users" ALTER COLUMN "email_key" SET NOT NULL;
+-- Create index "idx_users_email_key" to table: "users"
+CREATE UNIQUE INDEX "idx_users_email_key" ON "public"."users" ("email_key");
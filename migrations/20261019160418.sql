@@ -0,0 +1,34 @@
+-- Create "totp_factors" table
+CREATE TABLE "public"."totp_factors" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "user_obj_id" uuid NOT NULL,
+  "secret" character varying(255) NOT NULL,
+  "confirmed_at" timestamptz NULL,
+  "last_used_step" bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_totp_factors_deleted_at" to table: "totp_factors"
+CREATE INDEX "idx_totp_factors_deleted_at" ON "public"."totp_factors" ("deleted_at");
+-- Create index "idx_totp_factors_user_obj_id" to table: "totp_factors"
+CREATE UNIQUE INDEX "idx_totp_factors_user_obj_id" ON "public"."totp_factors" ("user_obj_id");
+-- Create "recovery_codes" table
+CREATE TABLE "public"."recovery_codes" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "user_obj_id" uuid NOT NULL,
+  "code_hash" character varying(64) NOT NULL,
+  "used_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_recovery_codes_deleted_at" to table: "recovery_codes"
+CREATE INDEX "idx_recovery_codes_deleted_at" ON "public"."recovery_codes" ("deleted_at");
+-- Create index "idx_recovery_codes_obj_id" to table: "recovery_codes"
+CREATE UNIQUE INDEX "idx_recovery_codes_obj_id" ON "public"."recovery_codes" ("obj_id");
+-- Create index "idx_recovery_codes_user_obj_id" to table: "recovery_codes"
+CREATE INDEX "idx_recovery_codes_user_obj_id" ON "public"."recovery_codes" ("user_obj_id");
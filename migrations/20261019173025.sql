@@ -0,0 +1,47 @@
+-- Create "passkey_credentials" table
+CREATE TABLE "public"."passkey_credentials" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "user_obj_id" uuid NOT NULL,
+  "credential_id" bytea NOT NULL,
+  "name" character varying(100) NOT NULL DEFAULT '',
+  "public_key" bytea NOT NULL,
+  "attestation_type" character varying(32) NOT NULL DEFAULT '',
+  "transports" character varying(255) NOT NULL DEFAULT '',
+  "aaguid" bytea NULL,
+  "sign_count" bigint NOT NULL DEFAULT 0,
+  "backup_eligible" boolean NOT NULL DEFAULT false,
+  "backup_state" boolean NOT NULL DEFAULT false,
+  "last_used_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_passkey_credentials_credential_id" to table: "passkey_credentials"
+CREATE UNIQUE INDEX "idx_passkey_credentials_credential_id" ON "public"."passkey_credentials" ("credential_id");
+-- Create index "idx_passkey_credentials_deleted_at" to table: "passkey_credentials"
+CREATE INDEX "idx_passkey_credentials_deleted_at" ON "public"."passkey_credentials" ("deleted_at");
+-- Create index "idx_passkey_credentials_obj_id" to table: "passkey_credentials"
+CREATE UNIQUE INDEX "idx_passkey_credentials_obj_id" ON "public"."passkey_credentials" ("obj_id");
+-- Create index "idx_passkey_credentials_user_obj_id" to table: "passkey_credentials"
+CREATE INDEX "idx_passkey_credentials_user_obj_id" ON "public"."passkey_credentials" ("user_obj_id");
+-- Create "passkey_sessions" table
+CREATE TABLE "public"."passkey_sessions" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "user_obj_id" uuid NULL,
+  "ceremony" character varying(20) NOT NULL,
+  "data" text NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_passkey_sessions_deleted_at" to table: "passkey_sessions"
+CREATE INDEX "idx_passkey_sessions_deleted_at" ON "public"."passkey_sessions" ("deleted_at");
+-- Create index "idx_passkey_sessions_obj_id" to table: "passkey_sessions"
+CREATE UNIQUE INDEX "idx_passkey_sessions_obj_id" ON "public"."passkey_sessions" ("obj_id");
+-- Create index "idx_passkey_sessions_user_obj_id" to table: "passkey_sessions"
+CREATE INDEX "idx_passkey_sessions_user_obj_id" ON "public"."passkey_sessions" ("user_obj_id");
@@ -0,0 +1,21 @@
+-- Create "passwordless_challenges" table
+CREATE TABLE "public"."passwordless_challenges" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "email" character varying(255) NOT NULL,
+  "email_key" character varying(255) NOT NULL,
+  "code_hash" character varying(64) NOT NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NOT NULL,
+  "consumed_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_passwordless_challenges_deleted_at" to table: "passwordless_challenges"
+CREATE INDEX "idx_passwordless_challenges_deleted_at" ON "public"."passwordless_challenges" ("deleted_at");
+-- Create index "idx_passwordless_challenges_email_key" to table: "passwordless_challenges"
+CREATE INDEX "idx_passwordless_challenges_email_key" ON "public"."passwordless_challenges" ("email_key");
+-- Create index "idx_passwordless_challenges_obj_id" to table: "passwordless_challenges"
+CREATE UNIQUE INDEX "idx_passwordless_challenges_obj_id" ON "public"."passwordless_challenges" ("obj_id");
@@ -0,0 +1,19 @@
+-- Create "trusted_devices" table
+CREATE TABLE "public"."trusted_devices" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "user_obj_id" uuid NOT NULL,
+  "name" character varying(255) NOT NULL DEFAULT '',
+  "expires_at" timestamptz NOT NULL,
+  "last_used_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_trusted_devices_deleted_at" to table: "trusted_devices"
+CREATE INDEX "idx_trusted_devices_deleted_at" ON "public"."trusted_devices" ("deleted_at");
+-- Create index "idx_trusted_devices_obj_id" to table: "trusted_devices"
+CREATE UNIQUE INDEX "idx_trusted_devices_obj_id" ON "public"."trusted_devices" ("obj_id");
+-- Create index "idx_trusted_devices_user_obj_id" to table: "trusted_devices"
+CREATE INDEX "idx_trusted_devices_user_obj_id" ON "public"."trusted_devices" ("user_obj_id");
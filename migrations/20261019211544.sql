@@ -0,0 +1,17 @@
+-- Create "login_failures" table
+CREATE TABLE "public"."login_failures" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "scope" character varying(16) NOT NULL,
+  "identifier" character varying(320) NOT NULL,
+  "failures" bigint NOT NULL DEFAULT 0,
+  "last_failed_at" timestamptz NOT NULL,
+  "locked_until" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_login_failures_deleted_at" to table: "login_failures"
+CREATE INDEX "idx_login_failures_deleted_at" ON "public"."login_failures" ("deleted_at");
+-- Create index "idx_login_failures_scope_identifier" to table: "login_failures"
+CREATE UNIQUE INDEX "idx_login_failures_scope_identifier" ON "public"."login_failures" ("scope", "identifier");
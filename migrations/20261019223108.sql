@@ -0,0 +1,22 @@
+-- Create "login_events" table
+CREATE TABLE "public"."login_events" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "user_obj_id" uuid NOT NULL,
+  "result" character varying(16) NOT NULL,
+  "reason" character varying(64) NOT NULL DEFAULT '',
+  "methods" character varying(64) NOT NULL DEFAULT '',
+  "ip_address" character varying(45) NOT NULL DEFAULT '',
+  "user_agent" character varying(512) NOT NULL DEFAULT '',
+  "occurred_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_login_events_deleted_at" to table: "login_events"
+CREATE INDEX "idx_login_events_deleted_at" ON "public"."login_events" ("deleted_at");
+-- Create index "idx_login_events_obj_id" to table: "login_events"
+CREATE UNIQUE INDEX "idx_login_events_obj_id" ON "public"."login_events" ("obj_id");
+-- Create index "idx_login_events_user_occurred_at" to table: "login_events"
+CREATE INDEX "idx_login_events_user_occurred_at" ON "public"."login_events" ("user_obj_id", "occurred_at");
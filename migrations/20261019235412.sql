@@ -0,0 +1,4 @@
+-- Modify "login_events" table
+ALTER TABLE "public"."login_events" ADD COLUMN "new_device" boolean NOT NULL DEFAULT false;
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "sessions_revoked_at" timestamptz NULL;
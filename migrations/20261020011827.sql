@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "status" character varying(20) NOT NULL DEFAULT 'active', ADD COLUMN "status_reason" character varying(255) NULL, ADD COLUMN "status_changed_at" timestamptz NULL;
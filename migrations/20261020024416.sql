@@ -0,0 +1,24 @@
+-- Create "audit_logs" table
+CREATE TABLE "public"."audit_logs" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "actor_obj_id" uuid NOT NULL,
+  "action" character varying(64) NOT NULL,
+  "target_obj_id" character varying(36) NOT NULL DEFAULT '',
+  "detail" text NOT NULL DEFAULT '',
+  "occurred_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_audit_logs_actor_obj_id" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_actor_obj_id" ON "public"."audit_logs" ("actor_obj_id");
+-- Create index "idx_audit_logs_deleted_at" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_deleted_at" ON "public"."audit_logs" ("deleted_at");
+-- Create index "idx_audit_logs_obj_id" to table: "audit_logs"
+CREATE UNIQUE INDEX "idx_audit_logs_obj_id" ON "public"."audit_logs" ("obj_id");
+-- Create index "idx_audit_logs_occurred_at" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_occurred_at" ON "public"."audit_logs" ("occurred_at");
+-- Create index "idx_audit_logs_target_obj_id" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_target_obj_id" ON "public"."audit_logs" ("target_obj_id");
@@ -0,0 +1,41 @@
+-- Create "roles" table
+CREATE TABLE "public"."roles" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "name" character varying(50) NOT NULL,
+  "description" character varying(255) NOT NULL DEFAULT '',
+  "permissions" text NOT NULL DEFAULT '[]',
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_roles_deleted_at" to table: "roles"
+CREATE INDEX "idx_roles_deleted_at" ON "public"."roles" ("deleted_at");
+-- Create index "idx_roles_name" to table: "roles"
+CREATE UNIQUE INDEX "idx_roles_name" ON "public"."roles" ("name");
+-- Create "user_roles" table
+CREATE TABLE "public"."user_roles" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "user_obj_id" uuid NOT NULL,
+  "role_name" character varying(50) NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_users_roles" FOREIGN KEY ("user_obj_id") REFERENCES "public"."users" ("obj_id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_user_roles_deleted_at" to table: "user_roles"
+CREATE INDEX "idx_user_roles_deleted_at" ON "public"."user_roles" ("deleted_at");
+-- Create index "idx_user_roles_role_name" to table: "user_roles"
+CREATE INDEX "idx_user_roles_role_name" ON "public"."user_roles" ("role_name");
+-- Create index "idx_user_roles_user_obj_id_role_name" to table: "user_roles"
+CREATE UNIQUE INDEX "idx_user_roles_user_obj_id_role_name" ON "public"."user_roles" ("user_obj_id", "role_name");
+-- Create the built-in roles
+INSERT INTO "public"."roles" ("created_at", "updated_at", "name", "description", "permissions") VALUES
+  (now(), now(), 'admin', 'すべての操作を許可する管理者', '["*"]'),
+  (now(), now(), 'user', '一般ユーザー', '[]');
+-- Move the role of each user to "user_roles"
+INSERT INTO "public"."user_roles" ("created_at", "updated_at", "user_obj_id", "role_name")
+  SELECT now(), now(), "obj_id", "role" FROM "public"."users";
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "role";
@@ -0,0 +1,58 @@
+-- Create "organizations" table
+CREATE TABLE "public"."organizations" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "name" character varying(100) NOT NULL,
+  "slug" character varying(50) NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_organizations_deleted_at" to table: "organizations"
+CREATE INDEX "idx_organizations_deleted_at" ON "public"."organizations" ("deleted_at");
+-- Create index "idx_organizations_obj_id" to table: "organizations"
+CREATE UNIQUE INDEX "idx_organizations_obj_id" ON "public"."organizations" ("obj_id");
+-- Create index "idx_organizations_slug" to table: "organizations"
+CREATE UNIQUE INDEX "idx_organizations_slug" ON "public"."organizations" ("slug");
+-- Create "organization_members" table
+CREATE TABLE "public"."organization_members" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "org_obj_id" uuid NOT NULL,
+  "user_obj_id" uuid NOT NULL,
+  "role" character varying(20) NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_organization_members_deleted_at" to table: "organization_members"
+CREATE INDEX "idx_organization_members_deleted_at" ON "public"."organization_members" ("deleted_at");
+-- Create index "idx_organization_members_org_obj_id_user_obj_id" to table: "organization_members"
+CREATE UNIQUE INDEX "idx_organization_members_org_obj_id_user_obj_id" ON "public"."organization_members" ("org_obj_id", "user_obj_id");
+-- Create index "idx_organization_members_user_obj_id" to table: "organization_members"
+CREATE INDEX "idx_organization_members_user_obj_id" ON "public"."organization_members" ("user_obj_id");
+-- Create "organization_invitations" table
+CREATE TABLE "public"."organization_invitations" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "org_obj_id" uuid NOT NULL,
+  "email" character varying(255) NOT NULL,
+  "role" character varying(20) NOT NULL,
+  "inviter_obj_id" uuid NOT NULL,
+  "status" character varying(20) NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "responded_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_organization_invitations_deleted_at" to table: "organization_invitations"
+CREATE INDEX "idx_organization_invitations_deleted_at" ON "public"."organization_invitations" ("deleted_at");
+-- Create index "idx_organization_invitations_obj_id" to table: "organization_invitations"
+CREATE UNIQUE INDEX "idx_organization_invitations_obj_id" ON "public"."organization_invitations" ("obj_id");
+-- Create index "idx_organization_invitations_org_obj_id" to table: "organization_invitations"
+CREATE INDEX "idx_organization_invitations_org_obj_id" ON "public"."organization_invitations" ("org_obj_id");
+-- Create index "idx_organization_invitations_status" to table: "organization_invitations"
+CREATE INDEX "idx_organization_invitations_status" ON "public"."organization_invitations" ("status");
@@ -0,0 +1,32 @@
+-- Modify "login_failures" table
+ALTER TABLE "public"."login_failures" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Drop index "idx_login_failures_scope_identifier" from table: "login_failures"
+DROP INDEX "public"."idx_login_failures_scope_identifier";
+-- Create index "idx_login_failures_tenant_id_scope_identifier" to table: "login_failures"
+CREATE UNIQUE INDEX "idx_login_failures_tenant_id_scope_identifier" ON "public"."login_failures" ("tenant_id", "scope", "identifier");
+-- Modify "organizations" table
+ALTER TABLE "public"."organizations" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Drop index "idx_organizations_slug" from table: "organizations"
+DROP INDEX "public"."idx_organizations_slug";
+-- Create index "idx_organizations_tenant_id_slug" to table: "organizations"
+CREATE UNIQUE INDEX "idx_organizations_tenant_id_slug" ON "public"."organizations" ("tenant_id", "slug");
+-- Modify "outbox_events" table
+ALTER TABLE "public"."outbox_events" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "passwordless_challenges" table
+ALTER TABLE "public"."passwordless_challenges" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "roles" table
+ALTER TABLE "public"."roles" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Drop index "idx_roles_name" from table: "roles"
+DROP INDEX "public"."idx_roles_name";
+-- Create index "idx_roles_tenant_id_name" to table: "roles"
+CREATE UNIQUE INDEX "idx_roles_tenant_id_name" ON "public"."roles" ("tenant_id", "name");
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Drop index "idx_users_email" from table: "users"
+DROP INDEX "public"."idx_users_email";
+-- Drop index "idx_users_email_key" from table: "users"
+DROP INDEX "public"."idx_users_email_key";
+-- Create index "idx_users_tenant_id_email" to table: "users"
+CREATE UNIQUE INDEX "idx_users_tenant_id_email" ON "public"."users" ("tenant_id", "email");
+-- Create index "idx_users_tenant_id_email_key" to table: "users"
+CREATE UNIQUE INDEX "idx_users_tenant_id_email_key" ON "public"."users" ("tenant_id", "email_key");
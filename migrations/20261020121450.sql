@@ -0,0 +1,68 @@
+-- Create "groups" table
+CREATE TABLE "public"."groups" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "tenant_id" character varying(64) NOT NULL DEFAULT 'default',
+  "name" character varying(50) NOT NULL,
+  "description" character varying(255) NOT NULL DEFAULT '',
+  "permissions" text NOT NULL DEFAULT '[]',
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_groups_deleted_at" to table: "groups"
+CREATE INDEX "idx_groups_deleted_at" ON "public"."groups" ("deleted_at");
+-- Create index "idx_groups_obj_id" to table: "groups"
+CREATE UNIQUE INDEX "idx_groups_obj_id" ON "public"."groups" ("obj_id");
+-- Create index "idx_groups_tenant_id_name" to table: "groups"
+CREATE UNIQUE INDEX "idx_groups_tenant_id_name" ON "public"."groups" ("tenant_id", "name");
+-- Create "group_roles" table
+CREATE TABLE "public"."group_roles" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "group_obj_id" uuid NOT NULL,
+  "role_name" character varying(50) NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_groups_roles" FOREIGN KEY ("group_obj_id") REFERENCES "public"."groups" ("obj_id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_group_roles_deleted_at" to table: "group_roles"
+CREATE INDEX "idx_group_roles_deleted_at" ON "public"."group_roles" ("deleted_at");
+-- Create index "idx_group_roles_group_obj_id_role_name" to table: "group_roles"
+CREATE UNIQUE INDEX "idx_group_roles_group_obj_id_role_name" ON "public"."group_roles" ("group_obj_id", "role_name");
+-- Create index "idx_group_roles_role_name" to table: "group_roles"
+CREATE INDEX "idx_group_roles_role_name" ON "public"."group_roles" ("role_name");
+-- Create "group_members" table
+CREATE TABLE "public"."group_members" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "group_obj_id" uuid NOT NULL,
+  "user_obj_id" uuid NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_group_members_deleted_at" to table: "group_members"
+CREATE INDEX "idx_group_members_deleted_at" ON "public"."group_members" ("deleted_at");
+-- Create index "idx_group_members_group_obj_id_user_obj_id" to table: "group_members"
+CREATE UNIQUE INDEX "idx_group_members_group_obj_id_user_obj_id" ON "public"."group_members" ("group_obj_id", "user_obj_id");
+-- Create index "idx_group_members_user_obj_id" to table: "group_members"
+CREATE INDEX "idx_group_members_user_obj_id" ON "public"."group_members" ("user_obj_id");
+-- Create "group_subgroups" table
+CREATE TABLE "public"."group_subgroups" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "parent_obj_id" uuid NOT NULL,
+  "child_obj_id" uuid NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_group_subgroups_child_obj_id" to table: "group_subgroups"
+CREATE INDEX "idx_group_subgroups_child_obj_id" ON "public"."group_subgroups" ("child_obj_id");
+-- Create index "idx_group_subgroups_deleted_at" to table: "group_subgroups"
+CREATE INDEX "idx_group_subgroups_deleted_at" ON "public"."group_subgroups" ("deleted_at");
+-- Create index "idx_group_subgroups_parent_obj_id_child_obj_id" to table: "group_subgroups"
+CREATE UNIQUE INDEX "idx_group_subgroups_parent_obj_id_child_obj_id" ON "public"."group_subgroups" ("parent_obj_id", "child_obj_id");
@@ -0,0 +1,25 @@
+-- Create "api_keys" table
+CREATE TABLE "public"."api_keys" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "obj_id" uuid NOT NULL,
+  "tenant_id" character varying(64) NOT NULL DEFAULT 'default',
+  "user_obj_id" uuid NOT NULL,
+  "name" character varying(100) NOT NULL,
+  "prefix" character varying(20) NOT NULL,
+  "secret_hash" character varying(64) NOT NULL,
+  "scopes" text NOT NULL DEFAULT '[]',
+  "expires_at" timestamptz NULL,
+  "last_used_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_api_keys_deleted_at" to table: "api_keys"
+CREATE INDEX "idx_api_keys_deleted_at" ON "public"."api_keys" ("deleted_at");
+-- Create index "idx_api_keys_obj_id" to table: "api_keys"
+CREATE UNIQUE INDEX "idx_api_keys_obj_id" ON "public"."api_keys" ("obj_id");
+-- Create index "idx_api_keys_secret_hash" to table: "api_keys"
+CREATE UNIQUE INDEX "idx_api_keys_secret_hash" ON "public"."api_keys" ("secret_hash");
+-- Create index "idx_api_keys_user_obj_id" to table: "api_keys"
+CREATE INDEX "idx_api_keys_user_obj_id" ON "public"."api_keys" ("user_obj_id");
@@ -0,0 +1,24 @@
+-- Modify "audit_logs" table
+ALTER TABLE "public"."audit_logs" ADD COLUMN "actor_kind" character varying(20) NOT NULL DEFAULT 'user';
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "kind" character varying(20) NOT NULL DEFAULT 'user';
+-- Create "service_accounts" table
+CREATE TABLE "public"."service_accounts" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "user_obj_id" uuid NOT NULL,
+  "tenant_id" character varying(64) NOT NULL DEFAULT 'default',
+  "description" character varying(255) NULL,
+  "owner_type" character varying(20) NOT NULL,
+  "owner_id" uuid NOT NULL,
+  "created_by" uuid NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_service_accounts_deleted_at" to table: "service_accounts"
+CREATE INDEX "idx_service_accounts_deleted_at" ON "public"."service_accounts" ("deleted_at");
+-- Create index "idx_service_accounts_owner_id" to table: "service_accounts"
+CREATE INDEX "idx_service_accounts_owner_id" ON "public"."service_accounts" ("owner_id");
+-- Create index "idx_service_accounts_user_obj_id" to table: "service_accounts"
+CREATE UNIQUE INDEX "idx_service_accounts_user_obj_id" ON "public"."service_accounts" ("user_obj_id");
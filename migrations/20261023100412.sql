@@ -0,0 +1,15 @@
+-- Create "email_verification_sends" table
+CREATE TABLE "public"."email_verification_sends" (
+  "id" bigserial NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "tenant_id" character varying(64) NOT NULL DEFAULT 'default',
+  "email_key" character varying(320) NOT NULL,
+  "sent_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_email_verification_sends_deleted_at" to table: "email_verification_sends"
+CREATE INDEX "idx_email_verification_sends_deleted_at" ON "public"."email_verification_sends" ("deleted_at");
+-- Create index "idx_email_verification_sends_tenant_id_email_key" to table: "email_verification_sends"
+CREATE UNIQUE INDEX "idx_email_verification_sends_tenant_id_email_key" ON "public"."email_verification_sends" ("tenant_id", "email_key");
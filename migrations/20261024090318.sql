@@ -0,0 +1,16 @@
+-- Modify "audit_logs" table
+ALTER TABLE "public"."audit_logs" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "email_changes" table
+ALTER TABLE "public"."email_changes" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "login_events" table
+ALTER TABLE "public"."login_events" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "passkey_credentials" table
+ALTER TABLE "public"."passkey_credentials" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "passkey_sessions" table
+ALTER TABLE "public"."passkey_sessions" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "recovery_codes" table
+ALTER TABLE "public"."recovery_codes" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "totp_factors" table
+ALTER TABLE "public"."totp_factors" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- Modify "trusted_devices" table
+ALTER TABLE "public"."trusted_devices" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
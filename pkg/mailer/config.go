@@ -0,0 +1,87 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// Driver はメールの送信方式
+type Driver string
+
+const (
+	DriverLog    Driver = "log"    // 宛先と件名をログに出力するのみ（既定。開発環境でのみ利用できる）
+	DriverSMTP   Driver = "smtp"   // SMTP サーバー経由で送信（配送キュー経由）
+	DriverFile   Driver = "file"   // ディレクトリに .eml ファイルとして書き出す
+	DriverMemory Driver = "memory" // メモリ上に保持する
+)
+
+type Config struct {
+	Env       string // 実行環境（development 以外では DriverLog を利用できない）
+	Driver    Driver
+	From      string
+	SMTP      *SMTPConfig
+	OutboxDir string // DriverFile の書き出し先
+	Queue     *QueueConfig
+}
+
+func NewConfigFromEnv() *Config {
+	from := utils.GetEnvDefault("MAIL_FROM", "no-reply@localhost")
+	return &Config{
+		Env:    utils.GetEnvDefault("ENV", "development"),
+		Driver: Driver(utils.GetEnvDefault("MAIL_DRIVER", string(DriverLog))),
+		From:   from,
+		SMTP: &SMTPConfig{
+			Host:     utils.GetEnvDefault("SMTP_HOST", "localhost"),
+			Port:     utils.GetEnvDefault("SMTP_PORT", "1025"),
+			Username: utils.GetEnvDefault("SMTP_USERNAME", ""),
+			Password: utils.GetEnvDefault("SMTP_PASSWORD", ""),
+			From:     from,
+		},
+		OutboxDir: utils.GetEnvDefault("MAIL_OUTBOX_DIR", "tmp/mail"),
+		Queue: &QueueConfig{
+			Size:        utils.GetEnvInt("MAIL_QUEUE_SIZE", 1000),
+			Workers:     utils.GetEnvInt("MAIL_QUEUE_WORKERS", 4),
+			MaxAttempts: utils.GetEnvInt("MAIL_QUEUE_MAX_ATTEMPTS", 5),
+			Backoff:     utils.GetEnvDuration("MAIL_QUEUE_BACKOFF", time.Second),
+			MaxBackoff:  utils.GetEnvDuration("MAIL_QUEUE_MAX_BACKOFF", time.Minute),
+		},
+	}
+}
+
+// LocaleFromEnv はメール文面の言語を環境変数 MAIL_LOCALE から取得する
+func LocaleFromEnv() Locale {
+	return ParseLocale(utils.GetEnvDefault("MAIL_LOCALE", string(DefaultLocale)))
+}
+
+// NewMailer は設定された送信方式の Mailer を作成する
+func NewMailer(config *Config) (Mailer, error) {
+	switch config.Driver {
+	case DriverLog:
+		// メールを送信しないため、本番環境で誤って使わないよう開発環境以外では拒否する
+		if config.Env != "development" {
+			return nil, errs.NewPkgError(fmt.Sprintf("mail driver %q is only available in development (ENV=%s)", config.Driver, config.Env))
+		}
+		return NewLogMailer(), nil
+	case DriverSMTP:
+		return NewQueueMailer(NewSMTPMailer(config.SMTP), config.Queue), nil
+	case DriverFile:
+		return NewFileOutbox(config.OutboxDir, config.From)
+	case DriverMemory:
+		return NewMemoryOutbox(), nil
+	default:
+		return nil, errs.NewPkgError(fmt.Sprintf("unknown mail driver: %s", config.Driver))
+	}
+}
+
+// Shutdown は、配送キューを持つ Mailer の場合に残ったメールの配送が終わるまで待って停止する
+// キューを持たない Mailer の場合は何もしない
+func Shutdown(ctx context.Context, m Mailer) error {
+	if q, ok := m.(*QueueMailer); ok {
+		return q.Shutdown(ctx)
+	}
+	return nil
+}
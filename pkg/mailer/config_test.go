@@ -0,0 +1,24 @@
+package mailer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMailer_LogDriver(t *testing.T) {
+	m, err := NewMailer(&Config{Env: "development", Driver: DriverLog})
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+
+	// 開発環境以外ではメールを送信しない log ドライバーを拒否する
+	m, err = NewMailer(&Config{Env: "production", Driver: DriverLog})
+	assert.Error(t, err)
+	assert.Nil(t, m)
+}
+
+func TestNewMailer_UnknownDriver(t *testing.T) {
+	m, err := NewMailer(&Config{Env: "development", Driver: "unknown"})
+	assert.Error(t, err)
+	assert.Nil(t, m)
+}
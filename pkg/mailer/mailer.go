@@ -0,0 +1,33 @@
+package mailer
+
+import (
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+// Message は送信するメールの内容
+type Message struct {
+	To       string
+	Subject  string
+	Body     string // テキスト本文
+	HTMLBody string // HTML本文（空の場合はテキストのみ送信）
+}
+
+// Mailer はメール送信の抽象
+type Mailer interface {
+	// Send: メールを送信
+	Send(msg *Message) error
+}
+
+// logMailer は、メールを送信せずに宛先と件名のみをログへ出力する Mailer の実装（開発用）
+// 本文には確認リンクなどのトークンが含まれるため出力しない
+type logMailer struct{}
+
+// NewLogMailer は、ログ出力のみを行う Mailer を返します。
+func NewLogMailer() Mailer {
+	return &logMailer{}
+}
+
+func (m *logMailer) Send(msg *Message) error {
+	logger.Info("mail sent", "to", msg.To, "subject", msg.Subject)
+	return nil
+}
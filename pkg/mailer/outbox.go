@@ -0,0 +1,93 @@
+package mailer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// MemoryOutbox は送信したメールをメモリ上に保持する Mailer の実装（テスト用）
+type MemoryOutbox struct {
+	mu       sync.Mutex
+	messages []*Message
+}
+
+// NewMemoryOutbox は、メールをメモリ上に保持する Mailer を返します。
+func NewMemoryOutbox() *MemoryOutbox {
+	return &MemoryOutbox{}
+}
+
+func (o *MemoryOutbox) Send(msg *Message) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	copied := *msg
+	o.messages = append(o.messages, &copied)
+	return nil
+}
+
+// Messages は送信されたメールを送信順に返す
+func (o *MemoryOutbox) Messages() []*Message {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]*Message(nil), o.messages...)
+}
+
+// MessagesTo は指定アドレス宛てのメールを送信順に返す
+func (o *MemoryOutbox) MessagesTo(to string) []*Message {
+	var result []*Message
+	for _, msg := range o.Messages() {
+		if msg.To == to {
+			result = append(result, msg)
+		}
+	}
+	return result
+}
+
+// LastTo は指定アドレス宛ての最後のメールを返す（存在しない場合は nil）
+func (o *MemoryOutbox) LastTo(to string) *Message {
+	messages := o.MessagesTo(to)
+	if len(messages) == 0 {
+		return nil
+	}
+	return messages[len(messages)-1]
+}
+
+// Reset は保持しているメールを破棄する
+func (o *MemoryOutbox) Reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.messages = nil
+}
+
+// fileOutbox は送信したメールを .eml ファイルとしてディレクトリに書き出す Mailer の実装（ローカル開発用）
+type fileOutbox struct {
+	dir  string
+	from string
+
+	mu  sync.Mutex
+	seq int
+}
+
+// NewFileOutbox は、メールを指定ディレクトリに .eml ファイルとして書き出す Mailer を返します。
+func NewFileOutbox(dir, from string) (Mailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errs.NewPkgError(fmt.Sprintf("failed to create outbox directory: %v", err))
+	}
+	return &fileOutbox{dir: dir, from: from}, nil
+}
+
+func (o *fileOutbox) Send(msg *Message) error {
+	o.mu.Lock()
+	o.seq++
+	name := fmt.Sprintf("%s-%04d.eml", time.Now().Format("20060102T150405.000000000"), o.seq)
+	o.mu.Unlock()
+
+	if err := os.WriteFile(filepath.Join(o.dir, name), buildMIME(o.from, msg), 0o644); err != nil {
+		return errs.NewPkgError(fmt.Sprintf("failed to write mail to outbox: %v", err))
+	}
+	return nil
+}
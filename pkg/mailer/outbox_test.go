@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryOutbox(t *testing.T) {
+	outbox := NewMemoryOutbox()
+
+	assert.NoError(t, outbox.Send(&Message{To: "a@example.com", Subject: "1"}))
+	assert.NoError(t, outbox.Send(&Message{To: "b@example.com", Subject: "2"}))
+	assert.NoError(t, outbox.Send(&Message{To: "a@example.com", Subject: "3"}))
+
+	assert.Len(t, outbox.Messages(), 3)
+	assert.Len(t, outbox.MessagesTo("a@example.com"), 2)
+	assert.Equal(t, "3", outbox.LastTo("a@example.com").Subject)
+	assert.Nil(t, outbox.LastTo("c@example.com"))
+
+	outbox.Reset()
+	assert.Empty(t, outbox.Messages())
+}
+
+func TestMemoryOutbox_CopiesMessage(t *testing.T) {
+	outbox := NewMemoryOutbox()
+	msg := &Message{To: "a@example.com", Subject: "before"}
+	assert.NoError(t, outbox.Send(msg))
+
+	// 送信後に元のメッセージを変更しても影響しないこと
+	msg.Subject = "after"
+	assert.Equal(t, "before", outbox.LastTo("a@example.com").Subject)
+}
+
+func TestFileOutbox(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	outbox, err := NewFileOutbox(dir, "no-reply@example.com")
+	assert.NoError(t, err)
+
+	assert.NoError(t, outbox.Send(&Message{To: "a@example.com", Subject: "1", Body: "body"}))
+	assert.NoError(t, outbox.Send(&Message{To: "b@example.com", Subject: "2", Body: "body"}))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	raw, err := os.ReadFile(files[0])
+	assert.NoError(t, err)
+	assert.Contains(t, string(raw), "To: a@example.com")
+}
@@ -0,0 +1,136 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/logger"
+)
+
+var (
+	ErrQueueFull   = errs.NewPkgError("mail queue is full")
+	ErrQueueClosed = errs.NewPkgError("mail queue is closed")
+)
+
+// QueueConfig は配送キューの設定
+type QueueConfig struct {
+	Size        int           // キューに保持できるメールの数
+	Workers     int           // 配送ワーカーの数（1 未満の場合は 1）
+	MaxAttempts int           // 1通あたりの最大送信試行回数
+	Backoff     time.Duration // 初回リトライまでの待機時間（以降は倍々に伸ばす）
+	MaxBackoff  time.Duration // 待機時間の上限
+}
+
+// queuedMessage は配送キュー上のメールと試行の状況
+type queuedMessage struct {
+	msg     *Message
+	attempt int           // これまでの試行回数
+	backoff time.Duration // 次のリトライまでの待機時間
+}
+
+// QueueMailer はメールをキューに積み、バックグラウンドで再試行しながら配送する Mailer
+// SMTP サーバーの障害でユーザーのリクエストが失敗しないよう、Send はキューへの投入のみを行う
+// 失敗したメールはタイマーで待機してからキューに戻すため、再試行の待機中もワーカーは他のメールを配送できる
+type QueueMailer struct {
+	next   Mailer
+	config *QueueConfig
+	queue  chan *queuedMessage
+
+	mu        sync.RWMutex
+	closed    bool
+	pending   sync.WaitGroup // 受け付けてから配送または断念するまでのメール
+	workers   sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// NewQueueMailer は next への配送をキュー経由で行う Mailer を作成し、配送ワーカーを起動します。
+func NewQueueMailer(next Mailer, config *QueueConfig) *QueueMailer {
+	q := &QueueMailer{
+		next:   next,
+		config: config,
+		queue:  make(chan *queuedMessage, config.Size),
+	}
+	workers := max(config.Workers, 1)
+	q.workers.Add(workers)
+	for range workers {
+		go q.run()
+	}
+	return q
+}
+
+// Send はメールを配送キューに積む。キューが満杯の場合は ErrQueueFull を返す
+func (q *QueueMailer) Send(msg *Message) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+	q.pending.Add(1)
+	select {
+	case q.queue <- &queuedMessage{msg: msg, backoff: q.config.Backoff}:
+		return nil
+	default:
+		q.pending.Done()
+		return ErrQueueFull
+	}
+}
+
+// Shutdown は新規の受付を止め、再試行待ちを含めてキューに残ったメールの配送が終わるまで待つ
+// ctx が先に終了した場合は、未配送のメールを破棄して ctx のエラーを返す
+func (q *QueueMailer) Shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		q.pending.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		logger.Warn("mail queue shutdown timed out; undelivered mails are dropped", "queued", len(q.queue))
+		return ctx.Err()
+	}
+
+	// すべてのメールの配送が終わった後はキューに戻すメールがないため、安全に閉じられる
+	q.closeOnce.Do(func() { close(q.queue) })
+	q.workers.Wait()
+	return nil
+}
+
+// Close は、キューに残ったメールの配送が終わるまで待ってから停止する
+func (q *QueueMailer) Close() {
+	_ = q.Shutdown(context.Background())
+}
+
+func (q *QueueMailer) run() {
+	defer q.workers.Done()
+	for item := range q.queue {
+		q.deliver(item)
+	}
+}
+
+// deliver は 1 回送信を試み、失敗した場合は最大試行回数まで指数バックオフでキューに戻す
+func (q *QueueMailer) deliver(item *queuedMessage) {
+	item.attempt++
+	err := q.next.Send(item.msg)
+	if err == nil {
+		q.pending.Done()
+		return
+	}
+	if item.attempt >= q.config.MaxAttempts {
+		logger.Error("failed to deliver mail", "to", item.msg.To, "subject", item.msg.Subject, "attempts", item.attempt, "error", err.Error())
+		q.pending.Done()
+		return
+	}
+	logger.Warn("mail delivery failed, retrying", "to", item.msg.To, "attempt", item.attempt, "error", err.Error())
+
+	backoff := item.backoff
+	item.backoff = min(item.backoff*2, q.config.MaxBackoff)
+	// 配送中（pending）のメールがある間はキューを閉じないため、待機後にキューへ戻せる
+	time.AfterFunc(backoff, func() { q.queue <- item })
+}
@@ -0,0 +1,131 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyMailer は指定回数だけ失敗した後に MemoryOutbox へ送信する
+type flakyMailer struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	outbox   *MemoryOutbox
+}
+
+func (m *flakyMailer) Send(msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.attempts++
+	if m.failures > 0 {
+		m.failures--
+		return errors.New("smtp unavailable")
+	}
+	return m.outbox.Send(msg)
+}
+
+func newTestQueueConfig() *QueueConfig {
+	return &QueueConfig{Size: 10, MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+}
+
+func TestQueueMailer_RetriesUntilDelivered(t *testing.T) {
+	next := &flakyMailer{failures: 2, outbox: NewMemoryOutbox()}
+	q := NewQueueMailer(next, newTestQueueConfig())
+
+	// 配送先が失敗していても Send はエラーを返さないこと
+	assert.NoError(t, q.Send(&Message{To: "a@example.com", Subject: "s"}))
+	q.Close()
+
+	assert.Equal(t, 3, next.attempts)
+	assert.Len(t, next.outbox.Messages(), 1)
+}
+
+func TestQueueMailer_GivesUpAfterMaxAttempts(t *testing.T) {
+	next := &flakyMailer{failures: 10, outbox: NewMemoryOutbox()}
+	q := NewQueueMailer(next, newTestQueueConfig())
+
+	assert.NoError(t, q.Send(&Message{To: "a@example.com", Subject: "s"}))
+	q.Close()
+
+	assert.Equal(t, 3, next.attempts)
+	assert.Empty(t, next.outbox.Messages())
+}
+
+func TestQueueMailer_Full(t *testing.T) {
+	block := make(chan struct{})
+	next := &blockingMailer{block: block}
+	q := NewQueueMailer(next, &QueueConfig{Size: 1, MaxAttempts: 1})
+
+	// 1通目はワーカーが処理中、2通目がキューを埋め、3通目は溢れる
+	assert.NoError(t, q.Send(&Message{To: "a@example.com"}))
+	assert.Eventually(t, func() bool { return len(q.queue) == 0 }, time.Second, time.Millisecond)
+	assert.NoError(t, q.Send(&Message{To: "b@example.com"}))
+	assert.ErrorIs(t, q.Send(&Message{To: "c@example.com"}), ErrQueueFull)
+
+	close(block)
+	q.Close()
+}
+
+func TestQueueMailer_SendAfterClose(t *testing.T) {
+	q := NewQueueMailer(NewMemoryOutbox(), newTestQueueConfig())
+	q.Close()
+
+	assert.ErrorIs(t, q.Send(&Message{To: "a@example.com"}), ErrQueueClosed)
+	q.Close() // 2回目の Close も安全であること
+}
+
+func TestQueueMailer_RetryDoesNotBlockWorker(t *testing.T) {
+	next := &recipientFailingMailer{fail: "a@example.com", outbox: NewMemoryOutbox()}
+	q := NewQueueMailer(next, &QueueConfig{Size: 10, MaxAttempts: 2, Backoff: time.Hour, MaxBackoff: time.Hour})
+
+	// 1通目の再試行を待つ間も、ワーカーは 2 通目を配送できること
+	assert.NoError(t, q.Send(&Message{To: "a@example.com"}))
+	assert.NoError(t, q.Send(&Message{To: "b@example.com"}))
+	assert.Eventually(t, func() bool { return next.outbox.LastTo("b@example.com") != nil }, time.Second, time.Millisecond)
+
+	// 再試行待ちのメールが残っている場合、期限までに停止できなければエラーを返すこと
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, q.Shutdown(ctx), context.DeadlineExceeded)
+	assert.ErrorIs(t, q.Send(&Message{To: "c@example.com"}), ErrQueueClosed)
+}
+
+func TestQueueMailer_ShutdownWaitsForRetries(t *testing.T) {
+	next := &flakyMailer{failures: 1, outbox: NewMemoryOutbox()}
+	q := NewQueueMailer(next, &QueueConfig{Size: 10, Workers: 2, MaxAttempts: 3, Backoff: 20 * time.Millisecond, MaxBackoff: 20 * time.Millisecond})
+
+	assert.NoError(t, q.Send(&Message{To: "a@example.com"}))
+	assert.NoError(t, q.Shutdown(context.Background()))
+
+	// 再試行待ちのメールも配送してから停止すること
+	assert.Equal(t, 2, next.attempts)
+	assert.Len(t, next.outbox.Messages(), 1)
+}
+
+// recipientFailingMailer は fail 宛てのメールのみ送信に失敗する
+type recipientFailingMailer struct {
+	fail   string
+	outbox *MemoryOutbox
+}
+
+func (m *recipientFailingMailer) Send(msg *Message) error {
+	if msg.To == m.fail {
+		return errors.New("mailbox unavailable")
+	}
+	return m.outbox.Send(msg)
+}
+
+// blockingMailer は block が閉じられるまで送信を待機する
+type blockingMailer struct {
+	block chan struct{}
+}
+
+func (m *blockingMailer) Send(msg *Message) error {
+	<-m.block
+	return nil
+}
@@ -0,0 +1,70 @@
+package mailer
+
+import (
+	"errors"
+	"mime"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSMTPMailer_Send(t *testing.T) {
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotMsg []byte
+	m := &smtpMailer{
+		config: &SMTPConfig{Host: "smtp.example.com", Port: "587", Username: "user", Password: "pass", From: "no-reply@example.com"},
+		send: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+			gotAddr, gotFrom, gotTo, gotMsg = addr, from, to, msg
+			assert.NotNil(t, a, "ユーザー名がある場合は認証すること")
+			return nil
+		},
+	}
+
+	err := m.Send(&Message{To: "user@example.com", Subject: "件名", Body: "本文", HTMLBody: "<p>本文</p>"})
+	assert.NoError(t, err)
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.Equal(t, "no-reply@example.com", gotFrom)
+	assert.Equal(t, []string{"user@example.com"}, gotTo)
+	assert.Contains(t, string(gotMsg), "multipart/alternative")
+}
+
+func TestSMTPMailer_SendError(t *testing.T) {
+	m := &smtpMailer{
+		config: &SMTPConfig{Host: "smtp.example.com", Port: "25"},
+		send: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+			assert.Nil(t, a, "ユーザー名がない場合は認証しないこと")
+			return errors.New("connection refused")
+		},
+	}
+
+	err := m.Send(&Message{To: "user@example.com", Subject: "subject", Body: "body"})
+	assert.Error(t, err)
+}
+
+func TestBuildMIME(t *testing.T) {
+	// テキストのみの場合は multipart にしない
+	raw := string(buildMIME("from@example.com", &Message{To: "to@example.com", Subject: "確認", Body: "text"}))
+	assert.Contains(t, raw, "From: from@example.com\r\n")
+	assert.Contains(t, raw, "To: to@example.com\r\n")
+	assert.Contains(t, raw, "Content-Type: text/plain; charset=UTF-8\r\n")
+	assert.NotContains(t, raw, "multipart")
+
+	// 件名は MIME エンコードされること
+	var subject string
+	for _, line := range strings.Split(raw, "\r\n") {
+		if strings.HasPrefix(line, "Subject: ") {
+			subject = strings.TrimPrefix(line, "Subject: ")
+		}
+	}
+	decoded, err := new(mime.WordDecoder).DecodeHeader(subject)
+	assert.NoError(t, err)
+	assert.Equal(t, "確認", decoded)
+
+	// HTML がある場合は multipart/alternative
+	raw = string(buildMIME("from@example.com", &Message{To: "to@example.com", Subject: "s", Body: "text", HTMLBody: "<p>html</p>"}))
+	assert.Contains(t, raw, "Content-Type: text/plain; charset=UTF-8\r\n")
+	assert.Contains(t, raw, "Content-Type: text/html; charset=UTF-8\r\n")
+}
@@ -0,0 +1,130 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// Locale はメール文面の言語
+type Locale string
+
+const (
+	LocaleJa Locale = "ja"
+	LocaleEn Locale = "en"
+
+	DefaultLocale = LocaleJa
+)
+
+var supportedLocales = []Locale{LocaleJa, LocaleEn}
+
+// Template はメールの種類（templates/<locale>/<Template>.{txt,html} に対応）
+type Template string
+
+const (
+	TemplateEmailVerification  Template = "email_verification"      // メールアドレス確認
+	TemplateEmailChangeConfirm Template = "email_change_confirm"    // メールアドレス変更の確認（新しいアドレス宛て）
+	TemplateEmailChangeNotice  Template = "email_change_notice"     // メールアドレス変更の通知（元のアドレス宛て）
+	TemplatePasswordlessLogin  Template = "passwordless_login"      // パスワードレスログインのリンク・コード
+	TemplateAccountLocked      Template = "account_locked"          // ログイン失敗によるアカウントロックの通知と解除リンク
+	TemplateNewDeviceLogin     Template = "new_device_login"        // 新しい端末・接続元からのログインの通知とアカウント保護のリンク
+	TemplateOrgInvitation      Template = "organization_invitation" // 組織への招待と承諾・辞退のリンク
+)
+
+var allTemplates = []Template{TemplateEmailVerification, TemplateEmailChangeConfirm, TemplateEmailChangeNotice, TemplatePasswordlessLogin, TemplateAccountLocked, TemplateNewDeviceLogin, TemplateOrgInvitation}
+
+//go:embed templates
+var templateFS embed.FS
+
+type parsedTemplate struct {
+	text *texttemplate.Template // "subject" と "text" を定義
+	html *htmltemplate.Template
+}
+
+var templates = mustParseTemplates()
+
+// ParseLocale は文字列（"en-US" などを含む）を対応する Locale に変換する。未対応の場合は DefaultLocale を返す
+func ParseLocale(s string) Locale {
+	lang := strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	for _, locale := range supportedLocales {
+		if Locale(lang) == locale {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+// Render はテンプレートに data を埋め込み、to 宛てのメッセージを作成する
+func Render(tmpl Template, locale Locale, to string, data any) (*Message, error) {
+	t, ok := templates[ParseLocale(string(locale))][tmpl]
+	if !ok {
+		return nil, errs.NewPkgError(fmt.Sprintf("mail template not found: %s", tmpl))
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, errs.NewPkgError(fmt.Sprintf("failed to render mail subject: %v", err))
+	}
+	if err := t.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return nil, errs.NewPkgError(fmt.Sprintf("failed to render mail text: %v", err))
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return nil, errs.NewPkgError(fmt.Sprintf("failed to render mail html: %v", err))
+	}
+
+	return &Message{
+		To:       to,
+		Subject:  strings.TrimSpace(subject.String()),
+		Body:     text.String(),
+		HTMLBody: html.String(),
+	}, nil
+}
+
+func mustParseTemplates() map[Locale]map[Template]*parsedTemplate {
+	result := make(map[Locale]map[Template]*parsedTemplate)
+	for _, locale := range supportedLocales {
+		funcs := map[string]any{"duration": durationFormatter(locale)}
+		result[locale] = make(map[Template]*parsedTemplate)
+		for _, tmpl := range allTemplates {
+			base := fmt.Sprintf("templates/%s/%s", locale, tmpl)
+			result[locale][tmpl] = &parsedTemplate{
+				text: texttemplate.Must(texttemplate.New(string(tmpl)).Funcs(funcs).ParseFS(templateFS, base+".txt")),
+				html: htmltemplate.Must(htmltemplate.New(string(tmpl)+".html").Funcs(funcs).ParseFS(templateFS, base+".html")),
+			}
+		}
+	}
+	return result
+}
+
+// durationFormatter は有効期限などの期間を言語に合わせて表示する関数を返す
+func durationFormatter(locale Locale) func(time.Duration) string {
+	return func(d time.Duration) string {
+		var n int64
+		var unit string
+		switch {
+		case d >= 24*time.Hour && d%(24*time.Hour) == 0:
+			n, unit = int64(d/(24*time.Hour)), "day"
+		case d >= time.Hour && d%time.Hour == 0:
+			n, unit = int64(d/time.Hour), "hour"
+		default:
+			n, unit = int64(d/time.Minute), "minute"
+		}
+
+		if locale == LocaleJa {
+			return fmt.Sprintf("%d%s", n, map[string]string{"day": "日間", "hour": "時間", "minute": "分"}[unit])
+		}
+		if n != 1 {
+			unit += "s"
+		}
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+}
@@ -0,0 +1,86 @@
+package mailer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type templateData struct {
+	Username     string
+	Link         string
+	NewEmail     string
+	Code         string
+	Device       string
+	IPAddress    string
+	OccurredAt   time.Time
+	ExpiresIn    time.Duration
+	Inviter      string
+	Organization string
+	Role         string
+}
+
+func TestRender_AllTemplates(t *testing.T) {
+	data := templateData{Username: "taro", Link: "https://example.com/x?token=abc", NewEmail: "new@example.com", Code: "123456", Device: "Chrome on macOS", IPAddress: "192.0.2.1", OccurredAt: time.Now(), ExpiresIn: 24 * time.Hour, Inviter: "hanako", Organization: "Acme", Role: "member"}
+
+	// すべての言語・種類のテンプレートが描画できること
+	for _, locale := range supportedLocales {
+		for _, tmpl := range allTemplates {
+			msg, err := Render(tmpl, locale, "to@example.com", data)
+			assert.NoError(t, err, "%s/%s", locale, tmpl)
+			assert.Equal(t, "to@example.com", msg.To)
+			assert.NotEmpty(t, msg.Subject, "%s/%s", locale, tmpl)
+			assert.Contains(t, msg.Body, data.Link, "%s/%s", locale, tmpl)
+			assert.Contains(t, msg.HTMLBody, "https://example.com/x?token=abc", "%s/%s", locale, tmpl)
+		}
+	}
+}
+
+func TestRender_Localized(t *testing.T) {
+	data := templateData{Username: "taro", Link: "https://example.com", ExpiresIn: 24 * time.Hour}
+
+	ja, err := Render(TemplateEmailVerification, LocaleJa, "to@example.com", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "メールアドレスの確認", ja.Subject)
+	assert.Contains(t, ja.Body, "1日間")
+
+	en, err := Render(TemplateEmailVerification, LocaleEn, "to@example.com", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Verify your email address", en.Subject)
+	assert.Contains(t, en.Body, "1 day")
+}
+
+func TestRender_EscapesHTML(t *testing.T) {
+	data := templateData{Username: "<script>", Link: "https://example.com", ExpiresIn: time.Hour}
+
+	msg, err := Render(TemplateEmailVerification, LocaleEn, "to@example.com", data)
+	assert.NoError(t, err)
+	assert.NotContains(t, msg.HTMLBody, "<script>")
+	assert.Contains(t, msg.Body, "<script>", "テキスト本文はエスケープしないこと")
+}
+
+func TestRender_UnknownTemplate(t *testing.T) {
+	_, err := Render(Template("unknown"), LocaleJa, "to@example.com", nil)
+	assert.Error(t, err)
+}
+
+func TestParseLocale(t *testing.T) {
+	assert.Equal(t, LocaleJa, ParseLocale("ja"))
+	assert.Equal(t, LocaleEn, ParseLocale("en-US"))
+	assert.Equal(t, LocaleEn, ParseLocale(" EN "))
+	assert.Equal(t, DefaultLocale, ParseLocale("fr"))
+	assert.Equal(t, DefaultLocale, ParseLocale(""))
+}
+
+func TestDurationFormatter(t *testing.T) {
+	ja := durationFormatter(LocaleJa)
+	assert.Equal(t, "7日間", ja(7*24*time.Hour))
+	assert.Equal(t, "36時間", ja(36*time.Hour))
+	assert.Equal(t, "30分", ja(30*time.Minute))
+
+	en := durationFormatter(LocaleEn)
+	assert.Equal(t, "1 hour", en(time.Hour))
+	assert.Equal(t, "2 days", en(48*time.Hour))
+	assert.Equal(t, "90 minutes", en(90*time.Minute))
+}
@@ -0,0 +1,79 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// Fixture は、入力に対して期待する判定（ポリシーの単体テスト）
+type Fixture struct {
+	Name    string `yaml:"name"`
+	Input   Input  `yaml:"input"`
+	Allowed bool   `yaml:"allowed"`
+}
+
+// File はポリシーのファイル（YAML）の内容
+type File struct {
+	Policies []Policy  `yaml:"policies"`
+	Fixtures []Fixture `yaml:"fixtures"`
+}
+
+// Bundle は読み込んだすべてのファイルのポリシーとフィクスチャー
+type Bundle struct {
+	Policies []Policy
+	Fixtures []Fixture // 名前の先頭にファイル名を付ける（例: "users.yaml: 本人は参照できる"）
+}
+
+// LoadDir はディレクトリ直下の *.yaml / *.yml をファイル名の順に読み込む
+func LoadDir(dir string) (*Bundle, error) {
+	var paths []string
+	for _, pattern := range []string{"*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, errs.NewPkgError(err.Error())
+		}
+		paths = append(paths, matches...)
+	}
+	slices.Sort(paths)
+	return LoadFiles(paths...)
+}
+
+// LoadFiles は指定したファイルを順に読み込む
+func LoadFiles(paths ...string) (*Bundle, error) {
+	bundle := &Bundle{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errs.NewPkgError(err.Error())
+		}
+		var file File
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return nil, errs.NewPkgError(fmt.Sprintf("%s: %s", path, err.Error()))
+		}
+		bundle.Policies = append(bundle.Policies, file.Policies...)
+		for _, fixture := range file.Fixtures {
+			fixture.Name = filepath.Base(path) + ": " + fixture.Name
+			bundle.Fixtures = append(bundle.Fixtures, fixture)
+		}
+	}
+	return bundle, nil
+}
+
+// RunFixtures はフィクスチャーの入力を評価し、期待と異なる判定になったフィクスチャーをエラーで返す
+func (e *Engine) RunFixtures(fixtures []Fixture) []error {
+	var failures []error
+	for _, fixture := range fixtures {
+		decision := e.Evaluate(fixture.Input)
+		if decision.Allowed != fixture.Allowed {
+			failures = append(failures, fmt.Errorf("%s: expected allowed=%t, got allowed=%t (policy=%q, reason=%q)",
+				fixture.Name, fixture.Allowed, decision.Allowed, decision.Policy, decision.Reason))
+		}
+	}
+	return failures
+}
@@ -0,0 +1,91 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicyFile = `
+policies:
+  - name: owner
+    effect: allow
+    actions: ["documents:*"]
+    condition: resource.attributes.owner == subject.uid
+fixtures:
+  - name: 所有者は参照できる
+    input:
+      subject: {uid: u-1}
+      action: documents:read
+      resource: {type: document, attributes: {owner: u-1}}
+    allowed: true
+  - name: 所有者以外は参照できない
+    input:
+      subject: {uid: u-2}
+      action: documents:read
+      resource: {type: document, attributes: {owner: u-1}}
+    allowed: false
+`
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "documents.yaml", testPolicyFile)
+	writeFile(t, dir, "public.yml", "policies:\n  - {name: public, effect: allow, actions: [\"health:read\"]}\n")
+	writeFile(t, dir, "README.md", "ポリシーではないファイルは読み込まない")
+
+	bundle, err := LoadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, bundle.Policies, 2)
+	assert.Equal(t, "owner", bundle.Policies[0].Name)
+	assert.Equal(t, "public", bundle.Policies[1].Name)
+	require.Len(t, bundle.Fixtures, 2)
+	assert.Equal(t, "documents.yaml: 所有者は参照できる", bundle.Fixtures[0].Name)
+
+	engine, err := NewEngine(bundle.Policies)
+	require.NoError(t, err)
+	assert.Empty(t, engine.RunFixtures(bundle.Fixtures))
+}
+
+func TestLoadFiles_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "broken.yaml", "policies: [")
+	_, err := LoadFiles(filepath.Join(dir, "broken.yaml"))
+	assert.ErrorContains(t, err, "broken.yaml")
+
+	_, err = LoadFiles(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestRunFixtures_Failure(t *testing.T) {
+	engine, err := NewEngine([]Policy{{Name: "nobody", Effect: EffectDeny, Actions: []string{"*"}}})
+	require.NoError(t, err)
+
+	failures := engine.RunFixtures([]Fixture{
+		{Name: "拒否される", Input: Input{Action: "users:read"}, Allowed: false},
+		{Name: "許可されるはず", Input: Input{Action: "users:read"}, Allowed: true},
+	})
+	require.Len(t, failures, 1)
+	assert.Contains(t, failures[0].Error(), "許可されるはず")
+	assert.Contains(t, failures[0].Error(), `policy="nobody"`)
+}
+
+// 同梱のポリシーがすべてのフィクスチャーを満たすこと
+func TestDefaultPolicies(t *testing.T) {
+	bundle, err := LoadDir(filepath.Join("..", "..", "policies"))
+	require.NoError(t, err)
+	require.NotEmpty(t, bundle.Fixtures)
+
+	engine, err := NewEngine(bundle.Policies)
+	require.NoError(t, err)
+	for _, failure := range engine.RunFixtures(bundle.Fixtures) {
+		t.Error(failure)
+	}
+}
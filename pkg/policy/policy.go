@@ -0,0 +1,215 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// Effect はポリシーの条件を満たした場合の判定
+type Effect string
+
+const (
+	EffectAllow Effect = "allow" // 許可する
+	EffectDeny  Effect = "deny"  // 拒否する（許可するポリシーより優先する）
+)
+
+// costLimit は 1 回の評価で許す CEL の計算量の上限（長すぎる評価で応答が止まらないようにする）
+const costLimit = 100000
+
+// Policy は、操作に対して条件を満たす場合の判定を定めるポリシー
+type Policy struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Effect      Effect   `yaml:"effect"`
+	Actions     []string `yaml:"actions"`   // 対象の操作。"*" はすべての操作、"users:*" のように末尾が "*" の場合は前方一致
+	Condition   string   `yaml:"condition"` // CEL の式（bool を返す）。省略した場合は常に満たす
+}
+
+// MatchesAction: 操作がポリシーの対象かどうか
+func (p Policy) MatchesAction(action string) bool {
+	for _, pattern := range p.Actions {
+		if pattern == action {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource は操作の対象
+type Resource struct {
+	Type       string         `yaml:"type" json:"type"`
+	ID         string         `yaml:"id" json:"id"`
+	Attributes map[string]any `yaml:"attributes" json:"attributes"`
+}
+
+// Input は評価の入力。CEL の式からは subject・action・resource・context の変数で参照する
+type Input struct {
+	Subject  map[string]any `yaml:"subject"`  // 操作するユーザーの属性とトークンのクレーム
+	Action   string         `yaml:"action"`   // 操作（例: "users:read"）
+	Resource Resource       `yaml:"resource"` // resource.type・resource.id・resource.attributes で参照する
+	Context  map[string]any `yaml:"context"`  // リクエストの情報（接続元 IP アドレスなど）
+}
+
+// Decision は評価の結果
+type Decision struct {
+	Allowed bool
+	Policy  string // 判定を決めたポリシーの名前（該当するポリシーがない場合は空）
+	Reason  string
+}
+
+type compiledPolicy struct {
+	Policy
+	program cel.Program // 条件を省略した場合は nil
+}
+
+// Engine は、ポリシーを評価して操作を許可するかどうかを判定する
+// 拒否するポリシーを優先し、許可するポリシーがない操作は拒否する
+type Engine struct {
+	policies []compiledPolicy
+}
+
+// newEnv は、ポリシーの条件を評価する CEL の環境を作成する
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("subject", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("action", cel.StringType),
+		cel.Variable("resource", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("context", cel.MapType(cel.StringType, cel.DynType)),
+	)
+}
+
+// NewEngine はポリシーの条件をコンパイルして Engine を作成する。不正なポリシーはまとめてエラーで返す
+func NewEngine(policies []Policy) (*Engine, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, errs.NewPkgError(err.Error())
+	}
+
+	engine := &Engine{policies: make([]compiledPolicy, 0, len(policies))}
+	names := make(map[string]struct{}, len(policies))
+	var problems []error
+	for _, p := range policies {
+		compiled, err := compile(env, p)
+		if err == nil {
+			if _, ok := names[p.Name]; ok {
+				err = errors.New("duplicate policy name")
+			}
+		}
+		if err != nil {
+			problems = append(problems, fmt.Errorf("policy %q: %w", p.Name, err))
+			continue
+		}
+		names[p.Name] = struct{}{}
+		engine.policies = append(engine.policies, compiled)
+	}
+	if len(problems) > 0 {
+		return nil, errs.NewPkgError(errors.Join(problems...).Error())
+	}
+	return engine, nil
+}
+
+func compile(env *cel.Env, p Policy) (compiledPolicy, error) {
+	if p.Name == "" {
+		return compiledPolicy{}, errors.New("name is required")
+	}
+	if p.Effect != EffectAllow && p.Effect != EffectDeny {
+		return compiledPolicy{}, fmt.Errorf("unknown effect: %q", p.Effect)
+	}
+	if len(p.Actions) == 0 {
+		return compiledPolicy{}, errors.New("actions are required")
+	}
+	if strings.TrimSpace(p.Condition) == "" {
+		return compiledPolicy{Policy: p}, nil
+	}
+
+	ast, issues := env.Compile(p.Condition)
+	if issues != nil && issues.Err() != nil {
+		return compiledPolicy{}, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return compiledPolicy{}, fmt.Errorf("condition must return bool, got %s", ast.OutputType())
+	}
+	program, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return compiledPolicy{}, err
+	}
+	return compiledPolicy{Policy: p, program: program}, nil
+}
+
+// Policies: 評価するポリシーの一覧
+func (e *Engine) Policies() []Policy {
+	policies := make([]Policy, 0, len(e.policies))
+	for _, p := range e.policies {
+		policies = append(policies, p.Policy)
+	}
+	return policies
+}
+
+// Evaluate は入力に対するポリシーを評価する
+// 条件の評価に失敗した場合、拒否するポリシーは満たしたもの、許可するポリシーは満たさないものとして扱う
+func (e *Engine) Evaluate(input Input) Decision {
+	vars := map[string]any{
+		"subject": nonNilMap(input.Subject),
+		"action":  input.Action,
+		"resource": map[string]any{
+			"type":       input.Resource.Type,
+			"id":         input.Resource.ID,
+			"attributes": nonNilMap(input.Resource.Attributes),
+		},
+		"context": nonNilMap(input.Context),
+	}
+
+	var allowedBy string
+	for _, p := range e.policies {
+		if !p.MatchesAction(input.Action) {
+			continue
+		}
+		satisfied, err := p.evaluate(vars)
+		if err != nil && p.Effect == EffectDeny {
+			return Decision{Allowed: false, Policy: p.Name, Reason: "policy evaluation failed: " + err.Error()}
+		}
+		if err != nil || !satisfied {
+			continue
+		}
+		if p.Effect == EffectDeny {
+			return Decision{Allowed: false, Policy: p.Name, Reason: "denied by policy"}
+		}
+		if allowedBy == "" {
+			allowedBy = p.Name
+		}
+	}
+	if allowedBy == "" {
+		return Decision{Allowed: false, Reason: "no policy allows the action"}
+	}
+	return Decision{Allowed: true, Policy: allowedBy, Reason: "allowed by policy"}
+}
+
+func (p compiledPolicy) evaluate(vars map[string]any) (bool, error) {
+	if p.program == nil {
+		return true, nil
+	}
+	out, _, err := p.program.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+	satisfied, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("condition returned %s", out.Type().TypeName())
+	}
+	return satisfied, nil
+}
+
+func nonNilMap(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+	return m
+}
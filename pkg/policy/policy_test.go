@@ -0,0 +1,99 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEngine(t *testing.T, policies ...Policy) *Engine {
+	t.Helper()
+	engine, err := NewEngine(policies)
+	require.NoError(t, err)
+	return engine
+}
+
+func TestPolicyMatchesAction(t *testing.T) {
+	p := Policy{Actions: []string{"users:read", "profile:*"}}
+	assert.True(t, p.MatchesAction("users:read"))
+	assert.True(t, p.MatchesAction("profile:delete"))
+	assert.False(t, p.MatchesAction("users:write"))
+	assert.True(t, Policy{Actions: []string{"*"}}.MatchesAction("roles:write"), "\"*\" はすべての操作に一致すること")
+}
+
+func TestNewEngine_Invalid(t *testing.T) {
+	cases := map[string]Policy{
+		"名前がない":      {Effect: EffectAllow, Actions: []string{"*"}},
+		"未知の判定":      {Name: "p", Effect: "maybe", Actions: []string{"*"}},
+		"操作がない":      {Name: "p", Effect: EffectAllow},
+		"式の構文が誤り":    {Name: "p", Effect: EffectAllow, Actions: []string{"*"}, Condition: "subject.uid =="},
+		"未定義の変数":     {Name: "p", Effect: EffectAllow, Actions: []string{"*"}, Condition: "user.uid == 'a'"},
+		"bool を返さない": {Name: "p", Effect: EffectAllow, Actions: []string{"*"}, Condition: "action + '!'"},
+	}
+	for name, p := range cases {
+		_, err := NewEngine([]Policy{p})
+		assert.Error(t, err, name)
+	}
+
+	_, err := NewEngine([]Policy{
+		{Name: "p", Effect: EffectAllow, Actions: []string{"*"}},
+		{Name: "p", Effect: EffectDeny, Actions: []string{"*"}},
+	})
+	assert.ErrorContains(t, err, "duplicate policy name", "同じ名前のポリシーはエラーになること")
+}
+
+func TestEvaluate(t *testing.T) {
+	engine := newTestEngine(t,
+		Policy{Name: "owner", Effect: EffectAllow, Actions: []string{"documents:*"}, Condition: "resource.attributes.owner == subject.uid"},
+		Policy{Name: "readers", Effect: EffectAllow, Actions: []string{"documents:read"}, Condition: `"reader" in subject.roles`},
+		Policy{Name: "office-only", Effect: EffectDeny, Actions: []string{"documents:write"}, Condition: `!context.ip.startsWith("10.")`},
+	)
+	subject := map[string]any{"uid": "u-1", "roles": []any{"user"}}
+	document := Resource{Type: "document", ID: "d-1", Attributes: map[string]any{"owner": "u-1"}}
+
+	decision := engine.Evaluate(Input{Subject: subject, Action: "documents:read", Resource: document})
+	assert.Equal(t, Decision{Allowed: true, Policy: "owner", Reason: "allowed by policy"}, decision)
+
+	decision = engine.Evaluate(Input{Subject: subject, Action: "documents:write", Resource: document, Context: map[string]any{"ip": "203.0.113.1"}})
+	assert.False(t, decision.Allowed, "拒否するポリシーを優先すること")
+	assert.Equal(t, "office-only", decision.Policy)
+
+	decision = engine.Evaluate(Input{Subject: subject, Action: "documents:write", Resource: document, Context: map[string]any{"ip": "10.0.0.1"}})
+	assert.True(t, decision.Allowed)
+
+	decision = engine.Evaluate(Input{Subject: subject, Action: "documents:read", Resource: Resource{Type: "document", Attributes: map[string]any{"owner": "u-2"}}})
+	assert.Equal(t, Decision{Allowed: false, Reason: "no policy allows the action"}, decision, "許可するポリシーがなければ拒否すること")
+
+	decision = engine.Evaluate(Input{Subject: map[string]any{"uid": "u-1", "roles": []any{"reader"}}, Action: "documents:read"})
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, "readers", decision.Policy)
+}
+
+func TestEvaluate_Error(t *testing.T) {
+	engine := newTestEngine(t,
+		Policy{Name: "allow-team", Effect: EffectAllow, Actions: []string{"*"}, Condition: "subject.team == resource.attributes.team"},
+		Policy{Name: "deny-blocked", Effect: EffectDeny, Actions: []string{"documents:write"}, Condition: "subject.blocked"},
+	)
+
+	// 属性が存在しない場合、許可するポリシーは満たさないものとして扱う
+	decision := engine.Evaluate(Input{Subject: map[string]any{}, Action: "documents:read"})
+	assert.False(t, decision.Allowed)
+	assert.Empty(t, decision.Policy)
+
+	// 拒否するポリシーの評価に失敗した場合は拒否する
+	decision = engine.Evaluate(Input{
+		Subject:  map[string]any{"team": "a"},
+		Action:   "documents:write",
+		Resource: Resource{Attributes: map[string]any{"team": "a"}},
+	})
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, "deny-blocked", decision.Policy)
+	assert.Contains(t, decision.Reason, "policy evaluation failed")
+}
+
+func TestEvaluate_NoCondition(t *testing.T) {
+	engine := newTestEngine(t, Policy{Name: "public", Effect: EffectAllow, Actions: []string{"health:read"}})
+	assert.True(t, engine.Evaluate(Input{Action: "health:read"}).Allowed, "条件を省略したポリシーは常に満たすこと")
+	assert.False(t, engine.Evaluate(Input{Action: "users:read"}).Allowed)
+}
@@ -0,0 +1,65 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// Route は、ポリシーで判定するルートと、ルートに対応する操作・対象の種類
+type Route struct {
+	Method       string // "*" はすべてのメソッドに一致
+	Path         string // 完全一致。末尾が "/*" の場合はその配下のパスに一致
+	Action       string
+	ResourceType string
+}
+
+// Matches: リクエストがルートの対象かどうか
+func (r Route) Matches(method string, path string) bool {
+	if r.Method != "*" && !strings.EqualFold(r.Method, method) {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(r.Path, "/*"); ok {
+		return strings.HasPrefix(path, prefix+"/") && len(path) > len(prefix)+1
+	}
+	return r.Path == path
+}
+
+// ParseRoutes は "METHOD /path=ACTION[@RESOURCE_TYPE]" を ";" で区切った文字列からルートを作成する
+// 例: "DELETE /api/v1/profile=profile:delete@user; GET /api/v1/admin/*=admin:read"
+func ParseRoutes(s string) ([]Route, error) {
+	var routes []Route
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, err := parseRoute(entry)
+		if err != nil {
+			return nil, errs.NewPkgError(fmt.Sprintf("invalid policy route %q: %s", entry, err.Error()))
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+func parseRoute(entry string) (Route, error) {
+	route, spec, ok := strings.Cut(entry, "=")
+	if !ok {
+		return Route{}, fmt.Errorf("missing '='")
+	}
+	method, path, ok := strings.Cut(strings.TrimSpace(route), " ")
+	path = strings.TrimSpace(path)
+	if !ok || method == "" || !strings.HasPrefix(path, "/") {
+		return Route{}, fmt.Errorf("route must be \"METHOD /path\"")
+	}
+
+	action, resourceType, _ := strings.Cut(strings.TrimSpace(spec), "@")
+	action = strings.TrimSpace(action)
+	if action == "" {
+		return Route{}, fmt.Errorf("action is required")
+	}
+
+	return Route{Method: strings.ToUpper(method), Path: path, Action: action, ResourceType: strings.TrimSpace(resourceType)}, nil
+}
@@ -0,0 +1,43 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRoutes(t *testing.T) {
+	routes, err := ParseRoutes("DELETE /api/v1/profile=profile:delete@user; get /api/v1/reports/*=reports:read")
+	assert.NoError(t, err)
+	assert.Equal(t, []Route{
+		{Method: "DELETE", Path: "/api/v1/profile", Action: "profile:delete", ResourceType: "user"},
+		{Method: "GET", Path: "/api/v1/reports/*", Action: "reports:read"},
+	}, routes)
+
+	routes, err = ParseRoutes("")
+	assert.NoError(t, err)
+	assert.Empty(t, routes)
+}
+
+func TestParseRoutes_Invalid(t *testing.T) {
+	for _, s := range []string{
+		"DELETE /api/v1/profile",         // 操作がない
+		"/api/v1/profile=profile:delete", // メソッドがない
+		"DELETE profile=profile:delete",  // パスが / で始まらない
+		"DELETE /api/v1/profile=@user",   // 操作が空
+	} {
+		_, err := ParseRoutes(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestRouteMatches(t *testing.T) {
+	route := Route{Method: "*", Path: "/api/v1/reports/*"}
+	assert.True(t, route.Matches("GET", "/api/v1/reports/1"))
+	assert.False(t, route.Matches("GET", "/api/v1/reports"))
+	assert.False(t, route.Matches("GET", "/api/v1/reports/"))
+
+	route = Route{Method: "DELETE", Path: "/api/v1/profile"}
+	assert.True(t, route.Matches("delete", "/api/v1/profile"))
+	assert.False(t, route.Matches("GET", "/api/v1/profile"))
+}
@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// Driver はリクエスト数の保存先
+type Driver string
+
+const (
+	DriverMemory Driver = "memory" // プロセス内のメモリ（既定、単一インスタンス用）
+	DriverRedis  Driver = "redis"  // Redis（複数インスタンスで共有）
+)
+
+// DefaultRules は既定のレート制限の規則（ログインなど総当たりの対象になるエンドポイントは厳しく制限する）
+// 認証に失敗するリクエストも含めて、接続元 IP アドレスごとの全体の上限も設ける
+const DefaultRules = "POST /api/v1/auth/login=20/1m:ip;" +
+	"POST /api/v1/auth/passwordless/start=5/1m:ip;" +
+	"POST /api/v1/auth/passwordless/verify=20/1m:ip;" +
+	"POST /api/v1/auth/mfa/verify=20/1m:ip;" +
+	"POST /api/v1/auth/email/verify/resend=5/1m:ip;" +
+	"POST /api/v1/auth/register=10/1m:ip;" +
+	"* /api/v1/*=600/1m:ip;" +
+	"* /api/v1/*=300/1m:user"
+
+type Config struct {
+	Enabled  bool
+	Driver   Driver
+	Rules    string // ParseRules の形式
+	FailOpen bool   // 保存先に接続できない場合にリクエストを許可する（false の場合は 503 を返す）
+	Redis    *RedisConfig
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		Enabled:  utils.GetEnvBool("RATE_LIMIT_ENABLED", true),
+		Driver:   Driver(utils.GetEnvDefault("RATE_LIMIT_DRIVER", string(DriverMemory))),
+		Rules:    utils.GetEnvDefault("RATE_LIMIT_RULES", DefaultRules),
+		FailOpen: utils.GetEnvBool("RATE_LIMIT_FAIL_OPEN", true),
+		Redis: &RedisConfig{
+			Addr:     utils.GetEnvDefault("RATE_LIMIT_REDIS_ADDR", "localhost:6379"),
+			Password: utils.GetEnvDefault("RATE_LIMIT_REDIS_PASSWORD", ""),
+			DB:       utils.GetEnvInt("RATE_LIMIT_REDIS_DB", 0),
+			PoolSize: utils.GetEnvInt("RATE_LIMIT_REDIS_POOL_SIZE", 10),
+			Timeout:  utils.GetEnvDuration("RATE_LIMIT_REDIS_TIMEOUT", 500*time.Millisecond),
+		},
+	}
+}
+
+// NewStore は設定された保存先の Store を作成する
+func NewStore(config *Config) (Store, error) {
+	switch config.Driver {
+	case DriverMemory:
+		return NewMemoryStore(), nil
+	case DriverRedis:
+		return NewRedisStore(config.Redis), nil
+	default:
+		return nil, errs.NewPkgError(fmt.Sprintf("unknown rate limit driver: %s", config.Driver))
+	}
+}
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// memoryCounter は、キーごとの現在と直前の固定ウィンドウのリクエスト数
+type memoryCounter struct {
+	index     int64
+	prev      int64
+	curr      int64
+	expiresAt time.Time // 直前のウィンドウとしても使われなくなる日時
+}
+
+// memoryStore は、プロセス内のメモリでリクエスト数を保持する Store の実装（単一インスタンス用）
+type memoryStore struct {
+	mu        sync.Mutex
+	counters  map[string]*memoryCounter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore は、メモリ上でリクエスト数を保持する Store を返します。
+func NewMemoryStore() Store {
+	return &memoryStore{
+		counters: make(map[string]*memoryCounter),
+		now:      time.Now,
+	}
+}
+
+func (s *memoryStore) Take(key string, limit int, window time.Duration) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	index := windowIndex(now, window)
+	counter, ok := s.counters[key]
+	switch {
+	case !ok:
+		counter = &memoryCounter{index: index}
+		s.counters[key] = counter
+	case counter.index == index-1:
+		counter.index, counter.prev, counter.curr = index, counter.curr, 0
+	case counter.index != index:
+		counter.index, counter.prev, counter.curr = index, 0, 0
+	}
+	counter.curr++
+	counter.expiresAt = time.Unix(0, (index+2)*window.Nanoseconds())
+
+	return evaluate(now, limit, window, counter.prev, counter.curr), nil
+}
+
+// sweep は使われなくなったキーを定期的に削除する
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for key, counter := range s.counters {
+		if !now.Before(counter.expiresAt) {
+			delete(s.counters, key)
+		}
+	}
+}
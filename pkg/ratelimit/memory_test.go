@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMemoryStore(now *time.Time) *memoryStore {
+	store := NewMemoryStore().(*memoryStore)
+	store.now = func() time.Time { return *now }
+	return store
+}
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	store := newTestMemoryStore(&now)
+
+	for i := 1; i <= 3; i++ {
+		result, err := store.Take("ip:192.0.2.1", 3, time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 3, result.Limit)
+		assert.Equal(t, 3-i, result.Remaining)
+	}
+
+	// 制限を超えたリクエストは拒否する
+	result, err := store.Take("ip:192.0.2.1", 3, time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	// キーごとに数える
+	result, err = store.Take("ip:192.0.2.2", 3, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
+
+func TestMemoryStore_SlidingWindow(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	store := newTestMemoryStore(&now)
+
+	for i := 0; i < 4; i++ {
+		_, _ = store.Take("user:1", 4, time.Minute)
+	}
+
+	// 次のウィンドウの開始直後は直前のリクエスト数が残っている
+	now = now.Add(time.Minute)
+	result, err := store.Take("user:1", 4, time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+
+	// ウィンドウの 3/4 が経過すると直前のリクエスト数は 1 として数える
+	now = now.Add(45 * time.Second)
+	result, err = store.Take("user:1", 4, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 1, result.Remaining)
+
+	// 2 ウィンドウ以上経過するとリセットされる
+	now = now.Add(3 * time.Minute)
+	result, err = store.Take("user:1", 4, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 3, result.Remaining)
+}
+
+func TestMemoryStore_Sweep(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	store := newTestMemoryStore(&now)
+
+	_, _ = store.Take("ip:192.0.2.1", 3, time.Minute)
+	now = now.Add(5 * time.Minute)
+	_, _ = store.Take("ip:192.0.2.2", 3, time.Minute)
+
+	// 使われなくなったキーは削除される
+	assert.NotContains(t, store.counters, "ip:192.0.2.1")
+	assert.Contains(t, store.counters, "ip:192.0.2.2")
+}
@@ -0,0 +1,158 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// KeyType はリクエストを数える単位
+type KeyType string
+
+const (
+	KeyIP     KeyType = "ip"     // 接続元 IP アドレスごと
+	KeyUser   KeyType = "user"   // 認証済みユーザーごと（未認証のリクエストは IP アドレスごと）
+	KeyAPIKey KeyType = "apikey" // API キーごと（API キーのないリクエストは IP アドレスごと）
+)
+
+// Rule はレート制限の規則（Window の間に Limit 回までリクエストを許可する）
+type Rule struct {
+	Method string // "*" はすべてのメソッドに一致
+	Path   string // 完全一致。末尾が "/*" の場合はその配下のパスに一致
+	Limit  int
+	Window time.Duration
+	Key    KeyType
+}
+
+// Matches: リクエストが規則の対象かどうか
+func (r Rule) Matches(method string, path string) bool {
+	if r.Method != "*" && !strings.EqualFold(r.Method, method) {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(r.Path, "/*"); ok {
+		return strings.HasPrefix(path, prefix+"/") && len(path) > len(prefix)+1
+	}
+	return r.Path == path
+}
+
+// Route: 規則の対象（"METHOD /path"）。規則ごとに使用量を分けるためのキーにも使う
+func (r Rule) Route() string {
+	return r.Method + " " + r.Path
+}
+
+// Policy: RateLimit-Policy ヘッダーの値（例: "10;w=60"）
+func (r Rule) Policy() string {
+	return fmt.Sprintf("%d;w=%d", r.Limit, int(math.Ceil(r.Window.Seconds())))
+}
+
+// SplitRules は、IP アドレスごとに数える規則とそれ以外（ユーザー・API キーごと）の規則に分ける
+// IP アドレスごとの規則は認証の前に適用し、認証に失敗したリクエストも数えられるようにする
+func SplitRules(rules []Rule) (byIP []Rule, byIdentity []Rule) {
+	for _, rule := range rules {
+		if rule.Key == KeyIP {
+			byIP = append(byIP, rule)
+		} else {
+			byIdentity = append(byIdentity, rule)
+		}
+	}
+	return byIP, byIdentity
+}
+
+// ParseRules は "METHOD /path=LIMIT/WINDOW[:KEY]" を ";" で区切った文字列から規則を作成する
+// 例: "POST /api/v1/auth/login=10/1m:ip; * /api/v1/*=300/1m:user"（KEY を省略した場合は ip）
+func ParseRules(s string) ([]Rule, error) {
+	var rules []Rule
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		rule, err := parseRule(entry)
+		if err != nil {
+			return nil, errs.NewPkgError(fmt.Sprintf("invalid rate limit rule %q: %s", entry, err.Error()))
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func parseRule(entry string) (Rule, error) {
+	route, spec, ok := strings.Cut(entry, "=")
+	if !ok {
+		return Rule{}, fmt.Errorf("missing '='")
+	}
+	method, path, ok := strings.Cut(strings.TrimSpace(route), " ")
+	path = strings.TrimSpace(path)
+	if !ok || method == "" || !strings.HasPrefix(path, "/") {
+		return Rule{}, fmt.Errorf("route must be \"METHOD /path\"")
+	}
+
+	spec, key, hasKey := strings.Cut(strings.TrimSpace(spec), ":")
+	keyType := KeyIP
+	if hasKey {
+		keyType = KeyType(strings.TrimSpace(key))
+	}
+	switch keyType {
+	case KeyIP, KeyUser, KeyAPIKey:
+	default:
+		return Rule{}, fmt.Errorf("unknown key %q", keyType)
+	}
+
+	limitStr, windowStr, ok := strings.Cut(spec, "/")
+	if !ok {
+		return Rule{}, fmt.Errorf("limit must be \"LIMIT/WINDOW\"")
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(limitStr))
+	if err != nil || limit <= 0 {
+		return Rule{}, fmt.Errorf("limit must be a positive integer")
+	}
+	window, err := time.ParseDuration(strings.TrimSpace(windowStr))
+	if err != nil || window <= 0 {
+		return Rule{}, fmt.Errorf("window must be a positive duration")
+	}
+
+	return Rule{Method: strings.ToUpper(method), Path: path, Limit: limit, Window: window, Key: keyType}, nil
+}
+
+// Result はリクエストを数えた結果
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	Reset     time.Duration // 現在のウィンドウが終わるまでの時間
+}
+
+// Store はキーごとのリクエスト数を保持する
+type Store interface {
+	// Take: key のリクエストを 1 回数え、limit を超えていないかを返す（超えた場合も数える）
+	Take(key string, limit int, window time.Duration) (*Result, error)
+}
+
+// windowIndex は now を含む固定ウィンドウの番号を返す
+func windowIndex(now time.Time, window time.Duration) int64 {
+	return now.UnixNano() / window.Nanoseconds()
+}
+
+// evaluate は、現在と直前の固定ウィンドウのリクエスト数からスライディングウィンドウでのリクエスト数を見積もり、結果を返す
+// 直前のウィンドウのリクエスト数は、現在のウィンドウの経過割合に応じて減らして数える。
+func evaluate(now time.Time, limit int, window time.Duration, prev int64, curr int64) *Result {
+	start := time.Unix(0, windowIndex(now, window)*window.Nanoseconds())
+	elapsed := now.Sub(start)
+	weight := 1 - float64(elapsed)/float64(window)
+	count := int64(math.Floor(float64(prev)*weight)) + curr
+
+	remaining := int64(limit) - count
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &Result{
+		Allowed:   count <= int64(limit),
+		Limit:     limit,
+		Remaining: int(remaining),
+		Reset:     window - elapsed,
+	}
+}
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules("POST /api/v1/auth/login=10/1m:ip; * /api/v1/*=300/1m:user;get /api/v1/keys=5/30s")
+	assert.NoError(t, err)
+	assert.Equal(t, []Rule{
+		{Method: "POST", Path: "/api/v1/auth/login", Limit: 10, Window: time.Minute, Key: KeyIP},
+		{Method: "*", Path: "/api/v1/*", Limit: 300, Window: time.Minute, Key: KeyUser},
+		{Method: "GET", Path: "/api/v1/keys", Limit: 5, Window: 30 * time.Second, Key: KeyIP},
+	}, rules)
+
+	// 既定の規則は解釈できること
+	_, err = ParseRules(DefaultRules)
+	assert.NoError(t, err)
+
+	rules, err = ParseRules("")
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+}
+
+func TestParseRules_Invalid(t *testing.T) {
+	for _, s := range []string{
+		"POST /login",               // 制限がない
+		"/login=10/1m",              // メソッドがない
+		"POST login=10/1m",          // パスが / で始まらない
+		"POST /login=10",            // ウィンドウがない
+		"POST /login=0/1m",          // 制限が 0
+		"POST /login=ten/1m",        // 制限が数値でない
+		"POST /login=10/minute",     // ウィンドウが期間でない
+		"POST /login=10/1m:session", // 未知のキー
+	} {
+		_, err := ParseRules(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestSplitRules(t *testing.T) {
+	rules, err := ParseRules("POST /login=10/1m:ip; * /api/*=300/1m:user; GET /keys=5/1m:apikey; * /api/*=600/1m")
+	assert.NoError(t, err)
+	byIP, byIdentity := SplitRules(rules)
+	assert.Equal(t, []Rule{rules[0], rules[3]}, byIP)
+	assert.Equal(t, []Rule{rules[1], rules[2]}, byIdentity)
+}
+
+func TestRule_Matches(t *testing.T) {
+	exact := Rule{Method: "POST", Path: "/api/v1/auth/login"}
+	assert.True(t, exact.Matches("POST", "/api/v1/auth/login"))
+	assert.True(t, exact.Matches("post", "/api/v1/auth/login"))
+	assert.False(t, exact.Matches("GET", "/api/v1/auth/login"))
+	assert.False(t, exact.Matches("POST", "/api/v1/auth/login/extra"))
+
+	wildcard := Rule{Method: "*", Path: "/api/v1/*"}
+	assert.True(t, wildcard.Matches("GET", "/api/v1/profile"))
+	assert.True(t, wildcard.Matches("DELETE", "/api/v1/profile/passkeys"))
+	assert.False(t, wildcard.Matches("GET", "/api/v1"))
+	assert.False(t, wildcard.Matches("GET", "/api/v1/"))
+	assert.False(t, wildcard.Matches("GET", "/health"))
+}
+
+func TestRule_Policy(t *testing.T) {
+	assert.Equal(t, "10;w=60", Rule{Limit: 10, Window: time.Minute}.Policy())
+	assert.Equal(t, "5;w=1", Rule{Limit: 5, Window: 500 * time.Millisecond}.Policy())
+	assert.Equal(t, "POST /login", Rule{Method: "POST", Path: "/login"}.Route())
+}
+
+func TestEvaluate(t *testing.T) {
+	window := time.Minute
+	start := time.Unix(0, windowIndex(time.Now(), window)*window.Nanoseconds())
+
+	// ウィンドウの開始直後は直前のウィンドウのリクエスト数をそのまま数える
+	result := evaluate(start, 10, window, 10, 1)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+	assert.Equal(t, window, result.Reset)
+
+	// ウィンドウの半分が経過すると直前のウィンドウのリクエスト数は半分として数える
+	result = evaluate(start.Add(30*time.Second), 10, window, 10, 1)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 4, result.Remaining)
+	assert.Equal(t, 30*time.Second, result.Reset)
+}
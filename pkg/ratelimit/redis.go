@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+type RedisConfig struct {
+	Addr     string        // host:port
+	Password string        // 空の場合は AUTH しない
+	DB       int           // 使用するデータベースの番号
+	PoolSize int           // 再利用する接続の最大数
+	Timeout  time.Duration // 接続・コマンドのタイムアウト
+}
+
+// redisStore は、Redis でリクエスト数を保持する Store の実装
+// 複数のインスタンスで使用量を共有できる。
+type redisStore struct {
+	client  *redis.Client
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// NewRedisStore は、Redis でリクエスト数を保持する Store を返します。
+func NewRedisStore(config *RedisConfig) Store {
+	return &redisStore{
+		client: redis.NewClient(&redis.Options{
+			Addr:         config.Addr,
+			Password:     config.Password,
+			DB:           config.DB,
+			PoolSize:     config.PoolSize,
+			DialTimeout:  config.Timeout,
+			ReadTimeout:  config.Timeout,
+			WriteTimeout: config.Timeout,
+			// リクエストの処理中に数えるため、失敗した場合は再試行せずにすぐ返す
+			MaxRetries: -1,
+		}),
+		timeout: config.Timeout,
+		now:     time.Now,
+	}
+}
+
+func (s *redisStore) Take(key string, limit int, window time.Duration) (*Result, error) {
+	ctx := context.Background()
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	now := s.now()
+	index := windowIndex(now, window)
+	currKey := fmt.Sprintf("ratelimit:%s:%d", key, index)
+	prevKey := fmt.Sprintf("ratelimit:%s:%d", key, index-1)
+
+	// INCR は原子的に加算されるため、複数のインスタンスからの同時リクエストも取りこぼさない
+	pipe := s.client.Pipeline()
+	incr := pipe.Incr(ctx, currKey)
+	pipe.PExpire(ctx, currKey, 2*window)
+	get := pipe.Get(ctx, prevKey)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.NewPkgError(fmt.Errorf("redis: %w", err).Error())
+	}
+
+	prev, err := get.Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.NewPkgError(fmt.Errorf("redis: unexpected GET reply: %w", err).Error())
+	}
+	return evaluate(now, limit, window, prev, incr.Val()), nil
+}
@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedisStore(addr string, password string, now *time.Time) *redisStore {
+	store := NewRedisStore(&RedisConfig{Addr: addr, Password: password, DB: 1, PoolSize: 2, Timeout: time.Second}).(*redisStore)
+	store.now = func() time.Time { return *now }
+	return store
+}
+
+func TestRedisStore_Take(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	now := time.Unix(1_800_000_000, 0)
+	store := newTestRedisStore(server.Addr(), "secret", &now)
+
+	for i := 1; i <= 3; i++ {
+		result, err := store.Take("ip:192.0.2.1", 3, time.Minute)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 3-i, result.Remaining)
+	}
+	result, err := store.Take("ip:192.0.2.1", 3, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+
+	// 次のウィンドウでは直前のウィンドウのリクエスト数を重み付けして数える
+	now = now.Add(90 * time.Second)
+	result, err = store.Take("ip:192.0.2.1", 3, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	// キーには 2 ウィンドウ分の有効期限を設定し、指定したデータベースに保存する
+	server.Select(1)
+	index := windowIndex(now, time.Minute)
+	assert.Equal(t, 2*time.Minute, server.TTL(fmt.Sprintf("ratelimit:ip:192.0.2.1:%d", index)))
+}
+
+func TestRedisStore_WrongPassword(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	now := time.Now()
+	store := newTestRedisStore(server.Addr(), "wrong", &now)
+
+	_, err := store.Take("ip:192.0.2.1", 3, time.Minute)
+	assert.Error(t, err)
+}
+
+func TestRedisStore_Unavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	_ = listener.Close()
+
+	now := time.Now()
+	store := newTestRedisStore(addr, "", &now)
+	_, err = store.Take("ip:192.0.2.1", 3, time.Minute)
+	assert.Error(t, err)
+}
+
+func TestNewStore(t *testing.T) {
+	store, err := NewStore(&Config{Driver: DriverMemory})
+	assert.NoError(t, err)
+	assert.IsType(t, &memoryStore{}, store)
+
+	store, err = NewStore(&Config{Driver: DriverRedis, Redis: &RedisConfig{Addr: "localhost:6379"}})
+	assert.NoError(t, err)
+	assert.IsType(t, &redisStore{}, store)
+
+	_, err = NewStore(&Config{Driver: "memcached"})
+	assert.Error(t, err)
+}
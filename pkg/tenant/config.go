@@ -0,0 +1,43 @@
+package tenant
+
+import (
+	"os"
+	"strings"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+type Config struct {
+	Tenants    string            // ParseTenants の形式（空の場合は DefaultID のテナントのみ）
+	Header     string            // テナントのIDを指定するリクエストヘッダー（空の場合はヘッダーでは指定できない）
+	Default    string            // ホスト名・ヘッダーから決まらない場合のテナント（空の場合はリクエストを拒否する）
+	SecretKey  string            // テナントの鍵を指定しない場合に、テナントの鍵を導出する元の鍵
+	SecretKeys map[string]string // テナントごとの鍵（キーは SecretKeyEnv の形式の環境変数名）
+}
+
+func NewConfigFromEnv() *Config {
+	return &Config{
+		Tenants:    utils.GetEnvDefault("TENANTS", ""),
+		Header:     utils.GetEnvDefault("TENANT_HEADER", "X-Tenant-ID"),
+		Default:    utils.GetEnvDefault("TENANT_DEFAULT", ""),
+		SecretKey:  utils.GetEnvDefault("JWT_SECRET_KEY", ""),
+		SecretKeys: secretKeysFromEnv(),
+	}
+}
+
+// SecretKeyEnv は、テナントの鍵を指定する環境変数の名前（TENANT_<ID>_JWT_SECRET_KEY、ID は大文字にし "-" を "_" にする）を返します。
+func SecretKeyEnv(tenantID string) string {
+	return "TENANT_" + strings.ToUpper(strings.ReplaceAll(tenantID, "-", "_")) + "_JWT_SECRET_KEY"
+}
+
+// secretKeysFromEnv は、環境変数からテナントごとの鍵を集める
+func secretKeysFromEnv() map[string]string {
+	keys := make(map[string]string)
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if strings.HasPrefix(name, "TENANT_") && strings.HasSuffix(name, "_JWT_SECRET_KEY") && value != "" {
+			keys[name] = value
+		}
+	}
+	return keys
+}
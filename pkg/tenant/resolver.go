@@ -0,0 +1,142 @@
+package tenant
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// テナントのIDは英小文字・数字で始まる英小文字・数字・"-"（64 文字以内）
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,63}$`)
+
+// Resolver は、リクエストのヘッダー・ホスト名からテナントを決める
+type Resolver struct {
+	tenants  []*Tenant
+	byID     map[string]*Tenant
+	byHost   map[string]*Tenant
+	header   string
+	fallback *Tenant
+}
+
+// NewResolver は、設定されたテナントを読み込んだ Resolver を作成します。
+// テナントを設定しない場合は、既定の鍵を使う DefaultID のテナントのみを持ち、すべてのリクエストをそのテナントとして扱います。
+func NewResolver(config *Config) (*Resolver, error) {
+	r := &Resolver{
+		byID:   make(map[string]*Tenant),
+		byHost: make(map[string]*Tenant),
+		header: config.Header,
+	}
+
+	if strings.TrimSpace(config.Tenants) == "" {
+		t := &Tenant{ID: DefaultID, Tokens: utils.DefaultTokenSigner()}
+		r.tenants = []*Tenant{t}
+		r.byID[t.ID] = t
+		r.fallback = t
+		return r, nil
+	}
+
+	tenants, err := ParseTenants(config.Tenants)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tenants {
+		if _, ok := r.byID[t.ID]; ok {
+			return nil, errs.NewPkgError(fmt.Sprintf("duplicate tenant %q", t.ID))
+		}
+		secret, err := secretKey(config, t.ID)
+		if err != nil {
+			return nil, err
+		}
+		t.Tokens = utils.NewTokenSigner(secret, t.ID)
+		r.tenants = append(r.tenants, t)
+		r.byID[t.ID] = t
+		for _, host := range t.Hosts {
+			if other, ok := r.byHost[host]; ok {
+				return nil, errs.NewPkgError(fmt.Sprintf("host %q is assigned to both tenant %q and %q", host, other.ID, t.ID))
+			}
+			r.byHost[host] = t
+		}
+	}
+	if config.Default != "" {
+		fallback, ok := r.byID[config.Default]
+		if !ok {
+			return nil, errs.NewPkgError(fmt.Sprintf("default tenant %q is not configured", config.Default))
+		}
+		r.fallback = fallback
+	}
+	return r, nil
+}
+
+// ParseTenants は "ID=HOST,HOST;ID=HOST" 形式（ホスト名は省略可）のテナントの一覧を読み込みます。
+// 例: "acme=acme.example.com;globex=globex.example.com,auth.globex.example.com"
+func ParseTenants(s string) ([]*Tenant, error) {
+	var tenants []*Tenant
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, hosts, _ := strings.Cut(entry, "=")
+		id = strings.TrimSpace(id)
+		if !tenantIDPattern.MatchString(id) {
+			return nil, errs.NewPkgError(fmt.Sprintf("invalid tenant %q: id must match %s", entry, tenantIDPattern.String()))
+		}
+		t := &Tenant{ID: id}
+		for _, host := range strings.Split(hosts, ",") {
+			if host = normalizeHost(host); host != "" {
+				t.Hosts = append(t.Hosts, host)
+			}
+		}
+		tenants = append(tenants, t)
+	}
+	return tenants, nil
+}
+
+// Tenants は、設定されたすべてのテナントを返します。
+func (r *Resolver) Tenants() []*Tenant {
+	return r.tenants
+}
+
+// Resolve は、リクエストのテナントを返します（決まらない場合は false）。
+// ヘッダーでテナントが指定された場合はヘッダーを優先し、存在しないテナントであればホスト名にかかわらず false を返します。
+func (r *Resolver) Resolve(req *http.Request) (*Tenant, bool) {
+	if r.header != "" {
+		if id := strings.TrimSpace(req.Header.Get(r.header)); id != "" {
+			t, ok := r.byID[id]
+			return t, ok
+		}
+	}
+	if t, ok := r.byHost[normalizeHost(req.Host)]; ok {
+		return t, true
+	}
+	return r.fallback, r.fallback != nil
+}
+
+// secretKey は、テナントの鍵を返す（指定されていない場合は元の鍵とテナントのIDから導出する）
+func secretKey(config *Config, tenantID string) ([]byte, error) {
+	if secret := config.SecretKeys[SecretKeyEnv(tenantID)]; secret != "" {
+		return []byte(secret), nil
+	}
+	if config.SecretKey == "" {
+		return nil, errs.NewPkgError(fmt.Sprintf("secret key of tenant %q is not set (set %s or JWT_SECRET_KEY)", tenantID, SecretKeyEnv(tenantID)))
+	}
+	mac := hmac.New(sha256.New, []byte(config.SecretKey))
+	mac.Write([]byte("tenant:" + tenantID))
+	return mac.Sum(nil), nil
+}
+
+// normalizeHost は、ホスト名を小文字にしポート番号を取り除く
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
@@ -0,0 +1,156 @@
+package tenant
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+func TestParseTenants(t *testing.T) {
+	tenants, err := ParseTenants("acme=acme.example.com; globex=Globex.example.com:8080,auth.globex.example.com;initech")
+	assert.NoError(t, err)
+	require.Len(t, tenants, 3)
+	assert.Equal(t, &Tenant{ID: "acme", Hosts: []string{"acme.example.com"}}, tenants[0])
+	assert.Equal(t, &Tenant{ID: "globex", Hosts: []string{"globex.example.com", "auth.globex.example.com"}}, tenants[1])
+	assert.Equal(t, &Tenant{ID: "initech"}, tenants[2])
+
+	for _, s := range []string{
+		"=acme.example.com", // ID がない
+		"Acme",              // 大文字を含む
+		"acme_corp",         // 使えない文字を含む
+		"-acme",             // "-" で始まる
+	} {
+		_, err := ParseTenants(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestNewResolver_SingleTenant(t *testing.T) {
+	resolver, err := NewResolver(&Config{Header: "X-Tenant-ID"})
+	assert.NoError(t, err)
+	require.Len(t, resolver.Tenants(), 1)
+	assert.Equal(t, DefaultID, resolver.Tenants()[0].ID)
+	assert.Same(t, utils.DefaultTokenSigner(), resolver.Tenants()[0].Tokens, "テナントを設定しない場合は既定の鍵を使うこと")
+
+	// どのホスト名のリクエストも既定のテナントとして扱う
+	tenant, ok := resolver.Resolve(httptest.NewRequest("GET", "http://anything.example.com/api/v1/profile", nil))
+	assert.True(t, ok)
+	assert.Equal(t, DefaultID, tenant.ID)
+}
+
+func TestNewResolver_Invalid(t *testing.T) {
+	for name, config := range map[string]*Config{
+		"重複したテナント":     {Tenants: "acme;acme", SecretKey: "secret"},
+		"重複したホスト名":     {Tenants: "acme=example.com;globex=example.com", SecretKey: "secret"},
+		"存在しない既定のテナント": {Tenants: "acme", Default: "globex", SecretKey: "secret"},
+		"鍵が設定されていない":   {Tenants: "acme"},
+		"不正なテナントの一覧":   {Tenants: "Acme", SecretKey: "secret"},
+	} {
+		_, err := NewResolver(config)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	resolver, err := NewResolver(&Config{
+		Tenants:   "acme=acme.example.com;globex=globex.example.com",
+		Header:    "X-Tenant-ID",
+		SecretKey: "secret",
+	})
+	require.NoError(t, err)
+
+	resolve := func(host string, header string) (string, bool) {
+		req := httptest.NewRequest("GET", "/api/v1/profile", nil)
+		req.Host = host
+		if header != "" {
+			req.Header.Set("X-Tenant-ID", header)
+		}
+		tenant, ok := resolver.Resolve(req)
+		if !ok {
+			return "", false
+		}
+		return tenant.ID, true
+	}
+
+	id, ok := resolve("acme.example.com", "")
+	assert.True(t, ok)
+	assert.Equal(t, "acme", id)
+	id, ok = resolve("GLOBEX.example.com:443", "")
+	assert.True(t, ok)
+	assert.Equal(t, "globex", id, "ホスト名の大文字小文字とポート番号は無視すること")
+
+	// ヘッダーの指定はホスト名より優先する
+	id, ok = resolve("acme.example.com", "globex")
+	assert.True(t, ok)
+	assert.Equal(t, "globex", id)
+	// 存在しないテナントを指定した場合はホスト名から決めない
+	_, ok = resolve("acme.example.com", "initech")
+	assert.False(t, ok)
+	// 既定のテナントがない場合、ホスト名から決まらないリクエストは拒否する
+	_, ok = resolve("unknown.example.com", "")
+	assert.False(t, ok)
+}
+
+func TestResolver_DefaultTenant(t *testing.T) {
+	resolver, err := NewResolver(&Config{Tenants: "acme=acme.example.com;globex", Default: "globex", SecretKey: "secret"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/api/v1/profile", nil)
+	req.Host = "unknown.example.com"
+	req.Header.Set("X-Tenant-ID", "acme") // ヘッダー名を設定しない場合はヘッダーを使わない
+	tenant, ok := resolver.Resolve(req)
+	assert.True(t, ok)
+	assert.Equal(t, "globex", tenant.ID)
+}
+
+// テナントごとに異なる鍵と受け手でトークンを発行し、他のテナントのトークンを受け付けないこと
+func TestResolver_TokensPerTenant(t *testing.T) {
+	resolver, err := NewResolver(&Config{
+		Tenants:    "acme;globex;initech",
+		SecretKey:  "secret",
+		SecretKeys: map[string]string{SecretKeyEnv("initech"): "initech-secret"},
+	})
+	require.NoError(t, err)
+	tenants := resolver.Tenants()
+	require.Len(t, tenants, 3)
+
+	for _, issuer := range tenants {
+		assert.Equal(t, issuer.ID, issuer.Tokens.Audience())
+		accessToken, _, err := issuer.Tokens.GenerateTokens("123", utils.NewAuthContext(utils.AMRPassword))
+		require.NoError(t, err)
+		for _, verifier := range tenants {
+			_, err := verifier.Tokens.ValidateToken(accessToken)
+			if verifier == issuer {
+				assert.NoError(t, err, issuer.ID)
+			} else {
+				assert.Error(t, err, "%s のトークンを %s で受け付けないこと", issuer.ID, verifier.ID)
+			}
+		}
+	}
+
+	// 導出した鍵は元の鍵が同じであれば変わらない（再起動しても発行済みのトークンを使える）
+	again, err := NewResolver(&Config{Tenants: "acme", SecretKey: "secret"})
+	require.NoError(t, err)
+	accessToken, _, err := tenants[0].Tokens.GenerateTokens("123", utils.NewAuthContext(utils.AMRPassword))
+	require.NoError(t, err)
+	_, err = again.Tenants()[0].Tokens.ValidateToken(accessToken)
+	assert.NoError(t, err)
+}
+
+func TestContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	tenantID, ok := FromContext(NewContext(context.Background(), "acme"))
+	assert.True(t, ok)
+	assert.Equal(t, "acme", tenantID)
+}
+
+func TestSecretKeyEnv(t *testing.T) {
+	assert.Equal(t, "TENANT_ACME_CORP_JWT_SECRET_KEY", SecretKeyEnv("acme-corp"))
+}
@@ -0,0 +1,33 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/goda6565/nexus-user-auth/pkg/utils"
+)
+
+// DefaultID は、テナントを設定しない場合の唯一のテナントのID（テナントを導入する前のデータはこのテナントに属する）
+const DefaultID = "default"
+
+// Tenant は、ユーザーなどのデータを他のテナントと共有しない利用者（顧客）の単位
+type Tenant struct {
+	ID     string
+	Hosts  []string           // このテナントとして扱うホスト名
+	Tokens *utils.TokenSigner // トークンの署名・検証（テナントごとに鍵と受け手が異なる）
+}
+
+type contextKey struct{}
+
+// NewContext は、操作の対象をテナントのデータに限定するコンテキストを返します。
+func NewContext(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenantID)
+}
+
+// FromContext は、コンテキストに設定されたテナントのIDを返します（設定されていない場合は false）。
+func FromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	tenantID, ok := ctx.Value(contextKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
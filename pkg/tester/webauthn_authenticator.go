@@ -0,0 +1,213 @@
+package tester
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+// authenticator data のフラグ（WebAuthn §6.1）
+const (
+	flagUserPresent  byte = 0x01
+	flagUserVerified byte = 0x04
+	flagAttested     byte = 0x40
+)
+
+// SoftwareAuthenticator は、テスト用にメモリ上の鍵で WebAuthn の登録・認証に応答するソフトウェア認証器
+// 実機の代わりに、ブラウザが navigator.credentials.create / get で返す JSON を生成する。
+type SoftwareAuthenticator struct {
+	RPID         string
+	Origin       string // clientDataJSON に記録するオリジン（フィッシングの再現には別のオリジンを指定する）
+	UserVerified bool   // 生体認証・PIN による本人確認を行ったことを示す UV フラグを立てる
+
+	credentials []*SoftwareCredential
+}
+
+// SoftwareCredential は、ソフトウェア認証器が保持するクレデンシャル
+type SoftwareCredential struct {
+	ID         []byte
+	UserHandle []byte
+	SignCount  uint32
+	key        *ecdsa.PrivateKey
+}
+
+func NewSoftwareAuthenticator(rpID string, origin string) *SoftwareAuthenticator {
+	return &SoftwareAuthenticator{
+		RPID:         rpID,
+		Origin:       origin,
+		UserVerified: true,
+	}
+}
+
+// Credentials は作成済みのクレデンシャルを返す
+func (a *SoftwareAuthenticator) Credentials() []*SoftwareCredential {
+	return a.credentials
+}
+
+// Register は登録オプション（CredentialCreation の JSON）に応答し、attestation の JSON を返す
+func (a *SoftwareAuthenticator) Register(options []byte) ([]byte, error) {
+	var creation protocol.CredentialCreation
+	if err := json.Unmarshal(options, &creation); err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	userHandle, err := userHandleBytes(creation.Response.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	credential := &SoftwareCredential{ID: id, UserHandle: userHandle, key: key}
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1, // P-256
+		XCoord: key.PublicKey.X.FillBytes(make([]byte, 32)),
+		YCoord: key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// attestedCredentialData: AAGUID(16) | credentialIdLength(2) | credentialId | credentialPublicKey
+	attested := make([]byte, 16, 16+2+len(id)+len(publicKey))
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(id)))
+	attested = append(attested, id...)
+	attested = append(attested, publicKey...)
+	authData := append(a.authData(flagAttested, credential.SignCount), attested...)
+
+	attestationObject, err := webauthncbor.Marshal(struct {
+		Fmt      string         `cbor:"fmt"`
+		AttStmt  map[string]any `cbor:"attStmt"`
+		AuthData []byte         `cbor:"authData"`
+	}{Fmt: "none", AttStmt: map[string]any{}, AuthData: authData})
+	if err != nil {
+		return nil, err
+	}
+
+	clientData, err := a.clientData("webauthn.create", creation.Response.Challenge.String())
+	if err != nil {
+		return nil, err
+	}
+
+	a.credentials = append(a.credentials, credential)
+	return json.Marshal(map[string]any{
+		"id":    encode(id),
+		"rawId": encode(id),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    encode(clientData),
+			"attestationObject": encode(attestationObject),
+		},
+	})
+}
+
+// Login は認証オプション（CredentialAssertion の JSON）に応答し、assertion の JSON を返す
+// allowCredentials が指定されている場合はその中から、指定がない場合（discoverable）は最初のクレデンシャルで署名する。
+func (a *SoftwareAuthenticator) Login(options []byte) ([]byte, error) {
+	var assertion protocol.CredentialAssertion
+	if err := json.Unmarshal(options, &assertion); err != nil {
+		return nil, err
+	}
+
+	credential := a.selectCredential(assertion.Response.AllowedCredentials)
+	if credential == nil {
+		return nil, fmt.Errorf("no matching credential")
+	}
+
+	credential.SignCount++
+	authData := a.authData(0, credential.SignCount)
+	clientData, err := a.clientData("webauthn.get", assertion.Response.Challenge.String())
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, credential.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]any{
+		"id":    encode(credential.ID),
+		"rawId": encode(credential.ID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    encode(clientData),
+			"authenticatorData": encode(authData),
+			"signature":         encode(signature),
+			"userHandle":        encode(credential.UserHandle),
+		},
+	})
+}
+
+func (a *SoftwareAuthenticator) selectCredential(allowed []protocol.CredentialDescriptor) *SoftwareCredential {
+	for _, credential := range a.credentials {
+		if len(allowed) == 0 {
+			return credential
+		}
+		for _, descriptor := range allowed {
+			if string(descriptor.CredentialID) == string(credential.ID) {
+				return credential
+			}
+		}
+	}
+	return nil
+}
+
+// authData は rpIdHash(32) | flags(1) | signCount(4) を組み立てる
+func (a *SoftwareAuthenticator) authData(flags byte, signCount uint32) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	flags |= flagUserPresent
+	if a.UserVerified {
+		flags |= flagUserVerified
+	}
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, signCount)
+}
+
+func (a *SoftwareAuthenticator) clientData(ceremony string, challenge string) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":        ceremony,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+// userHandleBytes はオプションの user.id（Base64URL の文字列、または URLEncodedBase64）をバイト列に戻す
+func userHandleBytes(id any) ([]byte, error) {
+	switch v := id.(type) {
+	case string:
+		return base64.RawURLEncoding.DecodeString(v)
+	case protocol.URLEncodedBase64:
+		return v, nil
+	case []byte:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unexpected user id type %T", id)
+	}
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,103 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/skip2/go-qrcode"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// RFC 6238 の既定値（主要な認証アプリが対応している組み合わせ）
+const (
+	Digits     = 6
+	Period     = 30 * time.Second
+	secretSize = 20 // HMAC-SHA1 のブロック長に合わせた 160 ビット
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret は Base32 でエンコードしたランダムな共有シークレットを生成する
+func GenerateSecret() (string, error) {
+	buf := make([]byte, secretSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errs.NewPkgError("failed to generate totp secret")
+	}
+	return b32.EncodeToString(buf), nil
+}
+
+// Step は時刻に対応するタイムステップ（Unix 時間を Period で割った値）を返す
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// CodeAt は指定したタイムステップのコードを返す
+func CodeAt(secret string, step int64) (string, error) {
+	key, err := b32.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", errs.NewPkgError("totp secret is not valid base32")
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// RFC 4226 の動的切り詰め
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1_000_000), nil
+}
+
+// Validate はコードを検証し、一致したタイムステップを返す
+// 端末の時刻ずれを考慮して前後 skew ステップまで許容する。
+// 同じコードの再利用を防ぐため、呼び出し側は返されたステップ以前のコードを拒否すること。
+func Validate(secret string, code string, t time.Time, skew int64) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != Digits {
+		return 0, false
+	}
+	current := Step(t)
+	for i := -skew; i <= skew; i++ {
+		expected, err := CodeAt(secret, current+i)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return current + i, true
+		}
+	}
+	return 0, false
+}
+
+// KeyURI は認証アプリに登録するための otpauth:// URI を返す
+func KeyURI(issuer string, account string, secret string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(Digits))
+	params.Set("period", fmt.Sprint(int(Period/time.Second)))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// QRCodeDataURI は URI を QR コード（PNG）にした data URI を返す
+func QRCodeDataURI(uri string) (string, error) {
+	png, err := qrcode.Encode(uri, qrcode.Medium, 256)
+	if err != nil {
+		return "", errs.NewPkgError("failed to encode qr code")
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), nil
+}
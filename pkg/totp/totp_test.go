@@ -0,0 +1,82 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 付録 B のテストベクター（SHA1、シークレットは "12345678901234567890"）
+func TestCodeAt_RFC6238Vectors(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, want := range cases {
+		got, err := CodeAt(secret, Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "unix=%d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0)
+
+	code, err := CodeAt(secret, Step(now))
+	require.NoError(t, err)
+	step, ok := Validate(secret, code, now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	// 1ステップ前のコードは許容範囲内
+	prev, _ := CodeAt(secret, Step(now)-1)
+	step, ok = Validate(secret, prev, now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now)-1, step)
+
+	// 範囲外のコードや形式の異なるコードは拒否
+	old, _ := CodeAt(secret, Step(now)-3)
+	_, ok = Validate(secret, old, now, 1)
+	assert.False(t, ok)
+	_, ok = Validate(secret, "12345", now, 1)
+	assert.False(t, ok)
+
+	// 空白を含めて入力されたコードも受け付ける
+	_, ok = Validate(secret, code[:3]+" "+code[3:], now, 1)
+	assert.True(t, ok)
+}
+
+func TestCodeAt_InvalidSecret(t *testing.T) {
+	_, err := CodeAt("not base32!", 1)
+	assert.Error(t, err)
+}
+
+func TestKeyURI(t *testing.T) {
+	uri := KeyURI("Nexus", "user@example.com", "JBSWY3DPEHPK3PXP")
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Nexus:user@example.com?"))
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", parsed.Query().Get("secret"))
+	assert.Equal(t, "Nexus", parsed.Query().Get("issuer"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+}
+
+func TestQRCodeDataURI(t *testing.T) {
+	dataURI, err := QRCodeDataURI("otpauth://totp/Nexus:user@example.com?secret=JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(dataURI, "data:image/png;base64,"))
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// ActionToken の用途。用途が異なるトークンは相互に利用できない。
+const (
+	PurposeEmailVerification  = "email_verification"
+	PurposeEmailChangeConfirm = "email_change_confirm"
+	PurposeEmailChangeUndo    = "email_change_undo"
+	PurposeMFAChallenge       = "mfa_challenge"
+	PurposePasswordlessLogin  = "passwordless_login"
+	PurposeTrustedDevice      = "trusted_device"
+	PurposeAccountUnlock      = "account_unlock"
+	PurposeSecureAccount      = "secure_account"
+	PurposePasswordReset      = "password_reset"
+	PurposeOrgInvitation      = "organization_invitation"
+)
+
+// ActionTokenClaims は、メール内のリンクなどで利用する用途限定トークンのクレーム。
+// 用途限定トークンはアクセストークンとは別の鍵（actionKey）で署名する。
+type ActionTokenClaims struct {
+	ID      string   `json:"id"`
+	Purpose string   `json:"purpose"`
+	Email   string   `json:"email,omitempty"`
+	Ref     string   `json:"ref,omitempty"` // 関連するリソースのID（メールアドレス変更リクエストなど）
+	AMR     []string `json:"amr,omitempty"` // 発行までに完了した認証の方法（二要素認証のチャレンジなど）
+	jwt.RegisteredClaims
+}
+
+// ActionToken は、検証済みの用途限定トークンの内容
+type ActionToken struct {
+	TokenID   string
+	ObjID     string
+	Email     string
+	Ref       string
+	AMR       []string
+	ExpiresAt time.Time
+}
+
+// GenerateActionToken は、用途とメールアドレスに紐づいた有効期限付きのトークンを既定の鍵で生成します。
+func GenerateActionToken(purpose string, objID string, email string, ttl time.Duration) (string, error) {
+	return defaultTokenSigner.GenerateActionToken(purpose, objID, email, ttl)
+}
+
+// GenerateActionTokenWithRef は、関連するリソースのIDを含めたトークンを既定の鍵で生成します。
+func GenerateActionTokenWithRef(purpose string, objID string, email string, ref string, ttl time.Duration) (string, error) {
+	return defaultTokenSigner.GenerateActionTokenWithRef(purpose, objID, email, ref, ttl)
+}
+
+// GenerateActionTokenWithAMR は、発行までに完了した認証の方法を含めたトークンを既定の鍵で生成します。
+func GenerateActionTokenWithAMR(purpose string, objID string, amr []string, ttl time.Duration) (string, error) {
+	return defaultTokenSigner.GenerateActionTokenWithAMR(purpose, objID, amr, ttl)
+}
+
+// ValidateActionToken は、既定の鍵でトークンの署名・有効期限・用途を検証します。
+func ValidateActionToken(purpose string, signedToken string) (*ActionToken, error) {
+	return defaultTokenSigner.ValidateActionToken(purpose, signedToken)
+}
+
+// GenerateActionToken は、用途とメールアドレスに紐づいた有効期限付きのトークンを生成します。
+func (s *TokenSigner) GenerateActionToken(purpose string, objID string, email string, ttl time.Duration) (string, error) {
+	return s.GenerateActionTokenWithRef(purpose, objID, email, "", ttl)
+}
+
+// GenerateActionTokenWithRef は、関連するリソースのIDを含めたトークンを生成します。
+func (s *TokenSigner) GenerateActionTokenWithRef(purpose string, objID string, email string, ref string, ttl time.Duration) (string, error) {
+	return s.generateActionToken(purpose, objID, email, ref, nil, ttl)
+}
+
+// GenerateActionTokenWithAMR は、発行までに完了した認証の方法を含めたトークンを生成します。
+// 二要素認証のチャレンジで、一要素目の方法を最終的なトークンの amr に引き継ぐために使います。
+func (s *TokenSigner) GenerateActionTokenWithAMR(purpose string, objID string, amr []string, ttl time.Duration) (string, error) {
+	return s.generateActionToken(purpose, objID, "", "", amr, ttl)
+}
+
+func (s *TokenSigner) generateActionToken(purpose string, objID string, email string, ref string, amr []string, ttl time.Duration) (string, error) {
+	if purpose == "" {
+		return "", errs.NewPkgError("action token purpose is empty")
+	}
+	claims := ActionTokenClaims{
+		ID:               objID,
+		Purpose:          purpose,
+		Email:            email,
+		Ref:              ref,
+		AMR:              amr,
+		RegisteredClaims: s.registeredClaims(objID, ttl),
+	}
+	claims.RegisteredClaims.ID = uuid.NewString()
+	return s.signWith(s.actionKey(), claims)
+}
+
+// ValidateActionToken は、トークンの署名・有効期限・受け手・用途を検証します。
+func (s *TokenSigner) ValidateActionToken(purpose string, signedToken string) (*ActionToken, error) {
+	token, err := s.parseWith(s.actionKey(), signedToken, &ActionTokenClaims{})
+	if err != nil {
+		if errors.Is(err, jwt.ErrSignatureInvalid) {
+			return nil, errs.NewPkgError("action token signature is invalid")
+		} else if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, errs.NewPkgError("action token is expired")
+		}
+		return nil, errs.NewPkgError(fmt.Sprintf("jwt: %v", err))
+	}
+
+	claims, ok := token.Claims.(*ActionTokenClaims)
+	if !ok || !token.Valid {
+		return nil, errs.NewPkgError("action token is invalid")
+	}
+	if claims.Purpose != purpose {
+		return nil, errs.NewPkgError("action token purpose mismatch")
+	}
+
+	return &ActionToken{
+		TokenID:   claims.RegisteredClaims.ID,
+		ObjID:     claims.ID,
+		Email:     claims.Email,
+		Ref:       claims.Ref,
+		AMR:       claims.AMR,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
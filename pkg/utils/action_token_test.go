@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerateActionToken は、用途限定トークンの生成と検証のテスト
+func TestGenerateActionToken(t *testing.T) {
+	setupEnv(t)
+
+	token, err := GenerateActionToken(PurposeEmailVerification, "123", "user@example.com", time.Hour)
+	assert.NoError(t, err, "トークン生成中にエラーが発生してはいけない")
+	assert.NotEmpty(t, token)
+
+	claims, err := ValidateActionToken(PurposeEmailVerification, token)
+	assert.NoError(t, err, "有効なトークンの検証中にエラーが発生してはいけない")
+	assert.Equal(t, "123", claims.ObjID, "検証結果のユーザーIDが一致する")
+	assert.Equal(t, "user@example.com", claims.Email, "検証結果のメールアドレスが一致する")
+	assert.NotEmpty(t, claims.TokenID, "トークンIDが付与されている")
+}
+
+// TestValidateActionToken_PurposeMismatch は、用途が異なるトークンを拒否するテスト
+func TestValidateActionToken_PurposeMismatch(t *testing.T) {
+	setupEnv(t)
+
+	token, err := GenerateActionToken("other_purpose", "123", "user@example.com", time.Hour)
+	assert.NoError(t, err)
+
+	claims, err := ValidateActionToken(PurposeEmailVerification, token)
+	assert.Error(t, err, "用途が異なるトークンはエラーとなる")
+	assert.Nil(t, claims)
+}
+
+// TestValidateActionToken_AccessToken は、アクセストークンを用途限定トークンとして使えないことのテスト
+func TestValidateActionToken_AccessToken(t *testing.T) {
+	setupEnv(t)
+
+	accessToken, _, err := GenerateTokens("123", NewAuthContext(AMRPassword))
+	assert.NoError(t, err)
+
+	claims, err := ValidateActionToken(PurposeEmailVerification, accessToken)
+	assert.Error(t, err, "アクセストークンは用途限定トークンとして検証できない")
+	assert.Nil(t, claims)
+}
+
+// TestValidateActionToken_Expired は、期限切れトークンの検証テスト
+func TestValidateActionToken_Expired(t *testing.T) {
+	setupEnv(t)
+
+	originalTimeNow := timeNowFunc
+	defer func() { timeNowFunc = originalTimeNow }()
+	timeNowFunc = func() time.Time { return time.Now().Add(-2 * time.Hour) }
+
+	token, err := GenerateActionToken(PurposeEmailVerification, "123", "user@example.com", time.Hour)
+	assert.NoError(t, err)
+
+	timeNowFunc = originalTimeNow
+	claims, err := ValidateActionToken(PurposeEmailVerification, token)
+	assert.Error(t, err, "期限切れのトークンは検証時にエラーとなる")
+	assert.Nil(t, claims)
+}
+
+// TestGenerateActionTokenWithRef は、関連リソースIDを含むトークンのテスト
+func TestGenerateActionTokenWithRef(t *testing.T) {
+	setupEnv(t)
+
+	token, err := GenerateActionTokenWithRef(PurposeEmailChangeConfirm, "123", "new@example.com", "ref-1", time.Hour)
+	assert.NoError(t, err)
+
+	claims, err := ValidateActionToken(PurposeEmailChangeConfirm, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "ref-1", claims.Ref, "関連リソースIDが一致する")
+
+	// 同じリクエストの取り消し用トークンとしては使えないこと
+	_, err = ValidateActionToken(PurposeEmailChangeUndo, token)
+	assert.Error(t, err)
+}
+
+// TestGenerateActionTokenWithAMR は、完了した認証の方法を含むトークンのテスト
+func TestGenerateActionTokenWithAMR(t *testing.T) {
+	setupEnv(t)
+
+	token, err := GenerateActionTokenWithAMR(PurposeMFAChallenge, "123", []string{AMRPassword}, time.Minute)
+	assert.NoError(t, err)
+
+	claims, err := ValidateActionToken(PurposeMFAChallenge, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", claims.ObjID)
+	assert.Equal(t, []string{AMRPassword}, claims.AMR)
+}
+
+// TestValidateToken_ActionToken は、用途限定トークンをアクセストークン・リフレッシュトークンとして使えないことのテスト
+func TestValidateToken_ActionToken(t *testing.T) {
+	setupEnv(t)
+
+	token, err := GenerateActionToken(PurposeMFAChallenge, "123", "", time.Minute)
+	assert.NoError(t, err)
+
+	claims, err := ValidateToken(token)
+	assert.Error(t, err, "用途限定トークンはアクセストークンとして受け付けない")
+	assert.Nil(t, claims)
+
+	claims, err = ValidateRefreshToken(token)
+	assert.Error(t, err, "用途限定トークンはリフレッシュトークンとして受け付けない")
+	assert.Nil(t, claims)
+}
+
+// TestTokenSigner_ActionToken は、用途限定トークンも鍵と受け手が同じ TokenSigner でのみ検証できることのテスト
+func TestTokenSigner_ActionToken(t *testing.T) {
+	setupEnv(t)
+
+	acme := NewTokenSigner([]byte("acme-secret"), "acme")
+	token, err := acme.GenerateActionToken(PurposePasswordReset, "123", "user@example.com", time.Hour)
+	assert.NoError(t, err)
+
+	claims, err := acme.ValidateActionToken(PurposePasswordReset, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", claims.Email)
+
+	_, err = NewTokenSigner([]byte("globex-secret"), "globex").ValidateActionToken(PurposePasswordReset, token)
+	assert.Error(t, err)
+	_, err = NewTokenSigner([]byte("acme-secret"), "globex").ValidateActionToken(PurposePasswordReset, token)
+	assert.Error(t, err)
+	_, err = ValidateActionToken(PurposePasswordReset, token)
+	assert.Error(t, err)
+}
+
+// TestValidateActionToken_AccessKey は、アクセストークンの鍵で署名した用途限定トークンを拒否するテスト
+func TestValidateActionToken_AccessKey(t *testing.T) {
+	setupEnv(t)
+
+	signer := DefaultTokenSigner()
+	token, err := signer.sign(ActionTokenClaims{
+		ID:               "123",
+		Purpose:          PurposeEmailVerification,
+		RegisteredClaims: signer.registeredClaims("123", time.Hour),
+	})
+	assert.NoError(t, err)
+
+	claims, err := ValidateActionToken(PurposeEmailVerification, token)
+	assert.Error(t, err, "アクセストークンの鍵で署名したトークンは用途限定トークンとして検証できない")
+	assert.Nil(t, claims)
+}
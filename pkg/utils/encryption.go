@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+
+	"github.com/goda6565/nexus-user-auth/errs"
+)
+
+// encryptionVersion は暗号文の先頭に付ける形式のバージョン（鍵や方式の変更に備える）
+const encryptionVersion = "v1:"
+
+// getEncryptionKey は環境変数 DATA_ENCRYPTION_KEY から AES-256 の鍵を導出する
+func getEncryptionKey() ([]byte, error) {
+	secret := os.Getenv("DATA_ENCRYPTION_KEY")
+	if secret == "" {
+		return nil, errs.NewPkgError("DATA_ENCRYPTION_KEY is not set")
+	}
+	key := sha256.Sum256([]byte(secret))
+	return key[:], nil
+}
+
+func newGCM() (cipher.AEAD, error) {
+	key, err := getEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errs.NewPkgError("failed to create cipher")
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errs.NewPkgError("failed to create cipher")
+	}
+	return gcm, nil
+}
+
+// EncryptString は値を AES-GCM で暗号化し、保存用の文字列にする
+// aad（関連データ）には値の持ち主の ID などを渡し、別のレコードへの付け替えを検出できるようにする。
+func EncryptString(plaintext string, aad string) (string, error) {
+	gcm, err := newGCM()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errs.NewPkgError("failed to generate nonce")
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), []byte(aad))
+	return encryptionVersion + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptString は EncryptString で暗号化した文字列を復号する
+func DecryptString(ciphertext string, aad string) (string, error) {
+	if len(ciphertext) < len(encryptionVersion) || ciphertext[:len(encryptionVersion)] != encryptionVersion {
+		return "", errs.NewPkgError("unsupported ciphertext format")
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(ciphertext[len(encryptionVersion):])
+	if err != nil {
+		return "", errs.NewPkgError("ciphertext is not valid base64")
+	}
+	gcm, err := newGCM()
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errs.NewPkgError("ciphertext is too short")
+	}
+	nonce, body := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, body, []byte(aad))
+	if err != nil {
+		return "", errs.NewPkgError("failed to decrypt ciphertext")
+	}
+	return string(plaintext), nil
+}
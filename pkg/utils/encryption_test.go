@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptString_RoundTrip(t *testing.T) {
+	t.Setenv("DATA_ENCRYPTION_KEY", "test-encryption-key")
+
+	ciphertext, err := EncryptString("JBSWY3DPEHPK3PXP", "user-1")
+	assert.NoError(t, err, "EncryptString should not error")
+	assert.NotContains(t, ciphertext, "JBSWY3DPEHPK3PXP", "Ciphertext should not contain the plaintext")
+
+	plaintext, err := DecryptString(ciphertext, "user-1")
+	assert.NoError(t, err, "DecryptString should not error")
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", plaintext)
+
+	// 同じ値でも暗号文は毎回異なる
+	other, _ := EncryptString("JBSWY3DPEHPK3PXP", "user-1")
+	assert.NotEqual(t, ciphertext, other)
+}
+
+func TestDecryptString_WrongAAD(t *testing.T) {
+	t.Setenv("DATA_ENCRYPTION_KEY", "test-encryption-key")
+
+	ciphertext, _ := EncryptString("secret", "user-1")
+	_, err := DecryptString(ciphertext, "user-2")
+	assert.Error(t, err, "Ciphertext bound to another owner should not decrypt")
+}
+
+func TestDecryptString_WrongKey(t *testing.T) {
+	t.Setenv("DATA_ENCRYPTION_KEY", "test-encryption-key")
+	ciphertext, _ := EncryptString("secret", "user-1")
+
+	t.Setenv("DATA_ENCRYPTION_KEY", "another-key")
+	_, err := DecryptString(ciphertext, "user-1")
+	assert.Error(t, err, "DecryptString should error with a different key")
+}
+
+func TestDecryptString_InvalidFormat(t *testing.T) {
+	t.Setenv("DATA_ENCRYPTION_KEY", "test-encryption-key")
+
+	_, err := DecryptString("plaintext", "user-1")
+	assert.Error(t, err)
+	_, err = DecryptString("v1:!!!", "user-1")
+	assert.Error(t, err)
+}
+
+func TestEncryptString_KeyNotSet(t *testing.T) {
+	t.Setenv("DATA_ENCRYPTION_KEY", "")
+
+	_, err := EncryptString("secret", "user-1")
+	assert.Error(t, err, "EncryptString should error when the key is not set")
+}
@@ -2,6 +2,8 @@ package utils
 
 import (
 	"os"
+	"strconv"
+	"time"
 )
 
 func GetEnvDefault(key, deftVal string) string {
@@ -11,3 +13,42 @@ func GetEnvDefault(key, deftVal string) string {
 	}
 	return val
 }
+
+// GetEnvDuration は環境変数を time.Duration として取得する。未設定や不正な値の場合はデフォルト値を返す。
+func GetEnvDuration(key string, deftVal time.Duration) time.Duration {
+	val, ok := os.LookupEnv(key)
+	if !ok {
+		return deftVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return deftVal
+	}
+	return d
+}
+
+// GetEnvInt は環境変数を int として取得する。未設定や不正な値の場合はデフォルト値を返す。
+func GetEnvInt(key string, deftVal int) int {
+	val, ok := os.LookupEnv(key)
+	if !ok {
+		return deftVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil {
+		return deftVal
+	}
+	return i
+}
+
+// GetEnvBool は環境変数を bool として取得する。未設定や不正な値の場合はデフォルト値を返す。
+func GetEnvBool(key string, deftVal bool) bool {
+	val, ok := os.LookupEnv(key)
+	if !ok {
+		return deftVal
+	}
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		return deftVal
+	}
+	return b
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -34,3 +35,58 @@ func TestGetEnvDefault_VarSet(t *testing.T) {
 	val := GetEnvDefault(key, defaultVal)
 	assert.Equal(t, expectedVal, val, "When the env variable is set, its value should be returned")
 }
+
+func TestGetEnvDuration(t *testing.T) {
+	key := "TEST_GET_ENV_DURATION"
+	defaultVal := 5 * time.Minute
+
+	// 未設定の場合はデフォルト値
+	err := os.Unsetenv(key)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultVal, GetEnvDuration(key, defaultVal))
+
+	// 正しい値の場合はパースされた値
+	t.Setenv(key, "90s")
+	assert.Equal(t, 90*time.Second, GetEnvDuration(key, defaultVal))
+
+	// 不正な値の場合はデフォルト値
+	t.Setenv(key, "not-a-duration")
+	assert.Equal(t, defaultVal, GetEnvDuration(key, defaultVal))
+}
+
+func TestGetEnvInt(t *testing.T) {
+	key := "TEST_GET_ENV_INT"
+	defaultVal := 10
+
+	// 未設定の場合はデフォルト値
+	err := os.Unsetenv(key)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultVal, GetEnvInt(key, defaultVal))
+
+	// 正しい値の場合はパースされた値
+	t.Setenv(key, "42")
+	assert.Equal(t, 42, GetEnvInt(key, defaultVal))
+
+	// 不正な値の場合はデフォルト値
+	t.Setenv(key, "abc")
+	assert.Equal(t, defaultVal, GetEnvInt(key, defaultVal))
+}
+
+func TestGetEnvBool(t *testing.T) {
+	key := "TEST_GET_ENV_BOOL"
+
+	// 未設定の場合はデフォルト値
+	err := os.Unsetenv(key)
+	assert.NoError(t, err)
+	assert.True(t, GetEnvBool(key, true))
+
+	// 正しい値の場合はパースされた値
+	t.Setenv(key, "false")
+	assert.False(t, GetEnvBool(key, true))
+	t.Setenv(key, "1")
+	assert.True(t, GetEnvBool(key, false))
+
+	// 不正な値の場合はデフォルト値
+	t.Setenv(key, "yes please")
+	assert.True(t, GetEnvBool(key, true))
+}
@@ -1,9 +1,13 @@
 package utils
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -15,10 +19,201 @@ import (
 var timeNowFunc = time.Now
 
 type MyJWTClaims struct {
-	ID string `json:"id"`
+	ID       string           `json:"id"`
+	Purpose  string           `json:"purpose,omitempty"`   // 用途限定トークン（ActionToken）の判別用。アクセストークンには含まれない
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"` // ユーザーが認証した日時（リフレッシュしても変わらない）
+	AMR      []string         `json:"amr,omitempty"`       // 認証に使った方法
+	ACR      string           `json:"acr,omitempty"`       // 認証の強度
+	OrgID    string           `json:"org_id,omitempty"`    // 切り替えた組織（個人として利用している場合は含まれない）
+	Groups   []string         `json:"groups,omitempty"`    // 所属するグループ（グループをクレームに含める場合のアクセストークンのみ）
+	// PrincipalType: トークンの主体の種類（サービスアカウントのトークンのみ。人のトークンには含まれない）
+	PrincipalType string `json:"principal_type,omitempty"`
+	Scope         string `json:"scope,omitempty"` // 利用できる操作（スペース区切り。サービスアカウントのトークンのみ）
 	jwt.RegisteredClaims
 }
 
+// PrincipalServiceAccount は、サービスアカウントのトークンの principal_type クレームの値
+const PrincipalServiceAccount = "service_account"
+
+// 認証に使った方法（RFC 8176 の amr 値）
+const (
+	AMRPassword    = "pwd"   // パスワード
+	AMROTP         = "otp"   // 認証アプリのコード・リカバリーコード
+	AMRHardwareKey = "hwk"   // パスキー
+	AMREmail       = "email" // メールで受け取ったリンク・コード（パスワードレスログイン）
+	AMRMFA         = "mfa"   // 複数の要素による認証
+)
+
+// 認証の強度（acr 値）。NIST SP 800-63B の認証保証レベルに対応する
+const (
+	ACRSingleFactor = "aal1" // 単一要素
+	ACRMultiFactor  = "aal2" // 複数要素、またはパスキー
+)
+
+// acrRank は acr 値の強さの順序
+var acrRank = map[string]int{
+	ACRSingleFactor: 1,
+	ACRMultiFactor:  2,
+}
+
+// ACRSatisfies は、acr が required 以上の強度かどうかを判定します。
+func ACRSatisfies(acr string, required string) bool {
+	return acrRank[acr] >= acrRank[required]
+}
+
+// AuthContext は、トークンを発行する元になった認証の情報（auth_time・amr・acr クレームになる）
+type AuthContext struct {
+	AuthTime time.Time
+	AMR      []string
+}
+
+// NewAuthContext は、現在時刻に指定の方法で認証した AuthContext を返します。
+// 複数の方法を指定した場合は AMRMFA を加えます。
+func NewAuthContext(methods ...string) AuthContext {
+	amr := make([]string, 0, len(methods)+1)
+	for _, method := range methods {
+		if method != "" && method != AMRMFA && !slices.Contains(amr, method) {
+			amr = append(amr, method)
+		}
+	}
+	if len(amr) > 1 {
+		amr = append(amr, AMRMFA)
+	}
+	return AuthContext{AuthTime: timeNowFunc(), AMR: amr}
+}
+
+// ACR は、認証に使った方法から認証の強度を返します。
+func (a AuthContext) ACR() string {
+	if slices.Contains(a.AMR, AMRMFA) || slices.Contains(a.AMR, AMRHardwareKey) {
+		return ACRMultiFactor
+	}
+	return ACRSingleFactor
+}
+
+// TokenSigner は、トークンの署名・検証に使う鍵と、トークンの受け手（aud クレーム）を持ちます。
+// テナントごとに異なる TokenSigner を使うことで、あるテナントで発行したトークンを別のテナントで使えないようにします。
+type TokenSigner struct {
+	secret   []byte     // nil の場合は環境変数 JWT_SECRET_KEY の値を使う
+	audience string     // 空の場合は aud クレームを含めず、検証もしない
+	groups   GroupsFunc // nil の場合は groups クレームを含めない
+}
+
+// GroupsFunc は、ユーザーが所属するグループの名前を返す関数です。
+type GroupsFunc func(objID string) ([]string, error)
+
+// NewTokenSigner は、指定の鍵で署名し、audience を aud クレームに含める TokenSigner を返します。
+func NewTokenSigner(secret []byte, audience string) *TokenSigner {
+	return &TokenSigner{secret: secret, audience: audience}
+}
+
+// defaultTokenSigner は、環境変数 JWT_SECRET_KEY の鍵で署名し、aud クレームを含めない TokenSigner
+var defaultTokenSigner = &TokenSigner{}
+
+// DefaultTokenSigner は、環境変数 JWT_SECRET_KEY の鍵で署名し、aud クレームを含めない TokenSigner を返します。
+func DefaultTokenSigner() *TokenSigner {
+	return defaultTokenSigner
+}
+
+// WithGroups は、アクセストークンに groups クレーム（fn が返すグループの名前）を含める TokenSigner を返します。
+// 鍵と受け手は元の TokenSigner と同じです。
+func (s *TokenSigner) WithGroups(fn GroupsFunc) *TokenSigner {
+	signer := *s
+	signer.groups = fn
+	return &signer
+}
+
+// Audience は、トークンの受け手（aud クレーム）を返します。
+func (s *TokenSigner) Audience() string {
+	return s.audience
+}
+
+func (s *TokenSigner) key() []byte {
+	if s.secret != nil {
+		return s.secret
+	}
+	return getJWTSecret()
+}
+
+// actionKey は、用途限定トークン（ActionToken）の署名に使う鍵を返す。
+// アクセストークンの鍵から導出した別の鍵を使い、用途限定トークンをアクセストークンとして検証できないようにする。
+func (s *TokenSigner) actionKey() []byte {
+	mac := hmac.New(sha256.New, s.key())
+	mac.Write([]byte("action-token"))
+	return mac.Sum(nil)
+}
+
+// sign は、クレームに署名したトークンを返す
+func (s *TokenSigner) sign(claims jwt.Claims) (string, error) {
+	return s.signWith(s.key(), claims)
+}
+
+// signWith は、指定の鍵でクレームに署名したトークンを返す
+func (s *TokenSigner) signWith(key []byte, claims jwt.Claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+}
+
+func (s *TokenSigner) registeredClaims(subject string, ttl time.Duration) jwt.RegisteredClaims {
+	claims := jwt.RegisteredClaims{
+		Issuer:    "ptf-auth-service",
+		Subject:   subject,
+		IssuedAt:  jwt.NewNumericDate(timeNowFunc()),
+		ExpiresAt: jwt.NewNumericDate(timeNowFunc().Add(ttl)),
+	}
+	if s.audience != "" {
+		claims.Audience = jwt.ClaimStrings{s.audience}
+	}
+	return claims
+}
+
+// parse は、トークンの署名・有効期限と aud クレームを検証する
+func (s *TokenSigner) parse(signedToken string, claims jwt.Claims) (*jwt.Token, error) {
+	return s.parseWith(s.key(), signedToken, claims)
+}
+
+// parseWith は、指定の鍵でトークンの署名・有効期限と aud クレームを検証する
+func (s *TokenSigner) parseWith(key []byte, signedToken string, claims jwt.Claims) (*jwt.Token, error) {
+	var options []jwt.ParserOption
+	if s.audience != "" {
+		options = append(options, jwt.WithAudience(s.audience))
+	}
+	return jwt.ParseWithClaims(signedToken, claims, func(token *jwt.Token) (interface{}, error) {
+		// HMAC 系の署名アルゴリズムのみ許可
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errs.NewPkgError(fmt.Sprintf("jwt: unexpected signing method: %v", token.Header["alg"]))
+		}
+		return key, nil
+	}, options...)
+}
+
+// newTokenClaims は、指定の有効期限と認証情報を持つアクセストークン・リフレッシュトークンのクレームを返す
+func (s *TokenSigner) newTokenClaims(objID string, auth AuthContext, orgID string, ttl time.Duration) MyJWTClaims {
+	claims := MyJWTClaims{
+		ID:               objID,
+		AMR:              auth.AMR,
+		ACR:              auth.ACR(),
+		OrgID:            orgID,
+		RegisteredClaims: s.registeredClaims(objID, ttl),
+	}
+	// 認証日時が不明な場合（クレームを持たない古いリフレッシュトークンなど）は含めない
+	if !auth.AuthTime.IsZero() {
+		claims.AuthTime = jwt.NewNumericDate(auth.AuthTime)
+	}
+	return claims
+}
+
+// newAccessTokenClaims は、アクセストークンのクレームを返す（groups クレームを含める場合はここで解決する）
+func (s *TokenSigner) newAccessTokenClaims(objID string, auth AuthContext, orgID string) (MyJWTClaims, error) {
+	claims := s.newTokenClaims(objID, auth, orgID, 24*time.Hour) // 24時間
+	if s.groups != nil {
+		groups, err := s.groups(objID)
+		if err != nil {
+			return MyJWTClaims{}, errs.NewPkgError(fmt.Sprintf("jwt: failed to resolve groups: %v", err))
+		}
+		claims.Groups = groups
+	}
+	return claims, nil
+}
+
 func getJWTSecret() []byte {
 	secret := os.Getenv("JWT_SECRET_KEY")
 	if secret == "" {
@@ -27,31 +222,36 @@ func getJWTSecret() []byte {
 	return []byte(secret)
 }
 
-func GenerateTokens(objID string) (accessToken string, refreshToken string, err error) {
+// GenerateTokens は、認証の情報を含めたアクセストークンとリフレッシュトークンを既定の鍵で生成します。
+func GenerateTokens(objID string, auth AuthContext) (accessToken string, refreshToken string, err error) {
+	return defaultTokenSigner.GenerateTokens(objID, auth)
+}
+
+// GenerateOrgTokens は、指定の組織に切り替えたトークンを既定の鍵で生成します。
+func GenerateOrgTokens(objID string, auth AuthContext, orgID string) (accessToken string, refreshToken string, err error) {
+	return defaultTokenSigner.GenerateOrgTokens(objID, auth, orgID)
+}
+
+// GenerateTokens は、認証の情報を含めたアクセストークンとリフレッシュトークンを生成します。
+func (s *TokenSigner) GenerateTokens(objID string, auth AuthContext) (accessToken string, refreshToken string, err error) {
+	return s.GenerateOrgTokens(objID, auth, "")
+}
+
+// GenerateOrgTokens は、指定の組織に切り替えたアクセストークンとリフレッシュトークン（org_id クレームを含む）を生成します。
+// orgID が空の場合は組織を含まない（個人として利用する）トークンを生成します。
+func (s *TokenSigner) GenerateOrgTokens(objID string, auth AuthContext, orgID string) (accessToken string, refreshToken string, err error) {
 	// アクセストークン（短期有効）
-	accessClaims := MyJWTClaims{
-		ID: objID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    "ptf-auth-service",
-			Subject:   objID,
-			ExpiresAt: jwt.NewNumericDate(timeNowFunc().Add(24 * time.Hour)), // 24時間
-		},
-	}
-	accessToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims).SignedString(getJWTSecret())
+	accessClaims, err := s.newAccessTokenClaims(objID, auth, orgID)
+	if err != nil {
+		return "", "", err
+	}
+	accessToken, err = s.sign(accessClaims)
 	if err != nil {
 		return "", "", err
 	}
 
 	// リフレッシュトークン（長期有効）
-	refreshClaims := MyJWTClaims{
-		ID: objID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    "ptf-auth-service",
-			Subject:   objID,
-			ExpiresAt: jwt.NewNumericDate(timeNowFunc().Add(7 * 24 * time.Hour)), // 7日間
-		},
-	}
-	refreshToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims).SignedString(getJWTSecret())
+	refreshToken, err = s.sign(s.newTokenClaims(objID, auth, orgID, 7*24*time.Hour)) // 7日間
 	if err != nil {
 		return "", "", err
 	}
@@ -59,18 +259,83 @@ func GenerateTokens(objID string) (accessToken string, refreshToken string, err
 	return accessToken, refreshToken, nil
 }
 
+// GenerateServiceAccountToken は、サービスアカウントのアクセストークン（principal_type・scope クレームを含む）を生成します。
+// 認証の情報（auth_time・amr・acr）は含めず、リフレッシュトークンも発行しません。
+func (s *TokenSigner) GenerateServiceAccountToken(objID string, scopes []string, ttl time.Duration) (string, error) {
+	claims, err := s.newAccessTokenClaims(objID, AuthContext{}, "")
+	if err != nil {
+		return "", err
+	}
+	claims.AMR, claims.ACR = nil, ""
+	claims.PrincipalType = PrincipalServiceAccount
+	claims.Scope = strings.Join(scopes, " ")
+	claims.RegisteredClaims = s.registeredClaims(objID, ttl)
+	return s.sign(claims)
+}
+
 type TokenClaims struct {
-	ObjID string
+	ObjID    string
+	IssuedAt time.Time // 発行日時（クレームがない古いトークンではゼロ値）
+	AuthTime time.Time // 認証日時（クレームがない古いトークンではゼロ値）
+	AMR      []string
+	ACR      string
+	OrgID    string   // 切り替えた組織（個人として利用している場合は空）
+	Groups   []string // 所属するグループ（groups クレームを含まないトークンでは nil）
+	// PrincipalType: トークンの主体の種類（サービスアカウントの場合は PrincipalServiceAccount、人の場合は空）
+	PrincipalType string
+	Scopes        []string // 利用できる操作（サービスアカウントのトークンのみ。人のトークンでは nil）
+}
+
+// IsServiceAccount は、サービスアカウントのトークンかどうかを返します。
+func (c *TokenClaims) IsServiceAccount() bool {
+	return c.PrincipalType == PrincipalServiceAccount
 }
 
+// AuthContext は、トークンの元になった認証の情報を返します。
+func (c *TokenClaims) AuthContext() AuthContext {
+	return AuthContext{AuthTime: c.AuthTime, AMR: c.AMR}
+}
+
+func newTokenClaimsResult(claims *MyJWTClaims) *TokenClaims {
+	result := &TokenClaims{
+		ObjID:  claims.ID,
+		AMR:    claims.AMR,
+		ACR:    claims.ACR,
+		OrgID:  claims.OrgID,
+		Groups: claims.Groups,
+
+		PrincipalType: claims.PrincipalType,
+	}
+	if claims.PrincipalType != "" {
+		result.Scopes = strings.Fields(claims.Scope)
+	}
+	if claims.IssuedAt != nil {
+		result.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.AuthTime != nil {
+		result.AuthTime = claims.AuthTime.Time
+	}
+	return result
+}
+
+// ValidateToken は、既定の鍵でアクセストークンを検証します。
 func ValidateToken(signedToken string) (*TokenClaims, error) {
-	token, err := jwt.ParseWithClaims(signedToken, &MyJWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// HMAC 系の署名アルゴリズムのみ許可
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errs.NewPkgError(fmt.Sprintf("jwt: unexpected signing method: %v", token.Header["alg"]))
-		}
-		return getJWTSecret(), nil
-	})
+	return defaultTokenSigner.ValidateToken(signedToken)
+}
+
+// ValidateRefreshToken は、既定の鍵でリフレッシュトークンを検証します。
+func ValidateRefreshToken(signedToken string) (*TokenClaims, error) {
+	return defaultTokenSigner.ValidateRefreshToken(signedToken)
+}
+
+// RefreshAccessToken は、既定の鍵でリフレッシュトークンから新しいアクセストークンを生成します。
+func RefreshAccessToken(refreshToken string) (newAccessToken string, err error) {
+	return defaultTokenSigner.RefreshAccessToken(refreshToken)
+}
+
+// ValidateToken は、アクセストークンの署名・有効期限・受け手を検証します。
+func (s *TokenSigner) ValidateToken(signedToken string) (*TokenClaims, error) {
+	token, err := s.parse(signedToken, &MyJWTClaims{})
 	if err != nil {
 		if errors.Is(err, jwt.ErrSignatureInvalid) {
 			return nil, errs.NewPkgError("token signature is invalid")
@@ -84,19 +349,17 @@ func ValidateToken(signedToken string) (*TokenClaims, error) {
 	if !ok || !token.Valid {
 		return nil, errs.NewPkgError("token is invalid")
 	}
+	// 用途限定トークン（二要素認証のチャレンジなど）は別の鍵で署名するが、念のため purpose クレームを持つトークンも受け付けない
+	if claims.Purpose != "" {
+		return nil, errs.NewPkgError("token is invalid")
+	}
 
-	return &TokenClaims{
-		ObjID: claims.ID,
-	}, nil
+	return newTokenClaimsResult(claims), nil
 }
 
-func ValidateRefreshToken(signedToken string) (*TokenClaims, error) {
-	token, err := jwt.ParseWithClaims(signedToken, &MyJWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errs.NewPkgError(fmt.Sprintf("jwt: unexpected signing method: %v", token.Header["alg"]))
-		}
-		return getJWTSecret(), nil
-	})
+// ValidateRefreshToken は、リフレッシュトークンの署名・有効期限・受け手を検証します。
+func (s *TokenSigner) ValidateRefreshToken(signedToken string) (*TokenClaims, error) {
+	token, err := s.parse(signedToken, &MyJWTClaims{})
 	if err != nil {
 		if errors.Is(err, jwt.ErrSignatureInvalid) {
 			return nil, errs.NewPkgError("refresh token signature is invalid")
@@ -110,29 +373,31 @@ func ValidateRefreshToken(signedToken string) (*TokenClaims, error) {
 	if !ok || !token.Valid {
 		return nil, errs.NewPkgError("refresh token is invalid")
 	}
+	if claims.Purpose != "" {
+		return nil, errs.NewPkgError("refresh token is invalid")
+	}
+	// サービスアカウントのトークンは更新できない（更新するとスコープのないトークンになるため）
+	if claims.PrincipalType != "" {
+		return nil, errs.NewPkgError("refresh token is invalid")
+	}
 
-	return &TokenClaims{
-		ObjID: claims.ID,
-	}, nil
+	return newTokenClaimsResult(claims), nil
 }
 
-func RefreshAccessToken(refreshToken string) (newAccessToken string, err error) {
+// RefreshAccessToken は、リフレッシュトークンを検証し、新しいアクセストークンを生成します。
+func (s *TokenSigner) RefreshAccessToken(refreshToken string) (newAccessToken string, err error) {
 	// リフレッシュトークンの検証
-	claims, err := ValidateRefreshToken(refreshToken)
+	claims, err := s.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return "", err
 	}
 
-	// 新しいアクセストークンを生成
-	accessClaims := MyJWTClaims{
-		ID: claims.ObjID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    "ptf-auth-service",
-			Subject:   claims.ObjID,
-			ExpiresAt: jwt.NewNumericDate(timeNowFunc().Add(24 * time.Hour)), // 24時間
-		},
+	// 新しいアクセストークンを生成（認証日時・方法と切り替えた組織はリフレッシュトークンから引き継ぎ、グループは改めて解決する）
+	accessClaims, err := s.newAccessTokenClaims(claims.ObjID, claims.AuthContext(), claims.OrgID)
+	if err != nil {
+		return "", err
 	}
-	newAccessToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims).SignedString(getJWTSecret())
+	newAccessToken, err = s.sign(accessClaims)
 	if err != nil {
 		return "", err
 	}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -26,7 +27,7 @@ func TestGenerateTokens(t *testing.T) {
 
 	objID := "123"
 
-	accessToken, refreshToken, err := GenerateTokens(objID)
+	accessToken, refreshToken, err := GenerateTokens(objID, NewAuthContext(AMRPassword))
 	assert.NoError(t, err, "トークン生成中にエラーが発生してはいけない")
 	assert.NotEmpty(t, accessToken, "アクセストークンが生成されるべき")
 	assert.NotEmpty(t, refreshToken, "リフレッシュトークンが生成されるべき")
@@ -38,7 +39,7 @@ func TestValidateAccessToken(t *testing.T) {
 
 	objID := "123"
 
-	accessToken, _, err := GenerateTokens(objID)
+	accessToken, _, err := GenerateTokens(objID, NewAuthContext(AMRPassword))
 	assert.NoError(t, err)
 
 	claims, err := ValidateToken(accessToken)
@@ -67,7 +68,7 @@ func TestAccessTokenExpiration(t *testing.T) {
 	// 25時間前の時刻を設定（アクセストークンの期限は 24時間なので、1時間前に期限切れ）
 	timeNowFunc = func() time.Time { return time.Now().Add(-25 * time.Hour) }
 
-	accessToken, _, err := GenerateTokens("123")
+	accessToken, _, err := GenerateTokens("123", NewAuthContext(AMRPassword))
 	assert.NoError(t, err)
 
 	claims, err := ValidateToken(accessToken)
@@ -81,7 +82,7 @@ func TestValidateRefreshToken(t *testing.T) {
 
 	objID := "123"
 
-	_, refreshToken, err := GenerateTokens(objID)
+	_, refreshToken, err := GenerateTokens(objID, NewAuthContext(AMRPassword))
 	assert.NoError(t, err)
 
 	claims, err := ValidateRefreshToken(refreshToken)
@@ -109,7 +110,7 @@ func TestRefreshTokenExpiration(t *testing.T) {
 	// 8日前の時刻を設定（リフレッシュトークンの期限は 7日間なので、1日超過）
 	timeNowFunc = func() time.Time { return time.Now().Add(-8 * 24 * time.Hour) }
 
-	_, refreshToken, err := GenerateTokens("123")
+	_, refreshToken, err := GenerateTokens("123", NewAuthContext(AMRPassword))
 	assert.NoError(t, err)
 
 	claims, err := ValidateRefreshToken(refreshToken)
@@ -123,7 +124,7 @@ func TestRefreshAccessToken(t *testing.T) {
 
 	objID := "123"
 
-	_, refreshToken, err := GenerateTokens(objID)
+	_, refreshToken, err := GenerateTokens(objID, NewAuthContext(AMRPassword))
 	assert.NoError(t, err)
 
 	newAccessToken, err := RefreshAccessToken(refreshToken)
@@ -141,3 +142,184 @@ func TestRefreshAccessTokenWithInvalidToken(t *testing.T) {
 	assert.Error(t, err, "無効なリフレッシュトークンではアクセストークンを更新できない")
 	assert.Empty(t, newAccessToken, "エラー時には新しいアクセストークンは生成されるべきではない")
 }
+
+// TestNewAuthContext は、認証方法から amr・acr が決まることのテスト
+func TestNewAuthContext(t *testing.T) {
+	auth := NewAuthContext(AMRPassword)
+	assert.Equal(t, []string{AMRPassword}, auth.AMR)
+	assert.Equal(t, ACRSingleFactor, auth.ACR())
+	assert.WithinDuration(t, time.Now(), auth.AuthTime, time.Second)
+
+	// 複数の方法で認証した場合は mfa を加え、重複は除く
+	auth = NewAuthContext(AMRPassword, AMROTP, AMRPassword)
+	assert.Equal(t, []string{AMRPassword, AMROTP, AMRMFA}, auth.AMR)
+	assert.Equal(t, ACRMultiFactor, auth.ACR())
+
+	// パスキーは単独でも複数要素として扱う
+	assert.Equal(t, ACRMultiFactor, NewAuthContext(AMRHardwareKey).ACR())
+}
+
+// TestACRSatisfies は、acr の強度の比較テスト
+func TestACRSatisfies(t *testing.T) {
+	assert.True(t, ACRSatisfies(ACRMultiFactor, ACRSingleFactor))
+	assert.True(t, ACRSatisfies(ACRSingleFactor, ACRSingleFactor))
+	assert.False(t, ACRSatisfies(ACRSingleFactor, ACRMultiFactor))
+	assert.False(t, ACRSatisfies("", ACRSingleFactor), "acr を持たないトークンは要求を満たさない")
+}
+
+// TestTokenAuthClaims は、トークンに認証日時・方法・強度が含まれ、リフレッシュ後も引き継がれることのテスト
+func TestTokenAuthClaims(t *testing.T) {
+	setupEnv(t)
+
+	originalTimeNow := timeNowFunc
+	defer func() { timeNowFunc = originalTimeNow }()
+	authTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	timeNowFunc = func() time.Time { return authTime }
+	accessToken, refreshToken, err := GenerateTokens("123", NewAuthContext(AMRPassword, AMROTP))
+	assert.NoError(t, err)
+	timeNowFunc = originalTimeNow
+
+	claims, err := ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.True(t, authTime.Equal(claims.AuthTime))
+	assert.True(t, authTime.Equal(claims.IssuedAt))
+	assert.Equal(t, []string{AMRPassword, AMROTP, AMRMFA}, claims.AMR)
+	assert.Equal(t, ACRMultiFactor, claims.ACR)
+
+	newAccessToken, err := RefreshAccessToken(refreshToken)
+	assert.NoError(t, err)
+	claims, err = ValidateToken(newAccessToken)
+	assert.NoError(t, err)
+	assert.True(t, authTime.Equal(claims.AuthTime), "リフレッシュしても認証日時は変わらないこと")
+	assert.True(t, claims.IssuedAt.After(authTime), "発行日時はリフレッシュした日時になること")
+	assert.Equal(t, ACRMultiFactor, claims.ACR)
+}
+
+// TestGenerateOrgTokens は、組織に切り替えたトークンに org_id が含まれ、リフレッシュ後も引き継がれることのテスト
+func TestGenerateOrgTokens(t *testing.T) {
+	setupEnv(t)
+
+	accessToken, refreshToken, err := GenerateOrgTokens("123", NewAuthContext(AMRPassword), "org-1")
+	assert.NoError(t, err)
+
+	claims, err := ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "org-1", claims.OrgID)
+
+	newAccessToken, err := RefreshAccessToken(refreshToken)
+	assert.NoError(t, err)
+	claims, err = ValidateToken(newAccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "org-1", claims.OrgID, "リフレッシュしても切り替えた組織は変わらないこと")
+
+	// 組織を指定しない場合は org_id を含まない
+	accessToken, _, err = GenerateTokens("123", NewAuthContext(AMRPassword))
+	assert.NoError(t, err)
+	claims, err = ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Empty(t, claims.OrgID)
+}
+
+// TestGenerateServiceAccountToken は、サービスアカウントのトークンが主体の種類とスコープを含み、更新できないことのテスト
+func TestGenerateServiceAccountToken(t *testing.T) {
+	setupEnv(t)
+
+	token, err := DefaultTokenSigner().GenerateServiceAccountToken("sa-1", []string{"profile:read", "users:read"}, time.Hour)
+	assert.NoError(t, err)
+
+	claims, err := ValidateToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "sa-1", claims.ObjID)
+	assert.True(t, claims.IsServiceAccount())
+	assert.Equal(t, PrincipalServiceAccount, claims.PrincipalType)
+	assert.Equal(t, []string{"profile:read", "users:read"}, claims.Scopes)
+	assert.True(t, claims.AuthTime.IsZero(), "認証の情報を含まないこと")
+	assert.Empty(t, claims.AMR)
+	assert.Empty(t, claims.ACR)
+
+	// リフレッシュトークンとしては使えない
+	_, err = ValidateRefreshToken(token)
+	assert.Error(t, err)
+	_, err = RefreshAccessToken(token)
+	assert.Error(t, err)
+
+	// 人のトークンは主体の種類・スコープを含まない
+	accessToken, _, err := GenerateTokens("123", NewAuthContext(AMRPassword))
+	assert.NoError(t, err)
+	claims, err = ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.False(t, claims.IsServiceAccount())
+	assert.Nil(t, claims.Scopes)
+}
+
+// TestTokenSigner は、TokenSigner で発行したトークンが、鍵と受け手が同じ TokenSigner でのみ検証できることのテスト
+func TestTokenSigner(t *testing.T) {
+	setupEnv(t)
+
+	acme := NewTokenSigner([]byte("acme-secret"), "acme")
+	accessToken, refreshToken, err := acme.GenerateTokens("123", NewAuthContext(AMRPassword))
+	assert.NoError(t, err)
+
+	claims, err := acme.ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", claims.ObjID)
+	newAccessToken, err := acme.RefreshAccessToken(refreshToken)
+	assert.NoError(t, err)
+	_, err = acme.ValidateToken(newAccessToken)
+	assert.NoError(t, err)
+
+	// 鍵が異なる TokenSigner・既定の鍵では検証できない
+	_, err = NewTokenSigner([]byte("globex-secret"), "globex").ValidateToken(accessToken)
+	assert.Error(t, err)
+	_, err = ValidateToken(accessToken)
+	assert.Error(t, err)
+	_, err = NewTokenSigner([]byte("globex-secret"), "globex").RefreshAccessToken(refreshToken)
+	assert.Error(t, err)
+
+	// 鍵が同じでも受け手が異なる場合は検証できない
+	_, err = NewTokenSigner([]byte("acme-secret"), "globex").ValidateToken(accessToken)
+	assert.Error(t, err)
+	_, err = NewTokenSigner([]byte("acme-secret"), "globex").ValidateRefreshToken(refreshToken)
+	assert.Error(t, err)
+}
+
+// TestTokenSignerWithGroups は、グループをクレームに含める場合に、アクセストークンのみに groups クレームを含めることのテスト
+func TestTokenSignerWithGroups(t *testing.T) {
+	setupEnv(t)
+
+	groups := []string{"engineering"}
+	signer := NewTokenSigner([]byte("acme-secret"), "acme").WithGroups(func(objID string) ([]string, error) {
+		assert.Equal(t, "123", objID)
+		return groups, nil
+	})
+	accessToken, refreshToken, err := signer.GenerateTokens("123", NewAuthContext(AMRPassword))
+	assert.NoError(t, err)
+
+	claims, err := signer.ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"engineering"}, claims.Groups)
+	claims, err = signer.ValidateRefreshToken(refreshToken)
+	assert.NoError(t, err)
+	assert.Nil(t, claims.Groups, "リフレッシュトークンには含めないこと")
+
+	// リフレッシュした場合は所属するグループを改めて解決する
+	groups = []string{"engineering", "sales"}
+	newAccessToken, err := signer.RefreshAccessToken(refreshToken)
+	assert.NoError(t, err)
+	claims, err = signer.ValidateToken(newAccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"engineering", "sales"}, claims.Groups)
+
+	// 元の TokenSigner は groups クレームを含めず、同じ鍵で検証できる
+	plain := NewTokenSigner([]byte("acme-secret"), "acme")
+	accessToken, _, err = plain.GenerateTokens("123", NewAuthContext(AMRPassword))
+	assert.NoError(t, err)
+	claims, err = signer.ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Nil(t, claims.Groups)
+
+	// グループを解決できない場合はトークンを発行しない
+	failing := plain.WithGroups(func(string) ([]string, error) { return nil, errors.New("db down") })
+	_, _, err = failing.GenerateTokens("123", NewAuthContext(AMRPassword))
+	assert.Error(t, err)
+}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"net/url"
+)
+
+// BuildTokenLink は、ベースURLに token クエリを付与したリンクを生成します。
+func BuildTokenLink(baseURL string, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
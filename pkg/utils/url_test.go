@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildTokenLink(t *testing.T) {
+	link, err := BuildTokenLink("https://app.example.com/verify", "abc.def")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://app.example.com/verify?token=abc.def", link)
+
+	// 既存のクエリは保持されること
+	link, err = BuildTokenLink("https://app.example.com/verify?lang=ja", "abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://app.example.com/verify?lang=ja&token=abc", link)
+}
+
+func TestBuildTokenLink_InvalidURL(t *testing.T) {
+	_, err := BuildTokenLink("://invalid", "abc")
+	assert.Error(t, err)
+}